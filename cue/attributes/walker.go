@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cuelang.org/go/cue"
+	"github.com/jmgilman/go/cue/internal/guard"
 )
 
 // Walker traverses CUE values and applies registered processors.
@@ -24,18 +25,34 @@ func NewWalker(registry *Registry, cueCtx *cue.Context) *Walker {
 // Walk traverses a CUE value, finds attributes, and applies processors.
 // Unknown attributes are ignored (forward compatibility).
 // Processor errors fill the path with an error value and continue walking.
+//
+// The walk is bounded by the evaluation limits set with cue.WithEvalLimits
+// (or the defaults); exceeding them returns a CodeTimeout platform error.
 func (w *Walker) Walk(ctx context.Context, value cue.Value) (cue.Value, error) {
-	return w.walkValue(ctx, value)
+	var result cue.Value
+	err := guard.Run(ctx, "attribute walk", func(g *guard.Guard) error {
+		var err error
+		result, err = w.walkValue(ctx, g, value, 0)
+		return err
+	})
+	if err != nil {
+		return cue.Value{}, err
+	}
+	return result, nil
 }
 
 // walkValue recursively processes a CUE value and its children.
-func (w *Walker) walkValue(ctx context.Context, value cue.Value) (cue.Value, error) {
+func (w *Walker) walkValue(ctx context.Context, g *guard.Guard, value cue.Value, depth int) (cue.Value, error) {
+	if err := g.Enter(depth); err != nil {
+		return cue.Value{}, err
+	}
+
 	// Based on the value's kind, walk appropriately
 	switch value.Kind() {
 	case cue.StructKind:
-		return w.walkStruct(ctx, value)
+		return w.walkStruct(ctx, g, value, depth)
 	case cue.ListKind:
-		return w.walkList(ctx, value)
+		return w.walkList(ctx, g, value, depth)
 	default:
 		// For scalar values, process attributes
 		return w.processValue(ctx, value)
@@ -44,46 +61,110 @@ func (w *Walker) walkValue(ctx context.Context, value cue.Value) (cue.Value, err
 
 // processValue processes attributes on a value and then walks its children.
 func (w *Walker) processValue(ctx context.Context, value cue.Value) (cue.Value, error) {
-	// Check for attributes on this value
-	attrs := value.Attributes(cue.ValueAttr)
-	
-	// Process each attribute
-	for _, attr := range attrs {
-		attrName := attr.Name()
-		
-		// Check if we have a processor for this attribute
-		processor, ok := w.registry.Get(attrName)
+	processor, parsedAttr, ok := w.match(value)
+	if !ok {
+		return value, nil
+	}
+
+	// Call the processor
+	newValue, err := processor.Process(ctx, parsedAttr)
+	if err != nil {
+		// Processor error - return an error value
+		errorMsg := fmt.Sprintf("attribute processing failed: %v", err)
+		return w.cueCtx.CompileString(fmt.Sprintf("_|_ // %s", errorMsg)), nil
+	}
+
+	// Replace the value with the processed result
+	return newValue, nil
+}
+
+// match returns the attribute on value that Walk processes: the first one
+// with a registered processor that parses successfully. Unknown attributes
+// are ignored (forward compatibility) and malformed attributes are skipped.
+func (w *Walker) match(value cue.Value) (Processor, Attribute, bool) {
+	for _, attr := range value.Attributes(cue.ValueAttr) {
+		processor, ok := w.registry.Get(attr.Name())
 		if !ok {
-			// Unknown attribute - ignore it (forward compatibility)
 			continue
 		}
-		
-		// Parse the attribute
-		parsedAttr, ok := ParseAttribute(value, attrName)
+
+		parsedAttr, ok := ParseAttribute(value, attr.Name())
 		if !ok {
-			// Malformed attribute - skip it
 			continue
 		}
-		
-		// Call the processor
-		newValue, err := processor.Process(ctx, parsedAttr)
+
+		// Only the first registered attribute is processed
+		return processor, parsedAttr, true
+	}
+
+	return nil, Attribute{}, false
+}
+
+// Plan returns the attributes Walk would process in value, in traversal
+// order, without calling any processors. It can be used to preview or audit
+// runtime substitutions before applying them.
+//
+// Each returned Attribute carries the name, arguments, and path that would be
+// passed to its processor. Because a processed value is replaced as a whole,
+// attributes nested below a planned attribute are not reported.
+//
+// Like Walk, Plan is bounded by the evaluation limits set with cue.WithEvalLimits.
+func (w *Walker) Plan(ctx context.Context, value cue.Value) ([]Attribute, error) {
+	plan := []Attribute{}
+	err := guard.Run(ctx, "attribute plan", func(g *guard.Guard) error {
+		return w.planValue(g, value, 0, &plan)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// planValue mirrors walkValue, recording matched attributes instead of processing them.
+func (w *Walker) planValue(g *guard.Guard, value cue.Value, depth int, plan *[]Attribute) error {
+	if err := g.Enter(depth); err != nil {
+		return err
+	}
+
+	switch value.Kind() {
+	case cue.StructKind:
+		iter, err := value.Fields(cue.All())
 		if err != nil {
-			// Processor error - return an error value
-			errorMsg := fmt.Sprintf("attribute processing failed: %v", err)
-			return w.cueCtx.CompileString(fmt.Sprintf("_|_ // %s", errorMsg)), nil
+			return nil // Walk leaves values it can't iterate unchanged
+		}
+		for iter.Next() {
+			field := iter.Value()
+
+			// Fields with a matched attribute are replaced, so their children are never walked
+			if _, attr, ok := w.match(field); ok {
+				*plan = append(*plan, attr)
+				continue
+			}
+			if err := w.planValue(g, field, depth+1, plan); err != nil {
+				return err
+			}
+		}
+	case cue.ListKind:
+		iter, err := value.List()
+		if err != nil {
+			return nil // Walk leaves values it can't iterate unchanged
+		}
+		for iter.Next() {
+			if err := w.planValue(g, iter.Value(), depth+1, plan); err != nil {
+				return err
+			}
+		}
+	default:
+		if _, attr, ok := w.match(value); ok {
+			*plan = append(*plan, attr)
 		}
-		
-		// Replace the value with the processed result
-		// Only process the first registered attribute
-		value = newValue
-		break
 	}
-	
-	return value, nil
+
+	return nil
 }
 
 // walkStruct walks through a struct's fields.
-func (w *Walker) walkStruct(ctx context.Context, value cue.Value) (cue.Value, error) {
+func (w *Walker) walkStruct(ctx context.Context, g *guard.Guard, value cue.Value, depth int) (cue.Value, error) {
 	// Build a map to collect processed fields
 	processedFields := make(map[string]string)
 	
@@ -104,7 +185,7 @@ func (w *Walker) walkStruct(ctx context.Context, value cue.Value) (cue.Value, er
 		}
 		
 		// Then recursively walk the field's children
-		finalField, err := w.walkValue(ctx, processedField)
+		finalField, err := w.walkValue(ctx, g, processedField, depth+1)
 		if err != nil {
 			return cue.Value{}, err
 		}
@@ -133,7 +214,7 @@ func (w *Walker) walkStruct(ctx context.Context, value cue.Value) (cue.Value, er
 }
 
 // walkList walks through list elements.
-func (w *Walker) walkList(ctx context.Context, value cue.Value) (cue.Value, error) {
+func (w *Walker) walkList(ctx context.Context, g *guard.Guard, value cue.Value, depth int) (cue.Value, error) {
 	// Build a list of processed elements
 	var processedElements []string
 	
@@ -147,7 +228,7 @@ func (w *Walker) walkList(ctx context.Context, value cue.Value) (cue.Value, erro
 		elem := iter.Value()
 		
 		// Recursively walk this element
-		processedElem, err := w.walkValue(ctx, elem)
+		processedElem, err := w.walkValue(ctx, g, elem, depth+1)
 		if err != nil {
 			return cue.Value{}, err
 		}
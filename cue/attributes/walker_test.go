@@ -8,6 +8,8 @@ import (
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
+	"github.com/jmgilman/go/cue/internal/guard"
+	"github.com/jmgilman/go/errors"
 )
 
 // walkerMockProcessor extends mockProcessor with additional tracking for walker tests.
@@ -541,3 +543,107 @@ func TestWalk_ErrorInNestedStruct_ContinuesWalking(t *testing.T) {
 		t.Error("'bad' field should have error")
 	}
 }
+
+func TestWalk_EvalLimits(t *testing.T) {
+	cueCtx := cuecontext.New()
+	walker := NewWalker(NewRegistry(), cueCtx)
+	value := cueCtx.CompileString(`a: b: c: d: "deep"`)
+
+	// Recommended limits allow ordinary values
+	if _, err := walker.Walk(guard.WithLimits(context.Background(), guard.Recommended), value); err != nil {
+		t.Fatalf("Walk failed with recommended limits: %v", err)
+	}
+
+	ctx := guard.WithLimits(context.Background(), guard.Limits{MaxDepth: 2})
+	_, err := walker.Walk(ctx, value)
+	if err == nil {
+		t.Fatal("Expected depth limit error")
+	}
+	if code := errors.GetCode(err); code != errors.CodeTimeout {
+		t.Errorf("Expected code %s, got %s", errors.CodeTimeout, code)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	ctx := context.Background()
+	cueCtx := cuecontext.New()
+	registry := NewRegistry()
+
+	artifact := &mockProcessor{name: "artifact"}
+	secret := &mockProcessor{name: "secret"}
+	for _, p := range []Processor{artifact, secret} {
+		if err := registry.Register(p); err != nil {
+			t.Fatalf("Failed to register processor: %v", err)
+		}
+	}
+
+	walker := NewWalker(registry, cueCtx)
+	value := cueCtx.CompileString(`{
+		image: "placeholder" @artifact(name="api", field="uri")
+		env: {
+			PASSWORD: "placeholder" @secret(key="db/password")
+			REGION: "us-east-1" @unknown(value="ignored")
+		}
+		sidecars: [{image: "placeholder" @artifact(name="proxy")}]
+		config: {nested: "value" @secret(key="nested")} @artifact(name="config")
+	}`)
+
+	plan, err := walker.Plan(ctx, value)
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+
+	// Processors are never called
+	if artifact.processCalled != 0 || secret.processCalled != 0 {
+		t.Errorf("Expected no processor calls, got artifact=%d secret=%d", artifact.processCalled, secret.processCalled)
+	}
+
+	want := []struct {
+		name string
+		path string
+		args map[string]string
+	}{
+		{name: "artifact", path: "image", args: map[string]string{"name": "api", "field": "uri"}},
+		{name: "secret", path: "env.PASSWORD", args: map[string]string{"key": "db/password"}},
+		{name: "artifact", path: "sidecars[0].image", args: map[string]string{"name": "proxy"}},
+		{name: "artifact", path: "config", args: map[string]string{"name": "config"}},
+	}
+	if len(plan) != len(want) {
+		t.Fatalf("Expected %d planned attributes, got %d: %+v", len(want), len(plan), plan)
+	}
+	for i, w := range want {
+		got := plan[i]
+		if got.Name != w.name {
+			t.Errorf("plan[%d]: expected name %q, got %q", i, w.name, got.Name)
+		}
+		if got.Path.String() != w.path {
+			t.Errorf("plan[%d]: expected path %q, got %q", i, w.path, got.Path.String())
+		}
+		for key, value := range w.args {
+			if got.Args[key] != value {
+				t.Errorf("plan[%d]: expected arg %s=%q, got %q", i, key, value, got.Args[key])
+			}
+		}
+	}
+
+	// Plan matches what Walk processes
+	if _, err := walker.Walk(ctx, value); err != nil {
+		t.Fatalf("Walk failed: %v", err)
+	}
+	if calls := artifact.processCalled + secret.processCalled; calls != len(plan) {
+		t.Errorf("Expected Walk to process %d attributes, got %d", len(plan), calls)
+	}
+}
+
+func TestPlan_NoAttributes(t *testing.T) {
+	cueCtx := cuecontext.New()
+	walker := NewWalker(NewRegistry(), cueCtx)
+
+	plan, err := walker.Plan(context.Background(), cueCtx.CompileString(`{a: 1, b: [1, 2]}`))
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if len(plan) != 0 {
+		t.Errorf("Expected empty plan, got %+v", plan)
+	}
+}
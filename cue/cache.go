@@ -0,0 +1,369 @@
+package cue
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strings"
+	"sync"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	cueyaml "cuelang.org/go/encoding/yaml"
+	"github.com/jmgilman/go/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// LoaderCache memoizes the values built by Loader.LoadPackage and
+// Loader.LoadModule.
+//
+// Entries are keyed on the loaded path and a hash of the path and content of
+// every file involved in the load. The files are still read on every call,
+// but the expensive load and build steps are skipped when nothing changed.
+// Adding, removing, or editing a file changes the hash, so stale values are
+// never returned and no explicit invalidation is needed.
+//
+// A LoaderCache is safe for concurrent use. Values are only shared between
+// loads made with the same CUE context, since CUE values cannot be mixed
+// across contexts.
+//
+// Example:
+//
+//	cache := cue.NewLoaderCache()
+//	loader := cue.NewLoader(filesystem).WithCache(cache)
+//
+//	// The first call builds the module; later calls return the cached
+//	// value until one of its files changes.
+//	value, err := loader.LoadModule(ctx, "schemas")
+type LoaderCache struct {
+	mu      sync.Mutex
+	entries map[string]loaderCacheEntry
+}
+
+// loaderCacheEntry is a value built from a specific set of file contents.
+type loaderCacheEntry struct {
+	cueCtx *cue.Context
+	digest [sha256.Size]byte
+	value  cue.Value
+}
+
+// NewLoaderCache creates an empty loader cache.
+func NewLoaderCache() *LoaderCache {
+	return &LoaderCache{
+		entries: make(map[string]loaderCacheEntry),
+	}
+}
+
+// Len returns the number of cached values.
+func (c *LoaderCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Clear removes all cached values.
+func (c *LoaderCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]loaderCacheEntry)
+}
+
+// get returns the value cached under key if it was built by cueCtx from
+// files matching digest.
+func (c *LoaderCache) get(cueCtx *cue.Context, key string, digest [sha256.Size]byte) (cue.Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || entry.cueCtx != cueCtx || entry.digest != digest {
+		return cue.Value{}, false
+	}
+	return entry.value, true
+}
+
+// put caches value under key, replacing any previous entry.
+func (c *LoaderCache) put(cueCtx *cue.Context, key string, digest [sha256.Size]byte, value cue.Value) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = loaderCacheEntry{
+		cueCtx: cueCtx,
+		digest: digest,
+		value:  value,
+	}
+}
+
+// digestFiles hashes the paths and contents of files in a stable order.
+// Lengths are included so that distinct file sets cannot collide by
+// shifting bytes between a path and its content.
+func digestFiles(files map[string][]byte) [sha256.Size]byte {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	h := sha256.New()
+	var size [8]byte
+	for _, path := range paths {
+		binary.BigEndian.PutUint64(size[:], uint64(len(path)))
+		h.Write(size[:])
+		h.Write([]byte(path))
+
+		binary.BigEndian.PutUint64(size[:], uint64(len(files[path])))
+		h.Write(size[:])
+		h.Write(files[path])
+	}
+
+	var digest [sha256.Size]byte
+	copy(digest[:], h.Sum(nil))
+	return digest
+}
+
+// EncodeCache memoizes YAML encodings of concrete CUE values, for services
+// that render the same values repeatedly.
+//
+// Entries are keyed on a hash of the value's JSON encoding, so equal values
+// share an entry regardless of how or where they were built. Lists are
+// cached element by element as well: when a list is rendered, elements seen
+// before (in this or any other list) are not re-encoded, which makes large
+// lists of repeated structs (e.g. generated manifests) much cheaper to render.
+// The output is identical to EncodeYAML.
+//
+// Entries are never evicted; call Clear to release memory. An EncodeCache is
+// safe for concurrent use.
+//
+// Example:
+//
+//	cache := cue.NewEncodeCache()
+//	data, err := cache.EncodeYAML(ctx, manifests)
+type EncodeCache struct {
+	mu        sync.Mutex
+	documents map[[sha256.Size]byte][]byte
+	elements  map[[sha256.Size]byte]*yaml.Node
+}
+
+// NewEncodeCache creates an empty encode cache.
+func NewEncodeCache() *EncodeCache {
+	return &EncodeCache{
+		documents: make(map[[sha256.Size]byte][]byte),
+		elements:  make(map[[sha256.Size]byte]*yaml.Node),
+	}
+}
+
+// Len returns the number of cached documents.
+func (c *EncodeCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.documents)
+}
+
+// Clear removes all cached documents and list elements.
+func (c *EncodeCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.documents = make(map[[sha256.Size]byte][]byte)
+	c.elements = make(map[[sha256.Size]byte]*yaml.Node)
+}
+
+// EncodeYAML encodes a CUE value to YAML bytes like EncodeYAML, returning a
+// cached encoding if an equal value was encoded before.
+//
+// Returns CodeCUEEncodeFailed if the value cannot be encoded or is not fully evaluated.
+func (c *EncodeCache) EncodeYAML(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return nil, wrapEncodeError(ctx.Err(), "context cancelled before encoding")
+	}
+
+	// Validate that the value is fully evaluated
+	if err := value.Err(); err != nil {
+		return nil, wrapEncodeErrorWithContext(
+			err,
+			"CUE value contains errors and cannot be encoded",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	// Check if value is concrete (fully evaluated)
+	if !value.IsConcrete() {
+		return nil, errors.New(
+			errors.CodeCUEEncodeFailed,
+			"CUE value is not concrete (contains unresolved values) and cannot be encoded to YAML",
+		)
+	}
+
+	var data []byte
+	var perr errors.PlatformError
+	if value.Kind() == cue.ListKind {
+		data, perr = c.encodeList(ctx, value)
+	} else {
+		data, perr = c.encodeDocument(value)
+	}
+	if perr != nil {
+		return nil, perr
+	}
+
+	// Callers own the returned slice
+	return bytes.Clone(data), nil
+}
+
+// encodeDocument encodes a non-list value, using the cached encoding if present.
+func (c *EncodeCache) encodeDocument(value cue.Value) ([]byte, errors.PlatformError) {
+	key, err := hashJSON(value)
+	if err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to hash CUE value: %v", err)
+	}
+
+	if data, ok := c.getDocument(key); ok {
+		return data, nil
+	}
+
+	data, err := cueyaml.Encode(value)
+	if err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to encode CUE value to YAML: %v", err)
+	}
+
+	c.putDocument(key, data)
+	return data, nil
+}
+
+// encodeList encodes a list by assembling the YAML nodes of its elements,
+// encoding only elements that are not cached yet.
+func (c *EncodeCache) encodeList(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError) {
+	iter, err := value.List()
+	if err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to iterate CUE list: %v", err)
+	}
+
+	// The document key is derived from the element keys, so a cached list
+	// only costs one JSON encoding per element
+	var elems []cue.Value
+	var keys [][sha256.Size]byte
+	h := sha256.New()
+	h.Write([]byte("list"))
+	for iter.Next() {
+		key, err := hashJSON(iter.Value())
+		if err != nil {
+			return nil, wrapEncodeErrorWithContext(
+				err,
+				"failed to hash CUE list element",
+				makeContext("index", len(elems)),
+			)
+		}
+		h.Write(key[:])
+		elems = append(elems, iter.Value())
+		keys = append(keys, key)
+	}
+
+	// An empty list has no elements to assemble
+	if len(elems) == 0 {
+		return c.encodeDocument(value)
+	}
+
+	var docKey [sha256.Size]byte
+	copy(docKey[:], h.Sum(nil))
+	if data, ok := c.getDocument(docKey); ok {
+		return data, nil
+	}
+
+	seq := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	for i, elem := range elems {
+		// Check context cancellation between elements
+		if ctx.Err() != nil {
+			return nil, wrapEncodeError(ctx.Err(), "context cancelled while encoding list")
+		}
+
+		node, perr := c.encodeElement(keys[i], elem)
+		if perr != nil {
+			return nil, errors.WithContext(perr, "index", i)
+		}
+		seq.Content = append(seq.Content, node)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(yamlIndent())
+	if err := enc.Encode(seq); err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to encode CUE list to YAML: %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to encode CUE list to YAML: %v", err)
+	}
+
+	data := buf.Bytes()
+	c.putDocument(docKey, data)
+	return data, nil
+}
+
+// encodeElement returns the YAML node of a list element. Nodes are obtained by
+// parsing the element's YAML encoding, which round-trips exactly, so the
+// assembled list renders the same as encoding the whole list at once.
+func (c *EncodeCache) encodeElement(key [sha256.Size]byte, elem cue.Value) (*yaml.Node, errors.PlatformError) {
+	c.mu.Lock()
+	node, ok := c.elements[key]
+	c.mu.Unlock()
+	if ok {
+		return node, nil
+	}
+
+	data, err := cueyaml.Encode(elem)
+	if err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to encode CUE list element to YAML: %v", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to parse encoded list element: %v", err)
+	}
+	if len(doc.Content) != 1 {
+		return nil, errors.New(errors.CodeCUEEncodeFailed, "encoded list element is not a single YAML document")
+	}
+	node = doc.Content[0]
+
+	c.mu.Lock()
+	c.elements[key] = node
+	c.mu.Unlock()
+
+	return node, nil
+}
+
+// getDocument returns the cached encoding under key.
+func (c *EncodeCache) getDocument(key [sha256.Size]byte) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.documents[key]
+	return data, ok
+}
+
+// putDocument caches data under key, replacing any previous entry.
+func (c *EncodeCache) putDocument(key [sha256.Size]byte, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.documents[key] = data
+}
+
+// yamlIndent returns the indentation used by cuelang.org/go/encoding/yaml,
+// detected once so that assembled lists render exactly like EncodeYAML.
+var yamlIndent = sync.OnceValue(func() int {
+	data, err := cueyaml.Encode(cuecontext.New().CompileString(`a: b: 1`))
+	if err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			if trimmed := strings.TrimLeft(line, " "); strings.HasPrefix(trimmed, "b:") {
+				return len(line) - len(trimmed)
+			}
+		}
+	}
+	return 4 // yaml.v3 default
+})
+
+// hashJSON hashes the JSON encoding of value.
+func hashJSON(value cue.Value) ([sha256.Size]byte, error) {
+	data, err := value.MarshalJSON()
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
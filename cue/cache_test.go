@@ -0,0 +1,391 @@
+package cue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	platformerrors "github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/fs/billy"
+)
+
+// TestLoaderCache tests memoization of package and module loads.
+func TestLoaderCache(t *testing.T) {
+	// lookupString returns the string at path in value.
+	lookupString := func(t *testing.T, value cue.Value, path string) string {
+		t.Helper()
+		str, err := value.LookupPath(cue.ParsePath(path)).String()
+		if err != nil {
+			t.Fatalf("failed to lookup %s: %v", path, err)
+		}
+		return str
+	}
+
+	// markCached replaces the cached value under key with a sentinel value,
+	// so that a later cache hit can be told apart from a rebuild.
+	markCached := func(t *testing.T, cache *LoaderCache, loader *Loader, key string) {
+		t.Helper()
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+
+		entry, ok := cache.entries[key]
+		if !ok {
+			t.Fatalf("expected cache entry for %s", key)
+		}
+		entry.value = loader.Context().CompileString(`value: "cached"`)
+		cache.entries[key] = entry
+	}
+
+	t.Run("returns cached module while files are unchanged", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("test.cue", []byte(`
+			package test
+			value: "hello"
+		`), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		ctx := context.Background()
+		cache := NewLoaderCache()
+		loader := NewLoader(mfs).WithCache(cache)
+
+		if _, err := loader.LoadModule(ctx, "."); err != nil {
+			t.Fatalf("LoadModule failed: %v", err)
+		}
+		if cache.Len() != 1 {
+			t.Fatalf("expected 1 cache entry, got %d", cache.Len())
+		}
+
+		markCached(t, cache, loader, "module:.")
+
+		result, err := loader.LoadModule(ctx, ".")
+		if err != nil {
+			t.Fatalf("LoadModule failed: %v", err)
+		}
+		if got := lookupString(t, result, "value"); got != "cached" {
+			t.Errorf("expected cached value, got value=%q", got)
+		}
+	})
+
+	t.Run("rebuilds module when a file changes", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("test.cue", []byte(`
+			package test
+			value: "hello"
+		`), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		ctx := context.Background()
+		cache := NewLoaderCache()
+		loader := NewLoader(mfs).WithCache(cache)
+
+		if _, err := loader.LoadModule(ctx, "."); err != nil {
+			t.Fatalf("LoadModule failed: %v", err)
+		}
+		markCached(t, cache, loader, "module:.")
+
+		if err := mfs.WriteFile("test.cue", []byte(`
+			package test
+			value: "updated"
+		`), 0644); err != nil {
+			t.Fatalf("failed to update test file: %v", err)
+		}
+
+		result, err := loader.LoadModule(ctx, ".")
+		if err != nil {
+			t.Fatalf("LoadModule failed: %v", err)
+		}
+		if got := lookupString(t, result, "value"); got != "updated" {
+			t.Errorf("expected value='updated', got value=%q", got)
+		}
+	})
+
+	t.Run("rebuilds package when a file is added", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("pkg/a.cue", []byte(`
+			package pkg
+			a: "one"
+		`), 0644); err != nil {
+			t.Fatalf("failed to create a.cue: %v", err)
+		}
+
+		ctx := context.Background()
+		cache := NewLoaderCache()
+		loader := NewLoader(mfs).WithCache(cache)
+
+		if _, err := loader.LoadPackage(ctx, "pkg"); err != nil {
+			t.Fatalf("LoadPackage failed: %v", err)
+		}
+
+		if err := mfs.WriteFile("pkg/b.cue", []byte(`
+			package pkg
+			b: "two"
+		`), 0644); err != nil {
+			t.Fatalf("failed to create b.cue: %v", err)
+		}
+
+		result, err := loader.LoadPackage(ctx, "pkg")
+		if err != nil {
+			t.Fatalf("LoadPackage failed: %v", err)
+		}
+		if got := lookupString(t, result, "b"); got != "two" {
+			t.Errorf("expected b='two', got b=%q", got)
+		}
+	})
+
+	t.Run("does not share values across CUE contexts", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("test.cue", []byte(`
+			package test
+			value: "hello"
+		`), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		ctx := context.Background()
+		cache := NewLoaderCache()
+		first := NewLoader(mfs).WithCache(cache)
+
+		if _, err := first.LoadModule(ctx, "."); err != nil {
+			t.Fatalf("LoadModule failed: %v", err)
+		}
+		markCached(t, cache, first, "module:.")
+
+		second := NewLoader(mfs).WithCache(cache)
+		result, err := second.LoadModule(ctx, ".")
+		if err != nil {
+			t.Fatalf("LoadModule failed: %v", err)
+		}
+		if got := lookupString(t, result, "value"); got != "hello" {
+			t.Errorf("expected value='hello', got value=%q", got)
+		}
+	})
+
+	t.Run("does not cache failed loads", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("test.cue", []byte(`
+			package test
+			value: "hello" & 42
+		`), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		cache := NewLoaderCache()
+		loader := NewLoader(mfs).WithCache(cache)
+
+		if _, err := loader.LoadModule(context.Background(), "."); err == nil {
+			t.Fatal("expected LoadModule to fail")
+		}
+		if cache.Len() != 0 {
+			t.Errorf("expected empty cache, got %d entries", cache.Len())
+		}
+	})
+
+	t.Run("clear removes all entries", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("test.cue", []byte(`
+			package test
+			value: "hello"
+		`), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		cache := NewLoaderCache()
+		loader := NewLoader(mfs).WithCache(cache)
+
+		if _, err := loader.LoadModule(context.Background(), "."); err != nil {
+			t.Fatalf("LoadModule failed: %v", err)
+		}
+
+		cache.Clear()
+		if cache.Len() != 0 {
+			t.Errorf("expected empty cache, got %d entries", cache.Len())
+		}
+	})
+}
+
+// TestDigestFiles tests that file digests are stable and sensitive to changes.
+func TestDigestFiles(t *testing.T) {
+	base := map[string][]byte{"a.cue": []byte("a: 1"), "b.cue": []byte("b: 2")}
+
+	if digestFiles(base) != digestFiles(map[string][]byte{"b.cue": []byte("b: 2"), "a.cue": []byte("a: 1")}) {
+		t.Error("expected digest to be independent of map order")
+	}
+	if digestFiles(base) == digestFiles(map[string][]byte{"a.cue": []byte("a: 1"), "b.cue": []byte("b: 3")}) {
+		t.Error("expected digest to change with file content")
+	}
+	if digestFiles(base) == digestFiles(map[string][]byte{"a.cue": []byte("a: 1"), "c.cue": []byte("b: 2")}) {
+		t.Error("expected digest to change with file path")
+	}
+	if digestFiles(map[string][]byte{"ab": []byte("c")}) == digestFiles(map[string][]byte{"a": []byte("bc")}) {
+		t.Error("expected digest to separate paths from content")
+	}
+}
+
+// TestEncodeCache tests memoized YAML encoding.
+func TestEncodeCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("matches EncodeYAML", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		cache := NewEncodeCache()
+
+		for _, source := range []string{
+			`{name: "api", spec: {replicas: 2, ports: [80, 443], note: "multi\nline"}}`,
+			`[{name: "a", tags: ["x", "y"], nested: {list: [[1, 2], {k: "v"}]}}, "plain", 42, null, [], {}]`,
+			`[]`,
+			`"scalar"`,
+		} {
+			value := cueCtx.CompileString(source)
+
+			want, err := EncodeYAML(ctx, value)
+			if err != nil {
+				t.Fatalf("EncodeYAML failed for %s: %v", source, err)
+			}
+
+			// Encode twice to compare both the miss and the hit
+			for i := 0; i < 2; i++ {
+				got, err := cache.EncodeYAML(ctx, value)
+				if err != nil {
+					t.Fatalf("EncodeCache.EncodeYAML failed for %s: %v", source, err)
+				}
+				if string(got) != string(want) {
+					t.Errorf("output differs from EncodeYAML for %s:\nwant:\n%s\ngot:\n%s", source, want, got)
+				}
+			}
+		}
+	})
+
+	t.Run("shares entries between equal values", func(t *testing.T) {
+		cache := NewEncodeCache()
+
+		first := cuecontext.New().CompileString(`{name: "api", replicas: 2}`)
+		second := cuecontext.New().CompileString(`{name: "api", replicas: 1 + 1}`)
+
+		if _, err := cache.EncodeYAML(ctx, first); err != nil {
+			t.Fatalf("EncodeYAML failed: %v", err)
+		}
+		if _, err := cache.EncodeYAML(ctx, second); err != nil {
+			t.Fatalf("EncodeYAML failed: %v", err)
+		}
+		if cache.Len() != 1 {
+			t.Errorf("expected 1 cached document, got %d", cache.Len())
+		}
+
+		cache.Clear()
+		if cache.Len() != 0 {
+			t.Errorf("expected empty cache after Clear, got %d", cache.Len())
+		}
+	})
+
+	t.Run("reuses list elements across lists", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		cache := NewEncodeCache()
+
+		if _, err := cache.EncodeYAML(ctx, cueCtx.CompileString(`[{a: 1}, {b: 2}]`)); err != nil {
+			t.Fatalf("EncodeYAML failed: %v", err)
+		}
+		if _, err := cache.EncodeYAML(ctx, cueCtx.CompileString(`[{b: 2}, {a: 1}, {c: 3}]`)); err != nil {
+			t.Fatalf("EncodeYAML failed: %v", err)
+		}
+		if n := len(cache.elements); n != 3 {
+			t.Errorf("expected 3 cached elements, got %d", n)
+		}
+	})
+
+	t.Run("returns independent copies", func(t *testing.T) {
+		cache := NewEncodeCache()
+		value := cuecontext.New().CompileString(`name: "api"`)
+
+		first, err := cache.EncodeYAML(ctx, value)
+		if err != nil {
+			t.Fatalf("EncodeYAML failed: %v", err)
+		}
+		first[0] = 'X'
+
+		second, err := cache.EncodeYAML(ctx, value)
+		if err != nil {
+			t.Fatalf("EncodeYAML failed: %v", err)
+		}
+		if second[0] == 'X' {
+			t.Error("expected cached output to be unaffected by caller modifications")
+		}
+	})
+
+	t.Run("rejects incomplete value", func(t *testing.T) {
+		cache := NewEncodeCache()
+
+		_, err := cache.EncodeYAML(ctx, cuecontext.New().CompileString(`[{name: string}]`))
+		if err == nil {
+			t.Fatal("expected error for incomplete value")
+		}
+		if err.Code() != platformerrors.CodeCUEEncodeFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEEncodeFailed, err.Code())
+		}
+	})
+}
+
+// manifestList builds a list of n similar manifests, cycling through a few variants.
+func manifestList(b *testing.B, n int) cue.Value {
+	b.Helper()
+
+	var source strings.Builder
+	source.WriteString("[\n")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&source, `{
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	metadata: {name: "service-%d", labels: team: "platform"}
+	spec: {
+		replicas: 2
+		template: spec: containers: [{name: "app", image: "registry/app:1.0", ports: [{containerPort: 8080}]}]
+	}
+},
+`, i%8)
+	}
+	source.WriteString("]\n")
+
+	value := cuecontext.New().CompileString(source.String())
+	if err := value.Err(); err != nil {
+		b.Fatalf("failed to build manifests: %v", err)
+	}
+	return value
+}
+
+func BenchmarkEncodeYAML_List(b *testing.B) {
+	ctx := context.Background()
+	value := manifestList(b, 1000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := EncodeYAML(ctx, value); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeCache_List(b *testing.B) {
+	ctx := context.Background()
+	value := manifestList(b, 1000)
+
+	b.Run("cold", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := NewEncodeCache().EncodeYAML(ctx, value); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("warm", func(b *testing.B) {
+		cache := NewEncodeCache()
+		for i := 0; i < b.N; i++ {
+			if _, err := cache.EncodeYAML(ctx, value); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,318 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"github.com/jmgilman/go/errors"
+)
+
+// ChangeType classifies a difference between two versions of a schema.
+type ChangeType string
+
+const (
+	// ChangeFieldAdded indicates a field exists only in the new schema.
+	ChangeFieldAdded ChangeType = "field_added"
+
+	// ChangeFieldRemoved indicates a field exists only in the old schema.
+	ChangeFieldRemoved ChangeType = "field_removed"
+
+	// ChangeFieldRequired indicates an optional field became required.
+	ChangeFieldRequired ChangeType = "field_required"
+
+	// ChangeFieldOptional indicates a required field became optional.
+	ChangeFieldOptional ChangeType = "field_optional"
+
+	// ChangeTypeChanged indicates a field no longer accepts a kind it used to (e.g. string to int).
+	ChangeTypeChanged ChangeType = "type_changed"
+
+	// ChangeConstraintTightened indicates a field rejects values it used to accept.
+	ChangeConstraintTightened ChangeType = "constraint_tightened"
+
+	// ChangeConstraintRelaxed indicates a field accepts values it used to reject.
+	ChangeConstraintRelaxed ChangeType = "constraint_relaxed"
+)
+
+// SchemaChange describes a single difference between two versions of a schema.
+type SchemaChange struct {
+	// Path is the field path of the change (e.g., ["#Config", "port"]).
+	Path []string `json:"path"`
+
+	// Type classifies the change.
+	Type ChangeType `json:"type"`
+
+	// Breaking reports whether data valid against the old schema may be
+	// rejected by the new schema.
+	Breaking bool `json:"breaking"`
+
+	// Message is the human-readable description of the change.
+	Message string `json:"message"`
+}
+
+// CompatReport lists the differences between two versions of a schema.
+type CompatReport struct {
+	// Changes lists every detected change, in field order of the old schema
+	// followed by fields added in the new schema.
+	Changes []SchemaChange `json:"changes"`
+}
+
+// Compatible reports whether the new schema accepts all data the old schema accepted.
+func (r *CompatReport) Compatible() bool {
+	for _, change := range r.Changes {
+		if change.Breaking {
+			return false
+		}
+	}
+	return true
+}
+
+// BreakingChanges returns the changes that may reject previously valid data.
+func (r *CompatReport) BreakingChanges() []SchemaChange {
+	var breaking []SchemaChange
+	for _, change := range r.Changes {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		}
+	}
+	return breaking
+}
+
+// schemaField is a field of a struct schema, keyed by its label.
+type schemaField struct {
+	label      string
+	value      cue.Value
+	optional   bool
+	definition bool
+}
+
+// Compat compares two versions of a schema and reports breaking and compatible changes.
+// A change is breaking if data valid against oldSchema may be rejected by newSchema:
+// removed fields, added required fields, optional fields becoming required,
+// type changes, and tightened constraints. Definitions are compared like fields,
+// so whole schema files can be compared at once.
+//
+// Both values must be created by the same CUE context.
+//
+// Returns CodeCUEValidationFailed if either schema contains errors.
+// Returns CodeInvalidInput if the schemas were created by different CUE contexts.
+//
+// Example:
+//
+//	report, err := cue.Compat(ctx, oldSchema, newSchema)
+//	if !report.Compatible() {
+//	    for _, change := range report.BreakingChanges() {
+//	        fmt.Printf("%s: %s\n", strings.Join(change.Path, "."), change.Message)
+//	    }
+//	}
+func Compat(ctx context.Context, oldSchema, newSchema cue.Value) (*CompatReport, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return nil, wrapValidationErrorWithContext(err, "context cancelled", nil)
+	}
+
+	if err := oldSchema.Err(); err != nil {
+		return nil, wrapValidationError(err, "old schema is invalid")
+	}
+	if err := newSchema.Err(); err != nil {
+		return nil, wrapValidationError(err, "new schema is invalid")
+	}
+
+	if oldSchema.Context() != newSchema.Context() {
+		return nil, errors.New(
+			errors.CodeInvalidInput,
+			"schemas must be created by the same CUE context to be compared",
+		)
+	}
+
+	report := &CompatReport{}
+	if err := compareSchemas(ctx, nil, oldSchema, newSchema, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// compareSchemas records the changes between two values at path.
+// Structs are compared field by field; other values are compared as a whole.
+func compareSchemas(ctx context.Context, path []string, oldValue, newValue cue.Value, report *CompatReport) error {
+	if err := ctx.Err(); err != nil {
+		return wrapValidationErrorWithContext(err, "context cancelled", nil)
+	}
+
+	oldKind := oldValue.IncompleteKind()
+	newKind := newValue.IncompleteKind()
+	if oldKind == cue.StructKind && newKind == cue.StructKind {
+		return compareStructs(ctx, path, oldValue, newValue, report)
+	}
+
+	// Values of optional fields never subsume values of required fields;
+	// unifying both with top drops the field's optionality
+	top := newValue.Context().CompileString("_")
+	oldValue, newValue = top.Unify(oldValue), top.Unify(newValue)
+
+	// Without options, Subsume checks that the receiver is a backwards
+	// compatible version of its argument
+	if err := newValue.Subsume(oldValue); err != nil {
+		change := SchemaChange{
+			Path:     path,
+			Type:     ChangeConstraintTightened,
+			Breaking: true,
+			Message:  fmt.Sprintf("constraint tightened from %v to %v", oldValue, newValue),
+		}
+		if oldKind&^newKind != 0 {
+			change.Type = ChangeTypeChanged
+			change.Message = fmt.Sprintf("type changed from %s to %s", oldKind, newKind)
+		}
+		report.Changes = append(report.Changes, change)
+		return nil
+	}
+
+	if err := oldValue.Subsume(newValue); err != nil {
+		report.Changes = append(report.Changes, SchemaChange{
+			Path:    path,
+			Type:    ChangeConstraintRelaxed,
+			Message: fmt.Sprintf("constraint relaxed from %v to %v", oldValue, newValue),
+		})
+	}
+
+	return nil
+}
+
+// compareStructs records the changes between the fields of two struct values.
+func compareStructs(ctx context.Context, path []string, oldValue, newValue cue.Value, report *CompatReport) error {
+	oldFields, err := listSchemaFields(oldValue)
+	if err != nil {
+		return wrapValidationErrorf(err, "failed to list fields of old schema at %s", formatFieldPath(strings.Join(path, ".")))
+	}
+	newFields, err := listSchemaFields(newValue)
+	if err != nil {
+		return wrapValidationErrorf(err, "failed to list fields of new schema at %s", formatFieldPath(strings.Join(path, ".")))
+	}
+
+	newByLabel := make(map[string]schemaField, len(newFields))
+	for _, field := range newFields {
+		newByLabel[field.label] = field
+	}
+
+	seen := make(map[string]bool, len(oldFields))
+	for _, oldField := range oldFields {
+		seen[oldField.label] = true
+		fieldPath := appendPath(path, oldField.label)
+
+		newField, ok := newByLabel[oldField.label]
+		if !ok {
+			report.Changes = append(report.Changes, SchemaChange{
+				Path:     fieldPath,
+				Type:     ChangeFieldRemoved,
+				Breaking: true,
+				Message:  "field removed",
+			})
+			continue
+		}
+
+		switch {
+		case oldField.optional && !newField.optional:
+			breaking := requiresInput(newField.value)
+			report.Changes = append(report.Changes, SchemaChange{
+				Path:     fieldPath,
+				Type:     ChangeFieldRequired,
+				Breaking: breaking,
+				Message:  "optional field is now required",
+			})
+		case !oldField.optional && newField.optional:
+			report.Changes = append(report.Changes, SchemaChange{
+				Path:    fieldPath,
+				Type:    ChangeFieldOptional,
+				Message: "required field is now optional",
+			})
+		}
+
+		if err := compareSchemas(ctx, fieldPath, oldField.value, newField.value, report); err != nil {
+			return err
+		}
+	}
+
+	for _, newField := range newFields {
+		if seen[newField.label] {
+			continue
+		}
+
+		// Definitions and optional fields never need to be present in data
+		breaking := !newField.optional && !newField.definition && requiresInput(newField.value)
+		message := "optional field added"
+		switch {
+		case newField.definition:
+			message = "definition added"
+		case breaking:
+			message = "required field added"
+		case !newField.optional:
+			message = "field with default added"
+		}
+
+		report.Changes = append(report.Changes, SchemaChange{
+			Path:     appendPath(path, newField.label),
+			Type:     ChangeFieldAdded,
+			Breaking: breaking,
+			Message:  message,
+		})
+	}
+
+	return nil
+}
+
+// listSchemaFields lists the regular, optional, and definition fields of a struct value.
+func listSchemaFields(value cue.Value) ([]schemaField, error) {
+	iter, err := value.Fields(cue.Optional(true), cue.Definitions(true))
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []schemaField
+	for iter.Next() {
+		selector := iter.Selector()
+		fields = append(fields, schemaField{
+			// The label is normalized so optional and required forms of a field match
+			label:      strings.TrimRight(selector.String(), "?!"),
+			value:      iter.Value(),
+			optional:   iter.IsOptional(),
+			definition: selector.IsDefinition(),
+		})
+	}
+
+	return fields, nil
+}
+
+// requiresInput reports whether data must provide a value for a field with
+// the given schema, i.e. the schema has neither a default nor a concrete value.
+// Structs only require input if one of their required fields does.
+func requiresInput(value cue.Value) bool {
+	if _, ok := value.Default(); ok {
+		return false
+	}
+
+	if value.IncompleteKind() == cue.StructKind {
+		fields, err := listSchemaFields(value)
+		if err != nil {
+			return true
+		}
+		for _, field := range fields {
+			if !field.optional && !field.definition && requiresInput(field.value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return !value.IsConcrete()
+}
+
+// appendPath returns a copy of path with label appended, so sibling changes
+// never share a backing array.
+func appendPath(path []string, label string) []string {
+	result := make([]string, len(path), len(path)+1)
+	copy(result, path)
+	return append(result, label)
+}
@@ -0,0 +1,232 @@
+package cue
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cuelang.org/go/cue/cuecontext"
+	platformerrors "github.com/jmgilman/go/errors"
+)
+
+func TestCompat(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldSchema  string
+		newSchema  string
+		wantChange *SchemaChange
+	}{
+		{
+			name:      "identical schemas",
+			oldSchema: `#Config: {name: string, port?: int}`,
+			newSchema: `#Config: {name: string, port?: int}`,
+		},
+		{
+			name:      "removed field",
+			oldSchema: `#Config: {name: string, port?: int}`,
+			newSchema: `#Config: {name: string}`,
+			wantChange: &SchemaChange{
+				Path:     []string{"#Config", "port"},
+				Type:     ChangeFieldRemoved,
+				Breaking: true,
+			},
+		},
+		{
+			name:      "removed definition",
+			oldSchema: `#Config: {name: string}, #Other: {id: string}`,
+			newSchema: `#Config: {name: string}`,
+			wantChange: &SchemaChange{
+				Path:     []string{"#Other"},
+				Type:     ChangeFieldRemoved,
+				Breaking: true,
+			},
+		},
+		{
+			name:      "added optional field",
+			oldSchema: `#Config: {name: string}`,
+			newSchema: `#Config: {name: string, port?: int}`,
+			wantChange: &SchemaChange{
+				Path: []string{"#Config", "port"},
+				Type: ChangeFieldAdded,
+			},
+		},
+		{
+			name:      "added required field",
+			oldSchema: `#Config: {name: string}`,
+			newSchema: `#Config: {name: string, port: int}`,
+			wantChange: &SchemaChange{
+				Path:     []string{"#Config", "port"},
+				Type:     ChangeFieldAdded,
+				Breaking: true,
+			},
+		},
+		{
+			name:      "added field with default",
+			oldSchema: `#Config: {name: string}`,
+			newSchema: `#Config: {name: string, port: *8080 | int}`,
+			wantChange: &SchemaChange{
+				Path: []string{"#Config", "port"},
+				Type: ChangeFieldAdded,
+			},
+		},
+		{
+			name:      "type changed",
+			oldSchema: `#Config: {port: string}`,
+			newSchema: `#Config: {port: int}`,
+			wantChange: &SchemaChange{
+				Path:     []string{"#Config", "port"},
+				Type:     ChangeTypeChanged,
+				Breaking: true,
+			},
+		},
+		{
+			name:      "constraint tightened",
+			oldSchema: `#Config: {port: int}`,
+			newSchema: `#Config: {port: int & >0}`,
+			wantChange: &SchemaChange{
+				Path:     []string{"#Config", "port"},
+				Type:     ChangeConstraintTightened,
+				Breaking: true,
+			},
+		},
+		{
+			name:      "constraint relaxed",
+			oldSchema: `#Config: {port: int & >0}`,
+			newSchema: `#Config: {port: int}`,
+			wantChange: &SchemaChange{
+				Path: []string{"#Config", "port"},
+				Type: ChangeConstraintRelaxed,
+			},
+		},
+		{
+			name:      "optional field becomes required",
+			oldSchema: `#Config: {port?: int}`,
+			newSchema: `#Config: {port: int}`,
+			wantChange: &SchemaChange{
+				Path:     []string{"#Config", "port"},
+				Type:     ChangeFieldRequired,
+				Breaking: true,
+			},
+		},
+		{
+			name:      "required field becomes optional",
+			oldSchema: `#Config: {port: int}`,
+			newSchema: `#Config: {port?: int}`,
+			wantChange: &SchemaChange{
+				Path: []string{"#Config", "port"},
+				Type: ChangeFieldOptional,
+			},
+		},
+		{
+			name:      "nested field change",
+			oldSchema: `#Config: {server: {host: string, port: int}}`,
+			newSchema: `#Config: {server: {host: string, port: string}}`,
+			wantChange: &SchemaChange{
+				Path:     []string{"#Config", "server", "port"},
+				Type:     ChangeTypeChanged,
+				Breaking: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cueCtx := cuecontext.New()
+
+			oldSchema := cueCtx.CompileString(tt.oldSchema)
+			newSchema := cueCtx.CompileString(tt.newSchema)
+
+			report, err := Compat(ctx, oldSchema, newSchema)
+			if err != nil {
+				t.Fatalf("Compat failed: %v", err)
+			}
+
+			if tt.wantChange == nil {
+				if len(report.Changes) != 0 {
+					t.Errorf("expected no changes, got %+v", report.Changes)
+				}
+				if !report.Compatible() {
+					t.Error("expected schemas to be compatible")
+				}
+				return
+			}
+
+			if len(report.Changes) != 1 {
+				t.Fatalf("expected 1 change, got %+v", report.Changes)
+			}
+
+			change := report.Changes[0]
+			if strings.Join(change.Path, ".") != strings.Join(tt.wantChange.Path, ".") {
+				t.Errorf("expected path %v, got %v", tt.wantChange.Path, change.Path)
+			}
+			if change.Type != tt.wantChange.Type {
+				t.Errorf("expected change type %s, got %s", tt.wantChange.Type, change.Type)
+			}
+			if change.Breaking != tt.wantChange.Breaking {
+				t.Errorf("expected breaking=%v, got %v (%s)", tt.wantChange.Breaking, change.Breaking, change.Message)
+			}
+			if report.Compatible() == tt.wantChange.Breaking {
+				t.Errorf("expected Compatible()=%v", !tt.wantChange.Breaking)
+			}
+			if got := len(report.BreakingChanges()); (got > 0) != tt.wantChange.Breaking {
+				t.Errorf("unexpected number of breaking changes: %d", got)
+			}
+		})
+	}
+}
+
+func TestCompat_EdgeCases(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid schema", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		oldSchema := cueCtx.CompileString(`{ a: `)
+		newSchema := cueCtx.CompileString(`a: int`)
+
+		_, err := Compat(ctx, oldSchema, newSchema)
+		if err == nil {
+			t.Fatal("expected error for invalid schema")
+		}
+		if code := platformerrors.GetCode(err); code != platformerrors.CodeCUEValidationFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEValidationFailed, code)
+		}
+	})
+
+	t.Run("different contexts", func(t *testing.T) {
+		oldSchema := cuecontext.New().CompileString(`a: int`)
+		newSchema := cuecontext.New().CompileString(`a: int`)
+
+		_, err := Compat(ctx, oldSchema, newSchema)
+		if err == nil {
+			t.Fatal("expected error for schemas from different contexts")
+		}
+		if code := platformerrors.GetCode(err); code != platformerrors.CodeInvalidInput {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, code)
+		}
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		value := cuecontext.New().CompileString(`a: int`)
+		if _, err := Compat(cancelled, value, value); err == nil {
+			t.Fatal("expected error when context is cancelled")
+		}
+	})
+
+	t.Run("compares non-struct values", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		report, err := Compat(ctx, cueCtx.CompileString(`int`), cueCtx.CompileString(`string`))
+		if err != nil {
+			t.Fatalf("Compat failed: %v", err)
+		}
+		if report.Compatible() {
+			t.Error("expected int to string to be incompatible")
+		}
+		if len(report.Changes) != 1 || len(report.Changes[0].Path) != 0 {
+			t.Errorf("expected a single root change, got %+v", report.Changes)
+		}
+	})
+}
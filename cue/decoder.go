@@ -4,6 +4,7 @@ package cue
 import (
 	"context"
 	"fmt"
+	"iter"
 	"reflect"
 
 	"cuelang.org/go/cue"
@@ -19,6 +20,8 @@ import (
 // - type mismatch occurs during decoding
 // - the CUE value is not concrete (contains unresolved values)
 //
+// Returns CodeTimeout if evaluation exceeds the limits set with WithEvalLimits.
+//
 // Supports optional fields and default values from CUE schemas.
 func Decode(ctx context.Context, value cue.Value, target interface{}) errors.PlatformError {
 	// Check if context is already cancelled
@@ -74,8 +77,14 @@ func Decode(ctx context.Context, value cue.Value, target interface{}) errors.Pla
 	// CUE's Decode will handle these correctly and catch the error if it fails
 	// This allows for more flexible decoding with optional fields
 
-	// Perform the decode operation
-	if err := value.Decode(target); err != nil {
+	// Perform the decode operation within the evaluation limits
+	var decodeErr error
+	if err := guarded(ctx, "decoding", value, wrapDecodeError, func() {
+		decodeErr = value.Decode(target)
+	}); err != nil {
+		return err
+	}
+	if err := decodeErr; err != nil {
 		// Extract information about what went wrong
 		targetType := targetElem.Type().Name()
 		if targetType == "" {
@@ -94,3 +103,101 @@ func Decode(ctx context.Context, value cue.Value, target interface{}) errors.Pla
 
 	return nil
 }
+
+// DecodeStream decodes the elements of a CUE list one at a time and passes
+// each to fn, so large lists (e.g. thousands of manifests) are never
+// materialized as a single Go slice. Decoding stops at the first error,
+// including errors returned by fn, which are returned unchanged.
+//
+// Returns CodeCUEDecodeFailed if:
+// - the CUE value contains errors or is not a list
+// - an element cannot be decoded into T
+//
+// Returns CodeTimeout if decoding an element exceeds the limits set with WithEvalLimits.
+//
+// Example:
+//
+//	err := cue.DecodeStream(ctx, manifests, func(m Manifest) error {
+//	    return apply(ctx, m)
+//	})
+func DecodeStream[T any](ctx context.Context, value cue.Value, fn func(T) error) error {
+	for item, err := range DecodeIter[T](ctx, value) {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeIter returns an iterator that decodes the elements of a CUE list one
+// at a time. Each element is decoded when the iterator reaches it. After an
+// error is yielded, iteration stops.
+//
+// Errors have the same codes as DecodeStream.
+//
+// Example:
+//
+//	for manifest, err := range cue.DecodeIter[Manifest](ctx, manifests) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    process(manifest)
+//	}
+func DecodeIter[T any](ctx context.Context, value cue.Value) iter.Seq2[T, errors.PlatformError] {
+	return func(yield func(T, errors.PlatformError) bool) {
+		var zero T
+
+		// Validate that the value is fully evaluated
+		if err := value.Err(); err != nil {
+			yield(zero, wrapDecodeErrorWithContext(
+				err,
+				"CUE value contains errors and cannot be decoded",
+				makeContext("error", err.Error()),
+			))
+			return
+		}
+
+		list, err := value.List()
+		if err != nil {
+			yield(zero, wrapDecodeErrorWithContext(
+				err,
+				"CUE value must be a list to be decoded as a stream",
+				makeContext("value_kind", value.IncompleteKind().String()),
+			))
+			return
+		}
+
+		for index := 0; list.Next(); index++ {
+			// Check context cancellation between elements
+			if ctx.Err() != nil {
+				yield(zero, wrapDecodeError(ctx.Err(), "context cancelled while decoding stream"))
+				return
+			}
+
+			var item T
+			elem := list.Value()
+			var decodeErr error
+			if err := guarded(ctx, "decoding", elem, wrapDecodeError, func() {
+				decodeErr = elem.Decode(&item)
+			}); err != nil {
+				yield(zero, err)
+				return
+			}
+			if err := decodeErr; err != nil {
+				yield(zero, wrapDecodeErrorWithContext(
+					err,
+					fmt.Sprintf("failed to decode list element %d", index),
+					makeContext("index", index),
+				))
+				return
+			}
+
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
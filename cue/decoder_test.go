@@ -2,6 +2,7 @@ package cue
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
@@ -553,3 +554,121 @@ func TestDecodeWithCUEConstraints(t *testing.T) {
 		t.Errorf("expected host='example.com', got %q", target.Host)
 	}
 }
+
+// TestDecodeStream tests decoding list elements one at a time.
+func TestDecodeStream(t *testing.T) {
+	ctx := context.Background()
+	cueCtx := cuecontext.New()
+
+	t.Run("decodes each element", func(t *testing.T) {
+		value := cueCtx.CompileString(`[{name: "a", value: 1}, {name: "b", value: 2}, {name: "c", value: 3}]`)
+
+		var names []string
+		total := 0
+		err := DecodeStream(ctx, value, func(item SimpleStruct) error {
+			names = append(names, item.Name)
+			total += item.Value
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("DecodeStream failed: %v", err)
+		}
+
+		if strings.Join(names, ",") != "a,b,c" {
+			t.Errorf("expected names a,b,c, got %v", names)
+		}
+		if total != 6 {
+			t.Errorf("expected total 6, got %d", total)
+		}
+	})
+
+	t.Run("decodes scalar elements", func(t *testing.T) {
+		value := cueCtx.CompileString(`["x", "y"]`)
+
+		var items []string
+		err := DecodeStream(ctx, value, func(item string) error {
+			items = append(items, item)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("DecodeStream failed: %v", err)
+		}
+		if strings.Join(items, ",") != "x,y" {
+			t.Errorf("expected items x,y, got %v", items)
+		}
+	})
+
+	t.Run("returns callback error unchanged", func(t *testing.T) {
+		value := cueCtx.CompileString(`[{name: "a", value: 1}, {name: "b", value: 2}]`)
+		stop := errors.New("stop")
+
+		calls := 0
+		err := DecodeStream(ctx, value, func(SimpleStruct) error {
+			calls++
+			return stop
+		})
+		if !errors.Is(err, stop) {
+			t.Errorf("expected callback error, got %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("expected decoding to stop after 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("reports failing element index", func(t *testing.T) {
+		value := cueCtx.CompileString(`[{name: "a", value: 1}, {name: "b", value: "two"}]`)
+
+		err := DecodeStream(ctx, value, func(SimpleStruct) error { return nil })
+		if err == nil {
+			t.Fatal("expected decode error")
+		}
+		if !strings.Contains(err.Error(), "element 1") {
+			t.Errorf("expected error about element 1, got: %v", err)
+		}
+	})
+
+	t.Run("rejects non-list value", func(t *testing.T) {
+		value := cueCtx.CompileString(`{name: "a", value: 1}`)
+
+		err := DecodeStream(ctx, value, func(SimpleStruct) error { return nil })
+		if err == nil {
+			t.Fatal("expected error for non-list value")
+		}
+		if !strings.Contains(err.Error(), "must be a list") {
+			t.Errorf("expected error about list, got: %v", err)
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		value := cueCtx.CompileString(`[{name: "a", value: 1}]`)
+		err := DecodeStream(cancelled, value, func(SimpleStruct) error { return nil })
+		if err == nil || !strings.Contains(err.Error(), "context cancelled") {
+			t.Errorf("expected error about context cancellation, got: %v", err)
+		}
+	})
+}
+
+// TestDecodeIter tests iterator-based decoding of list elements.
+func TestDecodeIter(t *testing.T) {
+	ctx := context.Background()
+	cueCtx := cuecontext.New()
+	value := cueCtx.CompileString(`[1, 2, 3, 4]`)
+
+	var seen []int
+	for item, err := range DecodeIter[int](ctx, value) {
+		if err != nil {
+			t.Fatalf("DecodeIter failed: %v", err)
+		}
+		if item == 3 {
+			break
+		}
+		seen = append(seen, item)
+	}
+
+	if len(seen) != 2 || seen[0] != 1 || seen[1] != 2 {
+		t.Errorf("expected [1 2] before break, got %v", seen)
+	}
+}
@@ -15,10 +15,14 @@ any Go struct types, without coupling to specific schema or configuration packag
 
 The package is organized into several components:
 
-  - Loader: Load CUE modules, packages, and files from filesystem
+  - Loader: Load CUE modules, packages, and files, and YAML/JSON data from filesystem
   - Validator: Validate CUE values against schemas
-  - Encoder: Encode CUE values to YAML/JSON
+  - Compat: Report breaking and compatible changes between schema versions
+  - Encoder: Encode CUE values to YAML/JSON/TOML/textproto
   - Decoder: Decode CUE values to Go structs
+  - Schema: Generate CUE definitions from Go struct types
+  - Format: Emit canonical, trimmed CUE source
+  - OpenAPI: Export definitions to OpenAPI and import JSON Schema
   - Attributes: Extensible attribute processing infrastructure (sub-package)
 
 # Caller Responsibilities
@@ -29,8 +33,9 @@ to the caller:
   - CUE Context Management: The Loader manages its own CUE context, but callers can
     access it via Context() for advanced operations
   - Filesystem Abstraction: All file operations use fs/core.ReadFS interface
-  - Caching: No built-in caching - implement at caller level if needed
-  - Timeouts: Use context.WithTimeout() for operation time limits
+  - Caching: Opt-in via LoaderCache for loads and ModuleRegistry for pulled module dependencies
+  - Timeouts: Validate, Decode, and attribute walks are unbounded unless limits are
+    set with WithEvalLimits() or the context has a deadline
   - Attribute Processors: Register custom processors via attributes.Registry
 
 # Example 1: Load, Validate, and Decode Config
@@ -297,22 +302,79 @@ Main package functions:
 	func (l *Loader) LoadFile(ctx context.Context, filePath string) (cue.Value, error)
 	func (l *Loader) LoadPackage(ctx context.Context, packagePath string) (cue.Value, error)
 	func (l *Loader) LoadModule(ctx context.Context, modulePath string) (cue.Value, error)
+	func (l *Loader) LoadInstances(ctx context.Context, dir string) (map[string]cue.Value, error)
 	func (l *Loader) LoadBytes(ctx context.Context, source []byte, filename string) (cue.Value, error)
+	func (l *Loader) LoadYAML(ctx context.Context, filePath string) ([]cue.Value, error)
+	func (l *Loader) LoadYAMLBytes(ctx context.Context, data []byte, filename string) ([]cue.Value, error)
+	func (l *Loader) LoadJSON(ctx context.Context, filePath string) (cue.Value, error)
+	func (l *Loader) LoadJSONBytes(ctx context.Context, data []byte, filename string) (cue.Value, error)
 	func (l *Loader) Context() *cue.Context
+	func (l *Loader) WithCache(cache *LoaderCache) *Loader
+	func (l *Loader) WithRegistry(registry *ModuleRegistry) *Loader
+
+	// Caching
+	func NewLoaderCache() *LoaderCache
+	func (c *LoaderCache) Len() int
+	func (c *LoaderCache) Clear()
+	func NewEncodeCache() *EncodeCache
+	func (c *EncodeCache) EncodeYAML(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError)
+	func (c *EncodeCache) Len() int
+	func (c *EncodeCache) Clear()
+
+	// Module registry
+	func NewModuleRegistry(registry string, puller ModulePuller, cacheFS core.FS) *ModuleRegistry
+	func (r *ModuleRegistry) Fetch(ctx context.Context, m module.Version) (module.SourceLoc, error)
+	func (r *ModuleRegistry) Requirements(ctx context.Context, m module.Version) ([]module.Version, error)
+	func (r *ModuleRegistry) ModuleVersions(ctx context.Context, mpath string) ([]string, error)
 
 	// Validation
+	func DefaultValidationOptions() ValidationOptions
+	func PartialValidationOptions() ValidationOptions
 	func Validate(ctx context.Context, schema cue.Value, data cue.Value) error
 	func ValidateWithOptions(ctx context.Context, schema cue.Value, data cue.Value, opts ValidationOptions) error
 	func ValidateConstraint(ctx context.Context, value cue.Value, constraint cue.Value) error
 	func ValidateConstraintWithOptions(ctx context.Context, value cue.Value, constraint cue.Value, opts ValidationOptions) error
+	func ValidateDetailed(ctx context.Context, schema cue.Value, data cue.Value) ([]ValidationIssue, error)
+	func ValidateDetailedWithOptions(ctx context.Context, schema cue.Value, data cue.Value, opts ValidationOptions) ([]ValidationIssue, error)
+	func ToJSON(issues []ValidationIssue) ([]byte, errors.PlatformError)
+
+	// Compatibility
+	func Compat(ctx context.Context, oldSchema cue.Value, newSchema cue.Value) (*CompatReport, error)
+	func (r *CompatReport) Compatible() bool
+	func (r *CompatReport) BreakingChanges() []SchemaChange
 
 	// Encoding
 	func EncodeYAML(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError)
 	func EncodeJSON(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError)
+	func EncodeTOML(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError)
+	func EncodeTextProto(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError)
 	func EncodeYAMLStream(ctx context.Context, value cue.Value, w io.Writer) errors.PlatformError
 
+	// Evaluation limits
+	func RecommendedEvalLimits() EvalLimits
+	func WithEvalLimits(ctx context.Context, limits EvalLimits) context.Context
+
 	// Decoding
 	func Decode(ctx context.Context, value cue.Value, target interface{}) errors.PlatformError
+	func DecodeStream[T any](ctx context.Context, value cue.Value, fn func(T) error) error
+	func DecodeIter[T any](ctx context.Context, value cue.Value) iter.Seq2[T, errors.PlatformError]
+
+	// Composition
+	func SetValue(ctx context.Context, value cue.Value, path string, newValue interface{}) (cue.Value, errors.PlatformError)
+	func Merge(ctx context.Context, values ...cue.Value) (cue.Value, errors.PlatformError)
+	func ApplyPolicy(ctx context.Context, data cue.Value, policy cue.Value) (*PolicyResult, errors.PlatformError)
+	func (r *PolicyResult) FromPolicy() []string
+
+	// Schema generation
+	func GenerateSchema(ctx context.Context, types ...interface{}) ([]byte, errors.PlatformError)
+
+	// Formatting
+	func Format(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError)
+	func TrimSource(ctx context.Context, filesystem core.FS, filePaths ...string) errors.PlatformError
+
+	// OpenAPI and JSON Schema
+	func ExportOpenAPI(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError)
+	func ImportJSONSchema(ctx context.Context, cueCtx *cue.Context, data []byte) (cue.Value, errors.PlatformError)
 
 Attributes sub-package (cue/attributes):
 
@@ -336,6 +398,7 @@ Attributes sub-package (cue/attributes):
 	type Walker struct { ... }
 	func NewWalker(registry *Registry, cueCtx *cue.Context) *Walker
 	func (w *Walker) Walk(ctx context.Context, value cue.Value) (cue.Value, error)
+	func (w *Walker) Plan(ctx context.Context, value cue.Value) ([]Attribute, error)
 
 	func ParseAttribute(value cue.Value, attrName string) (Attribute, bool)
 	func ParseArgs(attrText string) (map[string]string, error)
@@ -350,22 +413,29 @@ across the platform. Error codes include:
   - CodeCUEValidationFailed: Validation failures
   - CodeCUEDecodeFailed: Decoding failures
   - CodeCUEEncodeFailed: Encoding failures
+  - CodeConflict: Conflicting values in SetValue and Merge
+  - CodeTimeout: Evaluation exceeded its EvalLimits or the context deadline
 
 Validation errors include detailed field path information and structured error messages
-for debugging.
+for debugging. Set ValidationOptions.Sources to the filesystem the values were loaded
+from to also include source excerpts pointing at the offending lines.
 
 # Related Packages
 
   - github.com/jmgilman/go/errors - Platform error handling
   - github.com/jmgilman/go/fs/core - Filesystem abstraction interfaces
   - github.com/jmgilman/go/fs/billy - Billy filesystem wrapper
+  - github.com/jmgilman/go/oci - OCI bundle client for pulling module dependencies
   - cuelang.org/go - CUE language implementation
 
 # Performance Considerations
 
-  - Module loading can be expensive - caller should cache cue.Value results if needed
+  - Module loading can be expensive - use a LoaderCache to reuse results while files are unchanged
   - Use EncodeYAMLStream() for large manifests (>10MB) to avoid memory pressure
+  - Use an EncodeCache when rendering the same values, or lists of repeated elements, to YAML repeatedly
+  - Use DecodeStream() or DecodeIter() to process large lists element by element
   - CUE validation is typically fast (<100ms) but complex schemas may take longer
-  - Use context.WithTimeout() to set time limits on operations
+  - Use context.WithTimeout() or WithEvalLimits() to set time limits on operations
+  - Evaluation limits add a traversal of each validated or decoded value, so they are opt-in
 */
 package cue
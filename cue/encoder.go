@@ -2,10 +2,13 @@
 package cue
 
 import (
+	"bytes"
 	"context"
 	"io"
 
 	"cuelang.org/go/cue"
+	"cuelang.org/go/encoding/protobuf/textproto"
+	cuetoml "cuelang.org/go/encoding/toml"
 	cueyaml "cuelang.org/go/encoding/yaml"
 	"github.com/jmgilman/go/errors"
 )
@@ -86,6 +89,104 @@ func EncodeJSON(ctx context.Context, value cue.Value) ([]byte, errors.PlatformEr
 	return data, nil
 }
 
+// EncodeTOML encodes a CUE value to TOML bytes.
+// The value must be a struct, which becomes the root table of the document.
+// Returns CodeCUEEncodeFailed if the value cannot be encoded or is not fully evaluated.
+func EncodeTOML(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return nil, wrapEncodeError(ctx.Err(), "context cancelled before encoding")
+	}
+
+	// Validate that the value is fully evaluated
+	if err := value.Err(); err != nil {
+		return nil, wrapEncodeErrorWithContext(
+			err,
+			"CUE value contains errors and cannot be encoded",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	// Check if value is concrete (fully evaluated), including nested fields,
+	// which the TOML encoder would otherwise reject or silently skip
+	if !value.IsConcrete() || value.Validate(cue.Concrete(true)) != nil {
+		return nil, errors.New(
+			errors.CodeCUEEncodeFailed,
+			"CUE value is not concrete (contains unresolved values) and cannot be encoded to TOML",
+		)
+	}
+
+	// TOML documents always have a table at the top level
+	if value.Kind() != cue.StructKind {
+		return nil, errors.New(
+			errors.CodeCUEEncodeFailed,
+			"CUE value must be a struct to be encoded to TOML",
+		)
+	}
+
+	// Encode to TOML
+	var buf bytes.Buffer
+	if err := cuetoml.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, wrapEncodeErrorf(
+			err,
+			"failed to encode CUE value to TOML: %v",
+			err,
+		)
+	}
+	data := buf.Bytes()
+
+	return data, nil
+}
+
+// EncodeTextProto encodes a CUE value to textproto bytes.
+// The value must be a struct, which becomes the root message. Field names are
+// used as-is unless overridden with a @protobuf attribute.
+// Returns CodeCUEEncodeFailed if the value cannot be encoded or is not fully evaluated.
+func EncodeTextProto(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return nil, wrapEncodeError(ctx.Err(), "context cancelled before encoding")
+	}
+
+	// Validate that the value is fully evaluated
+	if err := value.Err(); err != nil {
+		return nil, wrapEncodeErrorWithContext(
+			err,
+			"CUE value contains errors and cannot be encoded",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	// Check if value is concrete (fully evaluated), including nested fields,
+	// which the textproto encoder would otherwise reject or silently skip
+	if !value.IsConcrete() || value.Validate(cue.Concrete(true)) != nil {
+		return nil, errors.New(
+			errors.CodeCUEEncodeFailed,
+			"CUE value is not concrete (contains unresolved values) and cannot be encoded to textproto",
+		)
+	}
+
+	// textproto documents always have a message at the top level
+	if value.Kind() != cue.StructKind {
+		return nil, errors.New(
+			errors.CodeCUEEncodeFailed,
+			"CUE value must be a struct to be encoded to textproto",
+		)
+	}
+
+	// Encode to textproto
+	data, err := textproto.NewEncoder().Encode(value)
+	if err != nil {
+		return nil, wrapEncodeErrorf(
+			err,
+			"failed to encode CUE value to textproto: %v",
+			err,
+		)
+	}
+
+	return data, nil
+}
+
 // EncodeYAMLStream encodes a CUE value to YAML and writes it to an io.Writer.
 // This is useful for large manifests to avoid loading the entire output into memory.
 // Returns CodeCUEEncodeFailed if the value cannot be encoded, is not fully evaluated,
@@ -383,6 +383,180 @@ func TestEncodeJSONContextCancelled(t *testing.T) {
 	}
 }
 
+// TestEncodeTOML tests the EncodeTOML function with various inputs.
+func TestEncodeTOML(t *testing.T) {
+	ctx := context.Background()
+	cueCtx := cuecontext.New()
+
+	tests := []struct {
+		name        string
+		input       string
+		wantErr     bool
+		errContains string
+		contains    []string
+	}{
+		{
+			name:     "simple object",
+			input:    `{name: "test", value: 123}`,
+			contains: []string{`name = 'test'`, `value = 123`},
+		},
+		{
+			name: "nested tables",
+			input: `{
+				package: {name: "demo", version: "0.1.0"}
+				dependencies: {serde: "1.0"}
+			}`,
+			contains: []string{"[package]", `name = 'demo'`, "[dependencies]", `serde = '1.0'`},
+		},
+		{
+			name:     "array of numbers",
+			input:    `{ports: [80, 443]}`,
+			contains: []string{"ports = [80, 443]"},
+		},
+		{
+			name:        "non-struct value",
+			input:       `"hello"`,
+			wantErr:     true,
+			errContains: "must be a struct",
+		},
+		{
+			name:        "non-concrete value",
+			input:       `{name: string}`,
+			wantErr:     true,
+			errContains: "not concrete",
+		},
+		{
+			name:        "value with errors",
+			input:       `{a: 1 & 2}`,
+			wantErr:     true,
+			errContains: "contains errors",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := cueCtx.CompileString(tt.input)
+
+			output, err := EncodeTOML(ctx, value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error containing %q, got: %v", tt.errContains, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(string(output), want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, output)
+				}
+			}
+		})
+	}
+}
+
+// TestEncodeTOMLContextCancelled tests that EncodeTOML respects context cancellation.
+func TestEncodeTOMLContextCancelled(t *testing.T) {
+	cueCtx := cuecontext.New()
+	value := cueCtx.CompileString(`{name: "test"}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // Cancel immediately
+
+	_, err := EncodeTOML(ctx, value)
+	if err == nil {
+		t.Fatal("expected error when context is cancelled")
+	}
+	if !strings.Contains(err.Error(), "context cancelled") {
+		t.Errorf("expected error about context cancellation, got: %v", err)
+	}
+}
+
+// TestEncodeTextProto tests the EncodeTextProto function with various inputs.
+func TestEncodeTextProto(t *testing.T) {
+	ctx := context.Background()
+	cueCtx := cuecontext.New()
+
+	tests := []struct {
+		name        string
+		input       string
+		wantErr     bool
+		errContains string
+		contains    []string
+	}{
+		{
+			name:     "simple message",
+			input:    `{name: "test", replicas: 3}`,
+			contains: []string{`name: "test"`, "replicas: 3"},
+		},
+		{
+			name:     "nested message",
+			input:    `{server: {host: "localhost", port: 8080}}`,
+			contains: []string{"server", `host: "localhost"`, "port: 8080"},
+		},
+		{
+			name:        "non-struct value",
+			input:       `42`,
+			wantErr:     true,
+			errContains: "must be a struct",
+		},
+		{
+			name:        "non-concrete value",
+			input:       `{replicas: int}`,
+			wantErr:     true,
+			errContains: "not concrete",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := cueCtx.CompileString(tt.input)
+
+			output, err := EncodeTextProto(ctx, value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error containing %q, got: %v", tt.errContains, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(string(output), want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, output)
+				}
+			}
+		})
+	}
+}
+
+// TestEncodeTextProtoContextCancelled tests that EncodeTextProto respects context cancellation.
+func TestEncodeTextProtoContextCancelled(t *testing.T) {
+	cueCtx := cuecontext.New()
+	value := cueCtx.CompileString(`{name: "test"}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // Cancel immediately
+
+	_, err := EncodeTextProto(ctx, value)
+	if err == nil {
+		t.Fatal("expected error when context is cancelled")
+	}
+	if !strings.Contains(err.Error(), "context cancelled") {
+		t.Errorf("expected error about context cancellation, got: %v", err)
+	}
+}
+
 // TestEncodeYAMLStream tests the streaming YAML encoder.
 func TestEncodeYAMLStream(t *testing.T) {
 	ctx := context.Background()
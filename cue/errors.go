@@ -132,6 +132,15 @@ func wrapEncodeErrorWithContext(err error, message string, ctx map[string]interf
 	return errors.WrapWithContext(err, errors.CodeCUEEncodeFailed, message, ctx)
 }
 
+// wrapConflictErrorWithContext wraps an error with CodeConflict and attaches context metadata.
+// Used when unifying CUE values produces conflicting values.
+func wrapConflictErrorWithContext(err error, message string, ctx map[string]interface{}) errors.PlatformError {
+	if err == nil {
+		return nil
+	}
+	return errors.WrapWithContext(err, errors.CodeConflict, message, ctx)
+}
+
 // extractErrorContext creates a context map with common error metadata.
 // Helper for building context maps for WrapWithContext calls.
 func extractErrorContext(kvPairs ...interface{}) map[string]interface{} {
@@ -0,0 +1,112 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	cueerrors "cuelang.org/go/cue/errors"
+	"cuelang.org/go/cue/token"
+	"github.com/jmgilman/go/fs/core"
+)
+
+const (
+	// maxExcerpts caps the number of source excerpts attached to an error.
+	maxExcerpts = 10
+
+	// excerptContextLines is the number of lines shown before the offending line.
+	excerptContextLines = 1
+)
+
+// renderExcerpts renders a source excerpt for each distinct position in err,
+// reading files through filesystem. Positions in files that cannot be read
+// (e.g. values compiled with LoadBytes) are skipped.
+//
+// Each excerpt names the file, line, and column, followed by the offending
+// line and the line before it, with a caret under the column:
+//
+//	config/app.cue:4:12
+//	   3 | spec: {
+//	   4 |     replicas: -1
+//	     |               ^
+func renderExcerpts(filesystem core.ReadFS, err error) []string {
+	if filesystem == nil || err == nil {
+		return nil
+	}
+
+	files := make(map[string][]string)
+	seen := make(map[string]bool)
+	var excerpts []string
+
+	for _, e := range cueerrors.Errors(err) {
+		for _, pos := range e.InputPositions() {
+			if len(excerpts) == maxExcerpts {
+				return excerpts
+			}
+			if !pos.IsValid() || pos.Filename() == "" {
+				continue
+			}
+
+			path := fsPath(pos.Filename())
+			key := fmt.Sprintf("%s:%d:%d", path, pos.Line(), pos.Column())
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			lines, ok := files[path]
+			if !ok {
+				data, err := filesystem.ReadFile(path)
+				if err == nil {
+					lines = strings.Split(string(data), "\n")
+				}
+				files[path] = lines
+			}
+
+			if excerpt, ok := renderExcerpt(path, lines, pos); ok {
+				excerpts = append(excerpts, excerpt)
+			}
+		}
+	}
+
+	return excerpts
+}
+
+// renderExcerpt renders the excerpt for pos within the lines of the file at path.
+func renderExcerpt(path string, lines []string, pos token.Pos) (string, bool) {
+	line := pos.Line()
+	if line < 1 || line > len(lines) {
+		return "", false
+	}
+
+	first := max(line-excerptContextLines, 1)
+	width := len(fmt.Sprint(line))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:%d:%d\n", path, line, pos.Column())
+	for n := first; n <= line; n++ {
+		fmt.Fprintf(&b, "%*d | %s\n", width+2, n, expandTabs(lines[n-1]))
+	}
+
+	// Columns count bytes; tabs before the column are expanded like the line
+	column := min(max(pos.Column(), 1), len(lines[line-1])+1)
+	prefix := expandTabs(lines[line-1][:column-1])
+	fmt.Fprintf(&b, "%*s | %s^", width+2, "", strings.Repeat(" ", len(prefix)))
+
+	return b.String(), true
+}
+
+// expandTabs replaces tabs with four spaces so carets line up in any terminal.
+func expandTabs(s string) string {
+	return strings.ReplaceAll(s, "\t", "    ")
+}
+
+// fsPath converts a CUE overlay filename back to a filesystem path, reversing makeAbsolutePath.
+func fsPath(filename string) string {
+	path := strings.ReplaceAll(filename, "\\", "/")
+	if runtime.GOOS == "windows" {
+		path = strings.TrimPrefix(path, "C:")
+	}
+	return strings.TrimPrefix(path, "/")
+}
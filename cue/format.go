@@ -0,0 +1,147 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/ast"
+	"cuelang.org/go/cue/cuecontext"
+	"cuelang.org/go/cue/format"
+	"cuelang.org/go/cue/load"
+	"cuelang.org/go/tools/trim"
+	"github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/fs/core"
+)
+
+// Format formats a CUE value as canonical CUE source, as produced by cue fmt.
+// The value does not need to be concrete, so schemas can be formatted too.
+// Comments, attributes, optional fields, and definitions are preserved.
+//
+// Returns CodeCUEEncodeFailed if the value contains errors or cannot be formatted.
+//
+// Example:
+//
+//	source, err := cue.Format(ctx, schema)
+//	err = filesystem.WriteFile("schema.cue", source, 0644)
+func Format(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return nil, wrapEncodeError(ctx.Err(), "context cancelled before formatting")
+	}
+
+	if err := checkConflicts(value); err != nil {
+		return nil, wrapEncodeErrorWithContext(
+			err,
+			"CUE value contains errors and cannot be formatted",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	node := value.Syntax(
+		cue.Docs(true),
+		cue.Attributes(true),
+		cue.Optional(true),
+		cue.Definitions(true),
+	)
+
+	// Emit top-level structs as file declarations rather than a braced literal
+	if st, ok := node.(*ast.StructLit); ok {
+		node = &ast.File{Decls: st.Elts}
+	}
+
+	data, err := format.Node(node, format.Simplify())
+	if err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to format CUE value: %v", err)
+	}
+
+	return data, nil
+}
+
+// TrimSource removes fields from CUE files that are implied by other
+// constraints in the same package, as cue trim does, and rewrites them in
+// canonical format. The filePaths are relative to the filesystem root and
+// must all belong to the same package. Files are only written if their
+// content changes.
+//
+// Returns CodeCUELoadFailed on file I/O errors.
+// Returns CodeCUEBuildFailed if the files cannot be built or trimmed.
+// Returns CodeCUEEncodeFailed if a trimmed file cannot be formatted or written.
+//
+// Example:
+//
+//	err := cue.TrimSource(ctx, filesystem, "config/base.cue", "config/prod.cue")
+func TrimSource(ctx context.Context, filesystem core.FS, filePaths ...string) errors.PlatformError {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return wrapLoadError(ctx.Err(), "context cancelled before trimming")
+	}
+
+	if len(filePaths) == 0 {
+		return errors.New(errors.CodeInvalidInput, "at least one file is required to trim")
+	}
+
+	files := make(map[string][]byte, len(filePaths))
+	paths := make(map[string]string, len(filePaths))
+	for _, path := range filePaths {
+		data, err := filesystem.ReadFile(path)
+		if err != nil {
+			return wrapLoadErrorWithContext(err, "failed to read CUE file", makeContext("file_path", path))
+		}
+		files[path] = data
+		paths[makeAbsolutePath(path)] = path
+	}
+
+	config := &load.Config{
+		Dir:     makeAbsolutePath("/"),
+		Overlay: buildOverlay(files),
+	}
+
+	insts := load.Instances(filePaths, config)
+	if len(insts) != 1 {
+		return wrapBuildErrorWithContext(
+			fmt.Errorf("loaded %d instances", len(insts)),
+			"files must belong to a single package",
+			makeContext("file_paths", filePaths),
+		)
+	}
+	if err := insts[0].Err; err != nil {
+		return wrapBuildErrorWithContext(err, "failed to load CUE files", makeContext("file_paths", filePaths))
+	}
+
+	value := cuecontext.New().BuildInstance(insts[0])
+	if err := value.Err(); err != nil {
+		return wrapBuildErrorWithContext(err, "failed to build CUE files", makeContext("file_paths", filePaths))
+	}
+
+	if err := trim.Files(insts[0].Files, value, &trim.Config{}); err != nil {
+		return wrapBuildErrorWithContext(err, "failed to trim CUE files", makeContext("file_paths", filePaths))
+	}
+
+	for _, file := range insts[0].Files {
+		path, ok := paths[file.Filename]
+		if !ok {
+			continue
+		}
+
+		data, err := format.Node(file, format.Simplify())
+		if err != nil {
+			return wrapEncodeErrorWithContext(err, "failed to format trimmed file", makeContext("file_path", path))
+		}
+		if bytes.Equal(data, files[path]) {
+			continue
+		}
+
+		info, err := filesystem.Stat(path)
+		if err != nil {
+			return wrapLoadErrorWithContext(err, "failed to stat CUE file", makeContext("file_path", path))
+		}
+		if err := filesystem.WriteFile(path, data, info.Mode().Perm()); err != nil {
+			return wrapEncodeErrorWithContext(err, "failed to write trimmed file", makeContext("file_path", path))
+		}
+	}
+
+	return nil
+}
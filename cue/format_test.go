@@ -0,0 +1,181 @@
+package cue
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cuelang.org/go/cue/cuecontext"
+	platformerrors "github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/fs/billy"
+)
+
+func TestFormat(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("formats schema as canonical source", func(t *testing.T) {
+		value := cuecontext.New().CompileString(`
+// Config is the service configuration.
+#Config: {name: string, port?: int & >0 @go(Port)}
+config: #Config & {name:   "api"}
+`)
+
+		source, err := Format(ctx, value)
+		if err != nil {
+			t.Fatalf("Format failed: %v", err)
+		}
+
+		output := string(source)
+		for _, want := range []string{
+			"// Config is the service configuration.",
+			"#Config: {",
+			"port?:",
+			"@go(Port)",
+			`name: "api"`,
+		} {
+			if !strings.Contains(output, want) {
+				t.Errorf("expected output to contain %q, got:\n%s", want, output)
+			}
+		}
+		if strings.HasPrefix(strings.TrimSpace(output), "{") {
+			t.Errorf("expected top-level struct without braces, got:\n%s", output)
+		}
+
+		// Formatting is idempotent
+		reformatted, err := Format(ctx, cuecontext.New().CompileBytes(source))
+		if err != nil {
+			t.Fatalf("Format failed on formatted output: %v", err)
+		}
+		if string(reformatted) != output {
+			t.Errorf("expected formatting to be idempotent, got:\n%s\nthen:\n%s", output, reformatted)
+		}
+	})
+
+	t.Run("rejects value with errors", func(t *testing.T) {
+		value := cuecontext.New().CompileString(`a: 1 & 2`)
+
+		_, err := Format(ctx, value)
+		if err == nil {
+			t.Fatal("expected error for value with errors")
+		}
+		if err.Code() != platformerrors.CodeCUEEncodeFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEEncodeFailed, err.Code())
+		}
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := Format(cancelCtx, cuecontext.New().CompileString(`a: 1`))
+		if err == nil {
+			t.Fatal("expected error for cancelled context")
+		}
+	})
+}
+
+func TestTrimSource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes fields implied by constraints", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("config/base.cue", []byte(`package config
+
+services: [string]: {replicas: 1, port: 8080}
+`), 0644); err != nil {
+			t.Fatalf("failed to create base file: %v", err)
+		}
+		if err := mfs.WriteFile("config/services.cue", []byte(`package config
+
+services: api: {replicas: 1, port: 8080}
+services: web: {replicas: 1, port: 8080, name: "web"}
+`), 0644); err != nil {
+			t.Fatalf("failed to create services file: %v", err)
+		}
+
+		if err := TrimSource(ctx, mfs, "config/base.cue", "config/services.cue"); err != nil {
+			t.Fatalf("TrimSource failed: %v", err)
+		}
+
+		data, err := mfs.ReadFile("config/services.cue")
+		if err != nil {
+			t.Fatalf("failed to read trimmed file: %v", err)
+		}
+		output := string(data)
+		if strings.Contains(output, "replicas") || strings.Contains(output, "8080") {
+			t.Errorf("expected implied fields to be removed, got:\n%s", output)
+		}
+		if !strings.Contains(output, `name: "web"`) {
+			t.Errorf("expected explicit field to be kept, got:\n%s", output)
+		}
+
+		// The trimmed package still evaluates to the same configuration
+		loader := NewLoader(mfs)
+		value, err := loader.LoadPackage(ctx, "config")
+		if err != nil {
+			t.Fatalf("LoadPackage failed: %v", err)
+		}
+		out, err := EncodeJSON(ctx, value)
+		if err != nil {
+			t.Fatalf("EncodeJSON failed: %v", err)
+		}
+		if !strings.Contains(string(out), `"replicas":1`) {
+			t.Errorf("expected trimmed package to keep replicas, got %s", out)
+		}
+	})
+
+	t.Run("leaves minimal files unchanged", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		source := []byte("package config\n\nname: \"api\"\n")
+		if err := mfs.WriteFile("config.cue", source, 0644); err != nil {
+			t.Fatalf("failed to create file: %v", err)
+		}
+
+		if err := TrimSource(ctx, mfs, "config.cue"); err != nil {
+			t.Fatalf("TrimSource failed: %v", err)
+		}
+
+		data, err := mfs.ReadFile("config.cue")
+		if err != nil {
+			t.Fatalf("failed to read file: %v", err)
+		}
+		if string(data) != string(source) {
+			t.Errorf("expected file to be unchanged, got:\n%s", data)
+		}
+	})
+
+	t.Run("returns error for missing file", func(t *testing.T) {
+		err := TrimSource(ctx, billy.NewMemory(), "missing.cue")
+		if err == nil {
+			t.Fatal("expected error for missing file")
+		}
+		if err.Code() != platformerrors.CodeCUELoadFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUELoadFailed, err.Code())
+		}
+	})
+
+	t.Run("returns error for conflicting files", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("config.cue", []byte("package config\n\na: 1\na: 2\n"), 0644); err != nil {
+			t.Fatalf("failed to create file: %v", err)
+		}
+
+		err := TrimSource(ctx, mfs, "config.cue")
+		if err == nil {
+			t.Fatal("expected error for conflicting file")
+		}
+		if err.Code() != platformerrors.CodeCUEBuildFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEBuildFailed, err.Code())
+		}
+	})
+
+	t.Run("rejects empty input", func(t *testing.T) {
+		err := TrimSource(ctx, billy.NewMemory())
+		if err == nil {
+			t.Fatal("expected error for empty input")
+		}
+		if err.Code() != platformerrors.CodeInvalidInput {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, err.Code())
+		}
+	})
+}
@@ -0,0 +1,170 @@
+// Package guard bounds CUE evaluation by the number of values visited, their
+// nesting depth, and wall-clock time. It is shared by the cue package and its
+// attributes sub-package so limits set on a context apply to both.
+package guard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cuelang.org/go/cue"
+	"github.com/jmgilman/go/errors"
+)
+
+// Limits bounds a single guarded operation. A zero field disables that limit.
+type Limits struct {
+	// MaxSteps is the maximum number of values visited.
+	MaxSteps int
+
+	// MaxDepth is the maximum nesting depth of visited values.
+	MaxDepth int
+
+	// Timeout is the wall-clock budget for the operation.
+	Timeout time.Duration
+}
+
+// Recommended is generous enough for large configurations while stopping
+// runaway evaluation. It is only applied when set on a context.
+var Recommended = Limits{
+	MaxSteps: 10_000_000,
+	MaxDepth: 1_000,
+	Timeout:  time.Minute,
+}
+
+type limitsKey struct{}
+
+// WithLimits returns a copy of ctx carrying limits.
+func WithLimits(ctx context.Context, limits Limits) context.Context {
+	return context.WithValue(ctx, limitsKey{}, limits)
+}
+
+// FromContext returns the limits carried by ctx, or zero limits if there are none.
+func FromContext(ctx context.Context) Limits {
+	limits, _ := ctx.Value(limitsKey{}).(Limits)
+	return limits
+}
+
+// Guard tracks the work done by a single operation. It is not safe for concurrent use.
+type Guard struct {
+	ctx       context.Context
+	operation string
+	limits    Limits
+	steps     int
+}
+
+// Run calls fn with a guard for operation, using the limits carried by ctx.
+//
+// CUE evaluation cannot be interrupted, so the time limit and ctx are checked
+// each time fn calls Enter. Run always waits for fn to return, so no
+// evaluation is left running on the CUE context after Run returns, but a
+// single long evaluation step can overrun the time limit.
+//
+// Limit violations and deadlines are returned as CodeTimeout platform errors.
+// If ctx is cancelled, ctx.Err() is returned unchanged.
+func Run(ctx context.Context, operation string, fn func(g *Guard) error) error {
+	limits := FromContext(ctx)
+	if limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, limits.Timeout)
+		defer cancel()
+	}
+
+	return fn(&Guard{ctx: ctx, operation: operation, limits: limits})
+}
+
+// Enter records a visit to a value at the given nesting depth, where the root
+// is at depth zero. It returns an error if a limit is exceeded or the
+// operation was interrupted.
+func (g *Guard) Enter(depth int) error {
+	if g.ctx.Err() != nil {
+		return g.interrupted()
+	}
+
+	g.steps++
+	if g.limits.MaxSteps > 0 && g.steps > g.limits.MaxSteps {
+		return g.exceeded("max_steps", g.limits.MaxSteps)
+	}
+	if g.limits.MaxDepth > 0 && depth > g.limits.MaxDepth {
+		return g.exceeded("max_depth", g.limits.MaxDepth)
+	}
+
+	return nil
+}
+
+// Walk evaluates value and its regular fields and list elements, calling
+// Enter for each. Optional fields and definitions are skipped because they
+// may legitimately be recursive. Values that cannot be iterated are left for
+// the caller to report.
+//
+// Without limits the traversal is skipped and only ctx is checked.
+func (g *Guard) Walk(value cue.Value) error {
+	if g.limits == (Limits{}) {
+		if g.ctx.Err() != nil {
+			return g.interrupted()
+		}
+		return nil
+	}
+	return g.walk(value, 0)
+}
+
+func (g *Guard) walk(value cue.Value, depth int) error {
+	if err := g.Enter(depth); err != nil {
+		return err
+	}
+
+	switch value.IncompleteKind() {
+	case cue.StructKind:
+		iter, err := value.Fields()
+		if err != nil {
+			return nil
+		}
+		for iter.Next() {
+			if err := g.walk(iter.Value(), depth+1); err != nil {
+				return err
+			}
+		}
+	case cue.ListKind:
+		iter, err := value.List()
+		if err != nil {
+			return nil
+		}
+		for iter.Next() {
+			if err := g.walk(iter.Value(), depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// exceeded returns the error for a violated step or depth limit.
+func (g *Guard) exceeded(limit string, value int) errors.PlatformError {
+	return errors.WithContextMap(
+		errors.New(errors.CodeTimeout, fmt.Sprintf("%s exceeded %s limit of %d", g.operation, limit, value)),
+		map[string]interface{}{
+			"operation": g.operation,
+			"limit":     limit,
+			limit:       value,
+		},
+	)
+}
+
+// interrupted returns the error for a spent time budget or cancelled context.
+func (g *Guard) interrupted() error {
+	err := g.ctx.Err()
+	if err != context.DeadlineExceeded {
+		return err
+	}
+
+	return errors.WrapWithContext(
+		err,
+		errors.CodeTimeout,
+		fmt.Sprintf("%s exceeded its time limit", g.operation),
+		map[string]interface{}{
+			"operation": g.operation,
+			"timeout":   g.limits.Timeout.String(),
+		},
+	)
+}
@@ -0,0 +1,103 @@
+package guard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/jmgilman/go/errors"
+)
+
+func TestFromContext(t *testing.T) {
+	if got := FromContext(context.Background()); got != (Limits{}) {
+		t.Errorf("expected no limits, got %+v", got)
+	}
+
+	limits := Limits{MaxDepth: 5}
+	if got := FromContext(WithLimits(context.Background(), limits)); got != limits {
+		t.Errorf("expected %+v, got %+v", limits, got)
+	}
+}
+
+func TestRun(t *testing.T) {
+	value := cuecontext.New().CompileString(`a: b: c: d: 1, list: [1, 2, 3]`)
+
+	tests := []struct {
+		name      string
+		limits    Limits
+		wantLimit string
+	}{
+		{name: "within limits", limits: Limits{MaxSteps: 100, MaxDepth: 10}},
+		{name: "unlimited", limits: Limits{}},
+		{name: "exceeds max depth", limits: Limits{MaxDepth: 2}, wantLimit: "max_depth"},
+		{name: "exceeds max steps", limits: Limits{MaxSteps: 3}, wantLimit: "max_steps"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := WithLimits(context.Background(), tt.limits)
+			err := Run(ctx, "test", func(g *Guard) error {
+				return g.Walk(value)
+			})
+
+			if tt.wantLimit == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected limit error")
+			}
+			if errors.GetCode(err) != errors.CodeTimeout {
+				t.Errorf("expected code %s, got %s", errors.CodeTimeout, errors.GetCode(err))
+			}
+			var perr errors.PlatformError
+			if !errors.As(err, &perr) || perr.Context()["limit"] != tt.wantLimit {
+				t.Errorf("expected limit %q in context, got %v", tt.wantLimit, err)
+			}
+		})
+	}
+
+	t.Run("exceeds timeout", func(t *testing.T) {
+		ctx := WithLimits(context.Background(), Limits{Timeout: 10 * time.Millisecond})
+
+		finished := false
+		err := Run(ctx, "test", func(g *Guard) error {
+			time.Sleep(20 * time.Millisecond)
+			err := g.Enter(0)
+			finished = true
+			return err
+		})
+		if errors.GetCode(err) != errors.CodeTimeout {
+			t.Fatalf("expected code %s, got %v", errors.CodeTimeout, err)
+		}
+		if !finished {
+			t.Error("expected Run to wait for the evaluation to return")
+		}
+	})
+
+	t.Run("returns cancellation unchanged", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(WithLimits(context.Background(), Limits{}))
+		cancel()
+
+		err := Run(ctx, "test", func(g *Guard) error {
+			return g.Enter(0)
+		})
+		if err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("returns function error", func(t *testing.T) {
+		want := errors.New(errors.CodeInternal, "failed")
+		err := Run(context.Background(), "test", func(g *Guard) error {
+			return want
+		})
+		if err != want {
+			t.Errorf("expected function error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"context"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"github.com/jmgilman/go/cue/internal/guard"
+	"github.com/jmgilman/go/errors"
+)
+
+// EvalLimits bounds the evaluation performed by a single Validate, Decode, or
+// attribute Walk call, so deeply recursive values and comprehension bombs fail
+// instead of hanging the process. A zero field disables that limit.
+//
+//   - MaxSteps is the maximum number of values visited.
+//   - MaxDepth is the maximum nesting depth of visited values.
+//   - Timeout is the wall-clock budget for the call.
+//
+// Limits are opt-in: a context without limits is evaluated unbounded.
+// Exceeding a limit, or the context's own deadline, returns a CodeTimeout error.
+// CUE evaluation cannot be interrupted, so the timeout is checked between
+// values and a single long evaluation step can overrun it. Calls always
+// return after their evaluation has stopped, so the CUE context stays usable.
+type EvalLimits = guard.Limits
+
+// RecommendedEvalLimits returns limits suited to untrusted input:
+// 10,000,000 steps, a depth of 1,000, and a one minute timeout.
+//
+// Example:
+//
+//	ctx = cue.WithEvalLimits(ctx, cue.RecommendedEvalLimits())
+func RecommendedEvalLimits() EvalLimits {
+	return guard.Recommended
+}
+
+// WithEvalLimits returns a copy of ctx carrying limits for the evaluation
+// functions of this package and the attributes sub-package.
+//
+// Example:
+//
+//	ctx = cue.WithEvalLimits(ctx, cue.EvalLimits{MaxDepth: 100, Timeout: 5 * time.Second})
+//	err := cue.Validate(ctx, schema, data)
+func WithEvalLimits(ctx context.Context, limits EvalLimits) context.Context {
+	return guard.WithLimits(ctx, limits)
+}
+
+// guarded evaluates value and then calls fn under the limits carried by ctx.
+// Limit violations are returned as CodeTimeout errors; cancellation is wrapped with wrap.
+func guarded(
+	ctx context.Context,
+	operation string,
+	value cue.Value,
+	wrap func(error, string) errors.PlatformError,
+	fn func(),
+) errors.PlatformError {
+	err := guard.Run(ctx, operation, func(g *guard.Guard) error {
+		if err := g.Walk(value); err != nil {
+			return err
+		}
+		fn()
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	var perr errors.PlatformError
+	if errors.As(err, &perr) {
+		return perr
+	}
+	return wrap(err, fmt.Sprintf("context cancelled during %s", operation))
+}
@@ -0,0 +1,108 @@
+package cue
+
+import (
+	"context"
+	"testing"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	platformerrors "github.com/jmgilman/go/errors"
+)
+
+func TestEvalLimits(t *testing.T) {
+	source := `
+config: {
+	name: "api"
+	spec: containers: [{image: "api:1.0", ports: [{port: 8080}]}]
+}
+`
+
+	t.Run("recommended limits allow ordinary values", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		schema := cueCtx.CompileString(`config: name: string`)
+		data := cueCtx.CompileString(source)
+
+		ctx := WithEvalLimits(context.Background(), RecommendedEvalLimits())
+		if err := Validate(ctx, schema, data); err != nil {
+			t.Errorf("expected validation to pass with recommended limits: %v", err)
+		}
+	})
+
+	t.Run("no limits by default", func(t *testing.T) {
+		value := cuecontext.New().CompileString(source).LookupPath(cue.ParsePath("config"))
+
+		var target struct {
+			Name string `json:"name"`
+		}
+		if err := Decode(context.Background(), value, &target); err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		if target.Name != "api" {
+			t.Errorf("expected name='api', got %q", target.Name)
+		}
+	})
+
+	t.Run("validate exceeds max depth", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		schema := cueCtx.CompileString(`config: name: string`)
+		data := cueCtx.CompileString(source)
+
+		ctx := WithEvalLimits(context.Background(), EvalLimits{MaxDepth: 3})
+		err := Validate(ctx, schema, data)
+		if err == nil {
+			t.Fatal("expected depth limit error")
+		}
+		if code := platformerrors.GetCode(err); code != platformerrors.CodeTimeout {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeTimeout, code)
+		}
+	})
+
+	t.Run("validate constraint exceeds max steps", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		value := cueCtx.CompileString(`items: [for i in [0, 1, 2, 3, 4, 5, 6, 7, 8, 9] for j in [0, 1, 2, 3, 4, 5, 6, 7, 8, 9] {i * j}]`)
+		constraint := cueCtx.CompileString(`items: [...int]`)
+
+		ctx := WithEvalLimits(context.Background(), EvalLimits{MaxSteps: 50})
+		err := ValidateConstraint(ctx, value, constraint)
+		if err == nil {
+			t.Fatal("expected step limit error")
+		}
+		if code := platformerrors.GetCode(err); code != platformerrors.CodeTimeout {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeTimeout, code)
+		}
+	})
+
+	t.Run("decode exceeds max steps", func(t *testing.T) {
+		value := cuecontext.New().CompileString(source).LookupPath(cue.ParsePath("config"))
+
+		var target struct {
+			Name string `json:"name"`
+		}
+		ctx := WithEvalLimits(context.Background(), EvalLimits{MaxSteps: 2})
+		err := Decode(ctx, value, &target)
+		if err == nil {
+			t.Fatal("expected step limit error")
+		}
+		if err.Code() != platformerrors.CodeTimeout {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeTimeout, err.Code())
+		}
+		if err.Context()["limit"] != "max_steps" {
+			t.Errorf("expected max_steps limit in context, got %v", err.Context())
+		}
+	})
+
+	t.Run("zero limits disable guard", func(t *testing.T) {
+		value := cuecontext.New().CompileString(source).LookupPath(cue.ParsePath("config"))
+
+		var target struct {
+			Name string `json:"name"`
+		}
+		ctx := WithEvalLimits(context.Background(), EvalLimits{})
+		if err := Decode(ctx, value, &target); err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		if target.Name != "api" {
+			t.Errorf("expected name='api', got %q", target.Name)
+		}
+	})
+}
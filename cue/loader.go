@@ -2,14 +2,18 @@ package cue
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/load"
+	cuejson "cuelang.org/go/encoding/json"
+	cueyamlpkg "cuelang.org/go/pkg/encoding/yaml"
 	"github.com/jmgilman/go/fs/core"
 )
 
@@ -17,8 +21,10 @@ import (
 // It maintains a CUE context for compilation and provides methods to load
 // CUE files, packages, and modules using proper CUE semantics.
 type Loader struct {
-	fs     core.ReadFS
-	cueCtx *cue.Context
+	fs       core.ReadFS
+	cueCtx   *cue.Context
+	cache    *LoaderCache
+	registry *ModuleRegistry
 }
 
 // NewLoader creates a new CUE loader with the given filesystem.
@@ -30,6 +36,22 @@ func NewLoader(filesystem core.ReadFS) *Loader {
 	}
 }
 
+// WithCache enables memoization of LoadPackage and LoadModule results using
+// the given cache. Passing nil disables caching. Returns the loader to allow
+// chaining with NewLoader.
+func (l *Loader) WithCache(cache *LoaderCache) *Loader {
+	l.cache = cache
+	return l
+}
+
+// WithRegistry enables resolving module dependencies from an OCI registry in
+// LoadModule. Passing nil requires all dependencies to be present on the
+// filesystem. Returns the loader to allow chaining with NewLoader.
+func (l *Loader) WithRegistry(registry *ModuleRegistry) *Loader {
+	l.registry = registry
+	return l
+}
+
 // Context returns the underlying CUE context.
 // This can be used for advanced CUE operations that need direct access to the context.
 func (l *Loader) Context() *cue.Context {
@@ -168,8 +190,8 @@ func (l *Loader) LoadPackage(ctx context.Context, packagePath string) (cue.Value
 		)
 	}
 
-	// Create overlay with all files in the package
-	overlay, err := l.buildOverlayForFiles(filePaths)
+	// Read all files in the package
+	files, err := l.readFiles(filePaths)
 	if err != nil {
 		return cue.Value{}, wrapLoadErrorWithContext(
 			err,
@@ -178,6 +200,19 @@ func (l *Loader) LoadPackage(ctx context.Context, packagePath string) (cue.Value
 		)
 	}
 
+	// Return the cached value if none of the files changed since it was built
+	cacheKey := "package:" + packagePath
+	var digest [sha256.Size]byte
+	if l.cache != nil {
+		digest = digestFiles(files)
+		if val, ok := l.cache.get(l.cueCtx, cacheKey, digest); ok {
+			return val, nil
+		}
+	}
+
+	// Create overlay with all files in the package
+	overlay := buildOverlay(files)
+
 	// Load using load.Instances
 	config := &load.Config{
 		Dir:     makeAbsolutePath(packagePath),
@@ -220,12 +255,18 @@ func (l *Loader) LoadPackage(ctx context.Context, packagePath string) (cue.Value
 		)
 	}
 
+	if l.cache != nil {
+		l.cache.put(l.cueCtx, cacheKey, digest, val)
+	}
+
 	return val, nil
 }
 
 // LoadModule loads a CUE module with proper package structure.
 // This recursively discovers all .cue files in the module and respects package organization.
 // The modulePath is relative to the filesystem root and should point to the module root.
+// Dependencies declared in cue.mod/module.cue are resolved through the registry
+// configured with WithRegistry.
 //
 // Returns CodeCUELoadFailed on file I/O errors.
 // Returns CodeCUEBuildFailed on CUE compilation errors.
@@ -253,8 +294,8 @@ func (l *Loader) LoadModule(ctx context.Context, modulePath string) (cue.Value,
 		)
 	}
 
-	// Create overlay with all files in the module
-	overlay, err := l.buildOverlayForFiles(filePaths)
+	// Read all files in the module
+	files, err := l.readFiles(filePaths)
 	if err != nil {
 		return cue.Value{}, wrapLoadErrorWithContext(
 			err,
@@ -263,6 +304,19 @@ func (l *Loader) LoadModule(ctx context.Context, modulePath string) (cue.Value,
 		)
 	}
 
+	// Return the cached value if none of the files changed since it was built
+	cacheKey := "module:" + modulePath
+	var digest [sha256.Size]byte
+	if l.cache != nil {
+		digest = digestFiles(files)
+		if val, ok := l.cache.get(l.cueCtx, cacheKey, digest); ok {
+			return val, nil
+		}
+	}
+
+	// Create overlay with all files in the module
+	overlay := buildOverlay(files)
+
 	// Try to read module import path from cue.mod/module.cue
 	// This is needed for proper import resolution when using overlays
 	moduleImportPath := ""
@@ -297,6 +351,12 @@ func (l *Loader) LoadModule(ctx context.Context, modulePath string) (cue.Value,
 		Overlay:    overlay,
 		Module:     moduleImportPath, // Explicitly set for import resolution with overlays
 	}
+	if l.registry != nil {
+		config.Registry = l.registry
+		if err := l.addRegistryOverlay(ctx, modulePath, overlay); err != nil {
+			return cue.Value{}, err
+		}
+	}
 
 	insts := load.Instances([]string{"."}, config)
 	if len(insts) == 0 {
@@ -334,9 +394,168 @@ func (l *Loader) LoadModule(ctx context.Context, modulePath string) (cue.Value,
 		)
 	}
 
+	if l.cache != nil {
+		l.cache.put(l.cueCtx, cacheKey, digest, val)
+	}
+
 	return val, nil
 }
 
+// LoadInstances discovers and loads every package under dir, like `cue eval ./...`.
+// The dir is relative to the filesystem root. If dir contains cue.mod/module.cue,
+// it is used as the module root so packages can import each other, and
+// dependencies are resolved through the registry configured with WithRegistry.
+//
+// The result maps each package directory, relative to the filesystem root,
+// to its value. The keys can be passed to LoadPackage to reload a single package.
+// Files in cue.mod are not loaded as packages.
+//
+// Returns CodeCUELoadFailed on file I/O errors or if dir contains no .cue files.
+// Returns CodeCUEBuildFailed if any package fails to compile.
+//
+// Example:
+//
+//	packages, err := loader.LoadInstances(ctx, "schemas")
+//	for path, value := range packages {
+//	    if err := cue.Validate(ctx, value, config); err != nil {
+//	        log.Printf("%s: %v", path, err)
+//	    }
+//	}
+func (l *Loader) LoadInstances(ctx context.Context, dir string) (map[string]cue.Value, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return nil, wrapLoadErrorWithContext(err, "context cancelled", makeContext("dir", dir))
+	}
+
+	// Recursively discover all CUE files under the directory
+	filePaths, err := discoverCueFiles(l.fs, dir)
+	if err != nil {
+		return nil, wrapLoadErrorWithContext(
+			err,
+			"failed to discover CUE files",
+			makeContext("dir", dir),
+		)
+	}
+
+	// Every directory holding a .cue file outside cue.mod is a package
+	seen := make(map[string]bool)
+	var packageDirs []string
+	for _, path := range filePaths {
+		rel, err := filepath.Rel(dir, filepath.Dir(path))
+		if err != nil {
+			continue
+		}
+		if first := strings.Split(filepath.ToSlash(rel), "/")[0]; first == "cue.mod" {
+			continue
+		}
+		if !seen[rel] {
+			seen[rel] = true
+			packageDirs = append(packageDirs, rel)
+		}
+	}
+	sort.Strings(packageDirs)
+
+	if len(packageDirs) == 0 {
+		return nil, wrapLoadErrorWithContext(
+			fmt.Errorf("no CUE files found"),
+			"directory contains no CUE packages",
+			makeContext("dir", dir),
+		)
+	}
+
+	// Read all files, including the module file, into the overlay
+	files, err := l.readFiles(filePaths)
+	if err != nil {
+		return nil, wrapLoadErrorWithContext(
+			err,
+			"failed to read package files",
+			makeContext("dir", dir),
+		)
+	}
+	overlay := buildOverlay(files)
+
+	// Read the module import path so packages can import each other
+	moduleImportPath := ""
+	if modPath, err := readModuleImportPath(l.fs, dir); err == nil {
+		moduleImportPath = modPath
+	}
+
+	// Normalize the directory path
+	root := dir
+	if dir == "." {
+		root = "/"
+	}
+
+	config := &load.Config{
+		Dir:        makeAbsolutePath(root),
+		ModuleRoot: makeAbsolutePath(root),
+		Overlay:    overlay,
+		Module:     moduleImportPath,
+	}
+	if l.registry != nil {
+		config.Registry = l.registry
+		if err := l.addRegistryOverlay(ctx, dir, overlay); err != nil {
+			return nil, err
+		}
+	}
+
+	args := make([]string, len(packageDirs))
+	for i, rel := range packageDirs {
+		args[i] = "."
+		if rel != "." {
+			args[i] = "./" + filepath.ToSlash(rel)
+		}
+	}
+
+	insts := load.Instances(args, config)
+	if len(insts) != len(packageDirs) {
+		return nil, wrapLoadErrorWithContext(
+			fmt.Errorf("loaded %d instances for %d packages", len(insts), len(packageDirs)),
+			"failed to load packages",
+			makeContext("dir", dir),
+		)
+	}
+
+	values := make(map[string]cue.Value, len(insts))
+	for i, inst := range insts {
+		// Check context cancellation between packages
+		if err := ctx.Err(); err != nil {
+			return nil, wrapLoadErrorWithContext(err, "context cancelled", makeContext("dir", dir))
+		}
+
+		packagePath := filepath.Join(dir, packageDirs[i])
+
+		if err := inst.Err; err != nil {
+			return nil, wrapBuildErrorWithContext(
+				err,
+				"failed to load package",
+				makeContext("package_path", packagePath),
+			)
+		}
+
+		val := l.cueCtx.BuildInstance(inst)
+		if err := val.Err(); err != nil {
+			return nil, wrapBuildErrorWithContext(
+				err,
+				"failed to build package",
+				makeContext("package_path", packagePath),
+			)
+		}
+
+		if err := val.Validate(); err != nil {
+			return nil, wrapBuildErrorWithContext(
+				err,
+				"CUE validation failed",
+				makeContext("package_path", packagePath),
+			)
+		}
+
+		values[packagePath] = val
+	}
+
+	return values, nil
+}
+
 // LoadBytes loads CUE source from byte slices with optional filename for error reporting.
 // The filename parameter is used only for error messages and can be empty or synthetic.
 // This method is useful for testing or loading dynamically generated CUE content.
@@ -374,25 +593,191 @@ func (l *Loader) LoadBytes(ctx context.Context, source []byte, filename string)
 	return val, nil
 }
 
+// LoadYAML loads a YAML file from the filesystem as CUE values, one per
+// document. Multi-document streams separated by "---" yield multiple values.
+// The filePath is relative to the filesystem root.
+//
+// Returns CodeCUELoadFailed on file I/O errors.
+// Returns CodeCUEBuildFailed if the file is not valid YAML.
+func (l *Loader) LoadYAML(ctx context.Context, filePath string) ([]cue.Value, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return nil, wrapLoadErrorWithContext(err, "context cancelled", makeContext("file_path", filePath))
+	}
+
+	data, err := l.fs.ReadFile(filePath)
+	if err != nil {
+		return nil, wrapLoadErrorWithContext(
+			err,
+			"failed to read YAML file",
+			makeContext("file_path", filePath),
+		)
+	}
+
+	return l.LoadYAMLBytes(ctx, data, filePath)
+}
+
+// LoadYAMLBytes converts YAML data to CUE values, one per document.
+// The filename parameter is used only for error messages and can be empty or synthetic.
+// An empty stream yields no values.
+//
+// Returns CodeCUEBuildFailed if the data is not valid YAML.
+func (l *Loader) LoadYAMLBytes(ctx context.Context, data []byte, filename string) ([]cue.Value, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return nil, wrapBuildErrorWithContext(err, "context cancelled", makeContext("filename", filename))
+	}
+
+	if filename == "" {
+		filename = "<input>"
+	}
+
+	// The YAML decoder reports an empty stream as a single null document
+	if strings.TrimSpace(string(data)) == "" {
+		return nil, nil
+	}
+
+	// Parse every document in the stream into a single CUE list
+	expr, err := cueyamlpkg.UnmarshalStream(data)
+	if err != nil {
+		return nil, wrapBuildErrorWithContext(
+			err,
+			"failed to parse YAML",
+			makeContext("filename", filename, "source_size", len(data)),
+		)
+	}
+
+	stream := l.cueCtx.BuildExpr(expr)
+	if err := stream.Err(); err != nil {
+		return nil, wrapBuildErrorWithContext(
+			err,
+			"failed to build CUE value from YAML",
+			makeContext("filename", filename),
+		)
+	}
+
+	iter, err := stream.List()
+	if err != nil {
+		return nil, wrapBuildErrorWithContext(
+			err,
+			"failed to iterate YAML documents",
+			makeContext("filename", filename),
+		)
+	}
+
+	var docs []cue.Value
+	for iter.Next() {
+		docs = append(docs, iter.Value())
+	}
+
+	return docs, nil
+}
+
+// LoadJSON loads a JSON file from the filesystem as a CUE value.
+// The filePath is relative to the filesystem root.
+//
+// Returns CodeCUELoadFailed on file I/O errors.
+// Returns CodeCUEBuildFailed if the file is not valid JSON.
+func (l *Loader) LoadJSON(ctx context.Context, filePath string) (cue.Value, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return cue.Value{}, wrapLoadErrorWithContext(err, "context cancelled", makeContext("file_path", filePath))
+	}
+
+	data, err := l.fs.ReadFile(filePath)
+	if err != nil {
+		return cue.Value{}, wrapLoadErrorWithContext(
+			err,
+			"failed to read JSON file",
+			makeContext("file_path", filePath),
+		)
+	}
+
+	return l.LoadJSONBytes(ctx, data, filePath)
+}
+
+// LoadJSONBytes converts JSON data to a CUE value.
+// The filename parameter is used for error messages and positions and can be empty or synthetic.
+//
+// Returns CodeCUEBuildFailed if the data is not valid JSON.
+func (l *Loader) LoadJSONBytes(ctx context.Context, data []byte, filename string) (cue.Value, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return cue.Value{}, wrapBuildErrorWithContext(err, "context cancelled", makeContext("filename", filename))
+	}
+
+	if filename == "" {
+		filename = "<input>"
+	}
+
+	expr, err := cuejson.Extract(filename, data)
+	if err != nil {
+		return cue.Value{}, wrapBuildErrorWithContext(
+			err,
+			"failed to parse JSON",
+			makeContext("filename", filename, "source_size", len(data)),
+		)
+	}
+
+	val := l.cueCtx.BuildExpr(expr)
+	if err := val.Err(); err != nil {
+		return cue.Value{}, wrapBuildErrorWithContext(
+			err,
+			"failed to build CUE value from JSON",
+			makeContext("filename", filename),
+		)
+	}
+
+	return val, nil
+}
+
+// addRegistryOverlay adds the registry dependencies of the module at modulePath to overlay.
+// Modules without a module file have no dependencies.
+func (l *Loader) addRegistryOverlay(ctx context.Context, modulePath string, overlay map[string]load.Source) error {
+	data, err := l.fs.ReadFile(filepath.Join(modulePath, "cue.mod", "module.cue"))
+	if err != nil {
+		return nil
+	}
+	return l.registry.addOverlay(ctx, data, overlay)
+}
+
 // buildOverlayForFiles creates a load.Config overlay from a list of file paths.
 // The overlay maps absolute paths to load.Source for use with load.Instances.
 func (l *Loader) buildOverlayForFiles(filePaths []string) (map[string]load.Source, error) {
-	overlay := make(map[string]load.Source)
+	files, err := l.readFiles(filePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildOverlay(files), nil
+}
+
+// readFiles reads the given files from the filesystem, keyed by path.
+func (l *Loader) readFiles(filePaths []string) (map[string][]byte, error) {
+	files := make(map[string][]byte, len(filePaths))
 
 	for _, path := range filePaths {
 		data, err := l.fs.ReadFile(path)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read file %s: %w", path, err)
 		}
+		files[path] = data
+	}
+
+	return files, nil
+}
+
+// buildOverlay creates a load.Config overlay from file contents keyed by path.
+func buildOverlay(files map[string][]byte) map[string]load.Source {
+	overlay := make(map[string]load.Source, len(files))
 
+	for path, data := range files {
 		// Create cross-platform absolute path for overlay key
 		// This must match the path format used in Config.Dir
-		absPath := makeAbsolutePath(path)
-
-		overlay[absPath] = load.FromBytes(data)
+		overlay[makeAbsolutePath(path)] = load.FromBytes(data)
 	}
 
-	return overlay, nil
+	return overlay
 }
 
 // discoverCueFiles recursively discovers .cue files in a directory.
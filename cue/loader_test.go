@@ -634,6 +634,313 @@ func TestLoadBytes(t *testing.T) {
 	})
 }
 
+// TestLoadYAML tests loading YAML documents as CUE values.
+func TestLoadYAML(t *testing.T) {
+	t.Run("loads single document", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("config.yaml", []byte("name: example\ncount: 10\n"), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		ctx := context.Background()
+		loader := NewLoader(mfs)
+
+		docs, err := loader.LoadYAML(ctx, "config.yaml")
+		if err != nil {
+			t.Fatalf("LoadYAML failed: %v", err)
+		}
+		if len(docs) != 1 {
+			t.Fatalf("expected 1 document, got %d", len(docs))
+		}
+
+		name, err := docs[0].LookupPath(cue.ParsePath("name")).String()
+		if err != nil {
+			t.Fatalf("failed to extract name string: %v", err)
+		}
+		if name != "example" {
+			t.Errorf("expected name='example', got name=%q", name)
+		}
+	})
+
+	t.Run("loads multi-document stream", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("manifests.yaml", []byte(`kind: Service
+name: api
+---
+kind: Deployment
+name: api
+---
+- a
+- b
+`), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		ctx := context.Background()
+		loader := NewLoader(mfs)
+
+		docs, err := loader.LoadYAML(ctx, "manifests.yaml")
+		if err != nil {
+			t.Fatalf("LoadYAML failed: %v", err)
+		}
+		if len(docs) != 3 {
+			t.Fatalf("expected 3 documents, got %d", len(docs))
+		}
+
+		for i, want := range []string{"Service", "Deployment"} {
+			kind, err := docs[i].LookupPath(cue.ParsePath("kind")).String()
+			if err != nil {
+				t.Fatalf("failed to extract kind of document %d: %v", i, err)
+			}
+			if kind != want {
+				t.Errorf("expected document %d kind=%q, got %q", i, want, kind)
+			}
+		}
+
+		if docs[2].Kind() != cue.ListKind {
+			t.Errorf("expected document 2 to be a list, got %s", docs[2].Kind())
+		}
+	})
+
+	t.Run("validates documents against schema", func(t *testing.T) {
+		ctx := context.Background()
+		loader := NewLoader(nil)
+
+		schema, err := loader.LoadBytes(ctx, []byte(`#Item: {name: string, count: int & >0}`), "schema.cue")
+		if err != nil {
+			t.Fatalf("LoadBytes failed: %v", err)
+		}
+		item := schema.LookupPath(cue.ParsePath("#Item"))
+
+		docs, err := loader.LoadYAMLBytes(ctx, []byte("name: a\ncount: 1\n---\nname: b\ncount: 0\n"), "items.yaml")
+		if err != nil {
+			t.Fatalf("LoadYAMLBytes failed: %v", err)
+		}
+		if len(docs) != 2 {
+			t.Fatalf("expected 2 documents, got %d", len(docs))
+		}
+
+		if err := Validate(ctx, item, docs[0]); err != nil {
+			t.Errorf("expected first document to be valid, got: %v", err)
+		}
+		if err := Validate(ctx, item, docs[1]); err == nil {
+			t.Error("expected second document to fail validation")
+		}
+	})
+
+	t.Run("returns no values for empty stream", func(t *testing.T) {
+		docs, err := NewLoader(nil).LoadYAMLBytes(context.Background(), []byte(""), "")
+		if err != nil {
+			t.Fatalf("LoadYAMLBytes failed: %v", err)
+		}
+		if len(docs) != 0 {
+			t.Errorf("expected no documents, got %d", len(docs))
+		}
+	})
+
+	t.Run("returns error on invalid YAML", func(t *testing.T) {
+		_, err := NewLoader(nil).LoadYAMLBytes(context.Background(), []byte("key: [unclosed\n"), "bad.yaml")
+		if err == nil {
+			t.Fatal("expected error for invalid YAML")
+		}
+
+		var perr platformerrors.PlatformError
+		if !errors.As(err, &perr) {
+			t.Fatalf("expected PlatformError, got %T", err)
+		}
+		if perr.Code() != platformerrors.CodeCUEBuildFailed {
+			t.Errorf("expected error code %s, got %s", platformerrors.CodeCUEBuildFailed, perr.Code())
+		}
+	})
+
+	t.Run("returns error on missing file", func(t *testing.T) {
+		_, err := NewLoader(billy.NewMemory()).LoadYAML(context.Background(), "missing.yaml")
+		if err == nil {
+			t.Fatal("expected error for missing file")
+		}
+
+		var perr platformerrors.PlatformError
+		if !errors.As(err, &perr) {
+			t.Fatalf("expected PlatformError, got %T", err)
+		}
+		if perr.Code() != platformerrors.CodeCUELoadFailed {
+			t.Errorf("expected error code %s, got %s", platformerrors.CodeCUELoadFailed, perr.Code())
+		}
+	})
+}
+
+// TestLoadJSON tests loading JSON documents as CUE values.
+func TestLoadJSON(t *testing.T) {
+	t.Run("loads JSON file", func(t *testing.T) {
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("config.json", []byte(`{"name": "example", "tags": ["a", "b"]}`), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		ctx := context.Background()
+		loader := NewLoader(mfs)
+
+		result, err := loader.LoadJSON(ctx, "config.json")
+		if err != nil {
+			t.Fatalf("LoadJSON failed: %v", err)
+		}
+
+		name, err := result.LookupPath(cue.ParsePath("name")).String()
+		if err != nil {
+			t.Fatalf("failed to extract name string: %v", err)
+		}
+		if name != "example" {
+			t.Errorf("expected name='example', got name=%q", name)
+		}
+
+		tags, err := result.LookupPath(cue.ParsePath("tags")).List()
+		if err != nil {
+			t.Fatalf("failed to iterate tags: %v", err)
+		}
+		count := 0
+		for tags.Next() {
+			count++
+		}
+		if count != 2 {
+			t.Errorf("expected 2 tags, got %d", count)
+		}
+	})
+
+	t.Run("returns error on invalid JSON", func(t *testing.T) {
+		_, err := NewLoader(nil).LoadJSONBytes(context.Background(), []byte(`{"name": }`), "bad.json")
+		if err == nil {
+			t.Fatal("expected error for invalid JSON")
+		}
+
+		var perr platformerrors.PlatformError
+		if !errors.As(err, &perr) {
+			t.Fatalf("expected PlatformError, got %T", err)
+		}
+		if perr.Code() != platformerrors.CodeCUEBuildFailed {
+			t.Errorf("expected error code %s, got %s", platformerrors.CodeCUEBuildFailed, perr.Code())
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := NewLoader(nil).LoadJSONBytes(ctx, []byte(`{}`), ""); err == nil {
+			t.Fatal("expected error when context is cancelled")
+		}
+	})
+}
+
+// TestLoadInstances tests loading every package under a directory.
+func TestLoadInstances(t *testing.T) {
+	writeFiles := func(t *testing.T, files map[string]string) *billy.MemoryFS {
+		t.Helper()
+		mfs := billy.NewMemory()
+		for path, content := range files {
+			if err := mfs.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatalf("failed to create %s: %v", path, err)
+			}
+		}
+		return mfs
+	}
+
+	t.Run("loads all packages in module", func(t *testing.T) {
+		mfs := writeFiles(t, map[string]string{
+			"repo/cue.mod/module.cue": `module: "example.com/repo@v0"
+language: version: "v0.14.0"
+`,
+			"repo/root.cue":                   "package root\nname: \"root\"\n",
+			"repo/schemas/service.cue":        "package schemas\n#Service: {name: string}\n",
+			"repo/services/api/api.cue":       "package api\nimport \"example.com/repo/schemas\"\nservice: schemas.#Service & {name: \"api\"}\n",
+			"repo/services/web/web.cue":       "package web\nservice: name: \"web\"\n",
+			"repo/services/web/overrides.cue": "package web\nservice: replicas: 2\n",
+		})
+
+		values, err := NewLoader(mfs).LoadInstances(context.Background(), "repo")
+		if err != nil {
+			t.Fatalf("LoadInstances failed: %v", err)
+		}
+
+		want := []string{"repo", "repo/schemas", "repo/services/api", "repo/services/web"}
+		if len(values) != len(want) {
+			t.Fatalf("expected %d packages, got %d: %v", len(want), len(values), values)
+		}
+		for _, path := range want {
+			if _, ok := values[path]; !ok {
+				t.Errorf("expected package %s to be loaded", path)
+			}
+		}
+
+		name, err := values["repo/services/api"].LookupPath(cue.ParsePath("service.name")).String()
+		if err != nil {
+			t.Fatalf("failed to lookup service.name: %v", err)
+		}
+		if name != "api" {
+			t.Errorf("expected name='api', got %q", name)
+		}
+
+		replicas, err := values["repo/services/web"].LookupPath(cue.ParsePath("service.replicas")).Int64()
+		if err != nil {
+			t.Fatalf("failed to lookup service.replicas: %v", err)
+		}
+		if replicas != 2 {
+			t.Errorf("expected files in the same package to be unified, got replicas=%d", replicas)
+		}
+	})
+
+	t.Run("loads packages without module", func(t *testing.T) {
+		mfs := writeFiles(t, map[string]string{
+			"a/a.cue": "package a\nvalue: 1\n",
+			"b/b.cue": "package b\nvalue: 2\n",
+		})
+
+		values, err := NewLoader(mfs).LoadInstances(context.Background(), ".")
+		if err != nil {
+			t.Fatalf("LoadInstances failed: %v", err)
+		}
+		if len(values) != 2 {
+			t.Fatalf("expected 2 packages, got %d: %v", len(values), values)
+		}
+	})
+
+	t.Run("reports failing package", func(t *testing.T) {
+		mfs := writeFiles(t, map[string]string{
+			"repo/good/good.cue": "package good\nvalue: 1\n",
+			"repo/bad/bad.cue":   "package bad\nvalue: 1 & 2\n",
+		})
+
+		_, err := NewLoader(mfs).LoadInstances(context.Background(), "repo")
+		if err == nil {
+			t.Fatal("expected error for failing package")
+		}
+
+		var perr platformerrors.PlatformError
+		if !errors.As(err, &perr) {
+			t.Fatalf("expected PlatformError, got %T", err)
+		}
+		if perr.Code() != platformerrors.CodeCUEBuildFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEBuildFailed, perr.Code())
+		}
+		if path := perr.Context()["package_path"]; path != "repo/bad" {
+			t.Errorf("expected package_path=repo/bad, got %v", path)
+		}
+	})
+
+	t.Run("returns error for empty directory", func(t *testing.T) {
+		mfs := writeFiles(t, map[string]string{"repo/README.md": "no packages"})
+
+		_, err := NewLoader(mfs).LoadInstances(context.Background(), "repo")
+		if err == nil {
+			t.Fatal("expected error for directory without packages")
+		}
+
+		var perr platformerrors.PlatformError
+		if !errors.As(err, &perr) || perr.Code() != platformerrors.CodeCUELoadFailed {
+			t.Errorf("expected code %s, got %v", platformerrors.CodeCUELoadFailed, err)
+		}
+	})
+}
+
 // TestHelperFunctions tests internal helper functions.
 func TestHelperFunctions(t *testing.T) {
 	t.Run("discoverCueFiles finds .cue files", func(t *testing.T) {
@@ -0,0 +1,107 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"cuelang.org/go/cue"
+	cuejson "cuelang.org/go/encoding/json"
+	"cuelang.org/go/encoding/jsonschema"
+	"cuelang.org/go/encoding/openapi"
+	"github.com/jmgilman/go/errors"
+)
+
+// ExportOpenAPI converts the definitions in a CUE value to an OpenAPI 3.0
+// document, returned as indented JSON. Each definition (e.g. #Config) becomes
+// a schema under components.schemas, and references between definitions are
+// kept as $ref entries.
+//
+// Besides definitions, the value may only contain an info field, which is used
+// as the OpenAPI info object, and a $version field. Without info, the title is
+// taken from the value's doc comment.
+//
+// Returns CodeCUEEncodeFailed if the value contains errors or cannot be expressed in OpenAPI.
+//
+// Example:
+//
+//	schema, err := loader.LoadPackage(ctx, "schemas")
+//	spec, err := cue.ExportOpenAPI(ctx, schema)
+func ExportOpenAPI(ctx context.Context, value cue.Value) ([]byte, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return nil, wrapEncodeError(ctx.Err(), "context cancelled before OpenAPI export")
+	}
+
+	if err := checkConflicts(value); err != nil {
+		return nil, wrapEncodeErrorWithContext(
+			err,
+			"CUE value contains errors and cannot be exported to OpenAPI",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	data, err := openapi.Gen(value, &openapi.Config{})
+	if err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to generate OpenAPI document: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return nil, wrapEncodeErrorf(err, "failed to format OpenAPI document: %v", err)
+	}
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+// ImportJSONSchema converts a JSON Schema document into a CUE value built with
+// cueCtx, so it can be used with Validate like any other schema. The root schema
+// becomes the value's top-level constraints and entries under $defs (or
+// definitions) become CUE definitions, e.g. #Address. The JSON Schema version
+// is taken from $schema, defaulting to draft 2020-12.
+//
+// Returns CodeInvalidInput if data is not valid JSON.
+// Returns CodeCUEBuildFailed if the schema uses unsupported keywords or cannot be built.
+//
+// Example:
+//
+//	schema, err := cue.ImportJSONSchema(ctx, loader.Context(), data)
+//	err = cue.Validate(ctx, schema, config)
+func ImportJSONSchema(ctx context.Context, cueCtx *cue.Context, data []byte) (cue.Value, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return cue.Value{}, wrapBuildError(ctx.Err(), "context cancelled before JSON Schema import")
+	}
+
+	expr, err := cuejson.Extract("schema.json", data)
+	if err != nil {
+		return cue.Value{}, errors.Wrap(err, errors.CodeInvalidInput, "JSON Schema is not valid JSON")
+	}
+
+	doc := cueCtx.BuildExpr(expr)
+	if err := doc.Err(); err != nil {
+		return cue.Value{}, errors.Wrap(err, errors.CodeInvalidInput, "JSON Schema is not valid JSON")
+	}
+
+	file, err := jsonschema.Extract(doc, &jsonschema.Config{})
+	if err != nil {
+		return cue.Value{}, wrapBuildErrorWithContext(
+			err,
+			"failed to convert JSON Schema to CUE",
+			makeContext("source_size", len(data)),
+		)
+	}
+
+	schema := cueCtx.BuildFile(file)
+	if err := schema.Err(); err != nil {
+		return cue.Value{}, wrapBuildErrorWithContext(
+			err,
+			"failed to build CUE schema from JSON Schema",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	return schema, nil
+}
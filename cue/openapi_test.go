@@ -0,0 +1,173 @@
+package cue
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	platformerrors "github.com/jmgilman/go/errors"
+)
+
+func TestExportOpenAPI(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("exports definitions as component schemas", func(t *testing.T) {
+		value := cuecontext.New().CompileString(`
+info: {title: "Platform API", version: "v1"}
+
+#Port: int & >0 & <65536
+
+#Service: {
+	name:  string
+	port?: #Port
+}
+`)
+
+		data, err := ExportOpenAPI(ctx, value)
+		if err != nil {
+			t.Fatalf("ExportOpenAPI failed: %v", err)
+		}
+
+		var doc struct {
+			OpenAPI string `json:"openapi"`
+			Info    struct {
+				Title   string `json:"title"`
+				Version string `json:"version"`
+			} `json:"info"`
+			Components struct {
+				Schemas map[string]struct {
+					Type       string                     `json:"type"`
+					Required   []string                   `json:"required"`
+					Properties map[string]json.RawMessage `json:"properties"`
+				} `json:"schemas"`
+			} `json:"components"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			t.Fatalf("failed to parse OpenAPI document: %v\n%s", err, data)
+		}
+
+		if doc.Info.Title != "Platform API" || doc.Info.Version != "v1" {
+			t.Errorf("expected info from value, got %+v", doc.Info)
+		}
+
+		service, ok := doc.Components.Schemas["Service"]
+		if !ok {
+			t.Fatalf("expected Service schema, got:\n%s", data)
+		}
+		if service.Type != "object" {
+			t.Errorf("expected Service type=object, got %q", service.Type)
+		}
+		if len(service.Required) != 1 || service.Required[0] != "name" {
+			t.Errorf("expected only name to be required, got %v", service.Required)
+		}
+		if _, ok := service.Properties["port"]; !ok {
+			t.Errorf("expected port property, got %v", service.Properties)
+		}
+		if _, ok := doc.Components.Schemas["Port"]; !ok {
+			t.Errorf("expected Port schema, got:\n%s", data)
+		}
+	})
+
+	t.Run("rejects value with errors", func(t *testing.T) {
+		value := cuecontext.New().CompileString(`#A: int & string`)
+
+		_, err := ExportOpenAPI(ctx, value)
+		if err == nil {
+			t.Fatal("expected error for value with errors")
+		}
+		if err.Code() != platformerrors.CodeCUEEncodeFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEEncodeFailed, err.Code())
+		}
+	})
+
+	t.Run("rejects unsupported top-level fields", func(t *testing.T) {
+		value := cuecontext.New().CompileString(`#A: string, config: {name: "api"}`)
+
+		_, err := ExportOpenAPI(ctx, value)
+		if err == nil {
+			t.Fatal("expected error for non-definition field")
+		}
+	})
+}
+
+func TestImportJSONSchema(t *testing.T) {
+	ctx := context.Background()
+
+	schemaJSON := []byte(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "name": {"type": "string", "minLength": 1},
+    "replicas": {"type": "integer", "minimum": 1},
+    "address": {"$ref": "#/$defs/address"}
+  },
+  "required": ["name"],
+  "$defs": {
+    "address": {
+      "type": "object",
+      "properties": {"host": {"type": "string"}},
+      "required": ["host"]
+    }
+  }
+}`)
+
+	t.Run("imports schema usable for validation", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		schema, err := ImportJSONSchema(ctx, cueCtx, schemaJSON)
+		if err != nil {
+			t.Fatalf("ImportJSONSchema failed: %v", err)
+		}
+
+		valid := cueCtx.CompileString(`name: "api", replicas: 2, address: host: "localhost"`)
+		if err := Validate(ctx, schema, valid); err != nil {
+			t.Errorf("expected valid config to pass: %v", err)
+		}
+
+		for name, source := range map[string]string{
+			"missing required field": `replicas: 2`,
+			"violates minimum":       `name: "api", replicas: 0`,
+			"wrong type":             `name: "api", replicas: "two"`,
+			"invalid nested ref":     `name: "api", address: {}`,
+		} {
+			if err := Validate(ctx, schema, cueCtx.CompileString(source)); err == nil {
+				t.Errorf("%s: expected validation error", name)
+			}
+		}
+
+		if !schema.LookupPath(cue.MakePath(cue.Def("address"))).Exists() {
+			t.Error("expected $defs entry to become #address definition")
+		}
+	})
+
+	t.Run("rejects invalid JSON", func(t *testing.T) {
+		_, err := ImportJSONSchema(ctx, cuecontext.New(), []byte(`{"type": `))
+		if err == nil {
+			t.Fatal("expected error for invalid JSON")
+		}
+		if err.Code() != platformerrors.CodeInvalidInput {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, err.Code())
+		}
+	})
+
+	t.Run("rejects invalid schema", func(t *testing.T) {
+		_, err := ImportJSONSchema(ctx, cuecontext.New(), []byte(`{"type": 42}`))
+		if err == nil {
+			t.Fatal("expected error for invalid schema")
+		}
+		if err.Code() != platformerrors.CodeCUEBuildFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEBuildFailed, err.Code())
+		}
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := ImportJSONSchema(cancelCtx, cuecontext.New(), schemaJSON)
+		if err == nil {
+			t.Fatal("expected error for cancelled context")
+		}
+	})
+}
@@ -0,0 +1,137 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"context"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"github.com/jmgilman/go/errors"
+)
+
+// SetValue returns a copy of value with newValue unified at path, leaving value unchanged.
+// The path uses CUE syntax (e.g., "spec.replicas" or "#Config.port"), and an
+// empty path unifies newValue with the root. newValue may be a cue.Value from
+// the same CUE context or any Go value CUE can encode.
+//
+// CUE values are never overwritten: setting a field that already holds a
+// different concrete value, or a value that violates the field's constraints,
+// is reported as a conflict. Source positions of the existing value are preserved.
+//
+// Returns CodeInvalidInput if the path is invalid or newValue belongs to another CUE context.
+// Returns CodeCUEBuildFailed if value contains errors.
+// Returns CodeConflict if newValue conflicts with value.
+//
+// Example:
+//
+//	patched, err := cue.SetValue(ctx, config, "spec.replicas", 3)
+func SetValue(ctx context.Context, value cue.Value, path string, newValue interface{}) (cue.Value, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return cue.Value{}, wrapBuildError(ctx.Err(), "context cancelled before setting value")
+	}
+
+	if err := checkConflicts(value); err != nil {
+		return cue.Value{}, wrapBuildErrorWithContext(
+			err,
+			"CUE value contains errors and cannot be modified",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	cuePath := cue.ParsePath(path)
+	if err := cuePath.Err(); err != nil {
+		return cue.Value{}, errors.Wrapf(err, errors.CodeInvalidInput, "invalid path %q", path)
+	}
+
+	if other, ok := newValue.(cue.Value); ok && other.Context() != value.Context() {
+		return cue.Value{}, errors.New(
+			errors.CodeInvalidInput,
+			"new value must be created by the same CUE context as the value being modified",
+		)
+	}
+
+	filled := value.FillPath(cuePath, newValue)
+	if err := checkConflicts(filled); err != nil {
+		return cue.Value{}, wrapConflictErrorWithContext(
+			err,
+			fmt.Sprintf("value at %s conflicts with existing configuration", formatFieldPath(path)),
+			makeContext(
+				"path", path,
+				"issues", extractValidationIssues(err),
+			),
+		)
+	}
+
+	return filled, nil
+}
+
+// Merge unifies values in order into a single value, e.g. a base configuration
+// followed by environment overlays. All values must be created by the same CUE context.
+//
+// Like SetValue, Merge never overwrites: the first value that conflicts with
+// the values before it is reported, identified by its index.
+//
+// Returns CodeInvalidInput if no values are given or they belong to different CUE contexts.
+// Returns CodeCUEBuildFailed if a value contains errors.
+// Returns CodeConflict if a value conflicts with the values before it.
+//
+// Example:
+//
+//	merged, err := cue.Merge(ctx, base, staging, overrides)
+func Merge(ctx context.Context, values ...cue.Value) (cue.Value, errors.PlatformError) {
+	if len(values) == 0 {
+		return cue.Value{}, errors.New(errors.CodeInvalidInput, "at least one value is required to merge")
+	}
+
+	var merged cue.Value
+	for i, value := range values {
+		// Check context cancellation between unifications
+		if ctx.Err() != nil {
+			return cue.Value{}, wrapBuildError(ctx.Err(), "context cancelled while merging values")
+		}
+
+		if err := checkConflicts(value); err != nil {
+			return cue.Value{}, wrapBuildErrorWithContext(
+				err,
+				fmt.Sprintf("value %d contains errors and cannot be merged", i),
+				makeContext("index", i, "error", err.Error()),
+			)
+		}
+
+		if i == 0 {
+			merged = value
+			continue
+		}
+
+		if value.Context() != merged.Context() {
+			return cue.Value{}, errors.New(
+				errors.CodeInvalidInput,
+				fmt.Sprintf("value %d was created by a different CUE context", i),
+			)
+		}
+
+		merged = merged.Unify(value)
+		if err := checkConflicts(merged); err != nil {
+			return cue.Value{}, wrapConflictErrorWithContext(
+				err,
+				fmt.Sprintf("value %d conflicts with previously merged values", i),
+				makeContext(
+					"index", i,
+					"issues", extractValidationIssues(err),
+				),
+			)
+		}
+	}
+
+	return merged, nil
+}
+
+// checkConflicts returns the first error in value, including errors in nested
+// fields. Incomplete values are not errors, so schemas can still be merged.
+func checkConflicts(value cue.Value) error {
+	if err := value.Err(); err != nil {
+		return err
+	}
+	return value.Validate()
+}
@@ -0,0 +1,194 @@
+package cue
+
+import (
+	"context"
+	"testing"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	platformerrors "github.com/jmgilman/go/errors"
+)
+
+func TestSetValue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets value at path", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		config := cueCtx.CompileString(`spec: {name: "api", replicas: int & >0}`)
+
+		patched, err := SetValue(ctx, config, "spec.replicas", 3)
+		if err != nil {
+			t.Fatalf("SetValue failed: %v", err)
+		}
+
+		replicas, err2 := patched.LookupPath(cue.ParsePath("spec.replicas")).Int64()
+		if err2 != nil {
+			t.Fatalf("failed to extract replicas: %v", err2)
+		}
+		if replicas != 3 {
+			t.Errorf("expected replicas=3, got %d", replicas)
+		}
+
+		// The original value is left unchanged
+		if config.LookupPath(cue.ParsePath("spec.replicas")).IsConcrete() {
+			t.Error("expected original value to remain unchanged")
+		}
+	})
+
+	t.Run("sets CUE value at new path", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		config := cueCtx.CompileString(`name: "api"`)
+		labels := cueCtx.CompileString(`{team: "platform"}`)
+
+		patched, err := SetValue(ctx, config, "metadata.labels", labels)
+		if err != nil {
+			t.Fatalf("SetValue failed: %v", err)
+		}
+
+		team, err2 := patched.LookupPath(cue.ParsePath("metadata.labels.team")).String()
+		if err2 != nil {
+			t.Fatalf("failed to extract team: %v", err2)
+		}
+		if team != "platform" {
+			t.Errorf("expected team='platform', got %q", team)
+		}
+	})
+
+	t.Run("detects conflicting concrete value", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		config := cueCtx.CompileString(`replicas: 2`)
+
+		_, err := SetValue(ctx, config, "replicas", 3)
+		if err == nil {
+			t.Fatal("expected conflict error")
+		}
+		if err.Code() != platformerrors.CodeConflict {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeConflict, err.Code())
+		}
+	})
+
+	t.Run("detects constraint violation", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		config := cueCtx.CompileString(`replicas: int & >0`)
+
+		_, err := SetValue(ctx, config, "replicas", -1)
+		if err == nil {
+			t.Fatal("expected conflict error")
+		}
+		if err.Code() != platformerrors.CodeConflict {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeConflict, err.Code())
+		}
+	})
+
+	t.Run("rejects invalid path", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		config := cueCtx.CompileString(`name: "api"`)
+
+		_, err := SetValue(ctx, config, "spec.[", 1)
+		if err == nil {
+			t.Fatal("expected error for invalid path")
+		}
+		if err.Code() != platformerrors.CodeInvalidInput {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, err.Code())
+		}
+	})
+
+	t.Run("rejects value from another context", func(t *testing.T) {
+		config := cuecontext.New().CompileString(`name: "api"`)
+		other := cuecontext.New().CompileString(`"platform"`)
+
+		_, err := SetValue(ctx, config, "team", other)
+		if err == nil {
+			t.Fatal("expected error for value from another context")
+		}
+		if err.Code() != platformerrors.CodeInvalidInput {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, err.Code())
+		}
+	})
+}
+
+func TestMerge(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("merges overlays in order", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		schema := cueCtx.CompileString(`replicas: int & >0, image: string, env: [string]: string`)
+		base := cueCtx.CompileString(`image: "api:1.0", env: LOG_LEVEL: "info"`)
+		overlay := cueCtx.CompileString(`replicas: 3, env: REGION: "us-east-1"`)
+
+		merged, err := Merge(ctx, schema, base, overlay)
+		if err != nil {
+			t.Fatalf("Merge failed: %v", err)
+		}
+		if err := merged.Validate(cue.Concrete(true)); err != nil {
+			t.Fatalf("expected merged value to be concrete: %v", err)
+		}
+
+		for path, want := range map[string]string{
+			"image":         "api:1.0",
+			"env.LOG_LEVEL": "info",
+			"env.REGION":    "us-east-1",
+		} {
+			got, err := merged.LookupPath(cue.ParsePath(path)).String()
+			if err != nil {
+				t.Fatalf("failed to extract %s: %v", path, err)
+			}
+			if got != want {
+				t.Errorf("expected %s=%q, got %q", path, want, got)
+			}
+		}
+	})
+
+	t.Run("returns single value unchanged", func(t *testing.T) {
+		value := cuecontext.New().CompileString(`name: "api"`)
+
+		merged, err := Merge(ctx, value)
+		if err != nil {
+			t.Fatalf("Merge failed: %v", err)
+		}
+		if !merged.Equals(value) {
+			t.Error("expected merged value to equal input")
+		}
+	})
+
+	t.Run("reports conflicting overlay", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		base := cueCtx.CompileString(`image: "api:1.0"`)
+		compatible := cueCtx.CompileString(`replicas: 2`)
+		conflicting := cueCtx.CompileString(`image: "api:2.0"`)
+
+		_, err := Merge(ctx, base, compatible, conflicting)
+		if err == nil {
+			t.Fatal("expected conflict error")
+		}
+		if err.Code() != platformerrors.CodeConflict {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeConflict, err.Code())
+		}
+		if index := err.Context()["index"]; index != 2 {
+			t.Errorf("expected conflict at index 2, got %v", index)
+		}
+	})
+
+	t.Run("rejects empty input", func(t *testing.T) {
+		_, err := Merge(ctx)
+		if err == nil {
+			t.Fatal("expected error for empty input")
+		}
+		if err.Code() != platformerrors.CodeInvalidInput {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, err.Code())
+		}
+	})
+
+	t.Run("rejects values from different contexts", func(t *testing.T) {
+		first := cuecontext.New().CompileString(`a: 1`)
+		second := cuecontext.New().CompileString(`b: 2`)
+
+		_, err := Merge(ctx, first, second)
+		if err == nil {
+			t.Fatal("expected error for values from different contexts")
+		}
+		if err.Code() != platformerrors.CodeInvalidInput {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, err.Code())
+		}
+	})
+}
@@ -0,0 +1,144 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"context"
+	"sort"
+
+	"cuelang.org/go/cue"
+	"github.com/jmgilman/go/errors"
+)
+
+// ValueSource identifies where a value in a policy-applied configuration came from.
+type ValueSource string
+
+const (
+	// SourceData marks a value set by the configuration itself.
+	SourceData ValueSource = "data"
+	// SourcePolicy marks a value injected by the policy, either as a default
+	// or as a fixed value the configuration did not set.
+	SourcePolicy ValueSource = "policy"
+)
+
+// PolicyResult is a configuration with a policy applied.
+type PolicyResult struct {
+	// Value is the unified configuration. Defaults from the policy are
+	// resolved when the value is decoded or encoded.
+	Value cue.Value
+
+	// Sources records the origin of each concrete field, keyed by field path
+	// in CUE syntax (e.g., "spec.replicas"). Lists are recorded as a whole.
+	Sources map[string]ValueSource
+}
+
+// FromPolicy returns the sorted paths of the fields whose values were injected by the policy.
+func (r *PolicyResult) FromPolicy() []string {
+	var paths []string
+	for path, source := range r.Sources {
+		if source == SourcePolicy {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// ApplyPolicy unifies an organization-wide policy onto a configuration and
+// records which values came from the policy. A policy is an ordinary CUE
+// value combining constraints (e.g., replicas: <=10), defaults (e.g.,
+// replicas: *2 | int), and fixed values (e.g., labels: team: "platform").
+//
+// The configuration must satisfy every policy constraint. Values set by the
+// configuration always take precedence over policy defaults, and their
+// fields are attributed to the configuration even when the policy sets the same value.
+//
+// Returns CodeInvalidInput if the values belong to different CUE contexts.
+// Returns CodeCUEBuildFailed if data or policy contains errors.
+// Returns CodeConflict if data violates the policy.
+//
+// Example:
+//
+//	result, err := cue.ApplyPolicy(ctx, config, policy)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, path := range result.FromPolicy() {
+//	    log.Printf("%s set by policy", path)
+//	}
+func ApplyPolicy(ctx context.Context, data cue.Value, policy cue.Value) (*PolicyResult, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return nil, wrapBuildError(ctx.Err(), "context cancelled before applying policy")
+	}
+
+	if data.Context() != policy.Context() {
+		return nil, errors.New(
+			errors.CodeInvalidInput,
+			"data and policy must be created by the same CUE context",
+		)
+	}
+
+	if err := checkConflicts(policy); err != nil {
+		return nil, wrapBuildErrorWithContext(
+			err,
+			"policy contains errors",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	if err := checkConflicts(data); err != nil {
+		return nil, wrapBuildErrorWithContext(
+			err,
+			"data contains errors",
+			makeContext("error", err.Error()),
+		)
+	}
+
+	unified := policy.Unify(data)
+	if err := checkConflicts(unified); err != nil {
+		return nil, wrapConflictErrorWithContext(
+			err,
+			"data violates policy",
+			makeContext("issues", extractValidationIssues(err)),
+		)
+	}
+
+	result := &PolicyResult{
+		Value:   unified,
+		Sources: make(map[string]ValueSource),
+	}
+	recordSources(unified, data, nil, result.Sources)
+
+	return result, nil
+}
+
+// recordSources records the origin of each concrete field below value,
+// attributing it to data if data sets a concrete value at the same path.
+func recordSources(value cue.Value, data cue.Value, selectors []cue.Selector, sources map[string]ValueSource) {
+	iter, err := value.Fields()
+	if err != nil {
+		return
+	}
+
+	for iter.Next() {
+		field := iter.Value()
+		fieldSelectors := append(selectors[:len(selectors):len(selectors)], iter.Selector())
+
+		if field.IncompleteKind() == cue.StructKind {
+			recordSources(field, data, fieldSelectors, sources)
+			continue
+		}
+
+		// Only concrete values, including resolved defaults, end up in the output
+		if resolved, _ := field.Default(); !resolved.IsConcrete() {
+			continue
+		}
+
+		path := cue.MakePath(fieldSelectors...)
+		source := SourcePolicy
+		if set := data.LookupPath(path); set.Exists() && set.IsConcrete() {
+			source = SourceData
+		}
+		sources[path.String()] = source
+	}
+}
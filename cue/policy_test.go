@@ -0,0 +1,122 @@
+package cue
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"cuelang.org/go/cue/cuecontext"
+	platformerrors "github.com/jmgilman/go/errors"
+)
+
+func TestApplyPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	policy := `
+replicas: *2 | int & <=10
+labels: team: "platform"
+resources: {
+	cpu:    *"500m" | string
+	memory: *"512Mi" | string
+}
+`
+
+	t.Run("injects defaults with provenance", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		data := cueCtx.CompileString(`
+name: "api"
+resources: memory: "1Gi"
+labels: team: "platform"
+`)
+
+		result, err := ApplyPolicy(ctx, data, cueCtx.CompileString(policy))
+		if err != nil {
+			t.Fatalf("ApplyPolicy failed: %v", err)
+		}
+
+		var config struct {
+			Name      string            `json:"name"`
+			Replicas  int               `json:"replicas"`
+			Labels    map[string]string `json:"labels"`
+			Resources struct {
+				CPU    string `json:"cpu"`
+				Memory string `json:"memory"`
+			} `json:"resources"`
+		}
+		if err := Decode(ctx, result.Value, &config); err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		if config.Replicas != 2 || config.Resources.CPU != "500m" || config.Resources.Memory != "1Gi" {
+			t.Errorf("unexpected config: %+v", config)
+		}
+
+		wantSources := map[string]ValueSource{
+			"name":             SourceData,
+			"replicas":         SourcePolicy,
+			"labels.team":      SourceData,
+			"resources.cpu":    SourcePolicy,
+			"resources.memory": SourceData,
+		}
+		if !reflect.DeepEqual(result.Sources, wantSources) {
+			t.Errorf("expected sources %v, got %v", wantSources, result.Sources)
+		}
+
+		wantPolicy := []string{"replicas", "resources.cpu"}
+		if got := result.FromPolicy(); !reflect.DeepEqual(got, wantPolicy) {
+			t.Errorf("expected policy paths %v, got %v", wantPolicy, got)
+		}
+	})
+
+	t.Run("attributes fixed values to policy", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		data := cueCtx.CompileString(`name: "api", replicas: 3`)
+
+		result, err := ApplyPolicy(ctx, data, cueCtx.CompileString(policy))
+		if err != nil {
+			t.Fatalf("ApplyPolicy failed: %v", err)
+		}
+		if result.Sources["labels.team"] != SourcePolicy {
+			t.Errorf("expected labels.team from policy, got %q", result.Sources["labels.team"])
+		}
+		if result.Sources["replicas"] != SourceData {
+			t.Errorf("expected replicas from data, got %q", result.Sources["replicas"])
+		}
+	})
+
+	t.Run("reports policy violation", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+		data := cueCtx.CompileString(`replicas: 20`)
+
+		_, err := ApplyPolicy(ctx, data, cueCtx.CompileString(policy))
+		if err == nil {
+			t.Fatal("expected policy violation")
+		}
+		if err.Code() != platformerrors.CodeConflict {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeConflict, err.Code())
+		}
+	})
+
+	t.Run("rejects values from different contexts", func(t *testing.T) {
+		data := cuecontext.New().CompileString(`name: "api"`)
+
+		_, err := ApplyPolicy(ctx, data, cuecontext.New().CompileString(policy))
+		if err == nil {
+			t.Fatal("expected error for values from different contexts")
+		}
+		if err.Code() != platformerrors.CodeInvalidInput {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, err.Code())
+		}
+	})
+
+	t.Run("rejects invalid policy", func(t *testing.T) {
+		cueCtx := cuecontext.New()
+
+		_, err := ApplyPolicy(ctx, cueCtx.CompileString(`name: "api"`), cueCtx.CompileString(`replicas: 1 & 2`))
+		if err == nil {
+			t.Fatal("expected error for invalid policy")
+		}
+		if err.Code() != platformerrors.CodeCUEBuildFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEBuildFailed, err.Code())
+		}
+	})
+}
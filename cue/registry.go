@@ -0,0 +1,261 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"cuelang.org/go/cue/load"
+	"cuelang.org/go/mod/modconfig"
+	"cuelang.org/go/mod/modfile"
+	"cuelang.org/go/mod/module"
+	"github.com/jmgilman/go/fs/core"
+)
+
+// Ensure ModuleRegistry can be used as a load.Config registry.
+var _ modconfig.Registry = (*ModuleRegistry)(nil)
+
+// moduleCacheRoot is the path at which the module cache is exposed to
+// cue/load, which reads dependency sources only from OS paths or its overlay.
+var moduleCacheRoot = makeAbsolutePath("/.cue-module-cache")
+
+// cacheSourceFS exposes the module cache at moduleCacheRoot.
+type cacheSourceFS struct {
+	core.FS
+}
+
+// OSRoot implements module.OSRootFS.
+func (cacheSourceFS) OSRoot() string {
+	return moduleCacheRoot
+}
+
+// ModulePuller downloads the OCI artifact at reference and extracts it into
+// targetDir. The oci package client satisfies it with a small adapter:
+//
+//	client, err := ocibundle.New()
+//	puller := cue.ModulePullerFunc(func(ctx context.Context, reference, targetDir string) error {
+//	    return client.Pull(ctx, reference, targetDir)
+//	})
+type ModulePuller interface {
+	Pull(ctx context.Context, reference, targetDir string) error
+}
+
+// ModulePullerFunc adapts a function to the ModulePuller interface.
+type ModulePullerFunc func(ctx context.Context, reference, targetDir string) error
+
+// Pull calls f(ctx, reference, targetDir).
+func (f ModulePullerFunc) Pull(ctx context.Context, reference, targetDir string) error {
+	return f(ctx, reference, targetDir)
+}
+
+// ModuleRegistry resolves CUE module dependencies from an OCI registry.
+//
+// Each module version is stored as an artifact at <registry>/<module path>:<version>
+// containing the module root (including cue.mod/module.cue). Pulled modules
+// are kept in a local module cache at <module path>@<version> on the cache
+// filesystem and reused by later loads, since published versions are immutable.
+//
+// Dependencies must be pinned in the main module's cue.mod/module.cue.
+// Because artifacts are pulled by reference, ModuleVersions only reports
+// versions already present in the local cache.
+//
+// A ModuleRegistry is safe for concurrent use.
+//
+// Example:
+//
+//	registry := cue.NewModuleRegistry("ghcr.io/myorg/cue", puller, cacheFS)
+//	loader := cue.NewLoader(filesystem).WithRegistry(registry)
+//	value, err := loader.LoadModule(ctx, "app")
+type ModuleRegistry struct {
+	registry string
+	puller   ModulePuller
+	cacheFS  core.FS
+	mu       sync.Mutex
+}
+
+// NewModuleRegistry creates a registry that pulls modules from the given OCI
+// registry prefix (e.g. "ghcr.io/myorg/cue") using puller. The cacheFS must be
+// the filesystem puller extracts artifacts to; use Chroot to scope the cache
+// to a directory.
+func NewModuleRegistry(registry string, puller ModulePuller, cacheFS core.FS) *ModuleRegistry {
+	return &ModuleRegistry{
+		registry: strings.TrimSuffix(registry, "/"),
+		puller:   puller,
+		cacheFS:  cacheFS,
+	}
+}
+
+// Fetch returns the location of module m in the local module cache, pulling
+// it from the registry first if needed.
+//
+// Returns CodeCUELoadFailed if the module cannot be pulled or the artifact is not a CUE module.
+func (r *ModuleRegistry) Fetch(ctx context.Context, m module.Version) (module.SourceLoc, error) {
+	dir := moduleCacheDir(m)
+	moduleFile := path.Join(dir, "cue.mod", "module.cue")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The module file is only present once an artifact has been fully extracted
+	exists, err := r.cacheFS.Exists(moduleFile)
+	if err != nil {
+		return module.SourceLoc{}, wrapLoadErrorWithContext(
+			err,
+			"failed to check module cache",
+			makeContext("module", m.String(), "dir", dir),
+		)
+	}
+
+	if !exists {
+		reference := r.reference(m)
+		if err := r.puller.Pull(ctx, reference, dir); err != nil {
+			return module.SourceLoc{}, wrapLoadErrorWithContext(
+				err,
+				"failed to pull CUE module",
+				makeContext("module", m.String(), "reference", reference),
+			)
+		}
+
+		if exists, err := r.cacheFS.Exists(moduleFile); err != nil || !exists {
+			return module.SourceLoc{}, wrapLoadErrorWithContext(
+				fmt.Errorf("cue.mod/module.cue not found in artifact"),
+				"pulled artifact is not a CUE module",
+				makeContext("module", m.String(), "reference", reference),
+			)
+		}
+	}
+
+	return module.SourceLoc{FS: cacheSourceFS{r.cacheFS}, Dir: dir}, nil
+}
+
+// addOverlay fetches the dependencies declared in moduleFile and adds their
+// files to overlay under moduleCacheRoot, where cue/load looks for them.
+// Dependencies must all be listed in the main module file, as CUE requires.
+//
+// Returns CodeCUELoadFailed if the module file is invalid or a dependency cannot be fetched.
+func (r *ModuleRegistry) addOverlay(ctx context.Context, moduleFile []byte, overlay map[string]load.Source) error {
+	mf, err := modfile.Parse(moduleFile, "cue.mod/module.cue")
+	if err != nil {
+		return wrapLoadError(err, "failed to parse module file")
+	}
+
+	for _, m := range mf.DepVersions() {
+		loc, err := r.Fetch(ctx, m)
+		if err != nil {
+			return err
+		}
+
+		err = r.cacheFS.Walk(loc.Dir, func(filePath string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			data, err := r.cacheFS.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+			overlay[filepath.Join(moduleCacheRoot, filepath.FromSlash(filePath))] = load.FromBytes(data)
+			return nil
+		})
+		if err != nil {
+			return wrapLoadErrorWithContext(
+				err,
+				"failed to read cached module",
+				makeContext("module", m.String(), "dir", loc.Dir),
+			)
+		}
+	}
+
+	return nil
+}
+
+// Requirements returns the dependencies declared by module m, fetching it if needed.
+//
+// Returns CodeCUELoadFailed if the module cannot be fetched or its module file is invalid.
+func (r *ModuleRegistry) Requirements(ctx context.Context, m module.Version) ([]module.Version, error) {
+	loc, err := r.Fetch(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleFile := path.Join(loc.Dir, "cue.mod", "module.cue")
+	data, err := fs.ReadFile(loc.FS, moduleFile)
+	if err != nil {
+		return nil, wrapLoadErrorWithContext(
+			err,
+			"failed to read module file",
+			makeContext("module", m.String(), "file_path", moduleFile),
+		)
+	}
+
+	mf, err := modfile.Parse(data, moduleFile)
+	if err != nil {
+		return nil, wrapLoadErrorWithContext(
+			err,
+			"failed to parse module file",
+			makeContext("module", m.String(), "file_path", moduleFile),
+		)
+	}
+
+	return mf.DepVersions(), nil
+}
+
+// ModuleVersions returns the cached versions of the module with the given
+// path, sorted lexically. A major version suffix (e.g. "@v1") restricts the
+// result to that major version.
+//
+// Returns CodeCUELoadFailed if the module cache cannot be read.
+func (r *ModuleRegistry) ModuleVersions(_ context.Context, mpath string) ([]string, error) {
+	basePath, major, _ := strings.Cut(mpath, "@")
+	parent, name := path.Split(basePath)
+	if parent == "" {
+		parent = "."
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	exists, err := r.cacheFS.Exists(parent)
+	if err != nil {
+		return nil, wrapLoadErrorWithContext(err, "failed to check module cache", makeContext("module", mpath))
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	entries, err := r.cacheFS.ReadDir(parent)
+	if err != nil {
+		return nil, wrapLoadErrorWithContext(err, "failed to read module cache", makeContext("module", mpath))
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		version, ok := strings.CutPrefix(entry.Name(), name+"@")
+		if !ok || !entry.IsDir() {
+			continue
+		}
+		if major != "" && version != major && !strings.HasPrefix(version, major+".") {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	return versions, nil
+}
+
+// reference returns the OCI reference of module m in the registry.
+func (r *ModuleRegistry) reference(m module.Version) string {
+	return fmt.Sprintf("%s/%s:%s", r.registry, m.BasePath(), m.Version())
+}
+
+// moduleCacheDir returns the cache directory of module m, e.g.
+// "example.com/schemas@v1.2.0".
+func moduleCacheDir(m module.Version) string {
+	return m.BasePath() + "@" + m.Version()
+}
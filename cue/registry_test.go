@@ -0,0 +1,190 @@
+package cue
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"testing"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/mod/module"
+	"github.com/jmgilman/go/fs/billy"
+)
+
+// fakeModulePuller extracts in-memory modules keyed by OCI reference.
+type fakeModulePuller struct {
+	fs      *billy.MemoryFS
+	modules map[string]map[string]string
+	pulls   []string
+}
+
+func (p *fakeModulePuller) Pull(_ context.Context, reference, targetDir string) error {
+	p.pulls = append(p.pulls, reference)
+
+	files, ok := p.modules[reference]
+	if !ok {
+		return fmt.Errorf("artifact %s not found", reference)
+	}
+	for name, content := range files {
+		if err := p.fs.WriteFile(path.Join(targetDir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestModuleRegistry(t *testing.T) {
+	newPuller := func() *fakeModulePuller {
+		return &fakeModulePuller{
+			fs: billy.NewMemory(),
+			modules: map[string]map[string]string{
+				"registry.example.com/cue/example.com/schemas:v0.1.0": {
+					"cue.mod/module.cue": `
+module: "example.com/schemas@v0"
+language: version: "v0.14.0"
+deps: "example.com/common@v0": v: "v0.2.0"
+`,
+					"schemas.cue": `
+package schemas
+#Config: {name: string, replicas: int & >0}
+`,
+				},
+				"registry.example.com/cue/example.com/common:v0.2.0": {
+					"cue.mod/module.cue": `
+module: "example.com/common@v0"
+language: version: "v0.14.0"
+`,
+					"common.cue": `
+package common
+#Name: string & =~"^[a-z]+$"
+`,
+				},
+				"registry.example.com/cue/example.com/broken:v0.1.0": {
+					"README.md": "not a module",
+				},
+			},
+		}
+	}
+
+	schemas := module.MustNewVersion("example.com/schemas@v0", "v0.1.0")
+
+	t.Run("loads module with registry dependency", func(t *testing.T) {
+		puller := newPuller()
+		registry := NewModuleRegistry("registry.example.com/cue/", puller, puller.fs)
+
+		mfs := billy.NewMemory()
+		if err := mfs.WriteFile("app/cue.mod/module.cue", []byte(`
+module: "example.com/app@v0"
+language: version: "v0.14.0"
+deps: {
+	"example.com/common@v0": v:  "v0.2.0"
+	"example.com/schemas@v0": v: "v0.1.0"
+}
+`), 0644); err != nil {
+			t.Fatalf("failed to create module file: %v", err)
+		}
+		if err := mfs.WriteFile("app/app.cue", []byte(`
+package app
+
+import "example.com/schemas"
+
+config: schemas.#Config & {name: "api", replicas: 2}
+`), 0644); err != nil {
+			t.Fatalf("failed to create app file: %v", err)
+		}
+
+		loader := NewLoader(mfs).WithRegistry(registry)
+		result, err := loader.LoadModule(context.Background(), "app")
+		if err != nil {
+			t.Fatalf("LoadModule failed: %v", err)
+		}
+
+		name, err := result.LookupPath(cue.ParsePath("config.name")).String()
+		if err != nil {
+			t.Fatalf("failed to lookup config.name: %v", err)
+		}
+		if name != "api" {
+			t.Errorf("expected name='api', got name=%q", name)
+		}
+	})
+
+	t.Run("reuses cached modules", func(t *testing.T) {
+		puller := newPuller()
+		registry := NewModuleRegistry("registry.example.com/cue", puller, puller.fs)
+
+		for i := 0; i < 2; i++ {
+			loc, err := registry.Fetch(context.Background(), schemas)
+			if err != nil {
+				t.Fatalf("Fetch failed: %v", err)
+			}
+			if loc.Dir != "example.com/schemas@v0.1.0" {
+				t.Errorf("expected cache dir example.com/schemas@v0.1.0, got %s", loc.Dir)
+			}
+		}
+
+		if len(puller.pulls) != 1 {
+			t.Errorf("expected 1 pull, got %d: %v", len(puller.pulls), puller.pulls)
+		}
+	})
+
+	t.Run("reads requirements from module file", func(t *testing.T) {
+		puller := newPuller()
+		registry := NewModuleRegistry("registry.example.com/cue", puller, puller.fs)
+
+		deps, err := registry.Requirements(context.Background(), schemas)
+		if err != nil {
+			t.Fatalf("Requirements failed: %v", err)
+		}
+
+		want := []module.Version{module.MustNewVersion("example.com/common@v0", "v0.2.0")}
+		if !reflect.DeepEqual(deps, want) {
+			t.Errorf("expected requirements %v, got %v", want, deps)
+		}
+	})
+
+	t.Run("lists cached versions", func(t *testing.T) {
+		puller := newPuller()
+		registry := NewModuleRegistry("registry.example.com/cue", puller, puller.fs)
+
+		if _, err := registry.Fetch(context.Background(), schemas); err != nil {
+			t.Fatalf("Fetch failed: %v", err)
+		}
+
+		versions, err := registry.ModuleVersions(context.Background(), "example.com/schemas@v0")
+		if err != nil {
+			t.Fatalf("ModuleVersions failed: %v", err)
+		}
+		if !reflect.DeepEqual(versions, []string{"v0.1.0"}) {
+			t.Errorf("expected versions [v0.1.0], got %v", versions)
+		}
+
+		versions, err = registry.ModuleVersions(context.Background(), "example.com/schemas@v1")
+		if err != nil {
+			t.Fatalf("ModuleVersions failed: %v", err)
+		}
+		if len(versions) != 0 {
+			t.Errorf("expected no v1 versions, got %v", versions)
+		}
+	})
+
+	t.Run("returns error for missing artifact", func(t *testing.T) {
+		puller := newPuller()
+		registry := NewModuleRegistry("registry.example.com/cue", puller, puller.fs)
+
+		_, err := registry.Fetch(context.Background(), module.MustNewVersion("example.com/missing@v0", "v0.1.0"))
+		if err == nil {
+			t.Fatal("expected error for missing artifact")
+		}
+	})
+
+	t.Run("returns error for artifact without module file", func(t *testing.T) {
+		puller := newPuller()
+		registry := NewModuleRegistry("registry.example.com/cue", puller, puller.fs)
+
+		_, err := registry.Fetch(context.Background(), module.MustNewVersion("example.com/broken@v0", "v0.1.0"))
+		if err == nil {
+			t.Fatal("expected error for artifact without module file")
+		}
+	})
+}
@@ -0,0 +1,313 @@
+// Package cue provides CUE evaluation and validation capabilities with platform error handling.
+package cue
+
+import (
+	"bytes"
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"cuelang.org/go/cue/format"
+	"github.com/jmgilman/go/errors"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// cueKeywords are identifiers that must be quoted when used as field labels.
+var cueKeywords = map[string]bool{
+	"package": true,
+	"import":  true,
+	"for":     true,
+	"in":      true,
+	"if":      true,
+	"let":     true,
+	"true":    true,
+	"false":   true,
+	"null":    true,
+}
+
+// GenerateSchema generates CUE definitions from Go struct types.
+// Each type may be given as a value, a pointer, or a reflect.Type, and must be
+// a named struct. Every named struct reachable from the given types becomes a
+// definition named after the Go type (e.g. Config becomes #Config), so the
+// returned source can be loaded with Loader.LoadBytes and used as a schema.
+//
+// Fields follow encoding/json conventions:
+//   - The json tag sets the field name; fields tagged "-" and unexported fields are skipped
+//   - Pointer fields and fields tagged omitempty are optional
+//   - Embedded structs without a json name are embedded in the definition
+//   - time.Time and encoding.TextMarshaler types map to string
+//   - []byte maps to string, matching its base64 JSON encoding
+//
+// A cue tag adds a constraint to the field, e.g. `cue:">0 & <=65535"`.
+//
+// Returns CodeInvalidInput if a type is not a named struct, contains a type
+// with no CUE equivalent (channels, functions, maps with non-string keys), or
+// if two different types share a name.
+//
+// Example:
+//
+//	source, err := cue.GenerateSchema(ctx, Config{})
+//	schema, err := loader.LoadBytes(ctx, source, "schema.cue")
+//	err = cue.Validate(ctx, schema.LookupPath(cue.ParsePath("#Config")), data)
+func GenerateSchema(ctx context.Context, types ...interface{}) ([]byte, errors.PlatformError) {
+	// Check if context is already cancelled
+	if ctx.Err() != nil {
+		return nil, wrapBuildError(ctx.Err(), "context cancelled before generating schema")
+	}
+
+	if len(types) == 0 {
+		return nil, errors.New(errors.CodeInvalidInput, "at least one type is required to generate a schema")
+	}
+
+	gen := &schemaGenerator{names: make(map[string]reflect.Type)}
+	for _, v := range types {
+		if v == nil {
+			return nil, errors.New(errors.CodeInvalidInput, "cannot generate a schema for a nil type")
+		}
+
+		t, ok := v.(reflect.Type)
+		if !ok {
+			t = reflect.TypeOf(v)
+		}
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || t.Name() == "" {
+			return nil, errors.New(
+				errors.CodeInvalidInput,
+				fmt.Sprintf("cannot generate a schema for %s: type must be a named struct", t),
+			)
+		}
+
+		if _, err := gen.define(t); err != nil {
+			return nil, err
+		}
+	}
+
+	// Definitions discovered while generating are appended to the order,
+	// so this loop also covers nested types.
+	var buf bytes.Buffer
+	for i := 0; i < len(gen.order); i++ {
+		t := gen.order[i]
+		body, err := gen.structBody(t)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "#%s: %s\n", t.Name(), body)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, wrapBuildErrorWithContext(
+			err,
+			"generated schema is not valid CUE",
+			makeContext("source", buf.String()),
+		)
+	}
+
+	return source, nil
+}
+
+// schemaGenerator tracks the definitions emitted by GenerateSchema.
+type schemaGenerator struct {
+	names map[string]reflect.Type
+	order []reflect.Type
+}
+
+// define registers a named struct type as a definition and returns its
+// reference. Types are only registered once.
+func (g *schemaGenerator) define(t reflect.Type) (string, errors.PlatformError) {
+	name := t.Name()
+	if existing, ok := g.names[name]; ok {
+		if existing != t {
+			return "", errors.New(
+				errors.CodeInvalidInput,
+				fmt.Sprintf("types %s and %s both map to definition #%s", existing, t, name),
+			)
+		}
+		return "#" + name, nil
+	}
+
+	if !isIdentifier(name) {
+		return "", errors.New(
+			errors.CodeInvalidInput,
+			fmt.Sprintf("cannot generate a definition name for type %s", t),
+		)
+	}
+
+	g.names[name] = t
+	g.order = append(g.order, t)
+	return "#" + name, nil
+}
+
+// structBody generates the CUE struct literal for a Go struct type.
+func (g *schemaGenerator) structBody(t reflect.Type) (string, errors.PlatformError) {
+	var b strings.Builder
+	b.WriteString("{\n")
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts := parseJSONTag(field.Tag.Get("json"))
+		if name == "-" && opts == "" {
+			continue
+		}
+
+		// Embedded structs contribute their fields to the parent, as in encoding/json
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				expr, err := g.typeExpr(embedded)
+				if err != nil {
+					return "", err
+				}
+				fmt.Fprintf(&b, "%s\n", expr)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		expr, err := g.typeExpr(field.Type)
+		if err != nil {
+			return "", errors.New(
+				errors.CodeInvalidInput,
+				fmt.Sprintf("field %s.%s: %s", t.Name(), field.Name, err.Message()),
+			)
+		}
+		if constraint := field.Tag.Get("cue"); constraint != "" {
+			expr = fmt.Sprintf("%s & %s", expr, constraint)
+		}
+
+		marker := ""
+		if field.Type.Kind() == reflect.Ptr || hasTagOption(opts, "omitempty") {
+			marker = "?"
+		}
+
+		fmt.Fprintf(&b, "%s%s: %s\n", formatLabel(name), marker, expr)
+	}
+
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// typeExpr generates the CUE expression for a Go type.
+func (g *schemaGenerator) typeExpr(t reflect.Type) (string, errors.PlatformError) {
+	// Types with custom encodings are checked before their underlying kind
+	switch {
+	case t == timeType:
+		return "string", nil
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return "string", nil
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		return "_", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		// Go numeric kinds share their names with CUE's predeclared bounds
+		return t.Kind().String(), nil
+	case reflect.String:
+		return "string", nil
+	case reflect.Interface:
+		return "_", nil
+	case reflect.Ptr:
+		return g.typeExpr(t.Elem())
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return "string", nil
+		}
+		elem, err := g.typeExpr(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[...%s]", elem), nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return "", errors.New(
+				errors.CodeInvalidInput,
+				fmt.Sprintf("map key type %s is not supported (must be a string)", t.Key()),
+			)
+		}
+		elem, err := g.typeExpr(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("{[string]: %s}", elem), nil
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structBody(t)
+		}
+		return g.define(t)
+	default:
+		return "", errors.New(
+			errors.CodeInvalidInput,
+			fmt.Sprintf("type %s has no CUE equivalent", t),
+		)
+	}
+}
+
+// parseJSONTag splits a json struct tag into its name and options.
+func parseJSONTag(tag string) (string, string) {
+	name, opts, _ := strings.Cut(tag, ",")
+	return name, opts
+}
+
+// hasTagOption reports whether a comma-separated tag option list contains option.
+func hasTagOption(opts, option string) bool {
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// formatLabel quotes a field label unless it is a plain CUE identifier.
+// Labels starting with an underscore are quoted so they do not become hidden fields.
+func formatLabel(name string) string {
+	if isIdentifier(name) && !cueKeywords[name] {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+// isIdentifier reports whether name is a letter followed by letters, digits, or underscores.
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r == '_' || (r >= '0' && r <= '9')):
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+package cue
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	platformerrors "github.com/jmgilman/go/errors"
+)
+
+type schemaTestMetadata struct {
+	ID string `json:"id"`
+}
+
+type schemaTestPort struct {
+	Number   int     `json:"number" cue:">0 & <=65535"`
+	Protocol *string `json:"protocol"`
+}
+
+type schemaTestService struct {
+	schemaTestMetadata
+	Name     string            `json:"name"`
+	Ports    []schemaTestPort  `json:"ports,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Created  time.Time         `json:"created,omitempty"`
+	Extra    interface{}       `json:"extra,omitempty"`
+	Ignored  string            `json:"-"`
+	Kebab    string            `json:"kebab-case,omitempty"`
+	Next     *schemaTestService
+	internal string
+}
+
+func TestGenerateSchema(t *testing.T) {
+	ctx := context.Background()
+
+	source, err := GenerateSchema(ctx, schemaTestService{})
+	if err != nil {
+		t.Fatalf("GenerateSchema failed: %v", err)
+	}
+
+	output := string(source)
+	// Field values are aligned by the formatter, so compare without padding
+	normalized := strings.Join(strings.Fields(output), " ")
+	for _, want := range []string{
+		"#schemaTestService: {",
+		"#schemaTestMetadata",
+		"name: string",
+		"ports?: [...#schemaTestPort]",
+		"labels?: {[string]: string}",
+		"created?: string",
+		"extra?: _",
+		`"kebab-case"?: string`,
+		"Next?: #schemaTestService",
+		"number: int & >0 & <=65535",
+		"protocol?: string",
+	} {
+		if !strings.Contains(normalized, want) {
+			t.Errorf("expected schema to contain %q, got:\n%s", want, output)
+		}
+	}
+	for _, unwanted := range []string{"Ignored", "internal"} {
+		if strings.Contains(output, unwanted) {
+			t.Errorf("expected schema not to contain %q, got:\n%s", unwanted, output)
+		}
+	}
+
+	// The generated schema must compile and validate data
+	cueCtx := cuecontext.New()
+	schema := cueCtx.CompileBytes(source).LookupPath(cue.ParsePath("#schemaTestService"))
+	if err := schema.Err(); err != nil {
+		t.Fatalf("failed to compile generated schema: %v\n%s", err, output)
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid data",
+			data: `{id: "svc-1", name: "api", ports: [{number: 8080, protocol: "tcp"}]}`,
+		},
+		{
+			name:    "missing required field",
+			data:    `{id: "svc-1"}`,
+			wantErr: true,
+		},
+		{
+			name:    "constraint violation",
+			data:    `{id: "svc-1", name: "api", ports: [{number: 70000}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    `{id: "svc-1", name: "api", replicas: 3}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := cueCtx.CompileString(tt.data)
+			err := Validate(ctx, schema, data)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected validation to fail")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("expected validation to succeed, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestGenerateSchema_Inputs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("accepts pointers and reflect types", func(t *testing.T) {
+		source, err := GenerateSchema(ctx, &schemaTestPort{}, reflect.TypeOf(schemaTestMetadata{}))
+		if err != nil {
+			t.Fatalf("GenerateSchema failed: %v", err)
+		}
+		for _, want := range []string{"#schemaTestPort: {", "#schemaTestMetadata: {"} {
+			if !strings.Contains(string(source), want) {
+				t.Errorf("expected schema to contain %q, got:\n%s", want, source)
+			}
+		}
+	})
+
+	type unsupportedField struct {
+		Callback func()
+	}
+	type unsupportedKey struct {
+		Counts map[int]string
+	}
+
+	tests := []struct {
+		name        string
+		types       []interface{}
+		errContains string
+	}{
+		{
+			name:        "no types",
+			errContains: "at least one type",
+		},
+		{
+			name:        "nil type",
+			types:       []interface{}{nil},
+			errContains: "nil type",
+		},
+		{
+			name:        "non-struct type",
+			types:       []interface{}{"hello"},
+			errContains: "must be a named struct",
+		},
+		{
+			name:        "anonymous struct",
+			types:       []interface{}{struct{ A string }{}},
+			errContains: "must be a named struct",
+		},
+		{
+			name:        "unsupported field type",
+			types:       []interface{}{unsupportedField{}},
+			errContains: "no CUE equivalent",
+		},
+		{
+			name:        "non-string map key",
+			types:       []interface{}{unsupportedKey{}},
+			errContains: "must be a string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GenerateSchema(ctx, tt.types...)
+			if err == nil {
+				t.Fatal("expected error but got none")
+			}
+			if err.Code() != platformerrors.CodeInvalidInput {
+				t.Errorf("expected code %s, got %s", platformerrors.CodeInvalidInput, err.Code())
+			}
+			if !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("expected error containing %q, got: %v", tt.errContains, err)
+			}
+		})
+	}
+}
+
+func TestGenerateSchema_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // Cancel immediately
+
+	_, err := GenerateSchema(ctx, schemaTestPort{})
+	if err == nil {
+		t.Fatal("expected error when context is cancelled")
+	}
+	if !strings.Contains(err.Error(), "context cancelled") {
+		t.Errorf("expected error about context cancellation, got: %v", err)
+	}
+}
@@ -2,11 +2,16 @@ package cue
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"cuelang.org/go/cue"
 	cueerrors "cuelang.org/go/cue/errors"
 	"cuelang.org/go/cue/token"
+	"github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/fs/core"
 )
 
 // ValidationOptions configures validation behavior.
@@ -20,6 +25,18 @@ type ValidationOptions struct {
 
 	// All reports all errors instead of stopping at the first one.
 	All bool
+
+	// IgnoreOptional ignores errors in fields that are optional in the schema,
+	// so optional fields can still hold placeholders during early passes.
+	IgnoreOptional bool
+
+	// ExemptPaths lists field paths in CUE syntax (e.g., "spec.image") whose
+	// errors are ignored, including errors in fields nested below them.
+	ExemptPaths []string
+
+	// Sources is the filesystem the validated values were loaded from. When
+	// set, validation errors include source excerpts for each error position.
+	Sources core.ReadFS
 }
 
 // DefaultValidationOptions returns sensible default validation options.
@@ -32,6 +49,71 @@ func DefaultValidationOptions() ValidationOptions {
 	}
 }
 
+// PartialValidationOptions returns options that check data against the schema
+// without requiring it to be concrete. Use these for a pre-render pass where
+// values are expected to be filled in later, followed by a full validation
+// with DefaultValidationOptions.
+func PartialValidationOptions() ValidationOptions {
+	return ValidationOptions{
+		Concrete: false,
+		Final:    false,
+		All:      true,
+	}
+}
+
+// cueOptions converts the options to CUE validation options.
+func (o ValidationOptions) cueOptions() []cue.Option {
+	var cueOpts []cue.Option
+	if o.Concrete {
+		cueOpts = append(cueOpts, cue.Concrete(true))
+	}
+	if o.Final {
+		cueOpts = append(cueOpts, cue.Final())
+	}
+	if o.All {
+		cueOpts = append(cueOpts, cue.All())
+	}
+	return cueOpts
+}
+
+// filterErrors removes the errors ignored by IgnoreOptional and ExemptPaths
+// from err. Returns nil if no errors remain.
+func (o ValidationOptions) filterErrors(err error, schema cue.Value) error {
+	if err == nil || (!o.IgnoreOptional && len(o.ExemptPaths) == 0) {
+		return err
+	}
+
+	exempt := make([][]string, len(o.ExemptPaths))
+	for i, path := range o.ExemptPaths {
+		exempt[i] = pathLabels(path)
+	}
+
+	var kept cueerrors.Error
+	for _, e := range cueerrors.Errors(err) {
+		path := e.Path()
+		if o.IgnoreOptional && inOptionalField(schema, path) {
+			continue
+		}
+		if hasPathPrefix(path, exempt) {
+			continue
+		}
+		kept = cueerrors.Append(kept, e)
+	}
+
+	if kept == nil {
+		return nil
+	}
+	return kept
+}
+
+// withExcerpts adds source excerpts for err to ctx when Sources is set.
+func (o ValidationOptions) withExcerpts(ctx map[string]interface{}, err error) map[string]interface{} {
+	if excerpts := renderExcerpts(o.Sources, err); len(excerpts) > 0 {
+		ctx["excerpts"] = excerpts
+	}
+	return ctx
+}
+
 // ValidationIssue represents a single validation error with structured information.
 type ValidationIssue struct {
 	// Path is the field path where the error occurred (e.g., ["user", "age"]).
@@ -40,10 +122,74 @@ type ValidationIssue struct {
 	// Message is the human-readable error message.
 	Message string
 
+	// Expected is the schema constraint at Path in CUE syntax (e.g., "int & >=0").
+	// Only set by ValidateDetailed, and empty if the schema has no field at Path.
+	Expected string
+
+	// Actual is the data value at Path in CUE syntax.
+	// Only set by ValidateDetailed, and empty if the data has no field at Path.
+	Actual string
+
 	// Position is the source position if available.
 	Position token.Pos
 }
 
+// validationIssueJSON is the JSON representation of a ValidationIssue.
+type validationIssueJSON struct {
+	Path     []string                `json:"path"`
+	Message  string                  `json:"message"`
+	Expected string                  `json:"expected,omitempty"`
+	Actual   string                  `json:"actual,omitempty"`
+	Position *validationPositionJSON `json:"position,omitempty"`
+}
+
+// validationPositionJSON is the JSON representation of a token.Pos.
+type validationPositionJSON struct {
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// MarshalJSON encodes the issue with its position expanded into file, line,
+// and column. The position is omitted when unknown.
+func (i ValidationIssue) MarshalJSON() ([]byte, error) {
+	out := validationIssueJSON{
+		Path:     i.Path,
+		Message:  i.Message,
+		Expected: i.Expected,
+		Actual:   i.Actual,
+	}
+	if out.Path == nil {
+		out.Path = []string{}
+	}
+	if i.Position.IsValid() {
+		out.Position = &validationPositionJSON{
+			File:   i.Position.Filename(),
+			Line:   i.Position.Line(),
+			Column: i.Position.Column(),
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+// ToJSON encodes validation issues as a JSON array, suitable for UIs and CI
+// annotations. A nil slice encodes as an empty array.
+//
+// Returns CodeCUEEncodeFailed if encoding fails.
+func ToJSON(issues []ValidationIssue) ([]byte, errors.PlatformError) {
+	if issues == nil {
+		issues = []ValidationIssue{}
+	}
+
+	data, err := json.Marshal(issues)
+	if err != nil {
+		return nil, wrapEncodeError(err, "failed to encode validation issues to JSON")
+	}
+
+	return data, nil
+}
+
 // Validate validates a CUE value against a schema using default options.
 // Returns nil if validation succeeds, PlatformError with detailed messages if it fails.
 // Both schema and data are generic cue.Value - no coupling to specific schema packages.
@@ -63,6 +209,7 @@ func Validate(ctx context.Context, schema cue.Value, data cue.Value) error {
 // 4. Extract structured error information on failure
 //
 // Returns CodeCUEValidationFailed on validation failure.
+// Returns CodeTimeout if evaluation exceeds the limits set with WithEvalLimits.
 func ValidateWithOptions(ctx context.Context, schema cue.Value, data cue.Value, opts ValidationOptions) error {
 	// Check context cancellation
 	if err := ctx.Err(); err != nil {
@@ -92,10 +239,10 @@ func ValidateWithOptions(ctx context.Context, schema cue.Value, data cue.Value,
 		return wrapValidationErrorWithContext(
 			err,
 			"data is invalid",
-			makeContext(
+			opts.withExcerpts(makeContext(
 				"data_error", details,
 				"issues", issues,
-			),
+			), err),
 		)
 	}
 
@@ -103,21 +250,18 @@ func ValidateWithOptions(ctx context.Context, schema cue.Value, data cue.Value,
 	unified := schema.Unify(data)
 
 	// Build validation options for CUE
-	var cueOpts []cue.Option
-	if opts.Concrete {
-		cueOpts = append(cueOpts, cue.Concrete(true))
-	}
-	if opts.Final {
-		cueOpts = append(cueOpts, cue.Final())
-	}
-	if opts.All {
-		cueOpts = append(cueOpts, cue.All())
-	}
+	cueOpts := opts.cueOptions()
 
-	// Validate the unified result
+	// Validate the unified result within the evaluation limits
 	// Note: We skip the unified.Err() check and go straight to Validate()
 	// so that the All option can collect all errors at once.
-	if err := unified.Validate(cueOpts...); err != nil {
+	var validationErr error
+	if err := guarded(ctx, "validation", unified, wrapValidationError, func() {
+		validationErr = unified.Validate(cueOpts...)
+	}); err != nil {
+		return err
+	}
+	if err := opts.filterErrors(validationErr, schema); err != nil {
 		details := cueerrors.Details(err, nil)
 		issues := extractValidationIssues(err)
 		positions := cueerrors.Positions(err)
@@ -125,17 +269,182 @@ func ValidateWithOptions(ctx context.Context, schema cue.Value, data cue.Value,
 		return wrapValidationErrorWithContext(
 			err,
 			"validation failed",
-			makeContext(
+			opts.withExcerpts(makeContext(
 				"details", details,
 				"issues", issues,
 				"positions", positions,
-			),
+			), err),
 		)
 	}
 
 	return nil
 }
 
+// ValidateDetailed validates a CUE value against a schema using default options
+// and reports every failure as a ValidationIssue.
+//
+// This is a convenience wrapper around ValidateDetailedWithOptions that uses DefaultValidationOptions().
+func ValidateDetailed(ctx context.Context, schema cue.Value, data cue.Value) ([]ValidationIssue, error) {
+	return ValidateDetailedWithOptions(ctx, schema, data, DefaultValidationOptions())
+}
+
+// ValidateDetailedWithOptions validates a CUE value against a schema with custom
+// options and reports every failure as a ValidationIssue, including the expected
+// constraint from the schema and the actual value from the data.
+//
+// Unlike ValidateWithOptions, failures of the data are not returned as an error:
+// the returned slice is empty when validation succeeds. An error is returned only
+// when validation cannot be performed.
+//
+// Returns CodeCUEValidationFailed if the context is cancelled or the schema is invalid.
+// Returns CodeTimeout if evaluation exceeds the limits set with WithEvalLimits.
+//
+// Example:
+//
+//	issues, err := cue.ValidateDetailed(ctx, schema, data)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, issue := range issues {
+//	    fmt.Printf("%s: %s (expected %s, got %s)\n",
+//	        strings.Join(issue.Path, "."), issue.Message, issue.Expected, issue.Actual)
+//	}
+func ValidateDetailedWithOptions(ctx context.Context, schema cue.Value, data cue.Value, opts ValidationOptions) ([]ValidationIssue, error) {
+	// Check context cancellation
+	if err := ctx.Err(); err != nil {
+		return nil, wrapValidationErrorWithContext(err, "context cancelled", nil)
+	}
+
+	// An invalid schema is a caller error rather than an issue with the data
+	if err := schema.Err(); err != nil {
+		return nil, wrapValidationErrorWithContext(
+			err,
+			"schema is invalid",
+			makeContext(
+				"schema_error", cueerrors.Details(err, nil),
+				"issues", extractValidationIssues(err),
+			),
+		)
+	}
+
+	if err := data.Err(); err != nil {
+		return describeValidationIssues(extractValidationIssues(err), schema, data), nil
+	}
+
+	// Validate the unified result within the evaluation limits
+	unified := schema.Unify(data)
+	var validationErr error
+	if err := guarded(ctx, "validation", unified, wrapValidationError, func() {
+		validationErr = unified.Validate(opts.cueOptions()...)
+	}); err != nil {
+		return nil, err
+	}
+	if err := opts.filterErrors(validationErr, schema); err != nil {
+		return describeValidationIssues(extractValidationIssues(err), schema, data), nil
+	}
+
+	return []ValidationIssue{}, nil
+}
+
+// describeValidationIssues fills in the expected constraint and actual value
+// of each issue by looking up its path in the schema and the data.
+func describeValidationIssues(issues []ValidationIssue, schema cue.Value, data cue.Value) []ValidationIssue {
+	for i := range issues {
+		if expected := lookupLabels(schema, issues[i].Path); expected.Exists() {
+			issues[i].Expected = fmt.Sprint(expected)
+		}
+		if actual := lookupLabels(data, issues[i].Path); actual.Exists() {
+			issues[i].Actual = fmt.Sprint(actual)
+		}
+	}
+
+	return issues
+}
+
+// lookupLabels looks up the value at the field path reported by a CUE error.
+// Numeric labels select list elements when the parent is a list.
+func lookupLabels(value cue.Value, labels []string) cue.Value {
+	for _, label := range labels {
+		var sel cue.Selector
+		index, err := strconv.Atoi(label)
+		switch {
+		case err == nil && value.IncompleteKind() == cue.ListKind:
+			sel = cue.Index(index)
+		case strings.HasPrefix(label, "#"):
+			sel = cue.Def(label)
+		default:
+			sel = cue.Str(label)
+		}
+
+		value = value.LookupPath(cue.MakePath(sel))
+		if !value.Exists() {
+			return value
+		}
+	}
+
+	return value
+}
+
+// inOptionalField reports whether the field path passes through a field that
+// is optional in schema.
+func inOptionalField(schema cue.Value, labels []string) bool {
+	value := schema
+	for _, label := range labels {
+		if value.IncompleteKind() == cue.StructKind {
+			if fields, err := listSchemaFields(value); err == nil {
+				for _, field := range fields {
+					if field.label == label && field.optional {
+						return true
+					}
+				}
+			}
+		}
+
+		value = lookupLabels(value, []string{label})
+		if !value.Exists() {
+			return false
+		}
+	}
+
+	return false
+}
+
+// pathLabels splits a field path in CUE syntax into labels. Paths CUE cannot
+// parse are split on dots.
+func pathLabels(path string) []string {
+	cuePath := cue.ParsePath(path)
+	if cuePath.Err() != nil {
+		return strings.Split(path, ".")
+	}
+
+	selectors := cuePath.Selectors()
+	labels := make([]string, len(selectors))
+	for i, sel := range selectors {
+		labels[i] = sel.String()
+	}
+	return labels
+}
+
+// hasPathPrefix reports whether path starts with any of the prefixes.
+func hasPathPrefix(path []string, prefixes [][]string) bool {
+	for _, prefix := range prefixes {
+		if len(prefix) > len(path) {
+			continue
+		}
+		matched := true
+		for i, label := range prefix {
+			if path[i] != label {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 // extractValidationIssues extracts structured validation issues from a CUE error.
 // Uses the cue/errors package to properly parse error information.
 func extractValidationIssues(err error) []ValidationIssue {
@@ -178,6 +487,7 @@ func ValidateConstraint(ctx context.Context, value cue.Value, constraint cue.Val
 // ValidateConstraintWithOptions validates that a value satisfies a specific constraint.
 // This is a helper function for validating individual constraints with custom options.
 // Returns nil if the constraint is satisfied.
+// Returns CodeTimeout if evaluation exceeds the limits set with WithEvalLimits.
 func ValidateConstraintWithOptions(ctx context.Context, value cue.Value, constraint cue.Value, opts ValidationOptions) error {
 	// Check context cancellation
 	if err := ctx.Err(); err != nil {
@@ -188,21 +498,18 @@ func ValidateConstraintWithOptions(ctx context.Context, value cue.Value, constra
 	unified := value.Unify(constraint)
 
 	// Build validation options for CUE
-	var cueOpts []cue.Option
-	if opts.Concrete {
-		cueOpts = append(cueOpts, cue.Concrete(true))
-	}
-	if opts.Final {
-		cueOpts = append(cueOpts, cue.Final())
-	}
-	if opts.All {
-		cueOpts = append(cueOpts, cue.All())
-	}
+	cueOpts := opts.cueOptions()
 
-	// Validate the unified result
+	// Validate the unified result within the evaluation limits
 	// Note: We skip the unified.Err() check and go straight to Validate()
 	// so that the All option can collect all errors at once.
-	if err := unified.Validate(cueOpts...); err != nil {
+	var validationErr error
+	if err := guarded(ctx, "constraint validation", unified, wrapValidationError, func() {
+		validationErr = unified.Validate(cueOpts...)
+	}); err != nil {
+		return err
+	}
+	if err := opts.filterErrors(validationErr, constraint); err != nil {
 		details := cueerrors.Details(err, nil)
 		issues := extractValidationIssues(err)
 		positions := cueerrors.Positions(err)
@@ -210,11 +517,11 @@ func ValidateConstraintWithOptions(ctx context.Context, value cue.Value, constra
 		return wrapValidationErrorWithContext(
 			err,
 			"constraint validation failed",
-			makeContext(
+			opts.withExcerpts(makeContext(
 				"details", details,
 				"issues", issues,
 				"positions", positions,
-			),
+			), err),
 		)
 	}
 
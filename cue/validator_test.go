@@ -2,12 +2,14 @@ package cue
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
 
 	"cuelang.org/go/cue/cuecontext"
 	platformerrors "github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/fs/billy"
 )
 
 func TestValidate_Success(t *testing.T) {
@@ -721,3 +723,298 @@ func TestValidateConstraintWithOptions(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateDetailed(t *testing.T) {
+	ctx := context.Background()
+	cueCtx := cuecontext.New()
+
+	schema := cueCtx.CompileString(`{
+		name: string
+		age: int & >=0 & <=150
+		tags: [...string]
+	}`)
+	if err := schema.Err(); err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+
+	t.Run("reports expected and actual values", func(t *testing.T) {
+		data := cueCtx.CompileString(`{
+			name: "alice"
+			age: 200
+			tags: ["a", 1]
+		}`)
+		if err := data.Err(); err != nil {
+			t.Fatalf("failed to compile data: %v", err)
+		}
+
+		issues, err := ValidateDetailed(ctx, schema, data)
+		if err != nil {
+			t.Fatalf("ValidateDetailed failed: %v", err)
+		}
+
+		byPath := make(map[string]ValidationIssue)
+		for _, issue := range issues {
+			byPath[strings.Join(issue.Path, ".")] = issue
+		}
+
+		age, ok := byPath["age"]
+		if !ok {
+			t.Fatalf("expected issue for age, got %+v", issues)
+		}
+		if !strings.Contains(age.Expected, "<=150") {
+			t.Errorf("expected constraint to mention <=150, got %q", age.Expected)
+		}
+		if age.Actual != "200" {
+			t.Errorf("expected actual value 200, got %q", age.Actual)
+		}
+
+		tag, ok := byPath["tags.1"]
+		if !ok {
+			t.Fatalf("expected issue for tags.1, got %+v", issues)
+		}
+		if tag.Actual != "1" {
+			t.Errorf("expected actual value 1, got %q", tag.Actual)
+		}
+	})
+
+	t.Run("returns no issues for valid data", func(t *testing.T) {
+		data := cueCtx.CompileString(`{
+			name: "alice"
+			age: 30
+			tags: ["a"]
+		}`)
+
+		issues, err := ValidateDetailed(ctx, schema, data)
+		if err != nil {
+			t.Fatalf("ValidateDetailed failed: %v", err)
+		}
+		if len(issues) != 0 {
+			t.Errorf("expected no issues, got %+v", issues)
+		}
+	})
+
+	t.Run("returns error for invalid schema", func(t *testing.T) {
+		invalid := cueCtx.CompileString(`{ a: `)
+		data := cueCtx.CompileString(`{ a: 1 }`)
+
+		_, err := ValidateDetailed(ctx, invalid, data)
+		if err == nil {
+			t.Fatal("expected error for invalid schema")
+		}
+
+		var platformErr platformerrors.PlatformError
+		if !errors.As(err, &platformErr) {
+			t.Fatalf("expected PlatformError, got %T", err)
+		}
+		if platformErr.Code() != platformerrors.CodeCUEValidationFailed {
+			t.Errorf("expected code %s, got %s", platformerrors.CodeCUEValidationFailed, platformErr.Code())
+		}
+	})
+}
+
+func TestToJSON(t *testing.T) {
+	t.Run("encodes issues", func(t *testing.T) {
+		data, err := ToJSON([]ValidationIssue{{
+			Path:     []string{"user", "age"},
+			Message:  "invalid value 200 (out of bound <=150)",
+			Expected: "int & >=0 & <=150",
+			Actual:   "200",
+		}})
+		if err != nil {
+			t.Fatalf("ToJSON failed: %v", err)
+		}
+
+		var decoded []map[string]interface{}
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("failed to decode JSON: %v", err)
+		}
+		if len(decoded) != 1 {
+			t.Fatalf("expected 1 issue, got %d", len(decoded))
+		}
+
+		issue := decoded[0]
+		if path, _ := issue["path"].([]interface{}); len(path) != 2 || path[0] != "user" || path[1] != "age" {
+			t.Errorf("unexpected path: %v", issue["path"])
+		}
+		if issue["expected"] != "int & >=0 & <=150" {
+			t.Errorf("unexpected expected constraint: %v", issue["expected"])
+		}
+		if issue["actual"] != "200" {
+			t.Errorf("unexpected actual value: %v", issue["actual"])
+		}
+		if _, ok := issue["position"]; ok {
+			t.Error("expected unknown position to be omitted")
+		}
+	})
+
+	t.Run("encodes nil as empty array", func(t *testing.T) {
+		data, err := ToJSON(nil)
+		if err != nil {
+			t.Fatalf("ToJSON failed: %v", err)
+		}
+		if string(data) != "[]" {
+			t.Errorf("expected [], got %s", data)
+		}
+	})
+}
+
+func TestValidateWithOptions_Filters(t *testing.T) {
+	ctx := context.Background()
+	cueCtx := cuecontext.New()
+
+	schema := cueCtx.CompileString(`{
+		name: string
+		spec: {
+			image: string & =~"^[a-z]+:[0-9.]+$"
+			replicas: int & >0
+		}
+		annotations?: [string]: string & !=""
+	}`)
+	if err := schema.Err(); err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+
+	t.Run("partial options allow incomplete values", func(t *testing.T) {
+		data := cueCtx.CompileString(`{name: "api", spec: {replicas: 2}}`)
+
+		if err := ValidateWithOptions(ctx, schema, data, PartialValidationOptions()); err != nil {
+			t.Errorf("expected partial validation to succeed, got: %v", err)
+		}
+		if err := Validate(ctx, schema, data); err == nil {
+			t.Error("expected full validation to fail for incomplete data")
+		}
+	})
+
+	t.Run("partial options still reject invalid values", func(t *testing.T) {
+		data := cueCtx.CompileString(`{name: "api", spec: {replicas: 0}}`)
+
+		if err := ValidateWithOptions(ctx, schema, data, PartialValidationOptions()); err == nil {
+			t.Error("expected partial validation to fail for constraint violation")
+		}
+	})
+
+	t.Run("exempt paths ignore nested errors", func(t *testing.T) {
+		data := cueCtx.CompileString(`{name: "api", spec: {image: "PLACEHOLDER", replicas: 2}}`)
+
+		opts := DefaultValidationOptions()
+		if err := ValidateWithOptions(ctx, schema, data, opts); err == nil {
+			t.Fatal("expected validation to fail without exemptions")
+		}
+
+		opts.ExemptPaths = []string{"spec.image"}
+		if err := ValidateWithOptions(ctx, schema, data, opts); err != nil {
+			t.Errorf("expected exempt path to be ignored, got: %v", err)
+		}
+
+		opts.ExemptPaths = []string{"spec"}
+		if err := ValidateWithOptions(ctx, schema, data, opts); err != nil {
+			t.Errorf("expected errors below exempt path to be ignored, got: %v", err)
+		}
+	})
+
+	t.Run("exempt paths keep other errors", func(t *testing.T) {
+		data := cueCtx.CompileString(`{name: "api", spec: {image: "PLACEHOLDER", replicas: 0}}`)
+
+		opts := DefaultValidationOptions()
+		opts.ExemptPaths = []string{"spec.image"}
+		err := ValidateWithOptions(ctx, schema, data, opts)
+		if err == nil {
+			t.Fatal("expected validation to fail for non-exempt path")
+		}
+		if !strings.Contains(err.Error(), "validation failed") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ignore optional skips optional field errors", func(t *testing.T) {
+		data := cueCtx.CompileString(`{name: "api", spec: {image: "api:1.0", replicas: 2}, annotations: {owner: ""}}`)
+
+		opts := DefaultValidationOptions()
+		if err := ValidateWithOptions(ctx, schema, data, opts); err == nil {
+			t.Fatal("expected validation to fail for optional field")
+		}
+
+		opts.IgnoreOptional = true
+		if err := ValidateWithOptions(ctx, schema, data, opts); err != nil {
+			t.Errorf("expected optional field errors to be ignored, got: %v", err)
+		}
+	})
+
+	t.Run("detailed validation applies filters", func(t *testing.T) {
+		data := cueCtx.CompileString(`{name: "api", spec: {image: "PLACEHOLDER", replicas: 2}}`)
+
+		opts := DefaultValidationOptions()
+		opts.ExemptPaths = []string{"spec.image"}
+		issues, err := ValidateDetailedWithOptions(ctx, schema, data, opts)
+		if err != nil {
+			t.Fatalf("ValidateDetailedWithOptions failed: %v", err)
+		}
+		if len(issues) != 0 {
+			t.Errorf("expected no issues, got %+v", issues)
+		}
+	})
+}
+
+func TestValidateWithOptions_Excerpts(t *testing.T) {
+	ctx := context.Background()
+
+	mfs := billy.NewMemory()
+	if err := mfs.WriteFile("config/app.cue", []byte("package config\n\nspec: {\n\tname:     \"api\"\n\treplicas: -1\n}\n"), 0644); err != nil {
+		t.Fatalf("failed to create config file: %v", err)
+	}
+
+	loader := NewLoader(mfs)
+	data, err := loader.LoadFile(ctx, "config/app.cue")
+	if err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+	schema := loader.Context().CompileString(`spec: {name: string, replicas: int & >0}`)
+
+	t.Run("includes excerpts when sources are set", func(t *testing.T) {
+		opts := DefaultValidationOptions()
+		opts.Sources = mfs
+
+		err := ValidateWithOptions(ctx, schema, data, opts)
+		if err == nil {
+			t.Fatal("expected validation error")
+		}
+
+		var perr platformerrors.PlatformError
+		if !errors.As(err, &perr) {
+			t.Fatalf("expected PlatformError, got %T", err)
+		}
+
+		excerpts, ok := perr.Context()["excerpts"].([]string)
+		if !ok || len(excerpts) == 0 {
+			t.Fatalf("expected excerpts in context, got %v", perr.Context())
+		}
+
+		var found bool
+		for _, excerpt := range excerpts {
+			if strings.HasPrefix(excerpt, "config/app.cue:5:") &&
+				strings.Contains(excerpt, "5 |     replicas: -1") &&
+				strings.Contains(excerpt, "4 |     name:") &&
+				strings.HasSuffix(excerpt, "^") {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected excerpt pointing at replicas line, got:\n%s", strings.Join(excerpts, "\n\n"))
+		}
+	})
+
+	t.Run("omits excerpts without sources", func(t *testing.T) {
+		err := Validate(ctx, schema, data)
+		if err == nil {
+			t.Fatal("expected validation error")
+		}
+
+		var perr platformerrors.PlatformError
+		if !errors.As(err, &perr) {
+			t.Fatalf("expected PlatformError, got %T", err)
+		}
+		if _, ok := perr.Context()["excerpts"]; ok {
+			t.Errorf("expected no excerpts without sources, got %v", perr.Context()["excerpts"])
+		}
+	})
+}
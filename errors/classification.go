@@ -40,6 +40,7 @@ var defaultClassifications = map[ErrorCode]ErrorClassification{
 	CodeInvalidConfig:  ClassificationPermanent,
 	CodeSchemaFailed:   ClassificationPermanent,
 	CodeNotImplemented: ClassificationPermanent,
+	CodeCanceled:       ClassificationPermanent, // The caller gave up
 
 	// Execution errors (permanent by default, but context-dependent)
 	CodeExecutionFailed: ClassificationPermanent,
@@ -62,10 +63,14 @@ var defaultClassifications = map[ErrorCode]ErrorClassification{
 }
 
 // getDefaultClassification returns the default classification for an error code.
-// Returns ClassificationPermanent if the code is not in the map (safe default).
+// Custom codes use the classification they were registered with.
+// Returns ClassificationPermanent if the code is unknown (safe default).
 func getDefaultClassification(code ErrorCode) ErrorClassification {
 	if class, ok := defaultClassifications[code]; ok {
 		return class
 	}
+	if def, ok := LookupCode(code); ok {
+		return def.Classification
+	}
 	return ClassificationPermanent // Safe default
 }
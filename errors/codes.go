@@ -52,6 +52,9 @@ const (
 	// CodeRateLimit indicates the rate limit has been exceeded.
 	CodeRateLimit ErrorCode = "RATE_LIMIT_EXCEEDED"
 
+	// CodeCanceled indicates the operation was canceled by the caller.
+	CodeCanceled ErrorCode = "CANCELED"
+
 	// Execution errors.
 
 	// CodeExecutionFailed indicates a general execution failure.
@@ -15,6 +15,7 @@ func New(code ErrorCode, message string) PlatformError {
 		message:        message,
 		context:        nil,
 		cause:          nil,
+		stack:          captureStack(nil, 1),
 	}
 }
 
@@ -31,5 +32,6 @@ func Newf(code ErrorCode, format string, args ...interface{}) PlatformError {
 		message:        fmt.Sprintf(format, args...),
 		context:        nil,
 		cause:          nil,
+		stack:          captureStack(nil, 1),
 	}
 }
@@ -1,6 +1,9 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"math"
+)
 
 // WithContext adds a single context field to an error.
 // Returns a new PlatformError with the context field added.
@@ -33,21 +36,13 @@ func WithContext(err error, key string, value interface{}) PlatformError {
 	}
 
 	// Create new context with existing fields plus new field
-	newContext := make(map[string]interface{})
-	if existingCtx := platformErr.Context(); existingCtx != nil {
-		for k, v := range existingCtx {
-			newContext[k] = v
-		}
+	result := clone(platformErr)
+	if result.context == nil {
+		result.context = make(map[string]interface{})
 	}
-	newContext[key] = value
+	result.context[key] = value
 
-	return &platformError{
-		code:           platformErr.Code(),
-		classification: platformErr.Classification(),
-		message:        platformErr.Message(),
-		context:        newContext,
-		cause:          platformErr.Unwrap(),
-	}
+	return result
 }
 
 // WithContextMap adds multiple context fields to an error.
@@ -82,24 +77,16 @@ func WithContextMap(err error, ctx map[string]interface{}) PlatformError {
 	}
 
 	// Merge existing context with new context
-	newContext := make(map[string]interface{})
-	if existingCtx := platformErr.Context(); existingCtx != nil {
-		for k, v := range existingCtx {
-			newContext[k] = v
-		}
+	result := clone(platformErr)
+	if result.context == nil {
+		result.context = make(map[string]interface{}, len(ctx))
 	}
 	// New fields override existing
 	for k, v := range ctx {
-		newContext[k] = v
+		result.context[k] = v
 	}
 
-	return &platformError{
-		code:           platformErr.Code(),
-		classification: platformErr.Classification(),
-		message:        platformErr.Message(),
-		context:        newContext,
-		cause:          platformErr.Unwrap(),
-	}
+	return result
 }
 
 // WithClassification overrides the classification of an error.
@@ -134,19 +121,172 @@ func WithClassification(err error, classification ErrorClassification) PlatformE
 	}
 
 	// Copy context to preserve immutability
-	var newContext map[string]interface{}
-	if existingCtx := platformErr.Context(); existingCtx != nil {
-		newContext = make(map[string]interface{}, len(existingCtx))
-		for k, v := range existingCtx {
-			newContext[k] = v
+	result := clone(platformErr)
+	result.classification = classification
+
+	return result
+}
+
+// Redact marks context fields as sensitive. Redacted fields are excluded from
+// ToJSON and JSON serialization, but remain available through Context and the
+// typed getters for internal logging.
+//
+// Redaction carries over when context is added to the error later. Wrapping
+// the error starts a new context, which is not redacted.
+//
+// If err is not a PlatformError, it is converted to one with CodeUnknown.
+// Returns nil if err is nil.
+//
+// Example:
+//
+//	err := errors.WithContextMap(err, map[string]interface{}{
+//	    "user_id": userID,
+//	    "email":   email,
+//	})
+//	err = errors.Redact(err, "email")
+func Redact(err error, keys ...string) PlatformError {
+	if err == nil {
+		return nil
+	}
+
+	// Convert to PlatformError if needed
+	var platformErr PlatformError
+	if !errors.As(err, &platformErr) {
+		platformErr = &platformError{
+			code:           CodeUnknown,
+			classification: ClassificationPermanent,
+			message:        err.Error(),
+			context:        nil,
+			cause:          err,
 		}
 	}
 
-	return &platformError{
-		code:           platformErr.Code(),
-		classification: classification,
-		message:        platformErr.Message(),
-		context:        newContext,
-		cause:          platformErr.Unwrap(),
+	// Copy redacted keys to preserve immutability
+	result := clone(platformErr)
+	redacted := make(map[string]struct{}, len(result.redacted)+len(keys))
+	for key := range result.redacted {
+		redacted[key] = struct{}{}
+	}
+	for _, key := range keys {
+		redacted[key] = struct{}{}
+	}
+	result.redacted = redacted
+
+	return result
+}
+
+// IsRedacted reports whether a context field of err has been marked as
+// sensitive with Redact.
+func IsRedacted(err error, key string) bool {
+	var platformErr *platformError
+	if !errors.As(err, &platformErr) {
+		return false
+	}
+	_, ok := platformErr.redacted[key]
+	return ok
+}
+
+// GetContextString returns a string context field of err.
+// Returns false if the field is missing or not a string.
+//
+// Example:
+//
+//	if project, ok := errors.GetContextString(err, "project"); ok {
+//	    log.Printf("build failed for %s", project)
+//	}
+func GetContextString(err error, key string) (string, bool) {
+	value, ok := getContextValue(err, key)
+	if !ok {
+		return "", false
+	}
+	s, ok := value.(string)
+	return s, ok
+}
+
+// GetContextInt returns an integer context field of err.
+// Any integer type is accepted, as are whole float64 values such as numbers
+// decoded from JSON. Returns false if the field is missing, not a number, or
+// does not fit in an int.
+//
+// Example:
+//
+//	if exitCode, ok := errors.GetContextInt(err, "exit_code"); ok {
+//	    os.Exit(exitCode)
+//	}
+func GetContextInt(err error, key string) (int, bool) {
+	value, ok := getContextValue(err, key)
+	if !ok {
+		return 0, false
+	}
+
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		if int64(int(v)) != v {
+			return 0, false
+		}
+		return int(v), true
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		if uint64(v) > math.MaxInt {
+			return 0, false
+		}
+		return int(v), true
+	case uint:
+		if uint64(v) > math.MaxInt {
+			return 0, false
+		}
+		return int(v), true
+	case uint64:
+		if v > math.MaxInt {
+			return 0, false
+		}
+		return int(v), true
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt || v >= math.MaxInt {
+			return 0, false
+		}
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
+
+// getContextValue returns a context field of the outermost PlatformError in
+// err's chain.
+func getContextValue(err error, key string) (interface{}, bool) {
+	var platformErr PlatformError
+	if !errors.As(err, &platformErr) {
+		return nil, false
+	}
+	value, ok := platformErr.Context()[key]
+	return value, ok
+}
+
+// publicContext returns the context of err without redacted fields, for
+// serialization. Returns nil if no fields remain.
+func publicContext(err PlatformError) map[string]interface{} {
+	ctx := err.Context()
+	e, ok := err.(*platformError)
+	if !ok || len(e.redacted) == 0 {
+		return ctx
+	}
+
+	for key := range e.redacted {
+		delete(ctx, key)
+	}
+	if len(ctx) == 0 {
+		return nil
 	}
+	return ctx
 }
@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"encoding/json"
 	stderrors "errors"
 	"testing"
 
@@ -140,3 +141,112 @@ func TestWithClassification_PreservesContext(t *testing.T) {
 
 	require.Equal(t, "value", err.Context()["key"])
 }
+
+func TestGetContextString(t *testing.T) {
+	err := WithContextMap(New(CodeBuildFailed, "build failed"), map[string]interface{}{
+		"project":   "api",
+		"exit_code": 1,
+	})
+
+	project, ok := GetContextString(err, "project")
+	require.True(t, ok)
+	require.Equal(t, "api", project)
+
+	_, ok = GetContextString(err, "exit_code")
+	require.False(t, ok)
+
+	_, ok = GetContextString(err, "missing")
+	require.False(t, ok)
+
+	_, ok = GetContextString(stderrors.New("standard error"), "project")
+	require.False(t, ok)
+}
+
+func TestGetContextInt(t *testing.T) {
+	inner := WithContextMap(New(CodeExecutionFailed, "failed"), map[string]interface{}{
+		"int":      1,
+		"int64":    int64(2),
+		"uint16":   uint16(3),
+		"json":     float64(4),
+		"fraction": 4.5,
+		"overflow": uint64(1 << 63),
+		"string":   "5",
+	})
+	for key, want := range map[string]int{"int": 1, "int64": 2, "uint16": 3, "json": 4} {
+		value, ok := GetContextInt(inner, key)
+		require.True(t, ok, key)
+		require.Equal(t, want, value, key)
+	}
+	for _, key := range []string{"fraction", "overflow", "string", "missing"} {
+		_, ok := GetContextInt(inner, key)
+		require.False(t, ok, key)
+	}
+
+	// Only the outermost context is visible through wrappers
+	wrapped := WithContext(Wrap(inner, CodeInternal, "wrapped"), "attempt", 2)
+	attempt, ok := GetContextInt(wrapped, "attempt")
+	require.True(t, ok)
+	require.Equal(t, 2, attempt)
+	_, ok = GetContextInt(wrapped, "int")
+	require.False(t, ok)
+}
+
+func TestRedact(t *testing.T) {
+	err := WithContextMap(New(CodeUnauthorized, "login failed"), map[string]interface{}{
+		"user_id": 42,
+		"email":   "user@example.com",
+	})
+	err = Redact(err, "email")
+
+	// Redacted fields remain available internally
+	email, ok := GetContextString(err, "email")
+	require.True(t, ok)
+	require.Equal(t, "user@example.com", email)
+	require.True(t, IsRedacted(err, "email"))
+	require.False(t, IsRedacted(err, "user_id"))
+
+	// But are excluded from serialization
+	response := ToJSON(err)
+	require.Equal(t, map[string]interface{}{"user_id": 42}, response.Context)
+
+	data, marshalErr := json.Marshal(err)
+	require.NoError(t, marshalErr)
+	require.NotContains(t, string(data), "user@example.com")
+}
+
+func TestRedact_PreservedByContextHelpers(t *testing.T) {
+	err := Redact(WithContext(New(CodeInternal, "failed"), "token", "secret"), "token")
+	err = WithContext(err, "attempt", 1)
+	err = WithClassification(err, ClassificationRetryable)
+
+	require.True(t, IsRedacted(err, "token"))
+	require.Equal(t, map[string]interface{}{"attempt": 1}, ToJSON(err).Context)
+}
+
+func TestRedact_AllFieldsOmitsContext(t *testing.T) {
+	err := Redact(WithContext(New(CodeInternal, "failed"), "token", "secret"), "token")
+
+	require.Nil(t, ToJSON(err).Context)
+}
+
+func TestRedact_Immutability(t *testing.T) {
+	original := WithContextMap(New(CodeInternal, "failed"), map[string]interface{}{"a": 1, "b": 2})
+	first := Redact(original, "a")
+	second := Redact(first, "b")
+
+	require.False(t, IsRedacted(original, "a"))
+	require.False(t, IsRedacted(first, "b"))
+	require.True(t, IsRedacted(second, "a"))
+	require.True(t, IsRedacted(second, "b"))
+}
+
+func TestRedact_StandardError(t *testing.T) {
+	err := Redact(stderrors.New("standard error"), "key")
+
+	require.Equal(t, CodeUnknown, err.Code())
+	require.True(t, IsRedacted(err, "key"))
+}
+
+func TestRedact_NilError(t *testing.T) {
+	require.Nil(t, Redact(nil, "key"))
+}
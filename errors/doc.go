@@ -12,6 +12,7 @@
 //   - Context metadata attachment for debugging
 //   - Error wrapping that preserves the error chain
 //   - JSON serialization for API responses
+//   - Optional stack trace capture for locating error origins
 //   - Zero dependencies (Layer 0 library)
 //
 // # Design Principles
@@ -58,10 +59,17 @@
 //	func handleError(w http.ResponseWriter, err error) {
 //	    response := errors.ToJSON(err)
 //	    w.Header().Set("Content-Type", "application/json")
-//	    w.WriteHeader(getHTTPStatus(errors.GetCode(err)))
+//	    w.WriteHeader(errors.HTTPStatus(err))
 //	    json.NewEncoder(w).Encode(response)
 //	}
 //
+// Parsing errors from other services:
+//
+//	remoteErr, err := errors.FromJSON(body)
+//	if err == nil && errors.IsRetryable(remoteErr) {
+//	    return retry(operation)
+//	}
+//
 // # Error Codes
 //
 // The library provides predefined error codes for all common platform scenarios:
@@ -69,7 +77,7 @@
 //   - Resource errors: CodeNotFound, CodeAlreadyExists, CodeConflict
 //   - Permission errors: CodeUnauthorized, CodeForbidden
 //   - Validation errors: CodeInvalidInput, CodeInvalidConfig, CodeSchemaFailed
-//   - Infrastructure errors: CodeDatabase, CodeNetwork, CodeTimeout, CodeRateLimit
+//   - Infrastructure errors: CodeDatabase, CodeNetwork, CodeTimeout, CodeRateLimit, CodeCanceled
 //   - Execution errors: CodeExecutionFailed, CodeBuildFailed, CodePublishFailed
 //   - System errors: CodeInternal, CodeNotImplemented, CodeUnavailable
 //   - Generic: CodeUnknown
@@ -77,6 +85,23 @@
 // Each error code has a default classification (retryable or permanent) that can
 // be overridden when needed.
 //
+// Modules can register custom codes with RegisterCode. Custom codes are
+// namespaced ("git/worktree_locked") to avoid collisions, and carry their own
+// default classification and status codes:
+//
+//	var CodeWorktreeLocked = errors.MustRegisterCode(errors.CodeDefinition{
+//	    Code:           "git/worktree_locked",
+//	    Classification: errors.ClassificationRetryable,
+//	    HTTPStatus:     http.StatusConflict,
+//	})
+//
+// NewDomain returns constructors for a single module that attach a "domain"
+// context field and namespace custom codes with the domain name:
+//
+//	var gitErrors = errors.NewDomain("git")
+//
+//	err := gitErrors.Wrap(err, "worktree_locked", "checkout failed")
+//
 // # Error Classification
 //
 // Errors are classified as either retryable or permanent:
@@ -87,6 +112,17 @@
 // Use errors.IsRetryable(err) to make retry decisions. The classification is
 // preserved when wrapping errors and can be overridden with WithClassification.
 //
+// Servers often say how long to wait before retrying. WithRetryAfter attaches
+// such a hint, and retry helpers read it with GetRetryAfter:
+//
+//	err = errors.WithRetryAfter(err, 30*time.Second)
+//
+// Context errors are easy to misclassify. FromContext turns a deadline into a
+// retryable CodeTimeout error and a cancellation into a permanent CodeCanceled
+// error, and IsTimeout and IsCanceled recognize both in any error chain:
+//
+//	return errors.FromContext(ctx.Err())
+//
 // # Standard Library Compatibility
 //
 // PlatformError implements the error interface and works seamlessly with standard
@@ -121,6 +157,49 @@
 // Context is included in JSON serialization but not in error chains exposed
 // to external callers (security).
 //
+// Read context fields with GetContextString and GetContextInt. Fields that
+// hold personal or secret data can be marked with Redact, which excludes them
+// from JSON serialization while keeping them available for internal logging:
+//
+//	err = errors.Redact(err, "email")
+//
+// # Structured Logging
+//
+// Errors created by this package implement slog.LogValuer, so errors logged
+// with slog are expanded into their code, classification, message, context,
+// wrapped errors, and stack trace. Attrs returns the same attributes for any
+// error:
+//
+//	logger.LogAttrs(ctx, slog.LevelError, "build failed", errors.Attrs(err)...)
+//
+// # Status Codes
+//
+// HTTPStatus and GRPCStatus map errors to transport status codes based on
+// their error code, and FromGRPC converts errors returned by gRPC clients back
+// into PlatformErrors. GRPCCode values match google.golang.org/grpc/codes:
+//
+//	return status.Error(codes.Code(errors.GRPCStatus(err)), err.Error())
+//
+// # Stack Traces
+//
+// Stack traces are not captured by default. Enable them once at startup to
+// record where New, Newf, and the Wrap functions create errors:
+//
+//	errors.EnableStackTraces(errors.DefaultStackDepth)
+//
+// Wrapping an error that already has a stack trace keeps the original one, so
+// StackTrace reports the origin of the error. WithStack records a stack trace
+// for a single error. Frames are printed by the %+v verb and included in JSON
+// serialization.
+//
+// Recover and WrapPanics convert panics into PlatformErrors that always carry
+// the stack trace of the panic:
+//
+//	func process(job Job) (err error) {
+//	    defer errors.Recover(&err, errors.CodeInternal)
+//	    return handle(job)
+//	}
+//
 // # Best Practices
 //
 //   - Always wrap errors with context: errors.Wrap(err, code, message)
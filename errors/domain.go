@@ -0,0 +1,137 @@
+package errors
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// domainName matches domain names: lowercase segments separated by slashes.
+var domainName = regexp.MustCompile(`^[a-z][a-z0-9_]*(/[a-z][a-z0-9_]*)*$`)
+
+// Domain creates errors for a single module or subsystem. Errors created by a
+// Domain carry the domain name in their "domain" context field, and custom
+// codes are namespaced with the domain name.
+//
+// Predefined codes such as CodeNotFound are used as is, so errors keep their
+// default classification and status codes. Other codes without a namespace
+// are prefixed with the domain name: a "git" domain turns "worktree_locked"
+// into "git/worktree_locked".
+type Domain struct {
+	name string
+}
+
+// NewDomain returns a Domain for the named module or subsystem. Names are
+// lowercase letters, digits, and underscores, optionally in slash-separated
+// segments such as "oci/signature". Panics if the name is invalid, since
+// domains are declared once at package level.
+//
+// Example:
+//
+//	var gitErrors = errors.NewDomain("git")
+//
+//	var CodeWorktreeLocked = gitErrors.MustRegisterCode(errors.CodeDefinition{
+//	    Code:           "worktree_locked",
+//	    Classification: errors.ClassificationRetryable,
+//	})
+//
+//	func (r *Repo) Checkout(ref string) error {
+//	    if err := r.checkout(ref); err != nil {
+//	        return gitErrors.Wrap(err, CodeWorktreeLocked, "worktree is locked")
+//	    }
+//	    return nil
+//	}
+func NewDomain(name string) *Domain {
+	if !domainName.MatchString(name) {
+		panic(fmt.Sprintf("errors: invalid domain name %q", name))
+	}
+	return &Domain{name: name}
+}
+
+// Name returns the name of the domain.
+func (d *Domain) Name() string {
+	return d.name
+}
+
+// Code returns the error code for a code of this domain, prefixing it with the
+// domain name unless it is predefined or already namespaced.
+//
+// Example:
+//
+//	gitErrors.Code("worktree_locked") // "git/worktree_locked"
+//	gitErrors.Code(errors.CodeNotFound) // "NOT_FOUND"
+func (d *Domain) Code(code ErrorCode) ErrorCode {
+	if _, ok := defaultClassifications[code]; ok || strings.Contains(string(code), "/") {
+		return code
+	}
+	return ErrorCode(d.name + "/" + string(code))
+}
+
+// RegisterCode registers a custom error code of this domain, prefixing the code
+// with the domain name. See the package-level RegisterCode.
+func (d *Domain) RegisterCode(def CodeDefinition) error {
+	def.Code = d.Code(def.Code)
+	return RegisterCode(def)
+}
+
+// MustRegisterCode is like RegisterCode but panics if the code cannot be
+// registered. It returns the prefixed code.
+func (d *Domain) MustRegisterCode(def CodeDefinition) ErrorCode {
+	def.Code = d.Code(def.Code)
+	return MustRegisterCode(def)
+}
+
+// New creates a new PlatformError of this domain.
+// See the package-level New.
+func (d *Domain) New(code ErrorCode, message string) PlatformError {
+	code = d.Code(code)
+	return &platformError{
+		code:           code,
+		classification: getDefaultClassification(code),
+		message:        message,
+		context:        d.context(),
+		stack:          captureStack(nil, 1),
+	}
+}
+
+// Newf creates a new PlatformError of this domain with a formatted message.
+// See the package-level Newf.
+func (d *Domain) Newf(code ErrorCode, format string, args ...interface{}) PlatformError {
+	code = d.Code(code)
+	return &platformError{
+		code:           code,
+		classification: getDefaultClassification(code),
+		message:        fmt.Sprintf(format, args...),
+		context:        d.context(),
+		stack:          captureStack(nil, 1),
+	}
+}
+
+// Wrap wraps an error with an error of this domain.
+// See the package-level Wrap. Returns nil if err is nil.
+func (d *Domain) Wrap(err error, code ErrorCode, message string) PlatformError {
+	if err == nil {
+		return nil
+	}
+
+	result := wrap(err, d.Code(code), message)
+	result.context = d.context()
+	return result
+}
+
+// Wrapf wraps an error with an error of this domain with a formatted message.
+// See the package-level Wrapf. Returns nil if err is nil.
+func (d *Domain) Wrapf(err error, code ErrorCode, format string, args ...interface{}) PlatformError {
+	if err == nil {
+		return nil
+	}
+
+	result := wrap(err, d.Code(code), fmt.Sprintf(format, args...))
+	result.context = d.context()
+	return result
+}
+
+// context returns the context attached to errors of this domain.
+func (d *Domain) context() map[string]interface{} {
+	return map[string]interface{}{"domain": d.name}
+}
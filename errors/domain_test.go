@@ -0,0 +1,94 @@
+package errors
+
+import (
+	stderrors "errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDomain(t *testing.T) {
+	domain := NewDomain("oci/signature")
+	require.Equal(t, "oci/signature", domain.Name())
+}
+
+func TestNewDomain_InvalidName(t *testing.T) {
+	for _, name := range []string{"", "Git", "git/", "/git", "git-worktree"} {
+		require.Panics(t, func() { NewDomain(name) }, name)
+	}
+}
+
+func TestDomain_Code(t *testing.T) {
+	domain := NewDomain("git")
+
+	require.Equal(t, ErrorCode("git/worktree_locked"), domain.Code("worktree_locked"))
+	require.Equal(t, CodeNotFound, domain.Code(CodeNotFound))
+	require.Equal(t, ErrorCode("oci/blob_missing"), domain.Code("oci/blob_missing"))
+}
+
+func TestDomain_New(t *testing.T) {
+	domain := NewDomain("git")
+
+	err := domain.New(CodeNotFound, "repository not found")
+	require.Equal(t, CodeNotFound, err.Code())
+	require.Equal(t, ClassificationPermanent, err.Classification())
+	require.Equal(t, map[string]interface{}{"domain": "git"}, err.Context())
+
+	err = domain.Newf("detached_head", "HEAD is detached at %s", "abc123")
+	require.Equal(t, ErrorCode("git/detached_head"), err.Code())
+	require.Equal(t, "HEAD is detached at abc123", err.Message())
+	require.Equal(t, "git", err.Context()["domain"])
+}
+
+func TestDomain_Wrap(t *testing.T) {
+	domain := NewDomain("git")
+	cause := New(CodeTimeout, "timed out")
+
+	err := domain.Wrap(cause, CodeNetwork, "fetch failed")
+	require.Equal(t, CodeNetwork, err.Code())
+	require.Equal(t, cause, err.Unwrap())
+	require.True(t, err.Classification().IsRetryable())
+	require.Equal(t, "git", err.Context()["domain"])
+
+	err = domain.Wrapf(stderrors.New("exit status 128"), "clone_failed", "failed to clone %s", "repo")
+	require.Equal(t, ErrorCode("git/clone_failed"), err.Code())
+	require.Equal(t, "failed to clone repo", err.Message())
+	require.Equal(t, "git", err.Context()["domain"])
+
+	require.Nil(t, domain.Wrap(nil, CodeNetwork, "fetch failed"))
+	require.Nil(t, domain.Wrapf(nil, CodeNetwork, "fetch %s", "failed"))
+}
+
+func TestDomain_RegisteredCodes(t *testing.T) {
+	domain := NewDomain("domain_test")
+	code := domain.MustRegisterCode(CodeDefinition{
+		Code:           "worktree_locked",
+		Classification: ClassificationRetryable,
+		HTTPStatus:     http.StatusConflict,
+	})
+	require.Equal(t, ErrorCode("domain_test/worktree_locked"), code)
+
+	err := domain.New("worktree_locked", "worktree is locked")
+	require.Equal(t, code, err.Code())
+	require.True(t, IsRetryable(err))
+	require.Equal(t, http.StatusConflict, HTTPStatus(err))
+
+	require.Error(t, domain.RegisterCode(CodeDefinition{Code: "worktree_locked"}))
+}
+
+func TestDomain_Stack(t *testing.T) {
+	enableStackTraces(t)
+	domain := NewDomain("git")
+
+	for name, err := range map[string]PlatformError{
+		"New":   domain.New(CodeInternal, "failed"),
+		"Newf":  domain.Newf(CodeInternal, "failed %d", 1),
+		"Wrap":  domain.Wrap(stderrors.New("cause"), CodeInternal, "failed"),
+		"Wrapf": domain.Wrapf(stderrors.New("cause"), CodeInternal, "failed %d", 1),
+	} {
+		stack := StackTrace(err)
+		require.NotEmpty(t, stack, name)
+		require.Contains(t, stack[0].Function, "TestDomain_Stack", name)
+	}
+}
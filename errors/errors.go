@@ -6,6 +6,10 @@ package errors
 // PlatformError provides error codes for categorization, classification for
 // retry logic, contextual metadata, and compatibility with standard library
 // error handling (errors.Is, errors.As, errors.Unwrap).
+//
+// PlatformErrors created by this package also implement slog.LogValuer, so
+// logging them with slog records their code, classification, and context as
+// structured attributes.
 type PlatformError interface {
 	error
 
@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"context"
 	stderrors "errors"
 )
 
@@ -97,3 +98,92 @@ func GetClassification(err error) ErrorClassification {
 func IsRetryable(err error) bool {
 	return GetClassification(err).IsRetryable()
 }
+
+// FromContext classifies the error of a done context, such as ctx.Err().
+// context.DeadlineExceeded becomes a retryable CodeTimeout error and
+// context.Canceled a permanent CodeCanceled error, since a caller that gave up
+// does not want the operation retried. The original error is preserved as the
+// cause, so errors.Is still matches it. The classification does not depend
+// on PlatformErrors that wrap the context error.
+//
+// PlatformErrors are returned unchanged, and other errors are converted with
+// CodeUnknown. Returns nil if err is nil.
+//
+// Example:
+//
+//	select {
+//	case <-ctx.Done():
+//	    return errors.FromContext(ctx.Err())
+//	case result := <-results:
+//	    return result.err
+//	}
+func FromContext(err error) PlatformError {
+	if err == nil {
+		return nil
+	}
+
+	// The code's own classification applies, even if the chain contains
+	// PlatformErrors classified otherwise
+	switch {
+	case stderrors.Is(err, context.DeadlineExceeded):
+		result := wrap(err, CodeTimeout, "operation timed out")
+		result.classification = ClassificationRetryable
+		return result
+	case stderrors.Is(err, context.Canceled):
+		result := wrap(err, CodeCanceled, "operation canceled")
+		result.classification = ClassificationPermanent
+		return result
+	}
+
+	var platformErr PlatformError
+	if stderrors.As(err, &platformErr) {
+		return platformErr
+	}
+	return &platformError{
+		code:           CodeUnknown,
+		classification: ClassificationPermanent,
+		message:        err.Error(),
+		cause:          err,
+	}
+}
+
+// IsCanceled reports whether err was caused by a canceled operation: it wraps
+// context.Canceled or an error with CodeCanceled.
+//
+// Example:
+//
+//	if errors.IsCanceled(err) {
+//	    return nil // The caller is no longer waiting
+//	}
+func IsCanceled(err error) bool {
+	return stderrors.Is(err, context.Canceled) || hasCode(err, CodeCanceled)
+}
+
+// IsTimeout reports whether err was caused by a timeout: it wraps
+// context.DeadlineExceeded, an error with CodeTimeout, or an error with a
+// Timeout method that reports true, such as network timeouts.
+//
+// Example:
+//
+//	if errors.IsTimeout(err) {
+//	    log.Printf("increase the timeout: %v", err)
+//	}
+func IsTimeout(err error) bool {
+	if stderrors.Is(err, context.DeadlineExceeded) || hasCode(err, CodeTimeout) {
+		return true
+	}
+
+	var timeoutErr interface{ Timeout() bool }
+	return stderrors.As(err, &timeoutErr) && timeoutErr.Timeout()
+}
+
+// hasCode reports whether any PlatformError in err's chain has code.
+func hasCode(err error, code ErrorCode) bool {
+	for err != nil {
+		if platformErr, ok := err.(PlatformError); ok && platformErr.Code() == code { //nolint:errorlint // walks the chain explicitly
+			return true
+		}
+		err = stderrors.Unwrap(err)
+	}
+	return false
+}
@@ -1,8 +1,11 @@
 package errors
 
 import (
+	"context"
 	stderrors "errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -166,3 +169,73 @@ func TestIsRetryable_WithClassificationOverride(t *testing.T) {
 	err = WithClassification(err, ClassificationRetryable)
 	require.True(t, IsRetryable(err))
 }
+
+func TestFromContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	err := FromContext(ctx.Err())
+	require.Equal(t, CodeTimeout, err.Code())
+	require.True(t, IsRetryable(err))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	err = FromContext(ctx.Err())
+	require.Equal(t, CodeCanceled, err.Code())
+	require.False(t, IsRetryable(err))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 499, HTTPStatus(err))
+	require.Equal(t, GRPCCodeCanceled, GRPCStatus(err))
+}
+
+func TestFromContext_WrappedPlatformError(t *testing.T) {
+	err := FromContext(Wrap(context.Canceled, CodeNetwork, "dial failed"))
+	require.Equal(t, CodeCanceled, err.Code())
+	require.False(t, IsRetryable(err))
+	require.ErrorIs(t, err, context.Canceled)
+
+	err = FromContext(Wrap(context.DeadlineExceeded, CodeNotFound, "lookup failed"))
+	require.Equal(t, CodeTimeout, err.Code())
+	require.True(t, IsRetryable(err))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestFromContext_OtherErrors(t *testing.T) {
+	platformErr := New(CodeNotFound, "not found")
+	require.Equal(t, platformErr, FromContext(platformErr))
+
+	stdErr := stderrors.New("boom")
+	err := FromContext(stdErr)
+	require.Equal(t, CodeUnknown, err.Code())
+	require.Equal(t, stdErr, err.Unwrap())
+
+	require.Nil(t, FromContext(nil))
+}
+
+func TestIsCanceled(t *testing.T) {
+	require.True(t, IsCanceled(context.Canceled))
+	require.True(t, IsCanceled(fmt.Errorf("fetch: %w", context.Canceled)))
+	require.True(t, IsCanceled(Wrap(New(CodeCanceled, "canceled"), CodeNetwork, "fetch failed")))
+	require.False(t, IsCanceled(context.DeadlineExceeded))
+	require.False(t, IsCanceled(New(CodeTimeout, "timed out")))
+	require.False(t, IsCanceled(nil))
+}
+
+// timeoutError is a network error that timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "i/o timeout" }
+func (timeoutError) Timeout() bool { return true }
+
+func TestIsTimeout(t *testing.T) {
+	require.True(t, IsTimeout(context.DeadlineExceeded))
+	require.True(t, IsTimeout(New(CodeTimeout, "timed out")))
+	require.True(t, IsTimeout(Wrap(New(CodeTimeout, "timed out"), CodeDatabase, "query failed")))
+	require.True(t, IsTimeout(Wrap(timeoutError{}, CodeNetwork, "dial failed")))
+	require.False(t, IsTimeout(context.Canceled))
+	require.False(t, IsTimeout(New(CodeNetwork, "connection refused")))
+	require.False(t, IsTimeout(nil))
+}
@@ -2,6 +2,7 @@ package errors
 
 import (
 	"encoding/json"
+	stderrors "errors"
 )
 
 // ErrorResponse represents the JSON structure for error responses in API endpoints.
@@ -10,6 +11,7 @@ import (
 //
 // The wrapped error chain is intentionally excluded to prevent information leakage
 // while still providing useful debugging context through the Code, Message, and Context fields.
+// ToJSONWithChain includes it for errors passed between trusted services.
 type ErrorResponse struct {
 	// Code is the error code identifying the type of error.
 	Code string `json:"code"`
@@ -23,18 +25,31 @@ type ErrorResponse struct {
 	// Context contains optional metadata about the error.
 	// Omitted from JSON if empty.
 	Context map[string]interface{} `json:"context,omitempty"`
+
+	// Stack is the stack trace recorded where the error originated.
+	// Omitted from JSON unless stack traces are enabled or WithStack was used.
+	Stack []Frame `json:"stack,omitempty"`
+
+	// Cause is the wrapped error, included only by ToJSONWithChain.
+	// Omitted from JSON if empty.
+	Cause *ErrorResponse `json:"cause,omitempty"`
 }
 
 // ToJSON converts any error to an ErrorResponse suitable for JSON serialization.
 // Returns nil if err is nil.
 //
 // For PlatformError instances, extracts code, message, classification, and context.
+// Context fields marked with Redact are excluded.
 // For standard errors, uses CodeUnknown, ClassificationPermanent, and the error message.
 //
 // The wrapped error chain is intentionally excluded to prevent information leakage.
 // Security consideration: Error chains may contain internal implementation details,
 // stack traces, database queries, file paths, or other sensitive information.
 //
+// The stack trace of the error is included when one was captured (see
+// EnableStackTraces and WithStack). Stack traces reveal source file paths, so
+// clear the Stack field before returning responses to untrusted clients.
+//
 // Example:
 //
 //	func handleError(w http.ResponseWriter, err error) {
@@ -43,7 +58,7 @@ type ErrorResponse struct {
 //	        return // No error
 //	    }
 //	    w.Header().Set("Content-Type", "application/json")
-//	    statusCode := getHTTPStatus(response.Code)
+//	    statusCode := errors.HTTPStatus(err)
 //	    w.WriteHeader(statusCode)
 //	    json.NewEncoder(w).Encode(response)
 //	}
@@ -63,7 +78,7 @@ func ToJSON(err error) *ErrorResponse {
 	var platformErr PlatformError
 	if As(err, &platformErr) {
 		message = platformErr.Message()
-		context = platformErr.Context()
+		context = publicContext(platformErr)
 	}
 
 	return &ErrorResponse{
@@ -71,6 +86,7 @@ func ToJSON(err error) *ErrorResponse {
 		Message:        message,
 		Classification: string(classification),
 		Context:        context,
+		Stack:          StackTrace(err),
 	}
 }
 
@@ -94,7 +110,8 @@ func (e *platformError) MarshalJSON() ([]byte, error) {
 		Code:           string(e.code),
 		Message:        e.message,
 		Classification: string(e.classification),
-		Context:        e.context,
+		Context:        publicContext(e),
+		Stack:          StackTrace(e),
 	}
 	data, err := json.Marshal(response)
 	if err != nil {
@@ -109,3 +126,107 @@ func (e *platformError) MarshalJSON() ([]byte, error) {
 	}
 	return data, nil
 }
+
+// ToJSONWithChain is like ToJSON, but also includes the wrapped errors as
+// nested Cause responses, so FromJSON can restore the full error chain.
+// Returns nil if err is nil.
+//
+// Use it for errors passed between trusted services only: the chain exposes
+// the messages of every wrapped error. A wrapped error that is not a
+// PlatformError, and does not wrap one, ends the chain with CodeUnknown and
+// its full message.
+//
+// Example:
+//
+//	data, err := json.Marshal(errors.ToJSONWithChain(err))
+func ToJSONWithChain(err error) *ErrorResponse {
+	response := ToJSON(err)
+	if response == nil {
+		return nil
+	}
+
+	// Describe wrapped errors without their own stack traces; StackTrace
+	// already reports the innermost one
+	current := response
+	var platformErr PlatformError
+	for stderrors.As(err, &platformErr) {
+		err = platformErr.Unwrap()
+		if err == nil {
+			break
+		}
+		current.Cause = ToJSON(err)
+		current.Cause.Stack = nil
+		current = current.Cause
+	}
+
+	return response
+}
+
+// FromJSON parses an error serialized by ToJSON, ToJSONWithChain, or
+// json.Marshal back into a PlatformError.
+// Returns a CodeInvalidInput error if data is not a valid error response.
+//
+// Example:
+//
+//	resp, err := http.Post(url, "application/json", body)
+//	if resp.StatusCode >= 400 {
+//	    data, _ := io.ReadAll(resp.Body)
+//	    remoteErr, err := errors.FromJSON(data)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    return remoteErr // errors.IsRetryable works as on the server
+//	}
+func FromJSON(data []byte) (PlatformError, error) {
+	var response ErrorResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, Wrap(err, CodeInvalidInput, "failed to parse error response")
+	}
+	if response.Code == "" {
+		return nil, New(CodeInvalidInput, "error response has no code")
+	}
+	return FromResponse(&response), nil
+}
+
+// FromResponse converts an ErrorResponse back into a PlatformError, restoring
+// its code, message, classification, context, and any nested causes.
+// Unknown classifications are replaced with the default classification of the
+// code. Stack traces are not restored, since they belong to another process.
+// Returns nil if response is nil.
+//
+// Context values are restored as decoded by encoding/json; for example,
+// numbers become float64. GetContextInt accepts whole float64 values.
+func FromResponse(response *ErrorResponse) PlatformError {
+	if response == nil {
+		return nil
+	}
+
+	code := ErrorCode(response.Code)
+	if code == "" {
+		code = CodeUnknown
+	}
+
+	classification := ErrorClassification(response.Classification)
+	if classification != ClassificationRetryable && classification != ClassificationPermanent {
+		classification = getDefaultClassification(code)
+	}
+
+	var context map[string]interface{}
+	if len(response.Context) > 0 {
+		context = make(map[string]interface{}, len(response.Context))
+		for k, v := range response.Context {
+			context[k] = v
+		}
+	}
+
+	result := &platformError{
+		code:           code,
+		classification: classification,
+		message:        response.Message,
+		context:        context,
+	}
+	if response.Cause != nil {
+		result.cause = FromResponse(response.Cause)
+	}
+	return result
+}
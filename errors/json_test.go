@@ -145,3 +145,89 @@ func TestJSON_Structure(t *testing.T) {
 	require.Contains(t, jsonStr, `"classification":"PERMANENT"`)
 	require.Contains(t, jsonStr, `"context":{"id":"123"}`)
 }
+
+func TestToJSONWithChain(t *testing.T) {
+	err := Wrap(stderrors.New("connection refused"), CodeNetwork, "dial failed")
+	err = Wrap(err, CodeDatabase, "query failed")
+	err = WithContext(err, "table", "users")
+
+	resp := ToJSONWithChain(err)
+
+	require.Equal(t, "DATABASE_ERROR", resp.Code)
+	require.Equal(t, "users", resp.Context["table"])
+	require.NotNil(t, resp.Cause)
+	require.Equal(t, "NETWORK_ERROR", resp.Cause.Code)
+	require.Equal(t, "dial failed", resp.Cause.Message)
+	require.NotNil(t, resp.Cause.Cause)
+	require.Equal(t, "UNKNOWN", resp.Cause.Cause.Code)
+	require.Equal(t, "connection refused", resp.Cause.Cause.Message)
+	require.Nil(t, resp.Cause.Cause.Cause)
+
+	require.Nil(t, ToJSON(err).Cause)
+	require.Nil(t, ToJSONWithChain(nil))
+}
+
+func TestFromJSON_RoundTrip(t *testing.T) {
+	original := Wrap(New(CodeTimeout, "deadline exceeded"), CodeNetwork, "fetch failed")
+	original = WithContextMap(original, map[string]interface{}{
+		"endpoint": "/api/v1/resource",
+		"attempt":  3,
+	})
+
+	data, marshalErr := json.Marshal(ToJSONWithChain(original))
+	require.NoError(t, marshalErr)
+
+	err, parseErr := FromJSON(data)
+	require.NoError(t, parseErr)
+
+	require.Equal(t, CodeNetwork, err.Code())
+	require.Equal(t, "fetch failed", err.Message())
+	require.True(t, IsRetryable(err))
+	endpoint, ok := GetContextString(err, "endpoint")
+	require.True(t, ok)
+	require.Equal(t, "/api/v1/resource", endpoint)
+	attempt, ok := GetContextInt(err, "attempt")
+	require.True(t, ok)
+	require.Equal(t, 3, attempt)
+
+	var cause PlatformError
+	require.True(t, As(err.Unwrap(), &cause))
+	require.Equal(t, CodeTimeout, cause.Code())
+	require.Equal(t, "deadline exceeded", cause.Message())
+	require.Nil(t, cause.Unwrap())
+	require.Equal(t, original.Error(), err.Error())
+}
+
+func TestFromJSON_PreservesClassification(t *testing.T) {
+	original := WithClassification(New(CodeInternal, "transient failure"), ClassificationRetryable)
+
+	data, marshalErr := json.Marshal(original)
+	require.NoError(t, marshalErr)
+
+	err, parseErr := FromJSON(data)
+	require.NoError(t, parseErr)
+	require.True(t, IsRetryable(err))
+}
+
+func TestFromJSON_DefaultClassification(t *testing.T) {
+	err, parseErr := FromJSON([]byte(`{"code":"RATE_LIMIT_EXCEEDED","message":"slow down","classification":"SOMETIMES"}`))
+	require.NoError(t, parseErr)
+	require.Equal(t, ClassificationRetryable, err.Classification())
+}
+
+func TestFromJSON_Invalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"invalid JSON": "{",
+		"no code":      `{"message":"failed"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			err, parseErr := FromJSON([]byte(data))
+			require.Nil(t, err)
+			require.Equal(t, CodeInvalidInput, GetCode(parseErr))
+		})
+	}
+}
+
+func TestFromResponse_NilResponse(t *testing.T) {
+	require.Nil(t, FromResponse(nil))
+}
@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // platformError is the concrete implementation of PlatformError.
 // It is private to enforce construction through package functions.
@@ -10,6 +13,16 @@ type platformError struct {
 	message        string
 	context        map[string]interface{}
 	cause          error
+
+	// stack holds the program counters where the error was created, if
+	// stack traces were enabled or WithStack was called.
+	stack []uintptr
+
+	// redacted holds the context keys excluded from serialization.
+	redacted map[string]struct{}
+
+	// retryAfter is the minimum delay before retrying, if known.
+	retryAfter time.Duration
 }
 
 // Error returns the string representation of the error.
@@ -53,3 +66,22 @@ func (e *platformError) Context() map[string]interface{} {
 func (e *platformError) Unwrap() error {
 	return e.cause
 }
+
+// clone returns a copy of err that can be modified, with its own copy of the
+// context map. State that is not part of the PlatformError interface, such as
+// stack traces, carries over when err was created by this package.
+func clone(err PlatformError) *platformError {
+	if e, ok := err.(*platformError); ok {
+		result := *e
+		result.context = e.Context()
+		return &result
+	}
+
+	return &platformError{
+		code:           err.Code(),
+		classification: err.Classification(),
+		message:        err.Message(),
+		context:        err.Context(),
+		cause:          err.Unwrap(),
+	}
+}
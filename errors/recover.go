@@ -0,0 +1,87 @@
+package errors
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// Recover converts a panic into a PlatformError with the given code and stores
+// it in *err. It must be called directly with defer; the recovered error
+// replaces any error the function was returning.
+//
+// The error message describes the panic value, which is also stored in the
+// "panic" context field. Panics with an error value, such as runtime errors,
+// wrap that error. The stack trace of the panic is always recorded, whether or
+// not stack traces are enabled globally.
+//
+// Example:
+//
+//	func (w *Worker) process(job Job) (err error) {
+//	    defer errors.Recover(&err, errors.CodeInternal)
+//	    return w.handle(job)
+//	}
+func Recover(err *error, code ErrorCode) {
+	if r := recover(); r != nil {
+		*err = panicError(r, code)
+	}
+}
+
+// WrapPanics returns a function that calls fn and converts panics into
+// CodeInternal PlatformErrors, as Recover does. It is useful for goroutines
+// started by worker pools, where an unrecovered panic would crash the process.
+//
+// Example:
+//
+//	g, ctx := errgroup.WithContext(ctx)
+//	for _, job := range jobs {
+//	    g.Go(errors.WrapPanics(func() error {
+//	        return process(ctx, job)
+//	    }))
+//	}
+//	return g.Wait()
+func WrapPanics(fn func() error) func() error {
+	return func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = panicError(r, CodeInternal)
+			}
+		}()
+		return fn()
+	}
+}
+
+// panicError converts a recovered panic value into a PlatformError. It must be
+// called by the deferred function that recovered the panic, so that the stack
+// trace starts where the panic occurred.
+func panicError(value interface{}, code ErrorCode) PlatformError {
+	depth := int(stackDepth.Load())
+	if depth == 0 {
+		depth = DefaultStackDepth
+	}
+
+	// Skip the deferred function and the runtime's panic handling
+	stack := callers(depth, 4)
+	for len(stack) > 0 {
+		fn := runtime.FuncForPC(stack[0] - 1)
+		if fn == nil || !strings.HasPrefix(fn.Name(), "runtime.") {
+			break
+		}
+		stack = stack[1:]
+	}
+
+	result := &platformError{
+		code:           code,
+		classification: getDefaultClassification(code),
+		message:        fmt.Sprintf("panic: %v", value),
+		context:        map[string]interface{}{"panic": fmt.Sprint(value)},
+		stack:          stack,
+	}
+
+	// Error values are wrapped, and already describe the panic in Error
+	if cause, ok := value.(error); ok {
+		result.message = "panic"
+		result.cause = cause
+	}
+	return result
+}
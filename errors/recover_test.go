@@ -0,0 +1,92 @@
+package errors
+
+import (
+	stderrors "errors"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// panicky panics with value.
+func panicky(value interface{}) {
+	panic(value)
+}
+
+func TestRecover(t *testing.T) {
+	run := func() (err error) {
+		defer Recover(&err, CodeExecutionFailed)
+		panicky("boom")
+		return nil
+	}
+
+	err := run()
+
+	require.Equal(t, CodeExecutionFailed, GetCode(err))
+	require.Equal(t, "[EXECUTION_FAILED] panic: boom", err.Error())
+	panicValue, ok := GetContextString(err, "panic")
+	require.True(t, ok)
+	require.Equal(t, "boom", panicValue)
+
+	stack := StackTrace(err)
+	require.NotEmpty(t, stack)
+	require.Contains(t, stack[0].Function, "panicky")
+}
+
+func TestRecover_ErrorValue(t *testing.T) {
+	cause := stderrors.New("invariant violated")
+	run := func() (err error) {
+		defer Recover(&err, CodeInternal)
+		panic(cause)
+	}
+
+	err := run()
+
+	require.ErrorIs(t, err, cause)
+	require.Equal(t, "[INTERNAL_ERROR] panic: invariant violated", err.Error())
+}
+
+func TestRecover_RuntimeError(t *testing.T) {
+	run := func() (err error) {
+		defer Recover(&err, CodeInternal)
+		var m map[string]int
+		m["key"] = 1
+		return nil
+	}
+
+	err := run()
+
+	var runtimeErr runtime.Error
+	require.ErrorAs(t, err, &runtimeErr)
+	require.Contains(t, StackTrace(err)[0].Function, "TestRecover_RuntimeError")
+}
+
+func TestRecover_NoPanic(t *testing.T) {
+	returned := New(CodeNotFound, "not found")
+	run := func() (err error) {
+		defer Recover(&err, CodeInternal)
+		return returned
+	}
+
+	require.Equal(t, returned, run())
+}
+
+func TestWrapPanics(t *testing.T) {
+	fn := WrapPanics(func() error {
+		panicky("worker crashed")
+		return nil
+	})
+
+	err := fn()
+
+	require.Equal(t, CodeInternal, GetCode(err))
+	require.Equal(t, "[INTERNAL_ERROR] panic: worker crashed", err.Error())
+	require.Contains(t, StackTrace(err)[0].Function, "panicky")
+}
+
+func TestWrapPanics_PassesThroughErrors(t *testing.T) {
+	returned := New(CodeTimeout, "timed out")
+
+	require.Equal(t, returned, WrapPanics(func() error { return returned })())
+	require.NoError(t, WrapPanics(func() error { return nil })())
+}
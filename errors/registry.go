@@ -0,0 +1,133 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// CodeDefinition describes a custom error code registered by a downstream module.
+type CodeDefinition struct {
+	// Code is the namespaced error code, such as "git/worktree_locked".
+	// The namespace is usually the module name; it keeps custom codes from
+	// colliding with the predefined codes and with the codes of other modules.
+	Code ErrorCode
+
+	// Classification is the default classification of errors with this code.
+	// Defaults to ClassificationPermanent if empty.
+	Classification ErrorClassification
+
+	// HTTPStatus is the HTTP status code returned by HTTPStatus.
+	// Defaults to http.StatusInternalServerError if zero.
+	HTTPStatus int
+
+	// GRPCCode is the gRPC status code returned by GRPCStatus.
+	// Defaults to GRPCCodeUnknown if zero.
+	GRPCCode GRPCCode
+}
+
+// namespacedCode matches custom error codes: lowercase namespace and name
+// segments separated by slashes.
+var namespacedCode = regexp.MustCompile(`^[a-z][a-z0-9_]*(/[a-z][a-z0-9_]*)+$`)
+
+// registry holds the custom error codes registered in this process.
+var registry = struct {
+	sync.RWMutex
+	codes map[ErrorCode]CodeDefinition
+}{codes: make(map[ErrorCode]CodeDefinition)}
+
+// RegisterCode registers a custom error code for this process, so errors with
+// the code are classified and mapped to status codes like predefined codes.
+//
+// Codes must be namespaced ("namespace/name", lowercase letters, digits, and
+// underscores) and may only be registered once. Returns a CodeInvalidInput
+// error for malformed definitions and a CodeAlreadyExists error for codes that
+// are already registered.
+//
+// Example:
+//
+//	err := errors.RegisterCode(errors.CodeDefinition{
+//	    Code:           "git/worktree_locked",
+//	    Classification: errors.ClassificationRetryable,
+//	    HTTPStatus:     http.StatusConflict,
+//	})
+func RegisterCode(def CodeDefinition) error {
+	if !namespacedCode.MatchString(string(def.Code)) {
+		return Newf(CodeInvalidInput, "error code %q must be namespaced, such as \"module/name\"", def.Code)
+	}
+
+	switch def.Classification {
+	case "":
+		def.Classification = ClassificationPermanent
+	case ClassificationRetryable, ClassificationPermanent:
+	default:
+		return Newf(CodeInvalidInput, "error code %q has unknown classification %q", def.Code, def.Classification)
+	}
+
+	if def.HTTPStatus == 0 {
+		def.HTTPStatus = http.StatusInternalServerError
+	}
+	if def.HTTPStatus < 100 || def.HTTPStatus > 599 {
+		return Newf(CodeInvalidInput, "error code %q has invalid HTTP status %d", def.Code, def.HTTPStatus)
+	}
+
+	if def.GRPCCode == GRPCCodeOK {
+		def.GRPCCode = GRPCCodeUnknown
+	}
+	if def.GRPCCode > GRPCCodeUnauthenticated {
+		return Newf(CodeInvalidInput, "error code %q has invalid gRPC code %d", def.Code, def.GRPCCode)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.codes[def.Code]; ok {
+		return Newf(CodeAlreadyExists, "error code %q is already registered", def.Code)
+	}
+	registry.codes[def.Code] = def
+
+	return nil
+}
+
+// MustRegisterCode is like RegisterCode but panics if the code cannot be
+// registered. It returns the code, so custom codes can be declared as
+// package-level variables.
+//
+// Example:
+//
+//	var CodeWorktreeLocked = errors.MustRegisterCode(errors.CodeDefinition{
+//	    Code:           "git/worktree_locked",
+//	    Classification: errors.ClassificationRetryable,
+//	    HTTPStatus:     http.StatusConflict,
+//	})
+func MustRegisterCode(def CodeDefinition) ErrorCode {
+	if err := RegisterCode(def); err != nil {
+		panic(fmt.Sprintf("errors: %v", err))
+	}
+	return def.Code
+}
+
+// LookupCode returns the definition of a registered custom error code.
+// Returns false for predefined and unregistered codes.
+func LookupCode(code ErrorCode) (CodeDefinition, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	def, ok := registry.codes[code]
+	return def, ok
+}
+
+// RegisteredCodes returns the registered custom error codes, sorted.
+func RegisteredCodes() []ErrorCode {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	codes := make([]ErrorCode, 0, len(registry.codes))
+	for code := range registry.codes {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+	return codes
+}
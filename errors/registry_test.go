@@ -0,0 +1,90 @@
+package errors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterCode(t *testing.T) {
+	code := MustRegisterCode(CodeDefinition{
+		Code:           "registry_test/worktree_locked",
+		Classification: ClassificationRetryable,
+		HTTPStatus:     http.StatusConflict,
+		GRPCCode:       GRPCCodeAborted,
+	})
+
+	err := Wrap(New(code, "worktree is locked"), code, "checkout failed")
+
+	require.True(t, IsRetryable(err))
+	require.Equal(t, http.StatusConflict, HTTPStatus(err))
+	require.Equal(t, GRPCCodeAborted, GRPCStatus(err))
+	require.Equal(t, "registry_test/worktree_locked", ToJSON(err).Code)
+	require.Contains(t, RegisteredCodes(), code)
+}
+
+func TestRegisterCode_Defaults(t *testing.T) {
+	code := MustRegisterCode(CodeDefinition{Code: "registry_test/defaults"})
+
+	def, ok := LookupCode(code)
+	require.True(t, ok)
+	require.Equal(t, ClassificationPermanent, def.Classification)
+	require.Equal(t, http.StatusInternalServerError, def.HTTPStatus)
+	require.Equal(t, GRPCCodeUnknown, def.GRPCCode)
+
+	err := New(code, "failed")
+	require.False(t, IsRetryable(err))
+	require.Equal(t, http.StatusInternalServerError, HTTPStatus(err))
+}
+
+func TestRegisterCode_Collision(t *testing.T) {
+	def := CodeDefinition{Code: "registry_test/collision", Classification: ClassificationRetryable}
+	require.NoError(t, RegisterCode(def))
+
+	err := RegisterCode(CodeDefinition{Code: "registry_test/collision"})
+	require.Error(t, err)
+	require.Equal(t, CodeAlreadyExists, GetCode(err))
+
+	// The original definition is kept
+	registered, ok := LookupCode(def.Code)
+	require.True(t, ok)
+	require.Equal(t, ClassificationRetryable, registered.Classification)
+}
+
+func TestRegisterCode_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		def  CodeDefinition
+	}{
+		{"predefined code", CodeDefinition{Code: CodeNotFound}},
+		{"no namespace", CodeDefinition{Code: "worktree_locked"}},
+		{"empty name", CodeDefinition{Code: "git/"}},
+		{"uppercase", CodeDefinition{Code: "git/WORKTREE_LOCKED"}},
+		{"unknown classification", CodeDefinition{Code: "git/invalid_class", Classification: "SOMETIMES"}},
+		{"invalid HTTP status", CodeDefinition{Code: "git/invalid_http", HTTPStatus: 42}},
+		{"invalid gRPC code", CodeDefinition{Code: "git/invalid_grpc", GRPCCode: 99}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterCode(tt.def)
+			require.Error(t, err)
+			require.Equal(t, CodeInvalidInput, GetCode(err))
+
+			_, ok := LookupCode(tt.def.Code)
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestMustRegisterCode_Panics(t *testing.T) {
+	require.Panics(t, func() {
+		MustRegisterCode(CodeDefinition{Code: "invalid"})
+	})
+}
+
+func TestLookupCode_Predefined(t *testing.T) {
+	_, ok := LookupCode(CodeNotFound)
+	require.False(t, ok)
+}
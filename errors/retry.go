@@ -0,0 +1,69 @@
+package errors
+
+import (
+	stderrors "errors"
+	"time"
+)
+
+// WithRetryAfter attaches a retry-after hint to an error, such as the delay a
+// server requested in a Retry-After header. Returns a new PlatformError that
+// is classified as retryable, since the hint says when the operation may
+// succeed. Retry helpers should wait at least this long before retrying.
+//
+// A zero or negative duration removes an existing hint.
+//
+// If err is not a PlatformError, it is converted to one with CodeUnknown.
+// Returns nil if err is nil.
+//
+// Example:
+//
+//	if resp.StatusCode == http.StatusTooManyRequests {
+//	    err := errors.New(errors.CodeRateLimit, "rate limit exceeded")
+//	    return errors.WithRetryAfter(err, 30*time.Second)
+//	}
+func WithRetryAfter(err error, d time.Duration) PlatformError {
+	if err == nil {
+		return nil
+	}
+
+	// Convert to PlatformError if needed
+	var platformErr PlatformError
+	if !stderrors.As(err, &platformErr) {
+		platformErr = &platformError{
+			code:           CodeUnknown,
+			classification: ClassificationPermanent,
+			message:        err.Error(),
+			context:        nil,
+			cause:          err,
+		}
+	}
+
+	result := clone(platformErr)
+	if d <= 0 {
+		result.retryAfter = 0
+		return result
+	}
+	result.retryAfter = d
+	result.classification = ClassificationRetryable
+
+	return result
+}
+
+// GetRetryAfter returns the retry-after hint of the outermost error in err's
+// chain that has one. Returns false if no error in the chain has a hint.
+//
+// Example:
+//
+//	delay := backoff(attempt)
+//	if hint, ok := errors.GetRetryAfter(err); ok {
+//	    delay = hint
+//	}
+func GetRetryAfter(err error) (time.Duration, bool) {
+	for err != nil {
+		if e, ok := err.(*platformError); ok && e.retryAfter > 0 { //nolint:errorlint // walks the chain explicitly
+			return e.retryAfter, true
+		}
+		err = stderrors.Unwrap(err)
+	}
+	return 0, false
+}
@@ -0,0 +1,73 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetryAfter(t *testing.T) {
+	err := WithRetryAfter(New(CodeRateLimit, "rate limit exceeded"), 30*time.Second)
+
+	retryAfter, ok := GetRetryAfter(err)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Second, retryAfter)
+	require.True(t, IsRetryable(err))
+}
+
+func TestWithRetryAfter_MarksRetryable(t *testing.T) {
+	err := WithRetryAfter(New(CodeForbidden, "secondary rate limit"), time.Minute)
+
+	require.Equal(t, CodeForbidden, err.Code())
+	require.True(t, IsRetryable(err))
+}
+
+func TestWithRetryAfter_ThroughWrappers(t *testing.T) {
+	err := WithRetryAfter(New(CodeRateLimit, "rate limit exceeded"), 5*time.Second)
+	wrapped := fmt.Errorf("list repositories: %w", Wrap(err, CodeNetwork, "request failed"))
+
+	retryAfter, ok := GetRetryAfter(wrapped)
+	require.True(t, ok)
+	require.Equal(t, 5*time.Second, retryAfter)
+}
+
+func TestWithRetryAfter_PreservedByContextHelpers(t *testing.T) {
+	err := WithRetryAfter(New(CodeRateLimit, "rate limit exceeded"), time.Second)
+	err = WithContext(err, "endpoint", "/repos")
+
+	retryAfter, ok := GetRetryAfter(err)
+	require.True(t, ok)
+	require.Equal(t, time.Second, retryAfter)
+}
+
+func TestWithRetryAfter_Clear(t *testing.T) {
+	err := WithRetryAfter(New(CodeRateLimit, "rate limit exceeded"), time.Second)
+	err = WithRetryAfter(err, 0)
+
+	_, ok := GetRetryAfter(err)
+	require.False(t, ok)
+}
+
+func TestWithRetryAfter_StandardError(t *testing.T) {
+	stdErr := stderrors.New("too many requests")
+	err := WithRetryAfter(stdErr, time.Second)
+
+	require.Equal(t, CodeUnknown, err.Code())
+	require.Equal(t, stdErr, err.Unwrap())
+	require.True(t, IsRetryable(err))
+}
+
+func TestWithRetryAfter_NilError(t *testing.T) {
+	require.Nil(t, WithRetryAfter(nil, time.Second))
+}
+
+func TestGetRetryAfter_NoHint(t *testing.T) {
+	_, ok := GetRetryAfter(New(CodeRateLimit, "rate limit exceeded"))
+	require.False(t, ok)
+
+	_, ok = GetRetryAfter(nil)
+	require.False(t, ok)
+}
@@ -0,0 +1,100 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// Attrs returns structured logging attributes for an error: its code,
+// classification, message, context, retry-after hint, the errors it wraps,
+// and its stack trace if one was captured. Context fields marked with Redact
+// are included, since logs are internal. Returns nil if err is nil.
+//
+// The "chain" attribute lists the wrapped errors from outermost to innermost.
+// PlatformErrors are listed as "[CODE] message"; the first other error is
+// listed with its full message, which describes the rest of the chain.
+//
+// Example:
+//
+//	logger.LogAttrs(ctx, slog.LevelError, "build failed", errors.Attrs(err)...)
+func Attrs(err error) []slog.Attr {
+	if err == nil {
+		return nil
+	}
+
+	message := err.Error()
+	var context map[string]interface{}
+	var cause error
+	var platformErr PlatformError
+	if stderrors.As(err, &platformErr) {
+		message = platformErr.Message()
+		context = platformErr.Context()
+		cause = platformErr.Unwrap()
+	}
+
+	attrs := []slog.Attr{
+		slog.String("code", string(GetCode(err))),
+		slog.String("classification", string(GetClassification(err))),
+		slog.String("message", message),
+	}
+
+	if len(context) > 0 {
+		keys := make([]string, 0, len(context))
+		for key := range context {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		fields := make([]any, 0, len(keys))
+		for _, key := range keys {
+			fields = append(fields, slog.Any(key, context[key]))
+		}
+		attrs = append(attrs, slog.Group("context", fields...))
+	}
+
+	if retryAfter, ok := GetRetryAfter(err); ok {
+		attrs = append(attrs, slog.Duration("retry_after", retryAfter))
+	}
+
+	if chain := errorChain(cause); len(chain) > 0 {
+		attrs = append(attrs, slog.Any("chain", chain))
+	}
+
+	if stack := StackTrace(err); len(stack) > 0 {
+		frames := make([]string, len(stack))
+		for i, frame := range stack {
+			frames[i] = frame.String()
+		}
+		attrs = append(attrs, slog.Any("stack", frames))
+	}
+
+	return attrs
+}
+
+// LogValue implements slog.LogValuer, so errors logged as attributes are
+// expanded into the group of attributes returned by Attrs.
+//
+// Example:
+//
+//	logger.Error("build failed", "error", err)
+//	// error.code=BUILD_FAILED error.classification=PERMANENT error.message="build failed" ...
+func (e *platformError) LogValue() slog.Value {
+	return slog.GroupValue(Attrs(e)...)
+}
+
+// errorChain describes the errors in a chain, starting with err.
+func errorChain(err error) []string {
+	var chain []string
+	for err != nil {
+		platformErr, ok := err.(PlatformError) //nolint:errorlint // each error in the chain is described separately
+		if !ok {
+			chain = append(chain, err.Error())
+			break
+		}
+		chain = append(chain, fmt.Sprintf("[%s] %s", platformErr.Code(), platformErr.Message()))
+		err = platformErr.Unwrap()
+	}
+	return chain
+}
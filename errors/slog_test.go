@@ -0,0 +1,111 @@
+package errors
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// attrMap converts attributes to a map for assertions.
+func attrMap(attrs []slog.Attr) map[string]slog.Value {
+	result := make(map[string]slog.Value, len(attrs))
+	for _, attr := range attrs {
+		result[attr.Key] = attr.Value
+	}
+	return result
+}
+
+func TestAttrs(t *testing.T) {
+	cause := stderrors.New("connection refused")
+	err := Wrap(cause, CodeNetwork, "failed to fetch")
+	err = Wrap(err, CodeBuildFailed, "build failed")
+	err = WithContextMap(err, map[string]interface{}{"project": "api", "attempt": 2})
+
+	attrs := attrMap(Attrs(err))
+
+	require.Equal(t, "BUILD_FAILED", attrs["code"].String())
+	require.Equal(t, "RETRYABLE", attrs["classification"].String())
+	require.Equal(t, "build failed", attrs["message"].String())
+
+	context := attrMap(attrs["context"].Group())
+	require.Equal(t, "api", context["project"].String())
+	require.Equal(t, int64(2), context["attempt"].Int64())
+
+	require.Equal(t, []string{"[NETWORK_ERROR] failed to fetch", "connection refused"}, attrs["chain"].Any())
+	require.NotContains(t, attrs, "stack")
+}
+
+func TestAttrs_StandardError(t *testing.T) {
+	err := fmt.Errorf("outer: %w", stderrors.New("inner"))
+
+	attrs := attrMap(Attrs(err))
+
+	require.Equal(t, "UNKNOWN", attrs["code"].String())
+	require.Equal(t, "PERMANENT", attrs["classification"].String())
+	require.Equal(t, "outer: inner", attrs["message"].String())
+	require.NotContains(t, attrs, "context")
+	require.NotContains(t, attrs, "chain")
+}
+
+func TestAttrs_IncludesRedactedFields(t *testing.T) {
+	err := Redact(WithContext(New(CodeUnauthorized, "login failed"), "email", "user@example.com"), "email")
+
+	context := attrMap(attrMap(Attrs(err))["context"].Group())
+	require.Equal(t, "user@example.com", context["email"].String())
+}
+
+func TestAttrs_Stack(t *testing.T) {
+	err := WithStack(New(CodeInternal, "failed"))
+
+	stack := attrMap(Attrs(err))["stack"].Any().([]string)
+	require.NotEmpty(t, stack)
+	require.Contains(t, stack[0], "TestAttrs_Stack")
+}
+
+func TestAttrs_NilError(t *testing.T) {
+	require.Nil(t, Attrs(nil))
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	err := WithContext(New(CodeNotFound, "project not found"), "project", "api")
+	logger.Error("request failed", "error", err)
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, map[string]interface{}{
+		"code":           "NOT_FOUND",
+		"classification": "PERMANENT",
+		"message":        "project not found",
+		"context":        map[string]interface{}{"project": "api"},
+	}, record["error"])
+}
+
+// externalError is a PlatformError implemented outside this package, without
+// a LogValue method.
+type externalError struct{}
+
+func (externalError) Error() string                       { return "external" }
+func (externalError) Code() ErrorCode                     { return CodeConflict }
+func (externalError) Classification() ErrorClassification { return ClassificationRetryable }
+func (externalError) Message() string                     { return "external" }
+func (externalError) Context() map[string]interface{}     { return nil }
+func (externalError) Unwrap() error                       { return nil }
+
+func TestAttrs_ExternalPlatformError(t *testing.T) {
+	var err PlatformError = externalError{}
+
+	attrs := Attrs(err)
+	require.Equal(t, []slog.Attr{
+		slog.String("code", "CONFLICT"),
+		slog.String("classification", "RETRYABLE"),
+		slog.String("message", "external"),
+	}, attrs)
+}
@@ -0,0 +1,185 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync/atomic"
+)
+
+// DefaultStackDepth is the number of frames captured by WithStack when stack
+// traces are not enabled globally.
+const DefaultStackDepth = 32
+
+// stackDepth is the number of frames captured by New, Newf, and the Wrap
+// functions. Zero disables stack capture.
+var stackDepth atomic.Int32
+
+// Frame is a single function call in a stack trace.
+type Frame struct {
+	// Function is the fully qualified function name.
+	Function string `json:"function"`
+
+	// File is the path of the source file.
+	File string `json:"file"`
+
+	// Line is the line number in the source file.
+	Line int `json:"line"`
+}
+
+// String returns the frame as "function (file:line)".
+func (f Frame) String() string {
+	return fmt.Sprintf("%s (%s:%d)", f.Function, f.File, f.Line)
+}
+
+// EnableStackTraces makes New, Newf, and the Wrap functions capture up to
+// depth stack frames where errors are created. Wrapping an error that already
+// has a stack trace does not capture another one. A depth of zero or less
+// disables capture again.
+//
+// Capturing stack traces costs roughly a microsecond per error, so it is
+// disabled by default. Enable it once at startup, before errors are created.
+//
+// Example:
+//
+//	func main() {
+//	    errors.EnableStackTraces(errors.DefaultStackDepth)
+//	    // ...
+//	}
+func EnableStackTraces(depth int) {
+	if depth < 0 {
+		depth = 0
+	}
+	stackDepth.Store(int32(depth)) //nolint:gosec // depth is a small frame count
+}
+
+// WithStack records the stack trace of the caller on err, whether or not
+// stack traces are enabled globally. Existing stack traces in the error chain
+// are kept, and StackTrace reports the innermost one.
+//
+// If err is not a PlatformError, it is converted to one with CodeUnknown.
+// Returns nil if err is nil.
+//
+// Example:
+//
+//	if err := worker.Run(ctx); err != nil {
+//	    return errors.WithStack(err)
+//	}
+func WithStack(err error) PlatformError {
+	if err == nil {
+		return nil
+	}
+
+	depth := int(stackDepth.Load())
+	if depth == 0 {
+		depth = DefaultStackDepth
+	}
+
+	var platformErr PlatformError
+	if !stderrors.As(err, &platformErr) {
+		return &platformError{
+			code:           CodeUnknown,
+			classification: ClassificationPermanent,
+			message:        err.Error(),
+			cause:          err,
+			stack:          callers(depth, 3),
+		}
+	}
+
+	result := clone(platformErr)
+	result.stack = callers(depth, 3)
+	return result
+}
+
+// StackTrace returns the stack trace recorded closest to the origin of err,
+// that is, by the innermost error in the chain that has one.
+// Returns nil if no error in the chain has a stack trace.
+//
+// Example:
+//
+//	for _, frame := range errors.StackTrace(err) {
+//	    log.Printf("  at %s", frame)
+//	}
+func StackTrace(err error) []Frame {
+	var stack []uintptr
+	for err != nil {
+		if e, ok := err.(*platformError); ok && len(e.stack) > 0 {
+			stack = e.stack
+		}
+		err = stderrors.Unwrap(err)
+	}
+	return frames(stack)
+}
+
+// Format implements fmt.Formatter. The %+v verb prints the error followed by
+// its stack trace, one frame per line; other verbs print the error message.
+//
+// Example:
+//
+//	log.Printf("%+v", err)
+func (e *platformError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		_, _ = io.WriteString(s, e.Error())
+		if s.Flag('+') {
+			for _, frame := range StackTrace(e) {
+				_, _ = fmt.Fprintf(s, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+			}
+		}
+	case 's':
+		_, _ = io.WriteString(s, e.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(s, "%q", e.Error())
+	default:
+		_, _ = fmt.Fprintf(s, "%%!%c(%s)", verb, e.Error())
+	}
+}
+
+// captureStack returns the stack above the skip package functions that
+// called it, if stack traces are enabled, or nil otherwise. Errors that wrap
+// a cause with a stack trace do not capture another one.
+func captureStack(cause error, skip int) []uintptr {
+	depth := int(stackDepth.Load())
+	if depth == 0 || hasStack(cause) {
+		return nil
+	}
+	return callers(depth, 3+skip)
+}
+
+// callers returns up to depth program counters, skipping skip frames
+// (runtime.Callers and callers itself count as two).
+func callers(depth, skip int) []uintptr {
+	pcs := make([]uintptr, depth)
+	n := runtime.Callers(skip, pcs)
+	return pcs[:n]
+}
+
+// hasStack reports whether an error in err's chain has a stack trace.
+func hasStack(err error) bool {
+	for err != nil {
+		if e, ok := err.(*platformError); ok && len(e.stack) > 0 {
+			return true
+		}
+		err = stderrors.Unwrap(err)
+	}
+	return false
+}
+
+// frames resolves program counters into frames.
+func frames(stack []uintptr) []Frame {
+	if len(stack) == 0 {
+		return nil
+	}
+
+	result := make([]Frame, 0, len(stack))
+	callersFrames := runtime.CallersFrames(stack)
+	for {
+		frame, more := callersFrames.Next()
+		result = append(result, Frame{Function: frame.Function, File: frame.File, Line: frame.Line})
+		if !more {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,129 @@
+package errors
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// enableStackTraces enables stack traces for the duration of a test.
+func enableStackTraces(t *testing.T) {
+	t.Helper()
+	EnableStackTraces(DefaultStackDepth)
+	t.Cleanup(func() { EnableStackTraces(0) })
+}
+
+func TestStackTrace_DisabledByDefault(t *testing.T) {
+	err := Wrap(New(CodeNotFound, "not found"), CodeInternal, "lookup failed")
+	require.Nil(t, StackTrace(err))
+	require.Nil(t, ToJSON(err).Stack)
+}
+
+func TestEnableStackTraces_New(t *testing.T) {
+	enableStackTraces(t)
+
+	err := New(CodeNotFound, "not found")
+
+	stack := StackTrace(err)
+	require.NotEmpty(t, stack)
+	require.True(t, strings.HasSuffix(stack[0].Function, "TestEnableStackTraces_New"), stack[0].Function)
+	require.True(t, strings.HasSuffix(stack[0].File, "stack_test.go"), stack[0].File)
+}
+
+func TestEnableStackTraces_Wrap(t *testing.T) {
+	enableStackTraces(t)
+
+	tests := []struct {
+		name string
+		wrap func(error) PlatformError
+	}{
+		{"Wrap", func(err error) PlatformError { return Wrap(err, CodeInternal, "failed") }},
+		{"Wrapf", func(err error) PlatformError { return Wrapf(err, CodeInternal, "failed %d", 1) }},
+		{"WrapWithContext", func(err error) PlatformError {
+			return WrapWithContext(err, CodeInternal, "failed", map[string]interface{}{"id": 1})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wrap(stderrors.New("cause"))
+
+			stack := StackTrace(err)
+			require.NotEmpty(t, stack)
+			require.Contains(t, stack[0].Function, "TestEnableStackTraces_Wrap")
+		})
+	}
+}
+
+func TestEnableStackTraces_KeepsOrigin(t *testing.T) {
+	enableStackTraces(t)
+
+	origin := New(CodeNotFound, "not found")
+	wrapped := Wrap(origin, CodeInternal, "lookup failed")
+	wrapped = WithContext(wrapped, "id", 1)
+
+	require.Nil(t, wrapped.(*platformError).stack)
+	require.Equal(t, StackTrace(origin), StackTrace(wrapped))
+}
+
+func TestEnableStackTraces_Depth(t *testing.T) {
+	EnableStackTraces(1)
+	t.Cleanup(func() { EnableStackTraces(0) })
+
+	require.Len(t, StackTrace(New(CodeInternal, "failed")), 1)
+}
+
+func TestWithStack(t *testing.T) {
+	err := WithStack(stderrors.New("standard error"))
+
+	require.Equal(t, CodeUnknown, err.Code())
+	stack := StackTrace(err)
+	require.NotEmpty(t, stack)
+	require.Contains(t, stack[0].Function, "TestWithStack")
+}
+
+func TestWithStack_PreservesPlatformError(t *testing.T) {
+	original := WithContext(New(CodeTimeout, "timed out"), "attempt", 3)
+	err := WithStack(original)
+
+	require.Equal(t, CodeTimeout, err.Code())
+	require.Equal(t, ClassificationRetryable, err.Classification())
+	require.Equal(t, "timed out", err.Message())
+	require.Equal(t, 3, err.Context()["attempt"])
+	require.NotEmpty(t, StackTrace(err))
+}
+
+func TestWithStack_NilError(t *testing.T) {
+	require.Nil(t, WithStack(nil))
+}
+
+func TestStackTrace_JSON(t *testing.T) {
+	err := WithStack(New(CodeInternal, "failed"))
+
+	response := ToJSON(err)
+	require.NotEmpty(t, response.Stack)
+
+	data, marshalErr := json.Marshal(err)
+	require.NoError(t, marshalErr)
+
+	var decoded ErrorResponse
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, response.Stack, decoded.Stack)
+}
+
+func TestFormat(t *testing.T) {
+	err := WithStack(New(CodeNotFound, "not found"))
+
+	require.Equal(t, "[NOT_FOUND] not found", fmt.Sprintf("%s", err))
+	require.Equal(t, "[NOT_FOUND] not found", fmt.Sprintf("%v", err))
+	require.Equal(t, `"[NOT_FOUND] not found"`, fmt.Sprintf("%q", err))
+
+	detailed := fmt.Sprintf("%+v", err)
+	require.True(t, strings.HasPrefix(detailed, "[NOT_FOUND] not found\n"))
+	require.Contains(t, detailed, "TestFormat\n\t")
+	require.Contains(t, detailed, "stack_test.go:")
+}
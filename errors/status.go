@@ -0,0 +1,258 @@
+package errors
+
+import (
+	stderrors "errors"
+	"net/http"
+	"reflect"
+)
+
+// GRPCCode is a gRPC status code. Its values match google.golang.org/grpc/codes,
+// so converting with codes.Code(errors.GRPCStatus(err)) is safe, without this
+// package depending on gRPC.
+type GRPCCode uint32
+
+// gRPC status codes, as defined by google.golang.org/grpc/codes.
+const (
+	GRPCCodeOK                 GRPCCode = 0
+	GRPCCodeCanceled           GRPCCode = 1
+	GRPCCodeUnknown            GRPCCode = 2
+	GRPCCodeInvalidArgument    GRPCCode = 3
+	GRPCCodeDeadlineExceeded   GRPCCode = 4
+	GRPCCodeNotFound           GRPCCode = 5
+	GRPCCodeAlreadyExists      GRPCCode = 6
+	GRPCCodePermissionDenied   GRPCCode = 7
+	GRPCCodeResourceExhausted  GRPCCode = 8
+	GRPCCodeFailedPrecondition GRPCCode = 9
+	GRPCCodeAborted            GRPCCode = 10
+	GRPCCodeOutOfRange         GRPCCode = 11
+	GRPCCodeUnimplemented      GRPCCode = 12
+	GRPCCodeInternal           GRPCCode = 13
+	GRPCCodeUnavailable        GRPCCode = 14
+	GRPCCodeDataLoss           GRPCCode = 15
+	GRPCCodeUnauthenticated    GRPCCode = 16
+)
+
+// httpStatuses maps error codes to HTTP status codes.
+var httpStatuses = map[ErrorCode]int{
+	CodeNotFound:      http.StatusNotFound,
+	CodeAlreadyExists: http.StatusConflict,
+	CodeConflict:      http.StatusConflict,
+
+	CodeUnauthorized: http.StatusUnauthorized,
+	CodeForbidden:    http.StatusForbidden,
+
+	CodeInvalidInput:  http.StatusBadRequest,
+	CodeInvalidConfig: http.StatusBadRequest,
+	CodeSchemaFailed:  http.StatusUnprocessableEntity,
+
+	CodeDatabase:  http.StatusInternalServerError,
+	CodeNetwork:   http.StatusBadGateway,
+	CodeTimeout:   http.StatusGatewayTimeout,
+	CodeRateLimit: http.StatusTooManyRequests,
+
+	// Not a standard status; used by proxies for requests the client abandoned
+	CodeCanceled: 499,
+
+	CodeExecutionFailed: http.StatusInternalServerError,
+	CodeBuildFailed:     http.StatusInternalServerError,
+	CodePublishFailed:   http.StatusInternalServerError,
+
+	// CUE and schema errors are problems with user-supplied configuration
+	CodeCUELoadFailed:             http.StatusUnprocessableEntity,
+	CodeCUEBuildFailed:            http.StatusUnprocessableEntity,
+	CodeCUEValidationFailed:       http.StatusUnprocessableEntity,
+	CodeCUEDecodeFailed:           http.StatusUnprocessableEntity,
+	CodeCUEEncodeFailed:           http.StatusUnprocessableEntity,
+	CodeSchemaVersionIncompatible: http.StatusUnprocessableEntity,
+
+	CodeInternal:       http.StatusInternalServerError,
+	CodeNotImplemented: http.StatusNotImplemented,
+	CodeUnavailable:    http.StatusServiceUnavailable,
+	CodeUnknown:        http.StatusInternalServerError,
+}
+
+// grpcCodes maps error codes to gRPC status codes.
+var grpcCodes = map[ErrorCode]GRPCCode{
+	CodeNotFound:      GRPCCodeNotFound,
+	CodeAlreadyExists: GRPCCodeAlreadyExists,
+	CodeConflict:      GRPCCodeAborted,
+
+	CodeUnauthorized: GRPCCodeUnauthenticated,
+	CodeForbidden:    GRPCCodePermissionDenied,
+
+	CodeInvalidInput:  GRPCCodeInvalidArgument,
+	CodeInvalidConfig: GRPCCodeFailedPrecondition,
+	CodeSchemaFailed:  GRPCCodeInvalidArgument,
+
+	CodeDatabase:  GRPCCodeInternal,
+	CodeNetwork:   GRPCCodeUnavailable,
+	CodeTimeout:   GRPCCodeDeadlineExceeded,
+	CodeRateLimit: GRPCCodeResourceExhausted,
+	CodeCanceled:  GRPCCodeCanceled,
+
+	CodeExecutionFailed: GRPCCodeInternal,
+	CodeBuildFailed:     GRPCCodeInternal,
+	CodePublishFailed:   GRPCCodeInternal,
+
+	CodeCUELoadFailed:             GRPCCodeInvalidArgument,
+	CodeCUEBuildFailed:            GRPCCodeInvalidArgument,
+	CodeCUEValidationFailed:       GRPCCodeInvalidArgument,
+	CodeCUEDecodeFailed:           GRPCCodeInvalidArgument,
+	CodeCUEEncodeFailed:           GRPCCodeInvalidArgument,
+	CodeSchemaVersionIncompatible: GRPCCodeFailedPrecondition,
+
+	CodeInternal:       GRPCCodeInternal,
+	CodeNotImplemented: GRPCCodeUnimplemented,
+	CodeUnavailable:    GRPCCodeUnavailable,
+	CodeUnknown:        GRPCCodeUnknown,
+}
+
+// grpcErrorCodes maps gRPC status codes back to error codes.
+// Codes without an equivalent map to CodeUnknown.
+var grpcErrorCodes = map[GRPCCode]ErrorCode{
+	GRPCCodeCanceled:           CodeCanceled,
+	GRPCCodeUnknown:            CodeUnknown,
+	GRPCCodeInvalidArgument:    CodeInvalidInput,
+	GRPCCodeDeadlineExceeded:   CodeTimeout,
+	GRPCCodeNotFound:           CodeNotFound,
+	GRPCCodeAlreadyExists:      CodeAlreadyExists,
+	GRPCCodePermissionDenied:   CodeForbidden,
+	GRPCCodeResourceExhausted:  CodeRateLimit,
+	GRPCCodeFailedPrecondition: CodeConflict,
+	GRPCCodeAborted:            CodeConflict,
+	GRPCCodeOutOfRange:         CodeInvalidInput,
+	GRPCCodeUnimplemented:      CodeNotImplemented,
+	GRPCCodeInternal:           CodeInternal,
+	GRPCCodeUnavailable:        CodeUnavailable,
+	GRPCCodeDataLoss:           CodeInternal,
+	GRPCCodeUnauthenticated:    CodeUnauthorized,
+}
+
+// HTTPStatus returns the HTTP status code for an error, based on its error code.
+// Custom codes use the status they were registered with (see RegisterCode).
+// Returns http.StatusOK if err is nil and http.StatusInternalServerError for
+// errors that are not PlatformErrors or have no mapping.
+//
+// Example:
+//
+//	func handleError(w http.ResponseWriter, err error) {
+//	    w.Header().Set("Content-Type", "application/json")
+//	    w.WriteHeader(errors.HTTPStatus(err))
+//	    json.NewEncoder(w).Encode(errors.ToJSON(err))
+//	}
+func HTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	code := GetCode(err)
+	if status, ok := httpStatuses[code]; ok {
+		return status
+	}
+	if def, ok := LookupCode(code); ok {
+		return def.HTTPStatus
+	}
+	return http.StatusInternalServerError
+}
+
+// GRPCStatus returns the gRPC status code for an error, based on its error code.
+// Custom codes use the code they were registered with (see RegisterCode).
+// Returns GRPCCodeOK if err is nil and GRPCCodeUnknown for errors that are not
+// PlatformErrors or have no mapping.
+//
+// Example:
+//
+//	if err != nil {
+//	    return nil, status.Error(codes.Code(errors.GRPCStatus(err)), err.Error())
+//	}
+func GRPCStatus(err error) GRPCCode {
+	if err == nil {
+		return GRPCCodeOK
+	}
+	code := GetCode(err)
+	if grpcCode, ok := grpcCodes[code]; ok {
+		return grpcCode
+	}
+	if def, ok := LookupCode(code); ok {
+		return def.GRPCCode
+	}
+	return GRPCCodeUnknown
+}
+
+// FromGRPC converts an error returned by a gRPC client into a PlatformError.
+// The error code is derived from the gRPC status code and the message from the
+// status message; the original error is preserved as the cause.
+//
+// gRPC errors are recognized by their GRPCStatus method, as used by
+// status.FromError. Errors without a gRPC status are converted with CodeUnknown.
+// Returns nil if err is nil.
+//
+// Example:
+//
+//	resp, err := client.GetProject(ctx, req)
+//	if err != nil {
+//	    return errors.FromGRPC(err)
+//	}
+func FromGRPC(err error) PlatformError {
+	if err == nil {
+		return nil
+	}
+
+	code, message, ok := grpcStatusOf(err)
+	if !ok {
+		return &platformError{
+			code:           CodeUnknown,
+			classification: ClassificationPermanent,
+			message:        err.Error(),
+			cause:          err,
+		}
+	}
+
+	errorCode, ok := grpcErrorCodes[code]
+	if !ok {
+		errorCode = CodeUnknown
+	}
+	return &platformError{
+		code:           errorCode,
+		classification: getDefaultClassification(errorCode),
+		message:        message,
+		cause:          err,
+	}
+}
+
+// grpcStatusOf extracts the status code and message from the first error in
+// err's chain with a GRPCStatus method. The status is accessed through
+// reflection so that this package does not depend on gRPC.
+func grpcStatusOf(err error) (GRPCCode, string, bool) {
+	for err != nil {
+		if method := reflect.ValueOf(err).MethodByName("GRPCStatus"); method.IsValid() && method.Type().NumIn() == 0 &&
+			method.Type().NumOut() == 1 {
+			if code, message, ok := statusFields(method.Call(nil)[0]); ok {
+				return code, message, true
+			}
+		}
+		err = stderrors.Unwrap(err)
+	}
+	return 0, "", false
+}
+
+// statusFields calls the Code and Message methods of a gRPC status.
+func statusFields(status reflect.Value) (GRPCCode, string, bool) {
+	if status.Kind() == reflect.Pointer && status.IsNil() {
+		return 0, "", false
+	}
+
+	codeMethod := status.MethodByName("Code")
+	messageMethod := status.MethodByName("Message")
+	if !codeMethod.IsValid() || !messageMethod.IsValid() ||
+		codeMethod.Type().NumIn() != 0 || codeMethod.Type().NumOut() != 1 ||
+		messageMethod.Type().NumIn() != 0 || messageMethod.Type().NumOut() != 1 {
+		return 0, "", false
+	}
+
+	code := codeMethod.Call(nil)[0]
+	message := messageMethod.Call(nil)[0]
+	if code.Kind() != reflect.Uint32 || message.Kind() != reflect.String {
+		return 0, "", false
+	}
+	return GRPCCode(code.Uint()), message.String(), true //nolint:gosec // gRPC codes are uint32
+}
@@ -0,0 +1,121 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testStatus mimics *status.Status from google.golang.org/grpc/status.
+type testStatus struct {
+	code    testCode
+	message string
+}
+
+// testCode mimics codes.Code from google.golang.org/grpc/codes.
+type testCode uint32
+
+func (s *testStatus) Code() testCode  { return s.code }
+func (s *testStatus) Message() string { return s.message }
+
+// testStatusError mimics the error returned by status.Error.
+type testStatusError struct {
+	status *testStatus
+}
+
+func (e *testStatusError) Error() string {
+	return fmt.Sprintf("rpc error: code = %d desc = %s", e.status.code, e.status.message)
+}
+
+func (e *testStatusError) GRPCStatus() *testStatus { return e.status }
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, http.StatusOK},
+		{"not found", New(CodeNotFound, "missing"), http.StatusNotFound},
+		{"unauthorized", New(CodeUnauthorized, "no token"), http.StatusUnauthorized},
+		{"forbidden", New(CodeForbidden, "denied"), http.StatusForbidden},
+		{"invalid input", New(CodeInvalidInput, "bad"), http.StatusBadRequest},
+		{"rate limit", New(CodeRateLimit, "slow down"), http.StatusTooManyRequests},
+		{"unavailable", New(CodeUnavailable, "down"), http.StatusServiceUnavailable},
+		{"wrapped", Wrap(New(CodeNotFound, "missing"), CodeConflict, "conflict"), http.StatusConflict},
+		{"standard error", stderrors.New("boom"), http.StatusInternalServerError},
+		{"unmapped code", New(ErrorCode("CUSTOM"), "custom"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, HTTPStatus(tt.err))
+		})
+	}
+}
+
+func TestStatusMappings_CoverAllCodes(t *testing.T) {
+	for code := range defaultClassifications {
+		require.Contains(t, httpStatuses, code)
+		require.Contains(t, grpcCodes, code)
+	}
+}
+
+func TestGRPCStatus(t *testing.T) {
+	require.Equal(t, GRPCCodeOK, GRPCStatus(nil))
+	require.Equal(t, GRPCCodeNotFound, GRPCStatus(New(CodeNotFound, "missing")))
+	require.Equal(t, GRPCCodeUnauthenticated, GRPCStatus(New(CodeUnauthorized, "no token")))
+	require.Equal(t, GRPCCodeDeadlineExceeded, GRPCStatus(New(CodeTimeout, "slow")))
+	require.Equal(t, GRPCCodeUnknown, GRPCStatus(stderrors.New("boom")))
+	require.Equal(t, GRPCCodeUnknown, GRPCStatus(New(ErrorCode("CUSTOM"), "custom")))
+}
+
+func TestFromGRPC(t *testing.T) {
+	grpcErr := &testStatusError{status: &testStatus{code: testCode(GRPCCodeUnavailable), message: "backend down"}}
+
+	err := FromGRPC(grpcErr)
+
+	require.Equal(t, CodeUnavailable, err.Code())
+	require.Equal(t, "backend down", err.Message())
+	require.True(t, err.Classification().IsRetryable())
+	require.Equal(t, grpcErr, err.Unwrap())
+}
+
+func TestFromGRPC_WrappedStatus(t *testing.T) {
+	grpcErr := &testStatusError{status: &testStatus{code: testCode(GRPCCodeNotFound), message: "no project"}}
+
+	err := FromGRPC(fmt.Errorf("get project: %w", grpcErr))
+
+	require.Equal(t, CodeNotFound, err.Code())
+	require.Equal(t, "no project", err.Message())
+}
+
+func TestFromGRPC_RoundTrip(t *testing.T) {
+	for _, code := range []ErrorCode{
+		CodeNotFound, CodeAlreadyExists, CodeConflict, CodeUnauthorized, CodeForbidden,
+		CodeInvalidInput, CodeTimeout, CodeRateLimit, CodeCanceled, CodeInternal, CodeNotImplemented, CodeUnavailable,
+	} {
+		t.Run(string(code), func(t *testing.T) {
+			grpcCode := GRPCStatus(New(code, "failed"))
+			grpcErr := &testStatusError{status: &testStatus{code: testCode(grpcCode), message: "failed"}}
+			require.Equal(t, code, FromGRPC(grpcErr).Code())
+		})
+	}
+}
+
+func TestFromGRPC_StandardError(t *testing.T) {
+	stdErr := stderrors.New("boom")
+
+	err := FromGRPC(stdErr)
+
+	require.Equal(t, CodeUnknown, err.Code())
+	require.Equal(t, "boom", err.Message())
+	require.Equal(t, stdErr, err.Unwrap())
+}
+
+func TestFromGRPC_NilError(t *testing.T) {
+	require.Nil(t, FromGRPC(nil))
+}
@@ -24,20 +24,7 @@ func Wrap(err error, code ErrorCode, message string) PlatformError {
 		return nil
 	}
 
-	// Preserve classification if wrapping a PlatformError
-	classification := getDefaultClassification(code)
-	var platformErr PlatformError
-	if errors.As(err, &platformErr) {
-		classification = platformErr.Classification()
-	}
-
-	return &platformError{
-		code:           code,
-		classification: classification,
-		message:        message,
-		context:        nil,
-		cause:          err,
-	}
+	return wrap(err, code, message)
 }
 
 // Wrapf wraps an error with a formatted message while preserving the original error.
@@ -54,7 +41,7 @@ func Wrapf(err error, code ErrorCode, format string, args ...interface{}) Platfo
 		return nil
 	}
 
-	return Wrap(err, code, fmt.Sprintf(format, args...))
+	return wrap(err, code, fmt.Sprintf(format, args...))
 }
 
 // WrapWithContext wraps an error and attaches context metadata in a single operation.
@@ -97,5 +84,26 @@ func WrapWithContext(err error, code ErrorCode, message string, ctx map[string]i
 		message:        message,
 		context:        contextCopy,
 		cause:          err,
+		stack:          captureStack(err, 1),
+	}
+}
+
+// wrap implements Wrap and Wrapf, so that stacks captured by either start at
+// their caller.
+func wrap(err error, code ErrorCode, message string) *platformError {
+	// Preserve classification if wrapping a PlatformError
+	classification := getDefaultClassification(code)
+	var platformErr PlatformError
+	if errors.As(err, &platformErr) {
+		classification = platformErr.Classification()
+	}
+
+	return &platformError{
+		code:           code,
+		classification: classification,
+		message:        message,
+		context:        nil,
+		cause:          err,
+		stack:          captureStack(err, 2),
 	}
 }
@@ -5,17 +5,21 @@ import (
 	"io"
 	"os"
 	osexec "os/exec"
+	"strings"
 	"time"
 )
 
 // Command is the concrete implementation of the Executor interface.
 // It provides command execution with configurable settings.
 type Command struct {
-	config  *config
-	ctx     context.Context
-	stdout  io.Writer
-	stderr  io.Writer
-	timeout string
+	config     *config
+	ctx        context.Context
+	stdin      io.Reader
+	stdout     io.Writer
+	stderr     io.Writer
+	stdoutLine func(line string)
+	stderrLine func(line string)
+	timeout    string
 }
 
 // New creates a new Command with the given options.
@@ -95,6 +99,44 @@ func (c *Command) WithPassthrough() Executor {
 	return c
 }
 
+// WithStreaming streams stdout without capturing it.
+func (c *Command) WithStreaming() Executor {
+	val := true
+	c.config.localStreaming = &val
+	return c
+}
+
+// WithStdoutLineHandler sets the handler called with each line of stdout.
+func (c *Command) WithStdoutLineHandler(handler func(line string)) Executor {
+	c.stdoutLine = handler
+	return c
+}
+
+// WithStderrLineHandler sets the handler called with each line of stderr.
+func (c *Command) WithStderrLineHandler(handler func(line string)) Executor {
+	c.stderrLine = handler
+	return c
+}
+
+// WithStdin sets the stdin reader for the next run.
+func (c *Command) WithStdin(r io.Reader) Executor {
+	c.stdin = r
+	return c
+}
+
+// WithStdinString sets the stdin contents for the next run.
+func (c *Command) WithStdinString(s string) Executor {
+	c.stdin = strings.NewReader(s)
+	return c
+}
+
+// WithInteractive connects the command to the caller's terminal.
+func (c *Command) WithInteractive() Executor {
+	val := true
+	c.config.localInteractive = &val
+	return c
+}
+
 // Run executes the command with the given arguments.
 func (c *Command) Run(args ...string) (*Result, error) {
 	if len(args) == 0 {
@@ -156,9 +198,45 @@ func (c *Command) Run(args ...string) (*Result, error) {
 	cmd.Stdout = newMultiWriter(stdoutCapture.Writer(), combined)
 	cmd.Stderr = newMultiWriter(stderrCapture.Writer(), combined)
 
+	// Streamed stdout goes straight to its writer and is never buffered
+	if c.config.effectiveStreaming() {
+		cmd.Stdout = c.stdout
+	}
+
+	// Line handlers see output as it is written
+	var stdoutLines, stderrLines *lineWriter
+	if c.stdoutLine != nil {
+		stdoutLines = newLineWriter(c.stdoutLine)
+		cmd.Stdout = newMultiWriter(cmd.Stdout, stdoutLines)
+	}
+	if c.stderrLine != nil {
+		stderrLines = newLineWriter(c.stderrLine)
+		cmd.Stderr = newMultiWriter(cmd.Stderr, stderrLines)
+	}
+
+	// Set input
+	cmd.Stdin = c.stdin
+
+	// Interactive commands use the terminal directly so they can detect it
+	if c.config.effectiveInteractive() {
+		if c.stdin == nil {
+			cmd.Stdin = os.Stdin
+		}
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+	}
+
 	// Execute the command
 	err := cmd.Run()
 
+	// Output without a trailing newline is still a line
+	if stdoutLines != nil {
+		stdoutLines.Flush()
+	}
+	if stderrLines != nil {
+		stderrLines.Flush()
+	}
+
 	// Build result
 	result := &Result{
 		Stdout:   stdoutCapture.String(),
@@ -170,6 +248,7 @@ func (c *Command) Run(args ...string) (*Result, error) {
 	// Reset local configuration for next run
 	c.config.resetLocal()
 	c.timeout = ""
+	c.stdin = nil
 
 	// Handle errors
 	if err != nil {
@@ -188,9 +267,11 @@ func (c *Command) Run(args ...string) (*Result, error) {
 // Clone creates a copy of the executor with the same configuration.
 func (c *Command) Clone() Executor {
 	return &Command{
-		config: c.config.clone(),
-		ctx:    c.ctx,
-		stdout: c.stdout,
-		stderr: c.stderr,
+		config:     c.config.clone(),
+		ctx:        c.ctx,
+		stdout:     c.stdout,
+		stderr:     c.stderr,
+		stdoutLine: c.stdoutLine,
+		stderrLine: c.stderrLine,
 	}
 }
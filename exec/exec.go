@@ -45,6 +45,35 @@ type Executor interface {
 	// The output will be written to the writers set by WithStdout/WithStderr (or os.Stdout/os.Stderr by default).
 	WithPassthrough() Executor
 
+	// WithStreaming writes stdout only to the writer set by WithStdout (or os.Stdout
+	// by default) without capturing it, so large outputs are not held in memory.
+	// Result.Stdout is empty and Result.Combined contains only stderr.
+	WithStreaming() Executor
+
+	// WithStdoutLineHandler calls handler with each line written to stdout, without
+	// the trailing newline, as the command runs. Output is still captured and
+	// written as configured, so long-running commands can report progress.
+	WithStdoutLineHandler(handler func(line string)) Executor
+
+	// WithStderrLineHandler calls handler with each line written to stderr, without
+	// the trailing newline, as the command runs. It may be called concurrently
+	// with the stdout handler.
+	WithStderrLineHandler(handler func(line string)) Executor
+
+	// WithStdin sets the reader the command reads stdin from.
+	// The reader is consumed by the next Run, so it applies to that run only.
+	WithStdin(r io.Reader) Executor
+
+	// WithStdinString sets a string to feed the command on stdin.
+	// Like WithStdin, it applies to the next Run only.
+	WithStdinString(s string) Executor
+
+	// WithInteractive connects the command directly to os.Stdin, os.Stdout, and
+	// os.Stderr, so it can prompt the user on their terminal. Output is not
+	// captured or passed to line handlers, and a reader set by WithStdin takes
+	// precedence over os.Stdin.
+	WithInteractive() Executor
+
 	// Run executes the command with the given arguments.
 	// It returns a Result containing the captured output and exit code.
 	Run(args ...string) (*Result, error)
@@ -135,3 +164,31 @@ func WithPassthrough() Option {
 		c.WithPassthrough()
 	}
 }
+
+// WithStreaming returns an Option that globally streams stdout without capturing it.
+func WithStreaming() Option {
+	return func(c *Command) {
+		c.WithStreaming()
+	}
+}
+
+// WithStdoutLineHandler returns an Option that sets the global stdout line handler.
+func WithStdoutLineHandler(handler func(line string)) Option {
+	return func(c *Command) {
+		c.WithStdoutLineHandler(handler)
+	}
+}
+
+// WithStderrLineHandler returns an Option that sets the global stderr line handler.
+func WithStderrLineHandler(handler func(line string)) Option {
+	return func(c *Command) {
+		c.WithStderrLineHandler(handler)
+	}
+}
+
+// WithInteractive returns an Option that globally connects commands to the caller's terminal.
+func WithInteractive() Option {
+	return func(c *Command) {
+		c.WithInteractive()
+	}
+}
@@ -132,6 +132,135 @@ func TestWithPassthrough(t *testing.T) {
 	}
 }
 
+func TestWithStreaming(t *testing.T) {
+	var stdout bytes.Buffer
+	exec := New()
+	result, err := exec.WithStdout(&stdout).WithStreaming().Run("sh", "-c", "echo stdout && echo stderr >&2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Check that stdout was streamed to the writer
+	if !strings.Contains(stdout.String(), "stdout") {
+		t.Errorf("expected streamed stdout to contain 'stdout', got: %s", stdout.String())
+	}
+
+	// Check that stdout was not captured
+	if result.Stdout != "" {
+		t.Errorf("expected stdout not to be captured, got: %s", result.Stdout)
+	}
+	if strings.Contains(result.Combined, "stdout") {
+		t.Errorf("expected combined output to omit stdout, got: %s", result.Combined)
+	}
+
+	// Check that stderr was still captured
+	if !strings.Contains(result.Stderr, "stderr") {
+		t.Errorf("expected captured stderr to contain 'stderr', got: %s", result.Stderr)
+	}
+}
+
+func TestWithLineHandlers(t *testing.T) {
+	var stdoutLines, stderrLines []string
+	exec := New()
+	result, err := exec.
+		WithStdoutLineHandler(func(line string) { stdoutLines = append(stdoutLines, line) }).
+		WithStderrLineHandler(func(line string) { stderrLines = append(stderrLines, line) }).
+		Run("sh", "-c", "printf 'one\\ntwo\\r\\nthree' && echo error >&2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Check that lines were split and the final partial line was flushed
+	expected := []string{"one", "two", "three"}
+	if strings.Join(stdoutLines, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected stdout lines %q, got: %q", expected, stdoutLines)
+	}
+	if len(stderrLines) != 1 || stderrLines[0] != "error" {
+		t.Errorf("expected stderr lines [\"error\"], got: %q", stderrLines)
+	}
+
+	// Check that output was still captured
+	if result.Stdout != "one\ntwo\r\nthree" {
+		t.Errorf("expected stdout to be captured, got: %q", result.Stdout)
+	}
+}
+
+func TestWithLineHandlersStreaming(t *testing.T) {
+	var stdout bytes.Buffer
+	var lines []string
+	exec := New()
+	result, err := exec.WithStdout(&stdout).
+		WithStreaming().
+		WithStdoutLineHandler(func(line string) { lines = append(lines, line) }).
+		Run("echo", "hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(lines) != 1 || lines[0] != "hello" {
+		t.Errorf("expected lines [\"hello\"], got: %q", lines)
+	}
+	if stdout.String() != "hello\n" {
+		t.Errorf("expected stdout to be streamed, got: %q", stdout.String())
+	}
+	if result.Stdout != "" {
+		t.Errorf("expected stdout not to be captured, got: %s", result.Stdout)
+	}
+}
+
+func TestWithStdin(t *testing.T) {
+	exec := New()
+	result, err := exec.WithStdin(strings.NewReader("from reader")).Run("cat")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Stdout != "from reader" {
+		t.Errorf("expected stdout 'from reader', got: %q", result.Stdout)
+	}
+
+	result, err = exec.WithStdinString("from string").Run("cat")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Stdout != "from string" {
+		t.Errorf("expected stdout 'from string', got: %q", result.Stdout)
+	}
+
+	// Check that stdin was reset after the run
+	result, err = exec.Run("cat")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Stdout != "" {
+		t.Errorf("expected stdin to be reset, got: %q", result.Stdout)
+	}
+}
+
+func TestWithInteractive(t *testing.T) {
+	var stdout bytes.Buffer
+	var lines []string
+	exec := New()
+	result, err := exec.WithStdout(&stdout).
+		WithStdoutLineHandler(func(line string) { lines = append(lines, line) }).
+		WithStdinString("input").
+		WithInteractive().
+		Run("sh", "-c", "cat >/dev/null")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Check that output bypassed capture, writers, and line handlers
+	if result.Stdout != "" || result.Combined != "" {
+		t.Errorf("expected output not to be captured, got: %q", result.Combined)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("expected custom writer to be bypassed, got: %q", stdout.String())
+	}
+	if len(lines) != 0 {
+		t.Errorf("expected no lines, got: %q", lines)
+	}
+}
+
 func TestCombinedOutput(t *testing.T) {
 	exec := New()
 	result, err := exec.Run("sh", "-c", "echo stdout && echo stderr >&2")
@@ -41,15 +41,33 @@ var _ exec.Executor = &ExecutorMock{}
 //			WithInheritEnvFunc: func() exec.Executor {
 //				panic("mock out the WithInheritEnv method")
 //			},
+//			WithInteractiveFunc: func() exec.Executor {
+//				panic("mock out the WithInteractive method")
+//			},
 //			WithPassthroughFunc: func() exec.Executor {
 //				panic("mock out the WithPassthrough method")
 //			},
 //			WithStderrFunc: func(w io.Writer) exec.Executor {
 //				panic("mock out the WithStderr method")
 //			},
+//			WithStderrLineHandlerFunc: func(handler func(line string)) exec.Executor {
+//				panic("mock out the WithStderrLineHandler method")
+//			},
+//			WithStdinFunc: func(r io.Reader) exec.Executor {
+//				panic("mock out the WithStdin method")
+//			},
+//			WithStdinStringFunc: func(s string) exec.Executor {
+//				panic("mock out the WithStdinString method")
+//			},
 //			WithStdoutFunc: func(w io.Writer) exec.Executor {
 //				panic("mock out the WithStdout method")
 //			},
+//			WithStdoutLineHandlerFunc: func(handler func(line string)) exec.Executor {
+//				panic("mock out the WithStdoutLineHandler method")
+//			},
+//			WithStreamingFunc: func() exec.Executor {
+//				panic("mock out the WithStreaming method")
+//			},
 //			WithTimeoutFunc: func(timeout string) exec.Executor {
 //				panic("mock out the WithTimeout method")
 //			},
@@ -81,15 +99,33 @@ type ExecutorMock struct {
 	// WithInheritEnvFunc mocks the WithInheritEnv method.
 	WithInheritEnvFunc func() exec.Executor
 
+	// WithInteractiveFunc mocks the WithInteractive method.
+	WithInteractiveFunc func() exec.Executor
+
 	// WithPassthroughFunc mocks the WithPassthrough method.
 	WithPassthroughFunc func() exec.Executor
 
 	// WithStderrFunc mocks the WithStderr method.
 	WithStderrFunc func(w io.Writer) exec.Executor
 
+	// WithStderrLineHandlerFunc mocks the WithStderrLineHandler method.
+	WithStderrLineHandlerFunc func(handler func(line string)) exec.Executor
+
+	// WithStdinFunc mocks the WithStdin method.
+	WithStdinFunc func(r io.Reader) exec.Executor
+
+	// WithStdinStringFunc mocks the WithStdinString method.
+	WithStdinStringFunc func(s string) exec.Executor
+
 	// WithStdoutFunc mocks the WithStdout method.
 	WithStdoutFunc func(w io.Writer) exec.Executor
 
+	// WithStdoutLineHandlerFunc mocks the WithStdoutLineHandler method.
+	WithStdoutLineHandlerFunc func(handler func(line string)) exec.Executor
+
+	// WithStreamingFunc mocks the WithStreaming method.
+	WithStreamingFunc func() exec.Executor
+
 	// WithTimeoutFunc mocks the WithTimeout method.
 	WithTimeoutFunc func(timeout string) exec.Executor
 
@@ -124,6 +160,9 @@ type ExecutorMock struct {
 		// WithInheritEnv holds details about calls to the WithInheritEnv method.
 		WithInheritEnv []struct {
 		}
+		// WithInteractive holds details about calls to the WithInteractive method.
+		WithInteractive []struct {
+		}
 		// WithPassthrough holds details about calls to the WithPassthrough method.
 		WithPassthrough []struct {
 		}
@@ -132,28 +171,57 @@ type ExecutorMock struct {
 			// W is the w argument value.
 			W io.Writer
 		}
+		// WithStderrLineHandler holds details about calls to the WithStderrLineHandler method.
+		WithStderrLineHandler []struct {
+			// Handler is the handler argument value.
+			Handler func(line string)
+		}
+		// WithStdin holds details about calls to the WithStdin method.
+		WithStdin []struct {
+			// R is the r argument value.
+			R io.Reader
+		}
+		// WithStdinString holds details about calls to the WithStdinString method.
+		WithStdinString []struct {
+			// S is the s argument value.
+			S string
+		}
 		// WithStdout holds details about calls to the WithStdout method.
 		WithStdout []struct {
 			// W is the w argument value.
 			W io.Writer
 		}
+		// WithStdoutLineHandler holds details about calls to the WithStdoutLineHandler method.
+		WithStdoutLineHandler []struct {
+			// Handler is the handler argument value.
+			Handler func(line string)
+		}
+		// WithStreaming holds details about calls to the WithStreaming method.
+		WithStreaming []struct {
+		}
 		// WithTimeout holds details about calls to the WithTimeout method.
 		WithTimeout []struct {
 			// Timeout is the timeout argument value.
 			Timeout string
 		}
 	}
-	lockClone             sync.RWMutex
-	lockRun               sync.RWMutex
-	lockWithContext       sync.RWMutex
-	lockWithDir           sync.RWMutex
-	lockWithDisableColors sync.RWMutex
-	lockWithEnv           sync.RWMutex
-	lockWithInheritEnv    sync.RWMutex
-	lockWithPassthrough   sync.RWMutex
-	lockWithStderr        sync.RWMutex
-	lockWithStdout        sync.RWMutex
-	lockWithTimeout       sync.RWMutex
+	lockClone                 sync.RWMutex
+	lockRun                   sync.RWMutex
+	lockWithContext           sync.RWMutex
+	lockWithDir               sync.RWMutex
+	lockWithDisableColors     sync.RWMutex
+	lockWithEnv               sync.RWMutex
+	lockWithInheritEnv        sync.RWMutex
+	lockWithInteractive       sync.RWMutex
+	lockWithPassthrough       sync.RWMutex
+	lockWithStderr            sync.RWMutex
+	lockWithStderrLineHandler sync.RWMutex
+	lockWithStdin             sync.RWMutex
+	lockWithStdinString       sync.RWMutex
+	lockWithStdout            sync.RWMutex
+	lockWithStdoutLineHandler sync.RWMutex
+	lockWithStreaming         sync.RWMutex
+	lockWithTimeout           sync.RWMutex
 }
 
 // Clone calls CloneFunc.
@@ -365,6 +433,33 @@ func (mock *ExecutorMock) WithInheritEnvCalls() []struct {
 	return calls
 }
 
+// WithInteractive calls WithInteractiveFunc.
+func (mock *ExecutorMock) WithInteractive() exec.Executor {
+	if mock.WithInteractiveFunc == nil {
+		panic("ExecutorMock.WithInteractiveFunc: method is nil but Executor.WithInteractive was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockWithInteractive.Lock()
+	mock.calls.WithInteractive = append(mock.calls.WithInteractive, callInfo)
+	mock.lockWithInteractive.Unlock()
+	return mock.WithInteractiveFunc()
+}
+
+// WithInteractiveCalls gets all the calls that were made to WithInteractive.
+// Check the length with:
+//
+//	len(mockedExecutor.WithInteractiveCalls())
+func (mock *ExecutorMock) WithInteractiveCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockWithInteractive.RLock()
+	calls = mock.calls.WithInteractive
+	mock.lockWithInteractive.RUnlock()
+	return calls
+}
+
 // WithPassthrough calls WithPassthroughFunc.
 func (mock *ExecutorMock) WithPassthrough() exec.Executor {
 	if mock.WithPassthroughFunc == nil {
@@ -424,6 +519,102 @@ func (mock *ExecutorMock) WithStderrCalls() []struct {
 	return calls
 }
 
+// WithStderrLineHandler calls WithStderrLineHandlerFunc.
+func (mock *ExecutorMock) WithStderrLineHandler(handler func(line string)) exec.Executor {
+	if mock.WithStderrLineHandlerFunc == nil {
+		panic("ExecutorMock.WithStderrLineHandlerFunc: method is nil but Executor.WithStderrLineHandler was just called")
+	}
+	callInfo := struct {
+		Handler func(line string)
+	}{
+		Handler: handler,
+	}
+	mock.lockWithStderrLineHandler.Lock()
+	mock.calls.WithStderrLineHandler = append(mock.calls.WithStderrLineHandler, callInfo)
+	mock.lockWithStderrLineHandler.Unlock()
+	return mock.WithStderrLineHandlerFunc(handler)
+}
+
+// WithStderrLineHandlerCalls gets all the calls that were made to WithStderrLineHandler.
+// Check the length with:
+//
+//	len(mockedExecutor.WithStderrLineHandlerCalls())
+func (mock *ExecutorMock) WithStderrLineHandlerCalls() []struct {
+	Handler func(line string)
+} {
+	var calls []struct {
+		Handler func(line string)
+	}
+	mock.lockWithStderrLineHandler.RLock()
+	calls = mock.calls.WithStderrLineHandler
+	mock.lockWithStderrLineHandler.RUnlock()
+	return calls
+}
+
+// WithStdin calls WithStdinFunc.
+func (mock *ExecutorMock) WithStdin(r io.Reader) exec.Executor {
+	if mock.WithStdinFunc == nil {
+		panic("ExecutorMock.WithStdinFunc: method is nil but Executor.WithStdin was just called")
+	}
+	callInfo := struct {
+		R io.Reader
+	}{
+		R: r,
+	}
+	mock.lockWithStdin.Lock()
+	mock.calls.WithStdin = append(mock.calls.WithStdin, callInfo)
+	mock.lockWithStdin.Unlock()
+	return mock.WithStdinFunc(r)
+}
+
+// WithStdinCalls gets all the calls that were made to WithStdin.
+// Check the length with:
+//
+//	len(mockedExecutor.WithStdinCalls())
+func (mock *ExecutorMock) WithStdinCalls() []struct {
+	R io.Reader
+} {
+	var calls []struct {
+		R io.Reader
+	}
+	mock.lockWithStdin.RLock()
+	calls = mock.calls.WithStdin
+	mock.lockWithStdin.RUnlock()
+	return calls
+}
+
+// WithStdinString calls WithStdinStringFunc.
+func (mock *ExecutorMock) WithStdinString(s string) exec.Executor {
+	if mock.WithStdinStringFunc == nil {
+		panic("ExecutorMock.WithStdinStringFunc: method is nil but Executor.WithStdinString was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockWithStdinString.Lock()
+	mock.calls.WithStdinString = append(mock.calls.WithStdinString, callInfo)
+	mock.lockWithStdinString.Unlock()
+	return mock.WithStdinStringFunc(s)
+}
+
+// WithStdinStringCalls gets all the calls that were made to WithStdinString.
+// Check the length with:
+//
+//	len(mockedExecutor.WithStdinStringCalls())
+func (mock *ExecutorMock) WithStdinStringCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockWithStdinString.RLock()
+	calls = mock.calls.WithStdinString
+	mock.lockWithStdinString.RUnlock()
+	return calls
+}
+
 // WithStdout calls WithStdoutFunc.
 func (mock *ExecutorMock) WithStdout(w io.Writer) exec.Executor {
 	if mock.WithStdoutFunc == nil {
@@ -456,6 +647,65 @@ func (mock *ExecutorMock) WithStdoutCalls() []struct {
 	return calls
 }
 
+// WithStdoutLineHandler calls WithStdoutLineHandlerFunc.
+func (mock *ExecutorMock) WithStdoutLineHandler(handler func(line string)) exec.Executor {
+	if mock.WithStdoutLineHandlerFunc == nil {
+		panic("ExecutorMock.WithStdoutLineHandlerFunc: method is nil but Executor.WithStdoutLineHandler was just called")
+	}
+	callInfo := struct {
+		Handler func(line string)
+	}{
+		Handler: handler,
+	}
+	mock.lockWithStdoutLineHandler.Lock()
+	mock.calls.WithStdoutLineHandler = append(mock.calls.WithStdoutLineHandler, callInfo)
+	mock.lockWithStdoutLineHandler.Unlock()
+	return mock.WithStdoutLineHandlerFunc(handler)
+}
+
+// WithStdoutLineHandlerCalls gets all the calls that were made to WithStdoutLineHandler.
+// Check the length with:
+//
+//	len(mockedExecutor.WithStdoutLineHandlerCalls())
+func (mock *ExecutorMock) WithStdoutLineHandlerCalls() []struct {
+	Handler func(line string)
+} {
+	var calls []struct {
+		Handler func(line string)
+	}
+	mock.lockWithStdoutLineHandler.RLock()
+	calls = mock.calls.WithStdoutLineHandler
+	mock.lockWithStdoutLineHandler.RUnlock()
+	return calls
+}
+
+// WithStreaming calls WithStreamingFunc.
+func (mock *ExecutorMock) WithStreaming() exec.Executor {
+	if mock.WithStreamingFunc == nil {
+		panic("ExecutorMock.WithStreamingFunc: method is nil but Executor.WithStreaming was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockWithStreaming.Lock()
+	mock.calls.WithStreaming = append(mock.calls.WithStreaming, callInfo)
+	mock.lockWithStreaming.Unlock()
+	return mock.WithStreamingFunc()
+}
+
+// WithStreamingCalls gets all the calls that were made to WithStreaming.
+// Check the length with:
+//
+//	len(mockedExecutor.WithStreamingCalls())
+func (mock *ExecutorMock) WithStreamingCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockWithStreaming.RLock()
+	calls = mock.calls.WithStreaming
+	mock.lockWithStreaming.RUnlock()
+	return calls
+}
+
 // WithTimeout calls WithTimeoutFunc.
 func (mock *ExecutorMock) WithTimeout(timeout string) exec.Executor {
 	if mock.WithTimeoutFunc == nil {
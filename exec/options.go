@@ -9,6 +9,8 @@ type config struct {
 	globalInheritEnv bool
 	globalDisableColors bool
 	globalPassthrough bool
+	globalStreaming bool
+	globalInteractive bool
 
 	// Local settings (set per-execution, override global)
 	localEnv        map[string]string
@@ -16,6 +18,8 @@ type config struct {
 	localInheritEnv *bool
 	localDisableColors *bool
 	localPassthrough *bool
+	localStreaming *bool
+	localInteractive *bool
 }
 
 // newConfig creates a new configuration with default values.
@@ -34,6 +38,8 @@ func (c *config) clone() *config {
 		globalInheritEnv:   c.globalInheritEnv,
 		globalDisableColors: c.globalDisableColors,
 		globalPassthrough:  c.globalPassthrough,
+		globalStreaming:    c.globalStreaming,
+		globalInteractive:  c.globalInteractive,
 		localEnv:           make(map[string]string),
 		localDir:           c.localDir,
 	}
@@ -61,6 +67,16 @@ func (c *config) clone() *config {
 		clone.localPassthrough = &val
 	}
 
+	if c.localStreaming != nil {
+		val := *c.localStreaming
+		clone.localStreaming = &val
+	}
+
+	if c.localInteractive != nil {
+		val := *c.localInteractive
+		clone.localInteractive = &val
+	}
+
 	return clone
 }
 
@@ -127,6 +143,24 @@ func (c *config) effectivePassthrough() bool {
 	return c.globalPassthrough
 }
 
+// effectiveStreaming returns whether to stream stdout without capturing it.
+// Local setting overrides global setting.
+func (c *config) effectiveStreaming() bool {
+	if c.localStreaming != nil {
+		return *c.localStreaming
+	}
+	return c.globalStreaming
+}
+
+// effectiveInteractive returns whether to connect the caller's terminal.
+// Local setting overrides global setting.
+func (c *config) effectiveInteractive() bool {
+	if c.localInteractive != nil {
+		return *c.localInteractive
+	}
+	return c.globalInteractive
+}
+
 // resetLocal resets all local settings.
 // This should be called after each Run() to ensure local settings don't carry over.
 func (c *config) resetLocal() {
@@ -135,4 +169,6 @@ func (c *config) resetLocal() {
 	c.localInheritEnv = nil
 	c.localDisableColors = nil
 	c.localPassthrough = nil
+	c.localStreaming = nil
+	c.localInteractive = nil
 }
@@ -95,3 +95,46 @@ func (cw *combinedWriter) String() string {
 	defer cw.mu.Unlock()
 	return cw.buffer.String()
 }
+
+// lineWriter splits output into lines and calls a handler with each one.
+// Partial lines are buffered until they are completed or flushed.
+type lineWriter struct {
+	handler func(line string)
+	buffer  []byte
+	mu      sync.Mutex
+}
+
+// newLineWriter creates a new line writer that calls handler for each line.
+func newLineWriter(handler func(line string)) *lineWriter {
+	return &lineWriter{
+		handler: handler,
+	}
+}
+
+// Write buffers data and calls the handler for each complete line.
+func (lw *lineWriter) Write(p []byte) (n int, err error) {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	lw.buffer = append(lw.buffer, p...)
+	for {
+		i := bytes.IndexByte(lw.buffer, '\n')
+		if i < 0 {
+			break
+		}
+		lw.handler(string(bytes.TrimSuffix(lw.buffer[:i], []byte{'\r'})))
+		lw.buffer = lw.buffer[i+1:]
+	}
+	return len(p), nil
+}
+
+// Flush calls the handler with any buffered partial line.
+func (lw *lineWriter) Flush() {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	if len(lw.buffer) > 0 {
+		lw.handler(string(bytes.TrimSuffix(lw.buffer, []byte{'\r'})))
+		lw.buffer = nil
+	}
+}
@@ -79,6 +79,42 @@ func (w *CommandWrapper) WithPassthrough() Executor {
 	return w
 }
 
+// WithStreaming streams stdout without capturing it.
+func (w *CommandWrapper) WithStreaming() Executor {
+	w.executor = w.executor.WithStreaming()
+	return w
+}
+
+// WithStdoutLineHandler sets the handler called with each line of stdout.
+func (w *CommandWrapper) WithStdoutLineHandler(handler func(line string)) Executor {
+	w.executor = w.executor.WithStdoutLineHandler(handler)
+	return w
+}
+
+// WithStderrLineHandler sets the handler called with each line of stderr.
+func (w *CommandWrapper) WithStderrLineHandler(handler func(line string)) Executor {
+	w.executor = w.executor.WithStderrLineHandler(handler)
+	return w
+}
+
+// WithStdin sets the stdin reader for the next run.
+func (w *CommandWrapper) WithStdin(r io.Reader) Executor {
+	w.executor = w.executor.WithStdin(r)
+	return w
+}
+
+// WithStdinString sets the stdin contents for the next run.
+func (w *CommandWrapper) WithStdinString(s string) Executor {
+	w.executor = w.executor.WithStdinString(s)
+	return w
+}
+
+// WithInteractive connects the command to the caller's terminal.
+func (w *CommandWrapper) WithInteractive() Executor {
+	w.executor = w.executor.WithInteractive()
+	return w
+}
+
 // Run executes the wrapped command with the given arguments.
 // The command name is prepended to the arguments.
 func (w *CommandWrapper) Run(args ...string) (*Result, error) {
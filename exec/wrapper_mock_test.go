@@ -48,6 +48,9 @@ func TestWrapperWithMock(t *testing.T) {
 		WithPassthroughFunc: func() exec.Executor {
 			return mockExec // Return self for chaining
 		},
+		WithStreamingFunc: func() exec.Executor {
+			return mockExec // Return self for chaining
+		},
 		RunFunc: func(args ...string) (*exec.Result, error) {
 			// Verify that the wrapper prepends the command name
 			if len(args) < 1 || args[0] != "git" {
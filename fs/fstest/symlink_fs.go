@@ -3,7 +3,9 @@ package fstest
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io/fs"
+	"sync"
 	"testing"
 
 	"github.com/jmgilman/go/fs/core"
@@ -38,6 +40,9 @@ func TestSymlinkFSWithConfig(t *testing.T, filesystem core.FS, config FSTestConf
 	t.Run("BrokenSymlink", func(t *testing.T) {
 		testSymlinkFSBroken(t, filesystem, sfs)
 	})
+	t.Run("WalkSymlinkCycle", func(t *testing.T) {
+		testSymlinkFSWalkCycle(t, filesystem, sfs)
+	})
 }
 
 // testSymlinkFSCreate tests Symlink() creation and basic verification.
@@ -176,3 +181,56 @@ func testSymlinkFSBroken(t *testing.T, filesystem core.FS, sfs core.SymlinkFS) {
 	// Note: Per design, broken symlinks should be detectable via Lstat (if supported).
 	// We don't test Lstat here as that's covered by TestMetadataFS.
 }
+
+// testSymlinkFSWalkCycle tests that core.WalkWithOptions terminates when
+// following symlinks that form cycles.
+func testSymlinkFSWalkCycle(t *testing.T, filesystem core.FS, sfs core.SymlinkFS) {
+	// Setup: a link back to an ancestor, and two directories linking to each other
+	for _, dir := range []string{"cycle/a", "cycle/b"} {
+		if err := filesystem.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("MkdirAll(%s): setup failed: %v", dir, err)
+		}
+	}
+	if err := filesystem.WriteFile("cycle/a/file.txt", []byte("content"), 0644); err != nil {
+		t.Fatalf("WriteFile(cycle/a/file.txt): setup failed: %v", err)
+	}
+	links := map[string]string{
+		"cycle/a/parent": "..",
+		"cycle/a/to-b":   "../b",
+		"cycle/b/to-a":   "../a",
+	}
+	for link, target := range links {
+		if err := sfs.Symlink(target, link); err != nil {
+			t.Fatalf("Symlink(%s, %s): setup failed: %v", target, link, err)
+		}
+	}
+
+	for _, parallelism := range []int{1, 4} {
+		var mu sync.Mutex
+		visited := make(map[string]int)
+		err := core.WalkWithOptions(filesystem, "cycle", core.WalkOptions{
+			Parallelism:    parallelism,
+			FollowSymlinks: true,
+		}, func(path string, _ fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			visited[path]++
+			if visited[path] > 1 {
+				return fmt.Errorf("visited %s twice", path)
+			}
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("WalkWithOptions(cycle, parallelism=%d): got error %v, want nil", parallelism, err)
+		}
+		for _, path := range []string{"cycle/a/file.txt", "cycle/a/parent", "cycle/b/to-a"} {
+			if visited[path] != 1 {
+				t.Errorf("WalkWithOptions(cycle, parallelism=%d): visited %s %d times, want 1", parallelism, path, visited[path])
+			}
+		}
+	}
+}
@@ -1,8 +1,12 @@
 package fstest
 
 import (
+	"errors"
 	"io/fs"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/jmgilman/go/fs/core"
 )
@@ -29,6 +33,12 @@ func TestWalkFSWithConfig(t *testing.T, filesystem core.FS, config FSTestConfig)
 	t.Run("PathHandling", func(t *testing.T) {
 		testWalkFSPathHandling(t, filesystem, config)
 	})
+	t.Run("WithOptions", func(t *testing.T) {
+		testWalkFSWithOptions(t, filesystem, config)
+	})
+	t.Run("ParallelStop", func(t *testing.T) {
+		testWalkFSParallelStop(t, filesystem, config)
+	})
 }
 
 // testWalkFSSimpleTree tests Walk() on a simple directory tree.
@@ -280,3 +290,117 @@ func testWalkFSPathHandling(t *testing.T, filesystem core.FS, config FSTestConfi
 		t.Fatalf("Walk(pathtest): got error %v, want nil", err)
 	}
 }
+
+// testWalkFSWithOptions tests core.WalkWithOptions with parallelism and filtering.
+func testWalkFSWithOptions(t *testing.T, filesystem core.FS, config FSTestConfig) {
+	// Setup: Create a tree with a directory that should be skipped
+	for _, dir := range []string{"optstest/a/nested", "optstest/b", "optstest/skipped"} {
+		if err := filesystem.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("MkdirAll(%s): setup failed: %v", dir, err)
+		}
+	}
+	files := []string{
+		"optstest/a/one.txt",
+		"optstest/a/nested/two.txt",
+		"optstest/b/three.txt",
+		"optstest/skipped/hidden.txt",
+	}
+	for _, file := range files {
+		if err := filesystem.WriteFile(file, []byte("content"), 0644); err != nil {
+			t.Fatalf("WriteFile(%s): setup failed: %v", file, err)
+		}
+	}
+
+	for _, parallelism := range []int{1, 4} {
+		var mu sync.Mutex
+		var visited []string
+		err := core.WalkWithOptions(filesystem, "optstest", core.WalkOptions{
+			Parallelism: parallelism,
+			Skip: func(_ string, d fs.DirEntry) bool {
+				return d.IsDir() && d.Name() == "skipped"
+			},
+		}, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			visited = append(visited, path)
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("WalkWithOptions(optstest, parallelism=%d): got error %v, want nil", parallelism, err)
+		}
+
+		sort.Strings(visited)
+		expected := []string{"optstest/a/nested/two.txt", "optstest/a/one.txt", "optstest/b/three.txt"}
+		if len(visited) != len(expected) {
+			t.Errorf("WalkWithOptions(optstest, parallelism=%d): visited %v, want %v", parallelism, visited, expected)
+			continue
+		}
+		for i := range expected {
+			if visited[i] != expected[i] {
+				t.Errorf("WalkWithOptions(optstest, parallelism=%d): path[%d] = %q, want %q",
+					parallelism, i, visited[i], expected[i])
+			}
+		}
+	}
+}
+
+// testWalkFSParallelStop tests that a parallel walk stopped by fs.SkipAll or an
+// error from the calling goroutine's path does not visit concurrent subtrees.
+func testWalkFSParallelStop(t *testing.T, filesystem core.FS, config FSTestConfig) {
+	// Setup: "a" is walked by a second goroutine, "b.txt" by the caller
+	if err := filesystem.MkdirAll("stoptest/a", 0755); err != nil {
+		t.Fatalf("MkdirAll(stoptest/a): setup failed: %v", err)
+	}
+	for _, file := range []string{"stoptest/a/one.txt", "stoptest/a/two.txt", "stoptest/b.txt"} {
+		if err := filesystem.WriteFile(file, []byte("content"), 0644); err != nil {
+			t.Fatalf("WriteFile(%s): setup failed: %v", file, err)
+		}
+	}
+
+	errStop := errors.New("stop")
+	for _, stopErr := range []error{fs.SkipAll, errStop} {
+		var mu sync.Mutex
+		var visited []string
+		released := make(chan struct{})
+		err := core.WalkWithOptions(filesystem, "stoptest", core.WalkOptions{
+			Parallelism: 2,
+		}, func(path string, _ fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			visited = append(visited, path)
+			mu.Unlock()
+
+			switch path {
+			case "stoptest/a":
+				// Hold the concurrent subtree until the walk has stopped
+				<-released
+			case "stoptest/b.txt":
+				time.AfterFunc(50*time.Millisecond, func() { close(released) })
+				return stopErr
+			}
+			return nil
+		})
+
+		wantErr := stopErr
+		if stopErr == fs.SkipAll {
+			wantErr = nil
+		}
+		if !errors.Is(err, wantErr) {
+			t.Errorf("WalkWithOptions(stoptest) stopped with %v: got error %v, want %v", stopErr, err, wantErr)
+		}
+		for _, path := range visited {
+			if path == "stoptest/a/one.txt" || path == "stoptest/a/two.txt" {
+				t.Errorf("WalkWithOptions(stoptest) stopped with %v: visited %q after the walk stopped", stopErr, path)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package github
+
+import "context"
+
+// ListDependabotAlerts lists the Dependabot alerts of the repository with the
+// given state ("open", "dismissed", "fixed", "auto_dismissed"). Several
+// states may be combined with commas. An empty state lists all alerts.
+//
+// Example:
+//
+//	alerts, err := repo.ListDependabotAlerts(ctx, "open")
+//	for _, alert := range alerts {
+//	    fmt.Printf("%s %s: %s\n", alert.Severity, alert.Package, alert.Summary)
+//	}
+func (r *Repository) ListDependabotAlerts(ctx context.Context, state string) ([]*DependabotAlertData, error) {
+	alerts, err := r.client.provider.ListDependabotAlerts(ctx, r.owner, r.name, ListAlertsOptions{State: state})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list Dependabot alerts")
+	}
+	return alerts, nil
+}
+
+// ListCodeScanningAlerts lists the code scanning alerts of the repository
+// with the given state ("open", "closed", "dismissed", "fixed"). An empty
+// state lists all alerts.
+func (r *Repository) ListCodeScanningAlerts(ctx context.Context, state string) ([]*CodeScanningAlertData, error) {
+	alerts, err := r.client.provider.ListCodeScanningAlerts(ctx, r.owner, r.name, ListAlertsOptions{State: state})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list code scanning alerts")
+	}
+	return alerts, nil
+}
+
+// ListSecretScanningAlerts lists the secret scanning alerts of the
+// repository with the given state ("open", "resolved"). An empty state lists
+// all alerts.
+func (r *Repository) ListSecretScanningAlerts(ctx context.Context, state string) ([]*SecretScanningAlertData, error) {
+	alerts, err := r.client.provider.ListSecretScanningAlerts(ctx, r.owner, r.name, ListAlertsOptions{State: state})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list secret scanning alerts")
+	}
+	return alerts, nil
+}
@@ -0,0 +1,159 @@
+package github
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/fs/core"
+)
+
+// ListArtifacts lists the artifacts uploaded by this workflow run.
+//
+// Example:
+//
+//	artifacts, err := run.ListArtifacts(ctx)
+//	for _, a := range artifacts {
+//	    fmt.Printf("%s (%d bytes)\n", a.Name, a.SizeInBytes)
+//	}
+func (wr *WorkflowRun) ListArtifacts(ctx context.Context) ([]*ArtifactData, error) {
+	var all []*ArtifactData
+	for page := 1; ; page++ {
+		artifacts, err := wr.client.provider.ListArtifacts(ctx, wr.owner, wr.repo, wr.data.ID, ListOptions{Page: page, PerPage: 100})
+		if err != nil {
+			return nil, WrapHTTPError(err, 0, "failed to list artifacts")
+		}
+		all = append(all, artifacts...)
+		if len(artifacts) < 100 {
+			return all, nil
+		}
+	}
+}
+
+// WriteArtifact streams the raw zip archive of an artifact to w without
+// buffering it in memory.
+//
+// Example:
+//
+//	f, _ := os.Create("artifact.zip")
+//	defer f.Close()
+//	err := run.WriteArtifact(ctx, artifact.ID, f)
+func (wr *WorkflowRun) WriteArtifact(ctx context.Context, artifactID int64, w io.Writer) error {
+	rc, err := wr.client.provider.DownloadArtifact(ctx, wr.owner, wr.repo, artifactID)
+	if err != nil {
+		return WrapHTTPError(err, 0, "failed to download artifact")
+	}
+	defer func() { _ = rc.Close() }()
+
+	if _, err := io.Copy(w, rc); err != nil {
+		err = errors.Wrap(err, errors.CodeNetwork, "failed to read artifact archive")
+		return errors.WithContext(err, "artifact_id", artifactID)
+	}
+	return nil
+}
+
+// DownloadArtifact downloads an artifact and extracts its contents into
+// targetDir on fsys. Directories are created as needed and existing files
+// are overwritten.
+//
+// Entries that would be written outside of targetDir are rejected with
+// ErrInvalidInput.
+//
+// zip extraction requires random access, so the archive is spooled to a
+// temporary file on the local disk rather than held in memory.
+//
+// Example:
+//
+//	fsys := billy.NewLocal()
+//	err := run.DownloadArtifact(ctx, artifact.ID, fsys, "dist")
+func (wr *WorkflowRun) DownloadArtifact(ctx context.Context, artifactID int64, fsys core.FS, targetDir string) error {
+	tmp, err := os.CreateTemp("", "github-artifact-*.zip")
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to create temporary artifact file")
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if err := wr.WriteArtifact(ctx, artifactID, tmp); err != nil {
+		return err
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to read temporary artifact file")
+	}
+
+	if err := extractArtifact(tmp, size, fsys, targetDir); err != nil {
+		return errors.WithContext(err, "artifact_id", artifactID)
+	}
+	return nil
+}
+
+// extractArtifact extracts a zip archive into targetDir on fsys.
+func extractArtifact(r io.ReaderAt, size int64, fsys core.FS, targetDir string) error {
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInvalidInput, "artifact is not a valid zip archive")
+	}
+
+	targetDir = path.Clean(targetDir)
+	if err := fsys.MkdirAll(targetDir, 0o755); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to create target directory")
+	}
+
+	for _, file := range reader.File {
+		name := path.Clean(strings.ReplaceAll(file.Name, "\\", "/"))
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			err := errors.New(errors.CodeInvalidInput, "artifact entry escapes target directory")
+			return errors.WithContext(err, "entry", file.Name)
+		}
+
+		dest := path.Join(targetDir, name)
+		if file.FileInfo().IsDir() {
+			if err := fsys.MkdirAll(dest, 0o755); err != nil {
+				return errors.Wrap(err, errors.CodeInternal, fmt.Sprintf("failed to create directory %s", dest))
+			}
+			continue
+		}
+
+		if err := fsys.MkdirAll(path.Dir(dest), 0o755); err != nil {
+			return errors.Wrap(err, errors.CodeInternal, fmt.Sprintf("failed to create directory %s", path.Dir(dest)))
+		}
+		if err := extractArtifactFile(file, fsys, dest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractArtifactFile writes a single zip entry to dest on fsys.
+func extractArtifactFile(file *zip.File, fsys core.FS, dest string) error {
+	src, err := file.Open()
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInvalidInput, fmt.Sprintf("failed to open artifact entry %s", file.Name))
+	}
+	defer func() { _ = src.Close() }()
+
+	dst, err := fsys.Create(dest)
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternal, fmt.Sprintf("failed to create %s", dest))
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return errors.Wrap(err, errors.CodeInternal, fmt.Sprintf("failed to write %s", dest))
+	}
+	if err := dst.Close(); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, fmt.Sprintf("failed to write %s", dest))
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// Auto-merge and merge queues are only exposed by the GraphQL API, so these
+// operations are performed with Provider.QueryGraphQL.
+
+// mergeTargetQuery resolves the node ID of a pull request and the merge
+// queue of its base branch, which is null when the branch has no queue.
+const mergeTargetQuery = `query($owner: String!, $repo: String!, $number: Int!, $base: String!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) { id }
+    mergeQueue(branch: $base) { id }
+  }
+}`
+
+const enableAutoMergeMutation = `mutation($pullRequest: ID!, $method: PullRequestMergeMethod!) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $pullRequest, mergeMethod: $method}) {
+    pullRequest { id }
+  }
+}`
+
+const disableAutoMergeMutation = `mutation($pullRequest: ID!) {
+  disablePullRequestAutoMerge(input: {pullRequestId: $pullRequest}) {
+    pullRequest { id }
+  }
+}`
+
+const enqueuePullRequestMutation = `mutation($pullRequest: ID!) {
+  enqueuePullRequest(input: {pullRequestId: $pullRequest}) {
+    mergeQueueEntry { id position state enqueuedAt }
+  }
+}`
+
+const dequeuePullRequestMutation = `mutation($pullRequest: ID!) {
+  dequeuePullRequest(input: {id: $pullRequest}) {
+    mergeQueueEntry { id }
+  }
+}`
+
+// EnableAutoMerge enables auto-merge for the pull request, so that GitHub
+// merges it with the given method ("merge", "squash", "rebase") once all
+// requirements are met.
+//
+// Auto-merge must be allowed in the repository settings.
+// Returns ErrInvalidInput if the merge method is unknown.
+//
+// Example:
+//
+//	err := pr.EnableAutoMerge(ctx, github.MergeMethodSquash)
+func (pr *PullRequest) EnableAutoMerge(ctx context.Context, method string) error {
+	graphQLMethod, err := graphQLMergeMethod(method)
+	if err != nil {
+		return err
+	}
+
+	id, _, err := pr.mergeTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	vars := map[string]interface{}{
+		"pullRequest": id,
+		"method":      graphQLMethod,
+	}
+	if err := pr.client.provider.QueryGraphQL(ctx, enableAutoMergeMutation, vars, nil); err != nil {
+		return WrapHTTPError(err, 0, "failed to enable auto-merge")
+	}
+	return nil
+}
+
+// DisableAutoMerge disables auto-merge for the pull request.
+func (pr *PullRequest) DisableAutoMerge(ctx context.Context) error {
+	id, _, err := pr.mergeTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	vars := map[string]interface{}{"pullRequest": id}
+	if err := pr.client.provider.QueryGraphQL(ctx, disableAutoMergeMutation, vars, nil); err != nil {
+		return WrapHTTPError(err, 0, "failed to disable auto-merge")
+	}
+	return nil
+}
+
+// AddToMergeQueue adds the pull request to the merge queue of its base
+// branch. The merge method and commit message are defined by the queue.
+func (pr *PullRequest) AddToMergeQueue(ctx context.Context) (*MergeQueueEntryData, error) {
+	id, _, err := pr.mergeTarget(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pr.enqueue(ctx, id)
+}
+
+// RemoveFromMergeQueue removes the pull request from the merge queue of its
+// base branch.
+func (pr *PullRequest) RemoveFromMergeQueue(ctx context.Context) error {
+	id, _, err := pr.mergeTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	vars := map[string]interface{}{"pullRequest": id}
+	if err := pr.client.provider.QueryGraphQL(ctx, dequeuePullRequestMutation, vars, nil); err != nil {
+		return WrapHTTPError(err, 0, "failed to remove pull request from merge queue")
+	}
+	return nil
+}
+
+// MergeOrEnqueue merges the pull request, or adds it to the merge queue when
+// its base branch has one. Merging directly into a branch with a merge queue
+// is rejected by GitHub, so this is the safe choice when the repository
+// configuration is not known in advance.
+//
+// The returned entry is nil when the pull request was merged directly.
+// Merge options are ignored when the pull request is queued.
+//
+// Example:
+//
+//	entry, err := pr.MergeOrEnqueue(ctx, github.WithMergeMethod("squash"))
+//	if entry != nil {
+//	    fmt.Printf("Queued at position %d\n", entry.Position)
+//	}
+func (pr *PullRequest) MergeOrEnqueue(ctx context.Context, opts ...MergeOption) (*MergeQueueEntryData, error) {
+	id, queued, err := pr.mergeTarget(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if queued {
+		return pr.enqueue(ctx, id)
+	}
+	return nil, pr.Merge(ctx, opts...)
+}
+
+// enqueue adds the pull request with the given node ID to its merge queue.
+func (pr *PullRequest) enqueue(ctx context.Context, id string) (*MergeQueueEntryData, error) {
+	vars := map[string]interface{}{"pullRequest": id}
+
+	var result struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry *MergeQueueEntryData `json:"mergeQueueEntry"`
+		} `json:"enqueuePullRequest"`
+	}
+	if err := pr.client.provider.QueryGraphQL(ctx, enqueuePullRequestMutation, vars, &result); err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to add pull request to merge queue")
+	}
+
+	return result.EnqueuePullRequest.MergeQueueEntry, nil
+}
+
+// mergeTarget returns the node ID of the pull request and whether its base
+// branch has a merge queue.
+func (pr *PullRequest) mergeTarget(ctx context.Context) (string, bool, error) {
+	vars := map[string]interface{}{
+		"owner":  pr.owner,
+		"repo":   pr.repo,
+		"number": pr.data.Number,
+		"base":   pr.data.BaseRef,
+	}
+
+	var result struct {
+		Repository *struct {
+			PullRequest *struct {
+				ID string `json:"id"`
+			} `json:"pullRequest"`
+			MergeQueue *struct {
+				ID string `json:"id"`
+			} `json:"mergeQueue"`
+		} `json:"repository"`
+	}
+	if err := pr.client.provider.QueryGraphQL(ctx, mergeTargetQuery, vars, &result); err != nil {
+		return "", false, WrapHTTPError(err, 0, "failed to resolve pull request")
+	}
+	if result.Repository == nil || result.Repository.PullRequest == nil {
+		return "", false, errors.New(errors.CodeNotFound, fmt.Sprintf("pull request %s/%s#%d not found", pr.owner, pr.repo, pr.data.Number))
+	}
+
+	return result.Repository.PullRequest.ID, result.Repository.MergeQueue != nil, nil
+}
+
+// graphQLMergeMethod converts a merge method to its PullRequestMergeMethod
+// enum value.
+func graphQLMergeMethod(method string) (string, error) {
+	switch method {
+	case MergeMethodMerge, MergeMethodSquash, MergeMethodRebase:
+		return strings.ToUpper(method), nil
+	default:
+		return "", errors.New(errors.CodeInvalidInput, fmt.Sprintf("unknown merge method %q", method))
+	}
+}
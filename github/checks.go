@@ -0,0 +1,172 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// Check outcomes used when evaluating check runs and commit statuses.
+const (
+	checkPending = "pending"
+	checkPassed  = "passed"
+	checkFailed  = "failed"
+)
+
+// WaitForChecks polls the check runs and commit statuses of the pull
+// request's head commit until they all pass, one fails, or the context is
+// cancelled. The pull request is refreshed on every poll, so pushes made
+// while waiting are picked up.
+//
+// Returns nil when all checks passed. A failed check yields an error with
+// code errors.CodeExecutionFailed naming the failed checks, and an expired
+// timeout or context yields errors.CodeTimeout.
+//
+// Example:
+//
+//	// Gate the merge on CI, backing off from 10 seconds to 1 minute
+//	err := pr.WaitForChecks(ctx, github.WaitOptions{
+//	    Interval:       10 * time.Second,
+//	    MaxInterval:    time.Minute,
+//	    Timeout:        30 * time.Minute,
+//	    RequiredChecks: []string{"build", "test"},
+//	    FailFast:       true,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	err = pr.Merge(ctx, github.WithMergeMethod("squash"))
+func (pr *PullRequest) WaitForChecks(ctx context.Context, opts WaitOptions) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 10 * time.Second // default
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	for {
+		if err := pr.Refresh(ctx); err != nil {
+			return err
+		}
+
+		outcomes, err := pr.checkOutcomes(ctx)
+		if err != nil {
+			return err
+		}
+
+		pending, failed := evaluateChecks(outcomes, opts.RequiredChecks)
+		if len(failed) > 0 && (opts.FailFast || len(pending) == 0) {
+			return errors.Newf(errors.CodeExecutionFailed, "pull request checks failed: %s", strings.Join(failed, ", "))
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrapf(ctx.Err(), errors.CodeTimeout, "pull request checks wait cancelled or timed out (pending: %s)", strings.Join(pending, ", "))
+		case <-timer.C:
+		}
+
+		if opts.MaxInterval > interval {
+			interval = min(interval*2, opts.MaxInterval)
+		}
+	}
+}
+
+// checkOutcomes returns the outcome of every check run and commit status
+// context reported for the head commit, keyed by name.
+func (pr *PullRequest) checkOutcomes(ctx context.Context) (map[string]string, error) {
+	runs, err := pr.client.provider.ListCheckRuns(ctx, pr.owner, pr.repo, pr.data.HeadSHA)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list check runs")
+	}
+
+	status, err := pr.client.provider.GetCombinedStatus(ctx, pr.owner, pr.repo, pr.data.HeadSHA)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to get commit status")
+	}
+
+	outcomes := make(map[string]string, len(runs)+len(status.Statuses))
+	record := func(name, outcome string) {
+		// Failures take precedence over pending checks, which take
+		// precedence over passed ones
+		switch current := outcomes[name]; {
+		case current == checkFailed:
+		case current == checkPending && outcome == checkPassed:
+		default:
+			outcomes[name] = outcome
+		}
+	}
+
+	for _, run := range runs {
+		record(run.Name, checkRunOutcome(run))
+	}
+	for _, s := range status.Statuses {
+		record(s.Context, commitStatusOutcome(s))
+	}
+
+	return outcomes, nil
+}
+
+// checkRunOutcome maps a check run to a check outcome. Neutral and skipped
+// runs do not block a merge and count as passed.
+func checkRunOutcome(run *CheckRunData) string {
+	if run.Status != "completed" {
+		return checkPending
+	}
+
+	switch run.Conclusion {
+	case "success", "neutral", "skipped":
+		return checkPassed
+	default:
+		return checkFailed
+	}
+}
+
+// commitStatusOutcome maps a commit status to a check outcome.
+func commitStatusOutcome(status *CommitStatusData) string {
+	switch status.State {
+	case "success":
+		return checkPassed
+	case "pending":
+		return checkPending
+	default:
+		return checkFailed
+	}
+}
+
+// evaluateChecks returns the sorted names of the pending and failed checks.
+// When required is non-empty only those checks are considered, and required
+// checks missing from outcomes are pending.
+func evaluateChecks(outcomes map[string]string, required []string) (pending, failed []string) {
+	names := required
+	if len(names) == 0 {
+		names = make([]string, 0, len(outcomes))
+		for name := range outcomes {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range names {
+		switch outcome, ok := outcomes[name]; {
+		case !ok, outcome == checkPending:
+			pending = append(pending, name)
+		case outcome == checkFailed:
+			failed = append(failed, name)
+		}
+	}
+
+	sort.Strings(pending)
+	sort.Strings(failed)
+	return pending, failed
+}
@@ -0,0 +1,45 @@
+package github
+
+import "context"
+
+// ListCommits lists commits in the repository, newest first.
+// Without options, the most recent commits on the default branch are returned.
+//
+// Example:
+//
+//	commits, err := repo.ListCommits(ctx,
+//	    github.WithCommitRef("main"),
+//	    github.WithCommitPath("cmd/"),
+//	    github.WithCommitsSince(lastRelease),
+//	)
+func (r *Repository) ListCommits(ctx context.Context, opts ...CommitFilterOption) ([]*CommitData, error) {
+	listOpts := ListCommitsOptions{}
+
+	for _, opt := range opts {
+		opt(&listOpts)
+	}
+
+	commits, err := r.client.provider.ListCommits(ctx, r.owner, r.name, listOpts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list commits")
+	}
+	return commits, nil
+}
+
+// CompareCommits compares base and head, which may be branches, tags, or
+// SHAs. The result reports how far head is ahead of and behind base, the
+// commits unique to head, and per-file change statistics.
+//
+// GitHub includes at most 250 commits and 300 files in a comparison.
+//
+// Example:
+//
+//	cmp, err := repo.CompareCommits(ctx, "v1.2.0", "main")
+//	fmt.Printf("%d commits since v1.2.0, %d files changed\n", cmp.AheadBy, len(cmp.Files))
+func (r *Repository) CompareCommits(ctx context.Context, base, head string) (*ComparisonData, error) {
+	comparison, err := r.client.provider.CompareCommits(ctx, r.owner, r.name, base, head, ListOptions{})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to compare commits")
+	}
+	return comparison, nil
+}
@@ -174,7 +174,12 @@
 //	    fmt.Printf("Created PR #%d: %s\n", pr.Number(), pr.HTMLURL())
 //
 //	    // Wait for checks to complete, then merge
-//	    if err := pr.Refresh(ctx); err != nil {
+//	    err = pr.WaitForChecks(ctx, github.WaitOptions{
+//	        Interval: 30 * time.Second,
+//	        Timeout:  30 * time.Minute,
+//	        FailFast: true,
+//	    })
+//	    if err != nil {
 //	        return err
 //	    }
 //
@@ -0,0 +1,148 @@
+package github
+
+import (
+	"context"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// Environments manages the deployment environments of a repository.
+//
+// Environments instances are obtained from a Repository:
+//
+//	envs := repo.Environments()
+//	env, err := envs.CreateOrUpdate(ctx, "production", github.EnvironmentOptions{
+//	    WaitTimer: 30,
+//	    Reviewers: []github.EnvironmentReviewer{{Type: "Team", ID: 42}},
+//	    DeploymentBranchPolicy: &github.DeploymentBranchPolicy{CustomBranchPolicies: true},
+//	})
+//	err = envs.SetBranchPolicies(ctx, "production",
+//	    github.DeploymentBranchPolicyData{Name: "main"},
+//	    github.DeploymentBranchPolicyData{Name: "v*", Type: "tag"},
+//	)
+type Environments struct {
+	client *Client
+	owner  string
+	repo   string
+}
+
+// Environments returns the deployment environments of the repository.
+func (r *Repository) Environments() *Environments {
+	return &Environments{
+		client: r.client,
+		owner:  r.owner,
+		repo:   r.name,
+	}
+}
+
+// List lists all deployment environments of the repository.
+func (e *Environments) List(ctx context.Context) ([]*EnvironmentData, error) {
+	var all []*EnvironmentData
+	for page := 1; ; page++ {
+		envs, err := e.client.provider.ListEnvironments(ctx, e.owner, e.repo, ListOptions{Page: page, PerPage: 100})
+		if err != nil {
+			return nil, WrapHTTPError(err, 0, "failed to list environments")
+		}
+		all = append(all, envs...)
+		if len(envs) < 100 {
+			return all, nil
+		}
+	}
+}
+
+// Get retrieves the environment with the given name.
+func (e *Environments) Get(ctx context.Context, name string) (*EnvironmentData, error) {
+	env, err := e.client.provider.GetEnvironment(ctx, e.owner, e.repo, name)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to get environment")
+	}
+	return env, nil
+}
+
+// CreateOrUpdate creates the environment with the given name or replaces
+// the protection rules of an existing one with opts. Rules not set in opts
+// are removed, so the environment always matches opts after the call.
+//
+// When opts enables custom branch policies, use SetBranchPolicies to define
+// the allowed refs.
+func (e *Environments) CreateOrUpdate(ctx context.Context, name string, opts EnvironmentOptions) (*EnvironmentData, error) {
+	env, err := e.client.provider.CreateOrUpdateEnvironment(ctx, e.owner, e.repo, name, opts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create or update environment")
+	}
+	return env, nil
+}
+
+// Delete deletes the environment with the given name, including its secrets
+// and variables.
+func (e *Environments) Delete(ctx context.Context, name string) error {
+	if err := e.client.provider.DeleteEnvironment(ctx, e.owner, e.repo, name); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete environment")
+	}
+	return nil
+}
+
+// ListBranchPolicies lists the custom deployment branch policies of an
+// environment.
+func (e *Environments) ListBranchPolicies(ctx context.Context, environment string) ([]*DeploymentBranchPolicyData, error) {
+	policies, err := e.client.provider.ListDeploymentBranchPolicies(ctx, e.owner, e.repo, environment)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list deployment branch policies")
+	}
+	return policies, nil
+}
+
+// SetBranchPolicies makes the custom deployment branch policies of an
+// environment match policies exactly. Missing policies are created and
+// policies not in the list are deleted; existing matches are left untouched.
+// A policy with an empty Type is treated as a branch policy.
+//
+// The environment must have been configured with custom branch policies.
+// Returns ErrInvalidInput if a policy has no name.
+func (e *Environments) SetBranchPolicies(ctx context.Context, environment string, policies ...DeploymentBranchPolicyData) error {
+	desired := make(map[DeploymentBranchPolicyData]bool, len(policies))
+	for _, policy := range policies {
+		if policy.Name == "" {
+			return errors.New(errors.CodeInvalidInput, "deployment branch policy requires a name")
+		}
+		desired[branchPolicyKey(policy)] = true
+	}
+
+	existing, err := e.ListBranchPolicies(ctx, environment)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range existing {
+		key := branchPolicyKey(*policy)
+		if desired[key] {
+			delete(desired, key)
+			continue
+		}
+		if err := e.client.provider.DeleteDeploymentBranchPolicy(ctx, e.owner, e.repo, environment, policy.ID); err != nil {
+			return WrapHTTPError(err, 0, "failed to delete deployment branch policy")
+		}
+	}
+
+	// Create in the order given so results are deterministic
+	for _, policy := range policies {
+		key := branchPolicyKey(policy)
+		if !desired[key] {
+			continue
+		}
+		delete(desired, key)
+		if _, err := e.client.provider.CreateDeploymentBranchPolicy(ctx, e.owner, e.repo, environment, key); err != nil {
+			return WrapHTTPError(err, 0, "failed to create deployment branch policy")
+		}
+	}
+
+	return nil
+}
+
+// branchPolicyKey returns the identity of a policy: its pattern and type.
+func branchPolicyKey(policy DeploymentBranchPolicyData) DeploymentBranchPolicyData {
+	if policy.Type == "" {
+		policy.Type = "branch"
+	}
+	return DeploymentBranchPolicyData{Name: policy.Name, Type: policy.Type}
+}
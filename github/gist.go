@@ -0,0 +1,125 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// Gists manages the gists of the authenticated user.
+//
+// Gists instances are obtained from a Client:
+//
+//	gists := client.Gists()
+//	gist, err := gists.Create(ctx, github.CreateGistOptions{
+//	    Description: "Debug bundle for run 1234",
+//	    Files: map[string]string{
+//	        "env.txt":   env,
+//	        "trace.log": trace,
+//	    },
+//	})
+//	fmt.Println(gist.HTMLURL)
+type Gists struct {
+	client *Client
+}
+
+// Gists returns the gists of the authenticated user.
+func (c *Client) Gists() *Gists {
+	return &Gists{client: c}
+}
+
+// List lists all gists of the authenticated user. File content is not
+// included; use Get or ReadFile to retrieve it.
+func (g *Gists) List(ctx context.Context) ([]*GistData, error) {
+	var all []*GistData
+	for page := 1; ; page++ {
+		gists, err := g.client.provider.ListGists(ctx, ListOptions{Page: page, PerPage: 100})
+		if err != nil {
+			return nil, WrapHTTPError(err, 0, "failed to list gists")
+		}
+		all = append(all, gists...)
+		if len(gists) < 100 {
+			return all, nil
+		}
+	}
+}
+
+// Get retrieves a gist, including the content of its files.
+func (g *Gists) Get(ctx context.Context, id string) (*GistData, error) {
+	gist, err := g.client.provider.GetGist(ctx, id)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to get gist")
+	}
+	return gist, nil
+}
+
+// Create creates a gist. Gists are secret unless Public is set.
+// Returns ErrInvalidInput if no files are given.
+func (g *Gists) Create(ctx context.Context, opts CreateGistOptions) (*GistData, error) {
+	if len(opts.Files) == 0 {
+		return nil, errors.New(errors.CodeInvalidInput, "gist requires at least one file")
+	}
+
+	gist, err := g.client.provider.CreateGist(ctx, opts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create gist")
+	}
+	return gist, nil
+}
+
+// Update updates the description and files of a gist. Files not mentioned
+// in opts are left unchanged.
+//
+// Example:
+//
+//	gist, err := gists.Update(ctx, id, github.UpdateGistOptions{
+//	    Files:       map[string]string{"trace.log": newTrace},
+//	    DeleteFiles: []string{"env.txt"},
+//	})
+func (g *Gists) Update(ctx context.Context, id string, opts UpdateGistOptions) (*GistData, error) {
+	gist, err := g.client.provider.UpdateGist(ctx, id, opts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to update gist")
+	}
+	return gist, nil
+}
+
+// Delete deletes a gist.
+func (g *Gists) Delete(ctx context.Context, id string) error {
+	if err := g.client.provider.DeleteGist(ctx, id); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete gist")
+	}
+	return nil
+}
+
+// ReadFile returns the full content of a file in a gist. Files too large to
+// be included in the gist response are downloaded from their raw URL.
+// Returns ErrNotFound if the gist has no file with the given name.
+func (g *Gists) ReadFile(ctx context.Context, id, filename string) ([]byte, error) {
+	gist, err := g.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	file, ok := gist.Files[filename]
+	if !ok {
+		return nil, errors.New(errors.CodeNotFound, fmt.Sprintf("gist %s has no file %q", id, filename))
+	}
+	if !file.Truncated {
+		return []byte(file.Content), nil
+	}
+
+	rc, err := g.client.provider.DownloadGistFile(ctx, file.RawURL)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to download gist file")
+	}
+	defer func() { _ = rc.Close() }()
+
+	content, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeNetwork, "failed to read gist file")
+	}
+	return content, nil
+}
@@ -6,7 +6,9 @@ require (
 	github.com/google/go-github/v67 v67.0.0
 	github.com/jmgilman/go/errors v0.1.0
 	github.com/jmgilman/go/exec v0.2.0
+	github.com/jmgilman/go/fs/core v0.2.0
 	github.com/stretchr/testify v1.11.1
+	golang.org/x/crypto v0.42.0
 )
 
 require (
@@ -16,5 +18,6 @@ require (
 	github.com/kr/text v0.2.0 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/rogpeppe/go-internal v1.14.1 // indirect
+	golang.org/x/sys v0.36.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,341 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// GraphQLError is an error returned in the errors array of a GraphQL response.
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Type    string        `json:"type,omitempty"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// DecodeGraphQLResponse decodes a raw GraphQL response body and unmarshals its
+// data field into result.
+//
+// If the response contains errors, they are converted into a PlatformError
+// whose code is derived from the type of the first error. Partial data is
+// discarded in that case.
+//
+// This is used by provider implementations and is not typically called directly.
+func DecodeGraphQLResponse(body []byte, result interface{}) error {
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []GraphQLError  `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to parse GraphQL response")
+	}
+
+	if len(resp.Errors) > 0 {
+		messages := make([]string, len(resp.Errors))
+		for i, e := range resp.Errors {
+			messages[i] = e.Message
+		}
+		err := errors.New(graphQLErrorCode(resp.Errors[0].Type), "GraphQL query failed: "+strings.Join(messages, "; "))
+		return errors.WithContext(err, "graphql_errors", resp.Errors)
+	}
+
+	if result == nil || len(resp.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(resp.Data, result); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to decode GraphQL data")
+	}
+	return nil
+}
+
+// graphQLErrorCode maps a GraphQL error type to an ErrorCode.
+func graphQLErrorCode(errType string) errors.ErrorCode {
+	switch errType {
+	case "NOT_FOUND":
+		return errors.CodeNotFound
+	case "FORBIDDEN", "INSUFFICIENT_SCOPES":
+		return errors.CodeForbidden
+	case "RATE_LIMITED":
+		return errors.CodeRateLimit
+	default:
+		return errors.CodeInvalidInput
+	}
+}
+
+// prStatusQuery fetches pull requests together with their reviews and the
+// check runs of their head commit in a single request.
+const prStatusQuery = `query($owner: String!, $repo: String!, $first: Int!, $states: [PullRequestState!], $head: String, $base: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequests(first: $first, states: $states, headRefName: $head, baseRefName: $base, orderBy: {field: UPDATED_AT, direction: DESC}) {
+      nodes {
+        number
+        title
+        body
+        state
+        isDraft
+        mergeable
+        merged
+        url
+        author { login }
+        headRefName
+        headRefOid
+        baseRefName
+        createdAt
+        updatedAt
+        mergedAt
+        closedAt
+        labels(first: 100) { nodes { name } }
+        reviews(last: 100) {
+          nodes {
+            databaseId
+            body
+            state
+            url
+            submittedAt
+            author { login }
+            commit { oid }
+          }
+        }
+        commits(last: 1) {
+          nodes {
+            commit {
+              statusCheckRollup {
+                contexts(first: 100) {
+                  nodes {
+                    ... on CheckRun {
+                      databaseId
+                      name
+                      status
+                      conclusion
+                      detailsUrl
+                      startedAt
+                      completedAt
+                    }
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// FetchPRsWithReviewsAndChecks retrieves pull requests along with their
+// reviews and the check runs of their head commit using a single GraphQL
+// query. The same information would otherwise take several REST calls per
+// pull request.
+//
+// The filter options match ListPullRequests and results are ordered by most
+// recently updated. Only the first page of results is returned; PerPage
+// controls its size (at most 100).
+//
+// Example:
+//
+//	statuses, err := repo.FetchPRsWithReviewsAndChecks(ctx, github.WithBase("main"))
+//	for _, s := range statuses {
+//	    fmt.Printf("#%d: %d reviews, %d checks\n", s.PullRequest.Number, len(s.Reviews), len(s.Checks))
+//	}
+func (r *Repository) FetchPRsWithReviewsAndChecks(ctx context.Context, opts ...PRFilterOption) ([]*PullRequestStatusData, error) {
+	listOpts := ListPullRequestsOptions{
+		State: StateOpen, // default to open
+	}
+	for _, opt := range opts {
+		opt(&listOpts)
+	}
+
+	first := listOpts.PerPage
+	if first <= 0 || first > 100 {
+		first = 30
+	}
+
+	vars := map[string]interface{}{
+		"owner": r.owner,
+		"repo":  r.name,
+		"first": first,
+	}
+	switch listOpts.State {
+	case StateOpen:
+		vars["states"] = []string{"OPEN"}
+	case StateClosed:
+		vars["states"] = []string{"CLOSED", "MERGED"}
+	}
+	if listOpts.Head != "" {
+		vars["head"] = listOpts.Head
+	}
+	if listOpts.Base != "" {
+		vars["base"] = listOpts.Base
+	}
+
+	var result struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []graphQLPullRequest `json:"nodes"`
+			} `json:"pullRequests"`
+		} `json:"repository"`
+	}
+	if err := r.client.provider.QueryGraphQL(ctx, prStatusQuery, vars, &result); err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to fetch pull requests with reviews and checks")
+	}
+
+	statuses := make([]*PullRequestStatusData, len(result.Repository.PullRequests.Nodes))
+	for i, node := range result.Repository.PullRequests.Nodes {
+		statuses[i] = node.convert()
+	}
+
+	return statuses, nil
+}
+
+// graphQLActor is a GraphQL actor reference.
+type graphQLActor struct {
+	Login string `json:"login"`
+}
+
+// graphQLPullRequest is the shape of a pull request node in prStatusQuery.
+type graphQLPullRequest struct {
+	Number      int           `json:"number"`
+	Title       string        `json:"title"`
+	Body        string        `json:"body"`
+	State       string        `json:"state"`
+	IsDraft     bool          `json:"isDraft"`
+	Mergeable   string        `json:"mergeable"`
+	Merged      bool          `json:"merged"`
+	URL         string        `json:"url"`
+	Author      *graphQLActor `json:"author"`
+	HeadRefName string        `json:"headRefName"`
+	HeadRefOid  string        `json:"headRefOid"`
+	BaseRefName string        `json:"baseRefName"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+	MergedAt    *time.Time    `json:"mergedAt"`
+	ClosedAt    *time.Time    `json:"closedAt"`
+	Labels      struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	Reviews struct {
+		Nodes []struct {
+			DatabaseID  int64         `json:"databaseId"`
+			Body        string        `json:"body"`
+			State       string        `json:"state"`
+			URL         string        `json:"url"`
+			SubmittedAt *time.Time    `json:"submittedAt"`
+			Author      *graphQLActor `json:"author"`
+			Commit      *struct {
+				Oid string `json:"oid"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"reviews"`
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					Contexts struct {
+						Nodes []struct {
+							DatabaseID  int64      `json:"databaseId"`
+							Name        string     `json:"name"`
+							Status      string     `json:"status"`
+							Conclusion  string     `json:"conclusion"`
+							DetailsURL  string     `json:"detailsUrl"`
+							StartedAt   *time.Time `json:"startedAt"`
+							CompletedAt *time.Time `json:"completedAt"`
+						} `json:"nodes"`
+					} `json:"contexts"`
+				} `json:"statusCheckRollup"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"commits"`
+}
+
+// convert converts a GraphQL pull request node to PullRequestStatusData.
+func (n *graphQLPullRequest) convert() *PullRequestStatusData {
+	pr := &PullRequestData{
+		Number:    n.Number,
+		Title:     n.Title,
+		Body:      n.Body,
+		HeadRef:   n.HeadRefName,
+		BaseRef:   n.BaseRefName,
+		HeadSHA:   n.HeadRefOid,
+		State:     StateOpen,
+		Labels:    make([]string, 0, len(n.Labels.Nodes)),
+		Draft:     n.IsDraft,
+		Merged:    n.Merged,
+		HTMLURL:   n.URL,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+		MergedAt:  n.MergedAt,
+		ClosedAt:  n.ClosedAt,
+	}
+
+	// GraphQL reports merged pull requests with a separate MERGED state
+	if n.State != "OPEN" {
+		pr.State = StateClosed
+	}
+	if n.Author != nil {
+		pr.Author = n.Author.Login
+	}
+	for _, label := range n.Labels.Nodes {
+		pr.Labels = append(pr.Labels, label.Name)
+	}
+
+	// Mergeability is UNKNOWN while GitHub computes it in the background
+	switch n.Mergeable {
+	case "MERGEABLE":
+		mergeable := true
+		pr.Mergeable = &mergeable
+	case "CONFLICTING":
+		mergeable := false
+		pr.Mergeable = &mergeable
+	}
+
+	status := &PullRequestStatusData{
+		PullRequest: pr,
+		Reviews:     make([]*ReviewData, 0, len(n.Reviews.Nodes)),
+		Checks:      []*CheckRunData{},
+	}
+
+	for _, node := range n.Reviews.Nodes {
+		review := &ReviewData{
+			ID:          node.DatabaseID,
+			Body:        node.Body,
+			State:       node.State,
+			HTMLURL:     node.URL,
+			SubmittedAt: node.SubmittedAt,
+		}
+		if node.Author != nil {
+			review.Author = node.Author.Login
+		}
+		if node.Commit != nil {
+			review.CommitID = node.Commit.Oid
+		}
+		status.Reviews = append(status.Reviews, review)
+	}
+
+	for _, commit := range n.Commits.Nodes {
+		if commit.Commit.StatusCheckRollup == nil {
+			continue
+		}
+		for _, node := range commit.Commit.StatusCheckRollup.Contexts.Nodes {
+			// Commit status contexts are not selected and decode as empty nodes
+			if node.Name == "" {
+				continue
+			}
+			status.Checks = append(status.Checks, &CheckRunData{
+				ID:          node.DatabaseID,
+				Name:        node.Name,
+				Status:      strings.ToLower(node.Status),
+				Conclusion:  strings.ToLower(node.Conclusion),
+				DetailsURL:  node.DetailsURL,
+				StartedAt:   node.StartedAt,
+				CompletedAt: node.CompletedAt,
+			})
+		}
+	}
+
+	return status
+}
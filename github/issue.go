@@ -111,6 +111,66 @@ func (i *Issue) RemoveLabel(ctx context.Context, label string) error {
 	return nil
 }
 
+// CreateComment posts a comment on the issue.
+//
+// Example:
+//
+//	comment, err := issue.CreateComment(ctx, "Build succeeded")
+func (i *Issue) CreateComment(ctx context.Context, body string) (*CommentData, error) {
+	data, err := i.client.provider.CreateComment(ctx, i.owner, i.repo, i.data.Number, body)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create comment")
+	}
+	return data, nil
+}
+
+// ListComments lists the comments on the issue.
+func (i *Issue) ListComments(ctx context.Context) ([]*CommentData, error) {
+	comments, err := i.client.provider.ListComments(ctx, i.owner, i.repo, i.data.Number, ListOptions{})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list comments")
+	}
+	return comments, nil
+}
+
+// UpdateComment replaces the body of a comment on the issue.
+// This allows bots to edit a previous status comment instead of posting a new one.
+//
+// Example:
+//
+//	comment, err := issue.UpdateComment(ctx, comment.ID, "Build failed")
+func (i *Issue) UpdateComment(ctx context.Context, commentID int64, body string) (*CommentData, error) {
+	data, err := i.client.provider.UpdateComment(ctx, i.owner, i.repo, commentID, body)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to update comment")
+	}
+	return data, nil
+}
+
+// DeleteComment deletes a comment on the issue.
+func (i *Issue) DeleteComment(ctx context.Context, commentID int64) error {
+	if err := i.client.provider.DeleteComment(ctx, i.owner, i.repo, commentID); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete comment")
+	}
+	return nil
+}
+
+// AddReaction adds a reaction (e.g., ReactionRocket) to the issue.
+func (i *Issue) AddReaction(ctx context.Context, content string) error {
+	if err := i.client.provider.AddIssueReaction(ctx, i.owner, i.repo, i.data.Number, content); err != nil {
+		return WrapHTTPError(err, 0, "failed to add reaction")
+	}
+	return nil
+}
+
+// AddCommentReaction adds a reaction (e.g., ReactionPlusOne) to a comment on the issue.
+func (i *Issue) AddCommentReaction(ctx context.Context, commentID int64, content string) error {
+	if err := i.client.provider.AddCommentReaction(ctx, i.owner, i.repo, commentID, content); err != nil {
+		return WrapHTTPError(err, 0, "failed to add comment reaction")
+	}
+	return nil
+}
+
 // Number returns the issue number.
 func (i *Issue) Number() int {
 	return i.data.Number
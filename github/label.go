@@ -0,0 +1,70 @@
+package github
+
+import (
+	"context"
+	"strings"
+)
+
+// ListLabels lists all labels defined in the repository.
+func (r *Repository) ListLabels(ctx context.Context) ([]*LabelData, error) {
+	labels, err := r.client.provider.ListLabels(ctx, r.owner, r.name, ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list labels")
+	}
+	return labels, nil
+}
+
+// CreateLabel creates a label in the repository.
+// A leading # in the color is accepted and stripped.
+//
+// Example:
+//
+//	label, err := repo.CreateLabel(ctx, github.CreateLabelOptions{
+//	    Name:        "bug",
+//	    Color:       "d73a4a",
+//	    Description: "Something isn't working",
+//	})
+func (r *Repository) CreateLabel(ctx context.Context, opts CreateLabelOptions) (*LabelData, error) {
+	opts.Color = normalizeLabelColor(opts.Color)
+
+	label, err := r.client.provider.CreateLabel(ctx, r.owner, r.name, opts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create label")
+	}
+	return label, nil
+}
+
+// UpdateLabel updates the label with the given name.
+// Only non-nil fields in opts are updated. A leading # in the color is
+// accepted and stripped.
+//
+// Example:
+//
+//	color := "0e8a16"
+//	label, err := repo.UpdateLabel(ctx, "bug", github.UpdateLabelOptions{Color: &color})
+func (r *Repository) UpdateLabel(ctx context.Context, name string, opts UpdateLabelOptions) (*LabelData, error) {
+	if opts.Color != nil {
+		color := normalizeLabelColor(*opts.Color)
+		opts.Color = &color
+	}
+
+	label, err := r.client.provider.UpdateLabel(ctx, r.owner, r.name, name, opts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to update label")
+	}
+	return label, nil
+}
+
+// DeleteLabel deletes the label with the given name from the repository.
+// The label is removed from all issues and pull requests.
+func (r *Repository) DeleteLabel(ctx context.Context, name string) error {
+	if err := r.client.provider.DeleteLabel(ctx, r.owner, r.name, name); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete label")
+	}
+	return nil
+}
+
+// normalizeLabelColor strips the leading # that the API rejects.
+func normalizeLabelColor(color string) string {
+	return strings.TrimPrefix(color, "#")
+}
@@ -0,0 +1,63 @@
+package github
+
+import "context"
+
+// ListMilestones lists the milestones in the repository with the given state
+// ("open", "closed", "all"). An empty state lists open milestones.
+func (r *Repository) ListMilestones(ctx context.Context, state string) ([]*MilestoneData, error) {
+	milestones, err := r.client.provider.ListMilestones(ctx, r.owner, r.name, ListMilestonesOptions{State: state})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list milestones")
+	}
+	return milestones, nil
+}
+
+// GetMilestone retrieves a milestone by number.
+func (r *Repository) GetMilestone(ctx context.Context, number int) (*MilestoneData, error) {
+	milestone, err := r.client.provider.GetMilestone(ctx, r.owner, r.name, number)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to get milestone")
+	}
+	return milestone, nil
+}
+
+// CreateMilestone creates a milestone in the repository.
+//
+// Example:
+//
+//	due := time.Now().AddDate(0, 0, 14)
+//	milestone, err := repo.CreateMilestone(ctx, github.CreateMilestoneOptions{
+//	    Title: "Sprint 12",
+//	    DueOn: &due,
+//	})
+func (r *Repository) CreateMilestone(ctx context.Context, opts CreateMilestoneOptions) (*MilestoneData, error) {
+	milestone, err := r.client.provider.CreateMilestone(ctx, r.owner, r.name, opts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create milestone")
+	}
+	return milestone, nil
+}
+
+// UpdateMilestone updates the milestone with the given number.
+// Only non-nil fields in opts are updated.
+//
+// Example:
+//
+//	state := "closed"
+//	milestone, err := repo.UpdateMilestone(ctx, 3, github.UpdateMilestoneOptions{State: &state})
+func (r *Repository) UpdateMilestone(ctx context.Context, number int, opts UpdateMilestoneOptions) (*MilestoneData, error) {
+	milestone, err := r.client.provider.UpdateMilestone(ctx, r.owner, r.name, number, opts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to update milestone")
+	}
+	return milestone, nil
+}
+
+// DeleteMilestone deletes the milestone with the given number.
+// Issues and pull requests in the milestone are left without one.
+func (r *Repository) DeleteMilestone(ctx context.Context, number int) error {
+	if err := r.client.provider.DeleteMilestone(ctx, r.owner, r.name, number); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete milestone")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package mocks_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jmgilman/go/github"
+	"github.com/jmgilman/go/github/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowRunArtifacts(t *testing.T) {
+	ctx := context.Background()
+
+	var pages []int
+	mock := &mocks.ProviderMock{
+		GetWorkflowRunFunc: func(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRunData, error) {
+			return &github.WorkflowRunData{ID: runID}, nil
+		},
+		ListArtifactsFunc: func(ctx context.Context, owner string, repo string, runID int64, opts github.ListOptions) ([]*github.ArtifactData, error) {
+			pages = append(pages, opts.Page)
+			count := opts.PerPage
+			if opts.Page == 2 {
+				count = 1
+			}
+			artifacts := make([]*github.ArtifactData, count)
+			for i := range artifacts {
+				artifacts[i] = &github.ArtifactData{ID: int64(opts.Page*1000 + i)}
+			}
+			return artifacts, nil
+		},
+		DownloadArtifactFunc: func(ctx context.Context, owner string, repo string, artifactID int64) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("zip-bytes")), nil
+		},
+	}
+
+	run, err := github.NewClient(mock, "testowner").Repository("testrepo").GetWorkflowRun(ctx, 42)
+	require.NoError(t, err)
+
+	t.Run("lists every page", func(t *testing.T) {
+		artifacts, err := run.ListArtifacts(ctx)
+		require.NoError(t, err)
+		assert.Len(t, artifacts, 101)
+		assert.Equal(t, []int{1, 2}, pages)
+	})
+
+	t.Run("streams the archive to a writer", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, run.WriteArtifact(ctx, 7, &buf))
+		assert.Equal(t, "zip-bytes", buf.String())
+	})
+}
@@ -0,0 +1,218 @@
+package mocks_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/github"
+	"github.com/jmgilman/go/github/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLCall records a QueryGraphQL call.
+type graphQLCall struct {
+	query string
+	vars  map[string]interface{}
+}
+
+// mergeTargetMock returns a provider for pull request 7 of testowner/testrepo,
+// whose GraphQL API answers the merge target query with target and every
+// mutation with mutationErr, or an enqueued entry on success.
+func mergeTargetMock(target string, mutationErr error) (*mocks.ProviderMock, *[]graphQLCall) {
+	var calls []graphQLCall
+	mock := &mocks.ProviderMock{
+		GetPullRequestFunc: func(ctx context.Context, owner string, repo string, number int) (*github.PullRequestData, error) {
+			return &github.PullRequestData{Number: number, BaseRef: "main"}, nil
+		},
+		QueryGraphQLFunc: func(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error {
+			calls = append(calls, graphQLCall{query: query, vars: vars})
+			if strings.HasPrefix(query, "query") {
+				return json.Unmarshal([]byte(target), result)
+			}
+			if mutationErr != nil {
+				return mutationErr
+			}
+			if result == nil {
+				return nil
+			}
+			return json.Unmarshal([]byte(`{"enqueuePullRequest": {"mergeQueueEntry": {"id": "MQE_1", "position": 2, "state": "QUEUED"}}}`), result)
+		},
+		MergePullRequestFunc: func(ctx context.Context, owner string, repo string, number int, opts github.MergePullRequestOptions) error {
+			return nil
+		},
+	}
+	return mock, &calls
+}
+
+const (
+	// withoutQueue is a merge target whose base branch has no merge queue.
+	withoutQueue = `{"repository": {"pullRequest": {"id": "PR_node"}, "mergeQueue": null}}`
+
+	// withQueue is a merge target whose base branch has a merge queue.
+	withQueue = `{"repository": {"pullRequest": {"id": "PR_node"}, "mergeQueue": {"id": "MQ_1"}}}`
+)
+
+func getPullRequest(t *testing.T, mock *mocks.ProviderMock) *github.PullRequest {
+	t.Helper()
+
+	pr, err := github.NewClient(mock, "testowner").Repository("testrepo").GetPullRequest(context.Background(), 7)
+	require.NoError(t, err)
+	return pr
+}
+
+func TestEnableAutoMerge(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("resolves the pull request and enables auto-merge", func(t *testing.T) {
+		mock, calls := mergeTargetMock(withoutQueue, nil)
+		require.NoError(t, getPullRequest(t, mock).EnableAutoMerge(ctx, github.MergeMethodSquash))
+
+		require.Len(t, *calls, 2)
+		assert.Equal(t, map[string]interface{}{
+			"owner":  "testowner",
+			"repo":   "testrepo",
+			"number": 7,
+			"base":   "main",
+		}, (*calls)[0].vars)
+		assert.Contains(t, (*calls)[1].query, "enablePullRequestAutoMerge")
+		assert.Equal(t, map[string]interface{}{
+			"pullRequest": "PR_node",
+			"method":      "SQUASH",
+		}, (*calls)[1].vars)
+	})
+
+	t.Run("rejects unknown merge methods", func(t *testing.T) {
+		mock, calls := mergeTargetMock(withoutQueue, nil)
+		err := getPullRequest(t, mock).EnableAutoMerge(ctx, "fast-forward")
+		assert.Equal(t, errors.CodeInvalidInput, errors.GetCode(err))
+		assert.Empty(t, *calls)
+	})
+
+	t.Run("reports missing pull requests", func(t *testing.T) {
+		mock, calls := mergeTargetMock(`{"repository": {"pullRequest": null, "mergeQueue": null}}`, nil)
+		err := getPullRequest(t, mock).EnableAutoMerge(ctx, github.MergeMethodMerge)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+		assert.Len(t, *calls, 1)
+	})
+
+	t.Run("wraps mutation errors", func(t *testing.T) {
+		cause := errors.New(errors.CodeForbidden, "auto-merge is not allowed")
+		mock, _ := mergeTargetMock(withoutQueue, cause)
+		err := getPullRequest(t, mock).EnableAutoMerge(ctx, github.MergeMethodMerge)
+		require.ErrorIs(t, err, cause)
+		assert.Contains(t, err.Error(), "failed to enable auto-merge")
+	})
+}
+
+func TestDisableAutoMerge(t *testing.T) {
+	ctx := context.Background()
+
+	mock, calls := mergeTargetMock(withoutQueue, nil)
+	require.NoError(t, getPullRequest(t, mock).DisableAutoMerge(ctx))
+	require.Len(t, *calls, 2)
+	assert.Contains(t, (*calls)[1].query, "disablePullRequestAutoMerge")
+	assert.Equal(t, map[string]interface{}{"pullRequest": "PR_node"}, (*calls)[1].vars)
+
+	mock, _ = mergeTargetMock(`{"repository": null}`, nil)
+	err := getPullRequest(t, mock).DisableAutoMerge(ctx)
+	assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+}
+
+func TestMergeQueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("adds the pull request to the queue", func(t *testing.T) {
+		mock, calls := mergeTargetMock(withQueue, nil)
+		entry, err := getPullRequest(t, mock).AddToMergeQueue(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		assert.Equal(t, "MQE_1", entry.ID)
+		assert.Equal(t, 2, entry.Position)
+		assert.Equal(t, "QUEUED", entry.State)
+
+		require.Len(t, *calls, 2)
+		assert.Contains(t, (*calls)[1].query, "enqueuePullRequest")
+		assert.Equal(t, map[string]interface{}{"pullRequest": "PR_node"}, (*calls)[1].vars)
+	})
+
+	t.Run("removes the pull request from the queue", func(t *testing.T) {
+		mock, calls := mergeTargetMock(withQueue, nil)
+		require.NoError(t, getPullRequest(t, mock).RemoveFromMergeQueue(ctx))
+		require.Len(t, *calls, 2)
+		assert.Contains(t, (*calls)[1].query, "dequeuePullRequest")
+		assert.Equal(t, map[string]interface{}{"pullRequest": "PR_node"}, (*calls)[1].vars)
+	})
+
+	t.Run("wraps mutation errors", func(t *testing.T) {
+		cause := errors.New(errors.CodeConflict, "pull request is not mergeable")
+		mock, _ := mergeTargetMock(withQueue, cause)
+		pr := getPullRequest(t, mock)
+
+		entry, err := pr.AddToMergeQueue(ctx)
+		require.ErrorIs(t, err, cause)
+		assert.Nil(t, entry)
+		assert.Contains(t, err.Error(), "failed to add pull request to merge queue")
+
+		err = pr.RemoveFromMergeQueue(ctx)
+		require.ErrorIs(t, err, cause)
+		assert.Contains(t, err.Error(), "failed to remove pull request from merge queue")
+	})
+
+	t.Run("reports resolution errors", func(t *testing.T) {
+		cause := errors.New(errors.CodeNetwork, "connection reset")
+		mock := &mocks.ProviderMock{
+			GetPullRequestFunc: func(ctx context.Context, owner string, repo string, number int) (*github.PullRequestData, error) {
+				return &github.PullRequestData{Number: number, BaseRef: "main"}, nil
+			},
+			QueryGraphQLFunc: func(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error {
+				return cause
+			},
+		}
+		_, err := getPullRequest(t, mock).AddToMergeQueue(ctx)
+		require.ErrorIs(t, err, cause)
+		assert.Contains(t, err.Error(), "failed to resolve pull request")
+	})
+}
+
+func TestMergeOrEnqueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("enqueues when the base branch has a merge queue", func(t *testing.T) {
+		mock, calls := mergeTargetMock(withQueue, nil)
+		entry, err := getPullRequest(t, mock).MergeOrEnqueue(ctx, github.WithMergeMethod(github.MergeMethodSquash))
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		assert.Equal(t, "MQE_1", entry.ID)
+
+		require.Len(t, *calls, 2)
+		assert.Contains(t, (*calls)[1].query, "enqueuePullRequest")
+		assert.Empty(t, mock.MergePullRequestCalls())
+	})
+
+	t.Run("merges when the base branch has no merge queue", func(t *testing.T) {
+		mock, calls := mergeTargetMock(withoutQueue, nil)
+		pr := getPullRequest(t, mock)
+		entry, err := pr.MergeOrEnqueue(ctx, github.WithMergeMethod(github.MergeMethodSquash))
+		require.NoError(t, err)
+		assert.Nil(t, entry)
+		assert.True(t, pr.IsMerged())
+
+		assert.Len(t, *calls, 1)
+		merges := mock.MergePullRequestCalls()
+		require.Len(t, merges, 1)
+		assert.Equal(t, 7, merges[0].Number)
+		assert.Equal(t, github.MergeMethodSquash, merges[0].Opts.MergeMethod)
+	})
+
+	t.Run("reports missing pull requests", func(t *testing.T) {
+		mock, _ := mergeTargetMock(`{"repository": {"pullRequest": null, "mergeQueue": {"id": "MQ_1"}}}`, nil)
+		entry, err := getPullRequest(t, mock).MergeOrEnqueue(ctx)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+		assert.Nil(t, entry)
+		assert.Empty(t, mock.MergePullRequestCalls())
+	})
+}
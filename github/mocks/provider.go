@@ -6,7 +6,9 @@ package mocks
 import (
 	"context"
 	"github.com/jmgilman/go/github"
+	"io"
 	"sync"
+	"time"
 )
 
 // Ensure, that ProviderMock does implement github.Provider.
@@ -19,60 +21,258 @@ var _ github.Provider = &ProviderMock{}
 //
 //		// make and configure a mocked github.Provider
 //		mockedProvider := &ProviderMock{
+//			AddCommentReactionFunc: func(ctx context.Context, owner string, repo string, commentID int64, content string) error {
+//				panic("mock out the AddCommentReaction method")
+//			},
+//			AddIssueReactionFunc: func(ctx context.Context, owner string, repo string, number int, content string) error {
+//				panic("mock out the AddIssueReaction method")
+//			},
 //			AddLabelsFunc: func(ctx context.Context, owner string, repo string, number int, labels []string) error {
 //				panic("mock out the AddLabels method")
 //			},
 //			CloseIssueFunc: func(ctx context.Context, owner string, repo string, number int) error {
 //				panic("mock out the CloseIssue method")
 //			},
+//			CompareCommitsFunc: func(ctx context.Context, owner string, repo string, base string, head string, opts github.ListOptions) (*github.ComparisonData, error) {
+//				panic("mock out the CompareCommits method")
+//			},
+//			CreateCommentFunc: func(ctx context.Context, owner string, repo string, number int, body string) (*github.CommentData, error) {
+//				panic("mock out the CreateComment method")
+//			},
+//			CreateDeploymentBranchPolicyFunc: func(ctx context.Context, owner string, repo string, environment string, policy github.DeploymentBranchPolicyData) (*github.DeploymentBranchPolicyData, error) {
+//				panic("mock out the CreateDeploymentBranchPolicy method")
+//			},
+//			CreateGistFunc: func(ctx context.Context, opts github.CreateGistOptions) (*github.GistData, error) {
+//				panic("mock out the CreateGist method")
+//			},
 //			CreateIssueFunc: func(ctx context.Context, owner string, repo string, opts github.CreateIssueOptions) (*github.IssueData, error) {
 //				panic("mock out the CreateIssue method")
 //			},
+//			CreateLabelFunc: func(ctx context.Context, owner string, repo string, opts github.CreateLabelOptions) (*github.LabelData, error) {
+//				panic("mock out the CreateLabel method")
+//			},
+//			CreateMilestoneFunc: func(ctx context.Context, owner string, repo string, opts github.CreateMilestoneOptions) (*github.MilestoneData, error) {
+//				panic("mock out the CreateMilestone method")
+//			},
+//			CreateOrUpdateEnvironmentFunc: func(ctx context.Context, owner string, repo string, name string, opts github.EnvironmentOptions) (*github.EnvironmentData, error) {
+//				panic("mock out the CreateOrUpdateEnvironment method")
+//			},
 //			CreatePullRequestFunc: func(ctx context.Context, owner string, repo string, opts github.CreatePullRequestOptions) (*github.PullRequestData, error) {
 //				panic("mock out the CreatePullRequest method")
 //			},
+//			CreateRefFunc: func(ctx context.Context, owner string, repo string, ref string, sha string) (*github.RefData, error) {
+//				panic("mock out the CreateRef method")
+//			},
 //			CreateRepositoryFunc: func(ctx context.Context, owner string, opts github.CreateRepositoryOptions) (*github.RepositoryData, error) {
 //				panic("mock out the CreateRepository method")
 //			},
+//			CreateRepositoryFromTemplateFunc: func(ctx context.Context, templateOwner string, templateRepo string, opts github.CreateRepositoryFromTemplateOptions) (*github.RepositoryData, error) {
+//				panic("mock out the CreateRepositoryFromTemplate method")
+//			},
+//			CreateReviewCommentFunc: func(ctx context.Context, owner string, repo string, number int, opts github.CreateReviewCommentOptions) (*github.ReviewCommentData, error) {
+//				panic("mock out the CreateReviewComment method")
+//			},
+//			CreateTagObjectFunc: func(ctx context.Context, owner string, repo string, opts github.CreateTagOptions) (*github.TagObjectData, error) {
+//				panic("mock out the CreateTagObject method")
+//			},
+//			DeleteCommentFunc: func(ctx context.Context, owner string, repo string, commentID int64) error {
+//				panic("mock out the DeleteComment method")
+//			},
+//			DeleteDeploymentBranchPolicyFunc: func(ctx context.Context, owner string, repo string, environment string, policyID int64) error {
+//				panic("mock out the DeleteDeploymentBranchPolicy method")
+//			},
+//			DeleteEnvironmentFunc: func(ctx context.Context, owner string, repo string, name string) error {
+//				panic("mock out the DeleteEnvironment method")
+//			},
+//			DeleteGistFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DeleteGist method")
+//			},
+//			DeleteLabelFunc: func(ctx context.Context, owner string, repo string, name string) error {
+//				panic("mock out the DeleteLabel method")
+//			},
+//			DeleteMilestoneFunc: func(ctx context.Context, owner string, repo string, number int) error {
+//				panic("mock out the DeleteMilestone method")
+//			},
+//			DeleteRefFunc: func(ctx context.Context, owner string, repo string, ref string) error {
+//				panic("mock out the DeleteRef method")
+//			},
+//			DeleteSecretFunc: func(ctx context.Context, scope github.SecretScope, name string) error {
+//				panic("mock out the DeleteSecret method")
+//			},
+//			DeleteThreadSubscriptionFunc: func(ctx context.Context, threadID string) error {
+//				panic("mock out the DeleteThreadSubscription method")
+//			},
+//			DeleteVariableFunc: func(ctx context.Context, scope github.SecretScope, name string) error {
+//				panic("mock out the DeleteVariable method")
+//			},
+//			DownloadArtifactFunc: func(ctx context.Context, owner string, repo string, artifactID int64) (io.ReadCloser, error) {
+//				panic("mock out the DownloadArtifact method")
+//			},
+//			DownloadGistFileFunc: func(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+//				panic("mock out the DownloadGistFile method")
+//			},
+//			ForkRepositoryFunc: func(ctx context.Context, owner string, repo string, opts github.ForkRepositoryOptions) (*github.RepositoryData, error) {
+//				panic("mock out the ForkRepository method")
+//			},
+//			GetCombinedStatusFunc: func(ctx context.Context, owner string, repo string, ref string) (*github.CombinedStatusData, error) {
+//				panic("mock out the GetCombinedStatus method")
+//			},
+//			GetEnvironmentFunc: func(ctx context.Context, owner string, repo string, name string) (*github.EnvironmentData, error) {
+//				panic("mock out the GetEnvironment method")
+//			},
+//			GetGistFunc: func(ctx context.Context, id string) (*github.GistData, error) {
+//				panic("mock out the GetGist method")
+//			},
 //			GetIssueFunc: func(ctx context.Context, owner string, repo string, number int) (*github.IssueData, error) {
 //				panic("mock out the GetIssue method")
 //			},
+//			GetMilestoneFunc: func(ctx context.Context, owner string, repo string, number int) (*github.MilestoneData, error) {
+//				panic("mock out the GetMilestone method")
+//			},
 //			GetPullRequestFunc: func(ctx context.Context, owner string, repo string, number int) (*github.PullRequestData, error) {
 //				panic("mock out the GetPullRequest method")
 //			},
 //			GetRepositoryFunc: func(ctx context.Context, owner string, repo string) (*github.RepositoryData, error) {
 //				panic("mock out the GetRepository method")
 //			},
+//			GetTrafficClonesFunc: func(ctx context.Context, owner string, repo string, opts github.TrafficOptions) (*github.TrafficData, error) {
+//				panic("mock out the GetTrafficClones method")
+//			},
+//			GetTrafficViewsFunc: func(ctx context.Context, owner string, repo string, opts github.TrafficOptions) (*github.TrafficData, error) {
+//				panic("mock out the GetTrafficViews method")
+//			},
+//			GetWorkflowJobLogsFunc: func(ctx context.Context, owner string, repo string, jobID int64) (io.ReadCloser, error) {
+//				panic("mock out the GetWorkflowJobLogs method")
+//			},
 //			GetWorkflowRunFunc: func(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRunData, error) {
 //				panic("mock out the GetWorkflowRun method")
 //			},
 //			GetWorkflowRunJobsFunc: func(ctx context.Context, owner string, repo string, runID int64) ([]*github.WorkflowJobData, error) {
 //				panic("mock out the GetWorkflowRunJobs method")
 //			},
+//			GetWorkflowRunLogsFunc: func(ctx context.Context, owner string, repo string, runID int64) (io.ReadCloser, error) {
+//				panic("mock out the GetWorkflowRunLogs method")
+//			},
+//			ListArtifactsFunc: func(ctx context.Context, owner string, repo string, runID int64, opts github.ListOptions) ([]*github.ArtifactData, error) {
+//				panic("mock out the ListArtifacts method")
+//			},
+//			ListCheckRunsFunc: func(ctx context.Context, owner string, repo string, ref string) ([]*github.CheckRunData, error) {
+//				panic("mock out the ListCheckRuns method")
+//			},
+//			ListCodeScanningAlertsFunc: func(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.CodeScanningAlertData, error) {
+//				panic("mock out the ListCodeScanningAlerts method")
+//			},
+//			ListCommentsFunc: func(ctx context.Context, owner string, repo string, number int, opts github.ListOptions) ([]*github.CommentData, error) {
+//				panic("mock out the ListComments method")
+//			},
+//			ListCommitsFunc: func(ctx context.Context, owner string, repo string, opts github.ListCommitsOptions) ([]*github.CommitData, error) {
+//				panic("mock out the ListCommits method")
+//			},
+//			ListContributorStatsFunc: func(ctx context.Context, owner string, repo string) ([]*github.ContributorStatsData, error) {
+//				panic("mock out the ListContributorStats method")
+//			},
+//			ListDependabotAlertsFunc: func(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.DependabotAlertData, error) {
+//				panic("mock out the ListDependabotAlerts method")
+//			},
+//			ListDeploymentBranchPoliciesFunc: func(ctx context.Context, owner string, repo string, environment string) ([]*github.DeploymentBranchPolicyData, error) {
+//				panic("mock out the ListDeploymentBranchPolicies method")
+//			},
+//			ListEnvironmentsFunc: func(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.EnvironmentData, error) {
+//				panic("mock out the ListEnvironments method")
+//			},
+//			ListGistsFunc: func(ctx context.Context, opts github.ListOptions) ([]*github.GistData, error) {
+//				panic("mock out the ListGists method")
+//			},
 //			ListIssuesFunc: func(ctx context.Context, owner string, repo string, opts github.ListIssuesOptions) ([]*github.IssueData, error) {
 //				panic("mock out the ListIssues method")
 //			},
+//			ListLabelsFunc: func(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.LabelData, error) {
+//				panic("mock out the ListLabels method")
+//			},
+//			ListMilestonesFunc: func(ctx context.Context, owner string, repo string, opts github.ListMilestonesOptions) ([]*github.MilestoneData, error) {
+//				panic("mock out the ListMilestones method")
+//			},
+//			ListNotificationsFunc: func(ctx context.Context, opts github.ListNotificationsOptions) ([]*github.NotificationData, error) {
+//				panic("mock out the ListNotifications method")
+//			},
 //			ListPullRequestsFunc: func(ctx context.Context, owner string, repo string, opts github.ListPullRequestsOptions) ([]*github.PullRequestData, error) {
 //				panic("mock out the ListPullRequests method")
 //			},
 //			ListRepositoriesFunc: func(ctx context.Context, owner string, opts github.ListOptions) ([]*github.RepositoryData, error) {
 //				panic("mock out the ListRepositories method")
 //			},
+//			ListReviewsFunc: func(ctx context.Context, owner string, repo string, number int, opts github.ListOptions) ([]*github.ReviewData, error) {
+//				panic("mock out the ListReviews method")
+//			},
+//			ListSecretScanningAlertsFunc: func(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.SecretScanningAlertData, error) {
+//				panic("mock out the ListSecretScanningAlerts method")
+//			},
+//			ListSecretsFunc: func(ctx context.Context, scope github.SecretScope, opts github.ListOptions) ([]*github.SecretData, error) {
+//				panic("mock out the ListSecrets method")
+//			},
+//			ListTagsFunc: func(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.TagData, error) {
+//				panic("mock out the ListTags method")
+//			},
+//			ListTopReferrersFunc: func(ctx context.Context, owner string, repo string) ([]*github.ReferrerData, error) {
+//				panic("mock out the ListTopReferrers method")
+//			},
+//			ListVariablesFunc: func(ctx context.Context, scope github.SecretScope, opts github.ListOptions) ([]*github.VariableData, error) {
+//				panic("mock out the ListVariables method")
+//			},
 //			ListWorkflowRunsFunc: func(ctx context.Context, owner string, repo string, opts github.ListWorkflowRunsOptions) ([]*github.WorkflowRunData, error) {
 //				panic("mock out the ListWorkflowRuns method")
 //			},
+//			MarkNotificationsReadFunc: func(ctx context.Context, lastReadAt time.Time) error {
+//				panic("mock out the MarkNotificationsRead method")
+//			},
+//			MarkThreadReadFunc: func(ctx context.Context, threadID string) error {
+//				panic("mock out the MarkThreadRead method")
+//			},
 //			MergePullRequestFunc: func(ctx context.Context, owner string, repo string, number int, opts github.MergePullRequestOptions) error {
 //				panic("mock out the MergePullRequest method")
 //			},
+//			QueryGraphQLFunc: func(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error {
+//				panic("mock out the QueryGraphQL method")
+//			},
 //			RemoveLabelFunc: func(ctx context.Context, owner string, repo string, number int, label string) error {
 //				panic("mock out the RemoveLabel method")
 //			},
+//			RequestReviewersFunc: func(ctx context.Context, owner string, repo string, number int, opts github.RequestReviewersOptions) error {
+//				panic("mock out the RequestReviewers method")
+//			},
+//			SetSecretFunc: func(ctx context.Context, scope github.SecretScope, opts github.SetSecretOptions) error {
+//				panic("mock out the SetSecret method")
+//			},
+//			SetThreadSubscriptionFunc: func(ctx context.Context, threadID string, ignored bool) (*github.ThreadSubscriptionData, error) {
+//				panic("mock out the SetThreadSubscription method")
+//			},
+//			SetVariableFunc: func(ctx context.Context, scope github.SecretScope, opts github.SetVariableOptions) error {
+//				panic("mock out the SetVariable method")
+//			},
+//			SubmitReviewFunc: func(ctx context.Context, owner string, repo string, number int, opts github.SubmitReviewOptions) (*github.ReviewData, error) {
+//				panic("mock out the SubmitReview method")
+//			},
+//			TransferRepositoryFunc: func(ctx context.Context, owner string, repo string, opts github.TransferRepositoryOptions) (*github.RepositoryData, error) {
+//				panic("mock out the TransferRepository method")
+//			},
 //			TriggerWorkflowFunc: func(ctx context.Context, owner string, repo string, workflowFileName string, ref string, inputs map[string]interface{}) error {
 //				panic("mock out the TriggerWorkflow method")
 //			},
+//			UpdateCommentFunc: func(ctx context.Context, owner string, repo string, commentID int64, body string) (*github.CommentData, error) {
+//				panic("mock out the UpdateComment method")
+//			},
+//			UpdateGistFunc: func(ctx context.Context, id string, opts github.UpdateGistOptions) (*github.GistData, error) {
+//				panic("mock out the UpdateGist method")
+//			},
 //			UpdateIssueFunc: func(ctx context.Context, owner string, repo string, number int, opts github.UpdateIssueOptions) (*github.IssueData, error) {
 //				panic("mock out the UpdateIssue method")
 //			},
+//			UpdateLabelFunc: func(ctx context.Context, owner string, repo string, name string, opts github.UpdateLabelOptions) (*github.LabelData, error) {
+//				panic("mock out the UpdateLabel method")
+//			},
+//			UpdateMilestoneFunc: func(ctx context.Context, owner string, repo string, number int, opts github.UpdateMilestoneOptions) (*github.MilestoneData, error) {
+//				panic("mock out the UpdateMilestone method")
+//			},
 //			UpdatePullRequestFunc: func(ctx context.Context, owner string, repo string, number int, opts github.UpdatePullRequestOptions) (*github.PullRequestData, error) {
 //				panic("mock out the UpdatePullRequest method")
 //			},
@@ -83,65 +283,289 @@ var _ github.Provider = &ProviderMock{}
 //
 //	}
 type ProviderMock struct {
+	// AddCommentReactionFunc mocks the AddCommentReaction method.
+	AddCommentReactionFunc func(ctx context.Context, owner string, repo string, commentID int64, content string) error
+
+	// AddIssueReactionFunc mocks the AddIssueReaction method.
+	AddIssueReactionFunc func(ctx context.Context, owner string, repo string, number int, content string) error
+
 	// AddLabelsFunc mocks the AddLabels method.
 	AddLabelsFunc func(ctx context.Context, owner string, repo string, number int, labels []string) error
 
 	// CloseIssueFunc mocks the CloseIssue method.
 	CloseIssueFunc func(ctx context.Context, owner string, repo string, number int) error
 
+	// CompareCommitsFunc mocks the CompareCommits method.
+	CompareCommitsFunc func(ctx context.Context, owner string, repo string, base string, head string, opts github.ListOptions) (*github.ComparisonData, error)
+
+	// CreateCommentFunc mocks the CreateComment method.
+	CreateCommentFunc func(ctx context.Context, owner string, repo string, number int, body string) (*github.CommentData, error)
+
+	// CreateDeploymentBranchPolicyFunc mocks the CreateDeploymentBranchPolicy method.
+	CreateDeploymentBranchPolicyFunc func(ctx context.Context, owner string, repo string, environment string, policy github.DeploymentBranchPolicyData) (*github.DeploymentBranchPolicyData, error)
+
+	// CreateGistFunc mocks the CreateGist method.
+	CreateGistFunc func(ctx context.Context, opts github.CreateGistOptions) (*github.GistData, error)
+
 	// CreateIssueFunc mocks the CreateIssue method.
 	CreateIssueFunc func(ctx context.Context, owner string, repo string, opts github.CreateIssueOptions) (*github.IssueData, error)
 
+	// CreateLabelFunc mocks the CreateLabel method.
+	CreateLabelFunc func(ctx context.Context, owner string, repo string, opts github.CreateLabelOptions) (*github.LabelData, error)
+
+	// CreateMilestoneFunc mocks the CreateMilestone method.
+	CreateMilestoneFunc func(ctx context.Context, owner string, repo string, opts github.CreateMilestoneOptions) (*github.MilestoneData, error)
+
+	// CreateOrUpdateEnvironmentFunc mocks the CreateOrUpdateEnvironment method.
+	CreateOrUpdateEnvironmentFunc func(ctx context.Context, owner string, repo string, name string, opts github.EnvironmentOptions) (*github.EnvironmentData, error)
+
 	// CreatePullRequestFunc mocks the CreatePullRequest method.
 	CreatePullRequestFunc func(ctx context.Context, owner string, repo string, opts github.CreatePullRequestOptions) (*github.PullRequestData, error)
 
+	// CreateRefFunc mocks the CreateRef method.
+	CreateRefFunc func(ctx context.Context, owner string, repo string, ref string, sha string) (*github.RefData, error)
+
 	// CreateRepositoryFunc mocks the CreateRepository method.
 	CreateRepositoryFunc func(ctx context.Context, owner string, opts github.CreateRepositoryOptions) (*github.RepositoryData, error)
 
+	// CreateRepositoryFromTemplateFunc mocks the CreateRepositoryFromTemplate method.
+	CreateRepositoryFromTemplateFunc func(ctx context.Context, templateOwner string, templateRepo string, opts github.CreateRepositoryFromTemplateOptions) (*github.RepositoryData, error)
+
+	// CreateReviewCommentFunc mocks the CreateReviewComment method.
+	CreateReviewCommentFunc func(ctx context.Context, owner string, repo string, number int, opts github.CreateReviewCommentOptions) (*github.ReviewCommentData, error)
+
+	// CreateTagObjectFunc mocks the CreateTagObject method.
+	CreateTagObjectFunc func(ctx context.Context, owner string, repo string, opts github.CreateTagOptions) (*github.TagObjectData, error)
+
+	// DeleteCommentFunc mocks the DeleteComment method.
+	DeleteCommentFunc func(ctx context.Context, owner string, repo string, commentID int64) error
+
+	// DeleteDeploymentBranchPolicyFunc mocks the DeleteDeploymentBranchPolicy method.
+	DeleteDeploymentBranchPolicyFunc func(ctx context.Context, owner string, repo string, environment string, policyID int64) error
+
+	// DeleteEnvironmentFunc mocks the DeleteEnvironment method.
+	DeleteEnvironmentFunc func(ctx context.Context, owner string, repo string, name string) error
+
+	// DeleteGistFunc mocks the DeleteGist method.
+	DeleteGistFunc func(ctx context.Context, id string) error
+
+	// DeleteLabelFunc mocks the DeleteLabel method.
+	DeleteLabelFunc func(ctx context.Context, owner string, repo string, name string) error
+
+	// DeleteMilestoneFunc mocks the DeleteMilestone method.
+	DeleteMilestoneFunc func(ctx context.Context, owner string, repo string, number int) error
+
+	// DeleteRefFunc mocks the DeleteRef method.
+	DeleteRefFunc func(ctx context.Context, owner string, repo string, ref string) error
+
+	// DeleteSecretFunc mocks the DeleteSecret method.
+	DeleteSecretFunc func(ctx context.Context, scope github.SecretScope, name string) error
+
+	// DeleteThreadSubscriptionFunc mocks the DeleteThreadSubscription method.
+	DeleteThreadSubscriptionFunc func(ctx context.Context, threadID string) error
+
+	// DeleteVariableFunc mocks the DeleteVariable method.
+	DeleteVariableFunc func(ctx context.Context, scope github.SecretScope, name string) error
+
+	// DownloadArtifactFunc mocks the DownloadArtifact method.
+	DownloadArtifactFunc func(ctx context.Context, owner string, repo string, artifactID int64) (io.ReadCloser, error)
+
+	// DownloadGistFileFunc mocks the DownloadGistFile method.
+	DownloadGistFileFunc func(ctx context.Context, rawURL string) (io.ReadCloser, error)
+
+	// ForkRepositoryFunc mocks the ForkRepository method.
+	ForkRepositoryFunc func(ctx context.Context, owner string, repo string, opts github.ForkRepositoryOptions) (*github.RepositoryData, error)
+
+	// GetCombinedStatusFunc mocks the GetCombinedStatus method.
+	GetCombinedStatusFunc func(ctx context.Context, owner string, repo string, ref string) (*github.CombinedStatusData, error)
+
+	// GetEnvironmentFunc mocks the GetEnvironment method.
+	GetEnvironmentFunc func(ctx context.Context, owner string, repo string, name string) (*github.EnvironmentData, error)
+
+	// GetGistFunc mocks the GetGist method.
+	GetGistFunc func(ctx context.Context, id string) (*github.GistData, error)
+
 	// GetIssueFunc mocks the GetIssue method.
 	GetIssueFunc func(ctx context.Context, owner string, repo string, number int) (*github.IssueData, error)
 
+	// GetMilestoneFunc mocks the GetMilestone method.
+	GetMilestoneFunc func(ctx context.Context, owner string, repo string, number int) (*github.MilestoneData, error)
+
 	// GetPullRequestFunc mocks the GetPullRequest method.
 	GetPullRequestFunc func(ctx context.Context, owner string, repo string, number int) (*github.PullRequestData, error)
 
 	// GetRepositoryFunc mocks the GetRepository method.
 	GetRepositoryFunc func(ctx context.Context, owner string, repo string) (*github.RepositoryData, error)
 
+	// GetTrafficClonesFunc mocks the GetTrafficClones method.
+	GetTrafficClonesFunc func(ctx context.Context, owner string, repo string, opts github.TrafficOptions) (*github.TrafficData, error)
+
+	// GetTrafficViewsFunc mocks the GetTrafficViews method.
+	GetTrafficViewsFunc func(ctx context.Context, owner string, repo string, opts github.TrafficOptions) (*github.TrafficData, error)
+
+	// GetWorkflowJobLogsFunc mocks the GetWorkflowJobLogs method.
+	GetWorkflowJobLogsFunc func(ctx context.Context, owner string, repo string, jobID int64) (io.ReadCloser, error)
+
 	// GetWorkflowRunFunc mocks the GetWorkflowRun method.
 	GetWorkflowRunFunc func(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRunData, error)
 
 	// GetWorkflowRunJobsFunc mocks the GetWorkflowRunJobs method.
 	GetWorkflowRunJobsFunc func(ctx context.Context, owner string, repo string, runID int64) ([]*github.WorkflowJobData, error)
 
+	// GetWorkflowRunLogsFunc mocks the GetWorkflowRunLogs method.
+	GetWorkflowRunLogsFunc func(ctx context.Context, owner string, repo string, runID int64) (io.ReadCloser, error)
+
+	// ListArtifactsFunc mocks the ListArtifacts method.
+	ListArtifactsFunc func(ctx context.Context, owner string, repo string, runID int64, opts github.ListOptions) ([]*github.ArtifactData, error)
+
+	// ListCheckRunsFunc mocks the ListCheckRuns method.
+	ListCheckRunsFunc func(ctx context.Context, owner string, repo string, ref string) ([]*github.CheckRunData, error)
+
+	// ListCodeScanningAlertsFunc mocks the ListCodeScanningAlerts method.
+	ListCodeScanningAlertsFunc func(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.CodeScanningAlertData, error)
+
+	// ListCommentsFunc mocks the ListComments method.
+	ListCommentsFunc func(ctx context.Context, owner string, repo string, number int, opts github.ListOptions) ([]*github.CommentData, error)
+
+	// ListCommitsFunc mocks the ListCommits method.
+	ListCommitsFunc func(ctx context.Context, owner string, repo string, opts github.ListCommitsOptions) ([]*github.CommitData, error)
+
+	// ListContributorStatsFunc mocks the ListContributorStats method.
+	ListContributorStatsFunc func(ctx context.Context, owner string, repo string) ([]*github.ContributorStatsData, error)
+
+	// ListDependabotAlertsFunc mocks the ListDependabotAlerts method.
+	ListDependabotAlertsFunc func(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.DependabotAlertData, error)
+
+	// ListDeploymentBranchPoliciesFunc mocks the ListDeploymentBranchPolicies method.
+	ListDeploymentBranchPoliciesFunc func(ctx context.Context, owner string, repo string, environment string) ([]*github.DeploymentBranchPolicyData, error)
+
+	// ListEnvironmentsFunc mocks the ListEnvironments method.
+	ListEnvironmentsFunc func(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.EnvironmentData, error)
+
+	// ListGistsFunc mocks the ListGists method.
+	ListGistsFunc func(ctx context.Context, opts github.ListOptions) ([]*github.GistData, error)
+
 	// ListIssuesFunc mocks the ListIssues method.
 	ListIssuesFunc func(ctx context.Context, owner string, repo string, opts github.ListIssuesOptions) ([]*github.IssueData, error)
 
+	// ListLabelsFunc mocks the ListLabels method.
+	ListLabelsFunc func(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.LabelData, error)
+
+	// ListMilestonesFunc mocks the ListMilestones method.
+	ListMilestonesFunc func(ctx context.Context, owner string, repo string, opts github.ListMilestonesOptions) ([]*github.MilestoneData, error)
+
+	// ListNotificationsFunc mocks the ListNotifications method.
+	ListNotificationsFunc func(ctx context.Context, opts github.ListNotificationsOptions) ([]*github.NotificationData, error)
+
 	// ListPullRequestsFunc mocks the ListPullRequests method.
 	ListPullRequestsFunc func(ctx context.Context, owner string, repo string, opts github.ListPullRequestsOptions) ([]*github.PullRequestData, error)
 
 	// ListRepositoriesFunc mocks the ListRepositories method.
 	ListRepositoriesFunc func(ctx context.Context, owner string, opts github.ListOptions) ([]*github.RepositoryData, error)
 
+	// ListReviewsFunc mocks the ListReviews method.
+	ListReviewsFunc func(ctx context.Context, owner string, repo string, number int, opts github.ListOptions) ([]*github.ReviewData, error)
+
+	// ListSecretScanningAlertsFunc mocks the ListSecretScanningAlerts method.
+	ListSecretScanningAlertsFunc func(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.SecretScanningAlertData, error)
+
+	// ListSecretsFunc mocks the ListSecrets method.
+	ListSecretsFunc func(ctx context.Context, scope github.SecretScope, opts github.ListOptions) ([]*github.SecretData, error)
+
+	// ListTagsFunc mocks the ListTags method.
+	ListTagsFunc func(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.TagData, error)
+
+	// ListTopReferrersFunc mocks the ListTopReferrers method.
+	ListTopReferrersFunc func(ctx context.Context, owner string, repo string) ([]*github.ReferrerData, error)
+
+	// ListVariablesFunc mocks the ListVariables method.
+	ListVariablesFunc func(ctx context.Context, scope github.SecretScope, opts github.ListOptions) ([]*github.VariableData, error)
+
 	// ListWorkflowRunsFunc mocks the ListWorkflowRuns method.
 	ListWorkflowRunsFunc func(ctx context.Context, owner string, repo string, opts github.ListWorkflowRunsOptions) ([]*github.WorkflowRunData, error)
 
+	// MarkNotificationsReadFunc mocks the MarkNotificationsRead method.
+	MarkNotificationsReadFunc func(ctx context.Context, lastReadAt time.Time) error
+
+	// MarkThreadReadFunc mocks the MarkThreadRead method.
+	MarkThreadReadFunc func(ctx context.Context, threadID string) error
+
 	// MergePullRequestFunc mocks the MergePullRequest method.
 	MergePullRequestFunc func(ctx context.Context, owner string, repo string, number int, opts github.MergePullRequestOptions) error
 
+	// QueryGraphQLFunc mocks the QueryGraphQL method.
+	QueryGraphQLFunc func(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error
+
 	// RemoveLabelFunc mocks the RemoveLabel method.
 	RemoveLabelFunc func(ctx context.Context, owner string, repo string, number int, label string) error
 
+	// RequestReviewersFunc mocks the RequestReviewers method.
+	RequestReviewersFunc func(ctx context.Context, owner string, repo string, number int, opts github.RequestReviewersOptions) error
+
+	// SetSecretFunc mocks the SetSecret method.
+	SetSecretFunc func(ctx context.Context, scope github.SecretScope, opts github.SetSecretOptions) error
+
+	// SetThreadSubscriptionFunc mocks the SetThreadSubscription method.
+	SetThreadSubscriptionFunc func(ctx context.Context, threadID string, ignored bool) (*github.ThreadSubscriptionData, error)
+
+	// SetVariableFunc mocks the SetVariable method.
+	SetVariableFunc func(ctx context.Context, scope github.SecretScope, opts github.SetVariableOptions) error
+
+	// SubmitReviewFunc mocks the SubmitReview method.
+	SubmitReviewFunc func(ctx context.Context, owner string, repo string, number int, opts github.SubmitReviewOptions) (*github.ReviewData, error)
+
+	// TransferRepositoryFunc mocks the TransferRepository method.
+	TransferRepositoryFunc func(ctx context.Context, owner string, repo string, opts github.TransferRepositoryOptions) (*github.RepositoryData, error)
+
 	// TriggerWorkflowFunc mocks the TriggerWorkflow method.
 	TriggerWorkflowFunc func(ctx context.Context, owner string, repo string, workflowFileName string, ref string, inputs map[string]interface{}) error
 
+	// UpdateCommentFunc mocks the UpdateComment method.
+	UpdateCommentFunc func(ctx context.Context, owner string, repo string, commentID int64, body string) (*github.CommentData, error)
+
+	// UpdateGistFunc mocks the UpdateGist method.
+	UpdateGistFunc func(ctx context.Context, id string, opts github.UpdateGistOptions) (*github.GistData, error)
+
 	// UpdateIssueFunc mocks the UpdateIssue method.
 	UpdateIssueFunc func(ctx context.Context, owner string, repo string, number int, opts github.UpdateIssueOptions) (*github.IssueData, error)
 
+	// UpdateLabelFunc mocks the UpdateLabel method.
+	UpdateLabelFunc func(ctx context.Context, owner string, repo string, name string, opts github.UpdateLabelOptions) (*github.LabelData, error)
+
+	// UpdateMilestoneFunc mocks the UpdateMilestone method.
+	UpdateMilestoneFunc func(ctx context.Context, owner string, repo string, number int, opts github.UpdateMilestoneOptions) (*github.MilestoneData, error)
+
 	// UpdatePullRequestFunc mocks the UpdatePullRequest method.
 	UpdatePullRequestFunc func(ctx context.Context, owner string, repo string, number int, opts github.UpdatePullRequestOptions) (*github.PullRequestData, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddCommentReaction holds details about calls to the AddCommentReaction method.
+		AddCommentReaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// CommentID is the commentID argument value.
+			CommentID int64
+			// Content is the content argument value.
+			Content string
+		}
+		// AddIssueReaction holds details about calls to the AddIssueReaction method.
+		AddIssueReaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Content is the content argument value.
+			Content string
+		}
 		// AddLabels holds details about calls to the AddLabels method.
 		AddLabels []struct {
 			// Ctx is the ctx argument value.
@@ -166,175 +590,223 @@ type ProviderMock struct {
 			// Number is the number argument value.
 			Number int
 		}
-		// CreateIssue holds details about calls to the CreateIssue method.
-		CreateIssue []struct {
+		// CompareCommits holds details about calls to the CompareCommits method.
+		CompareCommits []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
+			// Base is the base argument value.
+			Base string
+			// Head is the head argument value.
+			Head string
 			// Opts is the opts argument value.
-			Opts github.CreateIssueOptions
+			Opts github.ListOptions
 		}
-		// CreatePullRequest holds details about calls to the CreatePullRequest method.
-		CreatePullRequest []struct {
+		// CreateComment holds details about calls to the CreateComment method.
+		CreateComment []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// Opts is the opts argument value.
-			Opts github.CreatePullRequestOptions
+			// Number is the number argument value.
+			Number int
+			// Body is the body argument value.
+			Body string
 		}
-		// CreateRepository holds details about calls to the CreateRepository method.
-		CreateRepository []struct {
+		// CreateDeploymentBranchPolicy holds details about calls to the CreateDeploymentBranchPolicy method.
+		CreateDeploymentBranchPolicy []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Environment is the environment argument value.
+			Environment string
+			// Policy is the policy argument value.
+			Policy github.DeploymentBranchPolicyData
+		}
+		// CreateGist holds details about calls to the CreateGist method.
+		CreateGist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Opts is the opts argument value.
-			Opts github.CreateRepositoryOptions
+			Opts github.CreateGistOptions
 		}
-		// GetIssue holds details about calls to the GetIssue method.
-		GetIssue []struct {
+		// CreateIssue holds details about calls to the CreateIssue method.
+		CreateIssue []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// Number is the number argument value.
-			Number int
+			// Opts is the opts argument value.
+			Opts github.CreateIssueOptions
 		}
-		// GetPullRequest holds details about calls to the GetPullRequest method.
-		GetPullRequest []struct {
+		// CreateLabel holds details about calls to the CreateLabel method.
+		CreateLabel []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// Number is the number argument value.
-			Number int
+			// Opts is the opts argument value.
+			Opts github.CreateLabelOptions
 		}
-		// GetRepository holds details about calls to the GetRepository method.
-		GetRepository []struct {
+		// CreateMilestone holds details about calls to the CreateMilestone method.
+		CreateMilestone []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
+			// Opts is the opts argument value.
+			Opts github.CreateMilestoneOptions
 		}
-		// GetWorkflowRun holds details about calls to the GetWorkflowRun method.
-		GetWorkflowRun []struct {
+		// CreateOrUpdateEnvironment holds details about calls to the CreateOrUpdateEnvironment method.
+		CreateOrUpdateEnvironment []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// RunID is the runID argument value.
-			RunID int64
+			// Name is the name argument value.
+			Name string
+			// Opts is the opts argument value.
+			Opts github.EnvironmentOptions
 		}
-		// GetWorkflowRunJobs holds details about calls to the GetWorkflowRunJobs method.
-		GetWorkflowRunJobs []struct {
+		// CreatePullRequest holds details about calls to the CreatePullRequest method.
+		CreatePullRequest []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// RunID is the runID argument value.
-			RunID int64
+			// Opts is the opts argument value.
+			Opts github.CreatePullRequestOptions
 		}
-		// ListIssues holds details about calls to the ListIssues method.
-		ListIssues []struct {
+		// CreateRef holds details about calls to the CreateRef method.
+		CreateRef []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
+			// Ref is the ref argument value.
+			Ref string
+			// Sha is the sha argument value.
+			Sha string
+		}
+		// CreateRepository holds details about calls to the CreateRepository method.
+		CreateRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
 			// Opts is the opts argument value.
-			Opts github.ListIssuesOptions
+			Opts github.CreateRepositoryOptions
 		}
-		// ListPullRequests holds details about calls to the ListPullRequests method.
-		ListPullRequests []struct {
+		// CreateRepositoryFromTemplate holds details about calls to the CreateRepositoryFromTemplate method.
+		CreateRepositoryFromTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TemplateOwner is the templateOwner argument value.
+			TemplateOwner string
+			// TemplateRepo is the templateRepo argument value.
+			TemplateRepo string
+			// Opts is the opts argument value.
+			Opts github.CreateRepositoryFromTemplateOptions
+		}
+		// CreateReviewComment holds details about calls to the CreateReviewComment method.
+		CreateReviewComment []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
+			// Number is the number argument value.
+			Number int
 			// Opts is the opts argument value.
-			Opts github.ListPullRequestsOptions
+			Opts github.CreateReviewCommentOptions
 		}
-		// ListRepositories holds details about calls to the ListRepositories method.
-		ListRepositories []struct {
+		// CreateTagObject holds details about calls to the CreateTagObject method.
+		CreateTagObject []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
+			// Repo is the repo argument value.
+			Repo string
 			// Opts is the opts argument value.
-			Opts github.ListOptions
+			Opts github.CreateTagOptions
 		}
-		// ListWorkflowRuns holds details about calls to the ListWorkflowRuns method.
-		ListWorkflowRuns []struct {
+		// DeleteComment holds details about calls to the DeleteComment method.
+		DeleteComment []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// Opts is the opts argument value.
-			Opts github.ListWorkflowRunsOptions
+			// CommentID is the commentID argument value.
+			CommentID int64
 		}
-		// MergePullRequest holds details about calls to the MergePullRequest method.
-		MergePullRequest []struct {
+		// DeleteDeploymentBranchPolicy holds details about calls to the DeleteDeploymentBranchPolicy method.
+		DeleteDeploymentBranchPolicy []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// Number is the number argument value.
-			Number int
-			// Opts is the opts argument value.
-			Opts github.MergePullRequestOptions
+			// Environment is the environment argument value.
+			Environment string
+			// PolicyID is the policyID argument value.
+			PolicyID int64
 		}
-		// RemoveLabel holds details about calls to the RemoveLabel method.
-		RemoveLabel []struct {
+		// DeleteEnvironment holds details about calls to the DeleteEnvironment method.
+		DeleteEnvironment []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// Number is the number argument value.
-			Number int
-			// Label is the label argument value.
-			Label string
+			// Name is the name argument value.
+			Name string
 		}
-		// TriggerWorkflow holds details about calls to the TriggerWorkflow method.
-		TriggerWorkflow []struct {
+		// DeleteGist holds details about calls to the DeleteGist method.
+		DeleteGist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// DeleteLabel holds details about calls to the DeleteLabel method.
+		DeleteLabel []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// WorkflowFileName is the workflowFileName argument value.
-			WorkflowFileName string
-			// Ref is the ref argument value.
-			Ref string
-			// Inputs is the inputs argument value.
-			Inputs map[string]interface{}
+			// Name is the name argument value.
+			Name string
 		}
-		// UpdateIssue holds details about calls to the UpdateIssue method.
-		UpdateIssue []struct {
+		// DeleteMilestone holds details about calls to the DeleteMilestone method.
+		DeleteMilestone []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
@@ -343,663 +815,4045 @@ type ProviderMock struct {
 			Repo string
 			// Number is the number argument value.
 			Number int
-			// Opts is the opts argument value.
-			Opts github.UpdateIssueOptions
 		}
-		// UpdatePullRequest holds details about calls to the UpdatePullRequest method.
-		UpdatePullRequest []struct {
+		// DeleteRef holds details about calls to the DeleteRef method.
+		DeleteRef []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Owner is the owner argument value.
 			Owner string
 			// Repo is the repo argument value.
 			Repo string
-			// Number is the number argument value.
+			// Ref is the ref argument value.
+			Ref string
+		}
+		// DeleteSecret holds details about calls to the DeleteSecret method.
+		DeleteSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Scope is the scope argument value.
+			Scope github.SecretScope
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteThreadSubscription holds details about calls to the DeleteThreadSubscription method.
+		DeleteThreadSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ThreadID is the threadID argument value.
+			ThreadID string
+		}
+		// DeleteVariable holds details about calls to the DeleteVariable method.
+		DeleteVariable []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Scope is the scope argument value.
+			Scope github.SecretScope
+			// Name is the name argument value.
+			Name string
+		}
+		// DownloadArtifact holds details about calls to the DownloadArtifact method.
+		DownloadArtifact []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// ArtifactID is the artifactID argument value.
+			ArtifactID int64
+		}
+		// DownloadGistFile holds details about calls to the DownloadGistFile method.
+		DownloadGistFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RawURL is the rawURL argument value.
+			RawURL string
+		}
+		// ForkRepository holds details about calls to the ForkRepository method.
+		ForkRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ForkRepositoryOptions
+		}
+		// GetCombinedStatus holds details about calls to the GetCombinedStatus method.
+		GetCombinedStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Ref is the ref argument value.
+			Ref string
+		}
+		// GetEnvironment holds details about calls to the GetEnvironment method.
+		GetEnvironment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Name is the name argument value.
+			Name string
+		}
+		// GetGist holds details about calls to the GetGist method.
+		GetGist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// GetIssue holds details about calls to the GetIssue method.
+		GetIssue []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+		}
+		// GetMilestone holds details about calls to the GetMilestone method.
+		GetMilestone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+		}
+		// GetPullRequest holds details about calls to the GetPullRequest method.
+		GetPullRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
 			Number int
+		}
+		// GetRepository holds details about calls to the GetRepository method.
+		GetRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+		}
+		// GetTrafficClones holds details about calls to the GetTrafficClones method.
+		GetTrafficClones []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
 			// Opts is the opts argument value.
-			Opts github.UpdatePullRequestOptions
+			Opts github.TrafficOptions
+		}
+		// GetTrafficViews holds details about calls to the GetTrafficViews method.
+		GetTrafficViews []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.TrafficOptions
+		}
+		// GetWorkflowJobLogs holds details about calls to the GetWorkflowJobLogs method.
+		GetWorkflowJobLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// JobID is the jobID argument value.
+			JobID int64
+		}
+		// GetWorkflowRun holds details about calls to the GetWorkflowRun method.
+		GetWorkflowRun []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// RunID is the runID argument value.
+			RunID int64
+		}
+		// GetWorkflowRunJobs holds details about calls to the GetWorkflowRunJobs method.
+		GetWorkflowRunJobs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// RunID is the runID argument value.
+			RunID int64
+		}
+		// GetWorkflowRunLogs holds details about calls to the GetWorkflowRunLogs method.
+		GetWorkflowRunLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// RunID is the runID argument value.
+			RunID int64
+		}
+		// ListArtifacts holds details about calls to the ListArtifacts method.
+		ListArtifacts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// RunID is the runID argument value.
+			RunID int64
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListCheckRuns holds details about calls to the ListCheckRuns method.
+		ListCheckRuns []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Ref is the ref argument value.
+			Ref string
+		}
+		// ListCodeScanningAlerts holds details about calls to the ListCodeScanningAlerts method.
+		ListCodeScanningAlerts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListAlertsOptions
+		}
+		// ListComments holds details about calls to the ListComments method.
+		ListComments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListCommits holds details about calls to the ListCommits method.
+		ListCommits []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListCommitsOptions
+		}
+		// ListContributorStats holds details about calls to the ListContributorStats method.
+		ListContributorStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+		}
+		// ListDependabotAlerts holds details about calls to the ListDependabotAlerts method.
+		ListDependabotAlerts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListAlertsOptions
+		}
+		// ListDeploymentBranchPolicies holds details about calls to the ListDeploymentBranchPolicies method.
+		ListDeploymentBranchPolicies []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Environment is the environment argument value.
+			Environment string
+		}
+		// ListEnvironments holds details about calls to the ListEnvironments method.
+		ListEnvironments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListGists holds details about calls to the ListGists method.
+		ListGists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListIssues holds details about calls to the ListIssues method.
+		ListIssues []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListIssuesOptions
+		}
+		// ListLabels holds details about calls to the ListLabels method.
+		ListLabels []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListMilestones holds details about calls to the ListMilestones method.
+		ListMilestones []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListMilestonesOptions
+		}
+		// ListNotifications holds details about calls to the ListNotifications method.
+		ListNotifications []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts github.ListNotificationsOptions
 		}
+		// ListPullRequests holds details about calls to the ListPullRequests method.
+		ListPullRequests []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListPullRequestsOptions
+		}
+		// ListRepositories holds details about calls to the ListRepositories method.
+		ListRepositories []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListReviews holds details about calls to the ListReviews method.
+		ListReviews []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListSecretScanningAlerts holds details about calls to the ListSecretScanningAlerts method.
+		ListSecretScanningAlerts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListAlertsOptions
+		}
+		// ListSecrets holds details about calls to the ListSecrets method.
+		ListSecrets []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Scope is the scope argument value.
+			Scope github.SecretScope
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListTags holds details about calls to the ListTags method.
+		ListTags []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListTopReferrers holds details about calls to the ListTopReferrers method.
+		ListTopReferrers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+		}
+		// ListVariables holds details about calls to the ListVariables method.
+		ListVariables []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Scope is the scope argument value.
+			Scope github.SecretScope
+			// Opts is the opts argument value.
+			Opts github.ListOptions
+		}
+		// ListWorkflowRuns holds details about calls to the ListWorkflowRuns method.
+		ListWorkflowRuns []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.ListWorkflowRunsOptions
+		}
+		// MarkNotificationsRead holds details about calls to the MarkNotificationsRead method.
+		MarkNotificationsRead []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LastReadAt is the lastReadAt argument value.
+			LastReadAt time.Time
+		}
+		// MarkThreadRead holds details about calls to the MarkThreadRead method.
+		MarkThreadRead []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ThreadID is the threadID argument value.
+			ThreadID string
+		}
+		// MergePullRequest holds details about calls to the MergePullRequest method.
+		MergePullRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Opts is the opts argument value.
+			Opts github.MergePullRequestOptions
+		}
+		// QueryGraphQL holds details about calls to the QueryGraphQL method.
+		QueryGraphQL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// Vars is the vars argument value.
+			Vars map[string]interface{}
+			// Result is the result argument value.
+			Result interface{}
+		}
+		// RemoveLabel holds details about calls to the RemoveLabel method.
+		RemoveLabel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Label is the label argument value.
+			Label string
+		}
+		// RequestReviewers holds details about calls to the RequestReviewers method.
+		RequestReviewers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Opts is the opts argument value.
+			Opts github.RequestReviewersOptions
+		}
+		// SetSecret holds details about calls to the SetSecret method.
+		SetSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Scope is the scope argument value.
+			Scope github.SecretScope
+			// Opts is the opts argument value.
+			Opts github.SetSecretOptions
+		}
+		// SetThreadSubscription holds details about calls to the SetThreadSubscription method.
+		SetThreadSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ThreadID is the threadID argument value.
+			ThreadID string
+			// Ignored is the ignored argument value.
+			Ignored bool
+		}
+		// SetVariable holds details about calls to the SetVariable method.
+		SetVariable []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Scope is the scope argument value.
+			Scope github.SecretScope
+			// Opts is the opts argument value.
+			Opts github.SetVariableOptions
+		}
+		// SubmitReview holds details about calls to the SubmitReview method.
+		SubmitReview []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Opts is the opts argument value.
+			Opts github.SubmitReviewOptions
+		}
+		// TransferRepository holds details about calls to the TransferRepository method.
+		TransferRepository []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Opts is the opts argument value.
+			Opts github.TransferRepositoryOptions
+		}
+		// TriggerWorkflow holds details about calls to the TriggerWorkflow method.
+		TriggerWorkflow []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// WorkflowFileName is the workflowFileName argument value.
+			WorkflowFileName string
+			// Ref is the ref argument value.
+			Ref string
+			// Inputs is the inputs argument value.
+			Inputs map[string]interface{}
+		}
+		// UpdateComment holds details about calls to the UpdateComment method.
+		UpdateComment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// CommentID is the commentID argument value.
+			CommentID int64
+			// Body is the body argument value.
+			Body string
+		}
+		// UpdateGist holds details about calls to the UpdateGist method.
+		UpdateGist []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Opts is the opts argument value.
+			Opts github.UpdateGistOptions
+		}
+		// UpdateIssue holds details about calls to the UpdateIssue method.
+		UpdateIssue []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Opts is the opts argument value.
+			Opts github.UpdateIssueOptions
+		}
+		// UpdateLabel holds details about calls to the UpdateLabel method.
+		UpdateLabel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Name is the name argument value.
+			Name string
+			// Opts is the opts argument value.
+			Opts github.UpdateLabelOptions
+		}
+		// UpdateMilestone holds details about calls to the UpdateMilestone method.
+		UpdateMilestone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Opts is the opts argument value.
+			Opts github.UpdateMilestoneOptions
+		}
+		// UpdatePullRequest holds details about calls to the UpdatePullRequest method.
+		UpdatePullRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Repo is the repo argument value.
+			Repo string
+			// Number is the number argument value.
+			Number int
+			// Opts is the opts argument value.
+			Opts github.UpdatePullRequestOptions
+		}
+	}
+	lockAddCommentReaction           sync.RWMutex
+	lockAddIssueReaction             sync.RWMutex
+	lockAddLabels                    sync.RWMutex
+	lockCloseIssue                   sync.RWMutex
+	lockCompareCommits               sync.RWMutex
+	lockCreateComment                sync.RWMutex
+	lockCreateDeploymentBranchPolicy sync.RWMutex
+	lockCreateGist                   sync.RWMutex
+	lockCreateIssue                  sync.RWMutex
+	lockCreateLabel                  sync.RWMutex
+	lockCreateMilestone              sync.RWMutex
+	lockCreateOrUpdateEnvironment    sync.RWMutex
+	lockCreatePullRequest            sync.RWMutex
+	lockCreateRef                    sync.RWMutex
+	lockCreateRepository             sync.RWMutex
+	lockCreateRepositoryFromTemplate sync.RWMutex
+	lockCreateReviewComment          sync.RWMutex
+	lockCreateTagObject              sync.RWMutex
+	lockDeleteComment                sync.RWMutex
+	lockDeleteDeploymentBranchPolicy sync.RWMutex
+	lockDeleteEnvironment            sync.RWMutex
+	lockDeleteGist                   sync.RWMutex
+	lockDeleteLabel                  sync.RWMutex
+	lockDeleteMilestone              sync.RWMutex
+	lockDeleteRef                    sync.RWMutex
+	lockDeleteSecret                 sync.RWMutex
+	lockDeleteThreadSubscription     sync.RWMutex
+	lockDeleteVariable               sync.RWMutex
+	lockDownloadArtifact             sync.RWMutex
+	lockDownloadGistFile             sync.RWMutex
+	lockForkRepository               sync.RWMutex
+	lockGetCombinedStatus            sync.RWMutex
+	lockGetEnvironment               sync.RWMutex
+	lockGetGist                      sync.RWMutex
+	lockGetIssue                     sync.RWMutex
+	lockGetMilestone                 sync.RWMutex
+	lockGetPullRequest               sync.RWMutex
+	lockGetRepository                sync.RWMutex
+	lockGetTrafficClones             sync.RWMutex
+	lockGetTrafficViews              sync.RWMutex
+	lockGetWorkflowJobLogs           sync.RWMutex
+	lockGetWorkflowRun               sync.RWMutex
+	lockGetWorkflowRunJobs           sync.RWMutex
+	lockGetWorkflowRunLogs           sync.RWMutex
+	lockListArtifacts                sync.RWMutex
+	lockListCheckRuns                sync.RWMutex
+	lockListCodeScanningAlerts       sync.RWMutex
+	lockListComments                 sync.RWMutex
+	lockListCommits                  sync.RWMutex
+	lockListContributorStats         sync.RWMutex
+	lockListDependabotAlerts         sync.RWMutex
+	lockListDeploymentBranchPolicies sync.RWMutex
+	lockListEnvironments             sync.RWMutex
+	lockListGists                    sync.RWMutex
+	lockListIssues                   sync.RWMutex
+	lockListLabels                   sync.RWMutex
+	lockListMilestones               sync.RWMutex
+	lockListNotifications            sync.RWMutex
+	lockListPullRequests             sync.RWMutex
+	lockListRepositories             sync.RWMutex
+	lockListReviews                  sync.RWMutex
+	lockListSecretScanningAlerts     sync.RWMutex
+	lockListSecrets                  sync.RWMutex
+	lockListTags                     sync.RWMutex
+	lockListTopReferrers             sync.RWMutex
+	lockListVariables                sync.RWMutex
+	lockListWorkflowRuns             sync.RWMutex
+	lockMarkNotificationsRead        sync.RWMutex
+	lockMarkThreadRead               sync.RWMutex
+	lockMergePullRequest             sync.RWMutex
+	lockQueryGraphQL                 sync.RWMutex
+	lockRemoveLabel                  sync.RWMutex
+	lockRequestReviewers             sync.RWMutex
+	lockSetSecret                    sync.RWMutex
+	lockSetThreadSubscription        sync.RWMutex
+	lockSetVariable                  sync.RWMutex
+	lockSubmitReview                 sync.RWMutex
+	lockTransferRepository           sync.RWMutex
+	lockTriggerWorkflow              sync.RWMutex
+	lockUpdateComment                sync.RWMutex
+	lockUpdateGist                   sync.RWMutex
+	lockUpdateIssue                  sync.RWMutex
+	lockUpdateLabel                  sync.RWMutex
+	lockUpdateMilestone              sync.RWMutex
+	lockUpdatePullRequest            sync.RWMutex
+}
+
+// AddCommentReaction calls AddCommentReactionFunc.
+func (mock *ProviderMock) AddCommentReaction(ctx context.Context, owner string, repo string, commentID int64, content string) error {
+	if mock.AddCommentReactionFunc == nil {
+		panic("ProviderMock.AddCommentReactionFunc: method is nil but Provider.AddCommentReaction was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Owner     string
+		Repo      string
+		CommentID int64
+		Content   string
+	}{
+		Ctx:       ctx,
+		Owner:     owner,
+		Repo:      repo,
+		CommentID: commentID,
+		Content:   content,
+	}
+	mock.lockAddCommentReaction.Lock()
+	mock.calls.AddCommentReaction = append(mock.calls.AddCommentReaction, callInfo)
+	mock.lockAddCommentReaction.Unlock()
+	return mock.AddCommentReactionFunc(ctx, owner, repo, commentID, content)
+}
+
+// AddCommentReactionCalls gets all the calls that were made to AddCommentReaction.
+// Check the length with:
+//
+//	len(mockedProvider.AddCommentReactionCalls())
+func (mock *ProviderMock) AddCommentReactionCalls() []struct {
+	Ctx       context.Context
+	Owner     string
+	Repo      string
+	CommentID int64
+	Content   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Owner     string
+		Repo      string
+		CommentID int64
+		Content   string
+	}
+	mock.lockAddCommentReaction.RLock()
+	calls = mock.calls.AddCommentReaction
+	mock.lockAddCommentReaction.RUnlock()
+	return calls
+}
+
+// AddIssueReaction calls AddIssueReactionFunc.
+func (mock *ProviderMock) AddIssueReaction(ctx context.Context, owner string, repo string, number int, content string) error {
+	if mock.AddIssueReactionFunc == nil {
+		panic("ProviderMock.AddIssueReactionFunc: method is nil but Provider.AddIssueReaction was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Owner   string
+		Repo    string
+		Number  int
+		Content string
+	}{
+		Ctx:     ctx,
+		Owner:   owner,
+		Repo:    repo,
+		Number:  number,
+		Content: content,
+	}
+	mock.lockAddIssueReaction.Lock()
+	mock.calls.AddIssueReaction = append(mock.calls.AddIssueReaction, callInfo)
+	mock.lockAddIssueReaction.Unlock()
+	return mock.AddIssueReactionFunc(ctx, owner, repo, number, content)
+}
+
+// AddIssueReactionCalls gets all the calls that were made to AddIssueReaction.
+// Check the length with:
+//
+//	len(mockedProvider.AddIssueReactionCalls())
+func (mock *ProviderMock) AddIssueReactionCalls() []struct {
+	Ctx     context.Context
+	Owner   string
+	Repo    string
+	Number  int
+	Content string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Owner   string
+		Repo    string
+		Number  int
+		Content string
+	}
+	mock.lockAddIssueReaction.RLock()
+	calls = mock.calls.AddIssueReaction
+	mock.lockAddIssueReaction.RUnlock()
+	return calls
+}
+
+// AddLabels calls AddLabelsFunc.
+func (mock *ProviderMock) AddLabels(ctx context.Context, owner string, repo string, number int, labels []string) error {
+	if mock.AddLabelsFunc == nil {
+		panic("ProviderMock.AddLabelsFunc: method is nil but Provider.AddLabels was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Labels []string
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+		Labels: labels,
+	}
+	mock.lockAddLabels.Lock()
+	mock.calls.AddLabels = append(mock.calls.AddLabels, callInfo)
+	mock.lockAddLabels.Unlock()
+	return mock.AddLabelsFunc(ctx, owner, repo, number, labels)
+}
+
+// AddLabelsCalls gets all the calls that were made to AddLabels.
+// Check the length with:
+//
+//	len(mockedProvider.AddLabelsCalls())
+func (mock *ProviderMock) AddLabelsCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+	Labels []string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Labels []string
+	}
+	mock.lockAddLabels.RLock()
+	calls = mock.calls.AddLabels
+	mock.lockAddLabels.RUnlock()
+	return calls
+}
+
+// CloseIssue calls CloseIssueFunc.
+func (mock *ProviderMock) CloseIssue(ctx context.Context, owner string, repo string, number int) error {
+	if mock.CloseIssueFunc == nil {
+		panic("ProviderMock.CloseIssueFunc: method is nil but Provider.CloseIssue was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+	}
+	mock.lockCloseIssue.Lock()
+	mock.calls.CloseIssue = append(mock.calls.CloseIssue, callInfo)
+	mock.lockCloseIssue.Unlock()
+	return mock.CloseIssueFunc(ctx, owner, repo, number)
+}
+
+// CloseIssueCalls gets all the calls that were made to CloseIssue.
+// Check the length with:
+//
+//	len(mockedProvider.CloseIssueCalls())
+func (mock *ProviderMock) CloseIssueCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}
+	mock.lockCloseIssue.RLock()
+	calls = mock.calls.CloseIssue
+	mock.lockCloseIssue.RUnlock()
+	return calls
+}
+
+// CompareCommits calls CompareCommitsFunc.
+func (mock *ProviderMock) CompareCommits(ctx context.Context, owner string, repo string, base string, head string, opts github.ListOptions) (*github.ComparisonData, error) {
+	if mock.CompareCommitsFunc == nil {
+		panic("ProviderMock.CompareCommitsFunc: method is nil but Provider.CompareCommits was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Base  string
+		Head  string
+		Opts  github.ListOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Base:  base,
+		Head:  head,
+		Opts:  opts,
+	}
+	mock.lockCompareCommits.Lock()
+	mock.calls.CompareCommits = append(mock.calls.CompareCommits, callInfo)
+	mock.lockCompareCommits.Unlock()
+	return mock.CompareCommitsFunc(ctx, owner, repo, base, head, opts)
+}
+
+// CompareCommitsCalls gets all the calls that were made to CompareCommits.
+// Check the length with:
+//
+//	len(mockedProvider.CompareCommitsCalls())
+func (mock *ProviderMock) CompareCommitsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Base  string
+	Head  string
+	Opts  github.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Base  string
+		Head  string
+		Opts  github.ListOptions
+	}
+	mock.lockCompareCommits.RLock()
+	calls = mock.calls.CompareCommits
+	mock.lockCompareCommits.RUnlock()
+	return calls
+}
+
+// CreateComment calls CreateCommentFunc.
+func (mock *ProviderMock) CreateComment(ctx context.Context, owner string, repo string, number int, body string) (*github.CommentData, error) {
+	if mock.CreateCommentFunc == nil {
+		panic("ProviderMock.CreateCommentFunc: method is nil but Provider.CreateComment was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Body   string
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+		Body:   body,
+	}
+	mock.lockCreateComment.Lock()
+	mock.calls.CreateComment = append(mock.calls.CreateComment, callInfo)
+	mock.lockCreateComment.Unlock()
+	return mock.CreateCommentFunc(ctx, owner, repo, number, body)
+}
+
+// CreateCommentCalls gets all the calls that were made to CreateComment.
+// Check the length with:
+//
+//	len(mockedProvider.CreateCommentCalls())
+func (mock *ProviderMock) CreateCommentCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+	Body   string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Body   string
+	}
+	mock.lockCreateComment.RLock()
+	calls = mock.calls.CreateComment
+	mock.lockCreateComment.RUnlock()
+	return calls
+}
+
+// CreateDeploymentBranchPolicy calls CreateDeploymentBranchPolicyFunc.
+func (mock *ProviderMock) CreateDeploymentBranchPolicy(ctx context.Context, owner string, repo string, environment string, policy github.DeploymentBranchPolicyData) (*github.DeploymentBranchPolicyData, error) {
+	if mock.CreateDeploymentBranchPolicyFunc == nil {
+		panic("ProviderMock.CreateDeploymentBranchPolicyFunc: method is nil but Provider.CreateDeploymentBranchPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Owner       string
+		Repo        string
+		Environment string
+		Policy      github.DeploymentBranchPolicyData
+	}{
+		Ctx:         ctx,
+		Owner:       owner,
+		Repo:        repo,
+		Environment: environment,
+		Policy:      policy,
+	}
+	mock.lockCreateDeploymentBranchPolicy.Lock()
+	mock.calls.CreateDeploymentBranchPolicy = append(mock.calls.CreateDeploymentBranchPolicy, callInfo)
+	mock.lockCreateDeploymentBranchPolicy.Unlock()
+	return mock.CreateDeploymentBranchPolicyFunc(ctx, owner, repo, environment, policy)
+}
+
+// CreateDeploymentBranchPolicyCalls gets all the calls that were made to CreateDeploymentBranchPolicy.
+// Check the length with:
+//
+//	len(mockedProvider.CreateDeploymentBranchPolicyCalls())
+func (mock *ProviderMock) CreateDeploymentBranchPolicyCalls() []struct {
+	Ctx         context.Context
+	Owner       string
+	Repo        string
+	Environment string
+	Policy      github.DeploymentBranchPolicyData
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Owner       string
+		Repo        string
+		Environment string
+		Policy      github.DeploymentBranchPolicyData
+	}
+	mock.lockCreateDeploymentBranchPolicy.RLock()
+	calls = mock.calls.CreateDeploymentBranchPolicy
+	mock.lockCreateDeploymentBranchPolicy.RUnlock()
+	return calls
+}
+
+// CreateGist calls CreateGistFunc.
+func (mock *ProviderMock) CreateGist(ctx context.Context, opts github.CreateGistOptions) (*github.GistData, error) {
+	if mock.CreateGistFunc == nil {
+		panic("ProviderMock.CreateGistFunc: method is nil but Provider.CreateGist was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts github.CreateGistOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockCreateGist.Lock()
+	mock.calls.CreateGist = append(mock.calls.CreateGist, callInfo)
+	mock.lockCreateGist.Unlock()
+	return mock.CreateGistFunc(ctx, opts)
+}
+
+// CreateGistCalls gets all the calls that were made to CreateGist.
+// Check the length with:
+//
+//	len(mockedProvider.CreateGistCalls())
+func (mock *ProviderMock) CreateGistCalls() []struct {
+	Ctx  context.Context
+	Opts github.CreateGistOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts github.CreateGistOptions
+	}
+	mock.lockCreateGist.RLock()
+	calls = mock.calls.CreateGist
+	mock.lockCreateGist.RUnlock()
+	return calls
+}
+
+// CreateIssue calls CreateIssueFunc.
+func (mock *ProviderMock) CreateIssue(ctx context.Context, owner string, repo string, opts github.CreateIssueOptions) (*github.IssueData, error) {
+	if mock.CreateIssueFunc == nil {
+		panic("ProviderMock.CreateIssueFunc: method is nil but Provider.CreateIssue was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreateIssueOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockCreateIssue.Lock()
+	mock.calls.CreateIssue = append(mock.calls.CreateIssue, callInfo)
+	mock.lockCreateIssue.Unlock()
+	return mock.CreateIssueFunc(ctx, owner, repo, opts)
+}
+
+// CreateIssueCalls gets all the calls that were made to CreateIssue.
+// Check the length with:
+//
+//	len(mockedProvider.CreateIssueCalls())
+func (mock *ProviderMock) CreateIssueCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.CreateIssueOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreateIssueOptions
+	}
+	mock.lockCreateIssue.RLock()
+	calls = mock.calls.CreateIssue
+	mock.lockCreateIssue.RUnlock()
+	return calls
+}
+
+// CreateLabel calls CreateLabelFunc.
+func (mock *ProviderMock) CreateLabel(ctx context.Context, owner string, repo string, opts github.CreateLabelOptions) (*github.LabelData, error) {
+	if mock.CreateLabelFunc == nil {
+		panic("ProviderMock.CreateLabelFunc: method is nil but Provider.CreateLabel was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreateLabelOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockCreateLabel.Lock()
+	mock.calls.CreateLabel = append(mock.calls.CreateLabel, callInfo)
+	mock.lockCreateLabel.Unlock()
+	return mock.CreateLabelFunc(ctx, owner, repo, opts)
+}
+
+// CreateLabelCalls gets all the calls that were made to CreateLabel.
+// Check the length with:
+//
+//	len(mockedProvider.CreateLabelCalls())
+func (mock *ProviderMock) CreateLabelCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.CreateLabelOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreateLabelOptions
+	}
+	mock.lockCreateLabel.RLock()
+	calls = mock.calls.CreateLabel
+	mock.lockCreateLabel.RUnlock()
+	return calls
+}
+
+// CreateMilestone calls CreateMilestoneFunc.
+func (mock *ProviderMock) CreateMilestone(ctx context.Context, owner string, repo string, opts github.CreateMilestoneOptions) (*github.MilestoneData, error) {
+	if mock.CreateMilestoneFunc == nil {
+		panic("ProviderMock.CreateMilestoneFunc: method is nil but Provider.CreateMilestone was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreateMilestoneOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockCreateMilestone.Lock()
+	mock.calls.CreateMilestone = append(mock.calls.CreateMilestone, callInfo)
+	mock.lockCreateMilestone.Unlock()
+	return mock.CreateMilestoneFunc(ctx, owner, repo, opts)
+}
+
+// CreateMilestoneCalls gets all the calls that were made to CreateMilestone.
+// Check the length with:
+//
+//	len(mockedProvider.CreateMilestoneCalls())
+func (mock *ProviderMock) CreateMilestoneCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.CreateMilestoneOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreateMilestoneOptions
+	}
+	mock.lockCreateMilestone.RLock()
+	calls = mock.calls.CreateMilestone
+	mock.lockCreateMilestone.RUnlock()
+	return calls
+}
+
+// CreateOrUpdateEnvironment calls CreateOrUpdateEnvironmentFunc.
+func (mock *ProviderMock) CreateOrUpdateEnvironment(ctx context.Context, owner string, repo string, name string, opts github.EnvironmentOptions) (*github.EnvironmentData, error) {
+	if mock.CreateOrUpdateEnvironmentFunc == nil {
+		panic("ProviderMock.CreateOrUpdateEnvironmentFunc: method is nil but Provider.CreateOrUpdateEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+		Opts  github.EnvironmentOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Name:  name,
+		Opts:  opts,
+	}
+	mock.lockCreateOrUpdateEnvironment.Lock()
+	mock.calls.CreateOrUpdateEnvironment = append(mock.calls.CreateOrUpdateEnvironment, callInfo)
+	mock.lockCreateOrUpdateEnvironment.Unlock()
+	return mock.CreateOrUpdateEnvironmentFunc(ctx, owner, repo, name, opts)
+}
+
+// CreateOrUpdateEnvironmentCalls gets all the calls that were made to CreateOrUpdateEnvironment.
+// Check the length with:
+//
+//	len(mockedProvider.CreateOrUpdateEnvironmentCalls())
+func (mock *ProviderMock) CreateOrUpdateEnvironmentCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Name  string
+	Opts  github.EnvironmentOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+		Opts  github.EnvironmentOptions
+	}
+	mock.lockCreateOrUpdateEnvironment.RLock()
+	calls = mock.calls.CreateOrUpdateEnvironment
+	mock.lockCreateOrUpdateEnvironment.RUnlock()
+	return calls
+}
+
+// CreatePullRequest calls CreatePullRequestFunc.
+func (mock *ProviderMock) CreatePullRequest(ctx context.Context, owner string, repo string, opts github.CreatePullRequestOptions) (*github.PullRequestData, error) {
+	if mock.CreatePullRequestFunc == nil {
+		panic("ProviderMock.CreatePullRequestFunc: method is nil but Provider.CreatePullRequest was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreatePullRequestOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockCreatePullRequest.Lock()
+	mock.calls.CreatePullRequest = append(mock.calls.CreatePullRequest, callInfo)
+	mock.lockCreatePullRequest.Unlock()
+	return mock.CreatePullRequestFunc(ctx, owner, repo, opts)
+}
+
+// CreatePullRequestCalls gets all the calls that were made to CreatePullRequest.
+// Check the length with:
+//
+//	len(mockedProvider.CreatePullRequestCalls())
+func (mock *ProviderMock) CreatePullRequestCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.CreatePullRequestOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreatePullRequestOptions
+	}
+	mock.lockCreatePullRequest.RLock()
+	calls = mock.calls.CreatePullRequest
+	mock.lockCreatePullRequest.RUnlock()
+	return calls
+}
+
+// CreateRef calls CreateRefFunc.
+func (mock *ProviderMock) CreateRef(ctx context.Context, owner string, repo string, ref string, sha string) (*github.RefData, error) {
+	if mock.CreateRefFunc == nil {
+		panic("ProviderMock.CreateRefFunc: method is nil but Provider.CreateRef was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Ref   string
+		Sha   string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Ref:   ref,
+		Sha:   sha,
+	}
+	mock.lockCreateRef.Lock()
+	mock.calls.CreateRef = append(mock.calls.CreateRef, callInfo)
+	mock.lockCreateRef.Unlock()
+	return mock.CreateRefFunc(ctx, owner, repo, ref, sha)
+}
+
+// CreateRefCalls gets all the calls that were made to CreateRef.
+// Check the length with:
+//
+//	len(mockedProvider.CreateRefCalls())
+func (mock *ProviderMock) CreateRefCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Ref   string
+	Sha   string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Ref   string
+		Sha   string
+	}
+	mock.lockCreateRef.RLock()
+	calls = mock.calls.CreateRef
+	mock.lockCreateRef.RUnlock()
+	return calls
+}
+
+// CreateRepository calls CreateRepositoryFunc.
+func (mock *ProviderMock) CreateRepository(ctx context.Context, owner string, opts github.CreateRepositoryOptions) (*github.RepositoryData, error) {
+	if mock.CreateRepositoryFunc == nil {
+		panic("ProviderMock.CreateRepositoryFunc: method is nil but Provider.CreateRepository was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Opts  github.CreateRepositoryOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Opts:  opts,
+	}
+	mock.lockCreateRepository.Lock()
+	mock.calls.CreateRepository = append(mock.calls.CreateRepository, callInfo)
+	mock.lockCreateRepository.Unlock()
+	return mock.CreateRepositoryFunc(ctx, owner, opts)
+}
+
+// CreateRepositoryCalls gets all the calls that were made to CreateRepository.
+// Check the length with:
+//
+//	len(mockedProvider.CreateRepositoryCalls())
+func (mock *ProviderMock) CreateRepositoryCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Opts  github.CreateRepositoryOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Opts  github.CreateRepositoryOptions
+	}
+	mock.lockCreateRepository.RLock()
+	calls = mock.calls.CreateRepository
+	mock.lockCreateRepository.RUnlock()
+	return calls
+}
+
+// CreateRepositoryFromTemplate calls CreateRepositoryFromTemplateFunc.
+func (mock *ProviderMock) CreateRepositoryFromTemplate(ctx context.Context, templateOwner string, templateRepo string, opts github.CreateRepositoryFromTemplateOptions) (*github.RepositoryData, error) {
+	if mock.CreateRepositoryFromTemplateFunc == nil {
+		panic("ProviderMock.CreateRepositoryFromTemplateFunc: method is nil but Provider.CreateRepositoryFromTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		TemplateOwner string
+		TemplateRepo  string
+		Opts          github.CreateRepositoryFromTemplateOptions
+	}{
+		Ctx:           ctx,
+		TemplateOwner: templateOwner,
+		TemplateRepo:  templateRepo,
+		Opts:          opts,
+	}
+	mock.lockCreateRepositoryFromTemplate.Lock()
+	mock.calls.CreateRepositoryFromTemplate = append(mock.calls.CreateRepositoryFromTemplate, callInfo)
+	mock.lockCreateRepositoryFromTemplate.Unlock()
+	return mock.CreateRepositoryFromTemplateFunc(ctx, templateOwner, templateRepo, opts)
+}
+
+// CreateRepositoryFromTemplateCalls gets all the calls that were made to CreateRepositoryFromTemplate.
+// Check the length with:
+//
+//	len(mockedProvider.CreateRepositoryFromTemplateCalls())
+func (mock *ProviderMock) CreateRepositoryFromTemplateCalls() []struct {
+	Ctx           context.Context
+	TemplateOwner string
+	TemplateRepo  string
+	Opts          github.CreateRepositoryFromTemplateOptions
+} {
+	var calls []struct {
+		Ctx           context.Context
+		TemplateOwner string
+		TemplateRepo  string
+		Opts          github.CreateRepositoryFromTemplateOptions
+	}
+	mock.lockCreateRepositoryFromTemplate.RLock()
+	calls = mock.calls.CreateRepositoryFromTemplate
+	mock.lockCreateRepositoryFromTemplate.RUnlock()
+	return calls
+}
+
+// CreateReviewComment calls CreateReviewCommentFunc.
+func (mock *ProviderMock) CreateReviewComment(ctx context.Context, owner string, repo string, number int, opts github.CreateReviewCommentOptions) (*github.ReviewCommentData, error) {
+	if mock.CreateReviewCommentFunc == nil {
+		panic("ProviderMock.CreateReviewCommentFunc: method is nil but Provider.CreateReviewComment was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Opts   github.CreateReviewCommentOptions
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+		Opts:   opts,
+	}
+	mock.lockCreateReviewComment.Lock()
+	mock.calls.CreateReviewComment = append(mock.calls.CreateReviewComment, callInfo)
+	mock.lockCreateReviewComment.Unlock()
+	return mock.CreateReviewCommentFunc(ctx, owner, repo, number, opts)
+}
+
+// CreateReviewCommentCalls gets all the calls that were made to CreateReviewComment.
+// Check the length with:
+//
+//	len(mockedProvider.CreateReviewCommentCalls())
+func (mock *ProviderMock) CreateReviewCommentCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+	Opts   github.CreateReviewCommentOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Opts   github.CreateReviewCommentOptions
+	}
+	mock.lockCreateReviewComment.RLock()
+	calls = mock.calls.CreateReviewComment
+	mock.lockCreateReviewComment.RUnlock()
+	return calls
+}
+
+// CreateTagObject calls CreateTagObjectFunc.
+func (mock *ProviderMock) CreateTagObject(ctx context.Context, owner string, repo string, opts github.CreateTagOptions) (*github.TagObjectData, error) {
+	if mock.CreateTagObjectFunc == nil {
+		panic("ProviderMock.CreateTagObjectFunc: method is nil but Provider.CreateTagObject was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreateTagOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockCreateTagObject.Lock()
+	mock.calls.CreateTagObject = append(mock.calls.CreateTagObject, callInfo)
+	mock.lockCreateTagObject.Unlock()
+	return mock.CreateTagObjectFunc(ctx, owner, repo, opts)
+}
+
+// CreateTagObjectCalls gets all the calls that were made to CreateTagObject.
+// Check the length with:
+//
+//	len(mockedProvider.CreateTagObjectCalls())
+func (mock *ProviderMock) CreateTagObjectCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.CreateTagOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.CreateTagOptions
+	}
+	mock.lockCreateTagObject.RLock()
+	calls = mock.calls.CreateTagObject
+	mock.lockCreateTagObject.RUnlock()
+	return calls
+}
+
+// DeleteComment calls DeleteCommentFunc.
+func (mock *ProviderMock) DeleteComment(ctx context.Context, owner string, repo string, commentID int64) error {
+	if mock.DeleteCommentFunc == nil {
+		panic("ProviderMock.DeleteCommentFunc: method is nil but Provider.DeleteComment was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Owner     string
+		Repo      string
+		CommentID int64
+	}{
+		Ctx:       ctx,
+		Owner:     owner,
+		Repo:      repo,
+		CommentID: commentID,
+	}
+	mock.lockDeleteComment.Lock()
+	mock.calls.DeleteComment = append(mock.calls.DeleteComment, callInfo)
+	mock.lockDeleteComment.Unlock()
+	return mock.DeleteCommentFunc(ctx, owner, repo, commentID)
+}
+
+// DeleteCommentCalls gets all the calls that were made to DeleteComment.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteCommentCalls())
+func (mock *ProviderMock) DeleteCommentCalls() []struct {
+	Ctx       context.Context
+	Owner     string
+	Repo      string
+	CommentID int64
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Owner     string
+		Repo      string
+		CommentID int64
+	}
+	mock.lockDeleteComment.RLock()
+	calls = mock.calls.DeleteComment
+	mock.lockDeleteComment.RUnlock()
+	return calls
+}
+
+// DeleteDeploymentBranchPolicy calls DeleteDeploymentBranchPolicyFunc.
+func (mock *ProviderMock) DeleteDeploymentBranchPolicy(ctx context.Context, owner string, repo string, environment string, policyID int64) error {
+	if mock.DeleteDeploymentBranchPolicyFunc == nil {
+		panic("ProviderMock.DeleteDeploymentBranchPolicyFunc: method is nil but Provider.DeleteDeploymentBranchPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Owner       string
+		Repo        string
+		Environment string
+		PolicyID    int64
+	}{
+		Ctx:         ctx,
+		Owner:       owner,
+		Repo:        repo,
+		Environment: environment,
+		PolicyID:    policyID,
+	}
+	mock.lockDeleteDeploymentBranchPolicy.Lock()
+	mock.calls.DeleteDeploymentBranchPolicy = append(mock.calls.DeleteDeploymentBranchPolicy, callInfo)
+	mock.lockDeleteDeploymentBranchPolicy.Unlock()
+	return mock.DeleteDeploymentBranchPolicyFunc(ctx, owner, repo, environment, policyID)
+}
+
+// DeleteDeploymentBranchPolicyCalls gets all the calls that were made to DeleteDeploymentBranchPolicy.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteDeploymentBranchPolicyCalls())
+func (mock *ProviderMock) DeleteDeploymentBranchPolicyCalls() []struct {
+	Ctx         context.Context
+	Owner       string
+	Repo        string
+	Environment string
+	PolicyID    int64
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Owner       string
+		Repo        string
+		Environment string
+		PolicyID    int64
+	}
+	mock.lockDeleteDeploymentBranchPolicy.RLock()
+	calls = mock.calls.DeleteDeploymentBranchPolicy
+	mock.lockDeleteDeploymentBranchPolicy.RUnlock()
+	return calls
+}
+
+// DeleteEnvironment calls DeleteEnvironmentFunc.
+func (mock *ProviderMock) DeleteEnvironment(ctx context.Context, owner string, repo string, name string) error {
+	if mock.DeleteEnvironmentFunc == nil {
+		panic("ProviderMock.DeleteEnvironmentFunc: method is nil but Provider.DeleteEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Name:  name,
+	}
+	mock.lockDeleteEnvironment.Lock()
+	mock.calls.DeleteEnvironment = append(mock.calls.DeleteEnvironment, callInfo)
+	mock.lockDeleteEnvironment.Unlock()
+	return mock.DeleteEnvironmentFunc(ctx, owner, repo, name)
+}
+
+// DeleteEnvironmentCalls gets all the calls that were made to DeleteEnvironment.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteEnvironmentCalls())
+func (mock *ProviderMock) DeleteEnvironmentCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Name  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+	}
+	mock.lockDeleteEnvironment.RLock()
+	calls = mock.calls.DeleteEnvironment
+	mock.lockDeleteEnvironment.RUnlock()
+	return calls
+}
+
+// DeleteGist calls DeleteGistFunc.
+func (mock *ProviderMock) DeleteGist(ctx context.Context, id string) error {
+	if mock.DeleteGistFunc == nil {
+		panic("ProviderMock.DeleteGistFunc: method is nil but Provider.DeleteGist was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Id  string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDeleteGist.Lock()
+	mock.calls.DeleteGist = append(mock.calls.DeleteGist, callInfo)
+	mock.lockDeleteGist.Unlock()
+	return mock.DeleteGistFunc(ctx, id)
+}
+
+// DeleteGistCalls gets all the calls that were made to DeleteGist.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteGistCalls())
+func (mock *ProviderMock) DeleteGistCalls() []struct {
+	Ctx context.Context
+	Id  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Id  string
+	}
+	mock.lockDeleteGist.RLock()
+	calls = mock.calls.DeleteGist
+	mock.lockDeleteGist.RUnlock()
+	return calls
+}
+
+// DeleteLabel calls DeleteLabelFunc.
+func (mock *ProviderMock) DeleteLabel(ctx context.Context, owner string, repo string, name string) error {
+	if mock.DeleteLabelFunc == nil {
+		panic("ProviderMock.DeleteLabelFunc: method is nil but Provider.DeleteLabel was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Name:  name,
+	}
+	mock.lockDeleteLabel.Lock()
+	mock.calls.DeleteLabel = append(mock.calls.DeleteLabel, callInfo)
+	mock.lockDeleteLabel.Unlock()
+	return mock.DeleteLabelFunc(ctx, owner, repo, name)
+}
+
+// DeleteLabelCalls gets all the calls that were made to DeleteLabel.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteLabelCalls())
+func (mock *ProviderMock) DeleteLabelCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Name  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+	}
+	mock.lockDeleteLabel.RLock()
+	calls = mock.calls.DeleteLabel
+	mock.lockDeleteLabel.RUnlock()
+	return calls
+}
+
+// DeleteMilestone calls DeleteMilestoneFunc.
+func (mock *ProviderMock) DeleteMilestone(ctx context.Context, owner string, repo string, number int) error {
+	if mock.DeleteMilestoneFunc == nil {
+		panic("ProviderMock.DeleteMilestoneFunc: method is nil but Provider.DeleteMilestone was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+	}
+	mock.lockDeleteMilestone.Lock()
+	mock.calls.DeleteMilestone = append(mock.calls.DeleteMilestone, callInfo)
+	mock.lockDeleteMilestone.Unlock()
+	return mock.DeleteMilestoneFunc(ctx, owner, repo, number)
+}
+
+// DeleteMilestoneCalls gets all the calls that were made to DeleteMilestone.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteMilestoneCalls())
+func (mock *ProviderMock) DeleteMilestoneCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}
+	mock.lockDeleteMilestone.RLock()
+	calls = mock.calls.DeleteMilestone
+	mock.lockDeleteMilestone.RUnlock()
+	return calls
+}
+
+// DeleteRef calls DeleteRefFunc.
+func (mock *ProviderMock) DeleteRef(ctx context.Context, owner string, repo string, ref string) error {
+	if mock.DeleteRefFunc == nil {
+		panic("ProviderMock.DeleteRefFunc: method is nil but Provider.DeleteRef was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Ref   string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Ref:   ref,
+	}
+	mock.lockDeleteRef.Lock()
+	mock.calls.DeleteRef = append(mock.calls.DeleteRef, callInfo)
+	mock.lockDeleteRef.Unlock()
+	return mock.DeleteRefFunc(ctx, owner, repo, ref)
+}
+
+// DeleteRefCalls gets all the calls that were made to DeleteRef.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteRefCalls())
+func (mock *ProviderMock) DeleteRefCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Ref   string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Ref   string
+	}
+	mock.lockDeleteRef.RLock()
+	calls = mock.calls.DeleteRef
+	mock.lockDeleteRef.RUnlock()
+	return calls
+}
+
+// DeleteSecret calls DeleteSecretFunc.
+func (mock *ProviderMock) DeleteSecret(ctx context.Context, scope github.SecretScope, name string) error {
+	if mock.DeleteSecretFunc == nil {
+		panic("ProviderMock.DeleteSecretFunc: method is nil but Provider.DeleteSecret was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Scope github.SecretScope
+		Name  string
+	}{
+		Ctx:   ctx,
+		Scope: scope,
+		Name:  name,
+	}
+	mock.lockDeleteSecret.Lock()
+	mock.calls.DeleteSecret = append(mock.calls.DeleteSecret, callInfo)
+	mock.lockDeleteSecret.Unlock()
+	return mock.DeleteSecretFunc(ctx, scope, name)
+}
+
+// DeleteSecretCalls gets all the calls that were made to DeleteSecret.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteSecretCalls())
+func (mock *ProviderMock) DeleteSecretCalls() []struct {
+	Ctx   context.Context
+	Scope github.SecretScope
+	Name  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Scope github.SecretScope
+		Name  string
+	}
+	mock.lockDeleteSecret.RLock()
+	calls = mock.calls.DeleteSecret
+	mock.lockDeleteSecret.RUnlock()
+	return calls
+}
+
+// DeleteThreadSubscription calls DeleteThreadSubscriptionFunc.
+func (mock *ProviderMock) DeleteThreadSubscription(ctx context.Context, threadID string) error {
+	if mock.DeleteThreadSubscriptionFunc == nil {
+		panic("ProviderMock.DeleteThreadSubscriptionFunc: method is nil but Provider.DeleteThreadSubscription was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ThreadID string
+	}{
+		Ctx:      ctx,
+		ThreadID: threadID,
+	}
+	mock.lockDeleteThreadSubscription.Lock()
+	mock.calls.DeleteThreadSubscription = append(mock.calls.DeleteThreadSubscription, callInfo)
+	mock.lockDeleteThreadSubscription.Unlock()
+	return mock.DeleteThreadSubscriptionFunc(ctx, threadID)
+}
+
+// DeleteThreadSubscriptionCalls gets all the calls that were made to DeleteThreadSubscription.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteThreadSubscriptionCalls())
+func (mock *ProviderMock) DeleteThreadSubscriptionCalls() []struct {
+	Ctx      context.Context
+	ThreadID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ThreadID string
+	}
+	mock.lockDeleteThreadSubscription.RLock()
+	calls = mock.calls.DeleteThreadSubscription
+	mock.lockDeleteThreadSubscription.RUnlock()
+	return calls
+}
+
+// DeleteVariable calls DeleteVariableFunc.
+func (mock *ProviderMock) DeleteVariable(ctx context.Context, scope github.SecretScope, name string) error {
+	if mock.DeleteVariableFunc == nil {
+		panic("ProviderMock.DeleteVariableFunc: method is nil but Provider.DeleteVariable was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Scope github.SecretScope
+		Name  string
+	}{
+		Ctx:   ctx,
+		Scope: scope,
+		Name:  name,
+	}
+	mock.lockDeleteVariable.Lock()
+	mock.calls.DeleteVariable = append(mock.calls.DeleteVariable, callInfo)
+	mock.lockDeleteVariable.Unlock()
+	return mock.DeleteVariableFunc(ctx, scope, name)
+}
+
+// DeleteVariableCalls gets all the calls that were made to DeleteVariable.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteVariableCalls())
+func (mock *ProviderMock) DeleteVariableCalls() []struct {
+	Ctx   context.Context
+	Scope github.SecretScope
+	Name  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Scope github.SecretScope
+		Name  string
+	}
+	mock.lockDeleteVariable.RLock()
+	calls = mock.calls.DeleteVariable
+	mock.lockDeleteVariable.RUnlock()
+	return calls
+}
+
+// DownloadArtifact calls DownloadArtifactFunc.
+func (mock *ProviderMock) DownloadArtifact(ctx context.Context, owner string, repo string, artifactID int64) (io.ReadCloser, error) {
+	if mock.DownloadArtifactFunc == nil {
+		panic("ProviderMock.DownloadArtifactFunc: method is nil but Provider.DownloadArtifact was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Owner      string
+		Repo       string
+		ArtifactID int64
+	}{
+		Ctx:        ctx,
+		Owner:      owner,
+		Repo:       repo,
+		ArtifactID: artifactID,
+	}
+	mock.lockDownloadArtifact.Lock()
+	mock.calls.DownloadArtifact = append(mock.calls.DownloadArtifact, callInfo)
+	mock.lockDownloadArtifact.Unlock()
+	return mock.DownloadArtifactFunc(ctx, owner, repo, artifactID)
+}
+
+// DownloadArtifactCalls gets all the calls that were made to DownloadArtifact.
+// Check the length with:
+//
+//	len(mockedProvider.DownloadArtifactCalls())
+func (mock *ProviderMock) DownloadArtifactCalls() []struct {
+	Ctx        context.Context
+	Owner      string
+	Repo       string
+	ArtifactID int64
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Owner      string
+		Repo       string
+		ArtifactID int64
+	}
+	mock.lockDownloadArtifact.RLock()
+	calls = mock.calls.DownloadArtifact
+	mock.lockDownloadArtifact.RUnlock()
+	return calls
+}
+
+// DownloadGistFile calls DownloadGistFileFunc.
+func (mock *ProviderMock) DownloadGistFile(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	if mock.DownloadGistFileFunc == nil {
+		panic("ProviderMock.DownloadGistFileFunc: method is nil but Provider.DownloadGistFile was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		RawURL string
+	}{
+		Ctx:    ctx,
+		RawURL: rawURL,
+	}
+	mock.lockDownloadGistFile.Lock()
+	mock.calls.DownloadGistFile = append(mock.calls.DownloadGistFile, callInfo)
+	mock.lockDownloadGistFile.Unlock()
+	return mock.DownloadGistFileFunc(ctx, rawURL)
+}
+
+// DownloadGistFileCalls gets all the calls that were made to DownloadGistFile.
+// Check the length with:
+//
+//	len(mockedProvider.DownloadGistFileCalls())
+func (mock *ProviderMock) DownloadGistFileCalls() []struct {
+	Ctx    context.Context
+	RawURL string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		RawURL string
+	}
+	mock.lockDownloadGistFile.RLock()
+	calls = mock.calls.DownloadGistFile
+	mock.lockDownloadGistFile.RUnlock()
+	return calls
+}
+
+// ForkRepository calls ForkRepositoryFunc.
+func (mock *ProviderMock) ForkRepository(ctx context.Context, owner string, repo string, opts github.ForkRepositoryOptions) (*github.RepositoryData, error) {
+	if mock.ForkRepositoryFunc == nil {
+		panic("ProviderMock.ForkRepositoryFunc: method is nil but Provider.ForkRepository was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ForkRepositoryOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockForkRepository.Lock()
+	mock.calls.ForkRepository = append(mock.calls.ForkRepository, callInfo)
+	mock.lockForkRepository.Unlock()
+	return mock.ForkRepositoryFunc(ctx, owner, repo, opts)
+}
+
+// ForkRepositoryCalls gets all the calls that were made to ForkRepository.
+// Check the length with:
+//
+//	len(mockedProvider.ForkRepositoryCalls())
+func (mock *ProviderMock) ForkRepositoryCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ForkRepositoryOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ForkRepositoryOptions
+	}
+	mock.lockForkRepository.RLock()
+	calls = mock.calls.ForkRepository
+	mock.lockForkRepository.RUnlock()
+	return calls
+}
+
+// GetCombinedStatus calls GetCombinedStatusFunc.
+func (mock *ProviderMock) GetCombinedStatus(ctx context.Context, owner string, repo string, ref string) (*github.CombinedStatusData, error) {
+	if mock.GetCombinedStatusFunc == nil {
+		panic("ProviderMock.GetCombinedStatusFunc: method is nil but Provider.GetCombinedStatus was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Ref   string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Ref:   ref,
+	}
+	mock.lockGetCombinedStatus.Lock()
+	mock.calls.GetCombinedStatus = append(mock.calls.GetCombinedStatus, callInfo)
+	mock.lockGetCombinedStatus.Unlock()
+	return mock.GetCombinedStatusFunc(ctx, owner, repo, ref)
+}
+
+// GetCombinedStatusCalls gets all the calls that were made to GetCombinedStatus.
+// Check the length with:
+//
+//	len(mockedProvider.GetCombinedStatusCalls())
+func (mock *ProviderMock) GetCombinedStatusCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Ref   string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Ref   string
+	}
+	mock.lockGetCombinedStatus.RLock()
+	calls = mock.calls.GetCombinedStatus
+	mock.lockGetCombinedStatus.RUnlock()
+	return calls
+}
+
+// GetEnvironment calls GetEnvironmentFunc.
+func (mock *ProviderMock) GetEnvironment(ctx context.Context, owner string, repo string, name string) (*github.EnvironmentData, error) {
+	if mock.GetEnvironmentFunc == nil {
+		panic("ProviderMock.GetEnvironmentFunc: method is nil but Provider.GetEnvironment was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Name:  name,
+	}
+	mock.lockGetEnvironment.Lock()
+	mock.calls.GetEnvironment = append(mock.calls.GetEnvironment, callInfo)
+	mock.lockGetEnvironment.Unlock()
+	return mock.GetEnvironmentFunc(ctx, owner, repo, name)
+}
+
+// GetEnvironmentCalls gets all the calls that were made to GetEnvironment.
+// Check the length with:
+//
+//	len(mockedProvider.GetEnvironmentCalls())
+func (mock *ProviderMock) GetEnvironmentCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Name  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+	}
+	mock.lockGetEnvironment.RLock()
+	calls = mock.calls.GetEnvironment
+	mock.lockGetEnvironment.RUnlock()
+	return calls
+}
+
+// GetGist calls GetGistFunc.
+func (mock *ProviderMock) GetGist(ctx context.Context, id string) (*github.GistData, error) {
+	if mock.GetGistFunc == nil {
+		panic("ProviderMock.GetGistFunc: method is nil but Provider.GetGist was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Id  string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetGist.Lock()
+	mock.calls.GetGist = append(mock.calls.GetGist, callInfo)
+	mock.lockGetGist.Unlock()
+	return mock.GetGistFunc(ctx, id)
+}
+
+// GetGistCalls gets all the calls that were made to GetGist.
+// Check the length with:
+//
+//	len(mockedProvider.GetGistCalls())
+func (mock *ProviderMock) GetGistCalls() []struct {
+	Ctx context.Context
+	Id  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Id  string
+	}
+	mock.lockGetGist.RLock()
+	calls = mock.calls.GetGist
+	mock.lockGetGist.RUnlock()
+	return calls
+}
+
+// GetIssue calls GetIssueFunc.
+func (mock *ProviderMock) GetIssue(ctx context.Context, owner string, repo string, number int) (*github.IssueData, error) {
+	if mock.GetIssueFunc == nil {
+		panic("ProviderMock.GetIssueFunc: method is nil but Provider.GetIssue was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+	}
+	mock.lockGetIssue.Lock()
+	mock.calls.GetIssue = append(mock.calls.GetIssue, callInfo)
+	mock.lockGetIssue.Unlock()
+	return mock.GetIssueFunc(ctx, owner, repo, number)
+}
+
+// GetIssueCalls gets all the calls that were made to GetIssue.
+// Check the length with:
+//
+//	len(mockedProvider.GetIssueCalls())
+func (mock *ProviderMock) GetIssueCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}
+	mock.lockGetIssue.RLock()
+	calls = mock.calls.GetIssue
+	mock.lockGetIssue.RUnlock()
+	return calls
+}
+
+// GetMilestone calls GetMilestoneFunc.
+func (mock *ProviderMock) GetMilestone(ctx context.Context, owner string, repo string, number int) (*github.MilestoneData, error) {
+	if mock.GetMilestoneFunc == nil {
+		panic("ProviderMock.GetMilestoneFunc: method is nil but Provider.GetMilestone was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+	}
+	mock.lockGetMilestone.Lock()
+	mock.calls.GetMilestone = append(mock.calls.GetMilestone, callInfo)
+	mock.lockGetMilestone.Unlock()
+	return mock.GetMilestoneFunc(ctx, owner, repo, number)
+}
+
+// GetMilestoneCalls gets all the calls that were made to GetMilestone.
+// Check the length with:
+//
+//	len(mockedProvider.GetMilestoneCalls())
+func (mock *ProviderMock) GetMilestoneCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}
+	mock.lockGetMilestone.RLock()
+	calls = mock.calls.GetMilestone
+	mock.lockGetMilestone.RUnlock()
+	return calls
+}
+
+// GetPullRequest calls GetPullRequestFunc.
+func (mock *ProviderMock) GetPullRequest(ctx context.Context, owner string, repo string, number int) (*github.PullRequestData, error) {
+	if mock.GetPullRequestFunc == nil {
+		panic("ProviderMock.GetPullRequestFunc: method is nil but Provider.GetPullRequest was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+	}
+	mock.lockGetPullRequest.Lock()
+	mock.calls.GetPullRequest = append(mock.calls.GetPullRequest, callInfo)
+	mock.lockGetPullRequest.Unlock()
+	return mock.GetPullRequestFunc(ctx, owner, repo, number)
+}
+
+// GetPullRequestCalls gets all the calls that were made to GetPullRequest.
+// Check the length with:
+//
+//	len(mockedProvider.GetPullRequestCalls())
+func (mock *ProviderMock) GetPullRequestCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+	}
+	mock.lockGetPullRequest.RLock()
+	calls = mock.calls.GetPullRequest
+	mock.lockGetPullRequest.RUnlock()
+	return calls
+}
+
+// GetRepository calls GetRepositoryFunc.
+func (mock *ProviderMock) GetRepository(ctx context.Context, owner string, repo string) (*github.RepositoryData, error) {
+	if mock.GetRepositoryFunc == nil {
+		panic("ProviderMock.GetRepositoryFunc: method is nil but Provider.GetRepository was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+	}
+	mock.lockGetRepository.Lock()
+	mock.calls.GetRepository = append(mock.calls.GetRepository, callInfo)
+	mock.lockGetRepository.Unlock()
+	return mock.GetRepositoryFunc(ctx, owner, repo)
+}
+
+// GetRepositoryCalls gets all the calls that were made to GetRepository.
+// Check the length with:
+//
+//	len(mockedProvider.GetRepositoryCalls())
+func (mock *ProviderMock) GetRepositoryCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+	}
+	mock.lockGetRepository.RLock()
+	calls = mock.calls.GetRepository
+	mock.lockGetRepository.RUnlock()
+	return calls
+}
+
+// GetTrafficClones calls GetTrafficClonesFunc.
+func (mock *ProviderMock) GetTrafficClones(ctx context.Context, owner string, repo string, opts github.TrafficOptions) (*github.TrafficData, error) {
+	if mock.GetTrafficClonesFunc == nil {
+		panic("ProviderMock.GetTrafficClonesFunc: method is nil but Provider.GetTrafficClones was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.TrafficOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockGetTrafficClones.Lock()
+	mock.calls.GetTrafficClones = append(mock.calls.GetTrafficClones, callInfo)
+	mock.lockGetTrafficClones.Unlock()
+	return mock.GetTrafficClonesFunc(ctx, owner, repo, opts)
+}
+
+// GetTrafficClonesCalls gets all the calls that were made to GetTrafficClones.
+// Check the length with:
+//
+//	len(mockedProvider.GetTrafficClonesCalls())
+func (mock *ProviderMock) GetTrafficClonesCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.TrafficOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.TrafficOptions
+	}
+	mock.lockGetTrafficClones.RLock()
+	calls = mock.calls.GetTrafficClones
+	mock.lockGetTrafficClones.RUnlock()
+	return calls
+}
+
+// GetTrafficViews calls GetTrafficViewsFunc.
+func (mock *ProviderMock) GetTrafficViews(ctx context.Context, owner string, repo string, opts github.TrafficOptions) (*github.TrafficData, error) {
+	if mock.GetTrafficViewsFunc == nil {
+		panic("ProviderMock.GetTrafficViewsFunc: method is nil but Provider.GetTrafficViews was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.TrafficOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockGetTrafficViews.Lock()
+	mock.calls.GetTrafficViews = append(mock.calls.GetTrafficViews, callInfo)
+	mock.lockGetTrafficViews.Unlock()
+	return mock.GetTrafficViewsFunc(ctx, owner, repo, opts)
+}
+
+// GetTrafficViewsCalls gets all the calls that were made to GetTrafficViews.
+// Check the length with:
+//
+//	len(mockedProvider.GetTrafficViewsCalls())
+func (mock *ProviderMock) GetTrafficViewsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.TrafficOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.TrafficOptions
+	}
+	mock.lockGetTrafficViews.RLock()
+	calls = mock.calls.GetTrafficViews
+	mock.lockGetTrafficViews.RUnlock()
+	return calls
+}
+
+// GetWorkflowJobLogs calls GetWorkflowJobLogsFunc.
+func (mock *ProviderMock) GetWorkflowJobLogs(ctx context.Context, owner string, repo string, jobID int64) (io.ReadCloser, error) {
+	if mock.GetWorkflowJobLogsFunc == nil {
+		panic("ProviderMock.GetWorkflowJobLogsFunc: method is nil but Provider.GetWorkflowJobLogs was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		JobID int64
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		JobID: jobID,
+	}
+	mock.lockGetWorkflowJobLogs.Lock()
+	mock.calls.GetWorkflowJobLogs = append(mock.calls.GetWorkflowJobLogs, callInfo)
+	mock.lockGetWorkflowJobLogs.Unlock()
+	return mock.GetWorkflowJobLogsFunc(ctx, owner, repo, jobID)
+}
+
+// GetWorkflowJobLogsCalls gets all the calls that were made to GetWorkflowJobLogs.
+// Check the length with:
+//
+//	len(mockedProvider.GetWorkflowJobLogsCalls())
+func (mock *ProviderMock) GetWorkflowJobLogsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	JobID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		JobID int64
+	}
+	mock.lockGetWorkflowJobLogs.RLock()
+	calls = mock.calls.GetWorkflowJobLogs
+	mock.lockGetWorkflowJobLogs.RUnlock()
+	return calls
+}
+
+// GetWorkflowRun calls GetWorkflowRunFunc.
+func (mock *ProviderMock) GetWorkflowRun(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRunData, error) {
+	if mock.GetWorkflowRunFunc == nil {
+		panic("ProviderMock.GetWorkflowRunFunc: method is nil but Provider.GetWorkflowRun was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockGetWorkflowRun.Lock()
+	mock.calls.GetWorkflowRun = append(mock.calls.GetWorkflowRun, callInfo)
+	mock.lockGetWorkflowRun.Unlock()
+	return mock.GetWorkflowRunFunc(ctx, owner, repo, runID)
+}
+
+// GetWorkflowRunCalls gets all the calls that were made to GetWorkflowRun.
+// Check the length with:
+//
+//	len(mockedProvider.GetWorkflowRunCalls())
+func (mock *ProviderMock) GetWorkflowRunCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		RunID int64
+	}
+	mock.lockGetWorkflowRun.RLock()
+	calls = mock.calls.GetWorkflowRun
+	mock.lockGetWorkflowRun.RUnlock()
+	return calls
+}
+
+// GetWorkflowRunJobs calls GetWorkflowRunJobsFunc.
+func (mock *ProviderMock) GetWorkflowRunJobs(ctx context.Context, owner string, repo string, runID int64) ([]*github.WorkflowJobData, error) {
+	if mock.GetWorkflowRunJobsFunc == nil {
+		panic("ProviderMock.GetWorkflowRunJobsFunc: method is nil but Provider.GetWorkflowRunJobs was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockGetWorkflowRunJobs.Lock()
+	mock.calls.GetWorkflowRunJobs = append(mock.calls.GetWorkflowRunJobs, callInfo)
+	mock.lockGetWorkflowRunJobs.Unlock()
+	return mock.GetWorkflowRunJobsFunc(ctx, owner, repo, runID)
+}
+
+// GetWorkflowRunJobsCalls gets all the calls that were made to GetWorkflowRunJobs.
+// Check the length with:
+//
+//	len(mockedProvider.GetWorkflowRunJobsCalls())
+func (mock *ProviderMock) GetWorkflowRunJobsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		RunID int64
+	}
+	mock.lockGetWorkflowRunJobs.RLock()
+	calls = mock.calls.GetWorkflowRunJobs
+	mock.lockGetWorkflowRunJobs.RUnlock()
+	return calls
+}
+
+// GetWorkflowRunLogs calls GetWorkflowRunLogsFunc.
+func (mock *ProviderMock) GetWorkflowRunLogs(ctx context.Context, owner string, repo string, runID int64) (io.ReadCloser, error) {
+	if mock.GetWorkflowRunLogsFunc == nil {
+		panic("ProviderMock.GetWorkflowRunLogsFunc: method is nil but Provider.GetWorkflowRunLogs was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		RunID int64
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		RunID: runID,
+	}
+	mock.lockGetWorkflowRunLogs.Lock()
+	mock.calls.GetWorkflowRunLogs = append(mock.calls.GetWorkflowRunLogs, callInfo)
+	mock.lockGetWorkflowRunLogs.Unlock()
+	return mock.GetWorkflowRunLogsFunc(ctx, owner, repo, runID)
+}
+
+// GetWorkflowRunLogsCalls gets all the calls that were made to GetWorkflowRunLogs.
+// Check the length with:
+//
+//	len(mockedProvider.GetWorkflowRunLogsCalls())
+func (mock *ProviderMock) GetWorkflowRunLogsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	RunID int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		RunID int64
+	}
+	mock.lockGetWorkflowRunLogs.RLock()
+	calls = mock.calls.GetWorkflowRunLogs
+	mock.lockGetWorkflowRunLogs.RUnlock()
+	return calls
+}
+
+// ListArtifacts calls ListArtifactsFunc.
+func (mock *ProviderMock) ListArtifacts(ctx context.Context, owner string, repo string, runID int64, opts github.ListOptions) ([]*github.ArtifactData, error) {
+	if mock.ListArtifactsFunc == nil {
+		panic("ProviderMock.ListArtifactsFunc: method is nil but Provider.ListArtifacts was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		RunID int64
+		Opts  github.ListOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		RunID: runID,
+		Opts:  opts,
+	}
+	mock.lockListArtifacts.Lock()
+	mock.calls.ListArtifacts = append(mock.calls.ListArtifacts, callInfo)
+	mock.lockListArtifacts.Unlock()
+	return mock.ListArtifactsFunc(ctx, owner, repo, runID, opts)
+}
+
+// ListArtifactsCalls gets all the calls that were made to ListArtifacts.
+// Check the length with:
+//
+//	len(mockedProvider.ListArtifactsCalls())
+func (mock *ProviderMock) ListArtifactsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	RunID int64
+	Opts  github.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		RunID int64
+		Opts  github.ListOptions
+	}
+	mock.lockListArtifacts.RLock()
+	calls = mock.calls.ListArtifacts
+	mock.lockListArtifacts.RUnlock()
+	return calls
+}
+
+// ListCheckRuns calls ListCheckRunsFunc.
+func (mock *ProviderMock) ListCheckRuns(ctx context.Context, owner string, repo string, ref string) ([]*github.CheckRunData, error) {
+	if mock.ListCheckRunsFunc == nil {
+		panic("ProviderMock.ListCheckRunsFunc: method is nil but Provider.ListCheckRuns was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Ref   string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Ref:   ref,
+	}
+	mock.lockListCheckRuns.Lock()
+	mock.calls.ListCheckRuns = append(mock.calls.ListCheckRuns, callInfo)
+	mock.lockListCheckRuns.Unlock()
+	return mock.ListCheckRunsFunc(ctx, owner, repo, ref)
+}
+
+// ListCheckRunsCalls gets all the calls that were made to ListCheckRuns.
+// Check the length with:
+//
+//	len(mockedProvider.ListCheckRunsCalls())
+func (mock *ProviderMock) ListCheckRunsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Ref   string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Ref   string
+	}
+	mock.lockListCheckRuns.RLock()
+	calls = mock.calls.ListCheckRuns
+	mock.lockListCheckRuns.RUnlock()
+	return calls
+}
+
+// ListCodeScanningAlerts calls ListCodeScanningAlertsFunc.
+func (mock *ProviderMock) ListCodeScanningAlerts(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.CodeScanningAlertData, error) {
+	if mock.ListCodeScanningAlertsFunc == nil {
+		panic("ProviderMock.ListCodeScanningAlertsFunc: method is nil but Provider.ListCodeScanningAlerts was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListAlertsOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockListCodeScanningAlerts.Lock()
+	mock.calls.ListCodeScanningAlerts = append(mock.calls.ListCodeScanningAlerts, callInfo)
+	mock.lockListCodeScanningAlerts.Unlock()
+	return mock.ListCodeScanningAlertsFunc(ctx, owner, repo, opts)
+}
+
+// ListCodeScanningAlertsCalls gets all the calls that were made to ListCodeScanningAlerts.
+// Check the length with:
+//
+//	len(mockedProvider.ListCodeScanningAlertsCalls())
+func (mock *ProviderMock) ListCodeScanningAlertsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListAlertsOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListAlertsOptions
+	}
+	mock.lockListCodeScanningAlerts.RLock()
+	calls = mock.calls.ListCodeScanningAlerts
+	mock.lockListCodeScanningAlerts.RUnlock()
+	return calls
+}
+
+// ListComments calls ListCommentsFunc.
+func (mock *ProviderMock) ListComments(ctx context.Context, owner string, repo string, number int, opts github.ListOptions) ([]*github.CommentData, error) {
+	if mock.ListCommentsFunc == nil {
+		panic("ProviderMock.ListCommentsFunc: method is nil but Provider.ListComments was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Opts   github.ListOptions
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+		Opts:   opts,
+	}
+	mock.lockListComments.Lock()
+	mock.calls.ListComments = append(mock.calls.ListComments, callInfo)
+	mock.lockListComments.Unlock()
+	return mock.ListCommentsFunc(ctx, owner, repo, number, opts)
+}
+
+// ListCommentsCalls gets all the calls that were made to ListComments.
+// Check the length with:
+//
+//	len(mockedProvider.ListCommentsCalls())
+func (mock *ProviderMock) ListCommentsCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+	Opts   github.ListOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Opts   github.ListOptions
+	}
+	mock.lockListComments.RLock()
+	calls = mock.calls.ListComments
+	mock.lockListComments.RUnlock()
+	return calls
+}
+
+// ListCommits calls ListCommitsFunc.
+func (mock *ProviderMock) ListCommits(ctx context.Context, owner string, repo string, opts github.ListCommitsOptions) ([]*github.CommitData, error) {
+	if mock.ListCommitsFunc == nil {
+		panic("ProviderMock.ListCommitsFunc: method is nil but Provider.ListCommits was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListCommitsOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockListCommits.Lock()
+	mock.calls.ListCommits = append(mock.calls.ListCommits, callInfo)
+	mock.lockListCommits.Unlock()
+	return mock.ListCommitsFunc(ctx, owner, repo, opts)
+}
+
+// ListCommitsCalls gets all the calls that were made to ListCommits.
+// Check the length with:
+//
+//	len(mockedProvider.ListCommitsCalls())
+func (mock *ProviderMock) ListCommitsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListCommitsOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListCommitsOptions
+	}
+	mock.lockListCommits.RLock()
+	calls = mock.calls.ListCommits
+	mock.lockListCommits.RUnlock()
+	return calls
+}
+
+// ListContributorStats calls ListContributorStatsFunc.
+func (mock *ProviderMock) ListContributorStats(ctx context.Context, owner string, repo string) ([]*github.ContributorStatsData, error) {
+	if mock.ListContributorStatsFunc == nil {
+		panic("ProviderMock.ListContributorStatsFunc: method is nil but Provider.ListContributorStats was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+	}
+	mock.lockListContributorStats.Lock()
+	mock.calls.ListContributorStats = append(mock.calls.ListContributorStats, callInfo)
+	mock.lockListContributorStats.Unlock()
+	return mock.ListContributorStatsFunc(ctx, owner, repo)
+}
+
+// ListContributorStatsCalls gets all the calls that were made to ListContributorStats.
+// Check the length with:
+//
+//	len(mockedProvider.ListContributorStatsCalls())
+func (mock *ProviderMock) ListContributorStatsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+	}
+	mock.lockListContributorStats.RLock()
+	calls = mock.calls.ListContributorStats
+	mock.lockListContributorStats.RUnlock()
+	return calls
+}
+
+// ListDependabotAlerts calls ListDependabotAlertsFunc.
+func (mock *ProviderMock) ListDependabotAlerts(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.DependabotAlertData, error) {
+	if mock.ListDependabotAlertsFunc == nil {
+		panic("ProviderMock.ListDependabotAlertsFunc: method is nil but Provider.ListDependabotAlerts was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListAlertsOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockListDependabotAlerts.Lock()
+	mock.calls.ListDependabotAlerts = append(mock.calls.ListDependabotAlerts, callInfo)
+	mock.lockListDependabotAlerts.Unlock()
+	return mock.ListDependabotAlertsFunc(ctx, owner, repo, opts)
+}
+
+// ListDependabotAlertsCalls gets all the calls that were made to ListDependabotAlerts.
+// Check the length with:
+//
+//	len(mockedProvider.ListDependabotAlertsCalls())
+func (mock *ProviderMock) ListDependabotAlertsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListAlertsOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListAlertsOptions
+	}
+	mock.lockListDependabotAlerts.RLock()
+	calls = mock.calls.ListDependabotAlerts
+	mock.lockListDependabotAlerts.RUnlock()
+	return calls
+}
+
+// ListDeploymentBranchPolicies calls ListDeploymentBranchPoliciesFunc.
+func (mock *ProviderMock) ListDeploymentBranchPolicies(ctx context.Context, owner string, repo string, environment string) ([]*github.DeploymentBranchPolicyData, error) {
+	if mock.ListDeploymentBranchPoliciesFunc == nil {
+		panic("ProviderMock.ListDeploymentBranchPoliciesFunc: method is nil but Provider.ListDeploymentBranchPolicies was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Owner       string
+		Repo        string
+		Environment string
+	}{
+		Ctx:         ctx,
+		Owner:       owner,
+		Repo:        repo,
+		Environment: environment,
+	}
+	mock.lockListDeploymentBranchPolicies.Lock()
+	mock.calls.ListDeploymentBranchPolicies = append(mock.calls.ListDeploymentBranchPolicies, callInfo)
+	mock.lockListDeploymentBranchPolicies.Unlock()
+	return mock.ListDeploymentBranchPoliciesFunc(ctx, owner, repo, environment)
+}
+
+// ListDeploymentBranchPoliciesCalls gets all the calls that were made to ListDeploymentBranchPolicies.
+// Check the length with:
+//
+//	len(mockedProvider.ListDeploymentBranchPoliciesCalls())
+func (mock *ProviderMock) ListDeploymentBranchPoliciesCalls() []struct {
+	Ctx         context.Context
+	Owner       string
+	Repo        string
+	Environment string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Owner       string
+		Repo        string
+		Environment string
+	}
+	mock.lockListDeploymentBranchPolicies.RLock()
+	calls = mock.calls.ListDeploymentBranchPolicies
+	mock.lockListDeploymentBranchPolicies.RUnlock()
+	return calls
+}
+
+// ListEnvironments calls ListEnvironmentsFunc.
+func (mock *ProviderMock) ListEnvironments(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.EnvironmentData, error) {
+	if mock.ListEnvironmentsFunc == nil {
+		panic("ProviderMock.ListEnvironmentsFunc: method is nil but Provider.ListEnvironments was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockListEnvironments.Lock()
+	mock.calls.ListEnvironments = append(mock.calls.ListEnvironments, callInfo)
+	mock.lockListEnvironments.Unlock()
+	return mock.ListEnvironmentsFunc(ctx, owner, repo, opts)
+}
+
+// ListEnvironmentsCalls gets all the calls that were made to ListEnvironments.
+// Check the length with:
+//
+//	len(mockedProvider.ListEnvironmentsCalls())
+func (mock *ProviderMock) ListEnvironmentsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListOptions
+	}
+	mock.lockListEnvironments.RLock()
+	calls = mock.calls.ListEnvironments
+	mock.lockListEnvironments.RUnlock()
+	return calls
+}
+
+// ListGists calls ListGistsFunc.
+func (mock *ProviderMock) ListGists(ctx context.Context, opts github.ListOptions) ([]*github.GistData, error) {
+	if mock.ListGistsFunc == nil {
+		panic("ProviderMock.ListGistsFunc: method is nil but Provider.ListGists was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts github.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListGists.Lock()
+	mock.calls.ListGists = append(mock.calls.ListGists, callInfo)
+	mock.lockListGists.Unlock()
+	return mock.ListGistsFunc(ctx, opts)
+}
+
+// ListGistsCalls gets all the calls that were made to ListGists.
+// Check the length with:
+//
+//	len(mockedProvider.ListGistsCalls())
+func (mock *ProviderMock) ListGistsCalls() []struct {
+	Ctx  context.Context
+	Opts github.ListOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts github.ListOptions
+	}
+	mock.lockListGists.RLock()
+	calls = mock.calls.ListGists
+	mock.lockListGists.RUnlock()
+	return calls
+}
+
+// ListIssues calls ListIssuesFunc.
+func (mock *ProviderMock) ListIssues(ctx context.Context, owner string, repo string, opts github.ListIssuesOptions) ([]*github.IssueData, error) {
+	if mock.ListIssuesFunc == nil {
+		panic("ProviderMock.ListIssuesFunc: method is nil but Provider.ListIssues was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListIssuesOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockListIssues.Lock()
+	mock.calls.ListIssues = append(mock.calls.ListIssues, callInfo)
+	mock.lockListIssues.Unlock()
+	return mock.ListIssuesFunc(ctx, owner, repo, opts)
+}
+
+// ListIssuesCalls gets all the calls that were made to ListIssues.
+// Check the length with:
+//
+//	len(mockedProvider.ListIssuesCalls())
+func (mock *ProviderMock) ListIssuesCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListIssuesOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListIssuesOptions
+	}
+	mock.lockListIssues.RLock()
+	calls = mock.calls.ListIssues
+	mock.lockListIssues.RUnlock()
+	return calls
+}
+
+// ListLabels calls ListLabelsFunc.
+func (mock *ProviderMock) ListLabels(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.LabelData, error) {
+	if mock.ListLabelsFunc == nil {
+		panic("ProviderMock.ListLabelsFunc: method is nil but Provider.ListLabels was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockListLabels.Lock()
+	mock.calls.ListLabels = append(mock.calls.ListLabels, callInfo)
+	mock.lockListLabels.Unlock()
+	return mock.ListLabelsFunc(ctx, owner, repo, opts)
+}
+
+// ListLabelsCalls gets all the calls that were made to ListLabels.
+// Check the length with:
+//
+//	len(mockedProvider.ListLabelsCalls())
+func (mock *ProviderMock) ListLabelsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListOptions
+	}
+	mock.lockListLabels.RLock()
+	calls = mock.calls.ListLabels
+	mock.lockListLabels.RUnlock()
+	return calls
+}
+
+// ListMilestones calls ListMilestonesFunc.
+func (mock *ProviderMock) ListMilestones(ctx context.Context, owner string, repo string, opts github.ListMilestonesOptions) ([]*github.MilestoneData, error) {
+	if mock.ListMilestonesFunc == nil {
+		panic("ProviderMock.ListMilestonesFunc: method is nil but Provider.ListMilestones was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListMilestonesOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockListMilestones.Lock()
+	mock.calls.ListMilestones = append(mock.calls.ListMilestones, callInfo)
+	mock.lockListMilestones.Unlock()
+	return mock.ListMilestonesFunc(ctx, owner, repo, opts)
+}
+
+// ListMilestonesCalls gets all the calls that were made to ListMilestones.
+// Check the length with:
+//
+//	len(mockedProvider.ListMilestonesCalls())
+func (mock *ProviderMock) ListMilestonesCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListMilestonesOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListMilestonesOptions
+	}
+	mock.lockListMilestones.RLock()
+	calls = mock.calls.ListMilestones
+	mock.lockListMilestones.RUnlock()
+	return calls
+}
+
+// ListNotifications calls ListNotificationsFunc.
+func (mock *ProviderMock) ListNotifications(ctx context.Context, opts github.ListNotificationsOptions) ([]*github.NotificationData, error) {
+	if mock.ListNotificationsFunc == nil {
+		panic("ProviderMock.ListNotificationsFunc: method is nil but Provider.ListNotifications was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts github.ListNotificationsOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListNotifications.Lock()
+	mock.calls.ListNotifications = append(mock.calls.ListNotifications, callInfo)
+	mock.lockListNotifications.Unlock()
+	return mock.ListNotificationsFunc(ctx, opts)
+}
+
+// ListNotificationsCalls gets all the calls that were made to ListNotifications.
+// Check the length with:
+//
+//	len(mockedProvider.ListNotificationsCalls())
+func (mock *ProviderMock) ListNotificationsCalls() []struct {
+	Ctx  context.Context
+	Opts github.ListNotificationsOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts github.ListNotificationsOptions
+	}
+	mock.lockListNotifications.RLock()
+	calls = mock.calls.ListNotifications
+	mock.lockListNotifications.RUnlock()
+	return calls
+}
+
+// ListPullRequests calls ListPullRequestsFunc.
+func (mock *ProviderMock) ListPullRequests(ctx context.Context, owner string, repo string, opts github.ListPullRequestsOptions) ([]*github.PullRequestData, error) {
+	if mock.ListPullRequestsFunc == nil {
+		panic("ProviderMock.ListPullRequestsFunc: method is nil but Provider.ListPullRequests was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListPullRequestsOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
 	}
-	lockAddLabels          sync.RWMutex
-	lockCloseIssue         sync.RWMutex
-	lockCreateIssue        sync.RWMutex
-	lockCreatePullRequest  sync.RWMutex
-	lockCreateRepository   sync.RWMutex
-	lockGetIssue           sync.RWMutex
-	lockGetPullRequest     sync.RWMutex
-	lockGetRepository      sync.RWMutex
-	lockGetWorkflowRun     sync.RWMutex
-	lockGetWorkflowRunJobs sync.RWMutex
-	lockListIssues         sync.RWMutex
-	lockListPullRequests   sync.RWMutex
-	lockListRepositories   sync.RWMutex
-	lockListWorkflowRuns   sync.RWMutex
-	lockMergePullRequest   sync.RWMutex
-	lockRemoveLabel        sync.RWMutex
-	lockTriggerWorkflow    sync.RWMutex
-	lockUpdateIssue        sync.RWMutex
-	lockUpdatePullRequest  sync.RWMutex
+	mock.lockListPullRequests.Lock()
+	mock.calls.ListPullRequests = append(mock.calls.ListPullRequests, callInfo)
+	mock.lockListPullRequests.Unlock()
+	return mock.ListPullRequestsFunc(ctx, owner, repo, opts)
 }
 
-// AddLabels calls AddLabelsFunc.
-func (mock *ProviderMock) AddLabels(ctx context.Context, owner string, repo string, number int, labels []string) error {
-	if mock.AddLabelsFunc == nil {
-		panic("ProviderMock.AddLabelsFunc: method is nil but Provider.AddLabels was just called")
+// ListPullRequestsCalls gets all the calls that were made to ListPullRequests.
+// Check the length with:
+//
+//	len(mockedProvider.ListPullRequestsCalls())
+func (mock *ProviderMock) ListPullRequestsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListPullRequestsOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListPullRequestsOptions
+	}
+	mock.lockListPullRequests.RLock()
+	calls = mock.calls.ListPullRequests
+	mock.lockListPullRequests.RUnlock()
+	return calls
+}
+
+// ListRepositories calls ListRepositoriesFunc.
+func (mock *ProviderMock) ListRepositories(ctx context.Context, owner string, opts github.ListOptions) ([]*github.RepositoryData, error) {
+	if mock.ListRepositoriesFunc == nil {
+		panic("ProviderMock.ListRepositoriesFunc: method is nil but Provider.ListRepositories was just called")
 	}
 	callInfo := struct {
-		Ctx    context.Context
-		Owner  string
-		Repo   string
-		Number int
-		Labels []string
+		Ctx   context.Context
+		Owner string
+		Opts  github.ListOptions
 	}{
-		Ctx:    ctx,
-		Owner:  owner,
-		Repo:   repo,
-		Number: number,
-		Labels: labels,
+		Ctx:   ctx,
+		Owner: owner,
+		Opts:  opts,
 	}
-	mock.lockAddLabels.Lock()
-	mock.calls.AddLabels = append(mock.calls.AddLabels, callInfo)
-	mock.lockAddLabels.Unlock()
-	return mock.AddLabelsFunc(ctx, owner, repo, number, labels)
+	mock.lockListRepositories.Lock()
+	mock.calls.ListRepositories = append(mock.calls.ListRepositories, callInfo)
+	mock.lockListRepositories.Unlock()
+	return mock.ListRepositoriesFunc(ctx, owner, opts)
 }
 
-// AddLabelsCalls gets all the calls that were made to AddLabels.
+// ListRepositoriesCalls gets all the calls that were made to ListRepositories.
 // Check the length with:
 //
-//	len(mockedProvider.AddLabelsCalls())
-func (mock *ProviderMock) AddLabelsCalls() []struct {
-	Ctx    context.Context
-	Owner  string
-	Repo   string
-	Number int
-	Labels []string
+//	len(mockedProvider.ListRepositoriesCalls())
+func (mock *ProviderMock) ListRepositoriesCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Opts  github.ListOptions
 } {
 	var calls []struct {
-		Ctx    context.Context
-		Owner  string
-		Repo   string
-		Number int
-		Labels []string
+		Ctx   context.Context
+		Owner string
+		Opts  github.ListOptions
 	}
-	mock.lockAddLabels.RLock()
-	calls = mock.calls.AddLabels
-	mock.lockAddLabels.RUnlock()
+	mock.lockListRepositories.RLock()
+	calls = mock.calls.ListRepositories
+	mock.lockListRepositories.RUnlock()
 	return calls
 }
 
-// CloseIssue calls CloseIssueFunc.
-func (mock *ProviderMock) CloseIssue(ctx context.Context, owner string, repo string, number int) error {
-	if mock.CloseIssueFunc == nil {
-		panic("ProviderMock.CloseIssueFunc: method is nil but Provider.CloseIssue was just called")
+// ListReviews calls ListReviewsFunc.
+func (mock *ProviderMock) ListReviews(ctx context.Context, owner string, repo string, number int, opts github.ListOptions) ([]*github.ReviewData, error) {
+	if mock.ListReviewsFunc == nil {
+		panic("ProviderMock.ListReviewsFunc: method is nil but Provider.ListReviews was just called")
 	}
 	callInfo := struct {
 		Ctx    context.Context
 		Owner  string
 		Repo   string
 		Number int
+		Opts   github.ListOptions
 	}{
 		Ctx:    ctx,
 		Owner:  owner,
 		Repo:   repo,
 		Number: number,
+		Opts:   opts,
 	}
-	mock.lockCloseIssue.Lock()
-	mock.calls.CloseIssue = append(mock.calls.CloseIssue, callInfo)
-	mock.lockCloseIssue.Unlock()
-	return mock.CloseIssueFunc(ctx, owner, repo, number)
+	mock.lockListReviews.Lock()
+	mock.calls.ListReviews = append(mock.calls.ListReviews, callInfo)
+	mock.lockListReviews.Unlock()
+	return mock.ListReviewsFunc(ctx, owner, repo, number, opts)
 }
 
-// CloseIssueCalls gets all the calls that were made to CloseIssue.
+// ListReviewsCalls gets all the calls that were made to ListReviews.
 // Check the length with:
 //
-//	len(mockedProvider.CloseIssueCalls())
-func (mock *ProviderMock) CloseIssueCalls() []struct {
+//	len(mockedProvider.ListReviewsCalls())
+func (mock *ProviderMock) ListReviewsCalls() []struct {
 	Ctx    context.Context
 	Owner  string
 	Repo   string
 	Number int
+	Opts   github.ListOptions
 } {
 	var calls []struct {
 		Ctx    context.Context
 		Owner  string
 		Repo   string
 		Number int
+		Opts   github.ListOptions
 	}
-	mock.lockCloseIssue.RLock()
-	calls = mock.calls.CloseIssue
-	mock.lockCloseIssue.RUnlock()
+	mock.lockListReviews.RLock()
+	calls = mock.calls.ListReviews
+	mock.lockListReviews.RUnlock()
 	return calls
 }
 
-// CreateIssue calls CreateIssueFunc.
-func (mock *ProviderMock) CreateIssue(ctx context.Context, owner string, repo string, opts github.CreateIssueOptions) (*github.IssueData, error) {
-	if mock.CreateIssueFunc == nil {
-		panic("ProviderMock.CreateIssueFunc: method is nil but Provider.CreateIssue was just called")
+// ListSecretScanningAlerts calls ListSecretScanningAlertsFunc.
+func (mock *ProviderMock) ListSecretScanningAlerts(ctx context.Context, owner string, repo string, opts github.ListAlertsOptions) ([]*github.SecretScanningAlertData, error) {
+	if mock.ListSecretScanningAlertsFunc == nil {
+		panic("ProviderMock.ListSecretScanningAlertsFunc: method is nil but Provider.ListSecretScanningAlerts was just called")
 	}
 	callInfo := struct {
 		Ctx   context.Context
 		Owner string
 		Repo  string
-		Opts  github.CreateIssueOptions
+		Opts  github.ListAlertsOptions
 	}{
 		Ctx:   ctx,
 		Owner: owner,
 		Repo:  repo,
 		Opts:  opts,
 	}
-	mock.lockCreateIssue.Lock()
-	mock.calls.CreateIssue = append(mock.calls.CreateIssue, callInfo)
-	mock.lockCreateIssue.Unlock()
-	return mock.CreateIssueFunc(ctx, owner, repo, opts)
+	mock.lockListSecretScanningAlerts.Lock()
+	mock.calls.ListSecretScanningAlerts = append(mock.calls.ListSecretScanningAlerts, callInfo)
+	mock.lockListSecretScanningAlerts.Unlock()
+	return mock.ListSecretScanningAlertsFunc(ctx, owner, repo, opts)
 }
 
-// CreateIssueCalls gets all the calls that were made to CreateIssue.
+// ListSecretScanningAlertsCalls gets all the calls that were made to ListSecretScanningAlerts.
 // Check the length with:
 //
-//	len(mockedProvider.CreateIssueCalls())
-func (mock *ProviderMock) CreateIssueCalls() []struct {
+//	len(mockedProvider.ListSecretScanningAlertsCalls())
+func (mock *ProviderMock) ListSecretScanningAlertsCalls() []struct {
 	Ctx   context.Context
 	Owner string
 	Repo  string
-	Opts  github.CreateIssueOptions
+	Opts  github.ListAlertsOptions
 } {
 	var calls []struct {
 		Ctx   context.Context
 		Owner string
 		Repo  string
-		Opts  github.CreateIssueOptions
+		Opts  github.ListAlertsOptions
 	}
-	mock.lockCreateIssue.RLock()
-	calls = mock.calls.CreateIssue
-	mock.lockCreateIssue.RUnlock()
+	mock.lockListSecretScanningAlerts.RLock()
+	calls = mock.calls.ListSecretScanningAlerts
+	mock.lockListSecretScanningAlerts.RUnlock()
 	return calls
 }
 
-// CreatePullRequest calls CreatePullRequestFunc.
-func (mock *ProviderMock) CreatePullRequest(ctx context.Context, owner string, repo string, opts github.CreatePullRequestOptions) (*github.PullRequestData, error) {
-	if mock.CreatePullRequestFunc == nil {
-		panic("ProviderMock.CreatePullRequestFunc: method is nil but Provider.CreatePullRequest was just called")
+// ListSecrets calls ListSecretsFunc.
+func (mock *ProviderMock) ListSecrets(ctx context.Context, scope github.SecretScope, opts github.ListOptions) ([]*github.SecretData, error) {
+	if mock.ListSecretsFunc == nil {
+		panic("ProviderMock.ListSecretsFunc: method is nil but Provider.ListSecrets was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Scope github.SecretScope
+		Opts  github.ListOptions
+	}{
+		Ctx:   ctx,
+		Scope: scope,
+		Opts:  opts,
+	}
+	mock.lockListSecrets.Lock()
+	mock.calls.ListSecrets = append(mock.calls.ListSecrets, callInfo)
+	mock.lockListSecrets.Unlock()
+	return mock.ListSecretsFunc(ctx, scope, opts)
+}
+
+// ListSecretsCalls gets all the calls that were made to ListSecrets.
+// Check the length with:
+//
+//	len(mockedProvider.ListSecretsCalls())
+func (mock *ProviderMock) ListSecretsCalls() []struct {
+	Ctx   context.Context
+	Scope github.SecretScope
+	Opts  github.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Scope github.SecretScope
+		Opts  github.ListOptions
+	}
+	mock.lockListSecrets.RLock()
+	calls = mock.calls.ListSecrets
+	mock.lockListSecrets.RUnlock()
+	return calls
+}
+
+// ListTags calls ListTagsFunc.
+func (mock *ProviderMock) ListTags(ctx context.Context, owner string, repo string, opts github.ListOptions) ([]*github.TagData, error) {
+	if mock.ListTagsFunc == nil {
+		panic("ProviderMock.ListTagsFunc: method is nil but Provider.ListTags was just called")
 	}
 	callInfo := struct {
 		Ctx   context.Context
 		Owner string
 		Repo  string
-		Opts  github.CreatePullRequestOptions
+		Opts  github.ListOptions
 	}{
 		Ctx:   ctx,
 		Owner: owner,
 		Repo:  repo,
 		Opts:  opts,
 	}
-	mock.lockCreatePullRequest.Lock()
-	mock.calls.CreatePullRequest = append(mock.calls.CreatePullRequest, callInfo)
-	mock.lockCreatePullRequest.Unlock()
-	return mock.CreatePullRequestFunc(ctx, owner, repo, opts)
+	mock.lockListTags.Lock()
+	mock.calls.ListTags = append(mock.calls.ListTags, callInfo)
+	mock.lockListTags.Unlock()
+	return mock.ListTagsFunc(ctx, owner, repo, opts)
 }
 
-// CreatePullRequestCalls gets all the calls that were made to CreatePullRequest.
+// ListTagsCalls gets all the calls that were made to ListTags.
 // Check the length with:
 //
-//	len(mockedProvider.CreatePullRequestCalls())
-func (mock *ProviderMock) CreatePullRequestCalls() []struct {
+//	len(mockedProvider.ListTagsCalls())
+func (mock *ProviderMock) ListTagsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListOptions
+	}
+	mock.lockListTags.RLock()
+	calls = mock.calls.ListTags
+	mock.lockListTags.RUnlock()
+	return calls
+}
+
+// ListTopReferrers calls ListTopReferrersFunc.
+func (mock *ProviderMock) ListTopReferrers(ctx context.Context, owner string, repo string) ([]*github.ReferrerData, error) {
+	if mock.ListTopReferrersFunc == nil {
+		panic("ProviderMock.ListTopReferrersFunc: method is nil but Provider.ListTopReferrers was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+	}
+	mock.lockListTopReferrers.Lock()
+	mock.calls.ListTopReferrers = append(mock.calls.ListTopReferrers, callInfo)
+	mock.lockListTopReferrers.Unlock()
+	return mock.ListTopReferrersFunc(ctx, owner, repo)
+}
+
+// ListTopReferrersCalls gets all the calls that were made to ListTopReferrers.
+// Check the length with:
+//
+//	len(mockedProvider.ListTopReferrersCalls())
+func (mock *ProviderMock) ListTopReferrersCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+	}
+	mock.lockListTopReferrers.RLock()
+	calls = mock.calls.ListTopReferrers
+	mock.lockListTopReferrers.RUnlock()
+	return calls
+}
+
+// ListVariables calls ListVariablesFunc.
+func (mock *ProviderMock) ListVariables(ctx context.Context, scope github.SecretScope, opts github.ListOptions) ([]*github.VariableData, error) {
+	if mock.ListVariablesFunc == nil {
+		panic("ProviderMock.ListVariablesFunc: method is nil but Provider.ListVariables was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Scope github.SecretScope
+		Opts  github.ListOptions
+	}{
+		Ctx:   ctx,
+		Scope: scope,
+		Opts:  opts,
+	}
+	mock.lockListVariables.Lock()
+	mock.calls.ListVariables = append(mock.calls.ListVariables, callInfo)
+	mock.lockListVariables.Unlock()
+	return mock.ListVariablesFunc(ctx, scope, opts)
+}
+
+// ListVariablesCalls gets all the calls that were made to ListVariables.
+// Check the length with:
+//
+//	len(mockedProvider.ListVariablesCalls())
+func (mock *ProviderMock) ListVariablesCalls() []struct {
+	Ctx   context.Context
+	Scope github.SecretScope
+	Opts  github.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Scope github.SecretScope
+		Opts  github.ListOptions
+	}
+	mock.lockListVariables.RLock()
+	calls = mock.calls.ListVariables
+	mock.lockListVariables.RUnlock()
+	return calls
+}
+
+// ListWorkflowRuns calls ListWorkflowRunsFunc.
+func (mock *ProviderMock) ListWorkflowRuns(ctx context.Context, owner string, repo string, opts github.ListWorkflowRunsOptions) ([]*github.WorkflowRunData, error) {
+	if mock.ListWorkflowRunsFunc == nil {
+		panic("ProviderMock.ListWorkflowRunsFunc: method is nil but Provider.ListWorkflowRuns was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.ListWorkflowRunsOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
+	}
+	mock.lockListWorkflowRuns.Lock()
+	mock.calls.ListWorkflowRuns = append(mock.calls.ListWorkflowRuns, callInfo)
+	mock.lockListWorkflowRuns.Unlock()
+	return mock.ListWorkflowRunsFunc(ctx, owner, repo, opts)
+}
+
+// ListWorkflowRunsCalls gets all the calls that were made to ListWorkflowRuns.
+// Check the length with:
+//
+//	len(mockedProvider.ListWorkflowRunsCalls())
+func (mock *ProviderMock) ListWorkflowRunsCalls() []struct {
 	Ctx   context.Context
 	Owner string
 	Repo  string
-	Opts  github.CreatePullRequestOptions
+	Opts  github.ListWorkflowRunsOptions
 } {
 	var calls []struct {
 		Ctx   context.Context
 		Owner string
 		Repo  string
-		Opts  github.CreatePullRequestOptions
+		Opts  github.ListWorkflowRunsOptions
 	}
-	mock.lockCreatePullRequest.RLock()
-	calls = mock.calls.CreatePullRequest
-	mock.lockCreatePullRequest.RUnlock()
+	mock.lockListWorkflowRuns.RLock()
+	calls = mock.calls.ListWorkflowRuns
+	mock.lockListWorkflowRuns.RUnlock()
 	return calls
 }
 
-// CreateRepository calls CreateRepositoryFunc.
-func (mock *ProviderMock) CreateRepository(ctx context.Context, owner string, opts github.CreateRepositoryOptions) (*github.RepositoryData, error) {
-	if mock.CreateRepositoryFunc == nil {
-		panic("ProviderMock.CreateRepositoryFunc: method is nil but Provider.CreateRepository was just called")
+// MarkNotificationsRead calls MarkNotificationsReadFunc.
+func (mock *ProviderMock) MarkNotificationsRead(ctx context.Context, lastReadAt time.Time) error {
+	if mock.MarkNotificationsReadFunc == nil {
+		panic("ProviderMock.MarkNotificationsReadFunc: method is nil but Provider.MarkNotificationsRead was just called")
 	}
 	callInfo := struct {
-		Ctx   context.Context
-		Owner string
-		Opts  github.CreateRepositoryOptions
+		Ctx        context.Context
+		LastReadAt time.Time
 	}{
-		Ctx:   ctx,
-		Owner: owner,
-		Opts:  opts,
+		Ctx:        ctx,
+		LastReadAt: lastReadAt,
 	}
-	mock.lockCreateRepository.Lock()
-	mock.calls.CreateRepository = append(mock.calls.CreateRepository, callInfo)
-	mock.lockCreateRepository.Unlock()
-	return mock.CreateRepositoryFunc(ctx, owner, opts)
+	mock.lockMarkNotificationsRead.Lock()
+	mock.calls.MarkNotificationsRead = append(mock.calls.MarkNotificationsRead, callInfo)
+	mock.lockMarkNotificationsRead.Unlock()
+	return mock.MarkNotificationsReadFunc(ctx, lastReadAt)
 }
 
-// CreateRepositoryCalls gets all the calls that were made to CreateRepository.
+// MarkNotificationsReadCalls gets all the calls that were made to MarkNotificationsRead.
 // Check the length with:
 //
-//	len(mockedProvider.CreateRepositoryCalls())
-func (mock *ProviderMock) CreateRepositoryCalls() []struct {
-	Ctx   context.Context
-	Owner string
-	Opts  github.CreateRepositoryOptions
+//	len(mockedProvider.MarkNotificationsReadCalls())
+func (mock *ProviderMock) MarkNotificationsReadCalls() []struct {
+	Ctx        context.Context
+	LastReadAt time.Time
 } {
 	var calls []struct {
-		Ctx   context.Context
-		Owner string
-		Opts  github.CreateRepositoryOptions
+		Ctx        context.Context
+		LastReadAt time.Time
 	}
-	mock.lockCreateRepository.RLock()
-	calls = mock.calls.CreateRepository
-	mock.lockCreateRepository.RUnlock()
+	mock.lockMarkNotificationsRead.RLock()
+	calls = mock.calls.MarkNotificationsRead
+	mock.lockMarkNotificationsRead.RUnlock()
 	return calls
 }
 
-// GetIssue calls GetIssueFunc.
-func (mock *ProviderMock) GetIssue(ctx context.Context, owner string, repo string, number int) (*github.IssueData, error) {
-	if mock.GetIssueFunc == nil {
-		panic("ProviderMock.GetIssueFunc: method is nil but Provider.GetIssue was just called")
+// MarkThreadRead calls MarkThreadReadFunc.
+func (mock *ProviderMock) MarkThreadRead(ctx context.Context, threadID string) error {
+	if mock.MarkThreadReadFunc == nil {
+		panic("ProviderMock.MarkThreadReadFunc: method is nil but Provider.MarkThreadRead was just called")
 	}
 	callInfo := struct {
-		Ctx    context.Context
-		Owner  string
-		Repo   string
-		Number int
+		Ctx      context.Context
+		ThreadID string
 	}{
-		Ctx:    ctx,
-		Owner:  owner,
-		Repo:   repo,
-		Number: number,
+		Ctx:      ctx,
+		ThreadID: threadID,
 	}
-	mock.lockGetIssue.Lock()
-	mock.calls.GetIssue = append(mock.calls.GetIssue, callInfo)
-	mock.lockGetIssue.Unlock()
-	return mock.GetIssueFunc(ctx, owner, repo, number)
+	mock.lockMarkThreadRead.Lock()
+	mock.calls.MarkThreadRead = append(mock.calls.MarkThreadRead, callInfo)
+	mock.lockMarkThreadRead.Unlock()
+	return mock.MarkThreadReadFunc(ctx, threadID)
 }
 
-// GetIssueCalls gets all the calls that were made to GetIssue.
+// MarkThreadReadCalls gets all the calls that were made to MarkThreadRead.
 // Check the length with:
 //
-//	len(mockedProvider.GetIssueCalls())
-func (mock *ProviderMock) GetIssueCalls() []struct {
-	Ctx    context.Context
-	Owner  string
-	Repo   string
-	Number int
+//	len(mockedProvider.MarkThreadReadCalls())
+func (mock *ProviderMock) MarkThreadReadCalls() []struct {
+	Ctx      context.Context
+	ThreadID string
 } {
 	var calls []struct {
-		Ctx    context.Context
-		Owner  string
-		Repo   string
-		Number int
+		Ctx      context.Context
+		ThreadID string
 	}
-	mock.lockGetIssue.RLock()
-	calls = mock.calls.GetIssue
-	mock.lockGetIssue.RUnlock()
+	mock.lockMarkThreadRead.RLock()
+	calls = mock.calls.MarkThreadRead
+	mock.lockMarkThreadRead.RUnlock()
 	return calls
 }
 
-// GetPullRequest calls GetPullRequestFunc.
-func (mock *ProviderMock) GetPullRequest(ctx context.Context, owner string, repo string, number int) (*github.PullRequestData, error) {
-	if mock.GetPullRequestFunc == nil {
-		panic("ProviderMock.GetPullRequestFunc: method is nil but Provider.GetPullRequest was just called")
+// MergePullRequest calls MergePullRequestFunc.
+func (mock *ProviderMock) MergePullRequest(ctx context.Context, owner string, repo string, number int, opts github.MergePullRequestOptions) error {
+	if mock.MergePullRequestFunc == nil {
+		panic("ProviderMock.MergePullRequestFunc: method is nil but Provider.MergePullRequest was just called")
 	}
 	callInfo := struct {
 		Ctx    context.Context
 		Owner  string
 		Repo   string
 		Number int
+		Opts   github.MergePullRequestOptions
 	}{
 		Ctx:    ctx,
 		Owner:  owner,
 		Repo:   repo,
 		Number: number,
+		Opts:   opts,
 	}
-	mock.lockGetPullRequest.Lock()
-	mock.calls.GetPullRequest = append(mock.calls.GetPullRequest, callInfo)
-	mock.lockGetPullRequest.Unlock()
-	return mock.GetPullRequestFunc(ctx, owner, repo, number)
+	mock.lockMergePullRequest.Lock()
+	mock.calls.MergePullRequest = append(mock.calls.MergePullRequest, callInfo)
+	mock.lockMergePullRequest.Unlock()
+	return mock.MergePullRequestFunc(ctx, owner, repo, number, opts)
 }
 
-// GetPullRequestCalls gets all the calls that were made to GetPullRequest.
+// MergePullRequestCalls gets all the calls that were made to MergePullRequest.
 // Check the length with:
 //
-//	len(mockedProvider.GetPullRequestCalls())
-func (mock *ProviderMock) GetPullRequestCalls() []struct {
+//	len(mockedProvider.MergePullRequestCalls())
+func (mock *ProviderMock) MergePullRequestCalls() []struct {
 	Ctx    context.Context
 	Owner  string
 	Repo   string
 	Number int
+	Opts   github.MergePullRequestOptions
 } {
 	var calls []struct {
 		Ctx    context.Context
 		Owner  string
 		Repo   string
 		Number int
+		Opts   github.MergePullRequestOptions
 	}
-	mock.lockGetPullRequest.RLock()
-	calls = mock.calls.GetPullRequest
-	mock.lockGetPullRequest.RUnlock()
-	return calls
-}
-
-// GetRepository calls GetRepositoryFunc.
-func (mock *ProviderMock) GetRepository(ctx context.Context, owner string, repo string) (*github.RepositoryData, error) {
-	if mock.GetRepositoryFunc == nil {
-		panic("ProviderMock.GetRepositoryFunc: method is nil but Provider.GetRepository was just called")
-	}
-	callInfo := struct {
-		Ctx   context.Context
-		Owner string
-		Repo  string
-	}{
-		Ctx:   ctx,
-		Owner: owner,
-		Repo:  repo,
-	}
-	mock.lockGetRepository.Lock()
-	mock.calls.GetRepository = append(mock.calls.GetRepository, callInfo)
-	mock.lockGetRepository.Unlock()
-	return mock.GetRepositoryFunc(ctx, owner, repo)
-}
-
-// GetRepositoryCalls gets all the calls that were made to GetRepository.
-// Check the length with:
-//
-//	len(mockedProvider.GetRepositoryCalls())
-func (mock *ProviderMock) GetRepositoryCalls() []struct {
-	Ctx   context.Context
-	Owner string
-	Repo  string
-} {
-	var calls []struct {
-		Ctx   context.Context
-		Owner string
-		Repo  string
-	}
-	mock.lockGetRepository.RLock()
-	calls = mock.calls.GetRepository
-	mock.lockGetRepository.RUnlock()
+	mock.lockMergePullRequest.RLock()
+	calls = mock.calls.MergePullRequest
+	mock.lockMergePullRequest.RUnlock()
 	return calls
 }
 
-// GetWorkflowRun calls GetWorkflowRunFunc.
-func (mock *ProviderMock) GetWorkflowRun(ctx context.Context, owner string, repo string, runID int64) (*github.WorkflowRunData, error) {
-	if mock.GetWorkflowRunFunc == nil {
-		panic("ProviderMock.GetWorkflowRunFunc: method is nil but Provider.GetWorkflowRun was just called")
+// QueryGraphQL calls QueryGraphQLFunc.
+func (mock *ProviderMock) QueryGraphQL(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error {
+	if mock.QueryGraphQLFunc == nil {
+		panic("ProviderMock.QueryGraphQLFunc: method is nil but Provider.QueryGraphQL was just called")
 	}
 	callInfo := struct {
-		Ctx   context.Context
-		Owner string
-		Repo  string
-		RunID int64
+		Ctx    context.Context
+		Query  string
+		Vars   map[string]interface{}
+		Result interface{}
 	}{
-		Ctx:   ctx,
-		Owner: owner,
-		Repo:  repo,
-		RunID: runID,
+		Ctx:    ctx,
+		Query:  query,
+		Vars:   vars,
+		Result: result,
 	}
-	mock.lockGetWorkflowRun.Lock()
-	mock.calls.GetWorkflowRun = append(mock.calls.GetWorkflowRun, callInfo)
-	mock.lockGetWorkflowRun.Unlock()
-	return mock.GetWorkflowRunFunc(ctx, owner, repo, runID)
+	mock.lockQueryGraphQL.Lock()
+	mock.calls.QueryGraphQL = append(mock.calls.QueryGraphQL, callInfo)
+	mock.lockQueryGraphQL.Unlock()
+	return mock.QueryGraphQLFunc(ctx, query, vars, result)
 }
 
-// GetWorkflowRunCalls gets all the calls that were made to GetWorkflowRun.
+// QueryGraphQLCalls gets all the calls that were made to QueryGraphQL.
 // Check the length with:
 //
-//	len(mockedProvider.GetWorkflowRunCalls())
-func (mock *ProviderMock) GetWorkflowRunCalls() []struct {
-	Ctx   context.Context
-	Owner string
-	Repo  string
-	RunID int64
+//	len(mockedProvider.QueryGraphQLCalls())
+func (mock *ProviderMock) QueryGraphQLCalls() []struct {
+	Ctx    context.Context
+	Query  string
+	Vars   map[string]interface{}
+	Result interface{}
 } {
 	var calls []struct {
-		Ctx   context.Context
-		Owner string
-		Repo  string
-		RunID int64
+		Ctx    context.Context
+		Query  string
+		Vars   map[string]interface{}
+		Result interface{}
 	}
-	mock.lockGetWorkflowRun.RLock()
-	calls = mock.calls.GetWorkflowRun
-	mock.lockGetWorkflowRun.RUnlock()
+	mock.lockQueryGraphQL.RLock()
+	calls = mock.calls.QueryGraphQL
+	mock.lockQueryGraphQL.RUnlock()
 	return calls
 }
 
-// GetWorkflowRunJobs calls GetWorkflowRunJobsFunc.
-func (mock *ProviderMock) GetWorkflowRunJobs(ctx context.Context, owner string, repo string, runID int64) ([]*github.WorkflowJobData, error) {
-	if mock.GetWorkflowRunJobsFunc == nil {
-		panic("ProviderMock.GetWorkflowRunJobsFunc: method is nil but Provider.GetWorkflowRunJobs was just called")
+// RemoveLabel calls RemoveLabelFunc.
+func (mock *ProviderMock) RemoveLabel(ctx context.Context, owner string, repo string, number int, label string) error {
+	if mock.RemoveLabelFunc == nil {
+		panic("ProviderMock.RemoveLabelFunc: method is nil but Provider.RemoveLabel was just called")
 	}
 	callInfo := struct {
-		Ctx   context.Context
-		Owner string
-		Repo  string
-		RunID int64
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Label  string
 	}{
-		Ctx:   ctx,
-		Owner: owner,
-		Repo:  repo,
-		RunID: runID,
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+		Label:  label,
 	}
-	mock.lockGetWorkflowRunJobs.Lock()
-	mock.calls.GetWorkflowRunJobs = append(mock.calls.GetWorkflowRunJobs, callInfo)
-	mock.lockGetWorkflowRunJobs.Unlock()
-	return mock.GetWorkflowRunJobsFunc(ctx, owner, repo, runID)
+	mock.lockRemoveLabel.Lock()
+	mock.calls.RemoveLabel = append(mock.calls.RemoveLabel, callInfo)
+	mock.lockRemoveLabel.Unlock()
+	return mock.RemoveLabelFunc(ctx, owner, repo, number, label)
 }
 
-// GetWorkflowRunJobsCalls gets all the calls that were made to GetWorkflowRunJobs.
+// RemoveLabelCalls gets all the calls that were made to RemoveLabel.
 // Check the length with:
 //
-//	len(mockedProvider.GetWorkflowRunJobsCalls())
-func (mock *ProviderMock) GetWorkflowRunJobsCalls() []struct {
-	Ctx   context.Context
-	Owner string
-	Repo  string
-	RunID int64
+//	len(mockedProvider.RemoveLabelCalls())
+func (mock *ProviderMock) RemoveLabelCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+	Label  string
 } {
 	var calls []struct {
-		Ctx   context.Context
-		Owner string
-		Repo  string
-		RunID int64
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Label  string
 	}
-	mock.lockGetWorkflowRunJobs.RLock()
-	calls = mock.calls.GetWorkflowRunJobs
-	mock.lockGetWorkflowRunJobs.RUnlock()
+	mock.lockRemoveLabel.RLock()
+	calls = mock.calls.RemoveLabel
+	mock.lockRemoveLabel.RUnlock()
 	return calls
 }
 
-// ListIssues calls ListIssuesFunc.
-func (mock *ProviderMock) ListIssues(ctx context.Context, owner string, repo string, opts github.ListIssuesOptions) ([]*github.IssueData, error) {
-	if mock.ListIssuesFunc == nil {
-		panic("ProviderMock.ListIssuesFunc: method is nil but Provider.ListIssues was just called")
+// RequestReviewers calls RequestReviewersFunc.
+func (mock *ProviderMock) RequestReviewers(ctx context.Context, owner string, repo string, number int, opts github.RequestReviewersOptions) error {
+	if mock.RequestReviewersFunc == nil {
+		panic("ProviderMock.RequestReviewersFunc: method is nil but Provider.RequestReviewers was just called")
 	}
 	callInfo := struct {
-		Ctx   context.Context
-		Owner string
-		Repo  string
-		Opts  github.ListIssuesOptions
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Opts   github.RequestReviewersOptions
 	}{
-		Ctx:   ctx,
-		Owner: owner,
-		Repo:  repo,
-		Opts:  opts,
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+		Opts:   opts,
 	}
-	mock.lockListIssues.Lock()
-	mock.calls.ListIssues = append(mock.calls.ListIssues, callInfo)
-	mock.lockListIssues.Unlock()
-	return mock.ListIssuesFunc(ctx, owner, repo, opts)
+	mock.lockRequestReviewers.Lock()
+	mock.calls.RequestReviewers = append(mock.calls.RequestReviewers, callInfo)
+	mock.lockRequestReviewers.Unlock()
+	return mock.RequestReviewersFunc(ctx, owner, repo, number, opts)
 }
 
-// ListIssuesCalls gets all the calls that were made to ListIssues.
+// RequestReviewersCalls gets all the calls that were made to RequestReviewers.
 // Check the length with:
 //
-//	len(mockedProvider.ListIssuesCalls())
-func (mock *ProviderMock) ListIssuesCalls() []struct {
-	Ctx   context.Context
-	Owner string
-	Repo  string
-	Opts  github.ListIssuesOptions
+//	len(mockedProvider.RequestReviewersCalls())
+func (mock *ProviderMock) RequestReviewersCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+	Opts   github.RequestReviewersOptions
 } {
 	var calls []struct {
-		Ctx   context.Context
-		Owner string
-		Repo  string
-		Opts  github.ListIssuesOptions
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Opts   github.RequestReviewersOptions
 	}
-	mock.lockListIssues.RLock()
-	calls = mock.calls.ListIssues
-	mock.lockListIssues.RUnlock()
+	mock.lockRequestReviewers.RLock()
+	calls = mock.calls.RequestReviewers
+	mock.lockRequestReviewers.RUnlock()
 	return calls
 }
 
-// ListPullRequests calls ListPullRequestsFunc.
-func (mock *ProviderMock) ListPullRequests(ctx context.Context, owner string, repo string, opts github.ListPullRequestsOptions) ([]*github.PullRequestData, error) {
-	if mock.ListPullRequestsFunc == nil {
-		panic("ProviderMock.ListPullRequestsFunc: method is nil but Provider.ListPullRequests was just called")
+// SetSecret calls SetSecretFunc.
+func (mock *ProviderMock) SetSecret(ctx context.Context, scope github.SecretScope, opts github.SetSecretOptions) error {
+	if mock.SetSecretFunc == nil {
+		panic("ProviderMock.SetSecretFunc: method is nil but Provider.SetSecret was just called")
 	}
 	callInfo := struct {
 		Ctx   context.Context
-		Owner string
-		Repo  string
-		Opts  github.ListPullRequestsOptions
+		Scope github.SecretScope
+		Opts  github.SetSecretOptions
 	}{
 		Ctx:   ctx,
-		Owner: owner,
-		Repo:  repo,
+		Scope: scope,
 		Opts:  opts,
 	}
-	mock.lockListPullRequests.Lock()
-	mock.calls.ListPullRequests = append(mock.calls.ListPullRequests, callInfo)
-	mock.lockListPullRequests.Unlock()
-	return mock.ListPullRequestsFunc(ctx, owner, repo, opts)
+	mock.lockSetSecret.Lock()
+	mock.calls.SetSecret = append(mock.calls.SetSecret, callInfo)
+	mock.lockSetSecret.Unlock()
+	return mock.SetSecretFunc(ctx, scope, opts)
 }
 
-// ListPullRequestsCalls gets all the calls that were made to ListPullRequests.
+// SetSecretCalls gets all the calls that were made to SetSecret.
 // Check the length with:
 //
-//	len(mockedProvider.ListPullRequestsCalls())
-func (mock *ProviderMock) ListPullRequestsCalls() []struct {
+//	len(mockedProvider.SetSecretCalls())
+func (mock *ProviderMock) SetSecretCalls() []struct {
 	Ctx   context.Context
-	Owner string
-	Repo  string
-	Opts  github.ListPullRequestsOptions
+	Scope github.SecretScope
+	Opts  github.SetSecretOptions
 } {
 	var calls []struct {
 		Ctx   context.Context
-		Owner string
-		Repo  string
-		Opts  github.ListPullRequestsOptions
+		Scope github.SecretScope
+		Opts  github.SetSecretOptions
 	}
-	mock.lockListPullRequests.RLock()
-	calls = mock.calls.ListPullRequests
-	mock.lockListPullRequests.RUnlock()
+	mock.lockSetSecret.RLock()
+	calls = mock.calls.SetSecret
+	mock.lockSetSecret.RUnlock()
 	return calls
 }
 
-// ListRepositories calls ListRepositoriesFunc.
-func (mock *ProviderMock) ListRepositories(ctx context.Context, owner string, opts github.ListOptions) ([]*github.RepositoryData, error) {
-	if mock.ListRepositoriesFunc == nil {
-		panic("ProviderMock.ListRepositoriesFunc: method is nil but Provider.ListRepositories was just called")
+// SetThreadSubscription calls SetThreadSubscriptionFunc.
+func (mock *ProviderMock) SetThreadSubscription(ctx context.Context, threadID string, ignored bool) (*github.ThreadSubscriptionData, error) {
+	if mock.SetThreadSubscriptionFunc == nil {
+		panic("ProviderMock.SetThreadSubscriptionFunc: method is nil but Provider.SetThreadSubscription was just called")
 	}
 	callInfo := struct {
-		Ctx   context.Context
-		Owner string
-		Opts  github.ListOptions
+		Ctx      context.Context
+		ThreadID string
+		Ignored  bool
 	}{
-		Ctx:   ctx,
-		Owner: owner,
-		Opts:  opts,
+		Ctx:      ctx,
+		ThreadID: threadID,
+		Ignored:  ignored,
 	}
-	mock.lockListRepositories.Lock()
-	mock.calls.ListRepositories = append(mock.calls.ListRepositories, callInfo)
-	mock.lockListRepositories.Unlock()
-	return mock.ListRepositoriesFunc(ctx, owner, opts)
+	mock.lockSetThreadSubscription.Lock()
+	mock.calls.SetThreadSubscription = append(mock.calls.SetThreadSubscription, callInfo)
+	mock.lockSetThreadSubscription.Unlock()
+	return mock.SetThreadSubscriptionFunc(ctx, threadID, ignored)
 }
 
-// ListRepositoriesCalls gets all the calls that were made to ListRepositories.
+// SetThreadSubscriptionCalls gets all the calls that were made to SetThreadSubscription.
 // Check the length with:
 //
-//	len(mockedProvider.ListRepositoriesCalls())
-func (mock *ProviderMock) ListRepositoriesCalls() []struct {
-	Ctx   context.Context
-	Owner string
-	Opts  github.ListOptions
+//	len(mockedProvider.SetThreadSubscriptionCalls())
+func (mock *ProviderMock) SetThreadSubscriptionCalls() []struct {
+	Ctx      context.Context
+	ThreadID string
+	Ignored  bool
 } {
 	var calls []struct {
-		Ctx   context.Context
-		Owner string
-		Opts  github.ListOptions
+		Ctx      context.Context
+		ThreadID string
+		Ignored  bool
 	}
-	mock.lockListRepositories.RLock()
-	calls = mock.calls.ListRepositories
-	mock.lockListRepositories.RUnlock()
+	mock.lockSetThreadSubscription.RLock()
+	calls = mock.calls.SetThreadSubscription
+	mock.lockSetThreadSubscription.RUnlock()
 	return calls
 }
 
-// ListWorkflowRuns calls ListWorkflowRunsFunc.
-func (mock *ProviderMock) ListWorkflowRuns(ctx context.Context, owner string, repo string, opts github.ListWorkflowRunsOptions) ([]*github.WorkflowRunData, error) {
-	if mock.ListWorkflowRunsFunc == nil {
-		panic("ProviderMock.ListWorkflowRunsFunc: method is nil but Provider.ListWorkflowRuns was just called")
+// SetVariable calls SetVariableFunc.
+func (mock *ProviderMock) SetVariable(ctx context.Context, scope github.SecretScope, opts github.SetVariableOptions) error {
+	if mock.SetVariableFunc == nil {
+		panic("ProviderMock.SetVariableFunc: method is nil but Provider.SetVariable was just called")
 	}
 	callInfo := struct {
 		Ctx   context.Context
-		Owner string
-		Repo  string
-		Opts  github.ListWorkflowRunsOptions
+		Scope github.SecretScope
+		Opts  github.SetVariableOptions
 	}{
 		Ctx:   ctx,
-		Owner: owner,
-		Repo:  repo,
+		Scope: scope,
 		Opts:  opts,
 	}
-	mock.lockListWorkflowRuns.Lock()
-	mock.calls.ListWorkflowRuns = append(mock.calls.ListWorkflowRuns, callInfo)
-	mock.lockListWorkflowRuns.Unlock()
-	return mock.ListWorkflowRunsFunc(ctx, owner, repo, opts)
+	mock.lockSetVariable.Lock()
+	mock.calls.SetVariable = append(mock.calls.SetVariable, callInfo)
+	mock.lockSetVariable.Unlock()
+	return mock.SetVariableFunc(ctx, scope, opts)
 }
 
-// ListWorkflowRunsCalls gets all the calls that were made to ListWorkflowRuns.
+// SetVariableCalls gets all the calls that were made to SetVariable.
 // Check the length with:
 //
-//	len(mockedProvider.ListWorkflowRunsCalls())
-func (mock *ProviderMock) ListWorkflowRunsCalls() []struct {
+//	len(mockedProvider.SetVariableCalls())
+func (mock *ProviderMock) SetVariableCalls() []struct {
 	Ctx   context.Context
-	Owner string
-	Repo  string
-	Opts  github.ListWorkflowRunsOptions
+	Scope github.SecretScope
+	Opts  github.SetVariableOptions
 } {
 	var calls []struct {
 		Ctx   context.Context
-		Owner string
-		Repo  string
-		Opts  github.ListWorkflowRunsOptions
+		Scope github.SecretScope
+		Opts  github.SetVariableOptions
 	}
-	mock.lockListWorkflowRuns.RLock()
-	calls = mock.calls.ListWorkflowRuns
-	mock.lockListWorkflowRuns.RUnlock()
+	mock.lockSetVariable.RLock()
+	calls = mock.calls.SetVariable
+	mock.lockSetVariable.RUnlock()
 	return calls
 }
 
-// MergePullRequest calls MergePullRequestFunc.
-func (mock *ProviderMock) MergePullRequest(ctx context.Context, owner string, repo string, number int, opts github.MergePullRequestOptions) error {
-	if mock.MergePullRequestFunc == nil {
-		panic("ProviderMock.MergePullRequestFunc: method is nil but Provider.MergePullRequest was just called")
+// SubmitReview calls SubmitReviewFunc.
+func (mock *ProviderMock) SubmitReview(ctx context.Context, owner string, repo string, number int, opts github.SubmitReviewOptions) (*github.ReviewData, error) {
+	if mock.SubmitReviewFunc == nil {
+		panic("ProviderMock.SubmitReviewFunc: method is nil but Provider.SubmitReview was just called")
 	}
 	callInfo := struct {
 		Ctx    context.Context
 		Owner  string
 		Repo   string
 		Number int
-		Opts   github.MergePullRequestOptions
+		Opts   github.SubmitReviewOptions
 	}{
 		Ctx:    ctx,
 		Owner:  owner,
@@ -1007,81 +4861,77 @@ func (mock *ProviderMock) MergePullRequest(ctx context.Context, owner string, re
 		Number: number,
 		Opts:   opts,
 	}
-	mock.lockMergePullRequest.Lock()
-	mock.calls.MergePullRequest = append(mock.calls.MergePullRequest, callInfo)
-	mock.lockMergePullRequest.Unlock()
-	return mock.MergePullRequestFunc(ctx, owner, repo, number, opts)
+	mock.lockSubmitReview.Lock()
+	mock.calls.SubmitReview = append(mock.calls.SubmitReview, callInfo)
+	mock.lockSubmitReview.Unlock()
+	return mock.SubmitReviewFunc(ctx, owner, repo, number, opts)
 }
 
-// MergePullRequestCalls gets all the calls that were made to MergePullRequest.
+// SubmitReviewCalls gets all the calls that were made to SubmitReview.
 // Check the length with:
 //
-//	len(mockedProvider.MergePullRequestCalls())
-func (mock *ProviderMock) MergePullRequestCalls() []struct {
+//	len(mockedProvider.SubmitReviewCalls())
+func (mock *ProviderMock) SubmitReviewCalls() []struct {
 	Ctx    context.Context
 	Owner  string
 	Repo   string
 	Number int
-	Opts   github.MergePullRequestOptions
+	Opts   github.SubmitReviewOptions
 } {
 	var calls []struct {
 		Ctx    context.Context
 		Owner  string
 		Repo   string
 		Number int
-		Opts   github.MergePullRequestOptions
+		Opts   github.SubmitReviewOptions
 	}
-	mock.lockMergePullRequest.RLock()
-	calls = mock.calls.MergePullRequest
-	mock.lockMergePullRequest.RUnlock()
+	mock.lockSubmitReview.RLock()
+	calls = mock.calls.SubmitReview
+	mock.lockSubmitReview.RUnlock()
 	return calls
 }
 
-// RemoveLabel calls RemoveLabelFunc.
-func (mock *ProviderMock) RemoveLabel(ctx context.Context, owner string, repo string, number int, label string) error {
-	if mock.RemoveLabelFunc == nil {
-		panic("ProviderMock.RemoveLabelFunc: method is nil but Provider.RemoveLabel was just called")
+// TransferRepository calls TransferRepositoryFunc.
+func (mock *ProviderMock) TransferRepository(ctx context.Context, owner string, repo string, opts github.TransferRepositoryOptions) (*github.RepositoryData, error) {
+	if mock.TransferRepositoryFunc == nil {
+		panic("ProviderMock.TransferRepositoryFunc: method is nil but Provider.TransferRepository was just called")
 	}
 	callInfo := struct {
-		Ctx    context.Context
-		Owner  string
-		Repo   string
-		Number int
-		Label  string
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.TransferRepositoryOptions
 	}{
-		Ctx:    ctx,
-		Owner:  owner,
-		Repo:   repo,
-		Number: number,
-		Label:  label,
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Opts:  opts,
 	}
-	mock.lockRemoveLabel.Lock()
-	mock.calls.RemoveLabel = append(mock.calls.RemoveLabel, callInfo)
-	mock.lockRemoveLabel.Unlock()
-	return mock.RemoveLabelFunc(ctx, owner, repo, number, label)
+	mock.lockTransferRepository.Lock()
+	mock.calls.TransferRepository = append(mock.calls.TransferRepository, callInfo)
+	mock.lockTransferRepository.Unlock()
+	return mock.TransferRepositoryFunc(ctx, owner, repo, opts)
 }
 
-// RemoveLabelCalls gets all the calls that were made to RemoveLabel.
+// TransferRepositoryCalls gets all the calls that were made to TransferRepository.
 // Check the length with:
 //
-//	len(mockedProvider.RemoveLabelCalls())
-func (mock *ProviderMock) RemoveLabelCalls() []struct {
-	Ctx    context.Context
-	Owner  string
-	Repo   string
-	Number int
-	Label  string
+//	len(mockedProvider.TransferRepositoryCalls())
+func (mock *ProviderMock) TransferRepositoryCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Opts  github.TransferRepositoryOptions
 } {
 	var calls []struct {
-		Ctx    context.Context
-		Owner  string
-		Repo   string
-		Number int
-		Label  string
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Opts  github.TransferRepositoryOptions
 	}
-	mock.lockRemoveLabel.RLock()
-	calls = mock.calls.RemoveLabel
-	mock.lockRemoveLabel.RUnlock()
+	mock.lockTransferRepository.RLock()
+	calls = mock.calls.TransferRepository
+	mock.lockTransferRepository.RUnlock()
 	return calls
 }
 
@@ -1137,6 +4987,94 @@ func (mock *ProviderMock) TriggerWorkflowCalls() []struct {
 	return calls
 }
 
+// UpdateComment calls UpdateCommentFunc.
+func (mock *ProviderMock) UpdateComment(ctx context.Context, owner string, repo string, commentID int64, body string) (*github.CommentData, error) {
+	if mock.UpdateCommentFunc == nil {
+		panic("ProviderMock.UpdateCommentFunc: method is nil but Provider.UpdateComment was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Owner     string
+		Repo      string
+		CommentID int64
+		Body      string
+	}{
+		Ctx:       ctx,
+		Owner:     owner,
+		Repo:      repo,
+		CommentID: commentID,
+		Body:      body,
+	}
+	mock.lockUpdateComment.Lock()
+	mock.calls.UpdateComment = append(mock.calls.UpdateComment, callInfo)
+	mock.lockUpdateComment.Unlock()
+	return mock.UpdateCommentFunc(ctx, owner, repo, commentID, body)
+}
+
+// UpdateCommentCalls gets all the calls that were made to UpdateComment.
+// Check the length with:
+//
+//	len(mockedProvider.UpdateCommentCalls())
+func (mock *ProviderMock) UpdateCommentCalls() []struct {
+	Ctx       context.Context
+	Owner     string
+	Repo      string
+	CommentID int64
+	Body      string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Owner     string
+		Repo      string
+		CommentID int64
+		Body      string
+	}
+	mock.lockUpdateComment.RLock()
+	calls = mock.calls.UpdateComment
+	mock.lockUpdateComment.RUnlock()
+	return calls
+}
+
+// UpdateGist calls UpdateGistFunc.
+func (mock *ProviderMock) UpdateGist(ctx context.Context, id string, opts github.UpdateGistOptions) (*github.GistData, error) {
+	if mock.UpdateGistFunc == nil {
+		panic("ProviderMock.UpdateGistFunc: method is nil but Provider.UpdateGist was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Id   string
+		Opts github.UpdateGistOptions
+	}{
+		Ctx:  ctx,
+		Id:   id,
+		Opts: opts,
+	}
+	mock.lockUpdateGist.Lock()
+	mock.calls.UpdateGist = append(mock.calls.UpdateGist, callInfo)
+	mock.lockUpdateGist.Unlock()
+	return mock.UpdateGistFunc(ctx, id, opts)
+}
+
+// UpdateGistCalls gets all the calls that were made to UpdateGist.
+// Check the length with:
+//
+//	len(mockedProvider.UpdateGistCalls())
+func (mock *ProviderMock) UpdateGistCalls() []struct {
+	Ctx  context.Context
+	Id   string
+	Opts github.UpdateGistOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Id   string
+		Opts github.UpdateGistOptions
+	}
+	mock.lockUpdateGist.RLock()
+	calls = mock.calls.UpdateGist
+	mock.lockUpdateGist.RUnlock()
+	return calls
+}
+
 // UpdateIssue calls UpdateIssueFunc.
 func (mock *ProviderMock) UpdateIssue(ctx context.Context, owner string, repo string, number int, opts github.UpdateIssueOptions) (*github.IssueData, error) {
 	if mock.UpdateIssueFunc == nil {
@@ -1185,6 +5123,102 @@ func (mock *ProviderMock) UpdateIssueCalls() []struct {
 	return calls
 }
 
+// UpdateLabel calls UpdateLabelFunc.
+func (mock *ProviderMock) UpdateLabel(ctx context.Context, owner string, repo string, name string, opts github.UpdateLabelOptions) (*github.LabelData, error) {
+	if mock.UpdateLabelFunc == nil {
+		panic("ProviderMock.UpdateLabelFunc: method is nil but Provider.UpdateLabel was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+		Opts  github.UpdateLabelOptions
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Repo:  repo,
+		Name:  name,
+		Opts:  opts,
+	}
+	mock.lockUpdateLabel.Lock()
+	mock.calls.UpdateLabel = append(mock.calls.UpdateLabel, callInfo)
+	mock.lockUpdateLabel.Unlock()
+	return mock.UpdateLabelFunc(ctx, owner, repo, name, opts)
+}
+
+// UpdateLabelCalls gets all the calls that were made to UpdateLabel.
+// Check the length with:
+//
+//	len(mockedProvider.UpdateLabelCalls())
+func (mock *ProviderMock) UpdateLabelCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Repo  string
+	Name  string
+	Opts  github.UpdateLabelOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Repo  string
+		Name  string
+		Opts  github.UpdateLabelOptions
+	}
+	mock.lockUpdateLabel.RLock()
+	calls = mock.calls.UpdateLabel
+	mock.lockUpdateLabel.RUnlock()
+	return calls
+}
+
+// UpdateMilestone calls UpdateMilestoneFunc.
+func (mock *ProviderMock) UpdateMilestone(ctx context.Context, owner string, repo string, number int, opts github.UpdateMilestoneOptions) (*github.MilestoneData, error) {
+	if mock.UpdateMilestoneFunc == nil {
+		panic("ProviderMock.UpdateMilestoneFunc: method is nil but Provider.UpdateMilestone was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Opts   github.UpdateMilestoneOptions
+	}{
+		Ctx:    ctx,
+		Owner:  owner,
+		Repo:   repo,
+		Number: number,
+		Opts:   opts,
+	}
+	mock.lockUpdateMilestone.Lock()
+	mock.calls.UpdateMilestone = append(mock.calls.UpdateMilestone, callInfo)
+	mock.lockUpdateMilestone.Unlock()
+	return mock.UpdateMilestoneFunc(ctx, owner, repo, number, opts)
+}
+
+// UpdateMilestoneCalls gets all the calls that were made to UpdateMilestone.
+// Check the length with:
+//
+//	len(mockedProvider.UpdateMilestoneCalls())
+func (mock *ProviderMock) UpdateMilestoneCalls() []struct {
+	Ctx    context.Context
+	Owner  string
+	Repo   string
+	Number int
+	Opts   github.UpdateMilestoneOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Owner  string
+		Repo   string
+		Number int
+		Opts   github.UpdateMilestoneOptions
+	}
+	mock.lockUpdateMilestone.RLock()
+	calls = mock.calls.UpdateMilestone
+	mock.lockUpdateMilestone.RUnlock()
+	return calls
+}
+
 // UpdatePullRequest calls UpdatePullRequestFunc.
 func (mock *ProviderMock) UpdatePullRequest(ctx context.Context, owner string, repo string, number int, opts github.UpdatePullRequestOptions) (*github.PullRequestData, error) {
 	if mock.UpdatePullRequestFunc == nil {
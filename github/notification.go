@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// notificationPageSize is the largest page size the notifications API accepts.
+const notificationPageSize = 50
+
+// Notifications manages the notification threads of the authenticated user.
+//
+// Notifications instances are obtained from a Client:
+//
+//	notifications := client.Notifications()
+//	threads, err := notifications.List(ctx, github.WithParticipating())
+//	for _, thread := range threads {
+//	    fmt.Println(thread.Repository, thread.SubjectTitle)
+//	    err = notifications.MarkRead(ctx, thread.ID)
+//	}
+type Notifications struct {
+	client *Client
+}
+
+// Notifications returns the notifications of the authenticated user.
+func (c *Client) Notifications() *Notifications {
+	return &Notifications{client: c}
+}
+
+// List lists notification threads, most recently updated first. Only unread
+// notifications are returned unless WithAllNotifications is given. All pages
+// are fetched.
+//
+// Example:
+//
+//	threads, err := notifications.List(ctx,
+//	    github.WithNotificationRepository("myorg", "myrepo"),
+//	    github.WithNotificationsSince(lastPoll),
+//	)
+func (n *Notifications) List(ctx context.Context, opts ...NotificationFilterOption) ([]*NotificationData, error) {
+	listOpts := ListNotificationsOptions{}
+	for _, opt := range opts {
+		opt(&listOpts)
+	}
+	if (listOpts.Owner == "") != (listOpts.Repo == "") {
+		return nil, errors.New(errors.CodeInvalidInput, "notification repository filter requires an owner and repository")
+	}
+
+	var all []*NotificationData
+	listOpts.PerPage = notificationPageSize
+	for page := 1; ; page++ {
+		listOpts.Page = page
+		threads, err := n.client.provider.ListNotifications(ctx, listOpts)
+		if err != nil {
+			return nil, WrapHTTPError(err, 0, "failed to list notifications")
+		}
+		all = append(all, threads...)
+		if len(threads) < notificationPageSize {
+			return all, nil
+		}
+	}
+}
+
+// MarkRead marks a notification thread as read.
+func (n *Notifications) MarkRead(ctx context.Context, threadID string) error {
+	if err := n.client.provider.MarkThreadRead(ctx, threadID); err != nil {
+		return WrapHTTPError(err, 0, "failed to mark notification as read")
+	}
+	return nil
+}
+
+// MarkAllRead marks all notifications updated at or before lastReadAt as
+// read. Passing the time the notifications were listed avoids marking
+// threads that arrived afterwards.
+func (n *Notifications) MarkAllRead(ctx context.Context, lastReadAt time.Time) error {
+	if err := n.client.provider.MarkNotificationsRead(ctx, lastReadAt); err != nil {
+		return WrapHTTPError(err, 0, "failed to mark notifications as read")
+	}
+	return nil
+}
+
+// Subscribe subscribes to a notification thread so that all future activity
+// on it is delivered.
+func (n *Notifications) Subscribe(ctx context.Context, threadID string) (*ThreadSubscriptionData, error) {
+	subscription, err := n.client.provider.SetThreadSubscription(ctx, threadID, false)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to subscribe to notification thread")
+	}
+	return subscription, nil
+}
+
+// Ignore mutes a notification thread until the user is mentioned or
+// otherwise directly involved.
+func (n *Notifications) Ignore(ctx context.Context, threadID string) (*ThreadSubscriptionData, error) {
+	subscription, err := n.client.provider.SetThreadSubscription(ctx, threadID, true)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to ignore notification thread")
+	}
+	return subscription, nil
+}
+
+// Unsubscribe removes the subscription to a notification thread.
+func (n *Notifications) Unsubscribe(ctx context.Context, threadID string) error {
+	if err := n.client.provider.DeleteThreadSubscription(ctx, threadID); err != nil {
+		return WrapHTTPError(err, 0, "failed to unsubscribe from notification thread")
+	}
+	return nil
+}
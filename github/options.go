@@ -1,5 +1,7 @@
 package github
 
+import "time"
+
 // This file contains option types and helper functions for the options pattern
 // used throughout the library. Options provide a flexible way to configure
 // operations without requiring large parameter lists.
@@ -158,6 +160,47 @@ func WithCommitMessage(message string) MergeOption {
 	}
 }
 
+// ReviewOption configures pull request review submission.
+type ReviewOption func(*SubmitReviewOptions)
+
+// WithReviewBody sets the body for a review.
+func WithReviewBody(body string) ReviewOption {
+	return func(opts *SubmitReviewOptions) {
+		opts.Body = body
+	}
+}
+
+// WithReviewCommitID sets the commit SHA a review applies to.
+func WithReviewCommitID(sha string) ReviewOption {
+	return func(opts *SubmitReviewOptions) {
+		opts.CommitID = sha
+	}
+}
+
+// ReviewCommentOption configures pull request review comment creation.
+type ReviewCommentOption func(*CreateReviewCommentOptions)
+
+// WithCommentStartLine makes a review comment span from startLine to its line.
+func WithCommentStartLine(startLine int) ReviewCommentOption {
+	return func(opts *CreateReviewCommentOptions) {
+		opts.StartLine = startLine
+	}
+}
+
+// WithCommentSide sets the diff side for a review comment ("LEFT" or "RIGHT").
+func WithCommentSide(side string) ReviewCommentOption {
+	return func(opts *CreateReviewCommentOptions) {
+		opts.Side = side
+	}
+}
+
+// WithCommentCommitID sets the commit SHA a review comment is anchored to.
+func WithCommentCommitID(sha string) ReviewCommentOption {
+	return func(opts *CreateReviewCommentOptions) {
+		opts.CommitID = sha
+	}
+}
+
 // WorkflowFilterOption configures workflow run filtering.
 type WorkflowFilterOption func(*ListWorkflowRunsOptions)
 
@@ -181,3 +224,177 @@ func WithStatus(status string) WorkflowFilterOption {
 		opts.Status = status
 	}
 }
+
+// CommitFilterOption configures commit filtering.
+type CommitFilterOption func(*ListCommitsOptions)
+
+// WithCommitRef lists commits reachable from the given branch, tag, or SHA.
+func WithCommitRef(ref string) CommitFilterOption {
+	return func(opts *ListCommitsOptions) {
+		opts.SHA = ref
+	}
+}
+
+// WithCommitPath filters commits to those touching the given path.
+func WithCommitPath(path string) CommitFilterOption {
+	return func(opts *ListCommitsOptions) {
+		opts.Path = path
+	}
+}
+
+// WithCommitAuthor filters commits by GitHub login or email address.
+func WithCommitAuthor(author string) CommitFilterOption {
+	return func(opts *ListCommitsOptions) {
+		opts.Author = author
+	}
+}
+
+// WithCommitsSince filters commits to those made after t.
+func WithCommitsSince(t time.Time) CommitFilterOption {
+	return func(opts *ListCommitsOptions) {
+		opts.Since = &t
+	}
+}
+
+// WithCommitsUntil filters commits to those made before t.
+func WithCommitsUntil(t time.Time) CommitFilterOption {
+	return func(opts *ListCommitsOptions) {
+		opts.Until = &t
+	}
+}
+
+// WithCommitLimit sets the maximum number of commits to return (at most 100).
+func WithCommitLimit(limit int) CommitFilterOption {
+	return func(opts *ListCommitsOptions) {
+		opts.PerPage = limit
+	}
+}
+
+// NotificationFilterOption configures notification filtering.
+type NotificationFilterOption func(*ListNotificationsOptions)
+
+// WithAllNotifications includes notifications already marked as read.
+func WithAllNotifications() NotificationFilterOption {
+	return func(opts *ListNotificationsOptions) {
+		opts.All = true
+	}
+}
+
+// WithParticipating limits notifications to threads the user is directly
+// involved in, such as mentions, reviews, and assignments.
+func WithParticipating() NotificationFilterOption {
+	return func(opts *ListNotificationsOptions) {
+		opts.Participating = true
+	}
+}
+
+// WithNotificationsSince filters notifications to those updated after t.
+func WithNotificationsSince(t time.Time) NotificationFilterOption {
+	return func(opts *ListNotificationsOptions) {
+		opts.Since = &t
+	}
+}
+
+// WithNotificationsBefore filters notifications to those updated before t.
+func WithNotificationsBefore(t time.Time) NotificationFilterOption {
+	return func(opts *ListNotificationsOptions) {
+		opts.Before = &t
+	}
+}
+
+// WithNotificationRepository limits notifications to a single repository.
+func WithNotificationRepository(owner, repo string) NotificationFilterOption {
+	return func(opts *ListNotificationsOptions) {
+		opts.Owner = owner
+		opts.Repo = repo
+	}
+}
+
+// TemplateOption configures repository creation from a template.
+type TemplateOption func(*CreateRepositoryFromTemplateOptions)
+
+// WithTemplateOwner sets the owner of the new repository.
+func WithTemplateOwner(owner string) TemplateOption {
+	return func(opts *CreateRepositoryFromTemplateOptions) {
+		opts.Owner = owner
+	}
+}
+
+// WithTemplateDescription sets the description of the new repository.
+func WithTemplateDescription(description string) TemplateOption {
+	return func(opts *CreateRepositoryFromTemplateOptions) {
+		opts.Description = description
+	}
+}
+
+// WithTemplatePrivate makes the new repository private.
+func WithTemplatePrivate() TemplateOption {
+	return func(opts *CreateRepositoryFromTemplateOptions) {
+		opts.Private = true
+	}
+}
+
+// WithAllBranches copies all branches from the template.
+func WithAllBranches() TemplateOption {
+	return func(opts *CreateRepositoryFromTemplateOptions) {
+		opts.IncludeAllBranches = true
+	}
+}
+
+// ForkOption configures repository forking.
+type ForkOption func(*ForkRepositoryOptions)
+
+// WithForkOrganization forks into an organization instead of the authenticated user.
+func WithForkOrganization(org string) ForkOption {
+	return func(opts *ForkRepositoryOptions) {
+		opts.Organization = org
+	}
+}
+
+// WithForkName sets the name of the fork.
+func WithForkName(name string) ForkOption {
+	return func(opts *ForkRepositoryOptions) {
+		opts.Name = name
+	}
+}
+
+// WithDefaultBranchOnly forks only the default branch.
+func WithDefaultBranchOnly() ForkOption {
+	return func(opts *ForkRepositoryOptions) {
+		opts.DefaultBranchOnly = true
+	}
+}
+
+// TransferOption configures repository transfers.
+type TransferOption func(*TransferRepositoryOptions)
+
+// WithTransferName renames the repository as part of the transfer.
+func WithTransferName(name string) TransferOption {
+	return func(opts *TransferRepositoryOptions) {
+		opts.NewName = name
+	}
+}
+
+// WithTransferTeams grants teams in the new organization access to the repository.
+func WithTransferTeams(teamIDs ...int64) TransferOption {
+	return func(opts *TransferRepositoryOptions) {
+		opts.TeamIDs = teamIDs
+	}
+}
+
+// SecretOption configures the scope of secret and variable operations.
+type SecretOption func(*SecretScope)
+
+// WithEnvironment scopes a secret or variable to a deployment environment.
+func WithEnvironment(name string) SecretOption {
+	return func(scope *SecretScope) {
+		scope.Environment = name
+	}
+}
+
+// WithDependabot targets the Dependabot secret store instead of GitHub Actions.
+func WithDependabot() SecretOption {
+	return func(scope *SecretScope) {
+		scope.App = SecretAppDependabot
+	}
+}
@@ -0,0 +1,437 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// Project represents a GitHub Projects v2 project owned by a user or
+// organization.
+//
+// Projects are only reachable through the GraphQL API, so all operations are
+// performed with Provider.QueryGraphQL.
+//
+// Example:
+//
+//	project, err := client.Project(ctx, 7)
+//	itemID, err := project.AddIssue(ctx, issue)
+//	err = project.SetFieldValue(ctx, itemID, "Status", "In Progress")
+//	err = project.SetFieldValue(ctx, itemID, "Sprint", "Sprint 12")
+type Project struct {
+	client *Client
+	owner  string
+	data   *ProjectData
+}
+
+// projectQuery fetches a project and its fields. ProjectV2Owner covers both
+// users and organizations.
+const projectQuery = `query($owner: String!, $number: Int!) {
+  repositoryOwner(login: $owner) {
+    ... on ProjectV2Owner {
+      projectV2(number: $number) {
+        id
+        number
+        title
+        url
+        closed
+        fields(first: 100) {
+          nodes {
+            ... on ProjectV2FieldCommon {
+              id
+              name
+              dataType
+            }
+            ... on ProjectV2SingleSelectField {
+              options { id name }
+            }
+            ... on ProjectV2IterationField {
+              configuration {
+                iterations { id title startDate duration }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// projectContentQuery resolves the node ID of an issue or pull request.
+const projectContentQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issueOrPullRequest(number: $number) {
+      ... on Issue { id }
+      ... on PullRequest { id }
+    }
+  }
+}`
+
+const addProjectItemMutation = `mutation($project: ID!, $content: ID!) {
+  addProjectV2ItemById(input: {projectId: $project, contentId: $content}) {
+    item { id }
+  }
+}`
+
+const deleteProjectItemMutation = `mutation($project: ID!, $item: ID!) {
+  deleteProjectV2Item(input: {projectId: $project, itemId: $item}) {
+    deletedItemId
+  }
+}`
+
+// updateProjectFieldMutation sets a field value. It is formatted with the
+// GraphQL type of the value and its ProjectV2FieldValue key so that only
+// scalar variables are sent, which every provider supports.
+const updateProjectFieldMutation = `mutation($project: ID!, $item: ID!, $field: ID!, $value: %s) {
+  updateProjectV2ItemFieldValue(input: {projectId: $project, itemId: $item, fieldId: $field, value: {%s: $value}}) {
+    projectV2Item { id }
+  }
+}`
+
+const clearProjectFieldMutation = `mutation($project: ID!, $item: ID!, $field: ID!) {
+  clearProjectV2ItemFieldValue(input: {projectId: $project, itemId: $item, fieldId: $field}) {
+    projectV2Item { id }
+  }
+}`
+
+// Project retrieves the Projects v2 project with the given number owned by
+// the client's default owner.
+// Returns ErrNotFound if the project doesn't exist.
+func (c *Client) Project(ctx context.Context, number int) (*Project, error) {
+	p := &Project{
+		client: c,
+		owner:  c.owner,
+	}
+	if err := p.fetch(ctx, number); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Refresh refreshes the project data, including its fields, from GitHub.
+func (p *Project) Refresh(ctx context.Context) error {
+	return p.fetch(ctx, p.data.Number)
+}
+
+// fetch retrieves the project with the given number and stores its data.
+func (p *Project) fetch(ctx context.Context, number int) error {
+	vars := map[string]interface{}{
+		"owner":  p.owner,
+		"number": number,
+	}
+
+	var result struct {
+		RepositoryOwner *struct {
+			ProjectV2 *graphQLProject `json:"projectV2"`
+		} `json:"repositoryOwner"`
+	}
+	if err := p.client.provider.QueryGraphQL(ctx, projectQuery, vars, &result); err != nil {
+		return WrapHTTPError(err, 0, "failed to get project")
+	}
+	if result.RepositoryOwner == nil || result.RepositoryOwner.ProjectV2 == nil {
+		return errors.New(errors.CodeNotFound, fmt.Sprintf("project %d not found for %s", number, p.owner))
+	}
+
+	p.data = result.RepositoryOwner.ProjectV2.convert()
+	return nil
+}
+
+// ID returns the project's GraphQL node ID.
+func (p *Project) ID() string {
+	return p.data.ID
+}
+
+// Number returns the project number.
+func (p *Project) Number() int {
+	return p.data.Number
+}
+
+// Title returns the project title.
+func (p *Project) Title() string {
+	return p.data.Title
+}
+
+// URL returns the project URL.
+func (p *Project) URL() string {
+	return p.data.URL
+}
+
+// Data returns the raw project data.
+func (p *Project) Data() *ProjectData {
+	return p.data
+}
+
+// Field returns the project field with the given name (case-insensitive).
+// Returns nil if the project has no such field.
+func (p *Project) Field(name string) *ProjectFieldData {
+	for _, field := range p.data.Fields {
+		if strings.EqualFold(field.Name, name) {
+			return field
+		}
+	}
+	return nil
+}
+
+// AddIssue adds an issue to the project and returns the ID of the new
+// project item. Adding an issue that is already in the project returns the
+// existing item.
+func (p *Project) AddIssue(ctx context.Context, issue *Issue) (string, error) {
+	return p.addItem(ctx, issue.owner, issue.repo, issue.Number())
+}
+
+// AddPullRequest adds a pull request to the project and returns the ID of
+// the new project item. Adding a pull request that is already in the project
+// returns the existing item.
+func (p *Project) AddPullRequest(ctx context.Context, pr *PullRequest) (string, error) {
+	return p.addItem(ctx, pr.owner, pr.repo, pr.Number())
+}
+
+// addItem resolves the node ID of an issue or pull request and adds it to
+// the project.
+func (p *Project) addItem(ctx context.Context, owner, repo string, number int) (string, error) {
+	vars := map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}
+
+	var content struct {
+		Repository *struct {
+			IssueOrPullRequest *struct {
+				ID string `json:"id"`
+			} `json:"issueOrPullRequest"`
+		} `json:"repository"`
+	}
+	if err := p.client.provider.QueryGraphQL(ctx, projectContentQuery, vars, &content); err != nil {
+		return "", WrapHTTPError(err, 0, "failed to resolve project item content")
+	}
+	if content.Repository == nil || content.Repository.IssueOrPullRequest == nil {
+		return "", errors.New(errors.CodeNotFound, fmt.Sprintf("issue or pull request %s/%s#%d not found", owner, repo, number))
+	}
+
+	vars = map[string]interface{}{
+		"project": p.data.ID,
+		"content": content.Repository.IssueOrPullRequest.ID,
+	}
+
+	var result struct {
+		AddProjectV2ItemByID struct {
+			Item struct {
+				ID string `json:"id"`
+			} `json:"item"`
+		} `json:"addProjectV2ItemById"`
+	}
+	if err := p.client.provider.QueryGraphQL(ctx, addProjectItemMutation, vars, &result); err != nil {
+		return "", WrapHTTPError(err, 0, "failed to add project item")
+	}
+
+	return result.AddProjectV2ItemByID.Item.ID, nil
+}
+
+// RemoveItem removes an item from the project. The underlying issue or pull
+// request is not affected.
+func (p *Project) RemoveItem(ctx context.Context, itemID string) error {
+	vars := map[string]interface{}{
+		"project": p.data.ID,
+		"item":    itemID,
+	}
+	if err := p.client.provider.QueryGraphQL(ctx, deleteProjectItemMutation, vars, nil); err != nil {
+		return WrapHTTPError(err, 0, "failed to remove project item")
+	}
+	return nil
+}
+
+// SetFieldValue sets the value of a field on a project item. The field is
+// looked up by name and the value must match its type:
+//
+//   - TEXT: string
+//   - NUMBER: any integer or float type
+//   - DATE: time.Time (only the date is used)
+//   - SINGLE_SELECT: the option name as a string
+//   - ITERATION: the iteration title as a string
+//
+// Other field types, such as assignees or labels, are derived from the
+// underlying issue and cannot be set. Returns ErrNotFound if the field,
+// option, or iteration doesn't exist and ErrInvalidInput if the value has
+// the wrong type.
+func (p *Project) SetFieldValue(ctx context.Context, itemID, field string, value interface{}) error {
+	f := p.Field(field)
+	if f == nil {
+		return errors.New(errors.CodeNotFound, fmt.Sprintf("project field %q not found", field))
+	}
+
+	fieldValue, err := projectFieldValue(f, value)
+	if err != nil {
+		return err
+	}
+
+	vars := map[string]interface{}{
+		"project": p.data.ID,
+		"item":    itemID,
+		"field":   f.ID,
+		"value":   fieldValue.value,
+	}
+	mutation := fmt.Sprintf(updateProjectFieldMutation, fieldValue.graphQLType, fieldValue.key)
+	if err := p.client.provider.QueryGraphQL(ctx, mutation, vars, nil); err != nil {
+		return WrapHTTPError(err, 0, "failed to set project field value")
+	}
+	return nil
+}
+
+// ClearFieldValue clears the value of a field on a project item.
+// Returns ErrNotFound if the field doesn't exist.
+func (p *Project) ClearFieldValue(ctx context.Context, itemID, field string) error {
+	f := p.Field(field)
+	if f == nil {
+		return errors.New(errors.CodeNotFound, fmt.Sprintf("project field %q not found", field))
+	}
+
+	vars := map[string]interface{}{
+		"project": p.data.ID,
+		"item":    itemID,
+		"field":   f.ID,
+	}
+	if err := p.client.provider.QueryGraphQL(ctx, clearProjectFieldMutation, vars, nil); err != nil {
+		return WrapHTTPError(err, 0, "failed to clear project field value")
+	}
+	return nil
+}
+
+// projectValue is a single ProjectV2FieldValue entry.
+type projectValue struct {
+	key         string
+	graphQLType string
+	value       interface{}
+}
+
+// projectFieldValue converts value into the ProjectV2FieldValue entry for
+// the given field.
+func projectFieldValue(field *ProjectFieldData, value interface{}) (*projectValue, error) {
+	invalid := func() error {
+		return errors.New(errors.CodeInvalidInput,
+			fmt.Sprintf("invalid value %v for %s field %q", value, field.DataType, field.Name))
+	}
+
+	switch field.DataType {
+	case "TEXT":
+		s, ok := value.(string)
+		if !ok {
+			return nil, invalid()
+		}
+		return &projectValue{key: "text", graphQLType: "String!", value: s}, nil
+
+	case "NUMBER":
+		var n float64
+		switch v := value.(type) {
+		case int:
+			n = float64(v)
+		case int32:
+			n = float64(v)
+		case int64:
+			n = float64(v)
+		case float32:
+			n = float64(v)
+		case float64:
+			n = v
+		default:
+			return nil, invalid()
+		}
+		return &projectValue{key: "number", graphQLType: "Float!", value: n}, nil
+
+	case "DATE":
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil, invalid()
+		}
+		return &projectValue{key: "date", graphQLType: "Date!", value: t.Format("2006-01-02")}, nil
+
+	case "SINGLE_SELECT":
+		name, ok := value.(string)
+		if !ok {
+			return nil, invalid()
+		}
+		for _, option := range field.Options {
+			if strings.EqualFold(option.Name, name) {
+				return &projectValue{key: "singleSelectOptionId", graphQLType: "String!", value: option.ID}, nil
+			}
+		}
+		return nil, errors.New(errors.CodeNotFound, fmt.Sprintf("option %q not found in project field %q", name, field.Name))
+
+	case "ITERATION":
+		title, ok := value.(string)
+		if !ok {
+			return nil, invalid()
+		}
+		for _, iteration := range field.Iterations {
+			if strings.EqualFold(iteration.Title, title) {
+				return &projectValue{key: "iterationId", graphQLType: "String!", value: iteration.ID}, nil
+			}
+		}
+		return nil, errors.New(errors.CodeNotFound, fmt.Sprintf("iteration %q not found in project field %q", title, field.Name))
+	}
+
+	return nil, errors.New(errors.CodeInvalidInput,
+		fmt.Sprintf("project field %q of type %s cannot be set", field.Name, field.DataType))
+}
+
+// graphQLProject is the shape of a project node in projectQuery.
+type graphQLProject struct {
+	ID     string `json:"id"`
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+	Closed bool   `json:"closed"`
+	Fields struct {
+		Nodes []struct {
+			ID            string                   `json:"id"`
+			Name          string                   `json:"name"`
+			DataType      string                   `json:"dataType"`
+			Options       []ProjectFieldOptionData `json:"options"`
+			Configuration *struct {
+				Iterations []struct {
+					ID        string `json:"id"`
+					Title     string `json:"title"`
+					StartDate string `json:"startDate"`
+					Duration  int    `json:"duration"`
+				} `json:"iterations"`
+			} `json:"configuration"`
+		} `json:"nodes"`
+	} `json:"fields"`
+}
+
+// convert converts a GraphQL project node to ProjectData.
+func (n *graphQLProject) convert() *ProjectData {
+	project := &ProjectData{
+		ID:     n.ID,
+		Number: n.Number,
+		Title:  n.Title,
+		URL:    n.URL,
+		Closed: n.Closed,
+		Fields: make([]*ProjectFieldData, 0, len(n.Fields.Nodes)),
+	}
+
+	for _, node := range n.Fields.Nodes {
+		field := &ProjectFieldData{
+			ID:       node.ID,
+			Name:     node.Name,
+			DataType: node.DataType,
+			Options:  node.Options,
+		}
+		if node.Configuration != nil {
+			for _, iteration := range node.Configuration.Iterations {
+				field.Iterations = append(field.Iterations, ProjectIterationData{
+					ID:        iteration.ID,
+					Title:     iteration.Title,
+					StartDate: iteration.StartDate,
+					Duration:  iteration.Duration,
+				})
+			}
+		}
+		project.Fields = append(project.Fields, field)
+	}
+
+	return project
+}
@@ -1,6 +1,10 @@
 package github
 
-import "context"
+import (
+	"context"
+	"io"
+	"time"
+)
 
 //go:generate go run github.com/matryer/moq@latest -out mocks/provider.go -pkg mocks . Provider
 
@@ -53,6 +57,87 @@ type Provider interface {
 	// Returns ErrInvalidInput if the repository name is invalid.
 	CreateRepository(ctx context.Context, owner string, opts CreateRepositoryOptions) (*RepositoryData, error)
 
+	// CreateRepositoryFromTemplate creates a new repository from a template repository.
+	// Returns ErrNotFound if the template repository doesn't exist.
+	// Returns ErrInvalidInput if the source repository is not a template.
+	// Returns ErrConflict if a repository with the same name already exists.
+	CreateRepositoryFromTemplate(ctx context.Context, templateOwner, templateRepo string, opts CreateRepositoryFromTemplateOptions) (*RepositoryData, error)
+
+	// ForkRepository forks a repository.
+	// Forking happens asynchronously, so the returned repository may not be
+	// accessible immediately.
+	// Returns ErrNotFound if the source repository doesn't exist.
+	ForkRepository(ctx context.Context, owner, repo string, opts ForkRepositoryOptions) (*RepositoryData, error)
+
+	// TransferRepository transfers a repository to a new owner.
+	// Transfers to users must be accepted by the recipient before they take effect.
+	// Returns ErrNotFound if the repository doesn't exist.
+	// Returns ErrPermissionDenied if the user cannot transfer the repository.
+	TransferRepository(ctx context.Context, owner, repo string, opts TransferRepositoryOptions) (*RepositoryData, error)
+
+	// Commit operations
+
+	// ListCommits lists commits in a repository, newest first.
+	// Returns an empty slice if no commits match the filters.
+	// Returns ErrNotFound if the repository or starting ref doesn't exist.
+	ListCommits(ctx context.Context, owner, repo string, opts ListCommitsOptions) ([]*CommitData, error)
+
+	// CompareCommits compares two commits, branches, or tags.
+	// Pagination applies to the commits in the comparison; files are returned
+	// with the first page only.
+	// Returns ErrNotFound if either ref doesn't exist.
+	CompareCommits(ctx context.Context, owner, repo, base, head string, opts ListOptions) (*ComparisonData, error)
+
+	// GetCombinedStatus retrieves the combined commit status of a ref.
+	// Returns ErrNotFound if the ref doesn't exist.
+	GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*CombinedStatusData, error)
+
+	// ListCheckRuns lists the latest check runs of a ref.
+	// Returns an empty slice if no check runs have been reported.
+	// Returns ErrNotFound if the ref doesn't exist.
+	ListCheckRuns(ctx context.Context, owner, repo, ref string) ([]*CheckRunData, error)
+
+	// Tag and reference operations
+
+	// ListTags lists the tags of a repository.
+	// Returns an empty slice if the repository has no tags.
+	ListTags(ctx context.Context, owner, repo string, opts ListOptions) ([]*TagData, error)
+
+	// CreateTagObject creates an annotated tag object. The object is not
+	// reachable until a reference under refs/tags points to it.
+	// Returns ErrInvalidInput if the tagged object doesn't exist.
+	CreateTagObject(ctx context.Context, owner, repo string, opts CreateTagOptions) (*TagObjectData, error)
+
+	// CreateRef creates a reference. ref must be fully qualified
+	// (e.g. "refs/heads/feature").
+	// Returns ErrInvalidInput if the reference already exists or sha is unknown.
+	CreateRef(ctx context.Context, owner, repo, ref, sha string) (*RefData, error)
+
+	// DeleteRef deletes a reference. ref must be fully qualified.
+	// Returns ErrInvalidInput if the reference doesn't exist.
+	DeleteRef(ctx context.Context, owner, repo, ref string) error
+
+	// Traffic and statistics operations
+
+	// GetTrafficViews retrieves the page views of a repository over the last
+	// 14 days.
+	// Returns ErrPermissionDenied if the user lacks push access to the repository.
+	GetTrafficViews(ctx context.Context, owner, repo string, opts TrafficOptions) (*TrafficData, error)
+
+	// GetTrafficClones retrieves the clones of a repository over the last
+	// 14 days.
+	// Returns ErrPermissionDenied if the user lacks push access to the repository.
+	GetTrafficClones(ctx context.Context, owner, repo string, opts TrafficOptions) (*TrafficData, error)
+
+	// ListTopReferrers lists the top 10 referrers of a repository over the
+	// last 14 days.
+	ListTopReferrers(ctx context.Context, owner, repo string) ([]*ReferrerData, error)
+
+	// ListContributorStats lists the commit activity of every contributor.
+	// Returns an error with code errors.CodeUnavailable while GitHub is still
+	// computing the statistics.
+	ListContributorStats(ctx context.Context, owner, repo string) ([]*ContributorStatsData, error)
+
 	// Issue operations
 
 	// GetIssue retrieves a specific issue by number.
@@ -86,6 +171,82 @@ type Provider interface {
 	// Returns ErrNotFound if the issue doesn't exist.
 	RemoveLabel(ctx context.Context, owner, repo string, number int, label string) error
 
+	// Label operations
+
+	// ListLabels lists the labels defined in a repository.
+	// If opts.Page is zero, every page is fetched; otherwise only that page.
+	// Returns ErrNotFound if the repository doesn't exist.
+	ListLabels(ctx context.Context, owner, repo string, opts ListOptions) ([]*LabelData, error)
+
+	// CreateLabel creates a label in a repository.
+	// Returns ErrInvalidInput if the label already exists or the color is invalid.
+	// Returns ErrNotFound if the repository doesn't exist.
+	CreateLabel(ctx context.Context, owner, repo string, opts CreateLabelOptions) (*LabelData, error)
+
+	// UpdateLabel updates a label's name, color, or description.
+	// Only non-nil fields in opts are updated.
+	// Returns ErrNotFound if the label doesn't exist.
+	UpdateLabel(ctx context.Context, owner, repo, name string, opts UpdateLabelOptions) (*LabelData, error)
+
+	// DeleteLabel deletes a label from a repository, removing it from all issues.
+	// Returns ErrNotFound if the label doesn't exist.
+	DeleteLabel(ctx context.Context, owner, repo, name string) error
+
+	// Milestone operations
+
+	// ListMilestones lists the milestones in a repository.
+	// Returns ErrNotFound if the repository doesn't exist.
+	ListMilestones(ctx context.Context, owner, repo string, opts ListMilestonesOptions) ([]*MilestoneData, error)
+
+	// GetMilestone retrieves a milestone by number.
+	// Returns ErrNotFound if the milestone doesn't exist.
+	GetMilestone(ctx context.Context, owner, repo string, number int) (*MilestoneData, error)
+
+	// CreateMilestone creates a milestone in a repository.
+	// Returns ErrInvalidInput if a milestone with the same title already exists.
+	// Returns ErrNotFound if the repository doesn't exist.
+	CreateMilestone(ctx context.Context, owner, repo string, opts CreateMilestoneOptions) (*MilestoneData, error)
+
+	// UpdateMilestone updates a milestone's title, description, state, or due date.
+	// Only non-nil fields in opts are updated.
+	// Returns ErrNotFound if the milestone doesn't exist.
+	UpdateMilestone(ctx context.Context, owner, repo string, number int, opts UpdateMilestoneOptions) (*MilestoneData, error)
+
+	// DeleteMilestone deletes a milestone, removing it from all issues.
+	// Returns ErrNotFound if the milestone doesn't exist.
+	DeleteMilestone(ctx context.Context, owner, repo string, number int) error
+
+	// Comment operations
+
+	// CreateComment creates a comment on an issue or pull request.
+	// Returns ErrNotFound if the issue or pull request doesn't exist.
+	CreateComment(ctx context.Context, owner, repo string, number int, body string) (*CommentData, error)
+
+	// ListComments lists comments on an issue or pull request.
+	// Returns an empty slice if there are no comments.
+	// Returns ErrNotFound if the issue or pull request doesn't exist.
+	ListComments(ctx context.Context, owner, repo string, number int, opts ListOptions) ([]*CommentData, error)
+
+	// UpdateComment replaces the body of an existing comment.
+	// Returns ErrNotFound if the comment doesn't exist.
+	// Returns ErrPermissionDenied if the user cannot edit the comment.
+	UpdateComment(ctx context.Context, owner, repo string, commentID int64, body string) (*CommentData, error)
+
+	// DeleteComment deletes a comment.
+	// Returns ErrNotFound if the comment doesn't exist.
+	// Returns ErrPermissionDenied if the user cannot delete the comment.
+	DeleteComment(ctx context.Context, owner, repo string, commentID int64) error
+
+	// AddIssueReaction adds a reaction to an issue or pull request.
+	// No error if the user already reacted with the same content.
+	// Returns ErrInvalidInput if the reaction content is invalid.
+	AddIssueReaction(ctx context.Context, owner, repo string, number int, content string) error
+
+	// AddCommentReaction adds a reaction to an issue or pull request comment.
+	// No error if the user already reacted with the same content.
+	// Returns ErrInvalidInput if the reaction content is invalid.
+	AddCommentReaction(ctx context.Context, owner, repo string, commentID int64, content string) error
+
 	// Pull Request operations
 
 	// GetPullRequest retrieves a specific pull request by number.
@@ -111,6 +272,28 @@ type Provider interface {
 	// Returns ErrConflict if the pull request cannot be merged (conflicts, checks failing, etc.).
 	MergePullRequest(ctx context.Context, owner, repo string, number int, opts MergePullRequestOptions) error
 
+	// Pull Request review operations
+
+	// RequestReviewers requests reviews from users and/or teams.
+	// Returns ErrNotFound if the pull request doesn't exist.
+	// Returns ErrInvalidInput if a reviewer is not a collaborator.
+	RequestReviewers(ctx context.Context, owner, repo string, number int, opts RequestReviewersOptions) error
+
+	// SubmitReview creates and submits a review on a pull request.
+	// Returns ErrNotFound if the pull request doesn't exist.
+	// Returns ErrInvalidInput if the event is invalid or a required body is missing.
+	SubmitReview(ctx context.Context, owner, repo string, number int, opts SubmitReviewOptions) (*ReviewData, error)
+
+	// ListReviews lists reviews submitted on a pull request.
+	// Returns an empty slice if the pull request has no reviews.
+	// Returns ErrNotFound if the pull request doesn't exist.
+	ListReviews(ctx context.Context, owner, repo string, number int, opts ListOptions) ([]*ReviewData, error)
+
+	// CreateReviewComment creates a review comment anchored to a line in the pull request diff.
+	// Returns ErrNotFound if the pull request doesn't exist.
+	// Returns ErrInvalidInput if the path or line is not part of the diff.
+	CreateReviewComment(ctx context.Context, owner, repo string, number int, opts CreateReviewCommentOptions) (*ReviewCommentData, error)
+
 	// Workflow operations
 
 	// GetWorkflowRun retrieves a specific workflow run by ID.
@@ -126,6 +309,26 @@ type Provider interface {
 	// Returns ErrNotFound if the workflow run doesn't exist.
 	GetWorkflowRunJobs(ctx context.Context, owner, repo string, runID int64) ([]*WorkflowJobData, error)
 
+	// GetWorkflowRunLogs retrieves the combined plain-text logs for all jobs in a workflow run.
+	// The caller is responsible for closing the returned reader.
+	// Returns ErrNotFound if the workflow run doesn't exist or its logs have expired.
+	GetWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64) (io.ReadCloser, error)
+
+	// GetWorkflowJobLogs retrieves the plain-text logs for a single workflow job.
+	// The caller is responsible for closing the returned reader.
+	// Returns ErrNotFound if the job doesn't exist or its logs have expired.
+	GetWorkflowJobLogs(ctx context.Context, owner, repo string, jobID int64) (io.ReadCloser, error)
+
+	// ListArtifacts lists the artifacts uploaded by a workflow run.
+	// Returns an empty slice if the workflow run has no artifacts.
+	// Returns ErrNotFound if the workflow run doesn't exist.
+	ListArtifacts(ctx context.Context, owner, repo string, runID int64, opts ListOptions) ([]*ArtifactData, error)
+
+	// DownloadArtifact downloads an artifact as a zip archive.
+	// The caller is responsible for closing the returned reader.
+	// Returns ErrNotFound if the artifact doesn't exist or has expired.
+	DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64) (io.ReadCloser, error)
+
 	// TriggerWorkflow manually triggers a workflow run.
 	// workflowFileName is the filename of the workflow (e.g., "ci.yml").
 	// ref is the git ref (branch, tag, or SHA) to run the workflow from.
@@ -133,4 +336,149 @@ type Provider interface {
 	// Returns ErrNotFound if the workflow doesn't exist.
 	// Returns ErrInvalidInput if required inputs are missing or invalid.
 	TriggerWorkflow(ctx context.Context, owner, repo, workflowFileName string, ref string, inputs map[string]interface{}) error
+
+	// Environment operations
+
+	// ListEnvironments lists the deployment environments of a repository.
+	// Returns an empty slice if the repository has no environments.
+	// Returns ErrNotFound if the repository doesn't exist.
+	ListEnvironments(ctx context.Context, owner, repo string, opts ListOptions) ([]*EnvironmentData, error)
+
+	// GetEnvironment retrieves a deployment environment by name.
+	// Returns ErrNotFound if the environment doesn't exist.
+	GetEnvironment(ctx context.Context, owner, repo, name string) (*EnvironmentData, error)
+
+	// CreateOrUpdateEnvironment creates an environment or replaces the
+	// protection rules of an existing one.
+	// Returns ErrInvalidInput if a reviewer or the wait timer is invalid.
+	// Returns ErrPermissionDenied if the user cannot administer the repository.
+	CreateOrUpdateEnvironment(ctx context.Context, owner, repo, name string, opts EnvironmentOptions) (*EnvironmentData, error)
+
+	// DeleteEnvironment deletes a deployment environment and its secrets.
+	// Returns ErrNotFound if the environment doesn't exist.
+	DeleteEnvironment(ctx context.Context, owner, repo, name string) error
+
+	// ListDeploymentBranchPolicies lists all custom deployment branch policies
+	// of an environment.
+	// Returns ErrNotFound if the environment doesn't exist.
+	ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string) ([]*DeploymentBranchPolicyData, error)
+
+	// CreateDeploymentBranchPolicy adds a custom deployment branch policy to
+	// an environment. The environment must use custom branch policies.
+	// Returns ErrNotFound if the environment doesn't exist.
+	CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, policy DeploymentBranchPolicyData) (*DeploymentBranchPolicyData, error)
+
+	// DeleteDeploymentBranchPolicy removes a custom deployment branch policy.
+	// Returns ErrNotFound if the policy doesn't exist.
+	DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, policyID int64) error
+
+	// Security alert operations
+
+	// ListDependabotAlerts lists all Dependabot alerts of a repository.
+	// Returns an empty slice if no alerts match the filters.
+	// Returns ErrPermissionDenied if Dependabot alerts are disabled or the
+	// user lacks access to security alerts.
+	ListDependabotAlerts(ctx context.Context, owner, repo string, opts ListAlertsOptions) ([]*DependabotAlertData, error)
+
+	// ListCodeScanningAlerts lists all code scanning alerts of a repository.
+	// Returns an empty slice if no alerts match the filters.
+	// Returns ErrNotFound if code scanning is not enabled for the repository.
+	ListCodeScanningAlerts(ctx context.Context, owner, repo string, opts ListAlertsOptions) ([]*CodeScanningAlertData, error)
+
+	// ListSecretScanningAlerts lists all secret scanning alerts of a repository.
+	// Returns an empty slice if no alerts match the filters.
+	// Returns ErrNotFound if secret scanning is not enabled for the repository.
+	ListSecretScanningAlerts(ctx context.Context, owner, repo string, opts ListAlertsOptions) ([]*SecretScanningAlertData, error)
+
+	// Secret and variable operations
+
+	// SetSecret creates or updates an encrypted secret in the given scope.
+	// The value is encrypted with the scope's public key before it is sent.
+	// Returns ErrNotFound if the repository, environment, or organization doesn't exist.
+	// Returns ErrInvalidInput if the scope or secret name is invalid.
+	SetSecret(ctx context.Context, scope SecretScope, opts SetSecretOptions) error
+
+	// ListSecrets lists the secrets in the given scope. Values are never returned.
+	// Returns an empty slice if the scope has no secrets.
+	// Returns ErrNotFound if the repository, environment, or organization doesn't exist.
+	ListSecrets(ctx context.Context, scope SecretScope, opts ListOptions) ([]*SecretData, error)
+
+	// DeleteSecret deletes a secret from the given scope.
+	// Returns ErrNotFound if the secret doesn't exist.
+	DeleteSecret(ctx context.Context, scope SecretScope, name string) error
+
+	// SetVariable creates or updates a configuration variable in the given scope.
+	// Variables are only supported for GitHub Actions.
+	// Returns ErrNotFound if the repository, environment, or organization doesn't exist.
+	// Returns ErrInvalidInput if the scope or variable name is invalid.
+	SetVariable(ctx context.Context, scope SecretScope, opts SetVariableOptions) error
+
+	// ListVariables lists the configuration variables in the given scope.
+	// Returns an empty slice if the scope has no variables.
+	// Returns ErrNotFound if the repository, environment, or organization doesn't exist.
+	ListVariables(ctx context.Context, scope SecretScope, opts ListOptions) ([]*VariableData, error)
+
+	// DeleteVariable deletes a configuration variable from the given scope.
+	// Returns ErrNotFound if the variable doesn't exist.
+	DeleteVariable(ctx context.Context, scope SecretScope, name string) error
+
+	// Notification operations
+
+	// ListNotifications lists notification threads for the authenticated user.
+	// Returns an empty slice if there are no notifications.
+	ListNotifications(ctx context.Context, opts ListNotificationsOptions) ([]*NotificationData, error)
+
+	// MarkNotificationsRead marks all notifications updated at or before
+	// lastReadAt as read.
+	MarkNotificationsRead(ctx context.Context, lastReadAt time.Time) error
+
+	// MarkThreadRead marks a single notification thread as read.
+	// Returns ErrNotFound if the thread doesn't exist.
+	MarkThreadRead(ctx context.Context, threadID string) error
+
+	// SetThreadSubscription subscribes to a notification thread, or ignores
+	// it when ignored is true.
+	// Returns ErrNotFound if the thread doesn't exist.
+	SetThreadSubscription(ctx context.Context, threadID string, ignored bool) (*ThreadSubscriptionData, error)
+
+	// DeleteThreadSubscription removes the subscription to a notification
+	// thread. Notifications resume if the user participates again.
+	// Returns ErrNotFound if the thread doesn't exist.
+	DeleteThreadSubscription(ctx context.Context, threadID string) error
+
+	// Gist operations
+
+	// ListGists lists the gists of the authenticated user.
+	// File content is not included.
+	// Returns an empty slice if the user has no gists.
+	ListGists(ctx context.Context, opts ListOptions) ([]*GistData, error)
+
+	// GetGist retrieves a gist, including the content of its files.
+	// Returns ErrNotFound if the gist doesn't exist.
+	GetGist(ctx context.Context, id string) (*GistData, error)
+
+	// CreateGist creates a gist owned by the authenticated user.
+	// Returns ErrInvalidInput if no files are given.
+	CreateGist(ctx context.Context, opts CreateGistOptions) (*GistData, error)
+
+	// UpdateGist updates the description and files of a gist.
+	// Returns ErrNotFound if the gist doesn't exist.
+	UpdateGist(ctx context.Context, id string, opts UpdateGistOptions) (*GistData, error)
+
+	// DeleteGist deletes a gist.
+	// Returns ErrNotFound if the gist doesn't exist.
+	DeleteGist(ctx context.Context, id string) error
+
+	// DownloadGistFile downloads the full content of a gist file from its
+	// raw URL. Use this for files whose content was truncated.
+	DownloadGistFile(ctx context.Context, rawURL string) (io.ReadCloser, error)
+
+	// GraphQL operations
+
+	// QueryGraphQL executes a query or mutation against the GitHub GraphQL API
+	// and decodes the response data into result.
+	// vars contains the query variables (can be nil if the query has none).
+	// Returns ErrInvalidInput if the query is malformed or a variable is invalid.
+	// Returns ErrNotFound if a queried resource doesn't exist.
+	QueryGraphQL(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error
 }
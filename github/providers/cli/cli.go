@@ -5,8 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jmgilman/go/errors"
 	"github.com/jmgilman/go/exec"
@@ -18,7 +22,8 @@ type Option func(*CLIProvider) error
 
 // CLIProvider implements GitHubProvider using the gh CLI.
 type CLIProvider struct {
-	wrapper *exec.CommandWrapper
+	wrapper  *exec.CommandWrapper
+	hostname string
 }
 
 // NewCLIProvider creates a provider using the gh CLI.
@@ -31,6 +36,10 @@ type CLIProvider struct {
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
+//
+// Example with GitHub Enterprise Server:
+//
+//	provider, err := cli.NewCLIProvider(cli.WithHostname("github.example.com"))
 func NewCLIProvider(opts ...Option) (*CLIProvider, error) {
 	// Default executor
 	executor := exec.New(exec.WithInheritEnv())
@@ -47,7 +56,11 @@ func NewCLIProvider(opts ...Option) (*CLIProvider, error) {
 	}
 
 	// Verify gh is installed and authenticated
-	result, err := provider.wrapper.Run("auth", "status")
+	args := []string{"auth", "status"}
+	if provider.hostname != "" {
+		args = append(args, "--hostname", provider.hostname)
+	}
+	result, err := provider.command(context.Background()).Run(args...)
 	if err != nil {
 		return nil, wrapAuthError(err, result)
 	}
@@ -55,6 +68,28 @@ func NewCLIProvider(opts ...Option) (*CLIProvider, error) {
 	return provider, nil
 }
 
+// AddCommentReaction adds a reaction to an issue or pull request comment.
+func (c *CLIProvider) AddCommentReaction(ctx context.Context, owner, repo string, commentID int64, content string) error {
+	result, err := c.command(ctx).Run("api", "--method", "POST", fmt.Sprintf("repos/%s/%s/issues/comments/%d/reactions", owner, repo, commentID), "-f", "content="+content)
+
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to add comment reaction")
+	}
+
+	return nil
+}
+
+// AddIssueReaction adds a reaction to an issue or pull request.
+func (c *CLIProvider) AddIssueReaction(ctx context.Context, owner, repo string, number int, content string) error {
+	result, err := c.command(ctx).Run("api", "--method", "POST", fmt.Sprintf("repos/%s/%s/issues/%d/reactions", owner, repo, number), "-f", "content="+content)
+
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to add reaction")
+	}
+
+	return nil
+}
+
 // AddLabels adds labels to an issue.
 func (c *CLIProvider) AddLabels(ctx context.Context, owner, repo string, number int, labels []string) error {
 	args := []string{"issue", "edit", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo)}
@@ -62,7 +97,7 @@ func (c *CLIProvider) AddLabels(ctx context.Context, owner, repo string, number
 		args = append(args, "--add-label", label)
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	result, err := c.command(ctx).Run(args...)
 
 	if err != nil {
 		return c.wrapCLIError(err, result, "failed to add labels")
@@ -73,7 +108,7 @@ func (c *CLIProvider) AddLabels(ctx context.Context, owner, repo string, number
 
 // CloseIssue closes an issue.
 func (c *CLIProvider) CloseIssue(ctx context.Context, owner, repo string, number int) error {
-	result, err := c.wrapper.Clone().WithContext(ctx).Run("issue", "close", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo))
+	result, err := c.command(ctx).Run("issue", "close", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo))
 
 	if err != nil {
 		return c.wrapCLIError(err, result, "failed to close issue")
@@ -82,6 +117,138 @@ func (c *CLIProvider) CloseIssue(ctx context.Context, owner, repo string, number
 	return nil
 }
 
+// CompareCommits compares two commits, branches, or tags.
+func (c *CLIProvider) CompareCommits(ctx context.Context, owner, repo, base, head string, opts github.ListOptions) (*github.ComparisonData, error) {
+	endpoint := paginate(fmt.Sprintf("repos/%s/%s/compare/%s...%s", owner, repo, url.PathEscape(base), url.PathEscape(head)), opts)
+
+	result, err := c.command(ctx).Run("api", endpoint)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to compare commits")
+	}
+
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	comparison := &github.ComparisonData{
+		Commits: []*github.CommitData{},
+		Files:   []*github.CommitFileData{},
+	}
+	if v, ok := data["status"].(string); ok {
+		comparison.Status = v
+	}
+	if v, ok := data["ahead_by"].(float64); ok {
+		comparison.AheadBy = int(v)
+	}
+	if v, ok := data["behind_by"].(float64); ok {
+		comparison.BehindBy = int(v)
+	}
+	if v, ok := data["total_commits"].(float64); ok {
+		comparison.TotalCommits = int(v)
+	}
+	if v, ok := data["html_url"].(string); ok {
+		comparison.HTMLURL = v
+	}
+	if base, ok := data["merge_base_commit"].(map[string]interface{}); ok {
+		if v, ok := base["sha"].(string); ok {
+			comparison.MergeBaseSHA = v
+		}
+	}
+	if commits, ok := data["commits"].([]interface{}); ok {
+		for _, item := range commits {
+			if commit, ok := item.(map[string]interface{}); ok {
+				comparison.Commits = append(comparison.Commits, c.convertCommitFromMap(commit))
+			}
+		}
+	}
+	if files, ok := data["files"].([]interface{}); ok {
+		for _, item := range files {
+			file, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fileData := &github.CommitFileData{}
+			if v, ok := file["filename"].(string); ok {
+				fileData.Filename = v
+			}
+			if v, ok := file["previous_filename"].(string); ok {
+				fileData.PreviousFilename = v
+			}
+			if v, ok := file["status"].(string); ok {
+				fileData.Status = v
+			}
+			if v, ok := file["additions"].(float64); ok {
+				fileData.Additions = int(v)
+			}
+			if v, ok := file["deletions"].(float64); ok {
+				fileData.Deletions = int(v)
+			}
+			if v, ok := file["changes"].(float64); ok {
+				fileData.Changes = int(v)
+			}
+			comparison.Files = append(comparison.Files, fileData)
+		}
+	}
+
+	return comparison, nil
+}
+
+// CreateComment creates a comment on an issue or pull request.
+func (c *CLIProvider) CreateComment(ctx context.Context, owner, repo string, number int, body string) (*github.CommentData, error) {
+	result, err := c.command(ctx).Run("api", "--method", "POST", fmt.Sprintf("repos/%s/%s/issues/%d/comments", owner, repo, number), "-f", "body="+body)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create comment")
+	}
+
+	return c.parseCommentFromJSON(result)
+}
+
+// CreateDeploymentBranchPolicy adds a custom deployment branch policy to an environment.
+func (c *CLIProvider) CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, policy github.DeploymentBranchPolicyData) (*github.DeploymentBranchPolicyData, error) {
+	args := []string{
+		"api", "--method", "POST", deploymentBranchPoliciesEndpoint(owner, repo, environment),
+		"-f", "name=" + policy.Name,
+	}
+	if policy.Type != "" {
+		args = append(args, "-f", "type="+policy.Type)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create deployment branch policy")
+	}
+
+	var created github.DeploymentBranchPolicyData
+	if err := c.parseJSON(result, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// CreateGist creates a gist owned by the authenticated user.
+func (c *CLIProvider) CreateGist(ctx context.Context, opts github.CreateGistOptions) (*github.GistData, error) {
+	args := []string{
+		"api", "--method", "POST", "gists",
+		"-f", "description=" + opts.Description,
+		"-F", "public=" + strconv.FormatBool(opts.Public),
+	}
+	args = append(args, gistFileFields(opts.Files)...)
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create gist")
+	}
+
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	return c.convertGistFromMap(data), nil
+}
+
 // CreateIssue creates a new issue.
 func (c *CLIProvider) CreateIssue(ctx context.Context, owner, repo string, opts github.CreateIssueOptions) (*github.IssueData, error) {
 	args := []string{"issue", "create", "--repo", fmt.Sprintf("%s/%s", owner, repo), "--title", opts.Title}
@@ -100,7 +267,7 @@ func (c *CLIProvider) CreateIssue(ctx context.Context, owner, repo string, opts
 		}
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	result, err := c.command(ctx).Run(args...)
 
 	if err != nil {
 		return nil, c.wrapCLIError(err, result, "failed to create issue")
@@ -123,6 +290,102 @@ func (c *CLIProvider) CreateIssue(ctx context.Context, owner, repo string, opts
 	return c.GetIssue(ctx, owner, repo, number)
 }
 
+// CreateLabel creates a label in a repository.
+func (c *CLIProvider) CreateLabel(ctx context.Context, owner, repo string, opts github.CreateLabelOptions) (*github.LabelData, error) {
+	args := []string{
+		"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/labels", owner, repo),
+		"-f", "name=" + opts.Name,
+		"-f", "color=" + opts.Color,
+	}
+	if opts.Description != "" {
+		args = append(args, "-f", "description="+opts.Description)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create label")
+	}
+
+	var label github.LabelData
+	if err := c.parseJSON(result, &label); err != nil {
+		return nil, err
+	}
+
+	return &label, nil
+}
+
+// CreateMilestone creates a milestone in a repository.
+func (c *CLIProvider) CreateMilestone(ctx context.Context, owner, repo string, opts github.CreateMilestoneOptions) (*github.MilestoneData, error) {
+	args := []string{
+		"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/milestones", owner, repo),
+		"-f", "title=" + opts.Title,
+	}
+	if opts.Description != "" {
+		args = append(args, "-f", "description="+opts.Description)
+	}
+	if opts.State != "" {
+		args = append(args, "-f", "state="+opts.State)
+	}
+	if opts.DueOn != nil {
+		args = append(args, "-f", "due_on="+opts.DueOn.UTC().Format(time.RFC3339))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create milestone")
+	}
+
+	var milestone github.MilestoneData
+	if err := c.parseJSON(result, &milestone); err != nil {
+		return nil, err
+	}
+
+	return &milestone, nil
+}
+
+// CreateOrUpdateEnvironment creates an environment or replaces its protection rules.
+func (c *CLIProvider) CreateOrUpdateEnvironment(ctx context.Context, owner, repo, name string, opts github.EnvironmentOptions) (*github.EnvironmentData, error) {
+	args := []string{
+		"api", "--method", "PUT", environmentEndpoint(owner, repo, name),
+		"-F", "wait_timer=" + strconv.Itoa(opts.WaitTimer),
+		"-F", "prevent_self_review=" + strconv.FormatBool(opts.PreventSelfReview),
+	}
+
+	// Protection rules are replaced, so an empty reviewer list is sent explicitly
+	if len(opts.Reviewers) == 0 {
+		args = append(args, "-F", "reviewers[]")
+	}
+	for _, reviewer := range opts.Reviewers {
+		args = append(args,
+			"-f", "reviewers[][type]="+reviewer.Type,
+			"-F", "reviewers[][id]="+strconv.FormatInt(reviewer.ID, 10),
+		)
+	}
+	if opts.CanAdminsBypass != nil {
+		args = append(args, "-F", "can_admins_bypass="+strconv.FormatBool(*opts.CanAdminsBypass))
+	}
+	if policy := opts.DeploymentBranchPolicy; policy != nil {
+		args = append(args,
+			"-F", "deployment_branch_policy[protected_branches]="+strconv.FormatBool(policy.ProtectedBranches),
+			"-F", "deployment_branch_policy[custom_branch_policies]="+strconv.FormatBool(policy.CustomBranchPolicies),
+		)
+	} else {
+		args = append(args, "-F", "deployment_branch_policy=null")
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create or update environment")
+	}
+
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	return c.convertEnvironmentFromMap(data), nil
+}
+
 // CreatePullRequest creates a new pull request.
 func (c *CLIProvider) CreatePullRequest(ctx context.Context, owner, repo string, opts github.CreatePullRequestOptions) (*github.PullRequestData, error) {
 	args := []string{"pr", "create", "--repo", fmt.Sprintf("%s/%s", owner, repo), "--title", opts.Title, "--head", opts.Head, "--base", opts.Base}
@@ -134,7 +397,7 @@ func (c *CLIProvider) CreatePullRequest(ctx context.Context, owner, repo string,
 		args = append(args, "--draft")
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	result, err := c.command(ctx).Run(args...)
 	if err != nil {
 		return nil, c.wrapCLIError(err, result, "failed to create pull request")
 	}
@@ -155,6 +418,25 @@ func (c *CLIProvider) CreatePullRequest(ctx context.Context, owner, repo string,
 	return c.GetPullRequest(ctx, owner, repo, number)
 }
 
+// CreateRef creates a reference.
+func (c *CLIProvider) CreateRef(ctx context.Context, owner, repo, ref, sha string) (*github.RefData, error) {
+	result, err := c.command(ctx).Run(
+		"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/git/refs", owner, repo),
+		"-f", "ref="+ref,
+		"-f", "sha="+sha,
+	)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create reference")
+	}
+
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	return c.convertRefFromMap(data), nil
+}
+
 // CreateRepository creates a new repository.
 func (c *CLIProvider) CreateRepository(ctx context.Context, owner string, opts github.CreateRepositoryOptions) (*github.RepositoryData, error) {
 	// Build request body
@@ -171,444 +453,1481 @@ func (c *CLIProvider) CreateRepository(ctx context.Context, owner string, opts g
 	}
 
 	// Try to create as org repository first
-	result, err := c.wrapper.Clone().WithContext(ctx).Run("api", fmt.Sprintf("orgs/%s/repos", owner), "--input", "-", "--method", "POST", "--field", string(reqJSON))
+	result, err := c.command(ctx).Run("api", fmt.Sprintf("orgs/%s/repos", owner), "--input", "-", "--method", "POST", "--field", string(reqJSON))
 
 	if err != nil {
 		// Try as user repository
-		result, err = c.wrapper.Clone().WithContext(ctx).Run("api", "user/repos", "--input", "-", "--method", "POST", "--field", string(reqJSON))
+		result, err = c.command(ctx).Run("api", "user/repos", "--input", "-", "--method", "POST", "--field", string(reqJSON))
 		if err != nil {
 			return nil, c.wrapCLIError(err, result, "failed to create repository")
 		}
 	}
 
-	var apiResp struct {
-		ID            int64  `json:"id"`
-		Name          string `json:"name"`
-		FullName      string `json:"full_name"`
-		Description   string `json:"description"`
-		DefaultBranch string `json:"default_branch"`
-		Private       bool   `json:"private"`
-		Fork          bool   `json:"fork"`
-		Archived      bool   `json:"archived"`
-		CloneURL      string `json:"clone_url"`
-		SSHURL        string `json:"ssh_url"`
-		HTMLURL       string `json:"html_url"`
-		CreatedAt     string `json:"created_at"`
-		UpdatedAt     string `json:"updated_at"`
-		Owner         struct {
-			Login string `json:"login"`
-		} `json:"owner"`
+	return c.parseRepositoryFromJSON(result)
+}
+
+// CreateRepositoryFromTemplate creates a new repository from a template repository.
+func (c *CLIProvider) CreateRepositoryFromTemplate(ctx context.Context, templateOwner, templateRepo string, opts github.CreateRepositoryFromTemplateOptions) (*github.RepositoryData, error) {
+	args := []string{
+		"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/generate", templateOwner, templateRepo),
+		"-f", "owner=" + opts.Owner,
+		"-f", "name=" + opts.Name,
+		"-F", "private=" + strconv.FormatBool(opts.Private),
+		"-F", "include_all_branches=" + strconv.FormatBool(opts.IncludeAllBranches),
+	}
+	if opts.Description != "" {
+		args = append(args, "-f", "description="+opts.Description)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create repository from template")
+	}
+
+	return c.parseRepositoryFromJSON(result)
+}
+
+// CreateReviewComment creates a review comment anchored to a line in the pull request diff.
+func (c *CLIProvider) CreateReviewComment(ctx context.Context, owner, repo string, number int, opts github.CreateReviewCommentOptions) (*github.ReviewCommentData, error) {
+	args := []string{
+		"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/pulls/%d/comments", owner, repo, number),
+		"-f", "body=" + opts.Body,
+		"-f", "path=" + opts.Path,
+		"-f", "commit_id=" + opts.CommitID,
+		"-F", fmt.Sprintf("line=%d", opts.Line),
+	}
+	if opts.Side != "" {
+		args = append(args, "-f", "side="+opts.Side)
+	}
+	if opts.StartLine > 0 {
+		args = append(args, "-F", fmt.Sprintf("start_line=%d", opts.StartLine))
+		if opts.Side != "" {
+			args = append(args, "-f", "start_side="+opts.Side)
+		}
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create review comment")
 	}
 
+	var apiResp map[string]interface{}
 	if err := c.parseJSON(result, &apiResp); err != nil {
 		return nil, err
 	}
 
-	data := &github.RepositoryData{
-		ID:            apiResp.ID,
-		Owner:         apiResp.Owner.Login,
-		Name:          apiResp.Name,
-		FullName:      apiResp.FullName,
-		Description:   apiResp.Description,
-		DefaultBranch: apiResp.DefaultBranch,
-		Private:       apiResp.Private,
-		Fork:          apiResp.Fork,
-		Archived:      apiResp.Archived,
-		CloneURL:      apiResp.CloneURL,
-		SSHURL:        apiResp.SSHURL,
-		HTMLURL:       apiResp.HTMLURL,
+	return c.convertReviewCommentFromMap(apiResp), nil
+}
+
+// CreateTagObject creates an annotated tag object.
+func (c *CLIProvider) CreateTagObject(ctx context.Context, owner, repo string, opts github.CreateTagOptions) (*github.TagObjectData, error) {
+	args := []string{
+		"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/git/tags", owner, repo),
+		"-f", "tag=" + opts.Tag,
+		"-f", "message=" + opts.Message,
+		"-f", "object=" + opts.SHA,
+		"-f", "type=" + opts.Type,
+	}
+	if opts.TaggerName != "" {
+		args = append(args,
+			"-f", "tagger[name]="+opts.TaggerName,
+			"-f", "tagger[email]="+opts.TaggerEmail,
+		)
+		if opts.TaggedAt != nil {
+			args = append(args, "-f", "tagger[date]="+opts.TaggedAt.UTC().Format(time.RFC3339))
+		}
 	}
 
-	if t, err := github.ParseGitHubTime(apiResp.CreatedAt); err == nil {
-		data.CreatedAt = t
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to create tag object")
 	}
-	if t, err := github.ParseGitHubTime(apiResp.UpdatedAt); err == nil {
-		data.UpdatedAt = t
+
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
 	}
 
-	return data, nil
+	return c.convertTagObjectFromMap(data), nil
 }
 
-// GetIssue retrieves a specific issue by number.
-func (c *CLIProvider) GetIssue(ctx context.Context, owner, repo string, number int) (*github.IssueData, error) {
-	result, err := c.wrapper.Clone().WithContext(ctx).Run("issue", "view", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "number,title,body,state,author,labels,assignees,milestone,createdAt,updatedAt,closedAt,url")
+// DeleteComment deletes a comment.
+func (c *CLIProvider) DeleteComment(ctx context.Context, owner, repo string, commentID int64) error {
+	result, err := c.command(ctx).Run("api", "--method", "DELETE", fmt.Sprintf("repos/%s/%s/issues/comments/%d", owner, repo, commentID))
 
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to get issue")
+		return c.wrapCLIError(err, result, "failed to delete comment")
 	}
 
-	return c.parseIssueFromJSON(result)
+	return nil
 }
 
-// GetPullRequest retrieves a specific pull request by number.
-func (c *CLIProvider) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequestData, error) {
-	result, err := c.wrapper.Clone().WithContext(ctx).Run("pr", "view", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "number,title,body,state,author,headRefName,baseRefName,headRefOid,labels,isDraft,mergeable,mergedAt,createdAt,updatedAt,closedAt,url")
+// DeleteDeploymentBranchPolicy removes a custom deployment branch policy.
+func (c *CLIProvider) DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, policyID int64) error {
+	endpoint := fmt.Sprintf("%s/%d", deploymentBranchPoliciesEndpoint(owner, repo, environment), policyID)
+	result, err := c.command(ctx).Run("api", "--method", "DELETE", endpoint)
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to get pull request")
+		return c.wrapCLIError(err, result, "failed to delete deployment branch policy")
 	}
 
-	return c.parsePRFromJSON(result)
+	return nil
 }
 
-// GetRepository retrieves repository information.
-func (c *CLIProvider) GetRepository(ctx context.Context, owner, repo string) (*github.RepositoryData, error) {
-	result, err := c.wrapper.Clone().WithContext(ctx).Run("api", fmt.Sprintf("repos/%s/%s", owner, repo))
+// DeleteEnvironment deletes a deployment environment.
+func (c *CLIProvider) DeleteEnvironment(ctx context.Context, owner, repo, name string) error {
+	result, err := c.command(ctx).Run("api", "--method", "DELETE", environmentEndpoint(owner, repo, name))
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to get repository")
+		return c.wrapCLIError(err, result, "failed to delete environment")
 	}
 
-	// Parse gh API response
-	var apiResp struct {
-		ID            int64  `json:"id"`
-		Name          string `json:"name"`
-		FullName      string `json:"full_name"`
-		Description   string `json:"description"`
-		DefaultBranch string `json:"default_branch"`
-		Private       bool   `json:"private"`
-		Fork          bool   `json:"fork"`
-		Archived      bool   `json:"archived"`
-		CloneURL      string `json:"clone_url"`
-		SSHURL        string `json:"ssh_url"`
-		HTMLURL       string `json:"html_url"`
-		CreatedAt     string `json:"created_at"`
-		UpdatedAt     string `json:"updated_at"`
-		Owner         struct {
-			Login string `json:"login"`
-		} `json:"owner"`
-	}
+	return nil
+}
 
-	if err := c.parseJSON(result, &apiResp); err != nil {
-		return nil, err
+// DeleteGist deletes a gist.
+func (c *CLIProvider) DeleteGist(ctx context.Context, id string) error {
+	result, err := c.command(ctx).Run("api", "--method", "DELETE", "gists/"+id)
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to delete gist")
 	}
 
-	data := &github.RepositoryData{
-		ID:            apiResp.ID,
-		Owner:         apiResp.Owner.Login,
-		Name:          apiResp.Name,
-		FullName:      apiResp.FullName,
-		Description:   apiResp.Description,
-		DefaultBranch: apiResp.DefaultBranch,
-		Private:       apiResp.Private,
-		Fork:          apiResp.Fork,
-		Archived:      apiResp.Archived,
-		CloneURL:      apiResp.CloneURL,
-		SSHURL:        apiResp.SSHURL,
-		HTMLURL:       apiResp.HTMLURL,
-	}
+	return nil
+}
 
-	// Parse timestamps
-	if t, err := github.ParseGitHubTime(apiResp.CreatedAt); err == nil {
-		data.CreatedAt = t
-	}
-	if t, err := github.ParseGitHubTime(apiResp.UpdatedAt); err == nil {
-		data.UpdatedAt = t
+// DeleteLabel deletes a label from a repository.
+func (c *CLIProvider) DeleteLabel(ctx context.Context, owner, repo, name string) error {
+	result, err := c.command(ctx).Run("api", "--method", "DELETE", fmt.Sprintf("repos/%s/%s/labels/%s", owner, repo, url.PathEscape(name)))
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to delete label")
 	}
 
-	return data, nil
+	return nil
 }
 
-// GetWorkflowRun retrieves a specific workflow run by ID.
-func (c *CLIProvider) GetWorkflowRun(ctx context.Context, owner, repo string, runID int64) (*github.WorkflowRunData, error) {
-	result, err := c.wrapper.Clone().WithContext(ctx).Run("run", "view", strconv.FormatInt(runID, 10), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "databaseId,name,workflowDatabaseId,status,conclusion,headBranch,headSha,number,event,createdAt,updatedAt,url")
+// DeleteMilestone deletes a milestone from a repository.
+func (c *CLIProvider) DeleteMilestone(ctx context.Context, owner, repo string, number int) error {
+	result, err := c.command(ctx).Run("api", "--method", "DELETE", fmt.Sprintf("repos/%s/%s/milestones/%d", owner, repo, number))
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to delete milestone")
+	}
+
+	return nil
+}
 
+// DeleteRef deletes a reference.
+func (c *CLIProvider) DeleteRef(ctx context.Context, owner, repo, ref string) error {
+	endpoint := fmt.Sprintf("repos/%s/%s/git/%s", owner, repo, ref)
+	result, err := c.command(ctx).Run("api", "--method", "DELETE", endpoint)
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to get workflow run")
+		return c.wrapCLIError(err, result, "failed to delete reference")
 	}
 
-	return c.parseWorkflowRunFromJSON(result)
+	return nil
 }
 
-// GetWorkflowRunJobs retrieves the jobs for a specific workflow run.
-func (c *CLIProvider) GetWorkflowRunJobs(ctx context.Context, owner, repo string, runID int64) ([]*github.WorkflowJobData, error) {
-	result, err := c.wrapper.Clone().WithContext(ctx).Run("run", "view", strconv.FormatInt(runID, 10), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "jobs")
+// DeleteSecret deletes a secret from the given scope.
+func (c *CLIProvider) DeleteSecret(ctx context.Context, scope github.SecretScope, name string) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
 
+	args := append([]string{"secret", "delete", name}, secretScopeArgs(scope)...)
+	result, err := c.command(ctx).Run(args...)
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to get workflow run jobs")
+		return c.wrapCLIError(err, result, "failed to delete secret")
 	}
 
-	var apiResp struct {
-		Jobs []map[string]interface{} `json:"jobs"`
-	}
-	if err := c.parseJSON(result, &apiResp); err != nil {
-		return nil, err
-	}
+	return nil
+}
 
-	jobs := make([]*github.WorkflowJobData, 0, len(apiResp.Jobs))
-	for _, item := range apiResp.Jobs {
-		job := c.convertWorkflowJobFromMap(item, runID)
-		jobs = append(jobs, job)
+// DeleteThreadSubscription removes the subscription to a notification thread.
+func (c *CLIProvider) DeleteThreadSubscription(ctx context.Context, threadID string) error {
+	result, err := c.command(ctx).Run("api", "--method", "DELETE", fmt.Sprintf("notifications/threads/%s/subscription", threadID))
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to delete thread subscription")
 	}
 
-	return jobs, nil
+	return nil
 }
 
-// ListIssues lists issues for a repository with optional filtering.
-func (c *CLIProvider) ListIssues(ctx context.Context, owner, repo string, opts github.ListIssuesOptions) ([]*github.IssueData, error) {
-	args := []string{"issue", "list", "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "number,title,body,state,author,labels,assignees,milestone,createdAt,updatedAt,closedAt,url"}
-
-	if opts.State != "" {
-		args = append(args, "--state", opts.State)
-	}
-	if len(opts.Labels) > 0 {
-		args = append(args, "--label", strings.Join(opts.Labels, ","))
-	}
-	if opts.Assignee != "" {
-		args = append(args, "--assignee", opts.Assignee)
+// DeleteVariable deletes a configuration variable from the given scope.
+func (c *CLIProvider) DeleteVariable(ctx context.Context, scope github.SecretScope, name string) error {
+	if err := scope.ValidateForVariables(); err != nil {
+		return err
 	}
-	if opts.PerPage > 0 {
-		args = append(args, "--limit", strconv.Itoa(opts.PerPage))
+
+	args := append([]string{"variable", "delete", name}, secretScopeArgs(scope)...)
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to delete variable")
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	return nil
+}
 
+// DownloadArtifact downloads an artifact as a zip archive.
+func (c *CLIProvider) DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64) (io.ReadCloser, error) {
+	result, err := c.command(ctx).Run("api", fmt.Sprintf("repos/%s/%s/actions/artifacts/%d/zip", owner, repo, artifactID))
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to list issues")
+		return nil, c.wrapCLIError(err, result, "failed to download artifact")
 	}
 
-	var apiResp []map[string]interface{}
-	if err := c.parseJSON(result, &apiResp); err != nil {
-		return nil, err
-	}
+	return io.NopCloser(strings.NewReader(result.Stdout)), nil
+}
 
-	issues := make([]*github.IssueData, 0, len(apiResp))
-	for _, item := range apiResp {
-		issue := c.convertIssueFromMap(item)
-		issues = append(issues, issue)
+// DownloadGistFile downloads the full content of a gist file from its raw URL.
+func (c *CLIProvider) DownloadGistFile(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	result, err := c.command(ctx).Run("api", rawURL)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to download gist file")
 	}
 
-	return issues, nil
+	return io.NopCloser(strings.NewReader(result.Stdout)), nil
 }
 
-// ListPullRequests lists pull requests for a repository with optional filtering.
-func (c *CLIProvider) ListPullRequests(ctx context.Context, owner, repo string, opts github.ListPullRequestsOptions) ([]*github.PullRequestData, error) {
-	args := []string{"pr", "list", "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "number,title,body,state,author,headRefName,baseRefName,headRefOid,labels,isDraft,mergeable,mergedAt,createdAt,updatedAt,closedAt,url"}
-
-	if opts.State != "" {
-		args = append(args, "--state", opts.State)
+// ForkRepository forks a repository.
+func (c *CLIProvider) ForkRepository(ctx context.Context, owner, repo string, opts github.ForkRepositoryOptions) (*github.RepositoryData, error) {
+	args := []string{
+		"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/forks", owner, repo),
+		"-F", "default_branch_only=" + strconv.FormatBool(opts.DefaultBranchOnly),
 	}
-	if opts.Head != "" {
-		args = append(args, "--head", opts.Head)
+	if opts.Organization != "" {
+		args = append(args, "-f", "organization="+opts.Organization)
 	}
-	if opts.Base != "" {
-		args = append(args, "--base", opts.Base)
+	if opts.Name != "" {
+		args = append(args, "-f", "name="+opts.Name)
 	}
-	if opts.PerPage > 0 {
-		args = append(args, "--limit", strconv.Itoa(opts.PerPage))
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to fork repository")
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	return c.parseRepositoryFromJSON(result)
+}
+
+// GetCombinedStatus retrieves the combined commit status of a ref.
+// The latest status of up to 100 contexts is returned.
+func (c *CLIProvider) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*github.CombinedStatusData, error) {
+	endpoint := fmt.Sprintf("repos/%s/%s/commits/%s/status?per_page=100", owner, repo, url.PathEscape(ref))
 
+	result, err := c.command(ctx).Run("api", endpoint)
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to list pull requests")
+		return nil, c.wrapCLIError(err, result, "failed to get combined status")
 	}
 
-	var apiResp []map[string]interface{}
-	if err := c.parseJSON(result, &apiResp); err != nil {
+	status := &github.CombinedStatusData{}
+	if err := c.parseJSON(result, status); err != nil {
 		return nil, err
 	}
 
-	prs := make([]*github.PullRequestData, 0, len(apiResp))
-	for _, item := range apiResp {
-		pr := c.convertPRFromMap(item)
-		prs = append(prs, pr)
+	return status, nil
+}
+
+// GetEnvironment retrieves a deployment environment by name.
+func (c *CLIProvider) GetEnvironment(ctx context.Context, owner, repo, name string) (*github.EnvironmentData, error) {
+	result, err := c.command(ctx).Run("api", environmentEndpoint(owner, repo, name))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to get environment")
 	}
 
-	return prs, nil
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	return c.convertEnvironmentFromMap(data), nil
 }
 
-// ListRepositories lists repositories for the given owner.
-func (c *CLIProvider) ListRepositories(ctx context.Context, owner string, opts github.ListOptions) ([]*github.RepositoryData, error) {
-	args := []string{"api", fmt.Sprintf("users/%s/repos", owner)}
-	if opts.PerPage > 0 {
-		args = append(args, "--paginate")
+// GetGist retrieves a gist, including the content of its files.
+func (c *CLIProvider) GetGist(ctx context.Context, id string) (*github.GistData, error) {
+	result, err := c.command(ctx).Run("api", "gists/"+id)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to get gist")
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	return c.convertGistFromMap(data), nil
+}
+
+// GetIssue retrieves a specific issue by number.
+func (c *CLIProvider) GetIssue(ctx context.Context, owner, repo string, number int) (*github.IssueData, error) {
+	result, err := c.command(ctx).Run("issue", "view", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "number,title,body,state,author,labels,assignees,milestone,createdAt,updatedAt,closedAt,url")
 
 	if err != nil {
-		// Try as organization if user fails
-		result, err = c.wrapper.Clone().WithContext(ctx).Run("api", fmt.Sprintf("orgs/%s/repos", owner))
-		if err != nil {
-			return nil, c.wrapCLIError(err, result, "failed to list repositories")
-		}
+		return nil, c.wrapCLIError(err, result, "failed to get issue")
 	}
 
-	var apiResp []struct {
-		ID            int64  `json:"id"`
-		Name          string `json:"name"`
-		FullName      string `json:"full_name"`
-		Description   string `json:"description"`
-		DefaultBranch string `json:"default_branch"`
-		Private       bool   `json:"private"`
-		Fork          bool   `json:"fork"`
-		Archived      bool   `json:"archived"`
-		CloneURL      string `json:"clone_url"`
-		SSHURL        string `json:"ssh_url"`
-		HTMLURL       string `json:"html_url"`
-		CreatedAt     string `json:"created_at"`
-		UpdatedAt     string `json:"updated_at"`
-		Owner         struct {
-			Login string `json:"login"`
-		} `json:"owner"`
+	return c.parseIssueFromJSON(result)
+}
+
+// GetMilestone retrieves a milestone by number.
+func (c *CLIProvider) GetMilestone(ctx context.Context, owner, repo string, number int) (*github.MilestoneData, error) {
+	result, err := c.command(ctx).Run("api", fmt.Sprintf("repos/%s/%s/milestones/%d", owner, repo, number))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to get milestone")
 	}
 
-	if err := c.parseJSON(result, &apiResp); err != nil {
+	var milestone github.MilestoneData
+	if err := c.parseJSON(result, &milestone); err != nil {
 		return nil, err
 	}
 
-	repos := make([]*github.RepositoryData, len(apiResp))
-	for i, r := range apiResp {
-		repos[i] = &github.RepositoryData{
-			ID:            r.ID,
-			Owner:         r.Owner.Login,
-			Name:          r.Name,
-			FullName:      r.FullName,
-			Description:   r.Description,
-			DefaultBranch: r.DefaultBranch,
-			Private:       r.Private,
-			Fork:          r.Fork,
-			Archived:      r.Archived,
-			CloneURL:      r.CloneURL,
-			SSHURL:        r.SSHURL,
-			HTMLURL:       r.HTMLURL,
-		}
+	return &milestone, nil
+}
 
-		if t, err := github.ParseGitHubTime(r.CreatedAt); err == nil {
-			repos[i].CreatedAt = t
-		}
-		if t, err := github.ParseGitHubTime(r.UpdatedAt); err == nil {
-			repos[i].UpdatedAt = t
-		}
+// GetPullRequest retrieves a specific pull request by number.
+func (c *CLIProvider) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequestData, error) {
+	result, err := c.command(ctx).Run("pr", "view", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "number,title,body,state,author,headRefName,baseRefName,headRefOid,labels,isDraft,mergeable,mergedAt,createdAt,updatedAt,closedAt,url")
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to get pull request")
 	}
 
-	return repos, nil
+	return c.parsePRFromJSON(result)
 }
 
-// ListWorkflowRuns lists workflow runs for a repository with optional filtering.
-func (c *CLIProvider) ListWorkflowRuns(ctx context.Context, owner, repo string, opts github.ListWorkflowRunsOptions) ([]*github.WorkflowRunData, error) {
-	args := []string{"run", "list", "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "databaseId,name,workflowDatabaseId,status,conclusion,headBranch,headSha,number,event,createdAt,updatedAt,url"}
-
-	if opts.Branch != "" {
-		args = append(args, "--branch", opts.Branch)
+// GetRepository retrieves repository information.
+func (c *CLIProvider) GetRepository(ctx context.Context, owner, repo string) (*github.RepositoryData, error) {
+	result, err := c.command(ctx).Run("api", fmt.Sprintf("repos/%s/%s", owner, repo))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to get repository")
 	}
-	if opts.Status != "" {
-		// gh run list uses different status names
-		// Map our status constants to gh CLI status
-		switch opts.Status {
-		case github.WorkflowStatusCompleted:
-			args = append(args, "--status", "completed")
-		case github.WorkflowStatusInProgress:
-			args = append(args, "--status", "in_progress")
-		case github.WorkflowStatusQueued:
-			args = append(args, "--status", "queued")
-		}
+
+	return c.parseRepositoryFromJSON(result)
+}
+
+// GetTrafficClones retrieves the clones of a repository over the last 14 days.
+func (c *CLIProvider) GetTrafficClones(ctx context.Context, owner, repo string, opts github.TrafficOptions) (*github.TrafficData, error) {
+	result, err := c.command(ctx).Run("api", trafficEndpoint(owner, repo, "clones", opts))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to get traffic clones")
 	}
-	if opts.PerPage > 0 {
-		args = append(args, "--limit", strconv.Itoa(opts.PerPage))
+
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	return c.convertTrafficFromMap(data, "clones"), nil
+}
 
+// GetTrafficViews retrieves the page views of a repository over the last 14 days.
+func (c *CLIProvider) GetTrafficViews(ctx context.Context, owner, repo string, opts github.TrafficOptions) (*github.TrafficData, error) {
+	result, err := c.command(ctx).Run("api", trafficEndpoint(owner, repo, "views", opts))
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to list workflow runs")
+		return nil, c.wrapCLIError(err, result, "failed to get traffic views")
 	}
 
-	var apiResp []map[string]interface{}
-	if err := c.parseJSON(result, &apiResp); err != nil {
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
 		return nil, err
 	}
 
-	runs := make([]*github.WorkflowRunData, 0, len(apiResp))
-	for _, item := range apiResp {
-		run := c.convertWorkflowRunFromMap(item)
-		runs = append(runs, run)
+	return c.convertTrafficFromMap(data, "views"), nil
+}
+
+// GetWorkflowJobLogs retrieves the plain-text logs for a single workflow job.
+func (c *CLIProvider) GetWorkflowJobLogs(ctx context.Context, owner, repo string, jobID int64) (io.ReadCloser, error) {
+	result, err := c.command(ctx).Run("run", "view", "--job", strconv.FormatInt(jobID, 10), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--log")
+
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to get workflow job logs")
 	}
 
-	return runs, nil
+	return io.NopCloser(strings.NewReader(result.Stdout)), nil
 }
 
-// MergePullRequest merges a pull request.
-func (c *CLIProvider) MergePullRequest(ctx context.Context, owner, repo string, number int, opts github.MergePullRequestOptions) error {
-	args := []string{"pr", "merge", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo)}
+// GetWorkflowRun retrieves a specific workflow run by ID.
+func (c *CLIProvider) GetWorkflowRun(ctx context.Context, owner, repo string, runID int64) (*github.WorkflowRunData, error) {
+	result, err := c.command(ctx).Run("run", "view", strconv.FormatInt(runID, 10), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "databaseId,name,workflowDatabaseId,status,conclusion,headBranch,headSha,number,event,createdAt,updatedAt,url")
 
-	// Map merge method
-	switch opts.MergeMethod {
-	case github.MergeMethodSquash:
-		args = append(args, "--squash")
-	case github.MergeMethodRebase:
-		args = append(args, "--rebase")
-	case github.MergeMethodMerge, "":
-		args = append(args, "--merge")
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to get workflow run")
 	}
 
-	// gh pr merge doesn't support custom commit messages in the same way
-	// We'll use auto-merge behavior
+	return c.parseWorkflowRunFromJSON(result)
+}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+// GetWorkflowRunJobs retrieves the jobs for a specific workflow run.
+func (c *CLIProvider) GetWorkflowRunJobs(ctx context.Context, owner, repo string, runID int64) ([]*github.WorkflowJobData, error) {
+	result, err := c.command(ctx).Run("run", "view", strconv.FormatInt(runID, 10), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "jobs")
 
 	if err != nil {
-		return c.wrapCLIError(err, result, "failed to merge pull request")
+		return nil, c.wrapCLIError(err, result, "failed to get workflow run jobs")
 	}
 
-	return nil
+	var apiResp struct {
+		Jobs []map[string]interface{} `json:"jobs"`
+	}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*github.WorkflowJobData, 0, len(apiResp.Jobs))
+	for _, item := range apiResp.Jobs {
+		job := c.convertWorkflowJobFromMap(item, runID)
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
 }
 
-// RemoveLabel removes a label from an issue.
-func (c *CLIProvider) RemoveLabel(ctx context.Context, owner, repo string, number int, label string) error {
-	result, err := c.wrapper.Clone().WithContext(ctx).Run("issue", "edit", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--remove-label", label)
+// GetWorkflowRunLogs retrieves the combined plain-text logs for all jobs in a workflow run.
+func (c *CLIProvider) GetWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64) (io.ReadCloser, error) {
+	result, err := c.command(ctx).Run("run", "view", strconv.FormatInt(runID, 10), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--log")
 
 	if err != nil {
-		return c.wrapCLIError(err, result, "failed to remove label")
+		return nil, c.wrapCLIError(err, result, "failed to get workflow run logs")
 	}
 
-	return nil
+	return io.NopCloser(strings.NewReader(result.Stdout)), nil
 }
 
-// TriggerWorkflow manually triggers a workflow run.
-func (c *CLIProvider) TriggerWorkflow(ctx context.Context, owner, repo, workflowFileName string, ref string, inputs map[string]interface{}) error {
-	args := []string{"workflow", "run", workflowFileName, "--repo", fmt.Sprintf("%s/%s", owner, repo), "--ref", ref}
+// ListArtifacts lists the artifacts uploaded by a workflow run.
+func (c *CLIProvider) ListArtifacts(ctx context.Context, owner, repo string, runID int64, opts github.ListOptions) ([]*github.ArtifactData, error) {
+	endpoint := paginate(fmt.Sprintf("repos/%s/%s/actions/runs/%d/artifacts", owner, repo, runID), opts)
 
-	// Add inputs as field arguments
-	for key, value := range inputs {
-		args = append(args, "-f", fmt.Sprintf("%s=%v", key, value))
+	result, err := c.command(ctx).Run("api", endpoint)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list artifacts")
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	var apiResp struct {
+		Artifacts []map[string]interface{} `json:"artifacts"`
+	}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
 
-	if err != nil {
-		return c.wrapCLIError(err, result, "failed to trigger workflow")
+	artifacts := make([]*github.ArtifactData, 0, len(apiResp.Artifacts))
+	for _, item := range apiResp.Artifacts {
+		artifacts = append(artifacts, c.convertArtifactFromMap(item))
 	}
 
-	return nil
+	return artifacts, nil
 }
 
-// UpdateIssue updates an existing issue.
-func (c *CLIProvider) UpdateIssue(ctx context.Context, owner, repo string, number int, opts github.UpdateIssueOptions) (*github.IssueData, error) {
-	args := []string{"issue", "edit", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo)}
+// ListCheckRuns lists the latest check runs of a ref.
+func (c *CLIProvider) ListCheckRuns(ctx context.Context, owner, repo, ref string) ([]*github.CheckRunData, error) {
+	endpoint := fmt.Sprintf("repos/%s/%s/commits/%s/check-runs?per_page=100", owner, repo, url.PathEscape(ref))
 
-	if opts.Title != nil {
-		args = append(args, "--title", *opts.Title)
-	}
-	if opts.Body != nil {
-		args = append(args, "--body", *opts.Body)
+	runs := []*github.CheckRunData{}
+	err := c.stream(ctx, "failed to list check runs", func(raw json.RawMessage) error {
+		var run github.CheckRunData
+		if err := json.Unmarshal(raw, &run); err != nil {
+			return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse check run")
+		}
+		runs = append(runs, &run)
+		return nil
+	}, "api", "--paginate", endpoint, "--jq", ".check_runs[]")
+	if err != nil {
+		return nil, err
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	return runs, nil
+}
 
+// ListCodeScanningAlerts lists all code scanning alerts of a repository.
+func (c *CLIProvider) ListCodeScanningAlerts(ctx context.Context, owner, repo string, opts github.ListAlertsOptions) ([]*github.CodeScanningAlertData, error) {
+	alerts := []*github.CodeScanningAlertData{}
+	err := c.stream(ctx, "failed to list code scanning alerts", func(raw json.RawMessage) error {
+		var data map[string]interface{}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse code scanning alert")
+		}
+		alerts = append(alerts, c.convertCodeScanningAlertFromMap(data))
+		return nil
+	}, "api", "--paginate", alertsEndpoint(owner, repo, "code-scanning", opts), "--jq", ".[]")
 	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to update issue")
+		return nil, err
 	}
 
-	// Fetch updated issue
-	return c.GetIssue(ctx, owner, repo, number)
+	return alerts, nil
 }
 
-// UpdatePullRequest updates an existing pull request.
-func (c *CLIProvider) UpdatePullRequest(ctx context.Context, owner, repo string, number int, opts github.UpdatePullRequestOptions) (*github.PullRequestData, error) {
-	args := []string{"pr", "edit", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo)}
+// ListComments lists comments on an issue or pull request.
+func (c *CLIProvider) ListComments(ctx context.Context, owner, repo string, number int, opts github.ListOptions) ([]*github.CommentData, error) {
+	endpoint := paginate(fmt.Sprintf("repos/%s/%s/issues/%d/comments", owner, repo, number), opts)
 
-	if opts.Title != nil {
-		args = append(args, "--title", *opts.Title)
+	result, err := c.command(ctx).Run("api", endpoint)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list comments")
+	}
+
+	var apiResp []map[string]interface{}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	comments := make([]*github.CommentData, 0, len(apiResp))
+	for _, item := range apiResp {
+		comments = append(comments, c.convertCommentFromMap(item))
+	}
+
+	return comments, nil
+}
+
+// ListCommits lists commits in a repository.
+func (c *CLIProvider) ListCommits(ctx context.Context, owner, repo string, opts github.ListCommitsOptions) ([]*github.CommitData, error) {
+	args := []string{"api", "--method", "GET", paginate(fmt.Sprintf("repos/%s/%s/commits", owner, repo), opts.ListOptions)}
+	if opts.SHA != "" {
+		args = append(args, "-f", "sha="+opts.SHA)
+	}
+	if opts.Path != "" {
+		args = append(args, "-f", "path="+opts.Path)
+	}
+	if opts.Author != "" {
+		args = append(args, "-f", "author="+opts.Author)
+	}
+	if opts.Since != nil {
+		args = append(args, "-f", "since="+opts.Since.UTC().Format(time.RFC3339))
+	}
+	if opts.Until != nil {
+		args = append(args, "-f", "until="+opts.Until.UTC().Format(time.RFC3339))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list commits")
+	}
+
+	var data []map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	commits := make([]*github.CommitData, len(data))
+	for i, item := range data {
+		commits[i] = c.convertCommitFromMap(item)
+	}
+
+	return commits, nil
+}
+
+// ListContributorStats lists the commit activity of every contributor.
+//
+// GitHub responds with 202 Accepted and an empty object while the statistics
+// are being computed, which gh reports as a successful call.
+func (c *CLIProvider) ListContributorStats(ctx context.Context, owner, repo string) ([]*github.ContributorStatsData, error) {
+	result, err := c.command(ctx).Run("api", fmt.Sprintf("repos/%s/%s/stats/contributors", owner, repo))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list contributor statistics")
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(result.Stdout), "[") {
+		return nil, errors.New(errors.CodeUnavailable, "contributor statistics are being computed")
+	}
+
+	var data []map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	stats := make([]*github.ContributorStatsData, len(data))
+	for i, item := range data {
+		stats[i] = c.convertContributorStatsFromMap(item)
+	}
+
+	return stats, nil
+}
+
+// ListDependabotAlerts lists all Dependabot alerts of a repository.
+func (c *CLIProvider) ListDependabotAlerts(ctx context.Context, owner, repo string, opts github.ListAlertsOptions) ([]*github.DependabotAlertData, error) {
+	alerts := []*github.DependabotAlertData{}
+	err := c.stream(ctx, "failed to list Dependabot alerts", func(raw json.RawMessage) error {
+		var data map[string]interface{}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse Dependabot alert")
+		}
+		alerts = append(alerts, c.convertDependabotAlertFromMap(data))
+		return nil
+	}, "api", "--paginate", alertsEndpoint(owner, repo, "dependabot", opts), "--jq", ".[]")
+	if err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// ListDeploymentBranchPolicies lists all custom deployment branch policies of an environment.
+func (c *CLIProvider) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string) ([]*github.DeploymentBranchPolicyData, error) {
+	endpoint := deploymentBranchPoliciesEndpoint(owner, repo, environment) + "?per_page=100"
+
+	policies := []*github.DeploymentBranchPolicyData{}
+	err := c.stream(ctx, "failed to list deployment branch policies", func(raw json.RawMessage) error {
+		var policy github.DeploymentBranchPolicyData
+		if err := json.Unmarshal(raw, &policy); err != nil {
+			return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse deployment branch policy")
+		}
+		policies = append(policies, &policy)
+		return nil
+	}, "api", "--paginate", endpoint, "--jq", ".branch_policies[]")
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// ListEnvironments lists the deployment environments of a repository.
+func (c *CLIProvider) ListEnvironments(ctx context.Context, owner, repo string, opts github.ListOptions) ([]*github.EnvironmentData, error) {
+	endpoint := paginate(fmt.Sprintf("repos/%s/%s/environments", owner, repo), opts)
+
+	result, err := c.command(ctx).Run("api", endpoint)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list environments")
+	}
+
+	var data struct {
+		Environments []map[string]interface{} `json:"environments"`
+	}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	envs := make([]*github.EnvironmentData, len(data.Environments))
+	for i, env := range data.Environments {
+		envs[i] = c.convertEnvironmentFromMap(env)
+	}
+
+	return envs, nil
+}
+
+// ListGists lists the gists of the authenticated user.
+func (c *CLIProvider) ListGists(ctx context.Context, opts github.ListOptions) ([]*github.GistData, error) {
+	result, err := c.command(ctx).Run("api", paginate("gists", opts))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list gists")
+	}
+
+	var data []map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	gists := make([]*github.GistData, len(data))
+	for i, item := range data {
+		gists[i] = c.convertGistFromMap(item)
+	}
+
+	return gists, nil
+}
+
+// ListIssues lists issues for a repository with optional filtering.
+func (c *CLIProvider) ListIssues(ctx context.Context, owner, repo string, opts github.ListIssuesOptions) ([]*github.IssueData, error) {
+	args := []string{"issue", "list", "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "number,title,body,state,author,labels,assignees,milestone,createdAt,updatedAt,closedAt,url"}
+
+	if opts.State != "" {
+		args = append(args, "--state", opts.State)
+	}
+	if len(opts.Labels) > 0 {
+		args = append(args, "--label", strings.Join(opts.Labels, ","))
+	}
+	if opts.Assignee != "" {
+		args = append(args, "--assignee", opts.Assignee)
+	}
+	if opts.PerPage > 0 {
+		args = append(args, "--limit", strconv.Itoa(opts.PerPage))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list issues")
+	}
+
+	var apiResp []map[string]interface{}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	issues := make([]*github.IssueData, 0, len(apiResp))
+	for _, item := range apiResp {
+		issue := c.convertIssueFromMap(item)
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+// ListLabels lists the labels defined in a repository.
+// If opts.Page is zero, every page is fetched with gh api --paginate.
+func (c *CLIProvider) ListLabels(ctx context.Context, owner, repo string, opts github.ListOptions) ([]*github.LabelData, error) {
+	if opts.Page == 0 {
+		labels := []*github.LabelData{}
+		err := c.stream(ctx, "failed to list labels", func(raw json.RawMessage) error {
+			var label github.LabelData
+			if err := json.Unmarshal(raw, &label); err != nil {
+				return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse label")
+			}
+			labels = append(labels, &label)
+			return nil
+		}, "api", "--paginate", streamEndpoint(fmt.Sprintf("repos/%s/%s/labels", owner, repo), url.Values{}, opts), "--jq", ".[]")
+		if err != nil {
+			return nil, err
+		}
+		return labels, nil
+	}
+
+	endpoint := paginate(fmt.Sprintf("repos/%s/%s/labels", owner, repo), opts)
+
+	result, err := c.command(ctx).Run("api", endpoint)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list labels")
+	}
+
+	var labels []*github.LabelData
+	if err := c.parseJSON(result, &labels); err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// ListMilestones lists the milestones in a repository.
+func (c *CLIProvider) ListMilestones(ctx context.Context, owner, repo string, opts github.ListMilestonesOptions) ([]*github.MilestoneData, error) {
+	args := []string{"api", "--method", "GET", paginate(fmt.Sprintf("repos/%s/%s/milestones", owner, repo), opts.ListOptions)}
+	if opts.State != "" {
+		args = append(args, "-f", "state="+opts.State)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list milestones")
+	}
+
+	var milestones []*github.MilestoneData
+	if err := c.parseJSON(result, &milestones); err != nil {
+		return nil, err
+	}
+
+	return milestones, nil
+}
+
+// ListNotifications lists notification threads of the authenticated user.
+func (c *CLIProvider) ListNotifications(ctx context.Context, opts github.ListNotificationsOptions) ([]*github.NotificationData, error) {
+	endpoint := "notifications"
+	if opts.Owner != "" {
+		endpoint = fmt.Sprintf("repos/%s/%s/notifications", opts.Owner, opts.Repo)
+	}
+
+	args := []string{"api", "--method", "GET", paginate(endpoint, opts.ListOptions)}
+	if opts.All {
+		args = append(args, "-F", "all=true")
+	}
+	if opts.Participating {
+		args = append(args, "-F", "participating=true")
+	}
+	if opts.Since != nil {
+		args = append(args, "-f", "since="+opts.Since.UTC().Format(time.RFC3339))
+	}
+	if opts.Before != nil {
+		args = append(args, "-f", "before="+opts.Before.UTC().Format(time.RFC3339))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list notifications")
+	}
+
+	var data []map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*github.NotificationData, len(data))
+	for i, item := range data {
+		notifications[i] = c.convertNotificationFromMap(item)
+	}
+
+	return notifications, nil
+}
+
+// ListPullRequests lists pull requests for a repository with optional filtering.
+func (c *CLIProvider) ListPullRequests(ctx context.Context, owner, repo string, opts github.ListPullRequestsOptions) ([]*github.PullRequestData, error) {
+	args := []string{"pr", "list", "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "number,title,body,state,author,headRefName,baseRefName,headRefOid,labels,isDraft,mergeable,mergedAt,createdAt,updatedAt,closedAt,url"}
+
+	if opts.State != "" {
+		args = append(args, "--state", opts.State)
+	}
+	if opts.Head != "" {
+		args = append(args, "--head", opts.Head)
+	}
+	if opts.Base != "" {
+		args = append(args, "--base", opts.Base)
+	}
+	if opts.PerPage > 0 {
+		args = append(args, "--limit", strconv.Itoa(opts.PerPage))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list pull requests")
+	}
+
+	var apiResp []map[string]interface{}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	prs := make([]*github.PullRequestData, 0, len(apiResp))
+	for _, item := range apiResp {
+		pr := c.convertPRFromMap(item)
+		prs = append(prs, pr)
+	}
+
+	return prs, nil
+}
+
+// ListRepositories lists repositories for the given owner.
+//
+// If PerPage is set without a Page, all pages are fetched with gh api
+// --paginate and decoded as they stream in.
+func (c *CLIProvider) ListRepositories(ctx context.Context, owner string, opts github.ListOptions) ([]*github.RepositoryData, error) {
+	var repos []*github.RepositoryData
+	collect := func(raw json.RawMessage) error {
+		var r struct {
+			ID            int64  `json:"id"`
+			Name          string `json:"name"`
+			FullName      string `json:"full_name"`
+			Description   string `json:"description"`
+			DefaultBranch string `json:"default_branch"`
+			Private       bool   `json:"private"`
+			Fork          bool   `json:"fork"`
+			Archived      bool   `json:"archived"`
+			CloneURL      string `json:"clone_url"`
+			SSHURL        string `json:"ssh_url"`
+			HTMLURL       string `json:"html_url"`
+			CreatedAt     string `json:"created_at"`
+			UpdatedAt     string `json:"updated_at"`
+			Owner         struct {
+				Login string `json:"login"`
+			} `json:"owner"`
+		}
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse repository")
+		}
+
+		repo := &github.RepositoryData{
+			ID:            r.ID,
+			Owner:         r.Owner.Login,
+			Name:          r.Name,
+			FullName:      r.FullName,
+			Description:   r.Description,
+			DefaultBranch: r.DefaultBranch,
+			Private:       r.Private,
+			Fork:          r.Fork,
+			Archived:      r.Archived,
+			CloneURL:      r.CloneURL,
+			SSHURL:        r.SSHURL,
+			HTMLURL:       r.HTMLURL,
+		}
+		if t, err := github.ParseGitHubTime(r.CreatedAt); err == nil {
+			repo.CreatedAt = t
+		}
+		if t, err := github.ParseGitHubTime(r.UpdatedAt); err == nil {
+			repo.UpdatedAt = t
+		}
+
+		repos = append(repos, repo)
+		return nil
+	}
+
+	list := func(endpoint string) error {
+		args := []string{"api", paginate(endpoint, opts), "--jq", ".[]"}
+		if opts.PerPage > 0 && opts.Page == 0 {
+			args = append(args, "--paginate")
+		}
+		repos = make([]*github.RepositoryData, 0)
+		return c.stream(ctx, "failed to list repositories", collect, args...)
+	}
+
+	if err := list(fmt.Sprintf("users/%s/repos", owner)); err != nil {
+		// Try as organization if user fails
+		if err := list(fmt.Sprintf("orgs/%s/repos", owner)); err != nil {
+			return nil, err
+		}
+	}
+
+	return repos, nil
+}
+
+// ListReviews lists reviews submitted on a pull request.
+func (c *CLIProvider) ListReviews(ctx context.Context, owner, repo string, number int, opts github.ListOptions) ([]*github.ReviewData, error) {
+	endpoint := paginate(fmt.Sprintf("repos/%s/%s/pulls/%d/reviews", owner, repo, number), opts)
+
+	result, err := c.command(ctx).Run("api", endpoint)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list reviews")
+	}
+
+	var apiResp []map[string]interface{}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	reviews := make([]*github.ReviewData, 0, len(apiResp))
+	for _, item := range apiResp {
+		reviews = append(reviews, c.convertReviewFromMap(item))
+	}
+
+	return reviews, nil
+}
+
+// ListSecretScanningAlerts lists all secret scanning alerts of a repository.
+func (c *CLIProvider) ListSecretScanningAlerts(ctx context.Context, owner, repo string, opts github.ListAlertsOptions) ([]*github.SecretScanningAlertData, error) {
+	alerts := []*github.SecretScanningAlertData{}
+	err := c.stream(ctx, "failed to list secret scanning alerts", func(raw json.RawMessage) error {
+		var data map[string]interface{}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse secret scanning alert")
+		}
+		alerts = append(alerts, c.convertSecretScanningAlertFromMap(data))
+		return nil
+	}, "api", "--paginate", alertsEndpoint(owner, repo, "secret-scanning", opts), "--jq", ".[]")
+	if err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// ListSecrets lists the secrets in the given scope.
+// gh returns all secrets at once, so pagination options are ignored.
+func (c *CLIProvider) ListSecrets(ctx context.Context, scope github.SecretScope, _ github.ListOptions) ([]*github.SecretData, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+
+	fields := "name,updatedAt"
+	if scope.IsOrganization() {
+		fields += ",visibility"
+	}
+
+	args := append([]string{"secret", "list", "--json", fields}, secretScopeArgs(scope)...)
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list secrets")
+	}
+
+	var items []struct {
+		Name       string `json:"name"`
+		Visibility string `json:"visibility"`
+		UpdatedAt  string `json:"updatedAt"`
+	}
+	if err := c.parseJSON(result, &items); err != nil {
+		return nil, err
+	}
+
+	secrets := make([]*github.SecretData, 0, len(items))
+	for _, item := range items {
+		secret := &github.SecretData{
+			Name:       item.Name,
+			Visibility: strings.ToLower(item.Visibility),
+		}
+		if t, err := github.ParseGitHubTime(item.UpdatedAt); err == nil {
+			secret.UpdatedAt = t
+		}
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, nil
+}
+
+// ListTags lists the tags of a repository.
+func (c *CLIProvider) ListTags(ctx context.Context, owner, repo string, opts github.ListOptions) ([]*github.TagData, error) {
+	result, err := c.command(ctx).Run("api", paginate(fmt.Sprintf("repos/%s/%s/tags", owner, repo), opts))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list tags")
+	}
+
+	var data []map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	tags := make([]*github.TagData, len(data))
+	for i, item := range data {
+		tags[i] = c.convertTagFromMap(item)
+	}
+
+	return tags, nil
+}
+
+// ListTopReferrers lists the top 10 referrers of a repository over the last 14 days.
+func (c *CLIProvider) ListTopReferrers(ctx context.Context, owner, repo string) ([]*github.ReferrerData, error) {
+	result, err := c.command(ctx).Run("api", fmt.Sprintf("repos/%s/%s/traffic/popular/referrers", owner, repo))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list top referrers")
+	}
+
+	var referrers []*github.ReferrerData
+	if err := c.parseJSON(result, &referrers); err != nil {
+		return nil, err
+	}
+
+	return referrers, nil
+}
+
+// ListVariables lists the configuration variables in the given scope.
+// gh returns all variables at once, so pagination options are ignored.
+func (c *CLIProvider) ListVariables(ctx context.Context, scope github.SecretScope, _ github.ListOptions) ([]*github.VariableData, error) {
+	if err := scope.ValidateForVariables(); err != nil {
+		return nil, err
+	}
+
+	fields := "name,value,createdAt,updatedAt"
+	if scope.IsOrganization() {
+		fields += ",visibility"
+	}
+
+	args := append([]string{"variable", "list", "--json", fields}, secretScopeArgs(scope)...)
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list variables")
+	}
+
+	var items []struct {
+		Name       string `json:"name"`
+		Value      string `json:"value"`
+		Visibility string `json:"visibility"`
+		CreatedAt  string `json:"createdAt"`
+		UpdatedAt  string `json:"updatedAt"`
+	}
+	if err := c.parseJSON(result, &items); err != nil {
+		return nil, err
+	}
+
+	variables := make([]*github.VariableData, 0, len(items))
+	for _, item := range items {
+		variable := &github.VariableData{
+			Name:       item.Name,
+			Value:      item.Value,
+			Visibility: strings.ToLower(item.Visibility),
+		}
+		if t, err := github.ParseGitHubTime(item.CreatedAt); err == nil {
+			variable.CreatedAt = t
+		}
+		if t, err := github.ParseGitHubTime(item.UpdatedAt); err == nil {
+			variable.UpdatedAt = t
+		}
+		variables = append(variables, variable)
+	}
+
+	return variables, nil
+}
+
+// ListWorkflowRuns lists workflow runs for a repository with optional filtering.
+func (c *CLIProvider) ListWorkflowRuns(ctx context.Context, owner, repo string, opts github.ListWorkflowRunsOptions) ([]*github.WorkflowRunData, error) {
+	args := []string{"run", "list", "--repo", fmt.Sprintf("%s/%s", owner, repo), "--json", "databaseId,name,workflowDatabaseId,status,conclusion,headBranch,headSha,number,event,createdAt,updatedAt,url"}
+
+	if opts.Branch != "" {
+		args = append(args, "--branch", opts.Branch)
+	}
+	if opts.Status != "" {
+		// gh run list uses different status names
+		// Map our status constants to gh CLI status
+		switch opts.Status {
+		case github.WorkflowStatusCompleted:
+			args = append(args, "--status", "completed")
+		case github.WorkflowStatusInProgress:
+			args = append(args, "--status", "in_progress")
+		case github.WorkflowStatusQueued:
+			args = append(args, "--status", "queued")
+		}
+	}
+	if opts.PerPage > 0 {
+		args = append(args, "--limit", strconv.Itoa(opts.PerPage))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to list workflow runs")
+	}
+
+	var apiResp []map[string]interface{}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	runs := make([]*github.WorkflowRunData, 0, len(apiResp))
+	for _, item := range apiResp {
+		run := c.convertWorkflowRunFromMap(item)
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// MarkNotificationsRead marks all notifications updated at or before lastReadAt as read.
+func (c *CLIProvider) MarkNotificationsRead(ctx context.Context, lastReadAt time.Time) error {
+	result, err := c.command(ctx).Run("api", "--method", "PUT", "notifications", "-f", "last_read_at="+lastReadAt.UTC().Format(time.RFC3339))
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to mark notifications as read")
+	}
+
+	return nil
+}
+
+// MarkThreadRead marks a notification thread as read.
+func (c *CLIProvider) MarkThreadRead(ctx context.Context, threadID string) error {
+	result, err := c.command(ctx).Run("api", "--method", "PATCH", fmt.Sprintf("notifications/threads/%s", threadID))
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to mark thread as read")
+	}
+
+	return nil
+}
+
+// MergePullRequest merges a pull request.
+func (c *CLIProvider) MergePullRequest(ctx context.Context, owner, repo string, number int, opts github.MergePullRequestOptions) error {
+	args := []string{"pr", "merge", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo)}
+
+	// Map merge method
+	switch opts.MergeMethod {
+	case github.MergeMethodSquash:
+		args = append(args, "--squash")
+	case github.MergeMethodRebase:
+		args = append(args, "--rebase")
+	case github.MergeMethodMerge, "":
+		args = append(args, "--merge")
+	}
+
+	// gh pr merge doesn't support custom commit messages in the same way
+	// We'll use auto-merge behavior
+
+	result, err := c.command(ctx).Run(args...)
+
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to merge pull request")
+	}
+
+	return nil
+}
+
+// QueryGraphQL executes a query or mutation against the GitHub GraphQL API.
+//
+// Variables are passed to gh as request fields, so only scalar values and
+// string slices are supported.
+func (c *CLIProvider) QueryGraphQL(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error {
+	args := []string{"api", "graphql", "-f", "query=" + query}
+
+	// Sort variable names so the generated command is deterministic
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fields, err := graphQLFields(name, vars[name])
+		if err != nil {
+			return err
+		}
+		args = append(args, fields...)
+	}
+
+	res, err := c.command(ctx).Run(args...)
+	if err != nil {
+		// gh exits non-zero when the response contains GraphQL errors but
+		// still prints the response, which carries more precise error types.
+		if res != nil && strings.HasPrefix(strings.TrimSpace(res.Stdout), "{") {
+			if gqlErr := github.DecodeGraphQLResponse([]byte(res.Stdout), nil); gqlErr != nil {
+				return gqlErr
+			}
+		}
+		return c.wrapCLIError(err, res, "failed to execute GraphQL query")
+	}
+
+	return github.DecodeGraphQLResponse([]byte(res.Stdout), result)
+}
+
+// RemoveLabel removes a label from an issue.
+func (c *CLIProvider) RemoveLabel(ctx context.Context, owner, repo string, number int, label string) error {
+	result, err := c.command(ctx).Run("issue", "edit", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo), "--remove-label", label)
+
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to remove label")
+	}
+
+	return nil
+}
+
+// RequestReviewers requests reviews from users and/or teams.
+func (c *CLIProvider) RequestReviewers(ctx context.Context, owner, repo string, number int, opts github.RequestReviewersOptions) error {
+	args := []string{"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number)}
+
+	for _, reviewer := range opts.Reviewers {
+		args = append(args, "-f", "reviewers[]="+reviewer)
+	}
+	for _, team := range opts.TeamReviewers {
+		args = append(args, "-f", "team_reviewers[]="+team)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to request reviewers")
+	}
+
+	return nil
+}
+
+// SetSecret creates or updates an encrypted secret in the given scope.
+// gh encrypts the value with the scope's public key before sending it.
+func (c *CLIProvider) SetSecret(ctx context.Context, scope github.SecretScope, opts github.SetSecretOptions) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+
+	args := append([]string{"secret", "set", opts.Name, "--body", opts.Value}, secretScopeArgs(scope)...)
+	if scope.IsOrganization() && opts.Visibility != "" {
+		args = append(args, "--visibility", opts.Visibility)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to set secret")
+	}
+
+	// gh selects repositories by name, so repository IDs are set through the API
+	if scope.IsOrganization() && len(opts.SelectedRepositoryIDs) > 0 {
+		app := scope.App
+		if app == "" {
+			app = github.SecretAppActions
+		}
+		endpoint := fmt.Sprintf("orgs/%s/%s/secrets/%s/repositories", scope.Owner, app, opts.Name)
+		return c.setSelectedRepositories(ctx, endpoint, opts.SelectedRepositoryIDs, "failed to set secret repositories")
+	}
+
+	return nil
+}
+
+// SetThreadSubscription subscribes to or ignores a notification thread.
+func (c *CLIProvider) SetThreadSubscription(ctx context.Context, threadID string, ignored bool) (*github.ThreadSubscriptionData, error) {
+	endpoint := fmt.Sprintf("notifications/threads/%s/subscription", threadID)
+	result, err := c.command(ctx).Run("api", "--method", "PUT", endpoint, "-F", "ignored="+strconv.FormatBool(ignored))
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to set thread subscription")
+	}
+
+	var subscription github.ThreadSubscriptionData
+	if err := c.parseJSON(result, &subscription); err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+// SetVariable creates or updates a configuration variable in the given scope.
+func (c *CLIProvider) SetVariable(ctx context.Context, scope github.SecretScope, opts github.SetVariableOptions) error {
+	if err := scope.ValidateForVariables(); err != nil {
+		return err
+	}
+
+	args := append([]string{"variable", "set", opts.Name, "--body", opts.Value}, secretScopeArgs(scope)...)
+	if scope.IsOrganization() && opts.Visibility != "" {
+		args = append(args, "--visibility", opts.Visibility)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to set variable")
+	}
+
+	// gh selects repositories by name, so repository IDs are set through the API
+	if scope.IsOrganization() && len(opts.SelectedRepositoryIDs) > 0 {
+		endpoint := fmt.Sprintf("orgs/%s/actions/variables/%s/repositories", scope.Owner, opts.Name)
+		return c.setSelectedRepositories(ctx, endpoint, opts.SelectedRepositoryIDs, "failed to set variable repositories")
+	}
+
+	return nil
+}
+
+// SubmitReview creates and submits a review on a pull request.
+func (c *CLIProvider) SubmitReview(ctx context.Context, owner, repo string, number int, opts github.SubmitReviewOptions) (*github.ReviewData, error) {
+	args := []string{"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/pulls/%d/reviews", owner, repo, number), "-f", "event=" + opts.Event}
+
+	if opts.Body != "" {
+		args = append(args, "-f", "body="+opts.Body)
+	}
+	if opts.CommitID != "" {
+		args = append(args, "-f", "commit_id="+opts.CommitID)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to submit review")
+	}
+
+	var apiResp map[string]interface{}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	return c.convertReviewFromMap(apiResp), nil
+}
+
+// TransferRepository transfers a repository to a new owner.
+func (c *CLIProvider) TransferRepository(ctx context.Context, owner, repo string, opts github.TransferRepositoryOptions) (*github.RepositoryData, error) {
+	args := []string{
+		"api", "--method", "POST", fmt.Sprintf("repos/%s/%s/transfer", owner, repo),
+		"-f", "new_owner=" + opts.NewOwner,
+	}
+	if opts.NewName != "" {
+		args = append(args, "-f", "new_name="+opts.NewName)
+	}
+	for _, id := range opts.TeamIDs {
+		args = append(args, "-F", "team_ids[]="+strconv.FormatInt(id, 10))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to transfer repository")
+	}
+
+	return c.parseRepositoryFromJSON(result)
+}
+
+// TriggerWorkflow manually triggers a workflow run.
+func (c *CLIProvider) TriggerWorkflow(ctx context.Context, owner, repo, workflowFileName string, ref string, inputs map[string]interface{}) error {
+	args := []string{"workflow", "run", workflowFileName, "--repo", fmt.Sprintf("%s/%s", owner, repo), "--ref", ref}
+
+	// Add inputs as field arguments
+	for key, value := range inputs {
+		args = append(args, "-f", fmt.Sprintf("%s=%v", key, value))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+
+	if err != nil {
+		return c.wrapCLIError(err, result, "failed to trigger workflow")
+	}
+
+	return nil
+}
+
+// UpdateComment replaces the body of an existing comment.
+func (c *CLIProvider) UpdateComment(ctx context.Context, owner, repo string, commentID int64, body string) (*github.CommentData, error) {
+	result, err := c.command(ctx).Run("api", "--method", "PATCH", fmt.Sprintf("repos/%s/%s/issues/comments/%d", owner, repo, commentID), "-f", "body="+body)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to update comment")
+	}
+
+	return c.parseCommentFromJSON(result)
+}
+
+// UpdateGist updates the description and files of a gist.
+func (c *CLIProvider) UpdateGist(ctx context.Context, id string, opts github.UpdateGistOptions) (*github.GistData, error) {
+	args := []string{"api", "--method", "PATCH", "gists/" + id}
+	if opts.Description != nil {
+		args = append(args, "-f", "description="+*opts.Description)
+	}
+	args = append(args, gistFileFields(opts.Files)...)
+	for _, name := range opts.DeleteFiles {
+		args = append(args, "-F", fmt.Sprintf("files[%s]=null", name))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to update gist")
+	}
+
+	var data map[string]interface{}
+	if err := c.parseJSON(result, &data); err != nil {
+		return nil, err
+	}
+
+	return c.convertGistFromMap(data), nil
+}
+
+// UpdateIssue updates an existing issue.
+func (c *CLIProvider) UpdateIssue(ctx context.Context, owner, repo string, number int, opts github.UpdateIssueOptions) (*github.IssueData, error) {
+	args := []string{"issue", "edit", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo)}
+
+	if opts.Title != nil {
+		args = append(args, "--title", *opts.Title)
+	}
+	if opts.Body != nil {
+		args = append(args, "--body", *opts.Body)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to update issue")
+	}
+
+	// Fetch updated issue
+	return c.GetIssue(ctx, owner, repo, number)
+}
+
+// UpdateLabel updates a label's name, color, or description.
+func (c *CLIProvider) UpdateLabel(ctx context.Context, owner, repo, name string, opts github.UpdateLabelOptions) (*github.LabelData, error) {
+	args := []string{"api", "--method", "PATCH", fmt.Sprintf("repos/%s/%s/labels/%s", owner, repo, url.PathEscape(name))}
+	if opts.NewName != nil {
+		args = append(args, "-f", "new_name="+*opts.NewName)
+	}
+	if opts.Color != nil {
+		args = append(args, "-f", "color="+*opts.Color)
+	}
+	if opts.Description != nil {
+		args = append(args, "-f", "description="+*opts.Description)
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to update label")
+	}
+
+	var label github.LabelData
+	if err := c.parseJSON(result, &label); err != nil {
+		return nil, err
+	}
+
+	return &label, nil
+}
+
+// UpdateMilestone updates a milestone's title, description, state, or due date.
+func (c *CLIProvider) UpdateMilestone(ctx context.Context, owner, repo string, number int, opts github.UpdateMilestoneOptions) (*github.MilestoneData, error) {
+	args := []string{"api", "--method", "PATCH", fmt.Sprintf("repos/%s/%s/milestones/%d", owner, repo, number)}
+	if opts.Title != nil {
+		args = append(args, "-f", "title="+*opts.Title)
+	}
+	if opts.Description != nil {
+		args = append(args, "-f", "description="+*opts.Description)
+	}
+	if opts.State != nil {
+		args = append(args, "-f", "state="+*opts.State)
+	}
+	if opts.DueOn != nil {
+		args = append(args, "-f", "due_on="+opts.DueOn.UTC().Format(time.RFC3339))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to update milestone")
+	}
+
+	var milestone github.MilestoneData
+	if err := c.parseJSON(result, &milestone); err != nil {
+		return nil, err
+	}
+
+	return &milestone, nil
+}
+
+// UpdatePullRequest updates an existing pull request.
+func (c *CLIProvider) UpdatePullRequest(ctx context.Context, owner, repo string, number int, opts github.UpdatePullRequestOptions) (*github.PullRequestData, error) {
+	args := []string{"pr", "edit", strconv.Itoa(number), "--repo", fmt.Sprintf("%s/%s", owner, repo)}
+
+	if opts.Title != nil {
+		args = append(args, "--title", *opts.Title)
 	}
 	if opts.Body != nil {
 		args = append(args, "--body", *opts.Body)
@@ -617,14 +1936,528 @@ func (c *CLIProvider) UpdatePullRequest(ctx context.Context, owner, repo string,
 		args = append(args, "--base", *opts.Base)
 	}
 
-	result, err := c.wrapper.Clone().WithContext(ctx).Run(args...)
+	result, err := c.command(ctx).Run(args...)
+
+	if err != nil {
+		return nil, c.wrapCLIError(err, result, "failed to update pull request")
+	}
+
+	// Fetch updated PR
+	return c.GetPullRequest(ctx, owner, repo, number)
+}
+
+// command returns a gh invocation bound to ctx. A configured hostname is
+// passed through GH_HOST, which gh honors for API requests as well as for
+// OWNER/REPO arguments.
+func (c *CLIProvider) command(ctx context.Context) exec.Executor {
+	cmd := c.wrapper.Clone().WithContext(ctx)
+	if c.hostname != "" {
+		cmd = cmd.WithEnv(map[string]string{"GH_HOST": c.hostname})
+	}
+	return cmd
+}
+
+// convertArtifactFromMap converts a map from the GitHub REST API to ArtifactData.
+func (c *CLIProvider) convertArtifactFromMap(data map[string]interface{}) *github.ArtifactData {
+	artifact := &github.ArtifactData{}
+
+	if v, ok := data["id"].(float64); ok {
+		artifact.ID = int64(v)
+	}
+	if v, ok := data["name"].(string); ok {
+		artifact.Name = v
+	}
+	if v, ok := data["size_in_bytes"].(float64); ok {
+		artifact.SizeInBytes = int64(v)
+	}
+	if v, ok := data["expired"].(bool); ok {
+		artifact.Expired = v
+	}
+	if v, ok := data["archive_download_url"].(string); ok {
+		artifact.ArchiveDownloadURL = v
+	}
+
+	// Parse workflow run
+	if run, ok := data["workflow_run"].(map[string]interface{}); ok {
+		if id, ok := run["id"].(float64); ok {
+			artifact.RunID = int64(id)
+		}
+	}
+
+	// Parse timestamps
+	if v, ok := data["created_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			artifact.CreatedAt = t
+		}
+	}
+	if v, ok := data["expires_at"].(string); ok && v != "" {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			artifact.ExpiresAt = &t
+		}
+	}
+
+	return artifact
+}
+
+// convertCodeScanningAlertFromMap converts a map from the GitHub REST API to CodeScanningAlertData.
+func (c *CLIProvider) convertCodeScanningAlertFromMap(data map[string]interface{}) *github.CodeScanningAlertData {
+	alert := &github.CodeScanningAlertData{}
+
+	if v, ok := data["number"].(float64); ok {
+		alert.Number = int(v)
+	}
+	if v, ok := data["state"].(string); ok {
+		alert.State = v
+	}
+	if v, ok := data["dismissed_reason"].(string); ok {
+		alert.DismissedReason = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		alert.HTMLURL = v
+	}
+
+	// Parse rule and tool
+	if rule, ok := data["rule"].(map[string]interface{}); ok {
+		if v, ok := rule["id"].(string); ok {
+			alert.RuleID = v
+		}
+		if v, ok := rule["description"].(string); ok {
+			alert.RuleDescription = v
+		}
+		if v, ok := rule["severity"].(string); ok {
+			alert.Severity = v
+		}
+		if v, ok := rule["security_severity_level"].(string); ok {
+			alert.SecuritySeverity = v
+		}
+	}
+	if tool, ok := data["tool"].(map[string]interface{}); ok {
+		if v, ok := tool["name"].(string); ok {
+			alert.Tool = v
+		}
+	}
+
+	// Parse location of the most recent instance
+	if instance, ok := data["most_recent_instance"].(map[string]interface{}); ok {
+		if v, ok := instance["ref"].(string); ok {
+			alert.Ref = v
+		}
+		if location, ok := instance["location"].(map[string]interface{}); ok {
+			if v, ok := location["path"].(string); ok {
+				alert.Path = v
+			}
+			if v, ok := location["start_line"].(float64); ok {
+				alert.StartLine = int(v)
+			}
+		}
+	}
+
+	// Parse timestamps
+	if v, ok := data["created_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.CreatedAt = t
+		}
+	}
+	if v, ok := data["updated_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.UpdatedAt = t
+		}
+	}
+	if v, ok := data["dismissed_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.DismissedAt = &t
+		}
+	}
+	if v, ok := data["fixed_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.FixedAt = &t
+		}
+	}
+
+	return alert
+}
+
+// convertCommentFromMap converts a map from the GitHub REST API to CommentData.
+func (c *CLIProvider) convertCommentFromMap(data map[string]interface{}) *github.CommentData {
+	comment := &github.CommentData{}
+
+	if v, ok := data["id"].(float64); ok {
+		comment.ID = int64(v)
+	}
+	if v, ok := data["body"].(string); ok {
+		comment.Body = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		comment.HTMLURL = v
+	}
+
+	// Parse author
+	if user, ok := data["user"].(map[string]interface{}); ok {
+		if login, ok := user["login"].(string); ok {
+			comment.Author = login
+		}
+	}
+
+	// Parse timestamps
+	if v, ok := data["created_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			comment.CreatedAt = t
+		}
+	}
+	if v, ok := data["updated_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			comment.UpdatedAt = t
+		}
+	}
+
+	return comment
+}
+
+// convertCommitFromMap converts a map from the GitHub REST API to CommitData.
+func (c *CLIProvider) convertCommitFromMap(data map[string]interface{}) *github.CommitData {
+	commit := &github.CommitData{
+		Parents: []string{},
+	}
+
+	if v, ok := data["sha"].(string); ok {
+		commit.SHA = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		commit.HTMLURL = v
+	}
+
+	// Parse GitHub accounts, which are null for unlinked emails
+	if author, ok := data["author"].(map[string]interface{}); ok {
+		if login, ok := author["login"].(string); ok {
+			commit.Author = login
+		}
+	}
+	if committer, ok := data["committer"].(map[string]interface{}); ok {
+		if login, ok := committer["login"].(string); ok {
+			commit.Committer = login
+		}
+	}
+
+	// Parse parents
+	if parents, ok := data["parents"].([]interface{}); ok {
+		for _, item := range parents {
+			if parent, ok := item.(map[string]interface{}); ok {
+				if sha, ok := parent["sha"].(string); ok {
+					commit.Parents = append(commit.Parents, sha)
+				}
+			}
+		}
+	}
+
+	// Parse git commit details
+	if details, ok := data["commit"].(map[string]interface{}); ok {
+		if v, ok := details["message"].(string); ok {
+			commit.Message = v
+		}
+		if author, ok := details["author"].(map[string]interface{}); ok {
+			if v, ok := author["name"].(string); ok {
+				commit.AuthorName = v
+			}
+			if v, ok := author["email"].(string); ok {
+				commit.AuthorEmail = v
+			}
+			if v, ok := author["date"].(string); ok {
+				if t, err := github.ParseGitHubTime(v); err == nil {
+					commit.AuthoredAt = t
+				}
+			}
+		}
+		if committer, ok := details["committer"].(map[string]interface{}); ok {
+			if v, ok := committer["date"].(string); ok {
+				if t, err := github.ParseGitHubTime(v); err == nil {
+					commit.CommittedAt = t
+				}
+			}
+		}
+	}
+
+	return commit
+}
+
+// convertContributorStatsFromMap converts a map to ContributorStatsData.
+func (c *CLIProvider) convertContributorStatsFromMap(data map[string]interface{}) *github.ContributorStatsData {
+	stats := &github.ContributorStatsData{}
+
+	if v, ok := data["total"].(float64); ok {
+		stats.Total = int(v)
+	}
+
+	// Parse author
+	if author, ok := data["author"].(map[string]interface{}); ok {
+		if login, ok := author["login"].(string); ok {
+			stats.Author = login
+		}
+	}
+
+	// Parse weeks (abbreviated keys, week start as a Unix timestamp)
+	if weeks, ok := data["weeks"].([]interface{}); ok {
+		for _, item := range weeks {
+			week, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			weekData := &github.ContributorWeekData{}
+			if v, ok := week["w"].(float64); ok {
+				weekData.Week = time.Unix(int64(v), 0).UTC()
+			}
+			if v, ok := week["a"].(float64); ok {
+				weekData.Additions = int(v)
+			}
+			if v, ok := week["d"].(float64); ok {
+				weekData.Deletions = int(v)
+			}
+			if v, ok := week["c"].(float64); ok {
+				weekData.Commits = int(v)
+			}
+			stats.Weeks = append(stats.Weeks, weekData)
+		}
+	}
+
+	return stats
+}
+
+// convertDependabotAlertFromMap converts a map from the GitHub REST API to DependabotAlertData.
+func (c *CLIProvider) convertDependabotAlertFromMap(data map[string]interface{}) *github.DependabotAlertData {
+	alert := &github.DependabotAlertData{}
+
+	if v, ok := data["number"].(float64); ok {
+		alert.Number = int(v)
+	}
+	if v, ok := data["state"].(string); ok {
+		alert.State = v
+	}
+	if v, ok := data["dismissed_reason"].(string); ok {
+		alert.DismissedReason = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		alert.HTMLURL = v
+	}
+
+	// Parse dependency
+	if dependency, ok := data["dependency"].(map[string]interface{}); ok {
+		if pkg, ok := dependency["package"].(map[string]interface{}); ok {
+			if v, ok := pkg["name"].(string); ok {
+				alert.Package = v
+			}
+			if v, ok := pkg["ecosystem"].(string); ok {
+				alert.Ecosystem = v
+			}
+		}
+		if v, ok := dependency["manifest_path"].(string); ok {
+			alert.ManifestPath = v
+		}
+	}
+
+	// Parse advisory and affected versions
+	if advisory, ok := data["security_advisory"].(map[string]interface{}); ok {
+		if v, ok := advisory["ghsa_id"].(string); ok {
+			alert.GHSAID = v
+		}
+		if v, ok := advisory["cve_id"].(string); ok {
+			alert.CVEID = v
+		}
+		if v, ok := advisory["summary"].(string); ok {
+			alert.Summary = v
+		}
+		if v, ok := advisory["severity"].(string); ok {
+			alert.Severity = v
+		}
+	}
+	if vulnerability, ok := data["security_vulnerability"].(map[string]interface{}); ok {
+		if v, ok := vulnerability["vulnerable_version_range"].(string); ok {
+			alert.VulnerableVersionRange = v
+		}
+		if patched, ok := vulnerability["first_patched_version"].(map[string]interface{}); ok {
+			if v, ok := patched["identifier"].(string); ok {
+				alert.PatchedVersion = v
+			}
+		}
+	}
+
+	// Parse timestamps
+	if v, ok := data["created_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.CreatedAt = t
+		}
+	}
+	if v, ok := data["updated_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.UpdatedAt = t
+		}
+	}
+	if v, ok := data["dismissed_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.DismissedAt = &t
+		}
+	}
+	if v, ok := data["fixed_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.FixedAt = &t
+		}
+	}
+
+	return alert
+}
+
+// convertEnvironmentFromMap converts a map from the GitHub REST API to EnvironmentData.
+// Wait timers and reviewers are reported as protection rules.
+func (c *CLIProvider) convertEnvironmentFromMap(data map[string]interface{}) *github.EnvironmentData {
+	env := &github.EnvironmentData{
+		Reviewers: []github.EnvironmentReviewer{},
+	}
+
+	if v, ok := data["id"].(float64); ok {
+		env.ID = int64(v)
+	}
+	if v, ok := data["name"].(string); ok {
+		env.Name = v
+	}
+	if v, ok := data["can_admins_bypass"].(bool); ok {
+		env.CanAdminsBypass = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		env.HTMLURL = v
+	}
+
+	// Parse branch policy, which is null when all branches can deploy
+	if policy, ok := data["deployment_branch_policy"].(map[string]interface{}); ok {
+		env.DeploymentBranchPolicy = &github.DeploymentBranchPolicy{}
+		if v, ok := policy["protected_branches"].(bool); ok {
+			env.DeploymentBranchPolicy.ProtectedBranches = v
+		}
+		if v, ok := policy["custom_branch_policies"].(bool); ok {
+			env.DeploymentBranchPolicy.CustomBranchPolicies = v
+		}
+	}
+
+	// Parse protection rules
+	if rules, ok := data["protection_rules"].([]interface{}); ok {
+		for _, item := range rules {
+			rule, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch rule["type"] {
+			case "wait_timer":
+				if v, ok := rule["wait_timer"].(float64); ok {
+					env.WaitTimer = int(v)
+				}
+			case "required_reviewers":
+				if v, ok := rule["prevent_self_review"].(bool); ok {
+					env.PreventSelfReview = v
+				}
+				reviewers, _ := rule["reviewers"].([]interface{})
+				for _, r := range reviewers {
+					required, ok := r.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					reviewer := github.EnvironmentReviewer{}
+					if v, ok := required["type"].(string); ok {
+						reviewer.Type = v
+					}
+					if details, ok := required["reviewer"].(map[string]interface{}); ok {
+						if v, ok := details["id"].(float64); ok {
+							reviewer.ID = int64(v)
+						}
+						if v, ok := details["login"].(string); ok {
+							reviewer.Name = v
+						} else if v, ok := details["slug"].(string); ok {
+							reviewer.Name = v
+						}
+					}
+					env.Reviewers = append(env.Reviewers, reviewer)
+				}
+			}
+		}
+	}
+
+	// Parse timestamps
+	if v, ok := data["created_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			env.CreatedAt = t
+		}
+	}
+	if v, ok := data["updated_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			env.UpdatedAt = t
+		}
+	}
+
+	return env
+}
+
+// convertGistFromMap converts a map from the GitHub REST API to GistData.
+func (c *CLIProvider) convertGistFromMap(data map[string]interface{}) *github.GistData {
+	gist := &github.GistData{
+		Files: map[string]*github.GistFileData{},
+	}
+
+	if v, ok := data["id"].(string); ok {
+		gist.ID = v
+	}
+	if v, ok := data["description"].(string); ok {
+		gist.Description = v
+	}
+	if v, ok := data["public"].(bool); ok {
+		gist.Public = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		gist.HTMLURL = v
+	}
+
+	// Parse owner
+	if owner, ok := data["owner"].(map[string]interface{}); ok {
+		if login, ok := owner["login"].(string); ok {
+			gist.Owner = login
+		}
+	}
+
+	// Parse files
+	if files, ok := data["files"].(map[string]interface{}); ok {
+		for name, item := range files {
+			file, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fileData := &github.GistFileData{Filename: name}
+			if v, ok := file["language"].(string); ok {
+				fileData.Language = v
+			}
+			if v, ok := file["size"].(float64); ok {
+				fileData.Size = int(v)
+			}
+			if v, ok := file["raw_url"].(string); ok {
+				fileData.RawURL = v
+			}
+			if v, ok := file["content"].(string); ok {
+				fileData.Content = v
+			}
+			if v, ok := file["truncated"].(bool); ok {
+				fileData.Truncated = v
+			}
+			gist.Files[name] = fileData
+		}
+	}
 
-	if err != nil {
-		return nil, c.wrapCLIError(err, result, "failed to update pull request")
+	// Parse timestamps
+	if v, ok := data["created_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			gist.CreatedAt = t
+		}
+	}
+	if v, ok := data["updated_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			gist.UpdatedAt = t
+		}
 	}
 
-	// Fetch updated PR
-	return c.GetPullRequest(ctx, owner, repo, number)
+	return gist
 }
 
 // convertIssueFromMap converts a map from gh CLI JSON to IssueData.
@@ -705,6 +2538,55 @@ func (c *CLIProvider) convertIssueFromMap(data map[string]interface{}) *github.I
 	return issue
 }
 
+// convertNotificationFromMap converts a map from the GitHub REST API to NotificationData.
+func (c *CLIProvider) convertNotificationFromMap(data map[string]interface{}) *github.NotificationData {
+	notification := &github.NotificationData{}
+
+	if v, ok := data["id"].(string); ok {
+		notification.ID = v
+	}
+	if v, ok := data["reason"].(string); ok {
+		notification.Reason = v
+	}
+	if v, ok := data["unread"].(bool); ok {
+		notification.Unread = v
+	}
+
+	// Parse repository
+	if repo, ok := data["repository"].(map[string]interface{}); ok {
+		if v, ok := repo["full_name"].(string); ok {
+			notification.Repository = v
+		}
+	}
+
+	// Parse subject
+	if subject, ok := data["subject"].(map[string]interface{}); ok {
+		if v, ok := subject["title"].(string); ok {
+			notification.SubjectTitle = v
+		}
+		if v, ok := subject["type"].(string); ok {
+			notification.SubjectType = v
+		}
+		if v, ok := subject["url"].(string); ok {
+			notification.SubjectURL = v
+		}
+	}
+
+	// Parse timestamps
+	if v, ok := data["updated_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			notification.UpdatedAt = t
+		}
+	}
+	if v, ok := data["last_read_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			notification.LastReadAt = &t
+		}
+	}
+
+	return notification
+}
+
 // convertPRFromMap converts a map from gh CLI JSON to PullRequestData.
 func (c *CLIProvider) convertPRFromMap(data map[string]interface{}) *github.PullRequestData {
 	pr := &github.PullRequestData{}
@@ -794,6 +2676,268 @@ func (c *CLIProvider) convertPRFromMap(data map[string]interface{}) *github.Pull
 	return pr
 }
 
+// convertRefFromMap converts a map to RefData.
+func (c *CLIProvider) convertRefFromMap(data map[string]interface{}) *github.RefData {
+	ref := &github.RefData{}
+
+	if v, ok := data["ref"].(string); ok {
+		ref.Ref = v
+	}
+
+	// Parse target object
+	if object, ok := data["object"].(map[string]interface{}); ok {
+		if v, ok := object["sha"].(string); ok {
+			ref.SHA = v
+		}
+		if v, ok := object["type"].(string); ok {
+			ref.Type = v
+		}
+	}
+
+	return ref
+}
+
+// convertReviewCommentFromMap converts a map from the GitHub REST API to ReviewCommentData.
+func (c *CLIProvider) convertReviewCommentFromMap(data map[string]interface{}) *github.ReviewCommentData {
+	comment := &github.ReviewCommentData{}
+
+	if v, ok := data["id"].(float64); ok {
+		comment.ID = int64(v)
+	}
+	if v, ok := data["body"].(string); ok {
+		comment.Body = v
+	}
+	if v, ok := data["path"].(string); ok {
+		comment.Path = v
+	}
+	if v, ok := data["line"].(float64); ok {
+		comment.Line = int(v)
+	}
+	if v, ok := data["start_line"].(float64); ok {
+		comment.StartLine = int(v)
+	}
+	if v, ok := data["side"].(string); ok {
+		comment.Side = v
+	}
+	if v, ok := data["commit_id"].(string); ok {
+		comment.CommitID = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		comment.HTMLURL = v
+	}
+
+	// Parse author
+	if user, ok := data["user"].(map[string]interface{}); ok {
+		if login, ok := user["login"].(string); ok {
+			comment.Author = login
+		}
+	}
+
+	// Parse timestamps
+	if v, ok := data["created_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			comment.CreatedAt = t
+		}
+	}
+	if v, ok := data["updated_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			comment.UpdatedAt = t
+		}
+	}
+
+	return comment
+}
+
+// convertReviewFromMap converts a map from the GitHub REST API to ReviewData.
+func (c *CLIProvider) convertReviewFromMap(data map[string]interface{}) *github.ReviewData {
+	review := &github.ReviewData{}
+
+	if v, ok := data["id"].(float64); ok {
+		review.ID = int64(v)
+	}
+	if v, ok := data["body"].(string); ok {
+		review.Body = v
+	}
+	if v, ok := data["state"].(string); ok {
+		review.State = v
+	}
+	if v, ok := data["commit_id"].(string); ok {
+		review.CommitID = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		review.HTMLURL = v
+	}
+
+	// Parse author
+	if user, ok := data["user"].(map[string]interface{}); ok {
+		if login, ok := user["login"].(string); ok {
+			review.Author = login
+		}
+	}
+
+	// Parse timestamps
+	if v, ok := data["submitted_at"].(string); ok && v != "" {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			review.SubmittedAt = &t
+		}
+	}
+
+	return review
+}
+
+// convertSecretScanningAlertFromMap converts a map from the GitHub REST API to SecretScanningAlertData.
+// The secret value in the response is deliberately dropped.
+func (c *CLIProvider) convertSecretScanningAlertFromMap(data map[string]interface{}) *github.SecretScanningAlertData {
+	alert := &github.SecretScanningAlertData{}
+
+	if v, ok := data["number"].(float64); ok {
+		alert.Number = int(v)
+	}
+	if v, ok := data["state"].(string); ok {
+		alert.State = v
+	}
+	if v, ok := data["secret_type"].(string); ok {
+		alert.SecretType = v
+	}
+	if v, ok := data["secret_type_display_name"].(string); ok {
+		alert.SecretTypeDisplayName = v
+	}
+	if v, ok := data["resolution"].(string); ok {
+		alert.Resolution = v
+	}
+	if v, ok := data["push_protection_bypassed"].(bool); ok {
+		alert.PushProtectionBypassed = v
+	}
+	if v, ok := data["html_url"].(string); ok {
+		alert.HTMLURL = v
+	}
+
+	// Parse timestamps
+	if v, ok := data["created_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.CreatedAt = t
+		}
+	}
+	if v, ok := data["updated_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.UpdatedAt = t
+		}
+	}
+	if v, ok := data["resolved_at"].(string); ok {
+		if t, err := github.ParseGitHubTime(v); err == nil {
+			alert.ResolvedAt = &t
+		}
+	}
+
+	return alert
+}
+
+// convertTagFromMap converts a map to TagData.
+func (c *CLIProvider) convertTagFromMap(data map[string]interface{}) *github.TagData {
+	tag := &github.TagData{}
+
+	if v, ok := data["name"].(string); ok {
+		tag.Name = v
+	}
+	if v, ok := data["zipball_url"].(string); ok {
+		tag.ZipballURL = v
+	}
+	if v, ok := data["tarball_url"].(string); ok {
+		tag.TarballURL = v
+	}
+
+	// Parse commit
+	if commit, ok := data["commit"].(map[string]interface{}); ok {
+		if v, ok := commit["sha"].(string); ok {
+			tag.CommitSHA = v
+		}
+	}
+
+	return tag
+}
+
+// convertTagObjectFromMap converts a map to TagObjectData.
+func (c *CLIProvider) convertTagObjectFromMap(data map[string]interface{}) *github.TagObjectData {
+	tag := &github.TagObjectData{}
+
+	if v, ok := data["sha"].(string); ok {
+		tag.SHA = v
+	}
+	if v, ok := data["tag"].(string); ok {
+		tag.Tag = v
+	}
+	if v, ok := data["message"].(string); ok {
+		tag.Message = v
+	}
+
+	// Parse tagged object
+	if object, ok := data["object"].(map[string]interface{}); ok {
+		if v, ok := object["sha"].(string); ok {
+			tag.ObjectSHA = v
+		}
+		if v, ok := object["type"].(string); ok {
+			tag.ObjectType = v
+		}
+	}
+
+	// Parse tagger
+	if tagger, ok := data["tagger"].(map[string]interface{}); ok {
+		if v, ok := tagger["name"].(string); ok {
+			tag.TaggerName = v
+		}
+		if v, ok := tagger["email"].(string); ok {
+			tag.TaggerEmail = v
+		}
+		if v, ok := tagger["date"].(string); ok {
+			if t, err := github.ParseGitHubTime(v); err == nil {
+				tag.TaggedAt = t
+			}
+		}
+	}
+
+	return tag
+}
+
+// convertTrafficFromMap converts a map to TrafficData. key names the field
+// holding the points ("views" or "clones").
+func (c *CLIProvider) convertTrafficFromMap(data map[string]interface{}, key string) *github.TrafficData {
+	traffic := &github.TrafficData{
+		Points: []*github.TrafficPointData{},
+	}
+
+	if v, ok := data["count"].(float64); ok {
+		traffic.Count = int(v)
+	}
+	if v, ok := data["uniques"].(float64); ok {
+		traffic.Uniques = int(v)
+	}
+
+	// Parse points
+	if points, ok := data[key].([]interface{}); ok {
+		for _, item := range points {
+			point, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pointData := &github.TrafficPointData{}
+			if v, ok := point["timestamp"].(string); ok {
+				if t, err := github.ParseGitHubTime(v); err == nil {
+					pointData.Timestamp = t
+				}
+			}
+			if v, ok := point["count"].(float64); ok {
+				pointData.Count = int(v)
+			}
+			if v, ok := point["uniques"].(float64); ok {
+				pointData.Uniques = int(v)
+			}
+			traffic.Points = append(traffic.Points, pointData)
+		}
+	}
+
+	return traffic
+}
+
 // convertWorkflowJobFromMap converts a map from gh CLI JSON to WorkflowJobData.
 func (c *CLIProvider) convertWorkflowJobFromMap(data map[string]interface{}, runID int64) *github.WorkflowJobData {
 	job := &github.WorkflowJobData{
@@ -909,6 +3053,16 @@ func (c *CLIProvider) getErrorCodeFromResult(result *exec.Result) errors.ErrorCo
 	return errors.CodeExecutionFailed
 }
 
+// parseCommentFromJSON parses comment data from gh API JSON output.
+func (c *CLIProvider) parseCommentFromJSON(result *exec.Result) (*github.CommentData, error) {
+	var apiResp map[string]interface{}
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	return c.convertCommentFromMap(apiResp), nil
+}
+
 // parseIssueFromJSON parses issue data from gh CLI JSON output.
 func (c *CLIProvider) parseIssueFromJSON(result *exec.Result) (*github.IssueData, error) {
 	var apiResp map[string]interface{}
@@ -939,6 +3093,57 @@ func (c *CLIProvider) parsePRFromJSON(result *exec.Result) (*github.PullRequestD
 	return c.convertPRFromMap(apiResp), nil
 }
 
+// parseRepositoryFromJSON parses a repository from the GitHub REST API JSON output.
+func (c *CLIProvider) parseRepositoryFromJSON(result *exec.Result) (*github.RepositoryData, error) {
+	var apiResp struct {
+		ID            int64  `json:"id"`
+		Name          string `json:"name"`
+		FullName      string `json:"full_name"`
+		Description   string `json:"description"`
+		DefaultBranch string `json:"default_branch"`
+		Private       bool   `json:"private"`
+		Fork          bool   `json:"fork"`
+		Archived      bool   `json:"archived"`
+		CloneURL      string `json:"clone_url"`
+		SSHURL        string `json:"ssh_url"`
+		HTMLURL       string `json:"html_url"`
+		CreatedAt     string `json:"created_at"`
+		UpdatedAt     string `json:"updated_at"`
+		Owner         struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+	}
+
+	if err := c.parseJSON(result, &apiResp); err != nil {
+		return nil, err
+	}
+
+	data := &github.RepositoryData{
+		ID:            apiResp.ID,
+		Owner:         apiResp.Owner.Login,
+		Name:          apiResp.Name,
+		FullName:      apiResp.FullName,
+		Description:   apiResp.Description,
+		DefaultBranch: apiResp.DefaultBranch,
+		Private:       apiResp.Private,
+		Fork:          apiResp.Fork,
+		Archived:      apiResp.Archived,
+		CloneURL:      apiResp.CloneURL,
+		SSHURL:        apiResp.SSHURL,
+		HTMLURL:       apiResp.HTMLURL,
+	}
+
+	// Parse timestamps
+	if t, err := github.ParseGitHubTime(apiResp.CreatedAt); err == nil {
+		data.CreatedAt = t
+	}
+	if t, err := github.ParseGitHubTime(apiResp.UpdatedAt); err == nil {
+		data.UpdatedAt = t
+	}
+
+	return data, nil
+}
+
 // parseWorkflowRunFromJSON parses workflow run data from gh CLI JSON output.
 func (c *CLIProvider) parseWorkflowRunFromJSON(result *exec.Result) (*github.WorkflowRunData, error) {
 	var apiResp map[string]interface{}
@@ -987,6 +3192,22 @@ func (c *CLIProvider) parseWorkflowSteps(steps []interface{}) []github.WorkflowS
 	return result
 }
 
+// setSelectedRepositories replaces the repositories that can access an
+// organization secret or variable.
+func (c *CLIProvider) setSelectedRepositories(ctx context.Context, endpoint string, repoIDs []int64, message string) error {
+	args := []string{"api", "--method", "PUT", endpoint}
+	for _, id := range repoIDs {
+		args = append(args, "-F", "selected_repository_ids[]="+strconv.FormatInt(id, 10))
+	}
+
+	result, err := c.command(ctx).Run(args...)
+	if err != nil {
+		return c.wrapCLIError(err, result, message)
+	}
+
+	return nil
+}
+
 // wrapCLIError wraps CLI execution errors with appropriate error types.
 func (c *CLIProvider) wrapCLIError(err error, result *exec.Result, message string) error {
 	if err == nil {
@@ -1025,6 +3246,127 @@ func WithExecutor(executor exec.Executor) Option {
 	}
 }
 
+// WithHostname targets a GitHub Enterprise Server instance instead of
+// github.com. The host must already be authenticated with
+// "gh auth login --hostname".
+func WithHostname(hostname string) Option {
+	return func(p *CLIProvider) error {
+		if hostname == "" || strings.ContainsAny(hostname, "/:") {
+			err := errors.New(errors.CodeInvalidInput, "hostname must be a bare host name (e.g. github.example.com)")
+			return errors.WithContext(err, "field", "hostname")
+		}
+		p.hostname = hostname
+		return nil
+	}
+}
+
+// alertsEndpoint returns the REST endpoint for listing security alerts of
+// the given kind ("dependabot", "code-scanning", "secret-scanning").
+func alertsEndpoint(owner, repo, kind string, opts github.ListAlertsOptions) string {
+	endpoint := fmt.Sprintf("repos/%s/%s/%s/alerts?per_page=100", owner, repo, kind)
+	if opts.State != "" {
+		endpoint += "&state=" + url.QueryEscape(opts.State)
+	}
+	return endpoint
+}
+
+// deploymentBranchPoliciesEndpoint returns the REST endpoint for an
+// environment's deployment branch policies.
+func deploymentBranchPoliciesEndpoint(owner, repo, environment string) string {
+	return environmentEndpoint(owner, repo, environment) + "/deployment-branch-policies"
+}
+
+// environmentEndpoint returns the REST endpoint for a deployment environment.
+func environmentEndpoint(owner, repo, name string) string {
+	return fmt.Sprintf("repos/%s/%s/environments/%s", owner, repo, url.PathEscape(name))
+}
+
+// gistFileFields converts gist file contents into gh api field arguments,
+// sorted by file name so the generated command is deterministic.
+func gistFileFields(files map[string]string) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args := make([]string, 0, len(files)*2)
+	for _, name := range names {
+		args = append(args, "-f", fmt.Sprintf("files[%s][content]=%s", name, files[name]))
+	}
+	return args
+}
+
+// graphQLFields converts a GraphQL variable into gh api field arguments.
+// Strings are passed as raw fields and other scalars as typed fields.
+func graphQLFields(name string, value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return []string{"-F", name + "=null"}, nil
+	case string:
+		return []string{"-f", name + "=" + v}, nil
+	case bool:
+		return []string{"-F", name + "=" + strconv.FormatBool(v)}, nil
+	case int:
+		return []string{"-F", name + "=" + strconv.Itoa(v)}, nil
+	case int64:
+		return []string{"-F", name + "=" + strconv.FormatInt(v, 10)}, nil
+	case float64:
+		return []string{"-F", name + "=" + strconv.FormatFloat(v, 'f', -1, 64)}, nil
+	case []string:
+		fields := make([]string, 0, 2*len(v))
+		for _, item := range v {
+			fields = append(fields, "-f", name+"[]="+item)
+		}
+		return fields, nil
+	default:
+		err := errors.New(errors.CodeInvalidInput, fmt.Sprintf("unsupported GraphQL variable type %T", value))
+		return nil, errors.WithContext(err, "variable", name)
+	}
+}
+
+// paginate appends pagination query parameters to a gh api endpoint.
+func paginate(endpoint string, opts github.ListOptions) string {
+	params := make([]string, 0, 2)
+	if opts.PerPage > 0 {
+		params = append(params, fmt.Sprintf("per_page=%d", opts.PerPage))
+	}
+	if opts.Page > 0 {
+		params = append(params, fmt.Sprintf("page=%d", opts.Page))
+	}
+	if len(params) == 0 {
+		return endpoint
+	}
+	return endpoint + "?" + strings.Join(params, "&")
+}
+
+// secretScopeArgs converts a secret scope into gh secret and variable flags.
+func secretScopeArgs(scope github.SecretScope) []string {
+	var args []string
+	if scope.IsOrganization() {
+		args = append(args, "--org", scope.Owner)
+	} else {
+		args = append(args, "--repo", fmt.Sprintf("%s/%s", scope.Owner, scope.Repo))
+	}
+	if scope.Environment != "" {
+		args = append(args, "--env", scope.Environment)
+	}
+	if scope.IsDependabot() {
+		args = append(args, "--app", github.SecretAppDependabot)
+	}
+	return args
+}
+
+// trafficEndpoint returns the traffic endpoint of the given kind ("views" or
+// "clones") for a repository.
+func trafficEndpoint(owner, repo, kind string, opts github.TrafficOptions) string {
+	endpoint := fmt.Sprintf("repos/%s/%s/traffic/%s", owner, repo, kind)
+	if opts.Per != "" {
+		endpoint += "?per=" + url.QueryEscape(opts.Per)
+	}
+	return endpoint
+}
+
 // wrapAuthError wraps authentication errors from gh CLI.
 func wrapAuthError(err error, result *exec.Result) error {
 	authErr := errors.Wrap(err, errors.CodeUnauthorized, "gh CLI not authenticated")
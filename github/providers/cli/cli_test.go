@@ -5,6 +5,7 @@ import (
 	"context"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/jmgilman/go/errors"
 	"github.com/jmgilman/go/exec"
@@ -47,6 +48,9 @@ func setupMockExecutor(t *testing.T, runFunc func(args ...string) (*exec.Result,
 		WithPassthroughFunc: func() exec.Executor {
 			return mockExec
 		},
+		WithStreamingFunc: func() exec.Executor {
+			return mockExec
+		},
 		CloneFunc: func() exec.Executor {
 			return mockExec
 		},
@@ -105,6 +109,44 @@ func TestNewCLIProvider(t *testing.T) {
 		assert.Nil(t, provider)
 		assert.Equal(t, errors.CodeInvalidInput, errors.GetCode(err))
 	})
+
+	t.Run("targets hostname", func(t *testing.T) {
+
+		var calls [][]string
+		var envs []map[string]string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			calls = append(calls, args)
+			return &exec.Result{Stdout: "[]", ExitCode: 0}, nil
+		})
+		mock.WithEnvFunc = func(env map[string]string) exec.Executor {
+			envs = append(envs, env)
+			return mock
+		}
+
+		provider, err := NewCLIProvider(WithExecutor(mock), WithHostname("github.example.com"))
+		require.NoError(t, err)
+
+		_, err = provider.ListLabels(context.Background(), "owner", "repo", github.ListOptions{Page: 1})
+		require.NoError(t, err)
+
+		assert.Equal(t, [][]string{
+			{"gh", "auth", "status", "--hostname", "github.example.com"},
+			{"gh", "api", "repos/owner/repo/labels?page=1"},
+		}, calls)
+		assert.Equal(t, []map[string]string{
+			{"GH_HOST": "github.example.com"},
+			{"GH_HOST": "github.example.com"},
+		}, envs)
+	})
+
+	t.Run("fails with invalid hostname option", func(t *testing.T) {
+
+		provider, err := NewCLIProvider(WithHostname("https://github.example.com"))
+
+		assert.Error(t, err)
+		assert.Nil(t, provider)
+		assert.Equal(t, errors.CodeInvalidInput, errors.GetCode(err))
+	})
 }
 
 func TestCLIProvider_GetRepository(t *testing.T) {
@@ -239,6 +281,108 @@ func TestCLIProvider_GetRepository(t *testing.T) {
 	}
 }
 
+func TestCLIProvider_CreateRepositoryFromTemplate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "api" {
+				assert.Contains(t, args, "repos/testorg/template/generate")
+				assert.Contains(t, args, "owner=testorg")
+				assert.Contains(t, args, "name=newrepo")
+				assert.Contains(t, args, "private=true")
+				return &exec.Result{
+					Stdout:   `{"id": 456, "name": "newrepo", "full_name": "testorg/newrepo", "private": true, "owner": {"login": "testorg"}}`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		data, err := provider.CreateRepositoryFromTemplate(context.Background(), "testorg", "template", github.CreateRepositoryFromTemplateOptions{
+			Owner:   "testorg",
+			Name:    "newrepo",
+			Private: true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(456), data.ID)
+		assert.Equal(t, "testorg", data.Owner)
+		assert.Equal(t, "newrepo", data.Name)
+		assert.True(t, data.Private)
+	})
+}
+
+func TestCLIProvider_ForkRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "api" {
+				assert.Contains(t, args, "repos/upstream/testrepo/forks")
+				assert.Contains(t, args, "organization=testorg")
+				assert.NotContains(t, args, "name=")
+				return &exec.Result{
+					Stdout:   `{"id": 789, "name": "testrepo", "full_name": "testorg/testrepo", "fork": true, "owner": {"login": "testorg"}}`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		data, err := provider.ForkRepository(context.Background(), "upstream", "testrepo", github.ForkRepositoryOptions{
+			Organization: "testorg",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "testorg/testrepo", data.FullName)
+		assert.True(t, data.Fork)
+	})
+}
+
+func TestCLIProvider_TransferRepository(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "api" {
+				assert.Contains(t, args, "repos/testorg/testrepo/transfer")
+				assert.Contains(t, args, "new_owner=neworg")
+				assert.Contains(t, args, "team_ids[]=1")
+				assert.Contains(t, args, "team_ids[]=2")
+				return &exec.Result{
+					Stdout:   `{"id": 123, "name": "testrepo", "full_name": "neworg/testrepo", "owner": {"login": "neworg"}}`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		data, err := provider.TransferRepository(context.Background(), "testorg", "testrepo", github.TransferRepositoryOptions{
+			NewOwner: "neworg",
+			TeamIDs:  []int64{1, 2},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "neworg", data.Owner)
+	})
+}
+
 func TestCLIProvider_GetIssue(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 
@@ -356,34 +500,52 @@ func TestCLIProvider_CloseIssue(t *testing.T) {
 	})
 }
 
-func TestCLIProvider_GetPullRequest(t *testing.T) {
+func TestCLIProvider_CreateComment(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 
+		var gotArgs []string
 		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
 			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
 				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
 			}
-			if len(args) >= 2 && args[0] == "gh" && args[1] == "pr" {
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{
+					"id": 1001,
+					"user": {"login": "statusbot"},
+					"body": "Build started",
+					"html_url": "https://github.com/testorg/testrepo/issues/42#issuecomment-1001",
+					"created_at": "2023-01-01T00:00:00Z",
+					"updated_at": "2023-01-01T00:00:00Z"
+				}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		comment, err := provider.CreateComment(context.Background(), "testorg", "testrepo", 42, "Build started")
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "repos/testorg/testrepo/issues/42/comments")
+		assert.Contains(t, gotArgs, "body=Build started")
+		assert.Equal(t, int64(1001), comment.ID)
+		assert.Equal(t, "statusbot", comment.Author)
+		assert.Equal(t, "Build started", comment.Body)
+	})
+}
+
+func TestCLIProvider_ListComments(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 3 && args[0] == "gh" && args[1] == "api" && args[2] == "repos/testorg/testrepo/issues/42/comments" {
 				return &exec.Result{
-					Stdout: `{
-						"number": 10,
-						"title": "Test PR",
-						"body": "PR description",
-						"state": "OPEN",
-						"author": {"login": "developer"},
-						"headRefName": "feature",
-						"baseRefName": "main",
-						"headRefOid": "abc123",
-						"labels": [{"name": "enhancement"}],
-						"isDraft": false,
-						"mergeable": "MERGEABLE",
-						"merged": false,
-						"mergedAt": null,
-						"createdAt": "2023-01-01T00:00:00Z",
-						"updatedAt": "2023-01-02T00:00:00Z",
-						"closedAt": null,
-						"url": "https://github.com/testorg/testrepo/pull/10"
-					}`,
+					Stdout:   `[{"id": 1, "user": {"login": "alice"}, "body": "First"}, {"id": 2, "user": {"login": "statusbot"}, "body": "Build passed"}]`,
 					ExitCode: 0,
 				}, nil
 			}
@@ -393,65 +555,1683 @@ func TestCLIProvider_GetPullRequest(t *testing.T) {
 		provider, err := NewCLIProvider(WithExecutor(mock))
 		require.NoError(t, err)
 
-		data, err := provider.GetPullRequest(context.Background(), "testorg", "testrepo", 10)
+		comments, err := provider.ListComments(context.Background(), "testorg", "testrepo", 42, github.ListOptions{})
 
 		require.NoError(t, err)
+		require.Len(t, comments, 2)
+		assert.Equal(t, int64(2), comments[1].ID)
+		assert.Equal(t, "statusbot", comments[1].Author)
+	})
+}
 
-		assert.Equal(t, 10, data.Number)
-		assert.Equal(t, "Test PR", data.Title)
-		assert.Equal(t, "PR description", data.Body)
-		assert.Equal(t, "open", data.State)
-		assert.Equal(t, "developer", data.Author)
-		assert.Equal(t, "feature", data.HeadRef)
-		assert.Equal(t, "main", data.BaseRef)
-		assert.False(t, data.Draft)
-		assert.False(t, data.Merged)
-		assert.NotNil(t, data.Mergeable)
-		assert.True(t, *data.Mergeable)
+func TestCLIProvider_UpdateComment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{Stdout: `{"id": 1001, "body": "Build passed"}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		comment, err := provider.UpdateComment(context.Background(), "testorg", "testrepo", 1001, "Build passed")
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "PATCH")
+		assert.Contains(t, gotArgs, "repos/testorg/testrepo/issues/comments/1001")
+		assert.Equal(t, "Build passed", comment.Body)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{
+				Stderr:   "gh: Not Found (HTTP 404)",
+				ExitCode: 1,
+			}, errors.New(errors.CodeExecutionFailed, "exit status 1")
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		_, err = provider.UpdateComment(context.Background(), "testorg", "testrepo", 1001, "Build passed")
+
+		assert.Error(t, err)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
 	})
 }
 
-func TestCLIProvider_GetWorkflowRun(t *testing.T) {
+func TestCLIProvider_AddCommentReaction(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 
+		var gotArgs []string
 		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
 			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
 				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
 			}
-			if len(args) >= 2 && args[0] == "gh" && args[1] == "run" {
-				return &exec.Result{
-					Stdout: `{
-						"databaseId": 123456,
-						"name": "CI",
-						"workflowDatabaseId": 789,
-						"status": "COMPLETED",
-						"conclusion": "SUCCESS",
-						"headBranch": "main",
-						"headSha": "abc123",
-						"number": 42,
-						"event": "push",
-						"createdAt": "2023-01-01T00:00:00Z",
-						"updatedAt": "2023-01-01T00:05:00Z",
-						"url": "https://github.com/testorg/testrepo/actions/runs/123456"
-					}`,
-					ExitCode: 0,
-				}, nil
+			gotArgs = args
+			return &exec.Result{Stdout: `{"id": 1, "content": "rocket"}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.AddCommentReaction(context.Background(), "testorg", "testrepo", 1001, github.ReactionRocket)
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "repos/testorg/testrepo/issues/comments/1001/reactions")
+		assert.Contains(t, gotArgs, "content=rocket")
+	})
+}
+
+func TestCLIProvider_ListLabels(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
 			}
-			return &exec.Result{}, nil
+			gotArgs = args
+			return &exec.Result{
+				Stdout:   `[{"id": 208045946, "name": "bug", "color": "d73a4a", "description": "Something isn't working", "default": true}]`,
+				ExitCode: 0,
+			}, nil
 		})
 
 		provider, err := NewCLIProvider(WithExecutor(mock))
 		require.NoError(t, err)
 
-		data, err := provider.GetWorkflowRun(context.Background(), "testorg", "testrepo", 123456)
+		labels, err := provider.ListLabels(context.Background(), "testorg", "testrepo", github.ListOptions{Page: 1, PerPage: 100})
 
 		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "repos/testorg/testrepo/labels?per_page=100&page=1"}, gotArgs)
+		require.Len(t, labels, 1)
+		assert.Equal(t, int64(208045946), labels[0].ID)
+		assert.Equal(t, "bug", labels[0].Name)
+		assert.Equal(t, "d73a4a", labels[0].Color)
+		assert.Equal(t, "Something isn't working", labels[0].Description)
+		assert.True(t, labels[0].Default)
+	})
 
-		assert.Equal(t, int64(123456), data.ID)
-		assert.Equal(t, "CI", data.Name)
-		assert.Equal(t, "completed", data.Status)
-		assert.Equal(t, "success", data.Conclusion)
-		assert.Equal(t, "main", data.HeadBranch)
-		assert.Equal(t, 42, data.RunNumber)
+	t.Run("paginates all pages", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			// gh api --paginate --jq '.[]' emits the labels of every page as NDJSON.
+			return &exec.Result{
+				Stdout:   "{\"id\": 1, \"name\": \"bug\", \"color\": \"d73a4a\"}\n{\"id\": 2, \"name\": \"triage\", \"color\": \"ededed\"}\n",
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		labels, err := provider.ListLabels(context.Background(), "testorg", "testrepo", github.ListOptions{PerPage: 100})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--paginate", "repos/testorg/testrepo/labels?per_page=100", "--jq", ".[]"}, gotArgs)
+		require.Len(t, labels, 2)
+		assert.Equal(t, "bug", labels[0].Name)
+		assert.Equal(t, "triage", labels[1].Name)
+	})
+}
+
+func TestCLIProvider_CreateLabel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{Stdout: `{"id": 1, "name": "triage", "color": "ededed", "description": "Needs triage"}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		label, err := provider.CreateLabel(context.Background(), "testorg", "testrepo", github.CreateLabelOptions{
+			Name:        "triage",
+			Color:       "ededed",
+			Description: "Needs triage",
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "POST")
+		assert.Contains(t, gotArgs, "repos/testorg/testrepo/labels")
+		assert.Contains(t, gotArgs, "name=triage")
+		assert.Contains(t, gotArgs, "color=ededed")
+		assert.Contains(t, gotArgs, "description=Needs triage")
+		assert.Equal(t, "triage", label.Name)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{
+				Stderr:   "gh: Validation Failed (HTTP 422)",
+				ExitCode: 1,
+			}, errors.New(errors.CodeExecutionFailed, "exit status 1")
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		_, err = provider.CreateLabel(context.Background(), "testorg", "testrepo", github.CreateLabelOptions{Name: "bug", Color: "d73a4a"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCLIProvider_UpdateLabel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{Stdout: `{"id": 1, "name": "good first issue", "color": "7057ff"}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		newName := "good first issue"
+		color := "7057ff"
+		label, err := provider.UpdateLabel(context.Background(), "testorg", "testrepo", "beginner friendly", github.UpdateLabelOptions{
+			NewName: &newName,
+			Color:   &color,
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "PATCH")
+		assert.Contains(t, gotArgs, "repos/testorg/testrepo/labels/beginner%20friendly")
+		assert.Contains(t, gotArgs, "new_name=good first issue")
+		assert.Contains(t, gotArgs, "color=7057ff")
+		assert.NotContains(t, gotArgs, "description=")
+		assert.Equal(t, "good first issue", label.Name)
+	})
+}
+
+func TestCLIProvider_DeleteLabel(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{
+				Stderr:   "gh: Not Found (HTTP 404)",
+				ExitCode: 1,
+			}, errors.New(errors.CodeExecutionFailed, "exit status 1")
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.DeleteLabel(context.Background(), "testorg", "testrepo", "obsolete")
+
+		assert.Error(t, err)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+	})
+}
+
+func TestCLIProvider_ListMilestones(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `[{"id": 1002604, "number": 1, "title": "v1.0", "description": "Tracking milestone", "state": "open",
+					"open_issues": 4, "closed_issues": 8, "html_url": "https://github.com/testorg/testrepo/milestone/1",
+					"due_on": "2024-10-09T07:00:00Z", "created_at": "2024-09-25T23:39:01Z", "closed_at": null}]`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		milestones, err := provider.ListMilestones(context.Background(), "testorg", "testrepo", github.ListMilestonesOptions{State: "all"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--method", "GET", "repos/testorg/testrepo/milestones", "-f", "state=all"}, gotArgs)
+		require.Len(t, milestones, 1)
+		assert.Equal(t, 1, milestones[0].Number)
+		assert.Equal(t, "v1.0", milestones[0].Title)
+		assert.Equal(t, 4, milestones[0].OpenIssues)
+		assert.Equal(t, 8, milestones[0].ClosedIssues)
+		require.NotNil(t, milestones[0].DueOn)
+		assert.Nil(t, milestones[0].ClosedAt)
+	})
+}
+
+func TestCLIProvider_CreateMilestone(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{Stdout: `{"id": 1, "number": 2, "title": "Sprint 12", "state": "open"}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		due := time.Date(2024, 10, 9, 7, 0, 0, 0, time.UTC)
+		milestone, err := provider.CreateMilestone(context.Background(), "testorg", "testrepo", github.CreateMilestoneOptions{
+			Title: "Sprint 12",
+			DueOn: &due,
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "POST")
+		assert.Contains(t, gotArgs, "repos/testorg/testrepo/milestones")
+		assert.Contains(t, gotArgs, "title=Sprint 12")
+		assert.Contains(t, gotArgs, "due_on=2024-10-09T07:00:00Z")
+		assert.Equal(t, 2, milestone.Number)
+	})
+}
+
+func TestCLIProvider_UpdateMilestone(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{Stdout: `{"id": 1, "number": 2, "title": "Sprint 12", "state": "closed"}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		state := "closed"
+		milestone, err := provider.UpdateMilestone(context.Background(), "testorg", "testrepo", 2, github.UpdateMilestoneOptions{State: &state})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--method", "PATCH", "repos/testorg/testrepo/milestones/2", "-f", "state=closed"}, gotArgs)
+		assert.Equal(t, "closed", milestone.State)
+	})
+}
+
+func TestCLIProvider_DeleteMilestone(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{
+				Stderr:   "gh: Not Found (HTTP 404)",
+				ExitCode: 1,
+			}, errors.New(errors.CodeExecutionFailed, "exit status 1")
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.DeleteMilestone(context.Background(), "testorg", "testrepo", 99)
+
+		assert.Error(t, err)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+	})
+}
+
+func TestCLIProvider_ListCommits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `[{
+					"sha": "abc123",
+					"html_url": "https://github.com/testorg/testrepo/commit/abc123",
+					"author": {"login": "octocat"},
+					"committer": null,
+					"parents": [{"sha": "def456"}],
+					"commit": {
+						"message": "Fix bug",
+						"author": {"name": "Mona Octocat", "email": "mona@example.com", "date": "2024-01-02T03:04:05Z"},
+						"committer": {"name": "GitHub", "email": "noreply@github.com", "date": "2024-01-02T03:04:06Z"}
+					}
+				}]`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		commits, err := provider.ListCommits(context.Background(), "testorg", "testrepo", github.ListCommitsOptions{
+			Path:  "cmd/",
+			Since: &since,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--method", "GET", "repos/testorg/testrepo/commits", "-f", "path=cmd/", "-f", "since=2024-01-01T00:00:00Z"}, gotArgs)
+		require.Len(t, commits, 1)
+		assert.Equal(t, "abc123", commits[0].SHA)
+		assert.Equal(t, "Fix bug", commits[0].Message)
+		assert.Equal(t, "octocat", commits[0].Author)
+		assert.Empty(t, commits[0].Committer)
+		assert.Equal(t, "Mona Octocat", commits[0].AuthorName)
+		assert.Equal(t, []string{"def456"}, commits[0].Parents)
+		assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), commits[0].AuthoredAt)
+	})
+}
+
+func TestCLIProvider_CompareCommits(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{
+					"status": "diverged",
+					"ahead_by": 3,
+					"behind_by": 1,
+					"total_commits": 3,
+					"merge_base_commit": {"sha": "base123"},
+					"commits": [{"sha": "abc123", "commit": {"message": "Add feature"}}],
+					"files": [{"filename": "main.go", "status": "modified", "additions": 10, "deletions": 2, "changes": 12}]
+				}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		cmp, err := provider.CompareCommits(context.Background(), "testorg", "testrepo", "v1.0.0", "feature/login", github.ListOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "repos/testorg/testrepo/compare/v1.0.0...feature%2Flogin"}, gotArgs)
+		assert.Equal(t, "diverged", cmp.Status)
+		assert.Equal(t, 3, cmp.AheadBy)
+		assert.Equal(t, 1, cmp.BehindBy)
+		assert.Equal(t, "base123", cmp.MergeBaseSHA)
+		require.Len(t, cmp.Commits, 1)
+		assert.Equal(t, "Add feature", cmp.Commits[0].Message)
+		require.Len(t, cmp.Files, 1)
+		assert.Equal(t, 12, cmp.Files[0].Changes)
+	})
+
+	t.Run("unknown ref", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{
+				Stderr:   "gh: Not Found (HTTP 404)",
+				ExitCode: 1,
+			}, errors.New(errors.CodeExecutionFailed, "exit status 1")
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		_, err = provider.CompareCommits(context.Background(), "testorg", "testrepo", "v0.0.0", "main", github.ListOptions{})
+
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+	})
+}
+
+func TestCLIProvider_GetCombinedStatus(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{"state": "pending", "sha": "abc123", "total_count": 1, "statuses": [
+					{"id": 1, "context": "ci/jenkins", "state": "pending", "description": null,
+					 "target_url": "https://ci.example.com/1", "created_at": "2024-01-02T03:04:05Z", "updated_at": "2024-01-02T03:04:05Z"}]}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		status, err := provider.GetCombinedStatus(context.Background(), "testorg", "testrepo", "abc123")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "repos/testorg/testrepo/commits/abc123/status?per_page=100"}, gotArgs)
+		assert.Equal(t, "pending", status.State)
+		assert.Equal(t, "abc123", status.SHA)
+		require.Len(t, status.Statuses, 1)
+		assert.Equal(t, "ci/jenkins", status.Statuses[0].Context)
+		assert.Equal(t, "https://ci.example.com/1", status.Statuses[0].TargetURL)
+	})
+}
+
+func TestCLIProvider_ListCheckRuns(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		provider := setupStreamingExecutor(t, func(args ...string) { gotArgs = args },
+			`{"id": 1, "name": "build", "status": "completed", "conclusion": "success", "completed_at": "2024-01-02T03:05:05Z"}`+"\n",
+			`{"id": 2, "name": "test", "status": "queued", "conclusion": null, "completed_at": null}`+"\n",
+		)
+
+		runs, err := provider.ListCheckRuns(context.Background(), "testorg", "testrepo", "abc123")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--paginate", "repos/testorg/testrepo/commits/abc123/check-runs?per_page=100", "--jq", ".check_runs[]"}, gotArgs)
+		require.Len(t, runs, 2)
+		assert.Equal(t, "success", runs[0].Conclusion)
+		require.NotNil(t, runs[0].CompletedAt)
+		assert.Equal(t, "queued", runs[1].Status)
+		assert.Nil(t, runs[1].CompletedAt)
+	})
+}
+
+func TestCLIProvider_CreateOrUpdateEnvironment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{
+					"id": 161088068,
+					"name": "production",
+					"can_admins_bypass": true,
+					"protection_rules": [
+						{"id": 3736, "type": "wait_timer", "wait_timer": 30},
+						{"id": 3755, "type": "required_reviewers", "prevent_self_review": false, "reviewers": [
+							{"type": "Team", "reviewer": {"id": 42, "slug": "platform"}}
+						]}
+					],
+					"deployment_branch_policy": {"protected_branches": true, "custom_branch_policies": false},
+					"created_at": "2024-01-02T03:04:05Z"
+				}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		env, err := provider.CreateOrUpdateEnvironment(context.Background(), "testorg", "testrepo", "production", github.EnvironmentOptions{
+			WaitTimer:              30,
+			Reviewers:              []github.EnvironmentReviewer{{Type: "Team", ID: 42}},
+			DeploymentBranchPolicy: &github.DeploymentBranchPolicy{ProtectedBranches: true},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"gh", "api", "--method", "PUT", "repos/testorg/testrepo/environments/production",
+			"-F", "wait_timer=30",
+			"-F", "prevent_self_review=false",
+			"-f", "reviewers[][type]=Team",
+			"-F", "reviewers[][id]=42",
+			"-F", "deployment_branch_policy[protected_branches]=true",
+			"-F", "deployment_branch_policy[custom_branch_policies]=false",
+		}, gotArgs)
+		assert.Equal(t, int64(161088068), env.ID)
+		assert.Equal(t, 30, env.WaitTimer)
+		assert.Equal(t, []github.EnvironmentReviewer{{Type: "Team", ID: 42, Name: "platform"}}, env.Reviewers)
+		require.NotNil(t, env.DeploymentBranchPolicy)
+		assert.True(t, env.DeploymentBranchPolicy.ProtectedBranches)
+		assert.False(t, env.CreatedAt.IsZero())
+	})
+
+	t.Run("no protection rules", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{Stdout: `{"id": 1, "name": "staging", "deployment_branch_policy": null}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		env, err := provider.CreateOrUpdateEnvironment(context.Background(), "testorg", "testrepo", "staging", github.EnvironmentOptions{})
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "reviewers[]")
+		assert.Contains(t, gotArgs, "deployment_branch_policy=null")
+		assert.Nil(t, env.DeploymentBranchPolicy)
+		assert.Empty(t, env.Reviewers)
+	})
+}
+
+func TestCLIProvider_ListDeploymentBranchPolicies(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		provider := setupStreamingExecutor(t, func(args ...string) { gotArgs = args },
+			`{"id": 1, "name": "main", "type": "branch"}`+"\n",
+			`{"id": 2, "name": "v*", "type": "tag"}`+"\n",
+		)
+
+		policies, err := provider.ListDeploymentBranchPolicies(context.Background(), "testorg", "testrepo", "production")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--paginate", "repos/testorg/testrepo/environments/production/deployment-branch-policies?per_page=100", "--jq", ".branch_policies[]"}, gotArgs)
+		assert.Equal(t, []*github.DeploymentBranchPolicyData{
+			{ID: 1, Name: "main", Type: "branch"},
+			{ID: 2, Name: "v*", Type: "tag"},
+		}, policies)
+	})
+}
+
+func TestCLIProvider_ListNotifications(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `[{"id": "1", "repository": {"full_name": "testorg/testrepo"},
+					"subject": {"title": "Greetings", "url": "https://api.github.com/repos/testorg/testrepo/issues/123", "type": "Issue"},
+					"reason": "mention", "unread": true, "updated_at": "2024-01-02T03:04:05Z", "last_read_at": null}]`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		notifications, err := provider.ListNotifications(context.Background(), github.ListNotificationsOptions{
+			Participating: true,
+			Since:         &since,
+			Owner:         "testorg",
+			Repo:          "testrepo",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--method", "GET", "repos/testorg/testrepo/notifications", "-F", "participating=true", "-f", "since=2024-01-01T00:00:00Z"}, gotArgs)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, &github.NotificationData{
+			ID:           "1",
+			Repository:   "testorg/testrepo",
+			SubjectTitle: "Greetings",
+			SubjectType:  "Issue",
+			SubjectURL:   "https://api.github.com/repos/testorg/testrepo/issues/123",
+			Reason:       "mention",
+			Unread:       true,
+			UpdatedAt:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		}, notifications[0])
+	})
+}
+
+func TestCLIProvider_SetThreadSubscription(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout:   `{"subscribed": false, "ignored": true, "reason": null, "created_at": "2024-01-02T03:04:05Z"}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		subscription, err := provider.SetThreadSubscription(context.Background(), "1", true)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--method", "PUT", "notifications/threads/1/subscription", "-F", "ignored=true"}, gotArgs)
+		assert.False(t, subscription.Subscribed)
+		assert.True(t, subscription.Ignored)
+	})
+}
+
+func TestCLIProvider_ListDependabotAlerts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		provider := setupStreamingExecutor(t, func(args ...string) { gotArgs = args },
+			`{"number": 2, "state": "open", "dependency": {"package": {"ecosystem": "go", "name": "golang.org/x/net"}, "manifest_path": "go.mod"},`+
+				`"security_advisory": {"ghsa_id": "GHSA-4374-p667-p6c8", "cve_id": "CVE-2023-39325", "summary": "HTTP/2 rapid reset", "severity": "high"},`+
+				`"security_vulnerability": {"vulnerable_version_range": "< 0.17.0", "first_patched_version": {"identifier": "0.17.0"}},`+
+				`"created_at": "2024-01-02T03:04:05Z", "dismissed_at": null}`+"\n",
+		)
+
+		alerts, err := provider.ListDependabotAlerts(context.Background(), "testorg", "testrepo", github.ListAlertsOptions{State: "open,dismissed"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--paginate", "repos/testorg/testrepo/dependabot/alerts?per_page=100&state=open%2Cdismissed", "--jq", ".[]"}, gotArgs)
+		require.Len(t, alerts, 1)
+		assert.Equal(t, 2, alerts[0].Number)
+		assert.Equal(t, "golang.org/x/net", alerts[0].Package)
+		assert.Equal(t, "go.mod", alerts[0].ManifestPath)
+		assert.Equal(t, "CVE-2023-39325", alerts[0].CVEID)
+		assert.Equal(t, "high", alerts[0].Severity)
+		assert.Equal(t, "0.17.0", alerts[0].PatchedVersion)
+		assert.Nil(t, alerts[0].DismissedAt)
+	})
+}
+
+func TestCLIProvider_ListCodeScanningAlerts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		provider := setupStreamingExecutor(t, func(args ...string) { gotArgs = args },
+			`{"number": 4, "state": "open", "rule": {"id": "go/sql-injection", "description": "Database query built from user-controlled sources",`+
+				`"severity": "error", "security_severity_level": "high"}, "tool": {"name": "CodeQL"},`+
+				`"most_recent_instance": {"ref": "refs/heads/main", "location": {"path": "db/query.go", "start_line": 42}}}`+"\n",
+		)
+
+		alerts, err := provider.ListCodeScanningAlerts(context.Background(), "testorg", "testrepo", github.ListAlertsOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--paginate", "repos/testorg/testrepo/code-scanning/alerts?per_page=100", "--jq", ".[]"}, gotArgs)
+		require.Len(t, alerts, 1)
+		assert.Equal(t, "go/sql-injection", alerts[0].RuleID)
+		assert.Equal(t, "high", alerts[0].SecuritySeverity)
+		assert.Equal(t, "CodeQL", alerts[0].Tool)
+		assert.Equal(t, "db/query.go", alerts[0].Path)
+		assert.Equal(t, 42, alerts[0].StartLine)
+	})
+}
+
+func TestCLIProvider_ListSecretScanningAlerts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		provider := setupStreamingExecutor(t, func(args ...string) { gotArgs = args },
+			`{"number": 1, "state": "resolved", "secret_type": "github_personal_access_token", "secret_type_display_name": "GitHub Personal Access Token",`+
+				`"secret": "ghp_secret", "resolution": "revoked", "push_protection_bypassed": false, "resolved_at": "2024-01-02T03:04:05Z"}`+"\n",
+		)
+
+		alerts, err := provider.ListSecretScanningAlerts(context.Background(), "testorg", "testrepo", github.ListAlertsOptions{State: "resolved"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--paginate", "repos/testorg/testrepo/secret-scanning/alerts?per_page=100&state=resolved", "--jq", ".[]"}, gotArgs)
+		require.Len(t, alerts, 1)
+		assert.Equal(t, "github_personal_access_token", alerts[0].SecretType)
+		assert.Equal(t, "revoked", alerts[0].Resolution)
+		require.NotNil(t, alerts[0].ResolvedAt)
+	})
+}
+
+func TestCLIProvider_CreateGist(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{"id": "aa5a315d61ae9438b18d", "description": "Debug bundle", "public": false, "owner": {"login": "octocat"},
+					"html_url": "https://gist.github.com/aa5a315d61ae9438b18d",
+					"files": {"env.txt": {"filename": "env.txt", "size": 7, "raw_url": "https://gist.githubusercontent.com/octocat/aa5a315d61ae9438b18d/raw/env.txt", "content": "GOOS=linux", "truncated": false}},
+					"created_at": "2024-01-02T03:04:05Z", "updated_at": "2024-01-02T03:04:05Z"}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		gist, err := provider.CreateGist(context.Background(), github.CreateGistOptions{
+			Description: "Debug bundle",
+			Files:       map[string]string{"trace.log": "trace", "env.txt": "GOOS=linux"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"gh", "api", "--method", "POST", "gists",
+			"-f", "description=Debug bundle",
+			"-F", "public=false",
+			"-f", "files[env.txt][content]=GOOS=linux",
+			"-f", "files[trace.log][content]=trace",
+		}, gotArgs)
+		assert.Equal(t, "aa5a315d61ae9438b18d", gist.ID)
+		assert.Equal(t, "octocat", gist.Owner)
+		require.Contains(t, gist.Files, "env.txt")
+		assert.Equal(t, "GOOS=linux", gist.Files["env.txt"].Content)
+		assert.False(t, gist.Files["env.txt"].Truncated)
+	})
+}
+
+func TestCLIProvider_UpdateGist(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{Stdout: `{"id": "aa5a315d61ae9438b18d", "files": {}}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		description := "Updated bundle"
+		_, err = provider.UpdateGist(context.Background(), "aa5a315d61ae9438b18d", github.UpdateGistOptions{
+			Description: &description,
+			Files:       map[string]string{"trace.log": "new trace"},
+			DeleteFiles: []string{"env.txt"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"gh", "api", "--method", "PATCH", "gists/aa5a315d61ae9438b18d",
+			"-f", "description=Updated bundle",
+			"-f", "files[trace.log][content]=new trace",
+			"-F", "files[env.txt]=null",
+		}, gotArgs)
+	})
+}
+
+func TestCLIProvider_CreateTagObject(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac", "tag": "v1.2.0", "message": "Release v1.2.0",
+					"tagger": {"name": "Release Bot", "email": "bot@example.com", "date": "2024-01-02T03:04:05Z"},
+					"object": {"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c", "type": "commit"}}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		taggedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		tag, err := provider.CreateTagObject(context.Background(), "owner", "repo", github.CreateTagOptions{
+			Tag:         "v1.2.0",
+			Message:     "Release v1.2.0",
+			SHA:         "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			Type:        "commit",
+			TaggerName:  "Release Bot",
+			TaggerEmail: "bot@example.com",
+			TaggedAt:    &taggedAt,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"gh", "api", "--method", "POST", "repos/owner/repo/git/tags",
+			"-f", "tag=v1.2.0",
+			"-f", "message=Release v1.2.0",
+			"-f", "object=c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"-f", "type=commit",
+			"-f", "tagger[name]=Release Bot",
+			"-f", "tagger[email]=bot@example.com",
+			"-f", "tagger[date]=2024-01-02T03:04:05Z",
+		}, gotArgs)
+		assert.Equal(t, "940bd336248efae0f9ee5bc7b2d5c985887b16ac", tag.SHA)
+		assert.Equal(t, "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c", tag.ObjectSHA)
+		assert.Equal(t, "Release Bot", tag.TaggerName)
+		assert.Equal(t, taggedAt, tag.TaggedAt)
+	})
+}
+
+func TestCLIProvider_CreateRef(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout:   `{"ref": "refs/tags/v1.2.0", "object": {"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac", "type": "tag"}}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		ref, err := provider.CreateRef(context.Background(), "owner", "repo", "refs/tags/v1.2.0", "940bd336248efae0f9ee5bc7b2d5c985887b16ac")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"gh", "api", "--method", "POST", "repos/owner/repo/git/refs",
+			"-f", "ref=refs/tags/v1.2.0",
+			"-f", "sha=940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		}, gotArgs)
+		assert.Equal(t, &github.RefData{
+			Ref:  "refs/tags/v1.2.0",
+			SHA:  "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			Type: "tag",
+		}, ref)
+	})
+}
+
+func TestCLIProvider_DeleteRef(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.DeleteRef(context.Background(), "owner", "repo", "refs/heads/feature/login")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "--method", "DELETE", "repos/owner/repo/git/refs/heads/feature/login"}, gotArgs)
+	})
+}
+
+func TestCLIProvider_ListTags(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `[{"name": "v1.2.0", "commit": {"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"},
+					"zipball_url": "https://api.github.com/repos/owner/repo/zipball/v1.2.0",
+					"tarball_url": "https://api.github.com/repos/owner/repo/tarball/v1.2.0"}]`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		tags, err := provider.ListTags(context.Background(), "owner", "repo", github.ListOptions{Page: 2, PerPage: 100})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "repos/owner/repo/tags?per_page=100&page=2"}, gotArgs)
+		require.Len(t, tags, 1)
+		assert.Equal(t, "v1.2.0", tags[0].Name)
+		assert.Equal(t, "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c", tags[0].CommitSHA)
+	})
+}
+
+func TestCLIProvider_GetTrafficViews(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{"count": 14850, "uniques": 3782, "views": [
+					{"timestamp": "2024-01-01T00:00:00Z", "count": 440, "uniques": 143}]}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		views, err := provider.GetTrafficViews(context.Background(), "owner", "repo", github.TrafficOptions{Per: "week"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gh", "api", "repos/owner/repo/traffic/views?per=week"}, gotArgs)
+		assert.Equal(t, 14850, views.Count)
+		assert.Equal(t, 3782, views.Uniques)
+		require.Len(t, views.Points, 1)
+		assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), views.Points[0].Timestamp)
+		assert.Equal(t, 143, views.Points[0].Uniques)
+	})
+}
+
+func TestCLIProvider_ListTopReferrers(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{Stdout: `[{"referrer": "Google", "count": 4, "uniques": 3}]`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		referrers, err := provider.ListTopReferrers(context.Background(), "owner", "repo")
+
+		require.NoError(t, err)
+		assert.Equal(t, []*github.ReferrerData{{Referrer: "Google", Count: 4, Uniques: 3}}, referrers)
+	})
+}
+
+func TestCLIProvider_ListContributorStats(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{
+				Stdout:   `[{"author": {"login": "octocat"}, "total": 3, "weeks": [{"w": 1704067200, "a": 10, "d": 2, "c": 3}]}]`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		stats, err := provider.ListContributorStats(context.Background(), "owner", "repo")
+
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		assert.Equal(t, "octocat", stats[0].Author)
+		assert.Equal(t, []*github.ContributorWeekData{{
+			Week:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Additions: 10,
+			Deletions: 2,
+			Commits:   3,
+		}}, stats[0].Weeks)
+	})
+
+	t.Run("computing", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{Stdout: `{}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		_, err = provider.ListContributorStats(context.Background(), "owner", "repo")
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeUnavailable, errors.GetCode(err))
+	})
+}
+
+func TestCLIProvider_GetPullRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "pr" {
+				return &exec.Result{
+					Stdout: `{
+						"number": 10,
+						"title": "Test PR",
+						"body": "PR description",
+						"state": "OPEN",
+						"author": {"login": "developer"},
+						"headRefName": "feature",
+						"baseRefName": "main",
+						"headRefOid": "abc123",
+						"labels": [{"name": "enhancement"}],
+						"isDraft": false,
+						"mergeable": "MERGEABLE",
+						"merged": false,
+						"mergedAt": null,
+						"createdAt": "2023-01-01T00:00:00Z",
+						"updatedAt": "2023-01-02T00:00:00Z",
+						"closedAt": null,
+						"url": "https://github.com/testorg/testrepo/pull/10"
+					}`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		data, err := provider.GetPullRequest(context.Background(), "testorg", "testrepo", 10)
+
+		require.NoError(t, err)
+
+		assert.Equal(t, 10, data.Number)
+		assert.Equal(t, "Test PR", data.Title)
+		assert.Equal(t, "PR description", data.Body)
+		assert.Equal(t, "open", data.State)
+		assert.Equal(t, "developer", data.Author)
+		assert.Equal(t, "feature", data.HeadRef)
+		assert.Equal(t, "main", data.BaseRef)
+		assert.False(t, data.Draft)
+		assert.False(t, data.Merged)
+		assert.NotNil(t, data.Mergeable)
+		assert.True(t, *data.Mergeable)
+	})
+}
+
+func TestCLIProvider_RequestReviewers(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{Stdout: `{"number": 1}`, ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.RequestReviewers(context.Background(), "testorg", "testrepo", 1, github.RequestReviewersOptions{
+			Reviewers:     []string{"alice"},
+			TeamReviewers: []string{"platform"},
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "repos/testorg/testrepo/pulls/1/requested_reviewers")
+		assert.Contains(t, gotArgs, "reviewers[]=alice")
+		assert.Contains(t, gotArgs, "team_reviewers[]=platform")
+	})
+}
+
+func TestCLIProvider_SubmitReview(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{
+					"id": 80,
+					"user": {"login": "reviewbot"},
+					"body": "Looks good",
+					"state": "APPROVED",
+					"commit_id": "abc123",
+					"html_url": "https://github.com/testorg/testrepo/pull/1#pullrequestreview-80",
+					"submitted_at": "2023-01-01T00:00:00Z"
+				}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		review, err := provider.SubmitReview(context.Background(), "testorg", "testrepo", 1, github.SubmitReviewOptions{
+			Event: github.ReviewEventApprove,
+			Body:  "Looks good",
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "event=APPROVE")
+		assert.Contains(t, gotArgs, "body=Looks good")
+		assert.Equal(t, int64(80), review.ID)
+		assert.Equal(t, "reviewbot", review.Author)
+		assert.Equal(t, github.ReviewStateApproved, review.State)
+		assert.Equal(t, "abc123", review.CommitID)
+		require.NotNil(t, review.SubmittedAt)
+	})
+}
+
+func TestCLIProvider_ListReviews(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 3 && args[0] == "gh" && args[1] == "api" && args[2] == "repos/testorg/testrepo/pulls/1/reviews?per_page=50" {
+				return &exec.Result{
+					Stdout: `[
+						{"id": 1, "user": {"login": "alice"}, "state": "CHANGES_REQUESTED", "body": "Needs tests"},
+						{"id": 2, "user": {"login": "bob"}, "state": "PENDING"}
+					]`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		reviews, err := provider.ListReviews(context.Background(), "testorg", "testrepo", 1, github.ListOptions{PerPage: 50})
+
+		require.NoError(t, err)
+		require.Len(t, reviews, 2)
+		assert.Equal(t, "alice", reviews[0].Author)
+		assert.Equal(t, github.ReviewStateChangesRequested, reviews[0].State)
+		assert.Nil(t, reviews[1].SubmittedAt)
+	})
+}
+
+func TestCLIProvider_CreateReviewComment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			gotArgs = args
+			return &exec.Result{
+				Stdout: `{
+					"id": 10,
+					"user": {"login": "reviewbot"},
+					"body": "Handle this error",
+					"path": "main.go",
+					"line": 42,
+					"start_line": 40,
+					"side": "RIGHT",
+					"commit_id": "abc123",
+					"created_at": "2023-01-01T00:00:00Z",
+					"updated_at": "2023-01-01T00:00:00Z"
+				}`,
+				ExitCode: 0,
+			}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		comment, err := provider.CreateReviewComment(context.Background(), "testorg", "testrepo", 1, github.CreateReviewCommentOptions{
+			Body:      "Handle this error",
+			Path:      "main.go",
+			Line:      42,
+			StartLine: 40,
+			Side:      github.DiffSideRight,
+			CommitID:  "abc123",
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, gotArgs, "line=42")
+		assert.Contains(t, gotArgs, "start_line=40")
+		assert.Contains(t, gotArgs, "path=main.go")
+		assert.Equal(t, int64(10), comment.ID)
+		assert.Equal(t, "main.go", comment.Path)
+		assert.Equal(t, 42, comment.Line)
+		assert.Equal(t, 40, comment.StartLine)
+		assert.Equal(t, "reviewbot", comment.Author)
+	})
+}
+
+func TestCLIProvider_ListArtifacts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 3 && args[0] == "gh" && args[1] == "api" && args[2] == "repos/testorg/testrepo/actions/runs/123456/artifacts" {
+				return &exec.Result{
+					Stdout: `{
+						"total_count": 1,
+						"artifacts": [{
+							"id": 11,
+							"name": "dist",
+							"size_in_bytes": 2048,
+							"expired": false,
+							"archive_download_url": "https://api.github.com/repos/testorg/testrepo/actions/artifacts/11/zip",
+							"workflow_run": {"id": 123456},
+							"created_at": "2023-01-01T00:00:00Z",
+							"expires_at": "2023-04-01T00:00:00Z"
+						}]
+					}`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		artifacts, err := provider.ListArtifacts(context.Background(), "testorg", "testrepo", 123456, github.ListOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, artifacts, 1)
+		assert.Equal(t, int64(11), artifacts[0].ID)
+		assert.Equal(t, "dist", artifacts[0].Name)
+		assert.Equal(t, int64(2048), artifacts[0].SizeInBytes)
+		assert.Equal(t, int64(123456), artifacts[0].RunID)
+		require.NotNil(t, artifacts[0].ExpiresAt)
+	})
+}
+
+func TestCLIProvider_DownloadArtifact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 3 && args[0] == "gh" && args[1] == "api" && args[2] == "repos/testorg/testrepo/actions/artifacts/11/zip" {
+				return &exec.Result{Stdout: "PK\x03\x04archive", ExitCode: 0}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		rc, err := provider.DownloadArtifact(context.Background(), "testorg", "testrepo", 11)
+		require.NoError(t, err)
+		defer func() { _ = rc.Close() }()
+
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "PK\x03\x04archive", string(data))
+	})
+}
+
+func TestCLIProvider_GetWorkflowRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "run" {
+				return &exec.Result{
+					Stdout: `{
+						"databaseId": 123456,
+						"name": "CI",
+						"workflowDatabaseId": 789,
+						"status": "COMPLETED",
+						"conclusion": "SUCCESS",
+						"headBranch": "main",
+						"headSha": "abc123",
+						"number": 42,
+						"event": "push",
+						"createdAt": "2023-01-01T00:00:00Z",
+						"updatedAt": "2023-01-01T00:05:00Z",
+						"url": "https://github.com/testorg/testrepo/actions/runs/123456"
+					}`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		data, err := provider.GetWorkflowRun(context.Background(), "testorg", "testrepo", 123456)
+
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(123456), data.ID)
+		assert.Equal(t, "CI", data.Name)
+		assert.Equal(t, "completed", data.Status)
+		assert.Equal(t, "success", data.Conclusion)
+		assert.Equal(t, "main", data.HeadBranch)
+		assert.Equal(t, 42, data.RunNumber)
+	})
+}
+
+func TestCLIProvider_GetWorkflowRunLogs(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "run" {
+				assert.Equal(t, []string{"gh", "run", "view", "123456", "--repo", "testorg/testrepo", "--log"}, args)
+				return &exec.Result{Stdout: "build\tRun tests\tok\n", ExitCode: 0}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		rc, err := provider.GetWorkflowRunLogs(context.Background(), "testorg", "testrepo", 123456)
+		require.NoError(t, err)
+		defer func() { _ = rc.Close() }()
+
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "build\tRun tests\tok\n", string(data))
+	})
+}
+
+func TestCLIProvider_GetWorkflowJobLogs(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "run" {
+				assert.Equal(t, []string{"gh", "run", "view", "--job", "987", "--repo", "testorg/testrepo", "--log"}, args)
+				return &exec.Result{Stdout: "build\tRun tests\tok\n", ExitCode: 0}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		rc, err := provider.GetWorkflowJobLogs(context.Background(), "testorg", "testrepo", 987)
+		require.NoError(t, err)
+		defer func() { _ = rc.Close() }()
+
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "build\tRun tests\tok\n", string(data))
+	})
+}
+
+func TestCLIProvider_QueryGraphQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 3 && args[0] == "gh" && args[1] == "api" && args[2] == "graphql" {
+				assert.Equal(t, []string{
+					"gh", "api", "graphql",
+					"-f", "query=query { viewer { login } }",
+					"-F", "first=10",
+					"-f", "owner=testorg",
+					"-f", "states[]=OPEN",
+					"-f", "states[]=MERGED",
+				}, args)
+				return &exec.Result{Stdout: `{"data": {"viewer": {"login": "octocat"}}}`, ExitCode: 0}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		var result struct {
+			Viewer struct {
+				Login string `json:"login"`
+			} `json:"viewer"`
+		}
+		err = provider.QueryGraphQL(context.Background(), "query { viewer { login } }", map[string]interface{}{
+			"owner":  "testorg",
+			"first":  10,
+			"states": []string{"OPEN", "MERGED"},
+		}, &result)
+
+		require.NoError(t, err)
+		assert.Equal(t, "octocat", result.Viewer.Login)
+	})
+
+	t.Run("graphql error", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{
+				Stdout:   `{"data": {"repository": null}, "errors": [{"type": "NOT_FOUND", "message": "Could not resolve to a Repository"}]}`,
+				Stderr:   "gh: Could not resolve to a Repository",
+				ExitCode: 1,
+			}, errors.New(errors.CodeExecutionFailed, "exit status 1")
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.QueryGraphQL(context.Background(), "query { repository { id } }", nil, nil)
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+	})
+
+	t.Run("unsupported variable", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.QueryGraphQL(context.Background(), "query { viewer { login } }", map[string]interface{}{
+			"input": map[string]interface{}{"title": "x"},
+		}, nil)
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeInvalidInput, errors.GetCode(err))
+	})
+}
+
+func TestCLIProvider_SetSecret(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope github.SecretScope
+		want  []string
+	}{
+		{
+			name:  "repository",
+			scope: github.SecretScope{Owner: "testorg", Repo: "testrepo"},
+			want:  []string{"gh", "secret", "set", "TOKEN", "--body", "s3cr3t", "--repo", "testorg/testrepo"},
+		},
+		{
+			name:  "environment",
+			scope: github.SecretScope{Owner: "testorg", Repo: "testrepo", Environment: "production"},
+			want:  []string{"gh", "secret", "set", "TOKEN", "--body", "s3cr3t", "--repo", "testorg/testrepo", "--env", "production"},
+		},
+		{
+			name:  "dependabot",
+			scope: github.SecretScope{Owner: "testorg", Repo: "testrepo", App: github.SecretAppDependabot},
+			want:  []string{"gh", "secret", "set", "TOKEN", "--body", "s3cr3t", "--repo", "testorg/testrepo", "--app", "dependabot"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			var got []string
+			mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+				if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+					return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+				}
+				got = args
+				return &exec.Result{ExitCode: 0}, nil
+			})
+
+			provider, err := NewCLIProvider(WithExecutor(mock))
+			require.NoError(t, err)
+
+			err = provider.SetSecret(context.Background(), tt.scope, github.SetSecretOptions{Name: "TOKEN", Value: "s3cr3t"})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("organization with selected repositories", func(t *testing.T) {
+
+		var calls [][]string
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			calls = append(calls, args)
+			return &exec.Result{ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.SetSecret(context.Background(), github.SecretScope{Owner: "testorg"}, github.SetSecretOptions{
+			Name:                  "TOKEN",
+			Value:                 "s3cr3t",
+			Visibility:            github.VisibilitySelected,
+			SelectedRepositoryIDs: []int64{1, 2},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, calls, 2)
+		assert.Equal(t, []string{"gh", "secret", "set", "TOKEN", "--body", "s3cr3t", "--org", "testorg", "--visibility", "selected"}, calls[0])
+		assert.Equal(t, []string{
+			"gh", "api", "--method", "PUT", "orgs/testorg/actions/secrets/TOKEN/repositories",
+			"-F", "selected_repository_ids[]=1",
+			"-F", "selected_repository_ids[]=2",
+		}, calls[1])
+	})
+
+	t.Run("invalid scope", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		err = provider.SetSecret(context.Background(), github.SecretScope{Owner: "testorg", Environment: "production"}, github.SetSecretOptions{Name: "TOKEN"})
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeInvalidInput, errors.GetCode(err))
+	})
+}
+
+func TestCLIProvider_ListSecrets(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 3 && args[0] == "gh" && args[1] == "secret" && args[2] == "list" {
+				assert.Equal(t, []string{"gh", "secret", "list", "--json", "name,updatedAt,visibility", "--org", "testorg"}, args)
+				return &exec.Result{
+					Stdout:   `[{"name": "TOKEN", "visibility": "PRIVATE", "updatedAt": "2023-01-01T00:00:00Z"}]`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		secrets, err := provider.ListSecrets(context.Background(), github.SecretScope{Owner: "testorg"}, github.ListOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, secrets, 1)
+		assert.Equal(t, "TOKEN", secrets[0].Name)
+		assert.Equal(t, github.VisibilityPrivate, secrets[0].Visibility)
+		assert.False(t, secrets[0].UpdatedAt.IsZero())
+	})
+}
+
+func TestCLIProvider_ListVariables(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 2 && args[0] == "gh" && args[1] == "auth" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if len(args) >= 3 && args[0] == "gh" && args[1] == "variable" && args[2] == "list" {
+				assert.Equal(t, []string{"gh", "variable", "list", "--json", "name,value,createdAt,updatedAt", "--repo", "testorg/testrepo", "--env", "production"}, args)
+				return &exec.Result{
+					Stdout:   `[{"name": "REGION", "value": "us-east-1", "createdAt": "2023-01-01T00:00:00Z", "updatedAt": "2023-01-02T00:00:00Z"}]`,
+					ExitCode: 0,
+				}, nil
+			}
+			return &exec.Result{}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		scope := github.SecretScope{Owner: "testorg", Repo: "testrepo", Environment: "production"}
+		variables, err := provider.ListVariables(context.Background(), scope, github.ListOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, variables, 1)
+		assert.Equal(t, "REGION", variables[0].Name)
+		assert.Equal(t, "us-east-1", variables[0].Value)
+	})
+
+	t.Run("dependabot is not supported", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+		})
+
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		scope := github.SecretScope{Owner: "testorg", Repo: "testrepo", App: github.SecretAppDependabot}
+		_, err = provider.ListVariables(context.Background(), scope, github.ListOptions{})
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeInvalidInput, errors.GetCode(err))
 	})
 }
@@ -0,0 +1,227 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmgilman/go/errors"
+	github "github.com/jmgilman/go/github"
+)
+
+// defaultStreamPageSize is the page size requested when streaming with --paginate.
+const defaultStreamPageSize = 100
+
+// issueStreamFilter reshapes REST issues into the gh issue list JSON format
+// and drops pull requests, which the issues endpoint also returns.
+const issueStreamFilter = `.[] | select(.pull_request == null) | {number, title, body, state, author: .user, labels, assignees, milestone, createdAt: .created_at, updatedAt: .updated_at, closedAt: .closed_at, url: .html_url}`
+
+// workflowRunStreamFilter reshapes REST workflow runs into the gh run list JSON format.
+const workflowRunStreamFilter = `.workflow_runs[] | {databaseId: .id, name, workflowDatabaseId: .workflow_id, status, conclusion, headBranch: .head_branch, headSha: .head_sha, number: .run_number, event, createdAt: .created_at, updatedAt: .updated_at, url: .html_url}`
+
+// StreamIssues calls fn for every issue in a repository matching opts,
+// fetching all pages with gh api --paginate.
+//
+// Issues are decoded one at a time as gh writes them, so memory use does not
+// grow with the number of decoded issues. PerPage sets the page size
+// (default 100); Page is ignored. Pull requests are skipped.
+//
+// Returning an error from fn stops the stream and returns that error.
+// Cancelling ctx stops the stream and terminates gh.
+//
+// Example:
+//
+//	err := provider.StreamIssues(ctx, "owner", "repo", github.ListIssuesOptions{State: "all"},
+//	    func(issue *github.IssueData) error {
+//	        fmt.Println(issue.Number, issue.Title)
+//	        return nil
+//	    })
+func (c *CLIProvider) StreamIssues(ctx context.Context, owner, repo string, opts github.ListIssuesOptions, fn func(*github.IssueData) error) error {
+	query := url.Values{}
+	if opts.State != "" {
+		query.Set("state", opts.State)
+	}
+	if len(opts.Labels) > 0 {
+		query.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Assignee != "" {
+		query.Set("assignee", opts.Assignee)
+	}
+	if opts.Since != nil {
+		query.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	endpoint := streamEndpoint(fmt.Sprintf("repos/%s/%s/issues", owner, repo), query, opts.ListOptions)
+
+	return c.stream(ctx, "failed to stream issues", func(raw json.RawMessage) error {
+		var item map[string]interface{}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse issue")
+		}
+		return fn(c.convertIssueFromMap(item))
+	}, "api", "--paginate", endpoint, "--jq", issueStreamFilter)
+}
+
+// StreamWorkflowRuns calls fn for every workflow run in a repository matching
+// opts, fetching all pages with gh api --paginate.
+//
+// Runs are decoded one at a time as gh writes them. PerPage sets the page
+// size (default 100); Page is ignored. Returning an error from fn or
+// cancelling ctx stops the stream.
+//
+// Example:
+//
+//	err := provider.StreamWorkflowRuns(ctx, "owner", "repo", github.ListWorkflowRunsOptions{Branch: "main"},
+//	    func(run *github.WorkflowRunData) error {
+//	        fmt.Println(run.ID, run.Status)
+//	        return nil
+//	    })
+func (c *CLIProvider) StreamWorkflowRuns(ctx context.Context, owner, repo string, opts github.ListWorkflowRunsOptions, fn func(*github.WorkflowRunData) error) error {
+	query := url.Values{}
+	if opts.Branch != "" {
+		query.Set("branch", opts.Branch)
+	}
+	if opts.Event != "" {
+		query.Set("event", opts.Event)
+	}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+	endpoint := streamEndpoint(fmt.Sprintf("repos/%s/%s/actions/runs", owner, repo), query, opts.ListOptions)
+
+	return c.stream(ctx, "failed to stream workflow runs", func(raw json.RawMessage) error {
+		var item map[string]interface{}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return errors.Wrap(err, errors.CodeInvalidInput, "failed to parse workflow run")
+		}
+		return fn(c.convertWorkflowRunFromMap(item))
+	}, "api", "--paginate", endpoint, "--jq", workflowRunStreamFilter)
+}
+
+// stream runs a gh command whose output is newline-delimited JSON and calls
+// fn with each value as soon as its line is complete.
+//
+// Output is consumed through the executor's stdout writer while the command
+// runs and is not captured, so memory use does not grow with the output.
+// Executors that do not stream output are handled by decoding the captured
+// stdout after the command exits.
+func (c *CLIProvider) stream(ctx context.Context, message string, fn func(json.RawMessage) error, args ...string) error {
+	decoder := newNDJSONWriter(ctx, fn)
+
+	result, err := c.command(ctx).
+		WithStdout(decoder).
+		WithStreaming().
+		Run(args...)
+
+	// Errors raised while decoding take precedence over the resulting
+	// broken pipe reported by the command.
+	if decoder.err != nil {
+		return decoder.err
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), errors.CodeTimeout, message)
+		}
+		return c.wrapCLIError(err, result, message)
+	}
+
+	if decoder.written == 0 && result != nil && result.Stdout != "" {
+		if _, err := decoder.Write([]byte(result.Stdout)); err != nil {
+			return err
+		}
+	}
+	return decoder.Close()
+}
+
+// ndjsonWriter is an io.Writer that decodes newline-delimited JSON values
+// as they are written and passes each one to fn.
+type ndjsonWriter struct {
+	ctx     context.Context
+	fn      func(json.RawMessage) error
+	buf     []byte
+	written int64
+
+	// err is the first error returned by fn, the decoder, or ctx.
+	err error
+}
+
+// newNDJSONWriter creates a writer that calls fn for each decoded line.
+func newNDJSONWriter(ctx context.Context, fn func(json.RawMessage) error) *ndjsonWriter {
+	return &ndjsonWriter{
+		ctx: ctx,
+		fn:  fn,
+	}
+}
+
+// Write implements io.Writer. Complete lines are decoded immediately;
+// a trailing partial line is buffered until the rest arrives.
+func (w *ndjsonWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.written += int64(len(p))
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := w.buf[:i]
+		w.buf = w.buf[i+1:]
+		if err := w.emit(line); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Close decodes any remaining unterminated line.
+func (w *ndjsonWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	line := w.buf
+	w.buf = nil
+	return w.emit(line)
+}
+
+// emit decodes a single line and passes it to fn. Blank lines are skipped.
+func (w *ndjsonWriter) emit(line []byte) error {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+
+	if err := w.ctx.Err(); err != nil {
+		w.err = errors.Wrap(err, errors.CodeTimeout, "stream cancelled")
+		return w.err
+	}
+	if !json.Valid(line) {
+		err := errors.New(errors.CodeInvalidInput, "failed to parse JSON stream")
+		w.err = errors.WithContext(err, "line", string(line))
+		return w.err
+	}
+
+	// fn may retain the value, so it is copied out of the buffer.
+	if err := w.fn(json.RawMessage(append([]byte(nil), line...))); err != nil {
+		w.err = err
+		return err
+	}
+	return nil
+}
+
+// streamEndpoint builds a paginated REST endpoint with query parameters.
+func streamEndpoint(endpoint string, query url.Values, opts github.ListOptions) string {
+	perPage := opts.PerPage
+	if perPage <= 0 {
+		perPage = defaultStreamPageSize
+	}
+	query.Set("per_page", strconv.Itoa(perPage))
+	return endpoint + "?" + query.Encode()
+}
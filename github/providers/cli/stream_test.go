@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/exec"
+	"github.com/jmgilman/go/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupStreamingExecutor returns a mock executor whose commands write output
+// to the stdout writer supplied through WithStdout, in the given chunks.
+func setupStreamingExecutor(t *testing.T, onRun func(args ...string), chunks ...string) *CLIProvider {
+	t.Helper()
+
+	var stdout io.Writer
+	mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+		if len(args) >= 3 && args[1] == "auth" && args[2] == "status" {
+			return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+		}
+		onRun(args...)
+		for _, chunk := range chunks {
+			if _, err := stdout.Write([]byte(chunk)); err != nil {
+				return &exec.Result{ExitCode: 1}, errors.New(errors.CodeExecutionFailed, "broken pipe")
+			}
+		}
+		return &exec.Result{ExitCode: 0}, nil
+	})
+	mock.WithStdoutFunc = func(w io.Writer) exec.Executor {
+		stdout = w
+		return mock
+	}
+
+	provider, err := NewCLIProvider(WithExecutor(mock))
+	require.NoError(t, err)
+	return provider
+}
+
+func TestCLIProvider_StreamIssues(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		provider := setupStreamingExecutor(t, func(args ...string) { gotArgs = args },
+			`{"number": 1, "title": "First", "state": "open", "author": {"login": "octocat"}}`+"\n"+`{"number": 2, "ti`,
+			`tle": "Second", "state": "closed", "createdAt": "2023-01-01T00:00:00Z"}`+"\n",
+			`{"number": 3, "title": "Third", "state": "open"}`,
+		)
+
+		var issues []*github.IssueData
+		err := provider.StreamIssues(context.Background(), "owner", "repo",
+			github.ListIssuesOptions{State: "all", Labels: []string{"bug", "ui"}},
+			func(issue *github.IssueData) error {
+				issues = append(issues, issue)
+				return nil
+			})
+
+		require.NoError(t, err)
+		require.Len(t, issues, 3)
+		assert.Equal(t, "First", issues[0].Title)
+		assert.Equal(t, "octocat", issues[0].Author)
+		assert.Equal(t, "Second", issues[1].Title)
+		assert.Equal(t, "closed", issues[1].State)
+		assert.False(t, issues[1].CreatedAt.IsZero())
+		assert.Equal(t, 3, issues[2].Number)
+
+		require.Len(t, gotArgs, 6)
+		assert.Equal(t, []string{"gh", "api", "--paginate", "repos/owner/repo/issues?labels=bug%2Cui&per_page=100&state=all", "--jq"}, gotArgs[:5])
+	})
+
+	t.Run("callback error stops stream", func(t *testing.T) {
+
+		provider := setupStreamingExecutor(t, func(...string) {},
+			`{"number": 1}`+"\n",
+			`{"number": 2}`+"\n",
+			`{"number": 3}`+"\n",
+		)
+
+		stop := errors.New(errors.CodeInternal, "stop")
+		var seen int
+		err := provider.StreamIssues(context.Background(), "owner", "repo", github.ListIssuesOptions{},
+			func(issue *github.IssueData) error {
+				seen++
+				if issue.Number == 2 {
+					return stop
+				}
+				return nil
+			})
+
+		assert.Equal(t, stop, err)
+		assert.Equal(t, 2, seen)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		provider := setupStreamingExecutor(t, func(...string) {},
+			`{"number": 1}`+"\n",
+			`{"number": 2}`+"\n",
+		)
+
+		var seen int
+		err := provider.StreamIssues(ctx, "owner", "repo", github.ListIssuesOptions{},
+			func(*github.IssueData) error {
+				seen++
+				cancel()
+				return nil
+			})
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeTimeout, errors.GetCode(err))
+		assert.Equal(t, 1, seen)
+	})
+
+	t.Run("invalid line", func(t *testing.T) {
+
+		provider := setupStreamingExecutor(t, func(...string) {}, "not json\n")
+
+		err := provider.StreamIssues(context.Background(), "owner", "repo", github.ListIssuesOptions{},
+			func(*github.IssueData) error { return nil })
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeInvalidInput, errors.GetCode(err))
+	})
+}
+
+func TestCLIProvider_StreamWorkflowRuns(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+
+		var gotArgs []string
+		provider := setupStreamingExecutor(t, func(args ...string) { gotArgs = args },
+			`{"databaseId": 30433642, "name": "CI", "status": "completed", "conclusion": "success", "headBranch": "main"}`+"\n",
+		)
+
+		var runs []*github.WorkflowRunData
+		err := provider.StreamWorkflowRuns(context.Background(), "owner", "repo",
+			github.ListWorkflowRunsOptions{Branch: "main", ListOptions: github.ListOptions{PerPage: 50}},
+			func(run *github.WorkflowRunData) error {
+				runs = append(runs, run)
+				return nil
+			})
+
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.Equal(t, int64(30433642), runs[0].ID)
+		assert.Equal(t, "success", runs[0].Conclusion)
+		assert.Equal(t, "repos/owner/repo/actions/runs?branch=main&per_page=50", gotArgs[3])
+	})
+}
+
+func TestCLIProvider_ListRepositories(t *testing.T) {
+	t.Run("paginated", func(t *testing.T) {
+
+		var gotArgs []string
+		provider := setupStreamingExecutor(t, func(args ...string) { gotArgs = args },
+			`{"id": 1, "name": "one", "owner": {"login": "owner"}}`+"\n",
+			`{"id": 2, "name": "two", "owner": {"login": "owner"}, "created_at": "2023-01-01T00:00:00Z"}`+"\n",
+		)
+
+		repos, err := provider.ListRepositories(context.Background(), "owner", github.ListOptions{PerPage: 100})
+
+		require.NoError(t, err)
+		require.Len(t, repos, 2)
+		assert.Equal(t, "two", repos[1].Name)
+		assert.False(t, repos[1].CreatedAt.IsZero())
+		assert.Equal(t, []string{"gh", "api", "users/owner/repos?per_page=100", "--jq", ".[]", "--paginate"}, gotArgs)
+	})
+
+	t.Run("non-streaming executor", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 3 && args[1] == "auth" && args[2] == "status" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			return &exec.Result{Stdout: `{"id": 1, "name": "one"}` + "\n", ExitCode: 0}, nil
+		})
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		repos, err := provider.ListRepositories(context.Background(), "owner", github.ListOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, repos, 1)
+		assert.Equal(t, "one", repos[0].Name)
+	})
+
+	t.Run("falls back to organization", func(t *testing.T) {
+
+		mock := setupMockExecutor(t, func(args ...string) (*exec.Result, error) {
+			if len(args) >= 3 && args[1] == "auth" && args[2] == "status" {
+				return &exec.Result{Stdout: "Logged in", ExitCode: 0}, nil
+			}
+			if args[2] == "users/myorg/repos" {
+				return &exec.Result{Stderr: "HTTP 404: Not Found", ExitCode: 1}, errors.New(errors.CodeExecutionFailed, "exit status 1")
+			}
+			return &exec.Result{Stdout: `{"id": 1, "name": "one"}`, ExitCode: 0}, nil
+		})
+		provider, err := NewCLIProvider(WithExecutor(mock))
+		require.NoError(t, err)
+
+		repos, err := provider.ListRepositories(context.Background(), "myorg", github.ListOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, repos, 1)
+	})
+}
@@ -0,0 +1,187 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/google/go-github/v67/github"
+	gh "github.com/jmgilman/go/github"
+)
+
+// ListDependabotAlerts lists all Dependabot alerts of a repository.
+//
+// The Dependabot alerts API pages with cursors rather than page numbers, so
+// the next page is requested with the cursor from the previous response.
+func (s *SDKProvider) ListDependabotAlerts(ctx context.Context, owner, repo string, opts gh.ListAlertsOptions) ([]*gh.DependabotAlertData, error) {
+	listOpts := &github.ListAlertsOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	if opts.State != "" {
+		listOpts.State = github.String(opts.State)
+	}
+
+	result := []*gh.DependabotAlertData{}
+	for {
+		alerts, resp, err := s.client.Dependabot.ListRepoAlerts(ctx, owner, repo, listOpts)
+		if err != nil {
+			return nil, s.wrapError(err, resp, "failed to list Dependabot alerts")
+		}
+
+		for _, alert := range alerts {
+			result = append(result, s.convertDependabotAlert(alert))
+		}
+		if resp.After == "" {
+			return result, nil
+		}
+		listOpts.After = resp.After
+	}
+}
+
+// ListCodeScanningAlerts lists all code scanning alerts of a repository.
+func (s *SDKProvider) ListCodeScanningAlerts(ctx context.Context, owner, repo string, opts gh.ListAlertsOptions) ([]*gh.CodeScanningAlertData, error) {
+	listOpts := &github.AlertListOptions{
+		State:       opts.State,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	result := []*gh.CodeScanningAlertData{}
+	for {
+		alerts, resp, err := s.client.CodeScanning.ListAlertsForRepo(ctx, owner, repo, listOpts)
+		if err != nil {
+			return nil, s.wrapError(err, resp, "failed to list code scanning alerts")
+		}
+
+		for _, alert := range alerts {
+			result = append(result, s.convertCodeScanningAlert(alert))
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		listOpts.ListOptions.Page = resp.NextPage
+	}
+}
+
+// ListSecretScanningAlerts lists all secret scanning alerts of a repository.
+func (s *SDKProvider) ListSecretScanningAlerts(ctx context.Context, owner, repo string, opts gh.ListAlertsOptions) ([]*gh.SecretScanningAlertData, error) {
+	listOpts := &github.SecretScanningAlertListOptions{
+		State:       opts.State,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	result := []*gh.SecretScanningAlertData{}
+	for {
+		alerts, resp, err := s.client.SecretScanning.ListAlertsForRepo(ctx, owner, repo, listOpts)
+		if err != nil {
+			return nil, s.wrapError(err, resp, "failed to list secret scanning alerts")
+		}
+
+		for _, alert := range alerts {
+			result = append(result, s.convertSecretScanningAlert(alert))
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		listOpts.ListOptions.Page = resp.NextPage
+	}
+}
+
+// convertDependabotAlert converts a go-github DependabotAlert to DependabotAlertData.
+func (s *SDKProvider) convertDependabotAlert(alert *github.DependabotAlert) *gh.DependabotAlertData {
+	if alert == nil {
+		return nil
+	}
+
+	advisory := alert.GetSecurityAdvisory()
+	vulnerability := alert.GetSecurityVulnerability()
+
+	data := &gh.DependabotAlertData{
+		Number:                 alert.GetNumber(),
+		State:                  alert.GetState(),
+		Package:                alert.GetDependency().GetPackage().GetName(),
+		Ecosystem:              alert.GetDependency().GetPackage().GetEcosystem(),
+		ManifestPath:           alert.GetDependency().GetManifestPath(),
+		GHSAID:                 advisory.GetGHSAID(),
+		CVEID:                  advisory.GetCVEID(),
+		Summary:                advisory.GetSummary(),
+		Severity:               advisory.GetSeverity(),
+		VulnerableVersionRange: vulnerability.GetVulnerableVersionRange(),
+		PatchedVersion:         vulnerability.GetFirstPatchedVersion().GetIdentifier(),
+		DismissedReason:        alert.GetDismissedReason(),
+		HTMLURL:                alert.GetHTMLURL(),
+		CreatedAt:              alert.GetCreatedAt().Time,
+		UpdatedAt:              alert.GetUpdatedAt().Time,
+	}
+
+	if dismissedAt := alert.GetDismissedAt(); !dismissedAt.IsZero() {
+		t := dismissedAt.Time
+		data.DismissedAt = &t
+	}
+	if fixedAt := alert.GetFixedAt(); !fixedAt.IsZero() {
+		t := fixedAt.Time
+		data.FixedAt = &t
+	}
+
+	return data
+}
+
+// convertCodeScanningAlert converts a go-github code scanning Alert to CodeScanningAlertData.
+func (s *SDKProvider) convertCodeScanningAlert(alert *github.Alert) *gh.CodeScanningAlertData {
+	if alert == nil {
+		return nil
+	}
+
+	instance := alert.GetMostRecentInstance()
+
+	data := &gh.CodeScanningAlertData{
+		Number:           alert.GetNumber(),
+		State:            alert.GetState(),
+		RuleID:           alert.GetRule().GetID(),
+		RuleDescription:  alert.GetRule().GetDescription(),
+		Severity:         alert.GetRule().GetSeverity(),
+		SecuritySeverity: alert.GetRule().GetSecuritySeverityLevel(),
+		Tool:             alert.GetTool().GetName(),
+		Ref:              instance.GetRef(),
+		Path:             instance.GetLocation().GetPath(),
+		StartLine:        instance.GetLocation().GetStartLine(),
+		DismissedReason:  alert.GetDismissedReason(),
+		HTMLURL:          alert.GetHTMLURL(),
+		CreatedAt:        alert.GetCreatedAt().Time,
+		UpdatedAt:        alert.GetUpdatedAt().Time,
+	}
+
+	if dismissedAt := alert.GetDismissedAt(); !dismissedAt.IsZero() {
+		t := dismissedAt.Time
+		data.DismissedAt = &t
+	}
+	if fixedAt := alert.GetFixedAt(); !fixedAt.IsZero() {
+		t := fixedAt.Time
+		data.FixedAt = &t
+	}
+
+	return data
+}
+
+// convertSecretScanningAlert converts a go-github SecretScanningAlert to SecretScanningAlertData.
+func (s *SDKProvider) convertSecretScanningAlert(alert *github.SecretScanningAlert) *gh.SecretScanningAlertData {
+	if alert == nil {
+		return nil
+	}
+
+	data := &gh.SecretScanningAlertData{
+		Number:                 alert.GetNumber(),
+		State:                  alert.GetState(),
+		SecretType:             alert.GetSecretType(),
+		SecretTypeDisplayName:  alert.GetSecretTypeDisplayName(),
+		Resolution:             alert.GetResolution(),
+		PushProtectionBypassed: alert.GetPushProtectionBypassed(),
+		HTMLURL:                alert.GetHTMLURL(),
+		CreatedAt:              alert.GetCreatedAt().Time,
+		UpdatedAt:              alert.GetUpdatedAt().Time,
+	}
+
+	if resolvedAt := alert.GetResolvedAt(); !resolvedAt.IsZero() {
+		t := resolvedAt.Time
+		data.ResolvedAt = &t
+	}
+
+	return data
+}
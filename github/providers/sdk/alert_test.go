@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v67/github"
+	gh "github.com/jmgilman/go/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDKProvider_ListDependabotAlerts(t *testing.T) {
+	t.Parallel()
+
+	var states []string
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		states = append(states, r.URL.Query().Get("state"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			w.Header().Set("Link", `<`+server.URL+r.URL.Path+`?per_page=100&state=open&after=Y3Vyc29y>; rel="next"`)
+			_, _ = w.Write([]byte(`[{
+				"number": 2,
+				"state": "open",
+				"dependency": {"package": {"ecosystem": "go", "name": "golang.org/x/net"}, "manifest_path": "go.mod"},
+				"security_advisory": {"ghsa_id": "GHSA-4374-p667-p6c8", "cve_id": "CVE-2023-39325", "summary": "HTTP/2 rapid reset", "severity": "high"},
+				"security_vulnerability": {"vulnerable_version_range": "< 0.17.0", "first_patched_version": {"identifier": "0.17.0"}},
+				"html_url": "https://github.com/testowner/testrepo/security/dependabot/2",
+				"created_at": "2024-01-02T03:04:05Z",
+				"updated_at": "2024-01-02T03:04:05Z",
+				"dismissed_at": null,
+				"fixed_at": null
+			}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"number": 1, "state": "open"}]`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	alerts, err := provider.ListDependabotAlerts(context.Background(), "testowner", "testrepo", gh.ListAlertsOptions{State: "open"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"open", "open"}, states)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, &gh.DependabotAlertData{
+		Number:                 2,
+		State:                  "open",
+		Package:                "golang.org/x/net",
+		Ecosystem:              "go",
+		ManifestPath:           "go.mod",
+		GHSAID:                 "GHSA-4374-p667-p6c8",
+		CVEID:                  "CVE-2023-39325",
+		Summary:                "HTTP/2 rapid reset",
+		Severity:               "high",
+		VulnerableVersionRange: "< 0.17.0",
+		PatchedVersion:         "0.17.0",
+		HTMLURL:                "https://github.com/testowner/testrepo/security/dependabot/2",
+		CreatedAt:              time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		UpdatedAt:              time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}, alerts[0])
+	assert.Equal(t, 1, alerts[1].Number)
+}
@@ -0,0 +1,351 @@
+package sdk
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/fs/core"
+)
+
+// CacheStore persists responses for CacheTransport.
+//
+// Keys are hex-encoded SHA-256 digests and are safe to use as file names.
+// Implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the value stored under key.
+	// The boolean is false if no value is stored.
+	Get(key string) ([]byte, bool, error)
+
+	// Set stores value under key, replacing any existing value.
+	Set(key string, value []byte) error
+
+	// Delete removes the value stored under key.
+	// Deleting a missing key is not an error.
+	Delete(key string) error
+}
+
+// MaxCacheEntrySize is the largest response body CacheTransport stores.
+const MaxCacheEntrySize = 10 << 20
+
+// CacheTransport is an http.RoundTripper that caches GET responses and
+// revalidates them with conditional requests.
+//
+// Successful responses carrying an ETag or Last-Modified header are stored.
+// Subsequent requests for the same URL send If-None-Match and
+// If-Modified-Since, and a 304 Not Modified reply is answered from the cache.
+// GitHub does not count 304 responses against the primary rate limit, so
+// polling unchanged resources becomes effectively free.
+//
+// Every request still reaches GitHub, so cached data is never stale. Entries
+// are keyed by URL, Accept header, and Authorization header, so the transport
+// must sit below the layer that adds credentials; SDKProvider does this for
+// WithToken. Stores shared between credentials that are injected below this
+// transport (such as a client passed to WithClient) should be partitioned per
+// identity.
+//
+// Responses larger than MaxCacheEntrySize are passed through without being
+// cached. Store failures never fail a request; the transport falls back to an
+// unconditional request instead.
+type CacheTransport struct {
+	base  http.RoundTripper
+	store CacheStore
+}
+
+// NewCacheTransport wraps base with conditional request caching backed by store.
+// If base is nil, http.DefaultTransport is used.
+//
+// Example:
+//
+//	store := sdk.NewFSCacheStore(fsys, ".cache/github")
+//	httpClient := &http.Client{
+//	    Transport: sdk.NewCacheTransport(nil, store),
+//	}
+//	ghClient := github.NewClient(httpClient).WithAuthToken("ghp_...")
+func NewCacheTransport(base http.RoundTripper, store CacheStore) *CacheTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &CacheTransport{
+		base:  base,
+		store: store,
+	}
+}
+
+// cacheEntry is the serialized form of a cached response.
+type cacheEntry struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isCacheable(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	entry := t.load(key)
+
+	r := req
+	if entry != nil {
+		r = req.Clone(req.Context())
+		if etag := entry.Header.Get("ETag"); etag != "" {
+			r.Header.Set("If-None-Match", etag)
+		}
+		if modified := entry.Header.Get("Last-Modified"); modified != "" {
+			r.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && entry != nil:
+		return t.fromCache(req, resp, entry), nil
+	case resp.StatusCode == http.StatusOK && hasValidator(resp.Header):
+		return t.save(key, resp)
+	default:
+		return resp, nil
+	}
+}
+
+// load returns the cached entry for key, or nil if there is no usable entry.
+func (t *CacheTransport) load(key string) *cacheEntry {
+	data, ok, err := t.store.Get(key)
+	if err != nil || !ok {
+		return nil
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		_ = t.store.Delete(key)
+		return nil
+	}
+	return &entry
+}
+
+// save stores a successful response and returns it with a replayable body.
+// Bodies larger than MaxCacheEntrySize are returned without being stored.
+func (t *CacheTransport) save(key string, resp *http.Response) (*http.Response, error) {
+	if resp.ContentLength > MaxCacheEntrySize {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxCacheEntrySize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if len(body) > MaxCacheEntrySize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	data, err := json.Marshal(cacheEntry{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	})
+	if err == nil {
+		_ = t.store.Set(key, data)
+	}
+
+	return resp, nil
+}
+
+// fromCache builds the response for a 304 reply from the cached entry.
+// Headers from the 304 reply, such as the current rate limit, take precedence.
+func (t *CacheTransport) fromCache(req *http.Request, notModified *http.Response, entry *cacheEntry) *http.Response {
+	// Drain so the underlying connection can be reused.
+	_, _ = io.Copy(io.Discard, notModified.Body)
+	_ = notModified.Body.Close()
+
+	header := entry.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	for name, values := range notModified.Header {
+		header[name] = values
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+		TLS:           notModified.TLS,
+	}
+}
+
+// isCacheable reports whether req may be answered from the cache.
+// Requests that already carry their own validators or ask for a byte range
+// are passed through untouched.
+func isCacheable(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+	for _, name := range []string{"If-None-Match", "If-Modified-Since", "Range"} {
+		if req.Header.Get(name) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// hasValidator reports whether header carries a validator that can be used
+// in a conditional request.
+func hasValidator(header http.Header) bool {
+	return header.Get("ETag") != "" || header.Get("Last-Modified") != ""
+}
+
+// cacheKey derives the cache key for req.
+func cacheKey(req *http.Request) string {
+	h := sha256.New()
+	for _, part := range []string{
+		req.URL.String(),
+		req.Header.Get("Accept"),
+		req.Header.Get("Authorization"),
+	} {
+		_, _ = io.WriteString(h, part)
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// FSCacheStore is a CacheStore that keeps one file per entry in a directory
+// of a core.FS filesystem.
+type FSCacheStore struct {
+	fsys core.FS
+	dir  string
+}
+
+// NewFSCacheStore creates a store that writes entries under dir in fsys.
+// The directory is created on first write.
+//
+// Example:
+//
+//	provider, err := sdk.NewSDKProvider(
+//	    sdk.WithToken("ghp_..."),
+//	    sdk.WithCache(sdk.NewFSCacheStore(fsys, ".cache/github")),
+//	)
+func NewFSCacheStore(fsys core.FS, dir string) *FSCacheStore {
+	return &FSCacheStore{
+		fsys: fsys,
+		dir:  dir,
+	}
+}
+
+// Get implements CacheStore.
+func (s *FSCacheStore) Get(key string) ([]byte, bool, error) {
+	data, err := s.fsys.ReadFile(s.path(key))
+	if err != nil {
+		if errors.Is(err, core.ErrNotExist) {
+			return nil, false, nil
+		}
+		return nil, false, errors.Wrap(err, errors.CodeInternal, "failed to read cache entry")
+	}
+	return data, true, nil
+}
+
+// Set implements CacheStore.
+//
+// Entries are written to a uniquely named temporary file and renamed into
+// place, so concurrent readers never observe a partial entry and concurrent
+// writers of the same key never write to the same file.
+func (s *FSCacheStore) Set(key string, value []byte) error {
+	if err := s.fsys.MkdirAll(s.dir, 0o755); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to create cache directory")
+	}
+
+	var suffix [8]byte
+	if _, err := rand.Read(suffix[:]); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to name cache entry")
+	}
+	tmp := s.path(key) + "." + hex.EncodeToString(suffix[:]) + ".tmp"
+	if err := s.fsys.WriteFile(tmp, value, 0o600); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to write cache entry")
+	}
+	if err := s.fsys.Rename(tmp, s.path(key)); err != nil {
+		_ = s.fsys.Remove(tmp)
+		return errors.Wrap(err, errors.CodeInternal, "failed to write cache entry")
+	}
+	return nil
+}
+
+// Delete implements CacheStore.
+func (s *FSCacheStore) Delete(key string) error {
+	if err := s.fsys.Remove(s.path(key)); err != nil && !errors.Is(err, core.ErrNotExist) {
+		return errors.Wrap(err, errors.CodeInternal, "failed to delete cache entry")
+	}
+	return nil
+}
+
+// path returns the file path for key.
+func (s *FSCacheStore) path(key string) string {
+	return path.Join(s.dir, key)
+}
+
+// MemoryCacheStore is an in-memory CacheStore. Entries do not survive the
+// process, which makes it suitable for long-running pollers and tests.
+type MemoryCacheStore struct {
+	mu      sync.RWMutex
+	entries map[string][]byte
+}
+
+// NewMemoryCacheStore creates an empty in-memory store.
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{
+		entries: make(map[string][]byte),
+	}
+}
+
+// Get implements CacheStore.
+func (s *MemoryCacheStore) Get(key string) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, ok := s.entries[key]
+	return data, ok, nil
+}
+
+// Set implements CacheStore.
+func (s *MemoryCacheStore) Set(key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = value
+	return nil
+}
+
+// Delete implements CacheStore.
+func (s *MemoryCacheStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
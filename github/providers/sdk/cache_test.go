@@ -0,0 +1,216 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("serves not modified responses from cache", func(t *testing.T) {
+		t.Parallel()
+
+		var requests, conditional atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				conditional.Add(1)
+				w.Header().Set("X-RateLimit-Remaining", "4999")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("X-RateLimit-Remaining", "5000")
+			_, _ = w.Write([]byte(`{"id": 1}`))
+		}))
+		t.Cleanup(server.Close)
+
+		client := &http.Client{Transport: NewCacheTransport(nil, NewMemoryCacheStore())}
+
+		for i := 0; i < 3; i++ {
+			resp, err := client.Get(server.URL + "/repos/owner/repo")
+			require.NoError(t, err)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, `{"id": 1}`, string(body))
+			if i > 0 {
+				assert.Equal(t, "4999", resp.Header.Get("X-RateLimit-Remaining"))
+				assert.Equal(t, `"v1"`, resp.Header.Get("ETag"))
+			}
+		}
+
+		assert.Equal(t, int32(3), requests.Load())
+		assert.Equal(t, int32(2), conditional.Load())
+	})
+
+	t.Run("replaces entry when resource changes", func(t *testing.T) {
+		t.Parallel()
+
+		var version atomic.Int32
+		version.Store(1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			etag := `"v` + string(rune('0'+version.Load())) + `"`
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write([]byte(etag))
+		}))
+		t.Cleanup(server.Close)
+
+		client := &http.Client{Transport: NewCacheTransport(nil, NewMemoryCacheStore())}
+		get := func() string {
+			resp, err := client.Get(server.URL)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return string(body)
+		}
+
+		assert.Equal(t, `"v1"`, get())
+		version.Store(2)
+		assert.Equal(t, `"v2"`, get())
+		assert.Equal(t, `"v2"`, get())
+	})
+
+	t.Run("does not cache other methods", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewMemoryCacheStore()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("If-None-Match"))
+			w.Header().Set("ETag", `"v1"`)
+			w.WriteHeader(http.StatusCreated)
+		}))
+		t.Cleanup(server.Close)
+
+		client := &http.Client{Transport: NewCacheTransport(nil, store)}
+		for i := 0; i < 2; i++ {
+			resp, err := client.Post(server.URL, "application/json", nil)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+		}
+
+		assert.Empty(t, store.entries)
+	})
+
+	t.Run("does not cache responses without validators", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewMemoryCacheStore()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("ok"))
+		}))
+		t.Cleanup(server.Close)
+
+		client := &http.Client{Transport: NewCacheTransport(nil, store)}
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		assert.Empty(t, store.entries)
+	})
+
+	t.Run("ignores corrupt entries", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("If-None-Match"))
+			_, _ = w.Write([]byte("fresh"))
+		}))
+		t.Cleanup(server.Close)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		store := NewMemoryCacheStore()
+		require.NoError(t, store.Set(cacheKey(req), []byte("not json")))
+
+		resp, err := NewCacheTransport(nil, store).RoundTrip(req)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, "fresh", string(body))
+		_, ok, err := store.Get(cacheKey(req))
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("does not cache oversized responses", func(t *testing.T) {
+		t.Parallel()
+
+		body := strings.Repeat("x", MaxCacheEntrySize+1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		store := NewMemoryCacheStore()
+		resp, err := NewCacheTransport(nil, store).RoundTrip(req)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		got, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		assert.Len(t, got, len(body))
+		_, ok, err := store.Get(cacheKey(req))
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestSDKProvider_WithCache(t *testing.T) {
+	t.Parallel()
+
+	var conditional atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/repos/testowner/testrepo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"`+r.Header.Get("Authorization")+`"`)
+		_, _ = w.Write([]byte(`{"id": 123, "name": "testrepo"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	store := NewMemoryCacheStore()
+	newProvider := func(token string) *SDKProvider {
+		provider, err := NewSDKProvider(WithToken(token), WithBaseURL(server.URL+"/"), WithCache(store))
+		require.NoError(t, err)
+		return provider
+	}
+
+	_, err := newProvider("token-a").GetRepository(context.Background(), "testowner", "testrepo")
+	require.NoError(t, err)
+	_, err = newProvider("token-b").GetRepository(context.Background(), "testowner", "testrepo")
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), conditional.Load(), "entries must not be shared between tokens")
+
+	_, err = newProvider("token-a").GetRepository(context.Background(), "testowner", "testrepo")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), conditional.Load())
+}
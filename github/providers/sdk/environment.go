@@ -0,0 +1,201 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-github/v67/github"
+	"github.com/jmgilman/go/errors"
+	gh "github.com/jmgilman/go/github"
+)
+
+// ListEnvironments lists the deployment environments of a repository.
+func (s *SDKProvider) ListEnvironments(ctx context.Context, owner, repo string, opts gh.ListOptions) ([]*gh.EnvironmentData, error) {
+	listOpts := &github.EnvironmentListOptions{
+		ListOptions: github.ListOptions{
+			Page:    opts.Page,
+			PerPage: opts.PerPage,
+		},
+	}
+
+	envs, resp, err := s.client.Repositories.ListEnvironments(ctx, owner, repo, listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list environments")
+	}
+
+	result := make([]*gh.EnvironmentData, len(envs.Environments))
+	for i, env := range envs.Environments {
+		result[i] = s.convertEnvironment(env)
+	}
+
+	return result, nil
+}
+
+// GetEnvironment retrieves a deployment environment by name.
+func (s *SDKProvider) GetEnvironment(ctx context.Context, owner, repo, name string) (*gh.EnvironmentData, error) {
+	env, resp, err := s.client.Repositories.GetEnvironment(ctx, owner, repo, name)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get environment")
+	}
+
+	return s.convertEnvironment(env), nil
+}
+
+// CreateOrUpdateEnvironment creates an environment or replaces its protection rules.
+func (s *SDKProvider) CreateOrUpdateEnvironment(ctx context.Context, owner, repo, name string, opts gh.EnvironmentOptions) (*gh.EnvironmentData, error) {
+	req := &github.CreateUpdateEnvironment{
+		WaitTimer:         github.Int(opts.WaitTimer),
+		Reviewers:         make([]*github.EnvReviewers, len(opts.Reviewers)),
+		PreventSelfReview: github.Bool(opts.PreventSelfReview),
+		CanAdminsBypass:   opts.CanAdminsBypass,
+	}
+	for i, reviewer := range opts.Reviewers {
+		req.Reviewers[i] = &github.EnvReviewers{
+			Type: github.String(reviewer.Type),
+			ID:   github.Int64(reviewer.ID),
+		}
+	}
+	if policy := opts.DeploymentBranchPolicy; policy != nil {
+		req.DeploymentBranchPolicy = &github.BranchPolicy{
+			ProtectedBranches:    github.Bool(policy.ProtectedBranches),
+			CustomBranchPolicies: github.Bool(policy.CustomBranchPolicies),
+		}
+	}
+
+	env, resp, err := s.client.Repositories.CreateUpdateEnvironment(ctx, owner, repo, name, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create or update environment")
+	}
+
+	return s.convertEnvironment(env), nil
+}
+
+// DeleteEnvironment deletes a deployment environment.
+func (s *SDKProvider) DeleteEnvironment(ctx context.Context, owner, repo, name string) error {
+	resp, err := s.client.Repositories.DeleteEnvironment(ctx, owner, repo, name)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete environment")
+	}
+
+	return nil
+}
+
+// ListDeploymentBranchPolicies lists all custom deployment branch policies of an environment.
+//
+// go-github only fetches the first page of policies, so the pages are
+// requested directly.
+func (s *SDKProvider) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string) ([]*gh.DeploymentBranchPolicyData, error) {
+	u := fmt.Sprintf("repos/%s/%s/environments/%s/deployment-branch-policies", owner, repo, url.PathEscape(environment))
+
+	result := []*gh.DeploymentBranchPolicyData{}
+	for page := 1; page != 0; {
+		req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("%s?per_page=100&page=%d", u, page), nil)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.CodeInternal, "failed to create deployment branch policy request")
+		}
+
+		policies := new(github.DeploymentBranchPolicyResponse)
+		resp, err := s.client.Do(ctx, req, policies)
+		if err != nil {
+			return nil, s.wrapError(err, resp, "failed to list deployment branch policies")
+		}
+
+		for _, policy := range policies.BranchPolicies {
+			result = append(result, s.convertDeploymentBranchPolicy(policy))
+		}
+		page = resp.NextPage
+	}
+
+	return result, nil
+}
+
+// CreateDeploymentBranchPolicy adds a custom deployment branch policy to an environment.
+func (s *SDKProvider) CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, policy gh.DeploymentBranchPolicyData) (*gh.DeploymentBranchPolicyData, error) {
+	req := &github.DeploymentBranchPolicyRequest{
+		Name: github.String(policy.Name),
+	}
+	if policy.Type != "" {
+		req.Type = github.String(policy.Type)
+	}
+
+	created, resp, err := s.client.Repositories.CreateDeploymentBranchPolicy(ctx, owner, repo, environment, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create deployment branch policy")
+	}
+
+	return s.convertDeploymentBranchPolicy(created), nil
+}
+
+// DeleteDeploymentBranchPolicy removes a custom deployment branch policy.
+func (s *SDKProvider) DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, policyID int64) error {
+	resp, err := s.client.Repositories.DeleteDeploymentBranchPolicy(ctx, owner, repo, environment, policyID)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete deployment branch policy")
+	}
+
+	return nil
+}
+
+// convertEnvironment converts a go-github Environment to EnvironmentData.
+// Wait timers and reviewers are reported as protection rules.
+func (s *SDKProvider) convertEnvironment(env *github.Environment) *gh.EnvironmentData {
+	if env == nil {
+		return nil
+	}
+
+	data := &gh.EnvironmentData{
+		ID:              env.GetID(),
+		Name:            env.GetName(),
+		Reviewers:       []gh.EnvironmentReviewer{},
+		CanAdminsBypass: env.GetCanAdminsBypass(),
+		HTMLURL:         env.GetHTMLURL(),
+		CreatedAt:       env.GetCreatedAt().Time,
+		UpdatedAt:       env.GetUpdatedAt().Time,
+	}
+
+	if policy := env.DeploymentBranchPolicy; policy != nil {
+		data.DeploymentBranchPolicy = &gh.DeploymentBranchPolicy{
+			ProtectedBranches:    policy.GetProtectedBranches(),
+			CustomBranchPolicies: policy.GetCustomBranchPolicies(),
+		}
+	}
+
+	for _, rule := range env.ProtectionRules {
+		switch rule.GetType() {
+		case "wait_timer":
+			data.WaitTimer = rule.GetWaitTimer()
+		case "required_reviewers":
+			data.PreventSelfReview = rule.GetPreventSelfReview()
+			for _, required := range rule.Reviewers {
+				reviewer := gh.EnvironmentReviewer{Type: required.GetType()}
+				switch r := required.Reviewer.(type) {
+				case *github.User:
+					reviewer.ID = r.GetID()
+					reviewer.Name = r.GetLogin()
+				case *github.Team:
+					reviewer.ID = r.GetID()
+					reviewer.Name = r.GetSlug()
+				}
+				data.Reviewers = append(data.Reviewers, reviewer)
+			}
+		}
+	}
+
+	return data
+}
+
+// convertDeploymentBranchPolicy converts a go-github DeploymentBranchPolicy
+// to DeploymentBranchPolicyData.
+func (s *SDKProvider) convertDeploymentBranchPolicy(policy *github.DeploymentBranchPolicy) *gh.DeploymentBranchPolicyData {
+	if policy == nil {
+		return nil
+	}
+
+	return &gh.DeploymentBranchPolicyData{
+		ID:   policy.GetID(),
+		Name: policy.GetName(),
+		Type: policy.GetType(),
+	}
+}
@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v67/github"
+	gh "github.com/jmgilman/go/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDKProvider_CreateOrUpdateEnvironment(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]interface{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/environments/production", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"id": 161088068,
+			"name": "production",
+			"html_url": "https://github.com/testowner/testrepo/deployments/activity_log?environments_filter=production",
+			"can_admins_bypass": false,
+			"protection_rules": [
+				{"id": 3736, "type": "wait_timer", "wait_timer": 30},
+				{
+					"id": 3755,
+					"type": "required_reviewers",
+					"prevent_self_review": true,
+					"reviewers": [
+						{"type": "User", "reviewer": {"id": 1, "login": "octocat"}},
+						{"type": "Team", "reviewer": {"id": 42, "slug": "platform"}}
+					]
+				},
+				{"id": 3756, "type": "branch_policy"}
+			],
+			"deployment_branch_policy": {"protected_branches": false, "custom_branch_policies": true},
+			"created_at": "2024-01-02T03:04:05Z",
+			"updated_at": "2024-01-02T03:04:05Z"
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	env, err := provider.CreateOrUpdateEnvironment(context.Background(), "testowner", "testrepo", "production", gh.EnvironmentOptions{
+		WaitTimer:              30,
+		Reviewers:              []gh.EnvironmentReviewer{{Type: "Team", ID: 42}},
+		PreventSelfReview:      true,
+		DeploymentBranchPolicy: &gh.DeploymentBranchPolicy{CustomBranchPolicies: true},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, float64(30), received["wait_timer"])
+	assert.Equal(t, true, received["prevent_self_review"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"type": "Team", "id": float64(42)}}, received["reviewers"])
+	assert.Equal(t, map[string]interface{}{"protected_branches": false, "custom_branch_policies": true}, received["deployment_branch_policy"])
+
+	assert.Equal(t, int64(161088068), env.ID)
+	assert.Equal(t, "production", env.Name)
+	assert.Equal(t, 30, env.WaitTimer)
+	assert.True(t, env.PreventSelfReview)
+	assert.False(t, env.CanAdminsBypass)
+	assert.Equal(t, []gh.EnvironmentReviewer{
+		{Type: "User", ID: 1, Name: "octocat"},
+		{Type: "Team", ID: 42, Name: "platform"},
+	}, env.Reviewers)
+	require.NotNil(t, env.DeploymentBranchPolicy)
+	assert.True(t, env.DeploymentBranchPolicy.CustomBranchPolicies)
+}
+
+func TestSDKProvider_ListDeploymentBranchPolicies(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/environments/production/deployment-branch-policies", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("Link", `<`+server.URL+r.URL.Path+`?per_page=100&page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`{"total_count": 2, "branch_policies": [{"id": 1, "name": "main", "type": "branch"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"total_count": 2, "branch_policies": [{"id": 2, "name": "v*", "type": "tag"}]}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	policies, err := provider.ListDeploymentBranchPolicies(context.Background(), "testowner", "testrepo", "production")
+
+	require.NoError(t, err)
+	assert.Equal(t, []*gh.DeploymentBranchPolicyData{
+		{ID: 1, Name: "main", Type: "branch"},
+		{ID: 2, Name: "v*", Type: "tag"},
+	}, policies)
+}
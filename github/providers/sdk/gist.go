@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/google/go-github/v67/github"
+	"github.com/jmgilman/go/errors"
+	gh "github.com/jmgilman/go/github"
+)
+
+// ListGists lists the gists of the authenticated user.
+func (s *SDKProvider) ListGists(ctx context.Context, opts gh.ListOptions) ([]*gh.GistData, error) {
+	listOpts := &github.GistListOptions{
+		ListOptions: github.ListOptions{
+			Page:    opts.Page,
+			PerPage: opts.PerPage,
+		},
+	}
+
+	gists, resp, err := s.client.Gists.List(ctx, "", listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list gists")
+	}
+
+	result := make([]*gh.GistData, len(gists))
+	for i, gist := range gists {
+		result[i] = s.convertGist(gist)
+	}
+
+	return result, nil
+}
+
+// GetGist retrieves a gist, including the content of its files.
+func (s *SDKProvider) GetGist(ctx context.Context, id string) (*gh.GistData, error) {
+	gist, resp, err := s.client.Gists.Get(ctx, id)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get gist")
+	}
+
+	return s.convertGist(gist), nil
+}
+
+// CreateGist creates a gist owned by the authenticated user.
+func (s *SDKProvider) CreateGist(ctx context.Context, opts gh.CreateGistOptions) (*gh.GistData, error) {
+	req := &github.Gist{
+		Description: github.String(opts.Description),
+		Public:      github.Bool(opts.Public),
+		Files:       make(map[github.GistFilename]github.GistFile, len(opts.Files)),
+	}
+	for name, content := range opts.Files {
+		req.Files[github.GistFilename(name)] = github.GistFile{Content: github.String(content)}
+	}
+
+	gist, resp, err := s.client.Gists.Create(ctx, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create gist")
+	}
+
+	return s.convertGist(gist), nil
+}
+
+// UpdateGist updates the description and files of a gist.
+//
+// Deleting a file requires sending it as null, which go-github's Gist type
+// cannot express, so the request body is built directly.
+func (s *SDKProvider) UpdateGist(ctx context.Context, id string, opts gh.UpdateGistOptions) (*gh.GistData, error) {
+	files := make(map[string]interface{}, len(opts.Files)+len(opts.DeleteFiles))
+	for name, content := range opts.Files {
+		files[name] = map[string]string{"content": content}
+	}
+	for _, name := range opts.DeleteFiles {
+		files[name] = nil
+	}
+
+	body := map[string]interface{}{
+		"files": files,
+	}
+	if opts.Description != nil {
+		body["description"] = *opts.Description
+	}
+
+	req, err := s.client.NewRequest(http.MethodPatch, "gists/"+id, body)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternal, "failed to create gist request")
+	}
+
+	gist := new(github.Gist)
+	resp, err := s.client.Do(ctx, req, gist)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to update gist")
+	}
+
+	return s.convertGist(gist), nil
+}
+
+// DeleteGist deletes a gist.
+func (s *SDKProvider) DeleteGist(ctx context.Context, id string) error {
+	resp, err := s.client.Gists.Delete(ctx, id)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete gist")
+	}
+
+	return nil
+}
+
+// DownloadGistFile downloads the full content of a gist file from its raw URL.
+func (s *SDKProvider) DownloadGistFile(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	return s.download(ctx, rawURL, "failed to download gist file")
+}
+
+// convertGist converts a go-github Gist to GistData.
+//
+// go-github does not expose the truncated flag of gist files, so a file is
+// reported as truncated when its content is shorter than its size.
+func (s *SDKProvider) convertGist(gist *github.Gist) *gh.GistData {
+	if gist == nil {
+		return nil
+	}
+
+	data := &gh.GistData{
+		ID:          gist.GetID(),
+		Description: gist.GetDescription(),
+		Files:       make(map[string]*gh.GistFileData, len(gist.Files)),
+		Public:      gist.GetPublic(),
+		Owner:       gist.GetOwner().GetLogin(),
+		HTMLURL:     gist.GetHTMLURL(),
+		CreatedAt:   gist.GetCreatedAt().Time,
+		UpdatedAt:   gist.GetUpdatedAt().Time,
+	}
+
+	for name, file := range gist.Files {
+		data.Files[string(name)] = &gh.GistFileData{
+			Filename:  file.GetFilename(),
+			Language:  file.GetLanguage(),
+			Size:      file.GetSize(),
+			RawURL:    file.GetRawURL(),
+			Content:   file.GetContent(),
+			Truncated: file.Content != nil && len(file.GetContent()) < file.GetSize(),
+		}
+	}
+
+	return data
+}
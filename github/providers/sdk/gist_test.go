@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v67/github"
+	gh "github.com/jmgilman/go/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDKProvider_UpdateGist(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]interface{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/gists/aa5a315d61ae9438b18d", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"id": "aa5a315d61ae9438b18d",
+			"description": "Debug bundle",
+			"public": false,
+			"owner": {"login": "octocat"},
+			"html_url": "https://gist.github.com/aa5a315d61ae9438b18d",
+			"files": {
+				"trace.log": {
+					"filename": "trace.log",
+					"language": "Text",
+					"size": 12,
+					"raw_url": "https://gist.githubusercontent.com/octocat/aa5a315d61ae9438b18d/raw/trace.log",
+					"content": "trace",
+					"truncated": true
+				}
+			},
+			"created_at": "2024-01-02T03:04:05Z",
+			"updated_at": "2024-01-02T03:04:05Z"
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	gist, err := provider.UpdateGist(context.Background(), "aa5a315d61ae9438b18d", gh.UpdateGistOptions{
+		Files:       map[string]string{"trace.log": "trace"},
+		DeleteFiles: []string{"env.txt"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"files": map[string]interface{}{
+			"trace.log": map[string]interface{}{"content": "trace"},
+			"env.txt":   nil,
+		},
+	}, received)
+
+	assert.Equal(t, "aa5a315d61ae9438b18d", gist.ID)
+	assert.Equal(t, "octocat", gist.Owner)
+	assert.False(t, gist.Public)
+	require.Contains(t, gist.Files, "trace.log")
+	assert.Equal(t, "trace", gist.Files["trace.log"].Content)
+	assert.True(t, gist.Files["trace.log"].Truncated)
+}
@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-github/v67/github"
+	gh "github.com/jmgilman/go/github"
+)
+
+// ListNotifications lists notification threads of the authenticated user.
+func (s *SDKProvider) ListNotifications(ctx context.Context, opts gh.ListNotificationsOptions) ([]*gh.NotificationData, error) {
+	listOpts := &github.NotificationListOptions{
+		All:           opts.All,
+		Participating: opts.Participating,
+		ListOptions: github.ListOptions{
+			Page:    opts.Page,
+			PerPage: opts.PerPage,
+		},
+	}
+	if opts.Since != nil {
+		listOpts.Since = *opts.Since
+	}
+	if opts.Before != nil {
+		listOpts.Before = *opts.Before
+	}
+
+	var (
+		notifications []*github.Notification
+		resp          *github.Response
+		err           error
+	)
+	if opts.Owner != "" {
+		notifications, resp, err = s.client.Activity.ListRepositoryNotifications(ctx, opts.Owner, opts.Repo, listOpts)
+	} else {
+		notifications, resp, err = s.client.Activity.ListNotifications(ctx, listOpts)
+	}
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list notifications")
+	}
+
+	result := make([]*gh.NotificationData, len(notifications))
+	for i, notification := range notifications {
+		result[i] = s.convertNotification(notification)
+	}
+
+	return result, nil
+}
+
+// MarkNotificationsRead marks all notifications updated at or before lastReadAt as read.
+func (s *SDKProvider) MarkNotificationsRead(ctx context.Context, lastReadAt time.Time) error {
+	resp, err := s.client.Activity.MarkNotificationsRead(ctx, github.Timestamp{Time: lastReadAt})
+	if err != nil {
+		return s.wrapError(err, resp, "failed to mark notifications as read")
+	}
+
+	return nil
+}
+
+// MarkThreadRead marks a notification thread as read.
+func (s *SDKProvider) MarkThreadRead(ctx context.Context, threadID string) error {
+	resp, err := s.client.Activity.MarkThreadRead(ctx, threadID)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to mark thread as read")
+	}
+
+	return nil
+}
+
+// SetThreadSubscription subscribes to or ignores a notification thread.
+func (s *SDKProvider) SetThreadSubscription(ctx context.Context, threadID string, ignored bool) (*gh.ThreadSubscriptionData, error) {
+	subscription, resp, err := s.client.Activity.SetThreadSubscription(ctx, threadID, &github.Subscription{
+		Ignored: github.Bool(ignored),
+	})
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to set thread subscription")
+	}
+
+	return &gh.ThreadSubscriptionData{
+		Subscribed: subscription.GetSubscribed(),
+		Ignored:    subscription.GetIgnored(),
+		Reason:     subscription.GetReason(),
+		CreatedAt:  subscription.GetCreatedAt().Time,
+	}, nil
+}
+
+// DeleteThreadSubscription removes the subscription to a notification thread.
+func (s *SDKProvider) DeleteThreadSubscription(ctx context.Context, threadID string) error {
+	resp, err := s.client.Activity.DeleteThreadSubscription(ctx, threadID)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete thread subscription")
+	}
+
+	return nil
+}
+
+// convertNotification converts a go-github Notification to NotificationData.
+func (s *SDKProvider) convertNotification(notification *github.Notification) *gh.NotificationData {
+	if notification == nil {
+		return nil
+	}
+
+	data := &gh.NotificationData{
+		ID:         notification.GetID(),
+		Repository: notification.GetRepository().GetFullName(),
+		Reason:     notification.GetReason(),
+		Unread:     notification.GetUnread(),
+		UpdatedAt:  notification.GetUpdatedAt().Time,
+	}
+
+	if subject := notification.Subject; subject != nil {
+		data.SubjectTitle = subject.GetTitle()
+		data.SubjectType = subject.GetType()
+		data.SubjectURL = subject.GetURL()
+	}
+
+	if notification.LastReadAt != nil {
+		lastReadAt := notification.LastReadAt.Time
+		data.LastReadAt = &lastReadAt
+	}
+
+	return data
+}
@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v67/github"
+	gh "github.com/jmgilman/go/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDKProvider_ListNotifications(t *testing.T) {
+	t.Parallel()
+
+	var query map[string]string
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/notifications", func(w http.ResponseWriter, r *http.Request) {
+		query = map[string]string{
+			"all":           r.URL.Query().Get("all"),
+			"participating": r.URL.Query().Get("participating"),
+			"since":         r.URL.Query().Get("since"),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{
+			"id": "1",
+			"repository": {"id": 1296269, "full_name": "testowner/testrepo"},
+			"subject": {
+				"title": "Greetings",
+				"url": "https://api.github.com/repos/testowner/testrepo/issues/123",
+				"type": "Issue"
+			},
+			"reason": "subscribed",
+			"unread": true,
+			"updated_at": "2024-01-02T03:04:05Z",
+			"last_read_at": null
+		}]`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	notifications, err := provider.ListNotifications(context.Background(), gh.ListNotificationsOptions{
+		Participating: true,
+		Since:         &since,
+		Owner:         "testowner",
+		Repo:          "testrepo",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"all":           "",
+		"participating": "true",
+		"since":         "2024-01-01T00:00:00Z",
+	}, query)
+
+	require.Len(t, notifications, 1)
+	assert.Equal(t, &gh.NotificationData{
+		ID:           "1",
+		Repository:   "testowner/testrepo",
+		SubjectTitle: "Greetings",
+		SubjectType:  "Issue",
+		SubjectURL:   "https://api.github.com/repos/testowner/testrepo/issues/123",
+		Reason:       "subscribed",
+		Unread:       true,
+		UpdatedAt:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}, notifications[0])
+}
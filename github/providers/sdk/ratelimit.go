@@ -0,0 +1,315 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v67/github"
+	"github.com/jmgilman/go/errors"
+	gh "github.com/jmgilman/go/github"
+)
+
+// Default retry settings used when RetryPolicy fields are left unset.
+const (
+	defaultMaxRetries = 3
+	defaultBaseDelay  = time.Second
+	defaultMaxWait    = time.Minute
+)
+
+// RetryPolicy controls how the rate limit transport retries requests.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries per request.
+	// Defaults to 3.
+	MaxRetries int
+
+	// BaseDelay is the initial backoff used when GitHub does not say how long
+	// to wait. It doubles on each retry. Defaults to 1 second.
+	BaseDelay time.Duration
+
+	// MaxWait caps how long a single retry may sleep. Requests that would
+	// need to wait longer fail immediately with the rate limit response.
+	// Defaults to 1 minute.
+	MaxWait time.Duration
+}
+
+// RateLimitTransport is an http.RoundTripper that records GitHub rate limit
+// headers and automatically retries requests rejected by secondary rate
+// limits, abuse detection, or transient server errors.
+//
+// Retry-After is honored when present; otherwise the transport waits until
+// the reported rate limit reset or falls back to exponential backoff.
+// Whether a response is retried follows the errors package classification
+// of the equivalent provider error.
+//
+// Requests are only retried if their body can be replayed (req.GetBody is set
+// or the request has no body). Network and server errors are only retried for
+// idempotent methods, since the server may already have applied a POST or
+// PATCH; rate limit rejections are retried for every method.
+type RateLimitTransport struct {
+	base   http.RoundTripper
+	policy RetryPolicy
+
+	// sleep waits for d or until ctx is done. Replaceable for testing.
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu   sync.RWMutex
+	last *gh.RateLimitData
+}
+
+// NewRateLimitTransport wraps base with rate limit tracking and retries.
+// If base is nil, http.DefaultTransport is used.
+//
+// Example:
+//
+//	httpClient := &http.Client{
+//	    Transport: sdk.NewRateLimitTransport(nil, sdk.RetryPolicy{MaxRetries: 5}),
+//	}
+//	ghClient := github.NewClient(httpClient).WithAuthToken("ghp_...")
+func NewRateLimitTransport(base http.RoundTripper, policy RetryPolicy) *RateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if policy.MaxRetries <= 0 {
+		policy.MaxRetries = defaultMaxRetries
+	}
+	if policy.BaseDelay <= 0 {
+		policy.BaseDelay = defaultBaseDelay
+	}
+	if policy.MaxWait <= 0 {
+		policy.MaxWait = defaultMaxWait
+	}
+
+	return &RateLimitTransport{
+		base:   base,
+		policy: policy,
+		sleep:  sleepContext,
+	}
+}
+
+// RateLimit returns the most recent rate limit state observed in a response.
+// Returns nil if no response with rate limit headers has been seen yet.
+func (t *RateLimitTransport) RateLimit() *gh.RateLimitData {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.last == nil {
+		return nil
+	}
+	data := *t.last
+	return &data
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to replay request body: %w", err)
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(r)
+		if err == nil {
+			t.record(resp)
+		}
+
+		if attempt >= t.policy.MaxRetries || !t.canReplay(req) {
+			return resp, err
+		}
+
+		wait, retry := t.retryAfter(req, resp, err, attempt)
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil {
+			// Drain so the underlying connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// canReplay reports whether req can be sent again.
+func (t *RateLimitTransport) canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// retryAfter decides whether the result of an attempt should be retried and
+// how long to wait first.
+func (t *RateLimitTransport) retryAfter(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if err != nil {
+		if req.Context().Err() != nil || !isIdempotent(req.Method) {
+			return 0, false
+		}
+		return t.backoff(attempt), errors.IsRetryable(errors.Wrap(err, errors.CodeNetwork, "request failed"))
+	}
+
+	var wait time.Duration
+	switch {
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		if !isRateLimited(resp) {
+			return 0, false
+		}
+		wait = rateLimitWait(resp)
+	default:
+		if !isIdempotent(req.Method) {
+			return 0, false
+		}
+		statusErr := gh.WrapHTTPError(fmt.Errorf("%s", resp.Status), resp.StatusCode, "request failed")
+		if !errors.IsRetryable(statusErr) {
+			return 0, false
+		}
+	}
+
+	if wait <= 0 {
+		wait = t.backoff(attempt)
+	}
+	if wait > t.policy.MaxWait {
+		return 0, false
+	}
+	return wait, true
+}
+
+// isIdempotent reports whether requests with method can safely be sent again
+// after a failure that may have reached the server.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the exponential backoff delay for attempt.
+func (t *RateLimitTransport) backoff(attempt int) time.Duration {
+	wait := t.policy.BaseDelay << attempt
+	if wait <= 0 || wait > t.policy.MaxWait {
+		return t.policy.MaxWait
+	}
+	return wait
+}
+
+// record stores the rate limit headers from resp, if present.
+func (t *RateLimitTransport) record(resp *http.Response) {
+	data, ok := parseRateLimit(resp.Header)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.last = data
+}
+
+// isRateLimited reports whether a 403 or 429 response was caused by a primary
+// or secondary rate limit rather than a permission problem.
+func isRateLimited(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp.Header.Get("Retry-After") != "" || resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		return true
+	}
+
+	// Secondary limits are otherwise only identifiable from the message body.
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	msg := strings.ToLower(string(body))
+	return strings.Contains(msg, "secondary rate limit") || strings.Contains(msg, "abuse detection")
+}
+
+// rateLimitWait returns how long GitHub asked the client to wait, or zero if
+// the response does not say.
+func rateLimitWait(resp *http.Response) time.Duration {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return time.Until(at)
+		}
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if data, ok := parseRateLimit(resp.Header); ok {
+			return time.Until(data.Reset)
+		}
+	}
+
+	return 0
+}
+
+// parseRateLimit extracts rate limit state from GitHub response headers.
+func parseRateLimit(header http.Header) (*gh.RateLimitData, bool) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return nil, false
+	}
+
+	data := &gh.RateLimitData{
+		Resource: header.Get("X-RateLimit-Resource"),
+		Limit:    limit,
+	}
+	data.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	data.Used, _ = strconv.Atoi(header.Get("X-RateLimit-Used"))
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		data.Reset = time.Unix(reset, 0)
+	}
+
+	return data, true
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// convertRate converts a go-github Rate to RateLimitData.
+func convertRate(resource string, rate *github.Rate) *gh.RateLimitData {
+	if rate == nil {
+		return nil
+	}
+
+	data := &gh.RateLimitData{
+		Resource:  resource,
+		Limit:     rate.Limit,
+		Remaining: rate.Remaining,
+		Used:      rate.Limit - rate.Remaining,
+	}
+	if !rate.Reset.IsZero() {
+		data.Reset = rate.Reset.Time
+	}
+	return data
+}
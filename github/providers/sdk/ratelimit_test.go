@@ -0,0 +1,220 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v67/github"
+	"github.com/jmgilman/go/errors"
+	gh "github.com/jmgilman/go/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRateLimitTestProvider creates a provider with retries enabled against mux.
+func newRateLimitTestProvider(t *testing.T, mux *http.ServeMux, policy RetryPolicy) *SDKProvider {
+	t.Helper()
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client), WithRateLimitRetry(policy))
+	require.NoError(t, err)
+
+	return provider
+}
+
+func TestSDKProvider_RateLimitRetry(t *testing.T) {
+	t.Parallel()
+
+	const (
+		repoJSON           = `{"id": 123, "name": "testrepo", "full_name": "testowner/testrepo", "owner": {"login": "testowner"}}`
+		secondaryLimitJSON = `{"message": "You have exceeded a secondary rate limit.", "documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`
+	)
+
+	t.Run("retries secondary rate limit", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("/repos/testowner/testrepo", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-RateLimit-Limit", "5000")
+			w.Header().Set("X-RateLimit-Remaining", "4990")
+			w.Header().Set("X-RateLimit-Used", "10")
+			w.Header().Set("X-RateLimit-Reset", "1700000000")
+			w.Header().Set("X-RateLimit-Resource", "core")
+			if calls.Add(1) == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(secondaryLimitJSON))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(repoJSON))
+		})
+
+		provider := newRateLimitTestProvider(t, mux, RetryPolicy{BaseDelay: time.Millisecond})
+
+		repo, err := provider.GetRepository(context.Background(), "testowner", "testrepo")
+
+		require.NoError(t, err)
+		assert.Equal(t, "testrepo", repo.Name)
+		assert.Equal(t, int32(2), calls.Load())
+
+		limit := provider.RateLimit()
+		require.NotNil(t, limit)
+		assert.Equal(t, "core", limit.Resource)
+		assert.Equal(t, 5000, limit.Limit)
+		assert.Equal(t, 4990, limit.Remaining)
+		assert.Equal(t, 10, limit.Used)
+		assert.True(t, time.Unix(1700000000, 0).Equal(limit.Reset))
+	})
+
+	t.Run("retries server errors", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("/repos/testowner/testrepo", func(w http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(repoJSON))
+		})
+
+		provider := newRateLimitTestProvider(t, mux, RetryPolicy{BaseDelay: time.Millisecond})
+
+		_, err := provider.GetRepository(context.Background(), "testowner", "testrepo")
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("does not retry server errors for non-idempotent requests", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("/repos/testowner/testrepo/issues", func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		provider := newRateLimitTestProvider(t, mux, RetryPolicy{BaseDelay: time.Millisecond})
+
+		_, err := provider.CreateIssue(context.Background(), "testowner", "testrepo", gh.CreateIssueOptions{Title: "title"})
+
+		require.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("does not retry permission errors", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("/repos/testowner/testrepo", func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+		})
+
+		provider := newRateLimitTestProvider(t, mux, RetryPolicy{BaseDelay: time.Millisecond})
+
+		_, err := provider.GetRepository(context.Background(), "testowner", "testrepo")
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeForbidden, errors.GetCode(err))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("returns rate limit error when retries are exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("/repos/testowner/testrepo", func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(secondaryLimitJSON))
+		})
+
+		provider := newRateLimitTestProvider(t, mux, RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond})
+
+		_, err := provider.GetRepository(context.Background(), "testowner", "testrepo")
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeRateLimit, errors.GetCode(err))
+		assert.True(t, errors.IsRetryable(err))
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("does not wait longer than max wait", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("/repos/testowner/testrepo", func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+
+		provider := newRateLimitTestProvider(t, mux, RetryPolicy{MaxWait: time.Second})
+
+		_, err := provider.GetRepository(context.Background(), "testowner", "testrepo")
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeRateLimit, errors.GetCode(err))
+		assert.Equal(t, int32(1), calls.Load())
+
+		retryAfter, ok := errors.GetRetryAfter(err)
+		assert.True(t, ok)
+		assert.Equal(t, time.Hour, retryAfter)
+	})
+}
+
+func TestSDKProvider_GetRateLimit(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"resources": {"core": {"limit": 5000, "remaining": 4999, "used": 1, "reset": 1700000000}}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	limit, err := provider.GetRateLimit(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "core", limit.Resource)
+	assert.Equal(t, 5000, limit.Limit)
+	assert.Equal(t, 4999, limit.Remaining)
+	assert.Equal(t, 1, limit.Used)
+	assert.True(t, time.Unix(1700000000, 0).Equal(limit.Reset))
+}
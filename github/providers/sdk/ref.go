@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/google/go-github/v67/github"
+	gh "github.com/jmgilman/go/github"
+)
+
+// ListTags lists the tags of a repository.
+func (s *SDKProvider) ListTags(ctx context.Context, owner, repo string, opts gh.ListOptions) ([]*gh.TagData, error) {
+	listOpts := &github.ListOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+
+	tags, resp, err := s.client.Repositories.ListTags(ctx, owner, repo, listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list tags")
+	}
+
+	result := make([]*gh.TagData, len(tags))
+	for i, tag := range tags {
+		result[i] = &gh.TagData{
+			Name:       tag.GetName(),
+			CommitSHA:  tag.GetCommit().GetSHA(),
+			ZipballURL: tag.GetZipballURL(),
+			TarballURL: tag.GetTarballURL(),
+		}
+	}
+
+	return result, nil
+}
+
+// CreateTagObject creates an annotated tag object.
+func (s *SDKProvider) CreateTagObject(ctx context.Context, owner, repo string, opts gh.CreateTagOptions) (*gh.TagObjectData, error) {
+	req := &github.Tag{
+		Tag:     github.String(opts.Tag),
+		Message: github.String(opts.Message),
+		Object: &github.GitObject{
+			SHA:  github.String(opts.SHA),
+			Type: github.String(opts.Type),
+		},
+	}
+	if opts.TaggerName != "" {
+		req.Tagger = &github.CommitAuthor{
+			Name:  github.String(opts.TaggerName),
+			Email: github.String(opts.TaggerEmail),
+		}
+		if opts.TaggedAt != nil {
+			req.Tagger.Date = &github.Timestamp{Time: *opts.TaggedAt}
+		}
+	}
+
+	tag, resp, err := s.client.Git.CreateTag(ctx, owner, repo, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create tag object")
+	}
+
+	return s.convertTag(tag), nil
+}
+
+// CreateRef creates a reference.
+func (s *SDKProvider) CreateRef(ctx context.Context, owner, repo, ref, sha string) (*gh.RefData, error) {
+	req := &github.Reference{
+		Ref:    github.String(ref),
+		Object: &github.GitObject{SHA: github.String(sha)},
+	}
+
+	created, resp, err := s.client.Git.CreateRef(ctx, owner, repo, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create reference")
+	}
+
+	return &gh.RefData{
+		Ref:  created.GetRef(),
+		SHA:  created.GetObject().GetSHA(),
+		Type: created.GetObject().GetType(),
+	}, nil
+}
+
+// DeleteRef deletes a reference.
+func (s *SDKProvider) DeleteRef(ctx context.Context, owner, repo, ref string) error {
+	resp, err := s.client.Git.DeleteRef(ctx, owner, repo, ref)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete reference")
+	}
+
+	return nil
+}
+
+// convertTag converts a go-github Tag to TagObjectData.
+func (s *SDKProvider) convertTag(tag *github.Tag) *gh.TagObjectData {
+	if tag == nil {
+		return nil
+	}
+
+	return &gh.TagObjectData{
+		SHA:         tag.GetSHA(),
+		Tag:         tag.GetTag(),
+		Message:     tag.GetMessage(),
+		ObjectSHA:   tag.GetObject().GetSHA(),
+		ObjectType:  tag.GetObject().GetType(),
+		TaggerName:  tag.GetTagger().GetName(),
+		TaggerEmail: tag.GetTagger().GetEmail(),
+		TaggedAt:    tag.GetTagger().GetDate().Time,
+	}
+}
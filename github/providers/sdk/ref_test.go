@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v67/github"
+	gh "github.com/jmgilman/go/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDKProvider_CreateTagObject(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]interface{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/owner/repo/git/tags", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{
+			"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			"tag": "v1.2.0",
+			"message": "Release v1.2.0",
+			"tagger": {
+				"name": "Release Bot",
+				"email": "bot@example.com",
+				"date": "2024-01-02T03:04:05Z"
+			},
+			"object": {
+				"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+				"type": "commit"
+			}
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	tag, err := provider.CreateTagObject(context.Background(), "owner", "repo", gh.CreateTagOptions{
+		Tag:         "v1.2.0",
+		Message:     "Release v1.2.0",
+		SHA:         "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		Type:        "commit",
+		TaggerName:  "Release Bot",
+		TaggerEmail: "bot@example.com",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.0", received["tag"])
+	assert.Equal(t, "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c", received["object"])
+	assert.Equal(t, "commit", received["type"])
+	assert.Equal(t, "Release Bot", received["tagger"].(map[string]interface{})["name"])
+
+	assert.Equal(t, "940bd336248efae0f9ee5bc7b2d5c985887b16ac", tag.SHA)
+	assert.Equal(t, "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c", tag.ObjectSHA)
+	assert.Equal(t, "commit", tag.ObjectType)
+	assert.Equal(t, "bot@example.com", tag.TaggerEmail)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), tag.TaggedAt)
+}
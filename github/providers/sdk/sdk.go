@@ -7,16 +7,26 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/google/go-github/v67/github"
 	"github.com/jmgilman/go/errors"
 	gh "github.com/jmgilman/go/github"
 )
 
+// maxRedirects is the number of redirects followed when resolving download URLs.
+const maxRedirects = 10
+
 // SDKProvider implements GitHubProvider using the go-github SDK.
 type SDKProvider struct {
-	client *github.Client
+	client    *github.Client
+	transport *RateLimitTransport
 }
 
 // NewSDKProvider creates a provider using the GitHub SDK.
@@ -30,6 +40,13 @@ type SDKProvider struct {
 //	httpClient := &http.Client{Timeout: 30 * time.Second}
 //	ghClient := github.NewClient(httpClient)
 //	provider, err := sdk.NewSDKProvider(sdk.WithClient(ghClient))
+//
+// Example with GitHub Enterprise Server:
+//
+//	provider, err := sdk.NewSDKProvider(
+//	    sdk.WithToken("ghp_..."),
+//	    sdk.WithBaseURL("https://github.example.com"),
+//	)
 func NewSDKProvider(opts ...Option) (*SDKProvider, error) {
 	cfg := &config{}
 
@@ -39,24 +56,86 @@ func NewSDKProvider(opts ...Option) (*SDKProvider, error) {
 		}
 	}
 
-	// If no client was provided, create a default one
+	// If no client was provided, create a default one. Its token is applied
+	// after the other transports are installed.
+	var token string
 	if cfg.client == nil {
 		if cfg.token == "" {
 			err := errors.New(errors.CodeInvalidInput, "either token or client must be provided")
 			return nil, errors.WithContext(err, "field", "token or client")
 		}
-		cfg.client = github.NewClient(nil).WithAuthToken(cfg.token)
+		cfg.client = github.NewClient(nil)
+		token = cfg.token
+	}
+
+	if cfg.baseURL != "" {
+		// Uploads are served from the instance root rather than the REST prefix
+		uploadURL := strings.TrimSuffix(strings.TrimSuffix(cfg.baseURL, "/"), "/api/v3")
+		client, err := cfg.client.WithEnterpriseURLs(cfg.baseURL, uploadURL)
+		if err != nil {
+			wrapped := errors.Wrap(err, errors.CodeInvalidInput, "invalid base URL")
+			return nil, errors.WithContext(wrapped, "field", "baseURL")
+		}
+		cfg.client = client
 	}
 
-	return &SDKProvider{
+	provider := &SDKProvider{
 		client: cfg.client,
-	}, nil
+	}
+
+	if cfg.retry != nil {
+		provider.client, provider.transport = withRateLimitTransport(cfg.client, *cfg.retry)
+	}
+
+	// The cache sits outside the retry transport so that retried requests
+	// are revalidated once and stored once.
+	if cfg.cache != nil {
+		provider.client = withTransport(provider.client, func(base http.RoundTripper) http.RoundTripper {
+			return NewCacheTransport(base, cfg.cache)
+		})
+	}
+
+	// The token is added outermost so the cache sees it and keys entries by it.
+	if token != "" {
+		provider.client = provider.client.WithAuthToken(token)
+	}
+
+	return provider, nil
+}
+
+// withRateLimitTransport returns a copy of client whose requests go through a
+// RateLimitTransport. The original client is left untouched.
+func withRateLimitTransport(client *github.Client, policy RetryPolicy) (*github.Client, *RateLimitTransport) {
+	var transport *RateLimitTransport
+	wrapped := withTransport(client, func(base http.RoundTripper) http.RoundTripper {
+		transport = NewRateLimitTransport(base, policy)
+		return transport
+	})
+
+	return wrapped, transport
+}
+
+// withTransport returns a copy of client whose transport is replaced by the
+// result of wrap. The original client is left untouched.
+func withTransport(client *github.Client, wrap func(http.RoundTripper) http.RoundTripper) *github.Client {
+	httpClient := client.Client()
+	httpClient.Transport = wrap(httpClient.Transport)
+
+	wrapped := github.NewClient(httpClient)
+	wrapped.BaseURL = client.BaseURL
+	wrapped.UploadURL = client.UploadURL
+	wrapped.UserAgent = client.UserAgent
+
+	return wrapped
 }
 
 // config holds configuration for SDKProvider.
 type config struct {
-	client *github.Client
-	token  string
+	client  *github.Client
+	token   string
+	baseURL string
+	retry   *RetryPolicy
+	cache   CacheStore
 }
 
 // Option configures the SDK provider.
@@ -88,6 +167,96 @@ func WithClient(client *github.Client) Option {
 	}
 }
 
+// WithBaseURL targets a GitHub Enterprise Server instance instead of
+// github.com. baseURL is the root of the instance (e.g.
+// "https://github.example.com"); the REST API path ("/api/v3/"), the upload
+// path ("/api/uploads/"), and the GraphQL endpoint ("/api/graphql") are
+// derived from it. URLs already ending in "/api/v3" are accepted as-is.
+//
+// The option applies to clients passed with WithClient as well.
+func WithBaseURL(baseURL string) Option {
+	return func(cfg *config) error {
+		if baseURL == "" {
+			err := errors.New(errors.CodeInvalidInput, "base URL cannot be empty")
+			return errors.WithContext(err, "field", "baseURL")
+		}
+		cfg.baseURL = baseURL
+		return nil
+	}
+}
+
+// WithRateLimitRetry enables automatic retries for requests rejected by
+// GitHub's secondary rate limits and abuse detection, as well as transient
+// server errors. Zero values in policy are replaced with defaults.
+//
+// Example:
+//
+//	provider, err := sdk.NewSDKProvider(
+//	    sdk.WithToken("ghp_..."),
+//	    sdk.WithRateLimitRetry(sdk.RetryPolicy{MaxRetries: 5}),
+//	)
+func WithRateLimitRetry(policy RetryPolicy) Option {
+	return func(cfg *config) error {
+		if policy.MaxRetries < 0 {
+			err := errors.New(errors.CodeInvalidInput, "max retries cannot be negative")
+			return errors.WithContext(err, "field", "MaxRetries")
+		}
+		cfg.retry = &policy
+		return nil
+	}
+}
+
+// WithCache enables conditional requests backed by store. Responses carrying
+// an ETag or Last-Modified header are cached, and later requests for the same
+// resource are answered locally when GitHub replies 304 Not Modified, which
+// does not count against the rate limit. See CacheTransport for details.
+//
+// With WithToken, entries are keyed by the token, so one store can be shared
+// by providers using different tokens. Clients passed to WithClient that add
+// credentials in their own transport need a store per identity.
+//
+// Example:
+//
+//	provider, err := sdk.NewSDKProvider(
+//	    sdk.WithToken("ghp_..."),
+//	    sdk.WithCache(sdk.NewFSCacheStore(fsys, ".cache/github")),
+//	)
+func WithCache(store CacheStore) Option {
+	return func(cfg *config) error {
+		if store == nil {
+			err := errors.New(errors.CodeInvalidInput, "cache store cannot be nil")
+			return errors.WithContext(err, "field", "store")
+		}
+		cfg.cache = store
+		return nil
+	}
+}
+
+// RateLimit returns the rate limit state from the most recent response.
+// Returns nil if WithRateLimitRetry was not used or no response has been
+// received yet. Use GetRateLimit to query GitHub directly.
+func (s *SDKProvider) RateLimit() *gh.RateLimitData {
+	if s.transport == nil {
+		return nil
+	}
+	return s.transport.RateLimit()
+}
+
+// GetRateLimit retrieves the current core API rate limit from GitHub.
+// Querying the rate limit does not count against the quota.
+func (s *SDKProvider) GetRateLimit(ctx context.Context) (*gh.RateLimitData, error) {
+	limits, resp, err := s.client.RateLimit.Get(ctx)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get rate limit")
+	}
+
+	data := convertRate("core", limits.GetCore())
+	if data == nil {
+		return nil, errors.New(errors.CodeInternal, "rate limit response missing core resource")
+	}
+	return data, nil
+}
+
 // CreateRepository creates a new repository.
 func (s *SDKProvider) CreateRepository(ctx context.Context, owner string, opts gh.CreateRepositoryOptions) (*gh.RepositoryData, error) {
 	ghRepo := &github.Repository{
@@ -116,6 +285,67 @@ func (s *SDKProvider) CreateRepository(ctx context.Context, owner string, opts g
 	return s.convertRepository(repo), nil
 }
 
+// CreateRepositoryFromTemplate creates a new repository from a template repository.
+func (s *SDKProvider) CreateRepositoryFromTemplate(ctx context.Context, templateOwner, templateRepo string, opts gh.CreateRepositoryFromTemplateOptions) (*gh.RepositoryData, error) {
+	req := &github.TemplateRepoRequest{
+		Owner:              github.String(opts.Owner),
+		Name:               github.String(opts.Name),
+		Private:            github.Bool(opts.Private),
+		IncludeAllBranches: github.Bool(opts.IncludeAllBranches),
+	}
+	if opts.Description != "" {
+		req.Description = github.String(opts.Description)
+	}
+
+	repo, resp, err := s.client.Repositories.CreateFromTemplate(ctx, templateOwner, templateRepo, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create repository from template")
+	}
+
+	return s.convertRepository(repo), nil
+}
+
+// ForkRepository forks a repository.
+func (s *SDKProvider) ForkRepository(ctx context.Context, owner, repo string, opts gh.ForkRepositoryOptions) (*gh.RepositoryData, error) {
+	fork, resp, err := s.client.Repositories.CreateFork(ctx, owner, repo, &github.RepositoryCreateForkOptions{
+		Organization:      opts.Organization,
+		Name:              opts.Name,
+		DefaultBranchOnly: opts.DefaultBranchOnly,
+	})
+	if err != nil {
+		// GitHub responds with 202 Accepted while the fork is created in the
+		// background; the response still describes the pending fork.
+		var accepted *github.AcceptedError
+		if !errors.As(err, &accepted) {
+			return nil, s.wrapError(err, resp, "failed to fork repository")
+		}
+	}
+
+	return s.convertRepository(fork), nil
+}
+
+// TransferRepository transfers a repository to a new owner.
+func (s *SDKProvider) TransferRepository(ctx context.Context, owner, repo string, opts gh.TransferRepositoryOptions) (*gh.RepositoryData, error) {
+	req := github.TransferRequest{
+		NewOwner: opts.NewOwner,
+		TeamID:   opts.TeamIDs,
+	}
+	if opts.NewName != "" {
+		req.NewName = github.String(opts.NewName)
+	}
+
+	transferred, resp, err := s.client.Repositories.Transfer(ctx, owner, repo, req)
+	if err != nil {
+		// Transfers are processed asynchronously and may respond with 202 Accepted.
+		var accepted *github.AcceptedError
+		if !errors.As(err, &accepted) {
+			return nil, s.wrapError(err, resp, "failed to transfer repository")
+		}
+	}
+
+	return s.convertRepository(transferred), nil
+}
+
 // GetRepository retrieves repository information.
 func (s *SDKProvider) GetRepository(ctx context.Context, owner, repo string) (*gh.RepositoryData, error) {
 	ghRepo, resp, err := s.client.Repositories.Get(ctx, owner, repo)
@@ -211,6 +441,24 @@ func (s *SDKProvider) wrapError(err error, resp *github.Response, message string
 		statusCode = resp.StatusCode
 	}
 
+	// Primary and secondary rate limits are reported as 403s but are retryable
+	var rateErr *github.RateLimitError
+	if errors.As(err, &rateErr) {
+		wrapped := errors.Wrap(err, errors.CodeRateLimit, message)
+		wrapped = errors.WithRetryAfter(wrapped, time.Until(rateErr.Rate.Reset.Time))
+		return errors.WithContext(wrapped, "reset", rateErr.Rate.Reset.Time)
+	}
+
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		wrapped := errors.Wrap(err, errors.CodeRateLimit, message)
+		if abuseErr.RetryAfter != nil {
+			wrapped = errors.WithRetryAfter(wrapped, *abuseErr.RetryAfter)
+			wrapped = errors.WithContext(wrapped, "retry_after", *abuseErr.RetryAfter)
+		}
+		return wrapped
+	}
+
 	// Try to get status code from ErrorResponse
 	var ghErr *github.ErrorResponse
 	if errors.As(err, &ghErr) && ghErr.Response != nil {
@@ -218,13 +466,203 @@ func (s *SDKProvider) wrapError(err error, resp *github.Response, message string
 	}
 
 	if statusCode != 0 {
-		return gh.WrapHTTPError(err, statusCode, message)
+		wrapped := gh.WrapHTTPError(err, statusCode, message)
+		if ghErr != nil && ghErr.Response != nil && errors.GetCode(wrapped) == errors.CodeRateLimit {
+			if wait := rateLimitWait(ghErr.Response); wait > 0 {
+				return errors.WithRetryAfter(wrapped, wait)
+			}
+		}
+		return wrapped
 	}
 
 	// Fallback to network error for unknown errors
 	return errors.Wrap(err, errors.CodeNetwork, message)
 }
 
+// Commit operations
+
+// ListCommits lists commits in a repository.
+func (s *SDKProvider) ListCommits(ctx context.Context, owner, repo string, opts gh.ListCommitsOptions) ([]*gh.CommitData, error) {
+	listOpts := &github.CommitsListOptions{
+		SHA:    opts.SHA,
+		Path:   opts.Path,
+		Author: opts.Author,
+		ListOptions: github.ListOptions{
+			Page:    opts.Page,
+			PerPage: opts.PerPage,
+		},
+	}
+	if opts.Since != nil {
+		listOpts.Since = *opts.Since
+	}
+	if opts.Until != nil {
+		listOpts.Until = *opts.Until
+	}
+
+	commits, resp, err := s.client.Repositories.ListCommits(ctx, owner, repo, listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list commits")
+	}
+
+	result := make([]*gh.CommitData, len(commits))
+	for i, commit := range commits {
+		result[i] = s.convertCommit(commit)
+	}
+
+	return result, nil
+}
+
+// CompareCommits compares two commits, branches, or tags.
+func (s *SDKProvider) CompareCommits(ctx context.Context, owner, repo, base, head string, opts gh.ListOptions) (*gh.ComparisonData, error) {
+	listOpts := &github.ListOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+
+	comparison, resp, err := s.client.Repositories.CompareCommits(ctx, owner, repo, base, head, listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to compare commits")
+	}
+
+	data := &gh.ComparisonData{
+		Status:       comparison.GetStatus(),
+		AheadBy:      comparison.GetAheadBy(),
+		BehindBy:     comparison.GetBehindBy(),
+		TotalCommits: comparison.GetTotalCommits(),
+		MergeBaseSHA: comparison.GetMergeBaseCommit().GetSHA(),
+		Commits:      make([]*gh.CommitData, len(comparison.Commits)),
+		Files:        make([]*gh.CommitFileData, len(comparison.Files)),
+		HTMLURL:      comparison.GetHTMLURL(),
+	}
+	for i, commit := range comparison.Commits {
+		data.Commits[i] = s.convertCommit(commit)
+	}
+	for i, file := range comparison.Files {
+		data.Files[i] = &gh.CommitFileData{
+			Filename:         file.GetFilename(),
+			PreviousFilename: file.GetPreviousFilename(),
+			Status:           file.GetStatus(),
+			Additions:        file.GetAdditions(),
+			Deletions:        file.GetDeletions(),
+			Changes:          file.GetChanges(),
+		}
+	}
+
+	return data, nil
+}
+
+// GetCombinedStatus retrieves the combined commit status of a ref.
+func (s *SDKProvider) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*gh.CombinedStatusData, error) {
+	listOpts := &github.ListOptions{PerPage: 100}
+
+	var data *gh.CombinedStatusData
+	for {
+		status, resp, err := s.client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, listOpts)
+		if err != nil {
+			return nil, s.wrapError(err, resp, "failed to get combined status")
+		}
+
+		if data == nil {
+			data = &gh.CombinedStatusData{
+				State:    status.GetState(),
+				SHA:      status.GetSHA(),
+				Statuses: []*gh.CommitStatusData{},
+			}
+		}
+		for _, st := range status.Statuses {
+			data.Statuses = append(data.Statuses, &gh.CommitStatusData{
+				ID:          st.GetID(),
+				Context:     st.GetContext(),
+				State:       st.GetState(),
+				Description: st.GetDescription(),
+				TargetURL:   st.GetTargetURL(),
+				CreatedAt:   st.GetCreatedAt().Time,
+				UpdatedAt:   st.GetUpdatedAt().Time,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			return data, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
+// ListCheckRuns lists the latest check runs of a ref.
+func (s *SDKProvider) ListCheckRuns(ctx context.Context, owner, repo, ref string) ([]*gh.CheckRunData, error) {
+	listOpts := &github.ListCheckRunsOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	result := []*gh.CheckRunData{}
+	for {
+		runs, resp, err := s.client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, listOpts)
+		if err != nil {
+			return nil, s.wrapError(err, resp, "failed to list check runs")
+		}
+
+		for _, run := range runs.CheckRuns {
+			result = append(result, s.convertCheckRun(run))
+		}
+
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
+// convertCommit converts a go-github RepositoryCommit to CommitData.
+func (s *SDKProvider) convertCommit(commit *github.RepositoryCommit) *gh.CommitData {
+	if commit == nil {
+		return nil
+	}
+
+	data := &gh.CommitData{
+		SHA:         commit.GetSHA(),
+		Message:     commit.GetCommit().GetMessage(),
+		Author:      commit.GetAuthor().GetLogin(),
+		AuthorName:  commit.GetCommit().GetAuthor().GetName(),
+		AuthorEmail: commit.GetCommit().GetAuthor().GetEmail(),
+		Committer:   commit.GetCommitter().GetLogin(),
+		Parents:     make([]string, len(commit.Parents)),
+		HTMLURL:     commit.GetHTMLURL(),
+		AuthoredAt:  commit.GetCommit().GetAuthor().GetDate().Time,
+		CommittedAt: commit.GetCommit().GetCommitter().GetDate().Time,
+	}
+	for i, parent := range commit.Parents {
+		data.Parents[i] = parent.GetSHA()
+	}
+
+	return data
+}
+
+// convertCheckRun converts a go-github CheckRun to CheckRunData.
+func (s *SDKProvider) convertCheckRun(run *github.CheckRun) *gh.CheckRunData {
+	if run == nil {
+		return nil
+	}
+
+	data := &gh.CheckRunData{
+		ID:         run.GetID(),
+		Name:       run.GetName(),
+		Status:     run.GetStatus(),
+		Conclusion: run.GetConclusion(),
+		DetailsURL: run.GetDetailsURL(),
+	}
+
+	if startedAt := run.GetStartedAt(); !startedAt.IsZero() {
+		t := startedAt.Time
+		data.StartedAt = &t
+	}
+	if completedAt := run.GetCompletedAt(); !completedAt.IsZero() {
+		t := completedAt.Time
+		data.CompletedAt = &t
+	}
+
+	return data
+}
+
 // Issue operations
 
 // AddLabels adds labels to an issue.
@@ -407,6 +845,322 @@ func (s *SDKProvider) convertIssue(issue *github.Issue) *gh.IssueData {
 	return data
 }
 
+// Comment operations
+
+// CreateComment creates a comment on an issue or pull request.
+func (s *SDKProvider) CreateComment(ctx context.Context, owner, repo string, number int, body string) (*gh.CommentData, error) {
+	comment, resp, err := s.client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{
+		Body: github.String(body),
+	})
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create comment")
+	}
+
+	return s.convertComment(comment), nil
+}
+
+// ListComments lists comments on an issue or pull request.
+func (s *SDKProvider) ListComments(ctx context.Context, owner, repo string, number int, opts gh.ListOptions) ([]*gh.CommentData, error) {
+	listOpts := &github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{
+			Page:    opts.Page,
+			PerPage: opts.PerPage,
+		},
+	}
+
+	comments, resp, err := s.client.Issues.ListComments(ctx, owner, repo, number, listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list comments")
+	}
+
+	result := make([]*gh.CommentData, len(comments))
+	for i, comment := range comments {
+		result[i] = s.convertComment(comment)
+	}
+
+	return result, nil
+}
+
+// UpdateComment replaces the body of an existing comment.
+func (s *SDKProvider) UpdateComment(ctx context.Context, owner, repo string, commentID int64, body string) (*gh.CommentData, error) {
+	comment, resp, err := s.client.Issues.EditComment(ctx, owner, repo, commentID, &github.IssueComment{
+		Body: github.String(body),
+	})
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to update comment")
+	}
+
+	return s.convertComment(comment), nil
+}
+
+// DeleteComment deletes a comment.
+func (s *SDKProvider) DeleteComment(ctx context.Context, owner, repo string, commentID int64) error {
+	resp, err := s.client.Issues.DeleteComment(ctx, owner, repo, commentID)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete comment")
+	}
+
+	return nil
+}
+
+// AddIssueReaction adds a reaction to an issue or pull request.
+func (s *SDKProvider) AddIssueReaction(ctx context.Context, owner, repo string, number int, content string) error {
+	_, resp, err := s.client.Reactions.CreateIssueReaction(ctx, owner, repo, number, content)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to add reaction")
+	}
+
+	return nil
+}
+
+// AddCommentReaction adds a reaction to an issue or pull request comment.
+func (s *SDKProvider) AddCommentReaction(ctx context.Context, owner, repo string, commentID int64, content string) error {
+	_, resp, err := s.client.Reactions.CreateIssueCommentReaction(ctx, owner, repo, commentID, content)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to add comment reaction")
+	}
+
+	return nil
+}
+
+// convertComment converts a go-github IssueComment to CommentData.
+func (s *SDKProvider) convertComment(comment *github.IssueComment) *gh.CommentData {
+	if comment == nil {
+		return nil
+	}
+
+	data := &gh.CommentData{
+		ID:        comment.GetID(),
+		Body:      comment.GetBody(),
+		HTMLURL:   comment.GetHTMLURL(),
+		CreatedAt: comment.GetCreatedAt().Time,
+		UpdatedAt: comment.GetUpdatedAt().Time,
+	}
+
+	// Extract author
+	if user := comment.GetUser(); user != nil {
+		data.Author = user.GetLogin()
+	}
+
+	return data
+}
+
+// Label operations
+
+// ListLabels lists the labels defined in a repository.
+// If opts.Page is zero, every page is fetched by following NextPage.
+func (s *SDKProvider) ListLabels(ctx context.Context, owner, repo string, opts gh.ListOptions) ([]*gh.LabelData, error) {
+	listOpts := &github.ListOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+
+	result := []*gh.LabelData{}
+	for {
+		labels, resp, err := s.client.Issues.ListLabels(ctx, owner, repo, listOpts)
+		if err != nil {
+			return nil, s.wrapError(err, resp, "failed to list labels")
+		}
+
+		for _, label := range labels {
+			result = append(result, s.convertLabel(label))
+		}
+
+		if opts.Page > 0 || resp.NextPage == 0 {
+			return result, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
+// CreateLabel creates a label in a repository.
+func (s *SDKProvider) CreateLabel(ctx context.Context, owner, repo string, opts gh.CreateLabelOptions) (*gh.LabelData, error) {
+	label, resp, err := s.client.Issues.CreateLabel(ctx, owner, repo, &github.Label{
+		Name:        github.String(opts.Name),
+		Color:       github.String(opts.Color),
+		Description: github.String(opts.Description),
+	})
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create label")
+	}
+
+	return s.convertLabel(label), nil
+}
+
+// UpdateLabel updates a label's name, color, or description.
+//
+// The request is built directly because renaming requires the new_name
+// field, which go-github's Label type does not carry.
+func (s *SDKProvider) UpdateLabel(ctx context.Context, owner, repo, name string, opts gh.UpdateLabelOptions) (*gh.LabelData, error) {
+	body := map[string]string{}
+	if opts.NewName != nil {
+		body["new_name"] = *opts.NewName
+	}
+	if opts.Color != nil {
+		body["color"] = *opts.Color
+	}
+	if opts.Description != nil {
+		body["description"] = *opts.Description
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/labels/%s", owner, repo, url.PathEscape(name))
+	req, err := s.client.NewRequest(http.MethodPatch, u, body)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternal, "failed to create label request")
+	}
+
+	label := new(github.Label)
+	resp, err := s.client.Do(ctx, req, label)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to update label")
+	}
+
+	return s.convertLabel(label), nil
+}
+
+// DeleteLabel deletes a label from a repository.
+func (s *SDKProvider) DeleteLabel(ctx context.Context, owner, repo, name string) error {
+	resp, err := s.client.Issues.DeleteLabel(ctx, owner, repo, name)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete label")
+	}
+
+	return nil
+}
+
+// convertLabel converts a go-github Label to LabelData.
+func (s *SDKProvider) convertLabel(label *github.Label) *gh.LabelData {
+	if label == nil {
+		return nil
+	}
+
+	return &gh.LabelData{
+		ID:          label.GetID(),
+		Name:        label.GetName(),
+		Color:       label.GetColor(),
+		Description: label.GetDescription(),
+		Default:     label.GetDefault(),
+	}
+}
+
+// Milestone operations
+
+// ListMilestones lists the milestones in a repository.
+func (s *SDKProvider) ListMilestones(ctx context.Context, owner, repo string, opts gh.ListMilestonesOptions) ([]*gh.MilestoneData, error) {
+	listOpts := &github.MilestoneListOptions{
+		State: opts.State,
+		ListOptions: github.ListOptions{
+			Page:    opts.Page,
+			PerPage: opts.PerPage,
+		},
+	}
+
+	milestones, resp, err := s.client.Issues.ListMilestones(ctx, owner, repo, listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list milestones")
+	}
+
+	result := make([]*gh.MilestoneData, len(milestones))
+	for i, milestone := range milestones {
+		result[i] = s.convertMilestone(milestone)
+	}
+
+	return result, nil
+}
+
+// GetMilestone retrieves a milestone by number.
+func (s *SDKProvider) GetMilestone(ctx context.Context, owner, repo string, number int) (*gh.MilestoneData, error) {
+	milestone, resp, err := s.client.Issues.GetMilestone(ctx, owner, repo, number)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get milestone")
+	}
+
+	return s.convertMilestone(milestone), nil
+}
+
+// CreateMilestone creates a milestone in a repository.
+func (s *SDKProvider) CreateMilestone(ctx context.Context, owner, repo string, opts gh.CreateMilestoneOptions) (*gh.MilestoneData, error) {
+	req := &github.Milestone{
+		Title: github.String(opts.Title),
+	}
+	if opts.Description != "" {
+		req.Description = github.String(opts.Description)
+	}
+	if opts.State != "" {
+		req.State = github.String(opts.State)
+	}
+	if opts.DueOn != nil {
+		req.DueOn = &github.Timestamp{Time: *opts.DueOn}
+	}
+
+	milestone, resp, err := s.client.Issues.CreateMilestone(ctx, owner, repo, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create milestone")
+	}
+
+	return s.convertMilestone(milestone), nil
+}
+
+// UpdateMilestone updates a milestone's title, description, state, or due date.
+func (s *SDKProvider) UpdateMilestone(ctx context.Context, owner, repo string, number int, opts gh.UpdateMilestoneOptions) (*gh.MilestoneData, error) {
+	req := &github.Milestone{
+		Title:       opts.Title,
+		Description: opts.Description,
+		State:       opts.State,
+	}
+	if opts.DueOn != nil {
+		req.DueOn = &github.Timestamp{Time: *opts.DueOn}
+	}
+
+	milestone, resp, err := s.client.Issues.EditMilestone(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to update milestone")
+	}
+
+	return s.convertMilestone(milestone), nil
+}
+
+// DeleteMilestone deletes a milestone from a repository.
+func (s *SDKProvider) DeleteMilestone(ctx context.Context, owner, repo string, number int) error {
+	resp, err := s.client.Issues.DeleteMilestone(ctx, owner, repo, number)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete milestone")
+	}
+
+	return nil
+}
+
+// convertMilestone converts a go-github Milestone to MilestoneData.
+func (s *SDKProvider) convertMilestone(milestone *github.Milestone) *gh.MilestoneData {
+	if milestone == nil {
+		return nil
+	}
+
+	data := &gh.MilestoneData{
+		ID:           milestone.GetID(),
+		Number:       milestone.GetNumber(),
+		Title:        milestone.GetTitle(),
+		Description:  milestone.GetDescription(),
+		State:        milestone.GetState(),
+		OpenIssues:   milestone.GetOpenIssues(),
+		ClosedIssues: milestone.GetClosedIssues(),
+		HTMLURL:      milestone.GetHTMLURL(),
+		CreatedAt:    milestone.GetCreatedAt().Time,
+		UpdatedAt:    milestone.GetUpdatedAt().Time,
+	}
+
+	if dueOn := milestone.GetDueOn(); !dueOn.IsZero() {
+		t := dueOn.Time
+		data.DueOn = &t
+	}
+	if closedAt := milestone.GetClosedAt(); !closedAt.IsZero() {
+		t := closedAt.Time
+		data.ClosedAt = &t
+	}
+
+	return data
+}
+
 // Pull Request operations
 
 // CreatePullRequest creates a new pull request.
@@ -563,6 +1317,142 @@ func (s *SDKProvider) convertPullRequest(pr *github.PullRequest) *gh.PullRequest
 	return data
 }
 
+// Pull request review operations
+
+// RequestReviewers requests reviews from users and/or teams.
+func (s *SDKProvider) RequestReviewers(ctx context.Context, owner, repo string, number int, opts gh.RequestReviewersOptions) error {
+	req := github.ReviewersRequest{
+		Reviewers:     opts.Reviewers,
+		TeamReviewers: opts.TeamReviewers,
+	}
+
+	_, resp, err := s.client.PullRequests.RequestReviewers(ctx, owner, repo, number, req)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to request reviewers")
+	}
+
+	return nil
+}
+
+// SubmitReview creates and submits a review on a pull request.
+func (s *SDKProvider) SubmitReview(ctx context.Context, owner, repo string, number int, opts gh.SubmitReviewOptions) (*gh.ReviewData, error) {
+	req := &github.PullRequestReviewRequest{
+		Event: github.String(opts.Event),
+	}
+	if opts.Body != "" {
+		req.Body = github.String(opts.Body)
+	}
+	if opts.CommitID != "" {
+		req.CommitID = github.String(opts.CommitID)
+	}
+
+	review, resp, err := s.client.PullRequests.CreateReview(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to submit review")
+	}
+
+	return s.convertReview(review), nil
+}
+
+// ListReviews lists reviews submitted on a pull request.
+func (s *SDKProvider) ListReviews(ctx context.Context, owner, repo string, number int, opts gh.ListOptions) ([]*gh.ReviewData, error) {
+	listOpts := &github.ListOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+
+	reviews, resp, err := s.client.PullRequests.ListReviews(ctx, owner, repo, number, listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list reviews")
+	}
+
+	result := make([]*gh.ReviewData, len(reviews))
+	for i, review := range reviews {
+		result[i] = s.convertReview(review)
+	}
+
+	return result, nil
+}
+
+// CreateReviewComment creates a review comment anchored to a line in the pull request diff.
+func (s *SDKProvider) CreateReviewComment(ctx context.Context, owner, repo string, number int, opts gh.CreateReviewCommentOptions) (*gh.ReviewCommentData, error) {
+	req := &github.PullRequestComment{
+		Body:     github.String(opts.Body),
+		Path:     github.String(opts.Path),
+		Line:     github.Int(opts.Line),
+		CommitID: github.String(opts.CommitID),
+	}
+	if opts.Side != "" {
+		req.Side = github.String(opts.Side)
+	}
+	if opts.StartLine > 0 {
+		req.StartLine = github.Int(opts.StartLine)
+		req.StartSide = req.Side
+	}
+
+	comment, resp, err := s.client.PullRequests.CreateComment(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to create review comment")
+	}
+
+	return s.convertReviewComment(comment), nil
+}
+
+// convertReview converts a go-github PullRequestReview to ReviewData.
+func (s *SDKProvider) convertReview(review *github.PullRequestReview) *gh.ReviewData {
+	if review == nil {
+		return nil
+	}
+
+	data := &gh.ReviewData{
+		ID:       review.GetID(),
+		Body:     review.GetBody(),
+		State:    review.GetState(),
+		CommitID: review.GetCommitID(),
+		HTMLURL:  review.GetHTMLURL(),
+	}
+
+	// Extract author
+	if user := review.GetUser(); user != nil {
+		data.Author = user.GetLogin()
+	}
+
+	// Extract submitted time (unset for pending reviews)
+	if submittedAt := review.GetSubmittedAt(); !submittedAt.IsZero() {
+		t := submittedAt.Time
+		data.SubmittedAt = &t
+	}
+
+	return data
+}
+
+// convertReviewComment converts a go-github PullRequestComment to ReviewCommentData.
+func (s *SDKProvider) convertReviewComment(comment *github.PullRequestComment) *gh.ReviewCommentData {
+	if comment == nil {
+		return nil
+	}
+
+	data := &gh.ReviewCommentData{
+		ID:        comment.GetID(),
+		Body:      comment.GetBody(),
+		Path:      comment.GetPath(),
+		Line:      comment.GetLine(),
+		StartLine: comment.GetStartLine(),
+		Side:      comment.GetSide(),
+		CommitID:  comment.GetCommitID(),
+		HTMLURL:   comment.GetHTMLURL(),
+		CreatedAt: comment.GetCreatedAt().Time,
+		UpdatedAt: comment.GetUpdatedAt().Time,
+	}
+
+	// Extract author
+	if user := comment.GetUser(); user != nil {
+		data.Author = user.GetLogin()
+	}
+
+	return data
+}
+
 // Workflow operations
 
 // GetWorkflowRun retrieves a specific workflow run by ID.
@@ -630,6 +1520,179 @@ func (s *SDKProvider) TriggerWorkflow(ctx context.Context, owner, repo, workflow
 	return nil
 }
 
+// ListArtifacts lists the artifacts uploaded by a workflow run.
+func (s *SDKProvider) ListArtifacts(ctx context.Context, owner, repo string, runID int64, opts gh.ListOptions) ([]*gh.ArtifactData, error) {
+	listOpts := &github.ListOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+
+	artifacts, resp, err := s.client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, runID, listOpts)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list artifacts")
+	}
+
+	result := make([]*gh.ArtifactData, len(artifacts.Artifacts))
+	for i, artifact := range artifacts.Artifacts {
+		result[i] = s.convertArtifact(artifact)
+	}
+
+	return result, nil
+}
+
+// DownloadArtifact downloads an artifact as a zip archive.
+func (s *SDKProvider) DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64) (io.ReadCloser, error) {
+	archiveURL, resp, err := s.client.Actions.DownloadArtifact(ctx, owner, repo, artifactID, maxRedirects)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get artifact download URL")
+	}
+
+	return s.download(ctx, archiveURL.String(), "failed to download artifact")
+}
+
+// GetWorkflowRunLogs retrieves the combined plain-text logs for all jobs in a workflow run.
+//
+// The REST API only serves run logs as a zip archive, so the logs of each job
+// are fetched individually and streamed in job order. The logs of a job are
+// only requested once the previous job's logs have been read, so no more than
+// one job's logs are held in flight.
+func (s *SDKProvider) GetWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64) (io.ReadCloser, error) {
+	jobs, err := s.GetWorkflowRunJobs(ctx, owner, repo, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := &jobLogsReader{
+		jobs: jobs,
+		open: func(jobID int64) (io.ReadCloser, error) {
+			return s.GetWorkflowJobLogs(ctx, owner, repo, jobID)
+		},
+	}
+	// Open the first job eagerly so missing or expired logs fail the call
+	if err := logs.next(); err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// jobLogsReader concatenates the logs of workflow jobs, opening the logs of
+// each job once the previous job's logs are exhausted.
+type jobLogsReader struct {
+	jobs    []*gh.WorkflowJobData
+	open    func(jobID int64) (io.ReadCloser, error)
+	current io.ReadCloser
+}
+
+// next opens the logs of the next job, if any.
+func (r *jobLogsReader) next() error {
+	if len(r.jobs) == 0 {
+		return nil
+	}
+
+	job := r.jobs[0]
+	r.jobs = r.jobs[1:]
+	rc, err := r.open(job.ID)
+	if err != nil {
+		return errors.WithContext(err, "job", job.Name)
+	}
+	r.current = rc
+	return nil
+}
+
+// Read implements io.Reader.
+func (r *jobLogsReader) Read(p []byte) (int, error) {
+	for r.current != nil {
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			_ = r.current.Close()
+			r.current = nil
+			if nextErr := r.next(); nextErr != nil {
+				return n, nextErr
+			}
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		if err != nil {
+			return n, errors.Wrap(err, errors.CodeNetwork, "failed to read workflow job logs")
+		}
+		return n, nil
+	}
+	return 0, io.EOF
+}
+
+// Close implements io.Closer.
+func (r *jobLogsReader) Close() error {
+	r.jobs = nil
+	if r.current == nil {
+		return nil
+	}
+	err := r.current.Close()
+	r.current = nil
+	return err
+}
+
+// GetWorkflowJobLogs retrieves the plain-text logs for a single workflow job.
+func (s *SDKProvider) GetWorkflowJobLogs(ctx context.Context, owner, repo string, jobID int64) (io.ReadCloser, error) {
+	logsURL, resp, err := s.client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, maxRedirects)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get workflow job logs URL")
+	}
+
+	return s.download(ctx, logsURL.String(), "failed to download workflow job logs")
+}
+
+// download fetches a pre-signed download URL returned by the API.
+// Pre-signed URLs are fetched without GitHub credentials.
+func (s *SDKProvider) download(ctx context.Context, rawURL, msg string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeInternal, "failed to create download request")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeNetwork, msg)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, gh.WrapHTTPError(fmt.Errorf("unexpected status: %s", resp.Status), resp.StatusCode, msg)
+	}
+
+	return resp.Body, nil
+}
+
+// convertArtifact converts a go-github Artifact to ArtifactData.
+func (s *SDKProvider) convertArtifact(artifact *github.Artifact) *gh.ArtifactData {
+	if artifact == nil {
+		return nil
+	}
+
+	data := &gh.ArtifactData{
+		ID:                 artifact.GetID(),
+		Name:               artifact.GetName(),
+		SizeInBytes:        artifact.GetSizeInBytes(),
+		Expired:            artifact.GetExpired(),
+		ArchiveDownloadURL: artifact.GetArchiveDownloadURL(),
+		CreatedAt:          artifact.GetCreatedAt().Time,
+	}
+
+	// Extract workflow run
+	if run := artifact.GetWorkflowRun(); run != nil {
+		data.RunID = run.GetID()
+	}
+
+	// Extract expiry time
+	if expiresAt := artifact.GetExpiresAt(); !expiresAt.IsZero() {
+		t := expiresAt.Time
+		data.ExpiresAt = &t
+	}
+
+	return data
+}
+
 // convertWorkflowJob converts a go-github WorkflowJob to WorkflowJobData.
 func (s *SDKProvider) convertWorkflowJob(job *github.WorkflowJob) *gh.WorkflowJobData {
 	if job == nil {
@@ -708,3 +1771,38 @@ func (s *SDKProvider) convertWorkflowRun(run *github.WorkflowRun) *gh.WorkflowRu
 
 	return data
 }
+
+// GraphQL operations
+
+// QueryGraphQL executes a query or mutation against the GitHub GraphQL API.
+func (s *SDKProvider) QueryGraphQL(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error {
+	body := map[string]interface{}{
+		"query": query,
+	}
+	if len(vars) > 0 {
+		body["variables"] = vars
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, s.graphQLEndpoint(), body)
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to create GraphQL request")
+	}
+
+	var raw json.RawMessage
+	resp, err := s.client.Do(ctx, req, &raw)
+	if err != nil {
+		return s.wrapError(err, resp, "failed to execute GraphQL query")
+	}
+
+	return gh.DecodeGraphQLResponse(raw, result)
+}
+
+// graphQLEndpoint returns the GraphQL endpoint relative to the client's base URL.
+// GitHub Enterprise Server serves the REST API under /api/v3/ and the GraphQL
+// API under /api/graphql.
+func (s *SDKProvider) graphQLEndpoint() string {
+	if strings.HasSuffix(s.client.BaseURL.Path, "/api/v3/") {
+		return "../graphql"
+	}
+	return "graphql"
+}
@@ -2,8 +2,11 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/google/go-github/v67/github"
@@ -56,6 +59,19 @@ func TestNewSDKProvider(t *testing.T) {
 		assert.Equal(t, "testrepo", repo.Name)
 	})
 
+	t.Run("with base URL", func(t *testing.T) {
+		t.Parallel()
+
+		for _, baseURL := range []string{"https://github.example.com", "https://github.example.com/api/v3/"} {
+			provider, err := NewSDKProvider(WithToken("test-token"), WithBaseURL(baseURL))
+			require.NoError(t, err)
+
+			assert.Equal(t, "https://github.example.com/api/v3/", provider.client.BaseURL.String())
+			assert.Equal(t, "https://github.example.com/api/uploads/", provider.client.UploadURL.String())
+			assert.Equal(t, "../graphql", provider.graphQLEndpoint())
+		}
+	})
+
 	tests := []struct {
 		name      string
 		setupOpts []Option
@@ -76,6 +92,16 @@ func TestNewSDKProvider(t *testing.T) {
 			setupOpts: []Option{},
 			wantCode:  errors.CodeInvalidInput,
 		},
+		{
+			name:      "with empty base URL returns error",
+			setupOpts: []Option{WithToken("test-token"), WithBaseURL("")},
+			wantCode:  errors.CodeInvalidInput,
+		},
+		{
+			name:      "with malformed base URL returns error",
+			setupOpts: []Option{WithToken("test-token"), WithBaseURL("://github.example.com")},
+			wantCode:  errors.CodeInvalidInput,
+		},
 	}
 
 	for _, tt := range tests {
@@ -313,3 +339,559 @@ func TestSDKProvider_CreateRepository(t *testing.T) {
 		assert.True(t, repo.Private)
 	})
 }
+
+func TestSDKProvider_ForkRepository(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/upstream/testrepo/forks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if body["organization"] != "testorg" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		// GitHub creates forks asynchronously and responds with 202 Accepted.
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{
+			"id": 789,
+			"name": "testrepo",
+			"full_name": "testorg/testrepo",
+			"fork": true,
+			"owner": {"login": "testorg"}
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	fork, err := provider.ForkRepository(context.Background(), "upstream", "testrepo", gh.ForkRepositoryOptions{
+		Organization: "testorg",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(789), fork.ID)
+	assert.Equal(t, "testorg", fork.Owner)
+	assert.True(t, fork.Fork)
+}
+
+func TestSDKProvider_SubmitReview(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/pulls/1/reviews", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["event"] != gh.ReviewEventRequestChanges {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"id": 80,
+			"user": {"login": "reviewbot"},
+			"body": "Please add tests",
+			"state": "CHANGES_REQUESTED",
+			"commit_id": "abc123",
+			"submitted_at": "2023-01-01T00:00:00Z"
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	review, err := provider.SubmitReview(context.Background(), "testowner", "testrepo", 1, gh.SubmitReviewOptions{
+		Event: gh.ReviewEventRequestChanges,
+		Body:  "Please add tests",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(80), review.ID)
+	assert.Equal(t, "reviewbot", review.Author)
+	assert.Equal(t, gh.ReviewStateChangesRequested, review.State)
+	require.NotNil(t, review.SubmittedAt)
+}
+
+func TestSDKProvider_CreateReviewComment(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/pulls/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["path"] != "main.go" || body["line"] != float64(42) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{
+			"id": 10,
+			"user": {"login": "reviewbot"},
+			"body": "Handle this error",
+			"path": "main.go",
+			"line": 42,
+			"side": "RIGHT",
+			"commit_id": "abc123"
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	comment, err := provider.CreateReviewComment(context.Background(), "testowner", "testrepo", 1, gh.CreateReviewCommentOptions{
+		Body:     "Handle this error",
+		Path:     "main.go",
+		Line:     42,
+		Side:     gh.DiffSideRight,
+		CommitID: "abc123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), comment.ID)
+	assert.Equal(t, "main.go", comment.Path)
+	assert.Equal(t, 42, comment.Line)
+	assert.Equal(t, gh.DiffSideRight, comment.Side)
+}
+
+func TestSDKProvider_UpdateComment(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/issues/comments/1001", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"id": 1001,
+			"user": {"login": "statusbot"},
+			"body": "Build passed",
+			"created_at": "2023-01-01T00:00:00Z",
+			"updated_at": "2023-01-01T00:05:00Z"
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	comment, err := provider.UpdateComment(context.Background(), "testowner", "testrepo", 1001, "Build passed")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1001), comment.ID)
+	assert.Equal(t, "statusbot", comment.Author)
+	assert.Equal(t, "Build passed", comment.Body)
+}
+
+func TestSDKProvider_ListLabels(t *testing.T) {
+	t.Parallel()
+
+	var pages []string
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/labels", func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<`+server.URL+r.URL.Path+`?per_page=100&page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`[{"id": 1, "name": "bug", "color": "d73a4a"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id": 2, "name": "triage", "color": "ededed"}]`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	t.Run("fetches every page", func(t *testing.T) {
+		pages = nil
+		labels, err := provider.ListLabels(context.Background(), "testowner", "testrepo", gh.ListOptions{PerPage: 100})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"", "2"}, pages)
+		require.Len(t, labels, 2)
+		assert.Equal(t, "bug", labels[0].Name)
+		assert.Equal(t, "triage", labels[1].Name)
+	})
+
+	t.Run("fetches a single requested page", func(t *testing.T) {
+		pages = nil
+		labels, err := provider.ListLabels(context.Background(), "testowner", "testrepo", gh.ListOptions{Page: 2, PerPage: 100})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2"}, pages)
+		require.Len(t, labels, 1)
+		assert.Equal(t, "triage", labels[0].Name)
+	})
+}
+
+func TestSDKProvider_UpdateLabel(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]string
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/labels/bug", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"id": 208045946,
+			"name": "defect",
+			"color": "b60205",
+			"description": "Something isn't working",
+			"default": false
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	newName := "defect"
+	color := "b60205"
+	label, err := provider.UpdateLabel(context.Background(), "testowner", "testrepo", "bug", gh.UpdateLabelOptions{
+		NewName: &newName,
+		Color:   &color,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"new_name": "defect", "color": "b60205"}, received)
+	assert.Equal(t, int64(208045946), label.ID)
+	assert.Equal(t, "defect", label.Name)
+	assert.Equal(t, "b60205", label.Color)
+	assert.Equal(t, "Something isn't working", label.Description)
+}
+
+func TestSDKProvider_UpdateMilestone(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]interface{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/milestones/3", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"id": 1002604,
+			"number": 3,
+			"title": "Sprint 12",
+			"state": "closed",
+			"open_issues": 0,
+			"closed_issues": 8,
+			"html_url": "https://github.com/testowner/testrepo/milestone/3",
+			"due_on": "2024-10-09T07:00:00Z",
+			"created_at": "2024-09-25T23:39:01Z",
+			"updated_at": "2024-10-09T23:39:01Z",
+			"closed_at": "2024-10-09T23:39:01Z"
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	state := "closed"
+	milestone, err := provider.UpdateMilestone(context.Background(), "testowner", "testrepo", 3, gh.UpdateMilestoneOptions{
+		State: &state,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"state": "closed"}, received)
+	assert.Equal(t, 3, milestone.Number)
+	assert.Equal(t, "Sprint 12", milestone.Title)
+	assert.Equal(t, 8, milestone.ClosedIssues)
+	require.NotNil(t, milestone.DueOn)
+	assert.Equal(t, 2024, milestone.DueOn.Year())
+	require.NotNil(t, milestone.ClosedAt)
+}
+
+func TestSDKProvider_CompareCommits(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/compare/v1.0.0...main", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"status": "ahead",
+			"ahead_by": 2,
+			"behind_by": 0,
+			"total_commits": 2,
+			"html_url": "https://github.com/testowner/testrepo/compare/v1.0.0...main",
+			"merge_base_commit": {"sha": "base123"},
+			"commits": [
+				{
+					"sha": "abc123",
+					"html_url": "https://github.com/testowner/testrepo/commit/abc123",
+					"author": {"login": "octocat"},
+					"committer": {"login": "web-flow"},
+					"parents": [{"sha": "base123"}],
+					"commit": {
+						"message": "Fix bug",
+						"author": {"name": "Mona Octocat", "email": "mona@example.com", "date": "2024-01-02T03:04:05Z"},
+						"committer": {"name": "GitHub", "email": "noreply@github.com", "date": "2024-01-02T03:04:06Z"}
+					}
+				}
+			],
+			"files": [
+				{"filename": "main.go", "status": "modified", "additions": 10, "deletions": 2, "changes": 12},
+				{"filename": "new.go", "previous_filename": "old.go", "status": "renamed", "additions": 0, "deletions": 0, "changes": 0}
+			]
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	cmp, err := provider.CompareCommits(context.Background(), "testowner", "testrepo", "v1.0.0", "main", gh.ListOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ahead", cmp.Status)
+	assert.Equal(t, 2, cmp.AheadBy)
+	assert.Equal(t, "base123", cmp.MergeBaseSHA)
+	require.Len(t, cmp.Commits, 1)
+	assert.Equal(t, "abc123", cmp.Commits[0].SHA)
+	assert.Equal(t, "Fix bug", cmp.Commits[0].Message)
+	assert.Equal(t, "octocat", cmp.Commits[0].Author)
+	assert.Equal(t, "mona@example.com", cmp.Commits[0].AuthorEmail)
+	assert.Equal(t, []string{"base123"}, cmp.Commits[0].Parents)
+	assert.Equal(t, 2024, cmp.Commits[0].AuthoredAt.Year())
+	require.Len(t, cmp.Files, 2)
+	assert.Equal(t, 10, cmp.Files[0].Additions)
+	assert.Equal(t, "old.go", cmp.Files[1].PreviousFilename)
+}
+
+func TestSDKProvider_ListCheckRuns(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/commits/abc123/check-runs", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"total_count": 2,
+			"check_runs": [
+				{"id": 1, "name": "build", "status": "completed", "conclusion": "success", "started_at": "2024-01-02T03:04:05Z", "completed_at": "2024-01-02T03:05:05Z"},
+				{"id": 2, "name": "test", "status": "in_progress", "conclusion": null, "started_at": "2024-01-02T03:04:05Z"}
+			]
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	runs, err := provider.ListCheckRuns(context.Background(), "testowner", "testrepo", "abc123")
+
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "build", runs[0].Name)
+	assert.Equal(t, "success", runs[0].Conclusion)
+	require.NotNil(t, runs[0].CompletedAt)
+	assert.Equal(t, "in_progress", runs[1].Status)
+	assert.Empty(t, runs[1].Conclusion)
+	assert.Nil(t, runs[1].CompletedAt)
+}
+
+func TestSDKProvider_GetWorkflowRunLogs(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/actions/runs/123456/jobs", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"total_count": 2, "jobs": [{"id": 1, "name": "lint"}, {"id": 2, "name": "test"}]}`))
+	})
+	var downloads atomic.Int32
+	for _, id := range []string{"1", "2"} {
+		mux.HandleFunc("/repos/testowner/testrepo/actions/jobs/"+id+"/logs", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, server.URL+"/raw/"+id, http.StatusFound)
+		})
+		mux.HandleFunc("/raw/"+id, func(w http.ResponseWriter, _ *http.Request) {
+			downloads.Add(1)
+			_, _ = w.Write([]byte("job " + id + "\n"))
+		})
+	}
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	rc, err := provider.GetWorkflowRunLogs(context.Background(), "testowner", "testrepo", 123456)
+	require.NoError(t, err)
+	defer func() { _ = rc.Close() }()
+	assert.Equal(t, int32(1), downloads.Load(), "later jobs should be fetched as they are read")
+
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "job 1\njob 2\n", string(data))
+	assert.Equal(t, int32(2), downloads.Load())
+}
+
+func TestSDKProvider_QueryGraphQL(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if body.Variables["owner"] != "testowner" {
+			_, _ = w.Write([]byte(`{"data": null, "errors": [{"type": "NOT_FOUND", "message": "Could not resolve to a Repository"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"repository": {"name": "testrepo"}}}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	const query = `query($owner: String!) { repository(owner: $owner, name: "testrepo") { name } }`
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var result struct {
+			Repository struct {
+				Name string `json:"name"`
+			} `json:"repository"`
+		}
+		err := provider.QueryGraphQL(context.Background(), query, map[string]interface{}{"owner": "testowner"}, &result)
+
+		require.NoError(t, err)
+		assert.Equal(t, "testrepo", result.Repository.Name)
+	})
+
+	t.Run("graphql error", func(t *testing.T) {
+		t.Parallel()
+
+		err := provider.QueryGraphQL(context.Background(), query, map[string]interface{}{"owner": "missing"}, nil)
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+	})
+}
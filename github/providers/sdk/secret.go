@@ -0,0 +1,337 @@
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/google/go-github/v67/github"
+	"github.com/jmgilman/go/errors"
+	gh "github.com/jmgilman/go/github"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// SetSecret creates or updates an encrypted secret in the given scope.
+func (s *SDKProvider) SetSecret(ctx context.Context, scope gh.SecretScope, opts gh.SetSecretOptions) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+
+	key, err := s.secretPublicKey(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := encryptSecret(key.GetKey(), opts.Value)
+	if err != nil {
+		return err
+	}
+
+	var resp *github.Response
+	switch {
+	case scope.IsDependabot():
+		secret := &github.DependabotEncryptedSecret{
+			Name:           opts.Name,
+			KeyID:          key.GetKeyID(),
+			EncryptedValue: encrypted,
+		}
+		if scope.IsOrganization() {
+			secret.Visibility = opts.Visibility
+			secret.SelectedRepositoryIDs = opts.SelectedRepositoryIDs
+			resp, err = s.client.Dependabot.CreateOrUpdateOrgSecret(ctx, scope.Owner, secret)
+		} else {
+			resp, err = s.client.Dependabot.CreateOrUpdateRepoSecret(ctx, scope.Owner, scope.Repo, secret)
+		}
+	default:
+		secret := &github.EncryptedSecret{
+			Name:           opts.Name,
+			KeyID:          key.GetKeyID(),
+			EncryptedValue: encrypted,
+		}
+		switch {
+		case scope.IsOrganization():
+			secret.Visibility = opts.Visibility
+			secret.SelectedRepositoryIDs = opts.SelectedRepositoryIDs
+			resp, err = s.client.Actions.CreateOrUpdateOrgSecret(ctx, scope.Owner, secret)
+		case scope.Environment != "":
+			repoID, idErr := s.repositoryID(ctx, scope)
+			if idErr != nil {
+				return idErr
+			}
+			resp, err = s.client.Actions.CreateOrUpdateEnvSecret(ctx, repoID, scope.Environment, secret)
+		default:
+			resp, err = s.client.Actions.CreateOrUpdateRepoSecret(ctx, scope.Owner, scope.Repo, secret)
+		}
+	}
+	if err != nil {
+		return s.wrapError(err, resp, "failed to set secret")
+	}
+
+	return nil
+}
+
+// ListSecrets lists the secrets in the given scope.
+func (s *SDKProvider) ListSecrets(ctx context.Context, scope gh.SecretScope, opts gh.ListOptions) ([]*gh.SecretData, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+
+	listOpts := &github.ListOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+
+	var (
+		secrets *github.Secrets
+		resp    *github.Response
+		err     error
+	)
+	switch {
+	case scope.IsDependabot() && scope.IsOrganization():
+		secrets, resp, err = s.client.Dependabot.ListOrgSecrets(ctx, scope.Owner, listOpts)
+	case scope.IsDependabot():
+		secrets, resp, err = s.client.Dependabot.ListRepoSecrets(ctx, scope.Owner, scope.Repo, listOpts)
+	case scope.IsOrganization():
+		secrets, resp, err = s.client.Actions.ListOrgSecrets(ctx, scope.Owner, listOpts)
+	case scope.Environment != "":
+		repoID, idErr := s.repositoryID(ctx, scope)
+		if idErr != nil {
+			return nil, idErr
+		}
+		secrets, resp, err = s.client.Actions.ListEnvSecrets(ctx, repoID, scope.Environment, listOpts)
+	default:
+		secrets, resp, err = s.client.Actions.ListRepoSecrets(ctx, scope.Owner, scope.Repo, listOpts)
+	}
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list secrets")
+	}
+
+	result := make([]*gh.SecretData, len(secrets.Secrets))
+	for i, secret := range secrets.Secrets {
+		result[i] = &gh.SecretData{
+			Name:       secret.Name,
+			Visibility: secret.Visibility,
+			CreatedAt:  secret.CreatedAt.Time,
+			UpdatedAt:  secret.UpdatedAt.Time,
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteSecret deletes a secret from the given scope.
+func (s *SDKProvider) DeleteSecret(ctx context.Context, scope gh.SecretScope, name string) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+
+	var (
+		resp *github.Response
+		err  error
+	)
+	switch {
+	case scope.IsDependabot() && scope.IsOrganization():
+		resp, err = s.client.Dependabot.DeleteOrgSecret(ctx, scope.Owner, name)
+	case scope.IsDependabot():
+		resp, err = s.client.Dependabot.DeleteRepoSecret(ctx, scope.Owner, scope.Repo, name)
+	case scope.IsOrganization():
+		resp, err = s.client.Actions.DeleteOrgSecret(ctx, scope.Owner, name)
+	case scope.Environment != "":
+		repoID, idErr := s.repositoryID(ctx, scope)
+		if idErr != nil {
+			return idErr
+		}
+		resp, err = s.client.Actions.DeleteEnvSecret(ctx, repoID, scope.Environment, name)
+	default:
+		resp, err = s.client.Actions.DeleteRepoSecret(ctx, scope.Owner, scope.Repo, name)
+	}
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete secret")
+	}
+
+	return nil
+}
+
+// SetVariable creates or updates a configuration variable in the given scope.
+//
+// The API has separate create and update endpoints, so the variable is
+// created first and updated if it already exists.
+func (s *SDKProvider) SetVariable(ctx context.Context, scope gh.SecretScope, opts gh.SetVariableOptions) error {
+	if err := scope.ValidateForVariables(); err != nil {
+		return err
+	}
+
+	variable := &github.ActionsVariable{
+		Name:  opts.Name,
+		Value: opts.Value,
+	}
+	if scope.IsOrganization() {
+		variable.Visibility = github.String(opts.Visibility)
+		if len(opts.SelectedRepositoryIDs) > 0 {
+			ids := github.SelectedRepoIDs(opts.SelectedRepositoryIDs)
+			variable.SelectedRepositoryIDs = &ids
+		}
+	}
+
+	var (
+		resp *github.Response
+		err  error
+	)
+	switch {
+	case scope.IsOrganization():
+		resp, err = s.client.Actions.CreateOrgVariable(ctx, scope.Owner, variable)
+		if isConflict(resp) {
+			resp, err = s.client.Actions.UpdateOrgVariable(ctx, scope.Owner, variable)
+		}
+	case scope.Environment != "":
+		resp, err = s.client.Actions.CreateEnvVariable(ctx, scope.Owner, scope.Repo, scope.Environment, variable)
+		if isConflict(resp) {
+			resp, err = s.client.Actions.UpdateEnvVariable(ctx, scope.Owner, scope.Repo, scope.Environment, variable)
+		}
+	default:
+		resp, err = s.client.Actions.CreateRepoVariable(ctx, scope.Owner, scope.Repo, variable)
+		if isConflict(resp) {
+			resp, err = s.client.Actions.UpdateRepoVariable(ctx, scope.Owner, scope.Repo, variable)
+		}
+	}
+	if err != nil {
+		return s.wrapError(err, resp, "failed to set variable")
+	}
+
+	return nil
+}
+
+// ListVariables lists the configuration variables in the given scope.
+func (s *SDKProvider) ListVariables(ctx context.Context, scope gh.SecretScope, opts gh.ListOptions) ([]*gh.VariableData, error) {
+	if err := scope.ValidateForVariables(); err != nil {
+		return nil, err
+	}
+
+	listOpts := &github.ListOptions{
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+	}
+
+	var (
+		variables *github.ActionsVariables
+		resp      *github.Response
+		err       error
+	)
+	switch {
+	case scope.IsOrganization():
+		variables, resp, err = s.client.Actions.ListOrgVariables(ctx, scope.Owner, listOpts)
+	case scope.Environment != "":
+		variables, resp, err = s.client.Actions.ListEnvVariables(ctx, scope.Owner, scope.Repo, scope.Environment, listOpts)
+	default:
+		variables, resp, err = s.client.Actions.ListRepoVariables(ctx, scope.Owner, scope.Repo, listOpts)
+	}
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list variables")
+	}
+
+	result := make([]*gh.VariableData, len(variables.Variables))
+	for i, variable := range variables.Variables {
+		result[i] = &gh.VariableData{
+			Name:       variable.Name,
+			Value:      variable.Value,
+			Visibility: variable.GetVisibility(),
+			CreatedAt:  variable.GetCreatedAt().Time,
+			UpdatedAt:  variable.GetUpdatedAt().Time,
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteVariable deletes a configuration variable from the given scope.
+func (s *SDKProvider) DeleteVariable(ctx context.Context, scope gh.SecretScope, name string) error {
+	if err := scope.ValidateForVariables(); err != nil {
+		return err
+	}
+
+	var (
+		resp *github.Response
+		err  error
+	)
+	switch {
+	case scope.IsOrganization():
+		resp, err = s.client.Actions.DeleteOrgVariable(ctx, scope.Owner, name)
+	case scope.Environment != "":
+		resp, err = s.client.Actions.DeleteEnvVariable(ctx, scope.Owner, scope.Repo, scope.Environment, name)
+	default:
+		resp, err = s.client.Actions.DeleteRepoVariable(ctx, scope.Owner, scope.Repo, name)
+	}
+	if err != nil {
+		return s.wrapError(err, resp, "failed to delete variable")
+	}
+
+	return nil
+}
+
+// secretPublicKey retrieves the public key used to encrypt secrets for scope.
+func (s *SDKProvider) secretPublicKey(ctx context.Context, scope gh.SecretScope) (*github.PublicKey, error) {
+	var (
+		key  *github.PublicKey
+		resp *github.Response
+		err  error
+	)
+	switch {
+	case scope.IsDependabot() && scope.IsOrganization():
+		key, resp, err = s.client.Dependabot.GetOrgPublicKey(ctx, scope.Owner)
+	case scope.IsDependabot():
+		key, resp, err = s.client.Dependabot.GetRepoPublicKey(ctx, scope.Owner, scope.Repo)
+	case scope.IsOrganization():
+		key, resp, err = s.client.Actions.GetOrgPublicKey(ctx, scope.Owner)
+	case scope.Environment != "":
+		repoID, idErr := s.repositoryID(ctx, scope)
+		if idErr != nil {
+			return nil, idErr
+		}
+		key, resp, err = s.client.Actions.GetEnvPublicKey(ctx, repoID, scope.Environment)
+	default:
+		key, resp, err = s.client.Actions.GetRepoPublicKey(ctx, scope.Owner, scope.Repo)
+	}
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get secret public key")
+	}
+
+	return key, nil
+}
+
+// repositoryID resolves the numeric repository ID, which the environment
+// secret endpoints use instead of the owner and name.
+func (s *SDKProvider) repositoryID(ctx context.Context, scope gh.SecretScope) (int, error) {
+	repo, resp, err := s.client.Repositories.Get(ctx, scope.Owner, scope.Repo)
+	if err != nil {
+		return 0, s.wrapError(err, resp, "failed to get repository")
+	}
+	return int(repo.GetID()), nil
+}
+
+// encryptSecret encrypts value with a libsodium sealed box using the
+// base64-encoded public key returned by GitHub.
+func encryptSecret(publicKey, value string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", errors.Wrap(err, errors.CodeInternal, "failed to decode secret public key")
+	}
+	if len(decoded) != 32 {
+		return "", errors.New(errors.CodeInternal, "secret public key has invalid length")
+	}
+
+	var key [32]byte
+	copy(key[:], decoded)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &key, rand.Reader)
+	if err != nil {
+		return "", errors.Wrap(err, errors.CodeInternal, "failed to encrypt secret")
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// isConflict returns true if the response indicates the resource already exists.
+func isConflict(resp *github.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusConflict
+}
@@ -0,0 +1,124 @@
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v67/github"
+	"github.com/jmgilman/go/errors"
+	gh "github.com/jmgilman/go/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestSDKProvider_SetSecret(t *testing.T) {
+	t.Parallel()
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var received struct {
+		EncryptedValue string `json:"encrypted_value"`
+		KeyID          string `json:"key_id"`
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/repos/testowner/testrepo/actions/secrets/public-key", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"key_id": "568250167242549743", "key": "` + base64.StdEncoding.EncodeToString(publicKey[:]) + `"}`))
+	})
+	mux.HandleFunc("/repos/testowner/testrepo/actions/secrets/DEPLOY_TOKEN", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	scope := gh.SecretScope{Owner: "testowner", Repo: "testrepo"}
+	err = provider.SetSecret(context.Background(), scope, gh.SetSecretOptions{Name: "DEPLOY_TOKEN", Value: "s3cr3t"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "568250167242549743", received.KeyID)
+
+	sealed, err := base64.StdEncoding.DecodeString(received.EncryptedValue)
+	require.NoError(t, err)
+	plaintext, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+	require.True(t, ok)
+	assert.Equal(t, "s3cr3t", string(plaintext))
+}
+
+func TestSDKProvider_ListVariables(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() { server.Close() })
+
+	mux.HandleFunc("/orgs/testorg/actions/variables", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"total_count": 1,
+			"variables": [{
+				"name": "REGION",
+				"value": "us-east-1",
+				"visibility": "all",
+				"created_at": "2023-01-01T00:00:00Z",
+				"updated_at": "2023-01-02T00:00:00Z"
+			}]
+		}`))
+	})
+
+	client := github.NewClient(nil)
+	baseURL, err := client.BaseURL.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+
+	provider, err := NewSDKProvider(WithClient(client))
+	require.NoError(t, err)
+
+	t.Run("organization", func(t *testing.T) {
+		t.Parallel()
+
+		variables, err := provider.ListVariables(context.Background(), gh.SecretScope{Owner: "testorg"}, gh.ListOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, variables, 1)
+		assert.Equal(t, "REGION", variables[0].Name)
+		assert.Equal(t, "us-east-1", variables[0].Value)
+		assert.Equal(t, gh.VisibilityAll, variables[0].Visibility)
+	})
+
+	t.Run("dependabot is not supported", func(t *testing.T) {
+		t.Parallel()
+
+		scope := gh.SecretScope{Owner: "testorg", App: gh.SecretAppDependabot}
+		_, err := provider.ListVariables(context.Background(), scope, gh.ListOptions{})
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeInvalidInput, errors.GetCode(err))
+	})
+}
@@ -0,0 +1,105 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/google/go-github/v67/github"
+	"github.com/jmgilman/go/errors"
+	gh "github.com/jmgilman/go/github"
+)
+
+// GetTrafficViews retrieves the page views of a repository over the last 14 days.
+func (s *SDKProvider) GetTrafficViews(ctx context.Context, owner, repo string, opts gh.TrafficOptions) (*gh.TrafficData, error) {
+	views, resp, err := s.client.Repositories.ListTrafficViews(ctx, owner, repo, &github.TrafficBreakdownOptions{Per: opts.Per})
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get traffic views")
+	}
+
+	return &gh.TrafficData{
+		Count:   views.GetCount(),
+		Uniques: views.GetUniques(),
+		Points:  s.convertTrafficPoints(views.Views),
+	}, nil
+}
+
+// GetTrafficClones retrieves the clones of a repository over the last 14 days.
+func (s *SDKProvider) GetTrafficClones(ctx context.Context, owner, repo string, opts gh.TrafficOptions) (*gh.TrafficData, error) {
+	clones, resp, err := s.client.Repositories.ListTrafficClones(ctx, owner, repo, &github.TrafficBreakdownOptions{Per: opts.Per})
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to get traffic clones")
+	}
+
+	return &gh.TrafficData{
+		Count:   clones.GetCount(),
+		Uniques: clones.GetUniques(),
+		Points:  s.convertTrafficPoints(clones.Clones),
+	}, nil
+}
+
+// ListTopReferrers lists the top 10 referrers of a repository over the last 14 days.
+func (s *SDKProvider) ListTopReferrers(ctx context.Context, owner, repo string) ([]*gh.ReferrerData, error) {
+	referrers, resp, err := s.client.Repositories.ListTrafficReferrers(ctx, owner, repo)
+	if err != nil {
+		return nil, s.wrapError(err, resp, "failed to list top referrers")
+	}
+
+	result := make([]*gh.ReferrerData, len(referrers))
+	for i, referrer := range referrers {
+		result[i] = &gh.ReferrerData{
+			Referrer: referrer.GetReferrer(),
+			Count:    referrer.GetCount(),
+			Uniques:  referrer.GetUniques(),
+		}
+	}
+
+	return result, nil
+}
+
+// ListContributorStats lists the commit activity of every contributor.
+//
+// GitHub responds with 202 Accepted while the statistics are being computed,
+// which go-github reports as an AcceptedError.
+func (s *SDKProvider) ListContributorStats(ctx context.Context, owner, repo string) ([]*gh.ContributorStatsData, error) {
+	stats, resp, err := s.client.Repositories.ListContributorsStats(ctx, owner, repo)
+	if err != nil {
+		var acceptedErr *github.AcceptedError
+		if errors.As(err, &acceptedErr) {
+			return nil, errors.Wrap(err, errors.CodeUnavailable, "contributor statistics are being computed")
+		}
+		return nil, s.wrapError(err, resp, "failed to list contributor statistics")
+	}
+
+	result := make([]*gh.ContributorStatsData, len(stats))
+	for i, stat := range stats {
+		data := &gh.ContributorStatsData{
+			Author: stat.GetAuthor().GetLogin(),
+			Total:  stat.GetTotal(),
+			Weeks:  make([]*gh.ContributorWeekData, len(stat.Weeks)),
+		}
+		for j, week := range stat.Weeks {
+			data.Weeks[j] = &gh.ContributorWeekData{
+				Week:      week.GetWeek().Time,
+				Additions: week.GetAdditions(),
+				Deletions: week.GetDeletions(),
+				Commits:   week.GetCommits(),
+			}
+		}
+		result[i] = data
+	}
+
+	return result, nil
+}
+
+// convertTrafficPoints converts go-github TrafficData points to TrafficPointData.
+func (s *SDKProvider) convertTrafficPoints(points []*github.TrafficData) []*gh.TrafficPointData {
+	result := make([]*gh.TrafficPointData, len(points))
+	for i, point := range points {
+		result[i] = &gh.TrafficPointData{
+			Timestamp: point.GetTimestamp().Time,
+			Count:     point.GetCount(),
+			Uniques:   point.GetUniques(),
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v67/github"
+	"github.com/jmgilman/go/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSDKProvider_ListContributorStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		wantCode errors.ErrorCode
+		wantLen  int
+	}{
+		{
+			name:   "computed",
+			status: http.StatusOK,
+			body: `[{
+				"author": {"login": "octocat"},
+				"total": 3,
+				"weeks": [{"w": 1704067200, "a": 10, "d": 2, "c": 3}]
+			}]`,
+			wantLen: 1,
+		},
+		{
+			name:     "computing",
+			status:   http.StatusAccepted,
+			body:     `{}`,
+			wantCode: errors.CodeUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			server := httptest.NewServer(mux)
+			t.Cleanup(func() { server.Close() })
+
+			mux.HandleFunc("/repos/owner/repo/stats/contributors", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			client := github.NewClient(nil)
+			baseURL, err := client.BaseURL.Parse(server.URL + "/")
+			require.NoError(t, err)
+			client.BaseURL = baseURL
+
+			provider, err := NewSDKProvider(WithClient(client))
+			require.NoError(t, err)
+
+			stats, err := provider.ListContributorStats(context.Background(), "owner", "repo")
+			if tt.wantCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, errors.GetCode(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, stats, tt.wantLen)
+			assert.Equal(t, "octocat", stats[0].Author)
+			assert.Equal(t, 3, stats[0].Total)
+			require.Len(t, stats[0].Weeks, 1)
+			assert.Equal(t, 10, stats[0].Weeks[0].Additions)
+			assert.Equal(t, int64(1704067200), stats[0].Weeks[0].Week.Unix())
+		})
+	}
+}
@@ -61,6 +61,8 @@ func (pr *PullRequest) Update(ctx context.Context, opts ...PRUpdateOption) error
 }
 
 // Merge merges the pull request with the provided options.
+// Branches protected by a merge queue reject direct merges; use
+// MergeOrEnqueue when the base branch may have one.
 //
 // Example:
 //
@@ -132,6 +134,149 @@ func (pr *PullRequest) RemoveLabel(ctx context.Context, label string) error {
 	return nil
 }
 
+// CreateComment posts a comment on the pull request.
+//
+// Example:
+//
+//	comment, err := pr.CreateComment(ctx, "Build succeeded")
+func (pr *PullRequest) CreateComment(ctx context.Context, body string) (*CommentData, error) {
+	data, err := pr.client.provider.CreateComment(ctx, pr.owner, pr.repo, pr.data.Number, body)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create comment")
+	}
+	return data, nil
+}
+
+// ListComments lists the comments on the pull request.
+func (pr *PullRequest) ListComments(ctx context.Context) ([]*CommentData, error) {
+	comments, err := pr.client.provider.ListComments(ctx, pr.owner, pr.repo, pr.data.Number, ListOptions{})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list comments")
+	}
+	return comments, nil
+}
+
+// UpdateComment replaces the body of a comment on the pull request.
+// This allows bots to edit a previous status comment instead of posting a new one.
+//
+// Example:
+//
+//	comment, err := pr.UpdateComment(ctx, comment.ID, "Build failed")
+func (pr *PullRequest) UpdateComment(ctx context.Context, commentID int64, body string) (*CommentData, error) {
+	data, err := pr.client.provider.UpdateComment(ctx, pr.owner, pr.repo, commentID, body)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to update comment")
+	}
+	return data, nil
+}
+
+// DeleteComment deletes a comment on the pull request.
+func (pr *PullRequest) DeleteComment(ctx context.Context, commentID int64) error {
+	if err := pr.client.provider.DeleteComment(ctx, pr.owner, pr.repo, commentID); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete comment")
+	}
+	return nil
+}
+
+// AddReaction adds a reaction (e.g., ReactionRocket) to the pull request.
+func (pr *PullRequest) AddReaction(ctx context.Context, content string) error {
+	if err := pr.client.provider.AddIssueReaction(ctx, pr.owner, pr.repo, pr.data.Number, content); err != nil {
+		return WrapHTTPError(err, 0, "failed to add reaction")
+	}
+	return nil
+}
+
+// AddCommentReaction adds a reaction (e.g., ReactionPlusOne) to a comment on the pull request.
+func (pr *PullRequest) AddCommentReaction(ctx context.Context, commentID int64, content string) error {
+	if err := pr.client.provider.AddCommentReaction(ctx, pr.owner, pr.repo, commentID, content); err != nil {
+		return WrapHTTPError(err, 0, "failed to add comment reaction")
+	}
+	return nil
+}
+
+// RequestReviewers requests reviews from the given users.
+//
+// Example:
+//
+//	err := pr.RequestReviewers(ctx, "alice", "bob")
+func (pr *PullRequest) RequestReviewers(ctx context.Context, reviewers ...string) error {
+	opts := RequestReviewersOptions{Reviewers: reviewers}
+	if err := pr.client.provider.RequestReviewers(ctx, pr.owner, pr.repo, pr.data.Number, opts); err != nil {
+		return WrapHTTPError(err, 0, "failed to request reviewers")
+	}
+	return nil
+}
+
+// RequestTeamReviewers requests reviews from the given teams.
+// Teams are identified by their slug (e.g., "platform-team").
+func (pr *PullRequest) RequestTeamReviewers(ctx context.Context, teams ...string) error {
+	opts := RequestReviewersOptions{TeamReviewers: teams}
+	if err := pr.client.provider.RequestReviewers(ctx, pr.owner, pr.repo, pr.data.Number, opts); err != nil {
+		return WrapHTTPError(err, 0, "failed to request team reviewers")
+	}
+	return nil
+}
+
+// SubmitReview submits a review on the pull request.
+// The event must be one of ReviewEventApprove, ReviewEventRequestChanges,
+// or ReviewEventComment. A body is required unless approving.
+//
+// Example:
+//
+//	review, err := pr.SubmitReview(ctx, github.ReviewEventRequestChanges,
+//	    github.WithReviewBody("Please add tests"),
+//	)
+func (pr *PullRequest) SubmitReview(ctx context.Context, event string, opts ...ReviewOption) (*ReviewData, error) {
+	reviewOpts := &SubmitReviewOptions{
+		Event: event,
+	}
+	for _, opt := range opts {
+		opt(reviewOpts)
+	}
+
+	data, err := pr.client.provider.SubmitReview(ctx, pr.owner, pr.repo, pr.data.Number, *reviewOpts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to submit review")
+	}
+	return data, nil
+}
+
+// ListReviews lists the reviews submitted on the pull request.
+func (pr *PullRequest) ListReviews(ctx context.Context) ([]*ReviewData, error) {
+	reviews, err := pr.client.provider.ListReviews(ctx, pr.owner, pr.repo, pr.data.Number, ListOptions{})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list reviews")
+	}
+	return reviews, nil
+}
+
+// CreateReviewComment creates a review comment on a line of a file in the pull request diff.
+// The comment is anchored to the pull request's head commit unless overridden.
+//
+// Example:
+//
+//	comment, err := pr.CreateReviewComment(ctx, "main.go", 42, "Consider handling this error",
+//	    github.WithCommentStartLine(40),
+//	)
+func (pr *PullRequest) CreateReviewComment(ctx context.Context, path string, line int, body string, opts ...ReviewCommentOption) (*ReviewCommentData, error) {
+	commentOpts := &CreateReviewCommentOptions{
+		Body:     body,
+		Path:     path,
+		Line:     line,
+		Side:     DiffSideRight, // default
+		CommitID: pr.data.HeadSHA,
+	}
+	for _, opt := range opts {
+		opt(commentOpts)
+	}
+
+	data, err := pr.client.provider.CreateReviewComment(ctx, pr.owner, pr.repo, pr.data.Number, *commentOpts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create review comment")
+	}
+	return data, nil
+}
+
 // Number returns the pull request number.
 func (pr *PullRequest) Number() int {
 	return pr.data.Number
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// ListTags lists all tags of the repository.
+func (r *Repository) ListTags(ctx context.Context) ([]*TagData, error) {
+	var all []*TagData
+	for page := 1; ; page++ {
+		tags, err := r.client.provider.ListTags(ctx, r.owner, r.name, ListOptions{Page: page, PerPage: 100})
+		if err != nil {
+			return nil, WrapHTTPError(err, 0, "failed to list tags")
+		}
+		all = append(all, tags...)
+		if len(tags) < 100 {
+			return all, nil
+		}
+	}
+}
+
+// CreateTag creates an annotated tag and the refs/tags reference pointing
+// to it, without requiring a local clone.
+//
+// Returns ErrInvalidInput if the tag name or SHA is missing, or if the tag
+// already exists.
+//
+// Example:
+//
+//	tag, err := repo.CreateTag(ctx, github.CreateTagOptions{
+//	    Tag:     "v1.2.0",
+//	    Message: "Release v1.2.0",
+//	    SHA:     commitSHA,
+//	})
+func (r *Repository) CreateTag(ctx context.Context, opts CreateTagOptions) (*TagObjectData, error) {
+	if opts.Tag == "" || opts.SHA == "" {
+		return nil, errors.New(errors.CodeInvalidInput, "tag name and SHA are required")
+	}
+	if opts.Type == "" {
+		opts.Type = "commit" // default
+	}
+
+	tag, err := r.client.provider.CreateTagObject(ctx, r.owner, r.name, opts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create tag object")
+	}
+
+	if _, err := r.CreateRef(ctx, "refs/tags/"+opts.Tag, tag.SHA); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// CreateRef creates a reference pointing to the given SHA. The reference
+// must be fully qualified (e.g. "refs/heads/feature" or "refs/tags/v1.0.0").
+//
+// Returns ErrInvalidInput if the reference is not fully qualified or
+// already exists.
+func (r *Repository) CreateRef(ctx context.Context, ref, sha string) (*RefData, error) {
+	if !strings.HasPrefix(ref, "refs/") {
+		return nil, errors.Newf(errors.CodeInvalidInput, "reference %q is not fully qualified", ref)
+	}
+
+	data, err := r.client.provider.CreateRef(ctx, r.owner, r.name, ref, sha)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create reference")
+	}
+	return data, nil
+}
+
+// DeleteRef deletes a reference. The reference must be fully qualified
+// (e.g. "refs/tags/v1.0.0").
+//
+// Returns ErrInvalidInput if the reference is not fully qualified.
+func (r *Repository) DeleteRef(ctx context.Context, ref string) error {
+	if !strings.HasPrefix(ref, "refs/") {
+		return errors.Newf(errors.CodeInvalidInput, "reference %q is not fully qualified", ref)
+	}
+
+	if err := r.client.provider.DeleteRef(ctx, r.owner, r.name, ref); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete reference")
+	}
+	return nil
+}
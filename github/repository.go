@@ -3,6 +3,9 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/jmgilman/go/errors"
 )
 
 // Repository represents a GitHub repository and provides repository-scoped operations.
@@ -142,6 +145,138 @@ func (r *Repository) Data() *RepositoryData {
 	return r.data
 }
 
+// CreateFromTemplate creates a new repository using this repository as a
+// template. The new repository is owned by the client's default owner unless
+// WithTemplateOwner is given.
+//
+// Example:
+//
+//	template := client.Repository("service-template")
+//	repo, err := template.CreateFromTemplate(ctx, "payments",
+//	    github.WithTemplateDescription("Payments service"),
+//	    github.WithTemplatePrivate(),
+//	)
+func (r *Repository) CreateFromTemplate(ctx context.Context, name string, opts ...TemplateOption) (*Repository, error) {
+	createOpts := CreateRepositoryFromTemplateOptions{
+		Owner: r.client.owner,
+		Name:  name,
+	}
+	for _, opt := range opts {
+		opt(&createOpts)
+	}
+
+	data, err := r.client.provider.CreateRepositoryFromTemplate(ctx, r.owner, r.name, createOpts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to create repository from template")
+	}
+
+	return &Repository{
+		client: r.client,
+		owner:  data.Owner,
+		name:   data.Name,
+		data:   data,
+	}, nil
+}
+
+// Fork forks this repository. The fork is owned by the authenticated user
+// unless WithForkOrganization is given.
+//
+// GitHub creates forks asynchronously, so the returned repository may not be
+// usable right away. Call WaitUntilReady on it before accessing its contents.
+//
+// Example:
+//
+//	fork, err := repo.Fork(ctx, github.WithForkOrganization("myorg"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := fork.WaitUntilReady(ctx, 2*time.Second); err != nil {
+//	    log.Fatal(err)
+//	}
+func (r *Repository) Fork(ctx context.Context, opts ...ForkOption) (*Repository, error) {
+	forkOpts := ForkRepositoryOptions{}
+	for _, opt := range opts {
+		opt(&forkOpts)
+	}
+
+	data, err := r.client.provider.ForkRepository(ctx, r.owner, r.name, forkOpts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to fork repository")
+	}
+
+	return &Repository{
+		client: r.client,
+		owner:  data.Owner,
+		name:   data.Name,
+		data:   data,
+	}, nil
+}
+
+// Transfer transfers this repository to newOwner and returns the repository
+// at its new location. Transfers to users take effect once the recipient
+// accepts them.
+//
+// Example:
+//
+//	moved, err := repo.Transfer(ctx, "new-org", github.WithTransferTeams(42))
+func (r *Repository) Transfer(ctx context.Context, newOwner string, opts ...TransferOption) (*Repository, error) {
+	transferOpts := TransferRepositoryOptions{
+		NewOwner: newOwner,
+	}
+	for _, opt := range opts {
+		opt(&transferOpts)
+	}
+
+	data, err := r.client.provider.TransferRepository(ctx, r.owner, r.name, transferOpts)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to transfer repository")
+	}
+
+	name := transferOpts.NewName
+	if name == "" {
+		name = r.name
+	}
+
+	return &Repository{
+		client: r.client,
+		owner:  newOwner,
+		name:   name,
+		data:   data,
+	}, nil
+}
+
+// WaitUntilReady polls the repository until it can be fetched or the context
+// is cancelled. This is primarily useful after Fork or CreateFromTemplate,
+// which complete asynchronously on GitHub's side.
+// The pollInterval parameter specifies how often to check.
+func (r *Repository) WaitUntilReady(ctx context.Context, pollInterval time.Duration) error {
+	if pollInterval <= 0 {
+		pollInterval = 2 * time.Second // default
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// The provider is queried directly so that a not-found error can be
+		// told apart from other failures.
+		data, err := r.client.provider.GetRepository(ctx, r.owner, r.name)
+		if err == nil {
+			r.data = data
+			return nil
+		}
+		if errors.GetCode(err) != errors.CodeNotFound {
+			return WrapHTTPError(err, 0, "failed to get repository")
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), errors.CodeTimeout, "repository wait cancelled or timed out")
+		case <-ticker.C:
+		}
+	}
+}
+
 // Issue operations
 
 // CreateIssue creates a new issue in the repository.
@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// Validate checks that the scope identifies a valid secret store.
+// Returns ErrInvalidInput if the scope is incomplete or inconsistent.
+//
+// This is used by provider implementations and is not typically called directly.
+func (s SecretScope) Validate() error {
+	if s.Owner == "" {
+		return errors.New(errors.CodeInvalidInput, "secret scope requires an owner")
+	}
+
+	switch s.App {
+	case "", SecretAppActions:
+	case SecretAppDependabot:
+		if s.Environment != "" {
+			return errors.New(errors.CodeInvalidInput, "dependabot secrets cannot be scoped to an environment")
+		}
+	default:
+		return errors.WithContext(errors.New(errors.CodeInvalidInput, "unknown secret app"), "app", s.App)
+	}
+
+	if s.Environment != "" && s.Repo == "" {
+		return errors.New(errors.CodeInvalidInput, "environment scope requires a repository")
+	}
+
+	return nil
+}
+
+// ValidateForVariables checks that the scope can hold configuration variables.
+// Variables are only supported for GitHub Actions.
+// Returns ErrInvalidInput if the scope is invalid.
+//
+// This is used by provider implementations and is not typically called directly.
+func (s SecretScope) ValidateForVariables() error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	if s.App == SecretAppDependabot {
+		return errors.New(errors.CodeInvalidInput, "variables are not supported for dependabot")
+	}
+	return nil
+}
+
+// IsOrganization returns true if the scope refers to an organization.
+func (s SecretScope) IsOrganization() bool {
+	return s.Repo == ""
+}
+
+// IsDependabot returns true if the scope refers to the Dependabot secret store.
+func (s SecretScope) IsDependabot() bool {
+	return s.App == SecretAppDependabot
+}
+
+// Repository secret operations
+
+// SetSecret creates or updates a secret in the repository.
+// The value is encrypted against the repository's public key before it is sent.
+//
+// Example:
+//
+//	// Repository secret
+//	err := repo.SetSecret(ctx, "DEPLOY_TOKEN", token)
+//
+//	// Environment secret
+//	err = repo.SetSecret(ctx, "DEPLOY_TOKEN", token, github.WithEnvironment("production"))
+//
+//	// Dependabot secret
+//	err = repo.SetSecret(ctx, "NPM_TOKEN", token, github.WithDependabot())
+func (r *Repository) SetSecret(ctx context.Context, name, value string, opts ...SecretOption) error {
+	err := r.client.provider.SetSecret(ctx, r.secretScope(opts), SetSecretOptions{
+		Name:  name,
+		Value: value,
+	})
+	if err != nil {
+		return WrapHTTPError(err, 0, "failed to set secret")
+	}
+	return nil
+}
+
+// ListSecrets lists the secrets in the repository. Secret values are never returned.
+func (r *Repository) ListSecrets(ctx context.Context, opts ...SecretOption) ([]*SecretData, error) {
+	secrets, err := r.client.provider.ListSecrets(ctx, r.secretScope(opts), ListOptions{})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list secrets")
+	}
+	return secrets, nil
+}
+
+// DeleteSecret deletes a secret from the repository.
+func (r *Repository) DeleteSecret(ctx context.Context, name string, opts ...SecretOption) error {
+	if err := r.client.provider.DeleteSecret(ctx, r.secretScope(opts), name); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete secret")
+	}
+	return nil
+}
+
+// SetVariable creates or updates a configuration variable in the repository.
+//
+// Example:
+//
+//	err := repo.SetVariable(ctx, "REGION", "us-east-1", github.WithEnvironment("production"))
+func (r *Repository) SetVariable(ctx context.Context, name, value string, opts ...SecretOption) error {
+	err := r.client.provider.SetVariable(ctx, r.secretScope(opts), SetVariableOptions{
+		Name:  name,
+		Value: value,
+	})
+	if err != nil {
+		return WrapHTTPError(err, 0, "failed to set variable")
+	}
+	return nil
+}
+
+// ListVariables lists the configuration variables in the repository.
+func (r *Repository) ListVariables(ctx context.Context, opts ...SecretOption) ([]*VariableData, error) {
+	variables, err := r.client.provider.ListVariables(ctx, r.secretScope(opts), ListOptions{})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list variables")
+	}
+	return variables, nil
+}
+
+// DeleteVariable deletes a configuration variable from the repository.
+func (r *Repository) DeleteVariable(ctx context.Context, name string, opts ...SecretOption) error {
+	if err := r.client.provider.DeleteVariable(ctx, r.secretScope(opts), name); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete variable")
+	}
+	return nil
+}
+
+// secretScope builds the repository secret scope from options.
+func (r *Repository) secretScope(opts []SecretOption) SecretScope {
+	scope := SecretScope{
+		Owner: r.owner,
+		Repo:  r.name,
+	}
+	for _, opt := range opts {
+		opt(&scope)
+	}
+	return scope
+}
+
+// Organization secret operations
+
+// SetOrgSecret creates or updates a secret in the client's default owner,
+// which must be an organization.
+//
+// Example:
+//
+//	err := client.SetOrgSecret(ctx, github.SetSecretOptions{
+//	    Name:                  "REGISTRY_PASSWORD",
+//	    Value:                 password,
+//	    Visibility:            github.VisibilitySelected,
+//	    SelectedRepositoryIDs: []int64{1296269},
+//	})
+func (c *Client) SetOrgSecret(ctx context.Context, opts SetSecretOptions, scopeOpts ...SecretOption) error {
+	if opts.Visibility == "" {
+		opts.Visibility = VisibilityPrivate
+	}
+	if err := c.provider.SetSecret(ctx, c.orgSecretScope(scopeOpts), opts); err != nil {
+		return WrapHTTPError(err, 0, "failed to set organization secret")
+	}
+	return nil
+}
+
+// ListOrgSecrets lists the secrets in the client's default organization.
+func (c *Client) ListOrgSecrets(ctx context.Context, opts ...SecretOption) ([]*SecretData, error) {
+	secrets, err := c.provider.ListSecrets(ctx, c.orgSecretScope(opts), ListOptions{})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list organization secrets")
+	}
+	return secrets, nil
+}
+
+// DeleteOrgSecret deletes a secret from the client's default organization.
+func (c *Client) DeleteOrgSecret(ctx context.Context, name string, opts ...SecretOption) error {
+	if err := c.provider.DeleteSecret(ctx, c.orgSecretScope(opts), name); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete organization secret")
+	}
+	return nil
+}
+
+// SetOrgVariable creates or updates a configuration variable in the client's
+// default organization.
+func (c *Client) SetOrgVariable(ctx context.Context, opts SetVariableOptions) error {
+	if opts.Visibility == "" {
+		opts.Visibility = VisibilityPrivate
+	}
+	if err := c.provider.SetVariable(ctx, c.orgSecretScope(nil), opts); err != nil {
+		return WrapHTTPError(err, 0, "failed to set organization variable")
+	}
+	return nil
+}
+
+// ListOrgVariables lists the configuration variables in the client's default organization.
+func (c *Client) ListOrgVariables(ctx context.Context) ([]*VariableData, error) {
+	variables, err := c.provider.ListVariables(ctx, c.orgSecretScope(nil), ListOptions{})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list organization variables")
+	}
+	return variables, nil
+}
+
+// DeleteOrgVariable deletes a configuration variable from the client's default organization.
+func (c *Client) DeleteOrgVariable(ctx context.Context, name string) error {
+	if err := c.provider.DeleteVariable(ctx, c.orgSecretScope(nil), name); err != nil {
+		return WrapHTTPError(err, 0, "failed to delete organization variable")
+	}
+	return nil
+}
+
+// orgSecretScope builds the organization secret scope from options.
+func (c *Client) orgSecretScope(opts []SecretOption) SecretScope {
+	scope := SecretScope{
+		Owner: c.owner,
+	}
+	for _, opt := range opts {
+		opt(&scope)
+	}
+	return scope
+}
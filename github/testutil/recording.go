@@ -0,0 +1,1011 @@
+// Package testutil provides testing utilities for the github package.
+//
+// RecordingProvider captures the calls made against a real provider and the
+// results it returned, and later replays them offline. This makes it cheap
+// to write tests for multi-call flows against realistic data without
+// hand-writing mock functions for every call.
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/jmgilman/go/errors"
+	gh "github.com/jmgilman/go/github"
+)
+
+// Ensure RecordingProvider implements github.Provider.
+var _ gh.Provider = (*RecordingProvider)(nil)
+
+// Interaction is a single recorded provider call.
+type Interaction struct {
+	// Method is the name of the provider method that was called.
+	Method string `json:"method"`
+
+	// Request holds the call arguments keyed by parameter name.
+	// The context is not recorded.
+	Request json.RawMessage `json:"request"`
+
+	// Response holds the value returned by the provider, if any.
+	Response json.RawMessage `json:"response,omitempty"`
+
+	// Error holds the error returned by the provider, if any.
+	Error *InteractionError `json:"error,omitempty"`
+}
+
+// InteractionError is the recorded form of an error returned by a provider.
+type InteractionError struct {
+	Code    errors.ErrorCode `json:"code"`
+	Message string           `json:"message"`
+}
+
+// fixture is the on-disk format of a recording.
+type fixture struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// redactedValue replaces secret values in recorded requests.
+const redactedValue = "REDACTED"
+
+// interactionArgs holds the arguments of a provider call.
+type interactionArgs map[string]interface{}
+
+// RecordingProvider is a github.Provider that either records the calls made
+// against a wrapped provider or replays previously recorded calls.
+//
+// In replay mode each call is answered by the first unused interaction with
+// the same method and arguments, so independent calls may be replayed in any
+// order while repeated calls are answered in the order they were recorded.
+// Calls without a matching interaction fail with ErrNotImplemented.
+//
+// Results are round-tripped through JSON in both modes, so tests observe the
+// same values whether they run against a live provider or a fixture.
+//
+// Secret values passed to SetSecret are redacted before they are recorded.
+type RecordingProvider struct {
+	// provider is the wrapped provider; nil in replay mode.
+	provider gh.Provider
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecordingProvider creates a provider that forwards all calls to provider
+// and records them. Use Save to write the recording to a fixture file.
+//
+// Example:
+//
+//	recorder := testutil.NewRecordingProvider(sdkProvider)
+//	client := github.NewClient(recorder, "owner")
+//	// ... exercise the client ...
+//	err = recorder.Save("testdata/release_flow.json")
+func NewRecordingProvider(provider gh.Provider) *RecordingProvider {
+	return &RecordingProvider{
+		provider: provider,
+	}
+}
+
+// NewReplayProvider creates a provider that answers calls from the fixture
+// file at path, which must have been written by RecordingProvider.Save.
+//
+// Example:
+//
+//	replay, err := testutil.NewReplayProvider("testdata/release_flow.json")
+//	require.NoError(t, err)
+//	client := github.NewClient(replay, "owner")
+func NewReplayProvider(path string) (*RecordingProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithContext(errors.Wrap(err, errors.CodeNotFound, "failed to read fixture"), "path", path)
+	}
+
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, errors.WithContext(errors.Wrap(err, errors.CodeInvalidInput, "failed to parse fixture"), "path", path)
+	}
+
+	return &RecordingProvider{
+		interactions: f.Interactions,
+		used:         make([]bool, len(f.Interactions)),
+	}, nil
+}
+
+// Save writes the recorded interactions to path as JSON, creating parent
+// directories as needed.
+func (p *RecordingProvider) Save(path string) error {
+	p.mu.Lock()
+	data, err := json.MarshalIndent(fixture{Interactions: p.interactions}, "", "  ")
+	p.mu.Unlock()
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to encode fixture")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.WithContext(errors.Wrap(err, errors.CodeInternal, "failed to create fixture directory"), "path", path)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return errors.WithContext(errors.Wrap(err, errors.CodeInternal, "failed to write fixture"), "path", path)
+	}
+	return nil
+}
+
+// Interactions returns a copy of the recorded interactions.
+func (p *RecordingProvider) Interactions() []Interaction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]Interaction(nil), p.interactions...)
+}
+
+// Unused returns the interactions that have not been replayed yet. It is
+// always empty in record mode. Tests can use it to assert that a flow made
+// every call it was expected to make.
+func (p *RecordingProvider) Unused() []Interaction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range p.interactions {
+		if i < len(p.used) && !p.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+// call records or replays a single provider call. In record mode fn is
+// invoked against the wrapped provider. In both modes the response is
+// decoded into out, which may be nil for calls that only return an error.
+func (p *RecordingProvider) call(method string, args interactionArgs, out interface{}, fn func(gh.Provider) (interface{}, error)) error {
+	request, err := json.Marshal(args)
+	if err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to encode request")
+	}
+
+	var interaction Interaction
+	if p.provider == nil {
+		interaction, err = p.replay(method, request)
+		if err != nil {
+			return err
+		}
+	} else {
+		interaction = p.record(method, request, fn)
+	}
+
+	if out != nil && len(interaction.Response) > 0 {
+		if err := json.Unmarshal(interaction.Response, out); err != nil {
+			return errors.Wrap(err, errors.CodeInternal, "failed to decode recorded response")
+		}
+	}
+
+	if interaction.Error != nil {
+		return errors.New(interaction.Error.Code, interaction.Error.Message)
+	}
+	return nil
+}
+
+// record invokes fn against the wrapped provider and stores the result.
+func (p *RecordingProvider) record(method string, request []byte, fn func(gh.Provider) (interface{}, error)) Interaction {
+	result, callErr := fn(p.provider)
+
+	interaction := Interaction{
+		Method:  method,
+		Request: request,
+	}
+	if result != nil {
+		if response, err := json.Marshal(result); err == nil {
+			interaction.Response = response
+		}
+	}
+	if callErr != nil {
+		interaction.Error = recordError(callErr)
+	}
+
+	p.mu.Lock()
+	p.interactions = append(p.interactions, interaction)
+	p.mu.Unlock()
+
+	return interaction
+}
+
+// replay returns the first unused interaction matching method and request.
+func (p *RecordingProvider) replay(method string, request []byte) (Interaction, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, interaction := range p.interactions {
+		if p.used[i] || interaction.Method != method {
+			continue
+		}
+
+		var recorded bytes.Buffer
+		if err := json.Compact(&recorded, interaction.Request); err != nil {
+			continue
+		}
+		if bytes.Equal(recorded.Bytes(), request) {
+			p.used[i] = true
+			return interaction, nil
+		}
+	}
+
+	err := errors.New(errors.CodeNotImplemented, "no recorded interaction matches call")
+	return Interaction{}, errors.WithContextMap(err, map[string]interface{}{
+		"method":  method,
+		"request": string(request),
+	})
+}
+
+// recordError converts err into its recorded form.
+func recordError(err error) *InteractionError {
+	var platformErr errors.PlatformError
+	if errors.As(err, &platformErr) {
+		return &InteractionError{
+			Code:    platformErr.Code(),
+			Message: platformErr.Message(),
+		}
+	}
+
+	return &InteractionError{
+		Code:    errors.CodeUnknown,
+		Message: err.Error(),
+	}
+}
+
+// readAll reads and closes a stream returned by the provider.
+func readAll(rc io.ReadCloser, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.CodeNetwork, "failed to read stream")
+	}
+	return data, nil
+}
+
+// GetRepository implements github.Provider.
+func (p *RecordingProvider) GetRepository(ctx context.Context, owner, repo string) (*gh.RepositoryData, error) {
+	var result *gh.RepositoryData
+	err := p.call("GetRepository", interactionArgs{"owner": owner, "repo": repo}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetRepository(ctx, owner, repo)
+	})
+	return result, err
+}
+
+// ListRepositories implements github.Provider.
+func (p *RecordingProvider) ListRepositories(ctx context.Context, owner string, opts gh.ListOptions) ([]*gh.RepositoryData, error) {
+	var result []*gh.RepositoryData
+	err := p.call("ListRepositories", interactionArgs{"owner": owner, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListRepositories(ctx, owner, opts)
+	})
+	return result, err
+}
+
+// CreateRepository implements github.Provider.
+func (p *RecordingProvider) CreateRepository(ctx context.Context, owner string, opts gh.CreateRepositoryOptions) (*gh.RepositoryData, error) {
+	var result *gh.RepositoryData
+	err := p.call("CreateRepository", interactionArgs{"owner": owner, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateRepository(ctx, owner, opts)
+	})
+	return result, err
+}
+
+// CreateRepositoryFromTemplate implements github.Provider.
+func (p *RecordingProvider) CreateRepositoryFromTemplate(ctx context.Context, templateOwner, templateRepo string, opts gh.CreateRepositoryFromTemplateOptions) (*gh.RepositoryData, error) {
+	var result *gh.RepositoryData
+	err := p.call("CreateRepositoryFromTemplate", interactionArgs{"templateOwner": templateOwner, "templateRepo": templateRepo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateRepositoryFromTemplate(ctx, templateOwner, templateRepo, opts)
+	})
+	return result, err
+}
+
+// ForkRepository implements github.Provider.
+func (p *RecordingProvider) ForkRepository(ctx context.Context, owner, repo string, opts gh.ForkRepositoryOptions) (*gh.RepositoryData, error) {
+	var result *gh.RepositoryData
+	err := p.call("ForkRepository", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ForkRepository(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// TransferRepository implements github.Provider.
+func (p *RecordingProvider) TransferRepository(ctx context.Context, owner, repo string, opts gh.TransferRepositoryOptions) (*gh.RepositoryData, error) {
+	var result *gh.RepositoryData
+	err := p.call("TransferRepository", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.TransferRepository(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// ListCommits implements github.Provider.
+func (p *RecordingProvider) ListCommits(ctx context.Context, owner, repo string, opts gh.ListCommitsOptions) ([]*gh.CommitData, error) {
+	var result []*gh.CommitData
+	err := p.call("ListCommits", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListCommits(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// CompareCommits implements github.Provider.
+func (p *RecordingProvider) CompareCommits(ctx context.Context, owner, repo, base, head string, opts gh.ListOptions) (*gh.ComparisonData, error) {
+	var result *gh.ComparisonData
+	err := p.call("CompareCommits", interactionArgs{"owner": owner, "repo": repo, "base": base, "head": head, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CompareCommits(ctx, owner, repo, base, head, opts)
+	})
+	return result, err
+}
+
+// GetCombinedStatus implements github.Provider.
+func (p *RecordingProvider) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*gh.CombinedStatusData, error) {
+	var result *gh.CombinedStatusData
+	err := p.call("GetCombinedStatus", interactionArgs{"owner": owner, "repo": repo, "ref": ref}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetCombinedStatus(ctx, owner, repo, ref)
+	})
+	return result, err
+}
+
+// ListCheckRuns implements github.Provider.
+func (p *RecordingProvider) ListCheckRuns(ctx context.Context, owner, repo, ref string) ([]*gh.CheckRunData, error) {
+	var result []*gh.CheckRunData
+	err := p.call("ListCheckRuns", interactionArgs{"owner": owner, "repo": repo, "ref": ref}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListCheckRuns(ctx, owner, repo, ref)
+	})
+	return result, err
+}
+
+// ListTags implements github.Provider.
+func (p *RecordingProvider) ListTags(ctx context.Context, owner, repo string, opts gh.ListOptions) ([]*gh.TagData, error) {
+	var result []*gh.TagData
+	err := p.call("ListTags", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListTags(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// CreateTagObject implements github.Provider.
+func (p *RecordingProvider) CreateTagObject(ctx context.Context, owner, repo string, opts gh.CreateTagOptions) (*gh.TagObjectData, error) {
+	var result *gh.TagObjectData
+	err := p.call("CreateTagObject", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateTagObject(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// CreateRef implements github.Provider.
+func (p *RecordingProvider) CreateRef(ctx context.Context, owner, repo, ref, sha string) (*gh.RefData, error) {
+	var result *gh.RefData
+	err := p.call("CreateRef", interactionArgs{"owner": owner, "repo": repo, "ref": ref, "sha": sha}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateRef(ctx, owner, repo, ref, sha)
+	})
+	return result, err
+}
+
+// DeleteRef implements github.Provider.
+func (p *RecordingProvider) DeleteRef(ctx context.Context, owner, repo, ref string) error {
+	return p.call("DeleteRef", interactionArgs{"owner": owner, "repo": repo, "ref": ref}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteRef(ctx, owner, repo, ref)
+	})
+}
+
+// GetTrafficViews implements github.Provider.
+func (p *RecordingProvider) GetTrafficViews(ctx context.Context, owner, repo string, opts gh.TrafficOptions) (*gh.TrafficData, error) {
+	var result *gh.TrafficData
+	err := p.call("GetTrafficViews", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetTrafficViews(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// GetTrafficClones implements github.Provider.
+func (p *RecordingProvider) GetTrafficClones(ctx context.Context, owner, repo string, opts gh.TrafficOptions) (*gh.TrafficData, error) {
+	var result *gh.TrafficData
+	err := p.call("GetTrafficClones", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetTrafficClones(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// ListTopReferrers implements github.Provider.
+func (p *RecordingProvider) ListTopReferrers(ctx context.Context, owner, repo string) ([]*gh.ReferrerData, error) {
+	var result []*gh.ReferrerData
+	err := p.call("ListTopReferrers", interactionArgs{"owner": owner, "repo": repo}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListTopReferrers(ctx, owner, repo)
+	})
+	return result, err
+}
+
+// ListContributorStats implements github.Provider.
+func (p *RecordingProvider) ListContributorStats(ctx context.Context, owner, repo string) ([]*gh.ContributorStatsData, error) {
+	var result []*gh.ContributorStatsData
+	err := p.call("ListContributorStats", interactionArgs{"owner": owner, "repo": repo}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListContributorStats(ctx, owner, repo)
+	})
+	return result, err
+}
+
+// GetIssue implements github.Provider.
+func (p *RecordingProvider) GetIssue(ctx context.Context, owner, repo string, number int) (*gh.IssueData, error) {
+	var result *gh.IssueData
+	err := p.call("GetIssue", interactionArgs{"owner": owner, "repo": repo, "number": number}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetIssue(ctx, owner, repo, number)
+	})
+	return result, err
+}
+
+// ListIssues implements github.Provider.
+func (p *RecordingProvider) ListIssues(ctx context.Context, owner, repo string, opts gh.ListIssuesOptions) ([]*gh.IssueData, error) {
+	var result []*gh.IssueData
+	err := p.call("ListIssues", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListIssues(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// CreateIssue implements github.Provider.
+func (p *RecordingProvider) CreateIssue(ctx context.Context, owner, repo string, opts gh.CreateIssueOptions) (*gh.IssueData, error) {
+	var result *gh.IssueData
+	err := p.call("CreateIssue", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateIssue(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// UpdateIssue implements github.Provider.
+func (p *RecordingProvider) UpdateIssue(ctx context.Context, owner, repo string, number int, opts gh.UpdateIssueOptions) (*gh.IssueData, error) {
+	var result *gh.IssueData
+	err := p.call("UpdateIssue", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.UpdateIssue(ctx, owner, repo, number, opts)
+	})
+	return result, err
+}
+
+// CloseIssue implements github.Provider.
+func (p *RecordingProvider) CloseIssue(ctx context.Context, owner, repo string, number int) error {
+	return p.call("CloseIssue", interactionArgs{"owner": owner, "repo": repo, "number": number}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.CloseIssue(ctx, owner, repo, number)
+	})
+}
+
+// AddLabels implements github.Provider.
+func (p *RecordingProvider) AddLabels(ctx context.Context, owner, repo string, number int, labels []string) error {
+	return p.call("AddLabels", interactionArgs{"owner": owner, "repo": repo, "number": number, "labels": labels}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.AddLabels(ctx, owner, repo, number, labels)
+	})
+}
+
+// RemoveLabel implements github.Provider.
+func (p *RecordingProvider) RemoveLabel(ctx context.Context, owner, repo string, number int, label string) error {
+	return p.call("RemoveLabel", interactionArgs{"owner": owner, "repo": repo, "number": number, "label": label}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.RemoveLabel(ctx, owner, repo, number, label)
+	})
+}
+
+// ListLabels implements github.Provider.
+func (p *RecordingProvider) ListLabels(ctx context.Context, owner, repo string, opts gh.ListOptions) ([]*gh.LabelData, error) {
+	var result []*gh.LabelData
+	err := p.call("ListLabels", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListLabels(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// CreateLabel implements github.Provider.
+func (p *RecordingProvider) CreateLabel(ctx context.Context, owner, repo string, opts gh.CreateLabelOptions) (*gh.LabelData, error) {
+	var result *gh.LabelData
+	err := p.call("CreateLabel", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateLabel(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// UpdateLabel implements github.Provider.
+func (p *RecordingProvider) UpdateLabel(ctx context.Context, owner, repo, name string, opts gh.UpdateLabelOptions) (*gh.LabelData, error) {
+	var result *gh.LabelData
+	err := p.call("UpdateLabel", interactionArgs{"owner": owner, "repo": repo, "name": name, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.UpdateLabel(ctx, owner, repo, name, opts)
+	})
+	return result, err
+}
+
+// DeleteLabel implements github.Provider.
+func (p *RecordingProvider) DeleteLabel(ctx context.Context, owner, repo, name string) error {
+	return p.call("DeleteLabel", interactionArgs{"owner": owner, "repo": repo, "name": name}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteLabel(ctx, owner, repo, name)
+	})
+}
+
+// ListMilestones implements github.Provider.
+func (p *RecordingProvider) ListMilestones(ctx context.Context, owner, repo string, opts gh.ListMilestonesOptions) ([]*gh.MilestoneData, error) {
+	var result []*gh.MilestoneData
+	err := p.call("ListMilestones", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListMilestones(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// GetMilestone implements github.Provider.
+func (p *RecordingProvider) GetMilestone(ctx context.Context, owner, repo string, number int) (*gh.MilestoneData, error) {
+	var result *gh.MilestoneData
+	err := p.call("GetMilestone", interactionArgs{"owner": owner, "repo": repo, "number": number}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetMilestone(ctx, owner, repo, number)
+	})
+	return result, err
+}
+
+// CreateMilestone implements github.Provider.
+func (p *RecordingProvider) CreateMilestone(ctx context.Context, owner, repo string, opts gh.CreateMilestoneOptions) (*gh.MilestoneData, error) {
+	var result *gh.MilestoneData
+	err := p.call("CreateMilestone", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateMilestone(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// UpdateMilestone implements github.Provider.
+func (p *RecordingProvider) UpdateMilestone(ctx context.Context, owner, repo string, number int, opts gh.UpdateMilestoneOptions) (*gh.MilestoneData, error) {
+	var result *gh.MilestoneData
+	err := p.call("UpdateMilestone", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.UpdateMilestone(ctx, owner, repo, number, opts)
+	})
+	return result, err
+}
+
+// DeleteMilestone implements github.Provider.
+func (p *RecordingProvider) DeleteMilestone(ctx context.Context, owner, repo string, number int) error {
+	return p.call("DeleteMilestone", interactionArgs{"owner": owner, "repo": repo, "number": number}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteMilestone(ctx, owner, repo, number)
+	})
+}
+
+// CreateComment implements github.Provider.
+func (p *RecordingProvider) CreateComment(ctx context.Context, owner, repo string, number int, body string) (*gh.CommentData, error) {
+	var result *gh.CommentData
+	err := p.call("CreateComment", interactionArgs{"owner": owner, "repo": repo, "number": number, "body": body}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateComment(ctx, owner, repo, number, body)
+	})
+	return result, err
+}
+
+// ListComments implements github.Provider.
+func (p *RecordingProvider) ListComments(ctx context.Context, owner, repo string, number int, opts gh.ListOptions) ([]*gh.CommentData, error) {
+	var result []*gh.CommentData
+	err := p.call("ListComments", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListComments(ctx, owner, repo, number, opts)
+	})
+	return result, err
+}
+
+// UpdateComment implements github.Provider.
+func (p *RecordingProvider) UpdateComment(ctx context.Context, owner, repo string, commentID int64, body string) (*gh.CommentData, error) {
+	var result *gh.CommentData
+	err := p.call("UpdateComment", interactionArgs{"owner": owner, "repo": repo, "commentID": commentID, "body": body}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.UpdateComment(ctx, owner, repo, commentID, body)
+	})
+	return result, err
+}
+
+// DeleteComment implements github.Provider.
+func (p *RecordingProvider) DeleteComment(ctx context.Context, owner, repo string, commentID int64) error {
+	return p.call("DeleteComment", interactionArgs{"owner": owner, "repo": repo, "commentID": commentID}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteComment(ctx, owner, repo, commentID)
+	})
+}
+
+// AddIssueReaction implements github.Provider.
+func (p *RecordingProvider) AddIssueReaction(ctx context.Context, owner, repo string, number int, content string) error {
+	return p.call("AddIssueReaction", interactionArgs{"owner": owner, "repo": repo, "number": number, "content": content}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.AddIssueReaction(ctx, owner, repo, number, content)
+	})
+}
+
+// AddCommentReaction implements github.Provider.
+func (p *RecordingProvider) AddCommentReaction(ctx context.Context, owner, repo string, commentID int64, content string) error {
+	return p.call("AddCommentReaction", interactionArgs{"owner": owner, "repo": repo, "commentID": commentID, "content": content}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.AddCommentReaction(ctx, owner, repo, commentID, content)
+	})
+}
+
+// GetPullRequest implements github.Provider.
+func (p *RecordingProvider) GetPullRequest(ctx context.Context, owner, repo string, number int) (*gh.PullRequestData, error) {
+	var result *gh.PullRequestData
+	err := p.call("GetPullRequest", interactionArgs{"owner": owner, "repo": repo, "number": number}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetPullRequest(ctx, owner, repo, number)
+	})
+	return result, err
+}
+
+// ListPullRequests implements github.Provider.
+func (p *RecordingProvider) ListPullRequests(ctx context.Context, owner, repo string, opts gh.ListPullRequestsOptions) ([]*gh.PullRequestData, error) {
+	var result []*gh.PullRequestData
+	err := p.call("ListPullRequests", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListPullRequests(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// CreatePullRequest implements github.Provider.
+func (p *RecordingProvider) CreatePullRequest(ctx context.Context, owner, repo string, opts gh.CreatePullRequestOptions) (*gh.PullRequestData, error) {
+	var result *gh.PullRequestData
+	err := p.call("CreatePullRequest", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreatePullRequest(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// UpdatePullRequest implements github.Provider.
+func (p *RecordingProvider) UpdatePullRequest(ctx context.Context, owner, repo string, number int, opts gh.UpdatePullRequestOptions) (*gh.PullRequestData, error) {
+	var result *gh.PullRequestData
+	err := p.call("UpdatePullRequest", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.UpdatePullRequest(ctx, owner, repo, number, opts)
+	})
+	return result, err
+}
+
+// MergePullRequest implements github.Provider.
+func (p *RecordingProvider) MergePullRequest(ctx context.Context, owner, repo string, number int, opts gh.MergePullRequestOptions) error {
+	return p.call("MergePullRequest", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.MergePullRequest(ctx, owner, repo, number, opts)
+	})
+}
+
+// RequestReviewers implements github.Provider.
+func (p *RecordingProvider) RequestReviewers(ctx context.Context, owner, repo string, number int, opts gh.RequestReviewersOptions) error {
+	return p.call("RequestReviewers", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.RequestReviewers(ctx, owner, repo, number, opts)
+	})
+}
+
+// SubmitReview implements github.Provider.
+func (p *RecordingProvider) SubmitReview(ctx context.Context, owner, repo string, number int, opts gh.SubmitReviewOptions) (*gh.ReviewData, error) {
+	var result *gh.ReviewData
+	err := p.call("SubmitReview", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.SubmitReview(ctx, owner, repo, number, opts)
+	})
+	return result, err
+}
+
+// ListReviews implements github.Provider.
+func (p *RecordingProvider) ListReviews(ctx context.Context, owner, repo string, number int, opts gh.ListOptions) ([]*gh.ReviewData, error) {
+	var result []*gh.ReviewData
+	err := p.call("ListReviews", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListReviews(ctx, owner, repo, number, opts)
+	})
+	return result, err
+}
+
+// CreateReviewComment implements github.Provider.
+func (p *RecordingProvider) CreateReviewComment(ctx context.Context, owner, repo string, number int, opts gh.CreateReviewCommentOptions) (*gh.ReviewCommentData, error) {
+	var result *gh.ReviewCommentData
+	err := p.call("CreateReviewComment", interactionArgs{"owner": owner, "repo": repo, "number": number, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateReviewComment(ctx, owner, repo, number, opts)
+	})
+	return result, err
+}
+
+// GetWorkflowRun implements github.Provider.
+func (p *RecordingProvider) GetWorkflowRun(ctx context.Context, owner, repo string, runID int64) (*gh.WorkflowRunData, error) {
+	var result *gh.WorkflowRunData
+	err := p.call("GetWorkflowRun", interactionArgs{"owner": owner, "repo": repo, "runID": runID}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetWorkflowRun(ctx, owner, repo, runID)
+	})
+	return result, err
+}
+
+// ListWorkflowRuns implements github.Provider.
+func (p *RecordingProvider) ListWorkflowRuns(ctx context.Context, owner, repo string, opts gh.ListWorkflowRunsOptions) ([]*gh.WorkflowRunData, error) {
+	var result []*gh.WorkflowRunData
+	err := p.call("ListWorkflowRuns", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListWorkflowRuns(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// GetWorkflowRunJobs implements github.Provider.
+func (p *RecordingProvider) GetWorkflowRunJobs(ctx context.Context, owner, repo string, runID int64) ([]*gh.WorkflowJobData, error) {
+	var result []*gh.WorkflowJobData
+	err := p.call("GetWorkflowRunJobs", interactionArgs{"owner": owner, "repo": repo, "runID": runID}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetWorkflowRunJobs(ctx, owner, repo, runID)
+	})
+	return result, err
+}
+
+// GetWorkflowRunLogs implements github.Provider.
+// The stream is read fully and recorded, so the returned reader is buffered.
+func (p *RecordingProvider) GetWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64) (io.ReadCloser, error) {
+	var data []byte
+	err := p.call("GetWorkflowRunLogs", interactionArgs{"owner": owner, "repo": repo, "runID": runID}, &data, func(provider gh.Provider) (interface{}, error) {
+		return readAll(provider.GetWorkflowRunLogs(ctx, owner, repo, runID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// GetWorkflowJobLogs implements github.Provider.
+// The stream is read fully and recorded, so the returned reader is buffered.
+func (p *RecordingProvider) GetWorkflowJobLogs(ctx context.Context, owner, repo string, jobID int64) (io.ReadCloser, error) {
+	var data []byte
+	err := p.call("GetWorkflowJobLogs", interactionArgs{"owner": owner, "repo": repo, "jobID": jobID}, &data, func(provider gh.Provider) (interface{}, error) {
+		return readAll(provider.GetWorkflowJobLogs(ctx, owner, repo, jobID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// ListArtifacts implements github.Provider.
+func (p *RecordingProvider) ListArtifacts(ctx context.Context, owner, repo string, runID int64, opts gh.ListOptions) ([]*gh.ArtifactData, error) {
+	var result []*gh.ArtifactData
+	err := p.call("ListArtifacts", interactionArgs{"owner": owner, "repo": repo, "runID": runID, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListArtifacts(ctx, owner, repo, runID, opts)
+	})
+	return result, err
+}
+
+// DownloadArtifact implements github.Provider.
+// The stream is read fully and recorded, so the returned reader is buffered.
+func (p *RecordingProvider) DownloadArtifact(ctx context.Context, owner, repo string, artifactID int64) (io.ReadCloser, error) {
+	var data []byte
+	err := p.call("DownloadArtifact", interactionArgs{"owner": owner, "repo": repo, "artifactID": artifactID}, &data, func(provider gh.Provider) (interface{}, error) {
+		return readAll(provider.DownloadArtifact(ctx, owner, repo, artifactID))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// TriggerWorkflow implements github.Provider.
+func (p *RecordingProvider) TriggerWorkflow(ctx context.Context, owner, repo, workflowFileName string, ref string, inputs map[string]interface{}) error {
+	return p.call("TriggerWorkflow", interactionArgs{"owner": owner, "repo": repo, "workflowFileName": workflowFileName, "ref": ref, "inputs": inputs}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.TriggerWorkflow(ctx, owner, repo, workflowFileName, ref, inputs)
+	})
+}
+
+// ListEnvironments implements github.Provider.
+func (p *RecordingProvider) ListEnvironments(ctx context.Context, owner, repo string, opts gh.ListOptions) ([]*gh.EnvironmentData, error) {
+	var result []*gh.EnvironmentData
+	err := p.call("ListEnvironments", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListEnvironments(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// GetEnvironment implements github.Provider.
+func (p *RecordingProvider) GetEnvironment(ctx context.Context, owner, repo, name string) (*gh.EnvironmentData, error) {
+	var result *gh.EnvironmentData
+	err := p.call("GetEnvironment", interactionArgs{"owner": owner, "repo": repo, "name": name}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetEnvironment(ctx, owner, repo, name)
+	})
+	return result, err
+}
+
+// CreateOrUpdateEnvironment implements github.Provider.
+func (p *RecordingProvider) CreateOrUpdateEnvironment(ctx context.Context, owner, repo, name string, opts gh.EnvironmentOptions) (*gh.EnvironmentData, error) {
+	var result *gh.EnvironmentData
+	err := p.call("CreateOrUpdateEnvironment", interactionArgs{"owner": owner, "repo": repo, "name": name, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateOrUpdateEnvironment(ctx, owner, repo, name, opts)
+	})
+	return result, err
+}
+
+// DeleteEnvironment implements github.Provider.
+func (p *RecordingProvider) DeleteEnvironment(ctx context.Context, owner, repo, name string) error {
+	return p.call("DeleteEnvironment", interactionArgs{"owner": owner, "repo": repo, "name": name}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteEnvironment(ctx, owner, repo, name)
+	})
+}
+
+// ListDeploymentBranchPolicies implements github.Provider.
+func (p *RecordingProvider) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string) ([]*gh.DeploymentBranchPolicyData, error) {
+	var result []*gh.DeploymentBranchPolicyData
+	err := p.call("ListDeploymentBranchPolicies", interactionArgs{"owner": owner, "repo": repo, "environment": environment}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListDeploymentBranchPolicies(ctx, owner, repo, environment)
+	})
+	return result, err
+}
+
+// CreateDeploymentBranchPolicy implements github.Provider.
+func (p *RecordingProvider) CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, policy gh.DeploymentBranchPolicyData) (*gh.DeploymentBranchPolicyData, error) {
+	var result *gh.DeploymentBranchPolicyData
+	err := p.call("CreateDeploymentBranchPolicy", interactionArgs{"owner": owner, "repo": repo, "environment": environment, "policy": policy}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateDeploymentBranchPolicy(ctx, owner, repo, environment, policy)
+	})
+	return result, err
+}
+
+// DeleteDeploymentBranchPolicy implements github.Provider.
+func (p *RecordingProvider) DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, policyID int64) error {
+	return p.call("DeleteDeploymentBranchPolicy", interactionArgs{"owner": owner, "repo": repo, "environment": environment, "policyID": policyID}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteDeploymentBranchPolicy(ctx, owner, repo, environment, policyID)
+	})
+}
+
+// ListDependabotAlerts implements github.Provider.
+func (p *RecordingProvider) ListDependabotAlerts(ctx context.Context, owner, repo string, opts gh.ListAlertsOptions) ([]*gh.DependabotAlertData, error) {
+	var result []*gh.DependabotAlertData
+	err := p.call("ListDependabotAlerts", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListDependabotAlerts(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// ListCodeScanningAlerts implements github.Provider.
+func (p *RecordingProvider) ListCodeScanningAlerts(ctx context.Context, owner, repo string, opts gh.ListAlertsOptions) ([]*gh.CodeScanningAlertData, error) {
+	var result []*gh.CodeScanningAlertData
+	err := p.call("ListCodeScanningAlerts", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListCodeScanningAlerts(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// ListSecretScanningAlerts implements github.Provider.
+func (p *RecordingProvider) ListSecretScanningAlerts(ctx context.Context, owner, repo string, opts gh.ListAlertsOptions) ([]*gh.SecretScanningAlertData, error) {
+	var result []*gh.SecretScanningAlertData
+	err := p.call("ListSecretScanningAlerts", interactionArgs{"owner": owner, "repo": repo, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListSecretScanningAlerts(ctx, owner, repo, opts)
+	})
+	return result, err
+}
+
+// SetSecret implements github.Provider.
+func (p *RecordingProvider) SetSecret(ctx context.Context, scope gh.SecretScope, opts gh.SetSecretOptions) error {
+	redacted := opts
+	redacted.Value = redactedValue
+	return p.call("SetSecret", interactionArgs{"scope": scope, "opts": redacted}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.SetSecret(ctx, scope, opts)
+	})
+}
+
+// ListSecrets implements github.Provider.
+func (p *RecordingProvider) ListSecrets(ctx context.Context, scope gh.SecretScope, opts gh.ListOptions) ([]*gh.SecretData, error) {
+	var result []*gh.SecretData
+	err := p.call("ListSecrets", interactionArgs{"scope": scope, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListSecrets(ctx, scope, opts)
+	})
+	return result, err
+}
+
+// DeleteSecret implements github.Provider.
+func (p *RecordingProvider) DeleteSecret(ctx context.Context, scope gh.SecretScope, name string) error {
+	return p.call("DeleteSecret", interactionArgs{"scope": scope, "name": name}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteSecret(ctx, scope, name)
+	})
+}
+
+// SetVariable implements github.Provider.
+func (p *RecordingProvider) SetVariable(ctx context.Context, scope gh.SecretScope, opts gh.SetVariableOptions) error {
+	return p.call("SetVariable", interactionArgs{"scope": scope, "opts": opts}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.SetVariable(ctx, scope, opts)
+	})
+}
+
+// ListVariables implements github.Provider.
+func (p *RecordingProvider) ListVariables(ctx context.Context, scope gh.SecretScope, opts gh.ListOptions) ([]*gh.VariableData, error) {
+	var result []*gh.VariableData
+	err := p.call("ListVariables", interactionArgs{"scope": scope, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListVariables(ctx, scope, opts)
+	})
+	return result, err
+}
+
+// DeleteVariable implements github.Provider.
+func (p *RecordingProvider) DeleteVariable(ctx context.Context, scope gh.SecretScope, name string) error {
+	return p.call("DeleteVariable", interactionArgs{"scope": scope, "name": name}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteVariable(ctx, scope, name)
+	})
+}
+
+// ListNotifications implements github.Provider.
+func (p *RecordingProvider) ListNotifications(ctx context.Context, opts gh.ListNotificationsOptions) ([]*gh.NotificationData, error) {
+	var result []*gh.NotificationData
+	err := p.call("ListNotifications", interactionArgs{"opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListNotifications(ctx, opts)
+	})
+	return result, err
+}
+
+// MarkNotificationsRead implements github.Provider.
+func (p *RecordingProvider) MarkNotificationsRead(ctx context.Context, lastReadAt time.Time) error {
+	return p.call("MarkNotificationsRead", interactionArgs{"lastReadAt": lastReadAt}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.MarkNotificationsRead(ctx, lastReadAt)
+	})
+}
+
+// MarkThreadRead implements github.Provider.
+func (p *RecordingProvider) MarkThreadRead(ctx context.Context, threadID string) error {
+	return p.call("MarkThreadRead", interactionArgs{"threadID": threadID}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.MarkThreadRead(ctx, threadID)
+	})
+}
+
+// SetThreadSubscription implements github.Provider.
+func (p *RecordingProvider) SetThreadSubscription(ctx context.Context, threadID string, ignored bool) (*gh.ThreadSubscriptionData, error) {
+	var result *gh.ThreadSubscriptionData
+	err := p.call("SetThreadSubscription", interactionArgs{"threadID": threadID, "ignored": ignored}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.SetThreadSubscription(ctx, threadID, ignored)
+	})
+	return result, err
+}
+
+// DeleteThreadSubscription implements github.Provider.
+func (p *RecordingProvider) DeleteThreadSubscription(ctx context.Context, threadID string) error {
+	return p.call("DeleteThreadSubscription", interactionArgs{"threadID": threadID}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteThreadSubscription(ctx, threadID)
+	})
+}
+
+// ListGists implements github.Provider.
+func (p *RecordingProvider) ListGists(ctx context.Context, opts gh.ListOptions) ([]*gh.GistData, error) {
+	var result []*gh.GistData
+	err := p.call("ListGists", interactionArgs{"opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.ListGists(ctx, opts)
+	})
+	return result, err
+}
+
+// GetGist implements github.Provider.
+func (p *RecordingProvider) GetGist(ctx context.Context, id string) (*gh.GistData, error) {
+	var result *gh.GistData
+	err := p.call("GetGist", interactionArgs{"id": id}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.GetGist(ctx, id)
+	})
+	return result, err
+}
+
+// CreateGist implements github.Provider.
+func (p *RecordingProvider) CreateGist(ctx context.Context, opts gh.CreateGistOptions) (*gh.GistData, error) {
+	var result *gh.GistData
+	err := p.call("CreateGist", interactionArgs{"opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.CreateGist(ctx, opts)
+	})
+	return result, err
+}
+
+// UpdateGist implements github.Provider.
+func (p *RecordingProvider) UpdateGist(ctx context.Context, id string, opts gh.UpdateGistOptions) (*gh.GistData, error) {
+	var result *gh.GistData
+	err := p.call("UpdateGist", interactionArgs{"id": id, "opts": opts}, &result, func(provider gh.Provider) (interface{}, error) {
+		return provider.UpdateGist(ctx, id, opts)
+	})
+	return result, err
+}
+
+// DeleteGist implements github.Provider.
+func (p *RecordingProvider) DeleteGist(ctx context.Context, id string) error {
+	return p.call("DeleteGist", interactionArgs{"id": id}, nil, func(provider gh.Provider) (interface{}, error) {
+		return nil, provider.DeleteGist(ctx, id)
+	})
+}
+
+// DownloadGistFile implements github.Provider.
+// The stream is read fully and recorded, so the returned reader is buffered.
+func (p *RecordingProvider) DownloadGistFile(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	var data []byte
+	err := p.call("DownloadGistFile", interactionArgs{"rawURL": rawURL}, &data, func(provider gh.Provider) (interface{}, error) {
+		return readAll(provider.DownloadGistFile(ctx, rawURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// QueryGraphQL implements github.Provider.
+// The decoded result is recorded and decoded into result on replay.
+func (p *RecordingProvider) QueryGraphQL(ctx context.Context, query string, vars map[string]interface{}, result interface{}) error {
+	return p.call("QueryGraphQL", interactionArgs{"query": query, "vars": vars}, result, func(provider gh.Provider) (interface{}, error) {
+		return result, provider.QueryGraphQL(ctx, query, vars, result)
+	})
+}
@@ -0,0 +1,112 @@
+package testutil
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jmgilman/go/errors"
+	"github.com/jmgilman/go/github"
+	"github.com/jmgilman/go/github/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingProvider(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "testdata", "flow.json")
+
+	live := &mocks.ProviderMock{
+		GetRepositoryFunc: func(_ context.Context, owner, repo string) (*github.RepositoryData, error) {
+			return &github.RepositoryData{
+				ID:            123,
+				Owner:         owner,
+				Name:          repo,
+				FullName:      owner + "/" + repo,
+				DefaultBranch: "main",
+			}, nil
+		},
+		GetIssueFunc: func(_ context.Context, _, _ string, number int) (*github.IssueData, error) {
+			if number == 404 {
+				return nil, errors.New(errors.CodeNotFound, "issue not found")
+			}
+			return &github.IssueData{Number: number, Title: "Bug"}, nil
+		},
+		CloseIssueFunc: func(_ context.Context, _, _ string, _ int) error {
+			return nil
+		},
+		GetWorkflowRunLogsFunc: func(_ context.Context, _, _ string, _ int64) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("build ok\n")), nil
+		},
+		SetSecretFunc: func(_ context.Context, _ github.SecretScope, _ github.SetSecretOptions) error {
+			return nil
+		},
+	}
+
+	// Record a flow against the live provider
+	recorder := NewRecordingProvider(live)
+	flow := func(t *testing.T, provider github.Provider) {
+		client := github.NewClient(provider, "testowner")
+		repo := client.Repository("testrepo")
+		require.NoError(t, repo.Get(ctx))
+		assert.Equal(t, "main", repo.DefaultBranch())
+
+		issue, err := provider.GetIssue(ctx, "testowner", "testrepo", 42)
+		require.NoError(t, err)
+		assert.Equal(t, "Bug", issue.Title)
+
+		_, err = provider.GetIssue(ctx, "testowner", "testrepo", 404)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+
+		require.NoError(t, provider.CloseIssue(ctx, "testowner", "testrepo", 42))
+
+		logs, err := provider.GetWorkflowRunLogs(ctx, "testowner", "testrepo", 7)
+		require.NoError(t, err)
+		data, err := io.ReadAll(logs)
+		require.NoError(t, err)
+		assert.Equal(t, "build ok\n", string(data))
+
+		scope := github.SecretScope{Owner: "testowner", Repo: "testrepo"}
+		require.NoError(t, provider.SetSecret(ctx, scope, github.SetSecretOptions{Name: "TOKEN", Value: "s3cr3t"}))
+	}
+	flow(t, recorder)
+	require.NoError(t, recorder.Save(path))
+
+	t.Run("records interactions", func(t *testing.T) {
+		interactions := recorder.Interactions()
+		require.Len(t, interactions, 6)
+		assert.Equal(t, "GetRepository", interactions[0].Method)
+		assert.JSONEq(t, `{"owner": "testowner", "repo": "testrepo"}`, string(interactions[0].Request))
+		require.NotNil(t, interactions[2].Error)
+		assert.Equal(t, errors.CodeNotFound, interactions[2].Error.Code)
+		assert.NotContains(t, string(interactions[5].Request), "s3cr3t")
+	})
+
+	t.Run("replays offline", func(t *testing.T) {
+		replay, err := NewReplayProvider(path)
+		require.NoError(t, err)
+
+		flow(t, replay)
+		assert.Empty(t, replay.Unused())
+	})
+
+	t.Run("unmatched call", func(t *testing.T) {
+		replay, err := NewReplayProvider(path)
+		require.NoError(t, err)
+
+		_, err = replay.GetIssue(ctx, "testowner", "testrepo", 1)
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeNotImplemented, errors.GetCode(err))
+		assert.Len(t, replay.Unused(), 6)
+	})
+
+	t.Run("missing fixture", func(t *testing.T) {
+		_, err := NewReplayProvider(filepath.Join(t.TempDir(), "missing.json"))
+
+		require.Error(t, err)
+		assert.Equal(t, errors.CodeNotFound, errors.GetCode(err))
+	})
+}
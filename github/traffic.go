@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmgilman/go/errors"
+)
+
+// contributorStatsAttempts and contributorStatsDelay bound how long
+// ListContributorsStats waits for GitHub to compute statistics.
+const (
+	contributorStatsAttempts = 5
+	contributorStatsDelay    = 2 * time.Second
+)
+
+// TrafficViews retrieves the page views of the repository over the last 14
+// days, aggregated per "day" or "week". An empty period aggregates per day.
+//
+// Requires push access to the repository.
+//
+// Example:
+//
+//	views, err := repo.TrafficViews(ctx, "week")
+//	fmt.Printf("%d views from %d unique visitors\n", views.Count, views.Uniques)
+func (r *Repository) TrafficViews(ctx context.Context, per string) (*TrafficData, error) {
+	if err := validateTrafficPeriod(per); err != nil {
+		return nil, err
+	}
+
+	views, err := r.client.provider.GetTrafficViews(ctx, r.owner, r.name, TrafficOptions{Per: per})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to get traffic views")
+	}
+	return views, nil
+}
+
+// TrafficClones retrieves the clones of the repository over the last 14
+// days, aggregated per "day" or "week". An empty period aggregates per day.
+//
+// Requires push access to the repository.
+func (r *Repository) TrafficClones(ctx context.Context, per string) (*TrafficData, error) {
+	if err := validateTrafficPeriod(per); err != nil {
+		return nil, err
+	}
+
+	clones, err := r.client.provider.GetTrafficClones(ctx, r.owner, r.name, TrafficOptions{Per: per})
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to get traffic clones")
+	}
+	return clones, nil
+}
+
+// TopReferrers lists the top 10 sites referring traffic to the repository
+// over the last 14 days.
+func (r *Repository) TopReferrers(ctx context.Context) ([]*ReferrerData, error) {
+	referrers, err := r.client.provider.ListTopReferrers(ctx, r.owner, r.name)
+	if err != nil {
+		return nil, WrapHTTPError(err, 0, "failed to list top referrers")
+	}
+	return referrers, nil
+}
+
+// ListContributorsStats lists the commit activity of every contributor to
+// the repository.
+//
+// GitHub computes these statistics in the background and responds with no
+// data until they are ready, so the request is retried a few times before
+// giving up with an error with code errors.CodeUnavailable.
+func (r *Repository) ListContributorsStats(ctx context.Context) ([]*ContributorStatsData, error) {
+	for attempt := 1; ; attempt++ {
+		stats, err := r.client.provider.ListContributorStats(ctx, r.owner, r.name)
+		if err == nil {
+			return stats, nil
+		}
+		if errors.GetCode(err) != errors.CodeUnavailable {
+			return nil, WrapHTTPError(err, 0, "failed to list contributor statistics")
+		}
+		if attempt == contributorStatsAttempts {
+			return nil, errors.Wrap(err, errors.CodeUnavailable, "contributor statistics are not ready")
+		}
+
+		timer := time.NewTimer(contributorStatsDelay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, errors.Wrap(ctx.Err(), errors.CodeTimeout, "contributor statistics wait cancelled or timed out")
+		case <-timer.C:
+		}
+	}
+}
+
+// validateTrafficPeriod checks that per is a supported aggregation period.
+func validateTrafficPeriod(per string) error {
+	switch per {
+	case "", "day", "week":
+		return nil
+	default:
+		return errors.Newf(errors.CodeInvalidInput, "invalid traffic period %q (must be \"day\" or \"week\")", per)
+	}
+}
@@ -84,6 +84,502 @@ type PullRequestData struct {
 	ClosedAt  *time.Time `json:"closed_at,omitempty"`
 }
 
+// ReviewData contains pull request review information.
+type ReviewData struct {
+	// Identification
+	ID int64 `json:"id"`
+
+	// Content
+	Body  string `json:"body"`
+	State string `json:"state"`
+
+	// Metadata
+	Author   string `json:"author"`
+	CommitID string `json:"commit_id"`
+
+	// URL
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+}
+
+// ReviewCommentData contains a pull request review comment anchored to a file line.
+type ReviewCommentData struct {
+	// Identification
+	ID int64 `json:"id"`
+
+	// Content
+	Body string `json:"body"`
+
+	// Location
+	Path      string `json:"path"`
+	Line      int    `json:"line"`
+	StartLine int    `json:"start_line,omitempty"`
+	Side      string `json:"side"`
+	CommitID  string `json:"commit_id"`
+
+	// Metadata
+	Author string `json:"author"`
+
+	// URL
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CheckRunData contains check run information for a commit.
+type CheckRunData struct {
+	// Identification
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+
+	// Status and conclusion
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion,omitempty"`
+
+	// URL
+	DetailsURL string `json:"details_url"`
+
+	// Timestamps
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// CommitStatusData contains a commit status reported by an external service.
+type CommitStatusData struct {
+	// Identification
+	ID      int64  `json:"id"`
+	Context string `json:"context"`
+
+	// State is one of "success", "pending", "failure", or "error"
+	State       string `json:"state"`
+	Description string `json:"description,omitempty"`
+
+	// URL
+	TargetURL string `json:"target_url,omitempty"`
+
+	// Timestamps
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CombinedStatusData contains the combined commit status of a ref.
+type CombinedStatusData struct {
+	// State is "success", "pending", or "failure". A ref without any
+	// statuses is reported as "pending".
+	State string `json:"state"`
+	SHA   string `json:"sha"`
+
+	// Statuses holds the latest status for each context
+	Statuses []*CommitStatusData `json:"statuses"`
+}
+
+// PullRequestStatusData contains a pull request together with its reviews and
+// the check runs of its head commit.
+type PullRequestStatusData struct {
+	PullRequest *PullRequestData `json:"pull_request"`
+	Reviews     []*ReviewData    `json:"reviews"`
+	Checks      []*CheckRunData  `json:"checks"`
+}
+
+// MergeQueueEntryData contains the state of a pull request in a merge queue.
+type MergeQueueEntryData struct {
+	// Identification
+	ID string `json:"id"`
+
+	// Position is the zero-based position of the entry in the queue
+	Position int `json:"position"`
+
+	// State is one of "QUEUED", "AWAITING_CHECKS", "MERGEABLE", "UNMERGEABLE", or "LOCKED"
+	State string `json:"state"`
+
+	// Timestamps
+	EnqueuedAt time.Time `json:"enqueuedAt"`
+}
+
+// CommentData contains comment information for an issue or pull request.
+type CommentData struct {
+	// Identification
+	ID int64 `json:"id"`
+
+	// Content
+	Body string `json:"body"`
+
+	// Metadata
+	Author string `json:"author"`
+
+	// URL
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CommitData contains commit information.
+type CommitData struct {
+	// Identification
+	SHA string `json:"sha"`
+
+	// Content
+	Message string `json:"message"`
+
+	// Authorship
+	Author      string `json:"author"`
+	AuthorName  string `json:"author_name"`
+	AuthorEmail string `json:"author_email"`
+	Committer   string `json:"committer"`
+
+	// Parents are the SHAs of the parent commits
+	Parents []string `json:"parents"`
+
+	// URL
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	AuthoredAt  time.Time `json:"authored_at"`
+	CommittedAt time.Time `json:"committed_at"`
+}
+
+// CommitFileData contains the change statistics for a file in a commit
+// comparison.
+type CommitFileData struct {
+	// Identification
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+
+	// Status is the change type ("added", "removed", "modified", "renamed", ...)
+	Status string `json:"status"`
+
+	// Statistics
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+	Changes   int `json:"changes"`
+}
+
+// ComparisonData contains the result of comparing two commits.
+type ComparisonData struct {
+	// Status describes how head relates to base
+	// ("ahead", "behind", "identical", "diverged")
+	Status string `json:"status"`
+
+	// Counts
+	AheadBy      int `json:"ahead_by"`
+	BehindBy     int `json:"behind_by"`
+	TotalCommits int `json:"total_commits"`
+
+	// MergeBaseSHA is the SHA of the best common ancestor
+	MergeBaseSHA string `json:"merge_base_sha"`
+
+	// Commits reachable from head but not base, oldest first
+	Commits []*CommitData `json:"commits"`
+
+	// Files changed between base and head
+	Files []*CommitFileData `json:"files"`
+
+	// URL
+	HTMLURL string `json:"html_url"`
+}
+
+// TagData contains a repository tag as listed by the tags API.
+type TagData struct {
+	Name      string `json:"name"`
+	CommitSHA string `json:"commit_sha"`
+
+	// URLs
+	ZipballURL string `json:"zipball_url"`
+	TarballURL string `json:"tarball_url"`
+}
+
+// TagObjectData contains an annotated tag object.
+type TagObjectData struct {
+	// Identification
+	SHA string `json:"sha"`
+	Tag string `json:"tag"`
+
+	// Content
+	Message string `json:"message"`
+
+	// Tagged object
+	ObjectSHA  string `json:"object_sha"`
+	ObjectType string `json:"object_type"`
+
+	// Tagger
+	TaggerName  string    `json:"tagger_name"`
+	TaggerEmail string    `json:"tagger_email"`
+	TaggedAt    time.Time `json:"tagged_at"`
+}
+
+// RefData contains a Git reference.
+type RefData struct {
+	// Ref is the fully qualified name (e.g. "refs/heads/main")
+	Ref string `json:"ref"`
+
+	// Target object
+	SHA  string `json:"sha"`
+	Type string `json:"type"`
+}
+
+// TrafficData contains repository views or clones over the last 14 days.
+type TrafficData struct {
+	// Totals
+	Count   int `json:"count"`
+	Uniques int `json:"uniques"`
+
+	// Points contains one entry per day or week
+	Points []*TrafficPointData `json:"points"`
+}
+
+// TrafficPointData contains the traffic of a single day or week.
+type TrafficPointData struct {
+	Timestamp time.Time `json:"timestamp"`
+	Count     int       `json:"count"`
+	Uniques   int       `json:"uniques"`
+}
+
+// ReferrerData contains a site referring traffic to a repository.
+type ReferrerData struct {
+	Referrer string `json:"referrer"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+// ContributorStatsData contains the commit activity of a contributor.
+type ContributorStatsData struct {
+	// Author is the contributor's login
+	Author string `json:"author"`
+
+	// Total is the total number of commits
+	Total int `json:"total"`
+
+	// Weeks contains the weekly activity, oldest first
+	Weeks []*ContributorWeekData `json:"weeks"`
+}
+
+// ContributorWeekData contains a contributor's activity during one week.
+type ContributorWeekData struct {
+	Week      time.Time `json:"week"`
+	Additions int       `json:"additions"`
+	Deletions int       `json:"deletions"`
+	Commits   int       `json:"commits"`
+}
+
+// EnvironmentData contains deployment environment information.
+type EnvironmentData struct {
+	// Identification
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+
+	// Protection rules
+	WaitTimer         int                   `json:"wait_timer"`
+	Reviewers         []EnvironmentReviewer `json:"reviewers"`
+	PreventSelfReview bool                  `json:"prevent_self_review"`
+	CanAdminsBypass   bool                  `json:"can_admins_bypass"`
+
+	// DeploymentBranchPolicy restricts which refs can deploy.
+	// nil means all branches can deploy.
+	DeploymentBranchPolicy *DeploymentBranchPolicy `json:"deployment_branch_policy,omitempty"`
+
+	// URL
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// EnvironmentReviewer identifies a user or team that can approve deployments
+// to an environment.
+type EnvironmentReviewer struct {
+	// Type is "User" or "Team"
+	Type string `json:"type"`
+
+	// ID is the user or team ID
+	ID int64 `json:"id"`
+
+	// Name is the user login or team slug (populated in responses only)
+	Name string `json:"name,omitempty"`
+}
+
+// DeploymentBranchPolicy selects which refs can deploy to an environment.
+// Exactly one of the fields should be true.
+type DeploymentBranchPolicy struct {
+	// ProtectedBranches allows only branches with branch protection rules
+	ProtectedBranches bool `json:"protected_branches"`
+
+	// CustomBranchPolicies allows only refs matching the environment's
+	// deployment branch policies
+	CustomBranchPolicies bool `json:"custom_branch_policies"`
+}
+
+// DeploymentBranchPolicyData contains a custom deployment branch policy.
+type DeploymentBranchPolicyData struct {
+	// Identification
+	ID int64 `json:"id"`
+
+	// Name is the fnmatch pattern refs must match (e.g., "release/*")
+	Name string `json:"name"`
+
+	// Type is "branch" or "tag" (default "branch")
+	Type string `json:"type"`
+}
+
+// LabelData contains repository label information.
+type LabelData struct {
+	// Identification
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+
+	// Appearance
+	Color       string `json:"color"`
+	Description string `json:"description"`
+
+	// Metadata
+	Default bool `json:"default"`
+}
+
+// MilestoneData contains repository milestone information.
+type MilestoneData struct {
+	// Identification
+	ID     int64 `json:"id"`
+	Number int   `json:"number"`
+
+	// Content
+	Title       string `json:"title"`
+	Description string `json:"description"`
+
+	// State and progress
+	State        string `json:"state"`
+	OpenIssues   int    `json:"open_issues"`
+	ClosedIssues int    `json:"closed_issues"`
+
+	// URL
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	DueOn     *time.Time `json:"due_on,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+}
+
+// ProjectData contains Projects v2 project information.
+type ProjectData struct {
+	// Identification
+	ID     string `json:"id"`
+	Number int    `json:"number"`
+
+	// Content
+	Title string `json:"title"`
+	URL   string `json:"url"`
+
+	// State
+	Closed bool `json:"closed"`
+
+	// Fields are the project's custom and built-in fields
+	Fields []*ProjectFieldData `json:"fields"`
+}
+
+// ProjectFieldData contains information about a Projects v2 field.
+type ProjectFieldData struct {
+	// Identification
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// DataType is the GraphQL field type (e.g., "TEXT", "NUMBER", "DATE",
+	// "SINGLE_SELECT", "ITERATION")
+	DataType string `json:"data_type"`
+
+	// Options are the choices of a single select field
+	Options []ProjectFieldOptionData `json:"options,omitempty"`
+
+	// Iterations are the active and upcoming iterations of an iteration field
+	Iterations []ProjectIterationData `json:"iterations,omitempty"`
+}
+
+// ProjectFieldOptionData contains a single select field option.
+type ProjectFieldOptionData struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ProjectIterationData contains an iteration of an iteration field.
+type ProjectIterationData struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"start_date"`
+	Duration  int    `json:"duration"`
+}
+
+// NotificationData contains notification thread information.
+type NotificationData struct {
+	// Identification
+	ID string `json:"id"`
+
+	// Repository is the full name of the repository (owner/name)
+	Repository string `json:"repository"`
+
+	// Subject
+	SubjectTitle string `json:"subject_title"`
+	SubjectType  string `json:"subject_type"`
+	SubjectURL   string `json:"subject_url"`
+
+	// State
+	Reason string `json:"reason"`
+	Unread bool   `json:"unread"`
+
+	// Timestamps
+	UpdatedAt  time.Time  `json:"updated_at"`
+	LastReadAt *time.Time `json:"last_read_at,omitempty"`
+}
+
+// ThreadSubscriptionData contains the subscription state of a notification thread.
+type ThreadSubscriptionData struct {
+	Subscribed bool      `json:"subscribed"`
+	Ignored    bool      `json:"ignored"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// GistData contains gist information.
+type GistData struct {
+	// Identification
+	ID string `json:"id"`
+
+	// Content
+	Description string                   `json:"description"`
+	Files       map[string]*GistFileData `json:"files"`
+
+	// Metadata
+	Public bool   `json:"public"`
+	Owner  string `json:"owner"`
+
+	// URLs
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GistFileData contains a file in a gist.
+type GistFileData struct {
+	Filename string `json:"filename"`
+	Language string `json:"language,omitempty"`
+	Size     int    `json:"size"`
+
+	// RawURL serves the full file content
+	RawURL string `json:"raw_url"`
+
+	// Content is only included when a single gist is retrieved. Content
+	// larger than one megabyte is truncated; read RawURL for the full file.
+	Content   string `json:"content,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
 // WorkflowRunData contains workflow run information.
 type WorkflowRunData struct {
 	// Identification
@@ -149,6 +645,169 @@ type WorkflowStepData struct {
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
 }
 
+// ArtifactData contains workflow run artifact information.
+type ArtifactData struct {
+	// Identification
+	ID    int64 `json:"id"`
+	RunID int64 `json:"run_id"`
+
+	// Content
+	Name        string `json:"name"`
+	SizeInBytes int64  `json:"size_in_bytes"`
+
+	// State
+	Expired bool `json:"expired"`
+
+	// URL
+	ArchiveDownloadURL string `json:"archive_download_url"`
+
+	// Timestamps
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// SecretData contains secret metadata.
+// Secret values are write-only and cannot be read back from GitHub.
+type SecretData struct {
+	// Identification
+	Name string `json:"name"`
+
+	// Metadata
+	Visibility string `json:"visibility,omitempty"`
+
+	// Timestamps
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VariableData contains configuration variable information.
+type VariableData struct {
+	// Identification
+	Name string `json:"name"`
+
+	// Content
+	Value string `json:"value"`
+
+	// Metadata
+	Visibility string `json:"visibility,omitempty"`
+
+	// Timestamps
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DependabotAlertData contains a Dependabot alert for a vulnerable dependency.
+type DependabotAlertData struct {
+	// Identification
+	Number int `json:"number"`
+
+	// State is one of "open", "dismissed", "fixed", or "auto_dismissed"
+	State string `json:"state"`
+
+	// Dependency
+	Package      string `json:"package"`
+	Ecosystem    string `json:"ecosystem"`
+	ManifestPath string `json:"manifest_path"`
+
+	// Advisory
+	GHSAID                 string `json:"ghsa_id"`
+	CVEID                  string `json:"cve_id,omitempty"`
+	Summary                string `json:"summary"`
+	Severity               string `json:"severity"`
+	VulnerableVersionRange string `json:"vulnerable_version_range"`
+	PatchedVersion         string `json:"patched_version,omitempty"`
+
+	// Resolution
+	DismissedReason string `json:"dismissed_reason,omitempty"`
+
+	// URLs
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DismissedAt *time.Time `json:"dismissed_at,omitempty"`
+	FixedAt     *time.Time `json:"fixed_at,omitempty"`
+}
+
+// CodeScanningAlertData contains a code scanning alert.
+type CodeScanningAlertData struct {
+	// Identification
+	Number int `json:"number"`
+
+	// State is one of "open", "dismissed", or "fixed"; "closed" is accepted
+	// as a filter for dismissed and fixed alerts
+	State string `json:"state"`
+
+	// Rule
+	RuleID          string `json:"rule_id"`
+	RuleDescription string `json:"rule_description"`
+	Severity        string `json:"severity"`
+
+	// SecuritySeverity is set for security rules ("critical", "high", "medium", "low")
+	SecuritySeverity string `json:"security_severity,omitempty"`
+
+	// Tool is the name of the analysis tool that raised the alert
+	Tool string `json:"tool"`
+
+	// Location of the most recent instance
+	Ref       string `json:"ref"`
+	Path      string `json:"path"`
+	StartLine int    `json:"start_line"`
+
+	// Resolution
+	DismissedReason string `json:"dismissed_reason,omitempty"`
+
+	// URLs
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DismissedAt *time.Time `json:"dismissed_at,omitempty"`
+	FixedAt     *time.Time `json:"fixed_at,omitempty"`
+}
+
+// SecretScanningAlertData contains a secret scanning alert.
+// The leaked secret value is intentionally not included.
+type SecretScanningAlertData struct {
+	// Identification
+	Number int `json:"number"`
+
+	// State is either "open" or "resolved"
+	State string `json:"state"`
+
+	// Secret
+	SecretType            string `json:"secret_type"`
+	SecretTypeDisplayName string `json:"secret_type_display_name"`
+
+	// Resolution
+	Resolution             string `json:"resolution,omitempty"`
+	PushProtectionBypassed bool   `json:"push_protection_bypassed"`
+
+	// URLs
+	HTMLURL string `json:"html_url"`
+
+	// Timestamps
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+// RateLimitData contains the rate limit state reported by GitHub.
+type RateLimitData struct {
+	// Resource is the rate limit bucket (e.g. "core", "search", "graphql").
+	Resource string `json:"resource,omitempty"`
+
+	// Quota
+	Limit     int `json:"limit"`
+	Remaining int `json:"remaining"`
+	Used      int `json:"used"`
+
+	// Reset is when the quota is replenished.
+	Reset time.Time `json:"reset"`
+}
+
 // State constants for issues and pull requests.
 const (
 	// StateOpen indicates an issue or pull request is open.
@@ -209,6 +868,93 @@ const (
 	MergeMethodRebase = "rebase"
 )
 
+// Reactions for issues, pull requests, and comments.
+const (
+	// ReactionPlusOne is the 👍 reaction.
+	ReactionPlusOne = "+1"
+
+	// ReactionMinusOne is the 👎 reaction.
+	ReactionMinusOne = "-1"
+
+	// ReactionLaugh is the 😄 reaction.
+	ReactionLaugh = "laugh"
+
+	// ReactionConfused is the 😕 reaction.
+	ReactionConfused = "confused"
+
+	// ReactionHeart is the ❤️ reaction.
+	ReactionHeart = "heart"
+
+	// ReactionHooray is the 🎉 reaction.
+	ReactionHooray = "hooray"
+
+	// ReactionRocket is the 🚀 reaction.
+	ReactionRocket = "rocket"
+
+	// ReactionEyes is the 👀 reaction.
+	ReactionEyes = "eyes"
+)
+
+// Review events for submitting pull request reviews.
+const (
+	// ReviewEventApprove approves the pull request.
+	ReviewEventApprove = "APPROVE"
+
+	// ReviewEventRequestChanges requests changes to the pull request.
+	ReviewEventRequestChanges = "REQUEST_CHANGES"
+
+	// ReviewEventComment leaves a review without approving or requesting changes.
+	ReviewEventComment = "COMMENT"
+)
+
+// Review states reported for pull request reviews.
+const (
+	// ReviewStateApproved indicates the review approved the pull request.
+	ReviewStateApproved = "APPROVED"
+
+	// ReviewStateChangesRequested indicates the review requested changes.
+	ReviewStateChangesRequested = "CHANGES_REQUESTED"
+
+	// ReviewStateCommented indicates the review only left comments.
+	ReviewStateCommented = "COMMENTED"
+
+	// ReviewStateDismissed indicates the review was dismissed.
+	ReviewStateDismissed = "DISMISSED"
+
+	// ReviewStatePending indicates the review has not been submitted yet.
+	ReviewStatePending = "PENDING"
+)
+
+// Diff sides for review comments.
+const (
+	// DiffSideLeft anchors a comment to the base version of the file.
+	DiffSideLeft = "LEFT"
+
+	// DiffSideRight anchors a comment to the head version of the file.
+	DiffSideRight = "RIGHT"
+)
+
+// Secret stores.
+const (
+	// SecretAppActions stores secrets for GitHub Actions.
+	SecretAppActions = "actions"
+
+	// SecretAppDependabot stores secrets for Dependabot.
+	SecretAppDependabot = "dependabot"
+)
+
+// Visibility of organization secrets and variables.
+const (
+	// VisibilityAll grants access to all repositories in the organization.
+	VisibilityAll = "all"
+
+	// VisibilityPrivate grants access to private and internal repositories.
+	VisibilityPrivate = "private"
+
+	// VisibilitySelected grants access to an explicit list of repositories.
+	VisibilitySelected = "selected"
+)
+
 // ListOptions contains options for list operations.
 type ListOptions struct {
 	// Page is the page number for pagination (1-indexed)
@@ -233,6 +979,90 @@ type CreateRepositoryOptions struct {
 	AutoInit bool
 }
 
+// CreateRepositoryFromTemplateOptions contains options for creating a repository from a template.
+type CreateRepositoryFromTemplateOptions struct {
+	// Owner is the organization or user that will own the new repository (required)
+	Owner string
+
+	// Name is the repository name (required)
+	Name string
+
+	// Description is the repository description
+	Description string
+
+	// Private indicates whether the repository should be private
+	Private bool
+
+	// IncludeAllBranches copies all branches from the template instead of only the default branch
+	IncludeAllBranches bool
+}
+
+// ForkRepositoryOptions contains options for forking a repository.
+type ForkRepositoryOptions struct {
+	// Organization is the organization to fork into (defaults to the authenticated user)
+	Organization string
+
+	// Name is the name of the fork (defaults to the source repository name)
+	Name string
+
+	// DefaultBranchOnly indicates whether to fork only the default branch
+	DefaultBranchOnly bool
+}
+
+// TransferRepositoryOptions contains options for transferring a repository.
+type TransferRepositoryOptions struct {
+	// NewOwner is the organization or user receiving the repository (required)
+	NewOwner string
+
+	// NewName renames the repository as part of the transfer
+	NewName string
+
+	// TeamIDs are teams in the new organization that are granted access
+	TeamIDs []int64
+}
+
+// ListCommitsOptions contains options for listing commits.
+type ListCommitsOptions struct {
+	// SHA is the branch, tag, or SHA to start listing from (default branch if empty)
+	SHA string
+
+	// Path filters to commits touching this file or directory
+	Path string
+
+	// Author filters by GitHub login or email address
+	Author string
+
+	// Since filters to commits after this time
+	Since *time.Time
+
+	// Until filters to commits before this time
+	Until *time.Time
+
+	// ListOptions for pagination
+	ListOptions
+}
+
+// CreateTagOptions contains options for creating an annotated tag.
+type CreateTagOptions struct {
+	// Tag is the tag name (e.g. "v1.2.0")
+	Tag string
+
+	// Message is the tag message
+	Message string
+
+	// SHA is the object to tag
+	SHA string
+
+	// Type is the type of the tagged object (default: "commit")
+	Type string
+
+	// Tagger identifies who created the tag. When TaggerName is empty the
+	// authenticated user is used.
+	TaggerName  string
+	TaggerEmail string
+	TaggedAt    *time.Time
+}
+
 // ListIssuesOptions contains options for listing issues.
 type ListIssuesOptions struct {
 	// State filters by issue state ("open", "closed", "all")
@@ -287,6 +1117,151 @@ type UpdateIssueOptions struct {
 	Assignees []string
 }
 
+// CreateLabelOptions contains options for creating a label.
+type CreateLabelOptions struct {
+	// Name is the label name (required)
+	Name string
+
+	// Color is the hexadecimal color code without the leading # (required)
+	Color string
+
+	// Description is a short description of the label
+	Description string
+}
+
+// UpdateLabelOptions contains options for updating a label.
+// Only non-nil fields are updated.
+type UpdateLabelOptions struct {
+	// NewName renames the label
+	NewName *string
+
+	// Color is the new hexadecimal color code without the leading #
+	Color *string
+
+	// Description is the new label description
+	Description *string
+}
+
+// ListMilestonesOptions contains options for listing milestones.
+type ListMilestonesOptions struct {
+	// State filters by milestone state ("open", "closed", "all")
+	State string
+
+	// ListOptions for pagination
+	ListOptions
+}
+
+// CreateMilestoneOptions contains options for creating a milestone.
+type CreateMilestoneOptions struct {
+	// Title is the milestone title (required)
+	Title string
+
+	// Description is the milestone description
+	Description string
+
+	// State is the initial state ("open" or "closed", default "open")
+	State string
+
+	// DueOn is the milestone due date
+	DueOn *time.Time
+}
+
+// UpdateMilestoneOptions contains options for updating a milestone.
+// Only non-nil fields are updated.
+type UpdateMilestoneOptions struct {
+	// Title is the new milestone title
+	Title *string
+
+	// Description is the new milestone description
+	Description *string
+
+	// State is the new state ("open" or "closed")
+	State *string
+
+	// DueOn is the new due date
+	DueOn *time.Time
+}
+
+// EnvironmentOptions contains the protection rules of a deployment environment.
+// Creating or updating an environment replaces all of its protection rules.
+type EnvironmentOptions struct {
+	// WaitTimer is the number of minutes to delay deployments (0-43200)
+	WaitTimer int
+
+	// Reviewers are the users or teams that must approve deployments (up to 6)
+	Reviewers []EnvironmentReviewer
+
+	// PreventSelfReview stops the user who triggered a deployment from approving it
+	PreventSelfReview bool
+
+	// CanAdminsBypass allows administrators to bypass protection rules (default true)
+	CanAdminsBypass *bool
+
+	// DeploymentBranchPolicy restricts which refs can deploy.
+	// nil allows all branches.
+	DeploymentBranchPolicy *DeploymentBranchPolicy
+}
+
+// ListAlertsOptions contains options for listing security alerts.
+type ListAlertsOptions struct {
+	// State filters alerts by state. The accepted values depend on the
+	// alert type; an empty state lists alerts in every state.
+	State string
+}
+
+// ListNotificationsOptions contains options for listing notifications.
+type ListNotificationsOptions struct {
+	// All includes notifications already marked as read
+	All bool
+
+	// Participating limits results to threads the user is directly involved in
+	Participating bool
+
+	// Since filters to notifications updated after this time
+	Since *time.Time
+
+	// Before filters to notifications updated before this time
+	Before *time.Time
+
+	// Owner and Repo limit results to a single repository (both or neither)
+	Owner string
+	Repo  string
+
+	// ListOptions for pagination
+	ListOptions
+}
+
+// CreateGistOptions contains options for creating a gist.
+type CreateGistOptions struct {
+	// Description is the gist description
+	Description string
+
+	// Public makes the gist public; gists are secret by default
+	Public bool
+
+	// Files maps file names to their content (at least one is required)
+	Files map[string]string
+}
+
+// TrafficOptions contains options for retrieving traffic metrics.
+type TrafficOptions struct {
+	// Per is the aggregation period: "day" or "week" (default: "day")
+	Per string
+}
+
+// UpdateGistOptions contains options for updating a gist.
+// Files that are not mentioned are left unchanged.
+type UpdateGistOptions struct {
+	// Description is the new gist description
+	Description *string
+
+	// Files maps file names to their new content; new names add files
+	Files map[string]string
+
+	// DeleteFiles lists the names of files to remove
+	DeleteFiles []string
+}
+
 // ListPullRequestsOptions contains options for listing pull requests.
 type ListPullRequestsOptions struct {
 	// State filters by pull request state ("open", "closed", "all")
@@ -350,6 +1325,70 @@ type MergePullRequestOptions struct {
 	CommitMessage string
 }
 
+// WaitOptions contains options for waiting on pull request checks.
+type WaitOptions struct {
+	// Interval is the time between polls (default: 10 seconds)
+	Interval time.Duration
+
+	// MaxInterval enables exponential backoff: the interval doubles after
+	// each poll up to MaxInterval. Zero polls at a fixed Interval.
+	MaxInterval time.Duration
+
+	// Timeout bounds the total wait; zero waits until the context is done
+	Timeout time.Duration
+
+	// RequiredChecks names the check runs and status contexts that must
+	// pass. Required checks that have not been reported yet are treated as
+	// pending. When empty, every reported check must pass.
+	RequiredChecks []string
+
+	// FailFast returns as soon as a check fails instead of waiting for the
+	// remaining checks to complete
+	FailFast bool
+}
+
+// RequestReviewersOptions contains options for requesting pull request reviewers.
+type RequestReviewersOptions struct {
+	// Reviewers is the list of user logins to request a review from
+	Reviewers []string
+
+	// TeamReviewers is the list of team slugs to request a review from
+	TeamReviewers []string
+}
+
+// SubmitReviewOptions contains options for submitting a pull request review.
+type SubmitReviewOptions struct {
+	// Event is the review action ("APPROVE", "REQUEST_CHANGES", "COMMENT") (required)
+	Event string
+
+	// Body is the review body (required for "REQUEST_CHANGES" and "COMMENT")
+	Body string
+
+	// CommitID is the SHA of the commit being reviewed (defaults to the latest commit)
+	CommitID string
+}
+
+// CreateReviewCommentOptions contains options for creating a pull request review comment.
+type CreateReviewCommentOptions struct {
+	// Body is the comment text (required)
+	Body string
+
+	// Path is the relative path of the file to comment on (required)
+	Path string
+
+	// Line is the line of the diff to comment on (required)
+	Line int
+
+	// StartLine is the first line of a multi-line comment range
+	StartLine int
+
+	// Side is the side of the diff to comment on ("LEFT" or "RIGHT", defaults to "RIGHT")
+	Side string
+
+	// CommitID is the SHA of the commit to comment on (required)
+	CommitID string
+}
+
 // ListWorkflowRunsOptions contains options for listing workflow runs.
 type ListWorkflowRunsOptions struct {
 	// Branch filters by branch name
@@ -364,3 +1403,56 @@ type ListWorkflowRunsOptions struct {
 	// ListOptions for pagination
 	ListOptions
 }
+
+// SecretScope identifies where secrets and variables are stored.
+//
+// A scope with an empty Repo refers to the organization named by Owner.
+// Environment narrows a repository scope to a deployment environment.
+type SecretScope struct {
+	// Owner is the repository owner or organization name (required)
+	Owner string
+
+	// Repo is the repository name (empty for organization scope)
+	Repo string
+
+	// Environment is the deployment environment name (repository scope only)
+	Environment string
+
+	// App is the secret store ("actions" or "dependabot"); defaults to "actions"
+	App string
+}
+
+// SetSecretOptions contains options for creating or updating a secret.
+type SetSecretOptions struct {
+	// Name is the secret name (required)
+	Name string
+
+	// Value is the plaintext secret value (required).
+	// Providers encrypt the value before it leaves the process.
+	Value string
+
+	// Visibility controls which repositories can access an organization secret
+	// ("all", "private", "selected"). Ignored for repository scopes.
+	Visibility string
+
+	// SelectedRepositoryIDs lists the repositories that can access an
+	// organization secret with "selected" visibility.
+	SelectedRepositoryIDs []int64
+}
+
+// SetVariableOptions contains options for creating or updating a variable.
+type SetVariableOptions struct {
+	// Name is the variable name (required)
+	Name string
+
+	// Value is the variable value (required)
+	Value string
+
+	// Visibility controls which repositories can access an organization variable
+	// ("all", "private", "selected"). Ignored for repository scopes.
+	Visibility string
+
+	// SelectedRepositoryIDs lists the repositories that can access an
+	// organization variable with "selected" visibility.
+	SelectedRepositoryIDs []int64
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/jmgilman/go/errors"
@@ -57,6 +58,30 @@ func (wr *WorkflowRun) GetJobs(ctx context.Context) ([]*WorkflowJob, error) {
 	return jobs, nil
 }
 
+// GetLogs writes the combined plain-text logs for all jobs in this workflow
+// run to w.
+//
+// Example:
+//
+//	if run.IsFailed() {
+//	    var buf bytes.Buffer
+//	    if err := run.GetLogs(ctx, &buf); err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+func (wr *WorkflowRun) GetLogs(ctx context.Context, w io.Writer) error {
+	rc, err := wr.client.provider.GetWorkflowRunLogs(ctx, wr.owner, wr.repo, wr.data.ID)
+	if err != nil {
+		return WrapHTTPError(err, 0, "failed to get workflow run logs")
+	}
+	defer func() { _ = rc.Close() }()
+
+	if _, err := io.Copy(w, rc); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to copy workflow run logs")
+	}
+	return nil
+}
+
 // Wait polls the workflow run until it completes or the context is cancelled.
 // The pollInterval parameter specifies how often to check the status.
 //
@@ -241,6 +266,20 @@ func (wj *WorkflowJob) Steps() []WorkflowStepData {
 	return wj.data.Steps
 }
 
+// GetLogs writes the plain-text logs for this job to w.
+func (wj *WorkflowJob) GetLogs(ctx context.Context, w io.Writer) error {
+	rc, err := wj.client.provider.GetWorkflowJobLogs(ctx, wj.owner, wj.repo, wj.data.ID)
+	if err != nil {
+		return WrapHTTPError(err, 0, "failed to get workflow job logs")
+	}
+	defer func() { _ = rc.Close() }()
+
+	if _, err := io.Copy(w, rc); err != nil {
+		return errors.Wrap(err, errors.CodeInternal, "failed to copy workflow job logs")
+	}
+	return nil
+}
+
 // IsComplete returns true if the job has completed.
 func (wj *WorkflowJob) IsComplete() bool {
 	return wj.data.Status == WorkflowStatusCompleted
@@ -54,6 +54,14 @@ func NewTarGzArchiverWithFS(fsys core.FS) *TarGzArchiver {
 	return &TarGzArchiver{fs: fsys}
 }
 
+// NewTarGzArchiverWithOptions returns a tar.gz archiver bound to the provided
+// filesystem that records file metadata according to opts.
+func NewTarGzArchiverWithOptions(fsys core.FS, opts ArchiveOptions) *TarGzArchiver {
+	archiver := NewTarGzArchiverWithFS(fsys)
+	archiver.options = opts
+	return archiver
+}
+
 // ExtractOptions controls extraction behavior and security constraints.
 // These options provide safety limits to prevent various attack vectors
 // such as zip bombs, path traversal attacks, and resource exhaustion.
@@ -87,6 +95,36 @@ type ExtractOptions struct {
 	//   - data/**/*.txt: matches all .txt files in data and subdirectories
 	// When empty, all files are extracted (default behavior).
 	FilesToExtract []string
+
+	// SymlinkPolicy controls how symlinks in the archive are extracted.
+	// Empty is treated as SymlinkPreserveWithinRoot.
+	SymlinkPolicy SymlinkPolicy
+
+	// PreserveMtimes restores the recorded modification times of extracted
+	// files and directories. When false, entries have their extraction time.
+	PreserveMtimes bool
+
+	// PreserveOwnership restores the recorded uid and gid of extracted
+	// entries. It only takes effect when running as root and is ignored
+	// otherwise.
+	PreserveOwnership bool
+
+	// PreserveXattrs restores recorded extended attributes in the "user."
+	// namespace. Extended attributes are only written to the local filesystem
+	// on Linux.
+	PreserveXattrs bool
+
+	// Progress, if non-nil, is called after each entry is extracted with its
+	// name and the size of its content.
+	Progress func(name string, size int64)
+
+	// Filter, if non-nil, is called for each entry that passes FilesToExtract,
+	// including directories. Entries for which it returns false are skipped.
+	Filter func(info FileInfo) bool
+
+	// OnExtract, if non-nil, is called after each entry is written with its
+	// slash-separated path relative to the target and its archive metadata.
+	OnExtract func(path string, info FileInfo)
 }
 
 // DefaultExtractOptions provides safe defaults for archive extraction.
@@ -95,7 +133,8 @@ type ExtractOptions struct {
 // - MaxSize: 1GB (prevents resource exhaustion)
 // - MaxFileSize: 100MB (prevents large individual files)
 // - PreservePerms: false (sanitizes permissions)
-// - FilesToExtract: empty (extracts all files).
+// - FilesToExtract: empty (extracts all files)
+// - SymlinkPolicy: preserve-within-root (rejects escaping symlinks).
 var DefaultExtractOptions = ExtractOptions{
 	MaxFiles:         10000,
 	MaxSize:          1 * 1024 * 1024 * 1024, // 1GB
@@ -104,4 +143,5 @@ var DefaultExtractOptions = ExtractOptions{
 	StripPrefix:      "",
 	PreservePerms:    false,
 	FilesToExtract:   nil, // Extract all files by default
+	SymlinkPolicy:    SymlinkPreserveWithinRoot,
 }
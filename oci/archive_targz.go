@@ -5,7 +5,6 @@ package ocibundle
 import (
 	"archive/tar"
 	"bytes"
-	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
@@ -29,7 +28,8 @@ import (
 // operations with comprehensive validation and progress reporting capabilities.
 // Uses concurrent processing for improved performance on multi-core systems.
 type TarGzArchiver struct {
-	fs core.FS
+	fs      core.FS
+	options ArchiveOptions
 }
 
 // NewTarGzArchiver creates a new TarGzArchiver instance.
@@ -60,64 +60,99 @@ func (a *TarGzArchiver) ArchiveWithProgress(
 		return fmt.Errorf("output writer cannot be nil")
 	}
 
+	tarBytes, err := a.buildTar(ctx, sourceDir, progress)
+	if err != nil {
+		return err
+	}
+
+	// Note: Progress tracking for the estargz compression phase would be complex
+	// since estargz.Build doesn't provide progress callbacks. The progress callback
+	// tracks the tar creation phase which is the bulk of the work.
+	return writeEstargz(tarBytes, output, a.options)
+}
+
+// buildTar creates an uncompressed tar archive of sourceDir with progress
+// reporting, to be compressed by the caller.
+func (a *TarGzArchiver) buildTar(
+	ctx context.Context,
+	sourceDir string,
+	progress func(current, total int64),
+) ([]byte, error) {
 	if _, err := a.fs.Stat(sourceDir); os.IsNotExist(err) {
-		return fmt.Errorf("source directory does not exist: %s", sourceDir)
+		return nil, fmt.Errorf("source directory does not exist: %s", sourceDir)
+	}
+
+	rules, err := loadIgnoreRules(a.fs, sourceDir, a.options.Exclude)
+	if err != nil {
+		return nil, err
 	}
 
 	// If progress callback is provided, calculate total size first
 	var totalSize int64
 	if progress != nil {
-		er := a.fs.Walk(sourceDir, func(path string, d fs.DirEntry, err error) error {
+		// Hardlinks carry no content, so only the first link to a file counts
+		var mu sync.Mutex
+		seen := make(hardlinkIndex)
+		er := walkSource(a.fs, sourceDir, rules, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
 			if err != nil {
 				return err
 			}
-			if !d.IsDir() {
-				info, err := d.Info()
-				if err != nil {
-					return err
-				}
-				if info.Mode().IsRegular() {
-					totalSize += info.Size()
-				}
+			mu.Lock()
+			defer mu.Unlock()
+			if _, linked := seen.add(path, info); info.Mode().IsRegular() && !linked {
+				totalSize += info.Size()
 			}
 			return nil
 		})
 		if er != nil {
-			return fmt.Errorf("failed to calculate total size: %w", er)
+			return nil, fmt.Errorf("failed to calculate total size: %w", er)
 		}
 	}
 
-	// Step 1: Create uncompressed tar in a buffer
 	var tarBuf bytes.Buffer
 	tarWriter := tar.NewWriter(&tarBuf)
 
 	var currentSize int64
 
 	// Use concurrent processing to build the tar
-	if err := a.archiveWithConcurrency(ctx, sourceDir, tarWriter, &currentSize, totalSize, progress); err != nil {
-		return err
+	if err := a.archiveWithConcurrency(ctx, sourceDir, rules, tarWriter, &currentSize, totalSize, progress); err != nil {
+		return nil, err
 	}
 
 	// Close tar writer to finalize the tar archive
 	if err := tarWriter.Close(); err != nil {
-		return fmt.Errorf("failed to close tar writer: %w", err)
+		return nil, fmt.Errorf("failed to close tar writer: %w", err)
 	}
 
-	// Step 2: Convert the uncompressed tar to eStargz format
-	tarBytes := tarBuf.Bytes()
+	return tarBuf.Bytes(), nil
+}
+
+// writeEstargz converts an uncompressed tar archive to eStargz format and
+// writes it to output.
+func writeEstargz(tarBytes []byte, output io.Writer, archiveOpts ArchiveOptions) error {
 	tarReader := io.NewSectionReader(bytes.NewReader(tarBytes), 0, int64(len(tarBytes)))
 
 	// Build eStargz with compression level 9 (maximum compression)
-	estargzBlob, err := estargz.Build(tarReader, estargz.WithCompressionLevel(9))
+	buildOpts := []estargz.Option{estargz.WithCompressionLevel(9)}
+	if archiveOpts.Deterministic {
+		// By default the blob is built as one gzip part per CPU, so the
+		// compressed output depends on the machine. A minimum chunk size
+		// makes estargz compress all entries sequentially in a single part.
+		buildOpts = append(buildOpts, estargz.WithMinChunkSize(1))
+	}
+	estargzBlob, err := estargz.Build(tarReader, buildOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to build estargz archive: %w", err)
 	}
 	defer func() { _ = estargzBlob.Close() }()
 
-	// Step 3: Copy the eStargz blob to the output
-	// Note: Progress tracking for the estargz compression phase would be complex
-	// since estargz.Build doesn't provide progress callbacks. The progress callback
-	// above tracks the tar creation phase which is the bulk of the work.
 	if _, err := io.Copy(output, estargzBlob); err != nil {
 		return fmt.Errorf("failed to write estargz archive: %w", err)
 	}
@@ -131,13 +166,19 @@ func (a *TarGzArchiver) ArchiveWithProgress(
 func (a *TarGzArchiver) archiveWithConcurrency(
 	ctx context.Context,
 	sourceDir string,
+	rules ignoreRules,
 	tarWriter *tar.Writer,
 	currentSize *int64,
 	totalSize int64,
 	progress func(current, total int64),
 ) error {
 	// Collect all file paths first
-	fileInfos, err := collectFileInfos(a.fs, sourceDir)
+	fileInfos, err := collectFileInfos(a.fs, sourceDir, rules)
+	if err != nil {
+		return err
+	}
+
+	fileInfos, err = a.resolveLinks(fileInfos)
 	if err != nil {
 		return err
 	}
@@ -163,7 +204,8 @@ func (a *TarGzArchiver) archiveWithConcurrency(
 	}
 
 	// Send jobs
-	for _, fileInfo := range fileInfos {
+	for i, fileInfo := range fileInfos {
+		fileInfo.index = i
 		jobs <- fileInfo
 	}
 	close(jobs)
@@ -178,16 +220,54 @@ func (a *TarGzArchiver) archiveWithConcurrency(
 	return processArchiveResults(ctx, results, tarWriter, a.copyWithProgress, currentSize, totalSize, progress)
 }
 
+// resolveLinks reads the targets of the symlinks in entries and marks the
+// regular files that are hardlinks to earlier entries. Symlinks are skipped
+// if the filesystem does not support them.
+func (a *TarGzArchiver) resolveLinks(entries []fileInfoEntry) ([]fileInfoEntry, error) {
+	resolved := make([]fileInfoEntry, 0, len(entries))
+	seen := make(hardlinkIndex)
+
+	for _, entry := range entries {
+		switch {
+		case entry.info.Mode()&fs.ModeSymlink != 0:
+			target, ok, err := readlink(a.fs, entry.path)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			if err := validateArchivedSymlink(entry.relPath, target); err != nil {
+				return nil, err
+			}
+			entry.link = target
+		case entry.info.Mode().IsRegular():
+			entry.link, entry.hardlink = seen.add(entry.relPath, entry.info)
+		}
+		resolved = append(resolved, entry)
+	}
+
+	return resolved, nil
+}
+
 // fileInfoEntry holds information about a file to be archived
 type fileInfoEntry struct {
 	path     string
 	relPath  string
 	info     os.FileInfo
 	fileSize int64
+
+	// link is the symlink target or, for hardlinks, the linked entry
+	link     string
+	hardlink bool
+
+	// index is the position of the entry in the archive
+	index int
 }
 
 // archiveResult holds the result of processing a file for archiving
 type archiveResult struct {
+	index   int
 	relPath string
 	header  *tar.Header
 	content io.ReadCloser
@@ -204,16 +284,14 @@ func (a *TarGzArchiver) worker(ctx context.Context, jobs <-chan fileInfoEntry, r
 		default:
 		}
 
-		header, err := tar.FileInfoHeader(job.info, "")
+		header, err := a.header(a.fs, job.info, job.path, job.relPath, job.link, job.hardlink)
 		if err != nil {
-			results <- archiveResult{relPath: job.relPath, err: fmt.Errorf("failed to create tar header for %s: %w", job.path, err)}
+			results <- archiveResult{relPath: job.relPath, err: err}
 			continue
 		}
 
-		header.Name = job.relPath
-
 		var content io.ReadCloser
-		if job.info.Mode().IsRegular() {
+		if header.Typeflag == tar.TypeReg {
 			file, err := a.fs.Open(job.path)
 			if err != nil {
 				results <- archiveResult{relPath: job.relPath, err: fmt.Errorf("failed to open file %s: %w", job.path, err)}
@@ -223,6 +301,7 @@ func (a *TarGzArchiver) worker(ctx context.Context, jobs <-chan fileInfoEntry, r
 		}
 
 		results <- archiveResult{
+			index:   job.index,
 			relPath: job.relPath,
 			header:  header,
 			content: content,
@@ -231,12 +310,29 @@ func (a *TarGzArchiver) worker(ctx context.Context, jobs <-chan fileInfoEntry, r
 	}
 }
 
-// Helper function to get minimum of two integers
-func min(a, b int) int {
-	if a < b {
-		return a
+// header returns the tar header for the entry name with info, read from path
+// in fsys, after applying the archive options. For symlinks link is the
+// target; for hardlinks it is the linked entry.
+func (a *TarGzArchiver) header(fsys any, info fs.FileInfo, path, name, link string, hardlink bool) (*tar.Header, error) {
+	var header *tar.Header
+	var err error
+	if hardlink {
+		header, err = hardlinkHeader(info, name, link)
+	} else {
+		header, err = tar.FileInfoHeader(info, link)
+		if err != nil {
+			err = fmt.Errorf("failed to create tar header for %s: %w", path, err)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	header.Name = name
+
+	if err := a.options.apply(header, fsys, path); err != nil {
+		return nil, err
 	}
-	return b
+	return header, nil
 }
 
 // copyWithProgress copies data from src to dst while reporting progress
@@ -266,6 +362,7 @@ func (a *TarGzArchiver) copyWithProgress(dst io.Writer, src io.Reader, progress
 }
 
 // Extract expands a tar.gz archive to the specified target directory with security validation.
+// Zstd-compressed tar archives are detected and decompressed transparently.
 func (a *TarGzArchiver) Extract(ctx context.Context, input io.Reader, targetDir string, opts ExtractOptions) error {
 	if input == nil {
 		return fmt.Errorf("input reader cannot be nil")
@@ -275,13 +372,13 @@ func (a *TarGzArchiver) Extract(ctx context.Context, input io.Reader, targetDir
 		return fmt.Errorf("target directory cannot be empty")
 	}
 
-	gzipReader, err := gzip.NewReader(input)
+	decompressed, err := newDecompressor(input)
 	if err != nil {
-		return fmt.Errorf("failed to create gzip reader: %w", err)
+		return err
 	}
-	defer func() { _ = gzipReader.Close() }()
+	defer func() { _ = decompressed.Close() }()
 
-	tarReader := tar.NewReader(gzipReader)
+	tarReader := tar.NewReader(decompressed)
 
 	if mkErr := a.fs.MkdirAll(targetDir, 0o755); mkErr != nil {
 		return fmt.Errorf("failed to create target directory: %w", mkErr)
@@ -289,7 +386,7 @@ func (a *TarGzArchiver) Extract(ctx context.Context, input io.Reader, targetDir
 
 	validators := newDefaultValidatorChain(opts)
 
-	// Path traversal and symlink validation (internal validator)
+	// Path traversal validation (internal validator); symlinks are validated by links
 	pv := validatepkg.NewPathTraversalValidator()
 	pv.AllowHiddenFiles = opts.AllowHiddenFiles
 	pv.RootPath = targetDir
@@ -302,6 +399,9 @@ func (a *TarGzArchiver) Extract(ctx context.Context, input io.Reader, targetDir
 		return fmt.Errorf("failed to resolve target directory: %w", absErr)
 	}
 
+	links := newLinkExtractor(a.fs, rootAbs, opts.SymlinkPolicy)
+	metadata := newMetadataRestorer(a.fs, opts)
+
 	for {
 		header, nextErr := tarReader.Next()
 		if errors.Is(nextErr, io.EOF) {
@@ -318,12 +418,12 @@ func (a *TarGzArchiver) Extract(ctx context.Context, input io.Reader, targetDir
 			continue
 		}
 
-		if err := handleHeader(ctx, tarReader, header, targetDir, rootAbs, opts, validators, pv, &totalSize, &fileCount, a.fs); err != nil {
+		if err := handleHeader(ctx, tarReader, header, targetDir, rootAbs, opts, validators, pv, links, metadata, &totalSize, &fileCount, a.fs); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return metadata.finish()
 }
 
 // MediaType returns the OCI media type for tar.gz archives.
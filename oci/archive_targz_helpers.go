@@ -8,7 +8,9 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/jmgilman/go/fs/core"
 
@@ -108,11 +110,16 @@ func matchesRecursivePattern(path, pattern string) bool {
 	return true
 }
 
-// collectFileInfos walks the source directory and returns all entries with
-// their original path, relative path, and os.FileInfo.
-func collectFileInfos(fsys core.FS, sourceDir string) ([]fileInfoEntry, error) {
-	var fileInfos []fileInfoEntry
-	walkErr := fsys.Walk(sourceDir, func(path string, d fs.DirEntry, err error) error {
+// collectFileInfos walks the source directory and returns all entries not
+// excluded by rules with their original path, relative path, and os.FileInfo.
+// Directories are read concurrently, which matters on high-latency backends
+// such as S3, so entries are returned sorted by relative path.
+func collectFileInfos(fsys core.FS, sourceDir string, rules ignoreRules) ([]fileInfoEntry, error) {
+	var (
+		mu        sync.Mutex
+		fileInfos []fileInfoEntry
+	)
+	walkErr := walkSource(fsys, sourceDir, rules, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return fmt.Errorf("walk failed at %s: %w", path, err)
 		}
@@ -131,6 +138,8 @@ func collectFileInfos(fsys core.FS, sourceDir string) ([]fileInfoEntry, error) {
 			return fmt.Errorf("failed to get file info for %s: %w", path, err)
 		}
 
+		mu.Lock()
+		defer mu.Unlock()
 		fileInfos = append(fileInfos, fileInfoEntry{
 			path:     path,
 			relPath:  relPath,
@@ -143,9 +152,32 @@ func collectFileInfos(fsys core.FS, sourceDir string) ([]fileInfoEntry, error) {
 	if walkErr != nil {
 		return nil, fmt.Errorf("failed to collect files from %s: %w", sourceDir, walkErr)
 	}
+
+	sortFileInfos(fileInfos)
 	return fileInfos, nil
 }
 
+// walkSource walks sourceDir, reading up to maxConcurrentWorkers directories
+// concurrently and skipping entries excluded by rules without reading them.
+// walkFn may be called concurrently and must be safe for concurrent use.
+func walkSource(fsys core.FS, sourceDir string, rules ignoreRules, walkFn fs.WalkDirFunc) error {
+	return core.WalkWithOptions(fsys, sourceDir, core.WalkOptions{
+		Parallelism: maxConcurrentWorkers,
+		Skip: func(path string, d fs.DirEntry) bool {
+			relPath, err := filepath.Rel(sourceDir, path)
+			return err == nil && rules.excluded(relPath, d.IsDir())
+		},
+	}, walkFn)
+}
+
+// sortFileInfos orders entries by their slash-separated relative path, which
+// keeps directories ahead of their contents regardless of walk order.
+func sortFileInfos(fileInfos []fileInfoEntry) {
+	slices.SortStableFunc(fileInfos, func(a, b fileInfoEntry) int {
+		return strings.Compare(filepath.ToSlash(a.relPath), filepath.ToSlash(b.relPath))
+	})
+}
+
 // processArchiveResults consumes worker results and writes entries to tarWriter.
 func processArchiveResults(
 	ctx context.Context,
@@ -156,6 +188,10 @@ func processArchiveResults(
 	totalSize int64,
 	progress func(current, total int64),
 ) error {
+	// Entries are written in walk order regardless of which worker finishes
+	// first, so archives of the same tree are identical
+	pending := make(map[int]archiveResult)
+	next := 0
 	for result := range results {
 		if err := isDone(ctx, "archiving"); err != nil {
 			return err
@@ -165,8 +201,18 @@ func processArchiveResults(
 			return fmt.Errorf("worker error for %s: %w", result.relPath, result.err)
 		}
 
-		if err := writeArchiveEntry(tarWriter, result, copyWithProgress, currentSize, totalSize, progress); err != nil {
-			return err
+		pending[result.index] = result
+		for {
+			ready, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+
+			if err := writeArchiveEntry(tarWriter, ready, copyWithProgress, currentSize, totalSize, progress); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -256,6 +302,8 @@ func handleHeader(
 	opts ExtractOptions,
 	validators Validator,
 	pv *validatepkg.PathTraversalValidator,
+	links *linkExtractor,
+	metadata *metadataRestorer,
 	totalSize *int64,
 	fileCount *int,
 	fsys core.FS,
@@ -272,6 +320,15 @@ func handleHeader(
 	// Check if file matches selective extraction patterns (if specified)
 	pathForMatching := stripPrefix(hdr.Name, opts.StripPrefix)
 
+	// Entries must not be written through symlinks extracted earlier
+	name, err := resolveFSPath(pathForMatching)
+	if err != nil {
+		return NewBundleError("extract", hdr.Name, ErrSecurityViolation)
+	}
+	if err := links.checkPath(name); err != nil {
+		return err
+	}
+
 	// For selective extraction: skip files that don't match patterns
 	// Always include directories (they're needed for nested files)
 	if len(opts.FilesToExtract) > 0 && hdr.Typeflag != tar.TypeDir {
@@ -280,6 +337,10 @@ func handleHeader(
 		}
 	}
 
+	if opts.Filter != nil && !opts.Filter(headerFileInfo(hdr)) {
+		return nil
+	}
+
 	// Now that we know we're processing this file, increment the count
 	*fileCount++
 
@@ -291,7 +352,43 @@ func handleHeader(
 		return err
 	}
 
-	return performExtraction(tr, hdr, fullPath, opts, pv, fsys)
+	if err := performExtraction(tr, hdr, name, fullPath, opts, links, fsys); err != nil {
+		return err
+	}
+	if err := metadata.restore(fullPath, hdr); err != nil {
+		return err
+	}
+	reportExtracted(opts, hdr, name)
+	return nil
+}
+
+// reportExtracted reports an entry extracted to name, relative to the target,
+// to the progress and extraction callbacks.
+func reportExtracted(opts ExtractOptions, hdr *tar.Header, name string) {
+	if opts.Progress != nil {
+		var size int64
+		if hdr.Typeflag == tar.TypeReg {
+			size = hdr.Size
+		}
+		opts.Progress(hdr.Name, size)
+	}
+	if opts.OnExtract != nil {
+		opts.OnExtract(name, headerFileInfo(hdr))
+	}
+}
+
+// headerFileInfo returns the FileInfo describing the entry of hdr.
+func headerFileInfo(hdr *tar.Header) FileInfo {
+	return FileInfo{
+		Name:     hdr.Name,
+		Size:     hdr.Size,
+		Mode:     uint32(hdr.Mode),
+		Type:     hdr.Typeflag,
+		Linkname: hdr.Linkname,
+		ModTime:  hdr.ModTime,
+		UID:      hdr.Uid,
+		GID:      hdr.Gid,
+	}
 }
 
 // normalizeAndResolvePath validates the header path, applies strip prefix, and ensures it stays within root.
@@ -323,12 +420,7 @@ func validateFileAndArchive(
 	totalSize *int64,
 	fileCount *int,
 ) error {
-	info := FileInfo{
-		Name: hdr.Name,
-		Size: hdr.Size,
-		Mode: uint32(hdr.Mode),
-	}
-	if err := validators.ValidateFile(info); err != nil {
+	if err := validators.ValidateFile(headerFileInfo(hdr)); err != nil {
 		return NewBundleError("extract", hdr.Name, err)
 	}
 
@@ -351,9 +443,10 @@ func validateFileAndArchive(
 func performExtraction(
 	tr *tar.Reader,
 	hdr *tar.Header,
+	name string,
 	fullPath string,
 	opts ExtractOptions,
-	pv *validatepkg.PathTraversalValidator,
+	links *linkExtractor,
 	fsys core.FS,
 ) error {
 	switch hdr.Typeflag {
@@ -363,20 +456,23 @@ func performExtraction(
 		if err := extractRegularFile(fsys, tr, fullPath); err != nil {
 			return err
 		}
+		links.file(name)
 		if !opts.PreservePerms {
 			// Ensure mode on create; optionally adjust if FS exposes chmod in future.
 			return nil
 		}
 		return nil
 	case tar.TypeSymlink:
-		return extractSymlink(fsys, pv, hdr, fullPath)
+		return links.symlink(name, hdr.Linkname)
+	case tar.TypeLink:
+		return links.hardlink(name, stripPrefix(hdr.Linkname, opts.StripPrefix))
 	default:
 		return nil
 	}
 }
 
 // extractDir creates a directory.
-func extractDir(fsys core.FS, fullPath string) error {
+func extractDir(fsys core.WriteFS, fullPath string) error {
 	if err := fsys.MkdirAll(fullPath, 0o755); err != nil {
 		return fmt.Errorf("failed to create directory %s: %w", fullPath, err)
 	}
@@ -384,7 +480,7 @@ func extractDir(fsys core.FS, fullPath string) error {
 }
 
 // extractRegularFile writes out a regular file from a tar reader.
-func extractRegularFile(fsys core.FS, tr *tar.Reader, fullPath string) error {
+func extractRegularFile(fsys core.WriteFS, tr *tar.Reader, fullPath string) error {
 	file, err := fsys.OpenFile(fullPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
 	if err != nil {
 		return fmt.Errorf("failed to create file %s: %w", fullPath, err)
@@ -396,27 +492,3 @@ func extractRegularFile(fsys core.FS, tr *tar.Reader, fullPath string) error {
 	}
 	return nil
 }
-
-// extractSymlink creates a symlink after validator approval.
-func extractSymlink(
-	fsys core.FS,
-	pv *validatepkg.PathTraversalValidator,
-	hdr *tar.Header,
-	fullPath string,
-) error {
-	linkTarget := hdr.Linkname
-	if err := pv.ValidateSymlink(hdr.Name, linkTarget); err != nil {
-		return NewBundleError("extract", hdr.Name, ErrSecurityViolation)
-	}
-
-	// Check if filesystem supports symlinks
-	if sfs, ok := fsys.(core.SymlinkFS); ok {
-		if err := sfs.Symlink(linkTarget, fullPath); err != nil {
-			return fmt.Errorf("failed to create symlink %s -> %s: %w", fullPath, linkTarget, err)
-		}
-		return nil
-	}
-
-	// If symlinks not supported, skip silently or log warning
-	return nil
-}
@@ -0,0 +1,152 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains the zstd-compressed tar implementation of the Archiver interface.
+package ocibundle
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/jmgilman/go/fs/core"
+)
+
+// zstdMagic is the magic number that starts every zstd frame.
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// TarZstdArchiver implements the Archiver interface using zstd-compressed tar
+// archives, which are typically smaller and faster to compress and decompress
+// than tar.gz. Archives are plain tar+zstd rather than eStargz, so selective
+// extraction downloads the whole blob and filters it during extraction.
+//
+// Extraction shares the security validation of TarGzArchiver.
+type TarZstdArchiver struct {
+	tar *TarGzArchiver
+}
+
+// NewTarZstdArchiver creates a new TarZstdArchiver instance using the local filesystem.
+func NewTarZstdArchiver() *TarZstdArchiver {
+	return &TarZstdArchiver{tar: NewTarGzArchiver()}
+}
+
+// NewTarZstdArchiverWithFS returns a tar+zstd archiver bound to the provided filesystem.
+func NewTarZstdArchiverWithFS(fsys core.FS) *TarZstdArchiver {
+	return &TarZstdArchiver{tar: NewTarGzArchiverWithFS(fsys)}
+}
+
+// NewTarZstdArchiverWithOptions returns a tar+zstd archiver bound to the
+// provided filesystem that records file metadata according to opts.
+func NewTarZstdArchiverWithOptions(fsys core.FS, opts ArchiveOptions) *TarZstdArchiver {
+	return &TarZstdArchiver{tar: NewTarGzArchiverWithOptions(fsys, opts)}
+}
+
+// Archive creates a tar+zstd archive from the specified source directory.
+func (a *TarZstdArchiver) Archive(ctx context.Context, sourceDir string, output io.Writer) error {
+	return a.ArchiveWithProgress(ctx, sourceDir, output, nil)
+}
+
+// ArchiveWithProgress creates a tar+zstd archive with progress reporting.
+func (a *TarZstdArchiver) ArchiveWithProgress(
+	ctx context.Context,
+	sourceDir string,
+	output io.Writer,
+	progress func(current, total int64),
+) error {
+	if sourceDir == "" {
+		return fmt.Errorf("source directory cannot be empty")
+	}
+
+	if output == nil {
+		return fmt.Errorf("output writer cannot be nil")
+	}
+
+	tarBytes, err := a.tar.buildTar(ctx, sourceDir, progress)
+	if err != nil {
+		return err
+	}
+	return writeZstd(tarBytes, output, a.tar.options)
+}
+
+// ArchiveFS creates a tar+zstd archive from the root of source.
+func (a *TarZstdArchiver) ArchiveFS(
+	ctx context.Context,
+	source core.ReadFS,
+	output io.Writer,
+	progress func(current, total int64),
+) error {
+	if source == nil {
+		return fmt.Errorf("source filesystem cannot be nil")
+	}
+	if output == nil {
+		return fmt.Errorf("output writer cannot be nil")
+	}
+
+	tarBytes, err := a.tar.buildFSTar(ctx, source, progress)
+	if err != nil {
+		return err
+	}
+	return writeZstd(tarBytes, output, a.tar.options)
+}
+
+// Extract expands a tar+zstd archive to the specified target directory with security validation.
+func (a *TarZstdArchiver) Extract(ctx context.Context, input io.Reader, targetDir string, opts ExtractOptions) error {
+	return a.tar.Extract(ctx, input, targetDir, opts)
+}
+
+// ExtractToFS expands a tar+zstd archive into the root of target with the
+// same security validation as Extract.
+func (a *TarZstdArchiver) ExtractToFS(ctx context.Context, input io.Reader, target core.WriteFS, opts ExtractOptions) error {
+	return a.tar.ExtractToFS(ctx, input, target, opts)
+}
+
+// MediaType returns the OCI media type for tar+zstd archives.
+func (a *TarZstdArchiver) MediaType() string {
+	return "application/vnd.oci.image.layer.v1.tar+zstd"
+}
+
+// writeZstd compresses an uncompressed tar archive with zstd and writes it to output.
+func writeZstd(tarBytes []byte, output io.Writer, archiveOpts ArchiveOptions) error {
+	var encoderOpts []zstd.EOption
+	if archiveOpts.Deterministic {
+		// A single encoder keeps the output independent of the number of CPUs
+		encoderOpts = append(encoderOpts, zstd.WithEncoderConcurrency(1))
+	}
+	encoder, err := zstd.NewWriter(output, encoderOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to create zstd writer: %w", err)
+	}
+
+	if _, err := encoder.Write(tarBytes); err != nil {
+		_ = encoder.Close()
+		return fmt.Errorf("failed to write zstd archive: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to finalize zstd archive: %w", err)
+	}
+	return nil
+}
+
+// newDecompressor returns a reader that decompresses input, detecting zstd by
+// its magic number and otherwise assuming gzip, so bundles in either
+// compression extract regardless of their declared media type.
+func newDecompressor(input io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(input)
+
+	if magic, err := buffered.Peek(len(zstdMagic)); err == nil && bytes.Equal(magic, zstdMagic) {
+		decoder, err := zstd.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create zstd reader: %w", err)
+		}
+		return decoder.IOReadCloser(), nil
+	}
+
+	gzipReader, err := gzip.NewReader(buffered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+	return gzipReader, nil
+}
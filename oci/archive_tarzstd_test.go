@@ -0,0 +1,115 @@
+package ocibundle
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTarZstdArchiver_RoundTrip tests archiving and extracting a directory.
+func TestTarZstdArchiver_RoundTrip(t *testing.T) {
+	tempDir := t.TempDir()
+	sourceDir := filepath.Join(tempDir, "source")
+	targetDir := filepath.Join(tempDir, "target")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, "subdir"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "test.txt"), []byte("Hello World"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "subdir", "nested.txt"), []byte("Nested content"), 0o644))
+
+	archiver := NewTarZstdArchiver()
+	assert.Equal(t, "application/vnd.oci.image.layer.v1.tar+zstd", archiver.MediaType())
+
+	var archive bytes.Buffer
+	require.NoError(t, archiver.Archive(context.Background(), sourceDir, &archive))
+	assert.Equal(t, zstdMagic, archive.Bytes()[:len(zstdMagic)])
+
+	require.NoError(t, archiver.Extract(context.Background(), &archive, targetDir, DefaultExtractOptions))
+
+	content, err := os.ReadFile(filepath.Join(targetDir, "subdir", "nested.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "Nested content", string(content))
+}
+
+// TestTarZstdArchiver_FS tests archiving and extracting filesystems.
+func TestTarZstdArchiver_FS(t *testing.T) {
+	ctx := context.Background()
+
+	source := billy.NewMemory()
+	require.NoError(t, source.MkdirAll("config", 0o755))
+	require.NoError(t, source.WriteFile("config/app.yaml", []byte("name: app"), 0o644))
+
+	var archive bytes.Buffer
+	require.NoError(t, NewTarZstdArchiver().ArchiveFS(ctx, source, &archive, nil))
+
+	target := billy.NewMemory()
+	require.NoError(t, NewTarZstdArchiver().ExtractToFS(ctx, &archive, target, DefaultExtractOptions))
+
+	content, err := target.ReadFile("config/app.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "name: app", string(content))
+}
+
+// TestTarGzArchiver_ExtractZstd tests that zstd archives are detected and
+// extracted by the tar.gz archiver regardless of their media type.
+func TestTarGzArchiver_ExtractZstd(t *testing.T) {
+	source := billy.NewMemory()
+	require.NoError(t, source.WriteFile("hello.txt", []byte("hi"), 0o644))
+
+	var archive bytes.Buffer
+	require.NoError(t, NewTarZstdArchiver().ArchiveFS(context.Background(), source, &archive, nil))
+
+	targetDir := t.TempDir()
+	require.NoError(t, NewTarGzArchiver().Extract(context.Background(), &archive, targetDir, DefaultExtractOptions))
+
+	content, err := os.ReadFile(filepath.Join(targetDir, "hello.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hi", string(content))
+}
+
+func TestClient_WithCompression(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pushes zstd bundles", func(t *testing.T) {
+		source := billy.NewMemory()
+		require.NoError(t, source.WriteFile("hello.txt", []byte("hi"), 0o644))
+
+		rec := &pushRecorder{}
+		client := newPushRecordingClient(t, rec)
+
+		require.NoError(t, client.PushFS(ctx, source, "example.com/repo:tag", WithCompression(CompressionZstd)))
+		assert.Equal(t, "application/vnd.oci.image.layer.v1.tar+zstd", rec.mediaType)
+		assert.Equal(t, zstdMagic, rec.data[:len(zstdMagic)])
+
+		// Pulls select the zstd archiver by media type
+		archiver := client.pullArchiver(rec.mediaType)
+		require.IsType(t, &TarZstdArchiver{}, archiver)
+
+		target := billy.NewMemory()
+		require.NoError(t, archiver.(FSExtractor).ExtractToFS(ctx, bytes.NewReader(rec.data), target, DefaultExtractOptions))
+		content, err := target.ReadFile("hello.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "hi", string(content))
+	})
+
+	t.Run("rejects unsupported compression", func(t *testing.T) {
+		client := newPushRecordingClient(t, &pushRecorder{})
+
+		err := client.PushFS(ctx, billy.NewMemory(), "example.com/repo:tag", WithCompression("brotli"))
+		require.Error(t, err)
+	})
+
+	t.Run("rejects custom archiver", func(t *testing.T) {
+		client, err := NewWithOptions(WithArchiver(NewTarZstdArchiver()))
+		require.NoError(t, err)
+
+		err = client.PushFS(ctx, billy.NewMemory(), "example.com/repo:tag", WithCompression(CompressionGzip))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "custom archiver")
+	})
+}
@@ -9,8 +9,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
@@ -744,6 +746,60 @@ func TestSelectiveExtraction(t *testing.T) {
 	t.Logf("✓ Selective extraction worked correctly")
 }
 
+// TestExtractFilter tests predicate filters and extraction callbacks.
+func TestExtractFilter(t *testing.T) {
+	source := billy.NewMemory()
+	require.NoError(t, source.MkdirAll("bin", 0o755))
+	require.NoError(t, source.WriteFile("bin/tool", []byte("#!/bin/sh"), 0o755))
+	require.NoError(t, source.WriteFile("small.txt", []byte("small"), 0o644))
+	require.NoError(t, source.WriteFile("large.txt", bytes.Repeat([]byte("x"), 1024), 0o644))
+
+	var archive bytes.Buffer
+	require.NoError(t, NewTarZstdArchiver().ArchiveFS(context.Background(), source, &archive, nil))
+
+	pullOpts := applyPullOptions([]PullOption{
+		WithExtractFilter(func(info FileInfo) bool {
+			return info.Type == tar.TypeDir || info.Size < 100
+		}),
+		WithOnExtract(func(string, FileInfo) {}),
+	})
+	opts := pullExtractOptions(pullOpts)
+	require.NotNil(t, opts.Filter)
+	require.NotNil(t, opts.OnExtract)
+
+	extracted := make(map[string]FileInfo)
+	opts.OnExtract = func(path string, info FileInfo) {
+		extracted[path] = info
+	}
+
+	t.Run("directory", func(t *testing.T) {
+		clear(extracted)
+		targetDir := t.TempDir()
+		require.NoError(t, NewTarGzArchiver().Extract(context.Background(), bytes.NewReader(archive.Bytes()), targetDir, opts))
+
+		assert.FileExists(t, filepath.Join(targetDir, "small.txt"))
+		assert.FileExists(t, filepath.Join(targetDir, "bin", "tool"))
+		assert.NoFileExists(t, filepath.Join(targetDir, "large.txt"))
+
+		require.Len(t, extracted, 3)
+		assert.Equal(t, byte(tar.TypeDir), extracted["bin"].Type)
+		assert.Equal(t, int64(len("#!/bin/sh")), extracted["bin/tool"].Size)
+		assert.Equal(t, uint32(0o755), extracted["bin/tool"].Mode&0o777)
+		assert.Equal(t, "small.txt", extracted["small.txt"].Name)
+	})
+
+	t.Run("filesystem", func(t *testing.T) {
+		clear(extracted)
+		target := billy.NewMemory()
+		require.NoError(t, NewTarGzArchiver().ExtractToFS(context.Background(), bytes.NewReader(archive.Bytes()), target, opts))
+
+		exists, err := target.Exists("large.txt")
+		require.NoError(t, err)
+		assert.False(t, exists)
+		assert.ElementsMatch(t, []string{"bin", "bin/tool", "small.txt"}, slices.Collect(maps.Keys(extracted)))
+	})
+}
+
 // TestTarGzArchiver_BackwardCompatibility tests that plain tar.gz archives
 // (created without eStargz) can still be extracted.
 func TestTarGzArchiver_BackwardCompatibility(t *testing.T) {
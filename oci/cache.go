@@ -0,0 +1,192 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains management of the client's local cache.
+package ocibundle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmgilman/go/oci/cache"
+)
+
+// CacheStats describes the contents of the client's cache.
+type CacheStats struct {
+	// Entries is the number of cached entries
+	Entries int
+
+	// PinnedEntries is the number of entries protected by CachePin
+	PinnedEntries int
+
+	// ExpiredEntries is the number of entries past their TTL that have not
+	// been cleaned up yet
+	ExpiredEntries int
+
+	// SizeBytes is the total size of the cached entries
+	SizeBytes int64
+
+	// MaxSizeBytes is the size above which entries are evicted
+	MaxSizeBytes int64
+
+	// HitRate is the fraction of cache lookups that were hits
+	HitRate float64
+
+	// Evictions is the number of entries evicted since the cache was created
+	Evictions int64
+}
+
+// PruneOptions configures which cache entries CachePrune removes.
+type PruneOptions struct {
+	// OlderThan limits pruning to entries not used within this duration.
+	// Zero prunes all entries regardless of age.
+	OlderThan time.Duration
+
+	// KeepRefs lists references whose cached artifacts must not be pruned.
+	// Tags are resolved using the cached tag mappings only; references that
+	// cannot be resolved without contacting the registry are ignored.
+	KeepRefs []string
+}
+
+// PruneResult reports what CachePrune removed.
+type PruneResult struct {
+	// Removed is the number of cache entries removed
+	Removed int
+
+	// FreedBytes is the total size of the removed entries
+	FreedBytes int64
+}
+
+// CacheStats returns statistics about the client's cache.
+func (c *Client) CacheStats() (CacheStats, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	coordinator, err := c.cacheCoordinator()
+	if err != nil {
+		return CacheStats{}, err
+	}
+
+	stats := coordinator.GetStats()
+	return CacheStats{
+		Entries:        stats.TotalEntries,
+		PinnedEntries:  stats.PinnedEntries,
+		ExpiredEntries: stats.ExpiredEntries,
+		SizeBytes:      stats.TotalSize,
+		MaxSizeBytes:   stats.MaxSize,
+		HitRate:        stats.HitRate,
+		Evictions:      stats.Evictions,
+	}, nil
+}
+
+// CachePrune removes cached entries and their data from disk. Pinned
+// entries and the artifacts of opts.KeepRefs are always kept.
+//
+// Example:
+//
+//	// Remove everything not used in the last week, except the current release
+//	result, err := client.CachePrune(ctx, ocibundle.PruneOptions{
+//	    OlderThan: 7 * 24 * time.Hour,
+//	    KeepRefs:  []string{"ghcr.io/org/repo:stable"},
+//	})
+func (c *Client) CachePrune(ctx context.Context, opts PruneOptions) (PruneResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	coordinator, err := c.cacheCoordinator()
+	if err != nil {
+		return PruneResult{}, err
+	}
+
+	keep := make([]string, 0, len(opts.KeepRefs))
+	for _, reference := range opts.KeepRefs {
+		if digest, ok := cachedDigest(ctx, coordinator, reference); ok {
+			keep = append(keep, digest)
+		}
+	}
+
+	result, err := coordinator.Prune(ctx, cache.PruneOptions{
+		OlderThan: opts.OlderThan,
+		Keep:      keep,
+	})
+	pruned := PruneResult{Removed: result.Removed, FreedBytes: result.FreedBytes}
+	if err != nil {
+		return pruned, fmt.Errorf("failed to prune cache: %w", err)
+	}
+	if err := c.pruneLayerStore(ctx, coordinator); err != nil {
+		return pruned, fmt.Errorf("failed to prune layer store: %w", err)
+	}
+
+	return pruned, nil
+}
+
+// CachePin protects the cached artifact of reference from expiration,
+// eviction, and CachePrune until it is unpinned with CacheUnpin.
+// The artifact must already be cached, for example by PullWithCache.
+func (c *Client) CachePin(reference string) error {
+	return c.setCachePin(reference, true)
+}
+
+// CacheUnpin removes the protection added by CachePin, making the cached
+// artifact of reference subject to expiration, eviction, and pruning again.
+func (c *Client) CacheUnpin(reference string) error {
+	return c.setCachePin(reference, false)
+}
+
+// setCachePin pins or unpins the cached artifact of reference.
+func (c *Client) setCachePin(reference string, pinned bool) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if reference == "" {
+		return fmt.Errorf("reference cannot be empty")
+	}
+
+	coordinator, err := c.cacheCoordinator()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	digest, ok := cachedDigest(ctx, coordinator, reference)
+	if !ok {
+		return fmt.Errorf("%s is not cached", reference)
+	}
+
+	if pinned {
+		err = coordinator.Pin(ctx, digest)
+	} else {
+		err = coordinator.Unpin(ctx, digest)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update pin for %s: %w", reference, err)
+	}
+	return nil
+}
+
+// cacheCoordinator returns the client's cache coordinator.
+func (c *Client) cacheCoordinator() (*cache.Coordinator, error) {
+	if c.options.CacheConfig == nil || c.options.CacheConfig.Coordinator == nil {
+		return nil, fmt.Errorf("cache not configured")
+	}
+
+	coordinator, ok := c.options.CacheConfig.Coordinator.(*cache.Coordinator)
+	if !ok {
+		return nil, fmt.Errorf("cache is not a coordinator")
+	}
+	return coordinator, nil
+}
+
+// cachedDigest resolves reference to a digest without contacting the
+// registry, using the digest in the reference or a cached tag mapping.
+func cachedDigest(ctx context.Context, coordinator *cache.Coordinator, reference string) (string, bool) {
+	if _, digest, found := strings.Cut(reference, "@"); found {
+		return digest, true
+	}
+
+	mapping, err := coordinator.GetTagMapping(ctx, reference)
+	if err != nil || mapping == nil {
+		return "", false
+	}
+	return mapping.Digest, true
+}
@@ -145,7 +145,7 @@ func (bc *blobCacheImpl) PutBlob(ctx context.Context, digest string, reader io.R
 	// Copy data to the stream writer
 	_, err = io.Copy(streamWriter, reader)
 	if err != nil {
-		streamWriter.Close() // Cleanup on error
+		streamWriter.Abort() // Discard the partial blob
 		return fmt.Errorf("failed to write blob data: %w", err)
 	}
 
@@ -366,6 +366,30 @@ func (bc *blobCacheImpl) removeReference(ctx context.Context, digest string) err
 	return nil
 }
 
+// purgeBlob removes a blob and its reference regardless of the reference count.
+func (bc *blobCacheImpl) purgeBlob(ctx context.Context, digest string) error {
+	bc.refCountMutex.Lock()
+	defer bc.refCountMutex.Unlock()
+
+	refPath, err := bc.getRefPath(digest)
+	if err != nil {
+		return fmt.Errorf("failed to get ref path: %w", err)
+	}
+	if err := bc.storage.Remove(ctx, refPath); err != nil {
+		return fmt.Errorf("failed to remove reference: %w", err)
+	}
+
+	blobPath, err := bc.getBlobPath(digest)
+	if err != nil {
+		return fmt.Errorf("failed to get blob path: %w", err)
+	}
+	if err := bc.storage.Remove(ctx, blobPath); err != nil {
+		return fmt.Errorf("failed to remove blob: %w", err)
+	}
+
+	return nil
+}
+
 // blobRef represents a reference to a cached blob.
 type blobRef struct {
 	Digest    string        `json:"digest"`
@@ -6,9 +6,11 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"io"
 	"sync"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/jmgilman/go/fs/billy"
@@ -179,6 +181,25 @@ func TestBlobCache_PutBlob(t *testing.T) {
 	}
 }
 
+func TestBlobCache_PutBlob_ReadError(t *testing.T) {
+	ctx := context.Background()
+	cache := createTestBlobCache(t).(*blobCacheImpl)
+	digest := "sha256:" + sha256Hash([]byte("test data"))
+
+	reader := io.MultiReader(bytes.NewReader([]byte("test")), iotest.ErrReader(errors.New("connection reset")))
+	require.Error(t, cache.PutBlob(ctx, digest, reader))
+
+	// The partial blob is discarded rather than committed
+	blobPath, err := cache.getBlobPath(digest)
+	require.NoError(t, err)
+	exists, err := cache.storage.Exists(ctx, blobPath)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// The path is unlocked again, so a later write succeeds
+	require.NoError(t, cache.PutBlob(ctx, digest, bytes.NewReader([]byte("test data"))))
+}
+
 func TestBlobCache_HasBlob(t *testing.T) {
 	cache := createTestBlobCache(t)
 
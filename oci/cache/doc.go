@@ -14,7 +14,7 @@
 //
 // The cache system consists of several key components:
 //
-//   - CacheManager: Central coordinator that manages cache configuration and metrics
+//   - Coordinator: Central coordinator that manages cache configuration and metrics
 //   - Cache: Core interface for basic cache operations (get, put, delete, clear)
 //   - ManifestCache: Specialized interface for OCI manifest caching with validation
 //   - BlobCache: Specialized interface for OCI blob caching with streaming support
@@ -40,18 +40,49 @@
 //
 // # Configuration
 //
-// Cache behavior is controlled through CacheConfig:
+// Cache behavior is controlled through Config:
 //   - MaxSizeBytes: Maximum cache size in bytes
 //   - DefaultTTL: Default time-to-live for entries
+//   - Eviction: Optional EvictionStrategy replacing the default LRU and size-based composite
+//   - FsckOnStartup: Run Coordinator.Fsck when the coordinator is created
 //
 // # Metrics and Observability
 //
-// The CacheMetrics struct tracks:
+// Coordinator.GetStats returns a Stats snapshot and DetailedMetrics tracks:
 //   - Hit/miss ratios
 //   - Eviction counts
 //   - Error rates
 //   - Storage utilization
 //
+// # Integrity Checks
+//
+// Coordinator.Fsck verifies stored blobs against their digests and stored
+// manifests against their checksums, removes entries left corrupted by
+// interrupted writes, and rebuilds the index from the remaining data.
+//
+// # Sharing a Cache
+//
+// A Coordinator is safe to share between ocibundle clients and signature
+// verifiers. Create it once and pass it to each consumer:
+//
+//	coordinator, err := cache.NewCoordinator(ctx, cache.Config{
+//	    MaxSizeBytes: 1 << 30,
+//	    DefaultTTL:   24 * time.Hour,
+//	}, fs, "/var/cache/oci", nil)
+//	if err != nil {
+//	    return err
+//	}
+//	defer coordinator.Close()
+//
+//	pullClient, _ := ocibundle.NewWithOptions(ocibundle.WithCache(coordinator, "/var/cache/oci", 0, 0))
+//	pushClient, _ := ocibundle.NewWithOptions(ocibundle.WithCache(coordinator, "/var/cache/oci", 0, 0))
+//
+// # API Stability
+//
+// Config, Coordinator, Stats, the cache interfaces (Cache, ManifestCache,
+// BlobCache, TagCache) and EvictionStrategy with its built-in implementations
+// are a supported public API and follow the module's compatibility guarantees.
+//
 // # Thread Safety
 //
 // All cache implementations must be safe for concurrent use by multiple goroutines.
@@ -58,7 +58,11 @@ func (l *LRUEviction) OnAccess(entry *Entry) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	key := entry.Key
+	l.touch(entry.Key)
+}
+
+// touch moves an entry to the front of the access list. The caller must hold l.mu.
+func (l *LRUEviction) touch(key string) {
 	if elem, exists := l.entries[key]; exists {
 		// Move to front (most recently used)
 		l.accessList.MoveToFront(elem)
@@ -79,7 +83,7 @@ func (l *LRUEviction) OnAdd(entry *Entry) {
 	key := entry.Key
 	if _, exists := l.entries[key]; exists {
 		// Entry already exists, just move to front
-		l.OnAccess(entry)
+		l.touch(key)
 		return
 	}
 
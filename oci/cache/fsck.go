@@ -0,0 +1,333 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FsckResult reports what Fsck found and repaired.
+type FsckResult struct {
+	// Checked is the number of stored blobs and manifests that were verified
+	Checked int
+
+	// Corrupted lists the keys of entries removed because their data was
+	// unreadable or did not match its digest
+	Corrupted []string
+
+	// Missing lists the keys of index entries removed because their data no
+	// longer exists
+	Missing []string
+
+	// Recovered lists the keys of intact entries that were missing from the
+	// index or had lost their blob reference and were restored
+	Recovered []string
+
+	// Duration is how long the check took
+	Duration time.Duration
+}
+
+// Healthy reports whether Fsck found nothing to repair.
+func (r FsckResult) Healthy() bool {
+	return len(r.Corrupted) == 0 && len(r.Missing) == 0 && len(r.Recovered) == 0
+}
+
+// Fsck verifies the integrity of the cache and repairs it. Stored blobs are
+// checked against their digests and stored manifests against their checksums;
+// entries that fail are removed. The index is then rebuilt from what remains:
+// intact data missing from the index is re-indexed, and index entries whose
+// data is gone are dropped. Leftover temporary files from interrupted writes
+// are removed as well.
+//
+// Fsck is intended to recover from partial writes, for example after a power
+// loss. Set Config.FsckOnStartup to run it when the coordinator is created.
+func (cm *Coordinator) Fsck(ctx context.Context) (FsckResult, error) {
+	start := time.Now()
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	cm.logger.Info(ctx, "starting cache integrity check")
+
+	result := FsckResult{
+		Corrupted: make([]string, 0),
+		Missing:   make([]string, 0),
+		Recovered: make([]string, 0),
+	}
+
+	if err := cm.storage.CleanupTempFiles(ctx); err != nil {
+		cm.logger.Warn(ctx, "failed to clean up temporary files", "error", err)
+	}
+
+	if blobs, ok := cm.blobCache.(*blobCacheImpl); ok {
+		if err := cm.fsckBlobs(ctx, blobs, &result); err != nil {
+			return result, err
+		}
+	}
+	if err := cm.fsckManifests(ctx, &result); err != nil {
+		return result, err
+	}
+	if err := cm.fsckIndex(ctx, &result); err != nil {
+		return result, err
+	}
+
+	if err := cm.index.Persist(); err != nil {
+		return result, fmt.Errorf("failed to persist index: %w", err)
+	}
+
+	result.Duration = time.Since(start)
+	cm.logger.Info(
+		ctx,
+		"cache integrity check completed",
+		"checked", result.Checked,
+		"corrupted", len(result.Corrupted),
+		"missing", len(result.Missing),
+		"recovered", len(result.Recovered),
+		"duration", result.Duration,
+	)
+	return result, nil
+}
+
+// fsckBlobs verifies every stored blob against the digest in its file name,
+// removing corrupted blobs and restoring the references and index entries of
+// intact ones. References without a blob are removed.
+func (cm *Coordinator) fsckBlobs(ctx context.Context, blobs *blobCacheImpl, result *FsckResult) error {
+	blobPaths, err := cm.listShardedFiles(ctx, blobs.blobDir)
+	if err != nil {
+		return err
+	}
+
+	for _, blobPath := range blobPaths {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("context cancelled: %w", err)
+		}
+
+		digest := "sha256:" + filepath.Base(blobPath)
+		result.Checked++
+
+		data, readErr := cm.storage.ReadWithIntegrity(ctx, blobPath)
+		if readErr != nil || sha256Hex(data) != filepath.Base(blobPath) {
+			cm.logger.Warn(ctx, "removing corrupted blob", "digest", digest, "error", readErr)
+			if err := cm.storage.Remove(ctx, blobPath); err != nil {
+				return fmt.Errorf("failed to remove corrupted blob %s: %w", digest, err)
+			}
+			if refPath, err := blobs.getRefPath(digest); err == nil {
+				if err := cm.storage.Remove(ctx, refPath); err != nil {
+					return fmt.Errorf("failed to remove reference of corrupted blob %s: %w", digest, err)
+				}
+			}
+			cm.removeIndexEntry(ctx, digest)
+			result.Corrupted = append(result.Corrupted, digest)
+			continue
+		}
+
+		recovered, err := cm.fsckBlobReference(ctx, blobs, digest)
+		if err != nil {
+			return err
+		}
+		if _, indexed := cm.index.Get(digest); !indexed {
+			now := time.Now()
+			if err := cm.index.Put(digest, &IndexEntry{
+				Key:        digest,
+				Size:       int64(len(data)),
+				CreatedAt:  now,
+				AccessedAt: now,
+				TTL:        blobs.defaultTTL,
+				FilePath:   "blobs/" + digest,
+			}); err != nil {
+				return fmt.Errorf("failed to index blob %s: %w", digest, err)
+			}
+			recovered = true
+		}
+		if recovered {
+			result.Recovered = append(result.Recovered, digest)
+		}
+	}
+
+	refPaths, err := cm.listShardedFiles(ctx, blobs.refsDir)
+	if err != nil {
+		return err
+	}
+	for _, refPath := range refPaths {
+		blobPath, err := blobs.getBlobPath("sha256:" + filepath.Base(refPath))
+		if err != nil {
+			continue
+		}
+		if exists, err := cm.storage.Exists(ctx, blobPath); err != nil || exists {
+			continue
+		}
+		if err := cm.storage.Remove(ctx, refPath); err != nil {
+			return fmt.Errorf("failed to remove orphaned blob reference: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// fsckBlobReference replaces a missing or unreadable blob reference with a
+// fresh one. It reports whether the reference was replaced.
+func (cm *Coordinator) fsckBlobReference(ctx context.Context, blobs *blobCacheImpl, digest string) (bool, error) {
+	refPath, err := blobs.getRefPath(digest)
+	if err != nil {
+		return false, fmt.Errorf("failed to get ref path: %w", err)
+	}
+
+	exists, err := cm.storage.Exists(ctx, refPath)
+	if err != nil {
+		return false, fmt.Errorf("failed to check reference existence: %w", err)
+	}
+	if exists {
+		refData, readErr := cm.storage.ReadWithIntegrity(ctx, refPath)
+		if readErr == nil {
+			if _, parseErr := parseBlobRef(refData); parseErr == nil {
+				return false, nil
+			}
+		}
+		if err := cm.storage.Remove(ctx, refPath); err != nil {
+			return false, fmt.Errorf("failed to remove corrupted reference: %w", err)
+		}
+	}
+
+	if err := blobs.addReference(ctx, digest); err != nil {
+		return false, fmt.Errorf("failed to restore reference for blob %s: %w", digest, err)
+	}
+	return true, nil
+}
+
+// fsckManifests verifies every stored manifest, removing unreadable ones and
+// re-indexing intact manifests missing from the index.
+func (cm *Coordinator) fsckManifests(ctx context.Context, result *FsckResult) error {
+	names, err := cm.storage.ListFiles(ctx, "manifests")
+	if err != nil {
+		return fmt.Errorf("failed to list manifests: %w", err)
+	}
+
+	for _, digest := range names {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("context cancelled: %w", err)
+		}
+
+		manifestPath := "manifests/" + digest
+		result.Checked++
+
+		var entry manifestCacheEntry
+		data, readErr := cm.storage.ReadWithIntegrity(ctx, manifestPath)
+		if readErr == nil {
+			readErr = json.Unmarshal(data, &entry)
+		}
+		if readErr != nil || entry.Manifest == nil {
+			cm.logger.Warn(ctx, "removing corrupted manifest", "digest", digest, "error", readErr)
+			if err := cm.storage.Remove(ctx, manifestPath); err != nil {
+				return fmt.Errorf("failed to remove corrupted manifest %s: %w", digest, err)
+			}
+			cm.removeIndexEntry(ctx, digest)
+			result.Corrupted = append(result.Corrupted, digest)
+			continue
+		}
+
+		if _, indexed := cm.index.Get(digest); indexed {
+			continue
+		}
+		if err := cm.index.Put(digest, &IndexEntry{
+			Key:        digest,
+			Size:       int64(len(digest)),
+			CreatedAt:  entry.CreatedAt,
+			AccessedAt: entry.AccessedAt,
+			TTL:        cm.config.DefaultTTL,
+			FilePath:   manifestPath,
+		}); err != nil {
+			return fmt.Errorf("failed to index manifest %s: %w", digest, err)
+		}
+		result.Recovered = append(result.Recovered, digest)
+	}
+
+	return nil
+}
+
+// fsckIndex removes index entries whose data no longer exists.
+func (cm *Coordinator) fsckIndex(ctx context.Context, result *FsckResult) error {
+	for _, key := range cm.index.Keys(nil) {
+		entry, exists := cm.index.Get(key)
+		if !exists || entry.FilePath == "" {
+			continue
+		}
+
+		dataPath := entry.FilePath
+		if strings.HasPrefix(dataPath, "blobs/") {
+			blobs, ok := cm.blobCache.(*blobCacheImpl)
+			if !ok {
+				continue
+			}
+			blobPath, err := blobs.getBlobPath(key)
+			if err != nil {
+				continue
+			}
+			dataPath = blobPath
+		}
+
+		found, err := cm.storage.Exists(ctx, dataPath)
+		if err != nil {
+			return fmt.Errorf("failed to check data of %s: %w", key, err)
+		}
+		if !found {
+			cm.removeIndexEntry(ctx, key)
+			result.Missing = append(result.Missing, key)
+		}
+	}
+	return nil
+}
+
+// removeIndexEntry drops key from the index and the eviction strategy.
+func (cm *Coordinator) removeIndexEntry(ctx context.Context, key string) {
+	if _, exists := cm.index.Get(key); !exists {
+		return
+	}
+	if err := cm.deleteEntry(ctx, key); err != nil {
+		cm.logger.Warn(ctx, "failed to remove index entry", "key", key, "error", err)
+		return
+	}
+	cm.eviction.OnRemove(&Entry{Key: key})
+}
+
+// listShardedFiles returns the paths, relative to the storage root, of the
+// files in the shard subdirectories of dir.
+func (cm *Coordinator) listShardedFiles(ctx context.Context, dir string) ([]string, error) {
+	fullPath := filepath.Join(cm.storage.rootPath, dir)
+	exists, err := cm.storage.fs.Exists(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check directory %q: %w", dir, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	shards, err := cm.storage.fs.ReadDir(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %q: %w", dir, err)
+	}
+
+	var paths []string
+	for _, shard := range shards {
+		if !shard.IsDir() {
+			continue
+		}
+		names, err := cm.storage.ListFiles(ctx, filepath.Join(dir, shard.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			paths = append(paths, filepath.Join(dir, shard.Name(), name))
+		}
+	}
+	return paths, nil
+}
+
+// sha256Hex returns the hex-encoded SHA256 hash of data.
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoordinator_Fsck(t *testing.T) {
+	ctx := context.Background()
+	config := Config{
+		MaxSizeBytes: 1024 * 1024,
+		DefaultTTL:   time.Hour,
+	}
+	fs := billy.NewMemory()
+	root := t.TempDir()
+	coordinator, err := NewCoordinator(ctx, config, fs, root, nil)
+	require.NoError(t, err)
+
+	putBlob := func(content string) string {
+		digest := "sha256:" + sha256Hex([]byte(content))
+		require.NoError(t, coordinator.PutBlob(ctx, digest, bytes.NewReader([]byte(content))))
+		return digest
+	}
+	intact := putBlob("intact")
+	unreferenced := putBlob("unreferenced")
+	truncated := putBlob("truncated")
+	mismatched := putBlob("mismatched")
+	unindexed := putBlob("unindexed")
+
+	manifest := &ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.Descriptor{MediaType: ocispec.MediaTypeImageConfig, Size: 10},
+	}
+	manifestDigest := validTestDigest("manifest")
+	require.NoError(t, coordinator.PutManifest(ctx, manifestDigest, manifest))
+	missingDigest := validTestDigest("missing")
+	require.NoError(t, coordinator.PutManifest(ctx, missingDigest, manifest))
+	corruptDigest := validTestDigest("corrupt")
+	require.NoError(t, coordinator.PutManifest(ctx, corruptDigest, manifest))
+
+	blobs := coordinator.blobCache.(*blobCacheImpl)
+	blobFile := func(digest string) string {
+		path, err := blobs.getBlobPath(digest)
+		require.NoError(t, err)
+		return filepath.Join(root, path)
+	}
+	refFile := func(digest string) string {
+		path, err := blobs.getRefPath(digest)
+		require.NoError(t, err)
+		return filepath.Join(root, path)
+	}
+
+	// Simulate damage left behind by interrupted writes
+	require.NoError(t, fs.Remove(refFile(unreferenced)))
+	require.NoError(t, fs.WriteFile(blobFile(truncated), []byte("0123"), 0o644))
+	require.NoError(t, blobs.storage.writeWithChecksum(blobFile(mismatched), []byte("other")))
+	require.NoError(t, coordinator.index.Delete(unindexed))
+	require.NoError(t, fs.Remove(filepath.Join(root, "manifests", missingDigest)))
+	require.NoError(t, fs.WriteFile(filepath.Join(root, "manifests", corruptDigest), []byte("{\"manif"), 0o644))
+
+	result, err := coordinator.Fsck(ctx)
+	require.NoError(t, err)
+	assert.False(t, result.Healthy())
+	assert.Equal(t, 7, result.Checked)
+	assert.ElementsMatch(t, []string{truncated, mismatched, corruptDigest}, result.Corrupted)
+	assert.Equal(t, []string{missingDigest}, result.Missing)
+	assert.ElementsMatch(t, []string{unreferenced, unindexed}, result.Recovered)
+
+	for _, digest := range []string{intact, unreferenced, unindexed} {
+		_, err := coordinator.GetBlob(ctx, digest)
+		assert.NoError(t, err, digest)
+	}
+	for _, digest := range []string{truncated, mismatched} {
+		_, exists := coordinator.index.Get(digest)
+		assert.False(t, exists, digest)
+		found, err := fs.Exists(refFile(digest))
+		require.NoError(t, err)
+		assert.False(t, found, digest)
+	}
+	_, err = coordinator.GetManifest(ctx, manifestDigest)
+	assert.NoError(t, err)
+
+	t.Run("reports a repaired cache as healthy", func(t *testing.T) {
+		result, err := coordinator.Fsck(ctx)
+		require.NoError(t, err)
+		assert.True(t, result.Healthy())
+		assert.Equal(t, 4, result.Checked)
+	})
+
+	t.Run("runs on startup", func(t *testing.T) {
+		require.NoError(t, fs.WriteFile(blobFile(intact), []byte("partial"), 0o644))
+		require.NoError(t, coordinator.Close())
+
+		config.FsckOnStartup = true
+		restarted, err := NewCoordinator(ctx, config, fs, root, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = restarted.Close() })
+
+		_, exists := restarted.index.Get(intact)
+		assert.False(t, exists)
+		_, err = restarted.GetBlob(ctx, intact)
+		assert.Error(t, err)
+	})
+}
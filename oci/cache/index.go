@@ -13,6 +13,10 @@ import (
 	"time"
 )
 
+// pinnedMetadataKey marks an index entry as pinned. Storing the pin in the
+// entry metadata persists it with the index.
+const pinnedMetadataKey = "pinned"
+
 // IndexEntry represents a single entry in the cache index.
 type IndexEntry struct {
 	Key         string            `json:"key"`
@@ -25,14 +29,19 @@ type IndexEntry struct {
 	FilePath    string            `json:"file_path"` // Relative path to the data file
 }
 
-// IsExpired returns true if the index entry has expired.
+// IsExpired returns true if the index entry has expired. Pinned entries never expire.
 func (ie *IndexEntry) IsExpired() bool {
-	if ie.TTL <= 0 {
+	if ie.TTL <= 0 || ie.Pinned() {
 		return false
 	}
 	return time.Since(ie.CreatedAt) > ie.TTL
 }
 
+// Pinned returns true if the index entry is pinned.
+func (ie *IndexEntry) Pinned() bool {
+	return ie.Metadata[pinnedMetadataKey] == "true"
+}
+
 // Index manages in-memory cache index with persistence and recovery capabilities.
 type Index struct {
 	mu                  sync.RWMutex
@@ -288,6 +297,7 @@ func (idx *Index) compact() error {
 type IndexStats struct {
 	TotalEntries       int
 	ExpiredEntries     int
+	PinnedEntries      int
 	TotalSize          int64
 	LastCompaction     time.Time
 	AverageAccessCount float64
@@ -311,6 +321,9 @@ func (idx *Index) Stats() IndexStats {
 		if entry.IsExpired() {
 			stats.ExpiredEntries++
 		}
+		if entry.Pinned() {
+			stats.PinnedEntries++
+		}
 	}
 
 	if stats.TotalEntries > 0 {
@@ -72,6 +72,13 @@ func NewCoordinator(
 		return nil, fmt.Errorf("failed to initialize caches: %w", err)
 	}
 
+	// Repair the cache before serving from it
+	if config.FsckOnStartup {
+		if _, err := coordinator.Fsck(ctx); err != nil {
+			return nil, fmt.Errorf("failed to check cache integrity: %w", err)
+		}
+	}
+
 	// Start background cleanup
 	coordinator.startCleanupScheduler(ctx)
 
@@ -90,13 +97,16 @@ func (cm *Coordinator) initializeCaches(ctx context.Context) error {
 		return fmt.Errorf("failed to load cache index: %w", err)
 	}
 
-	// Initialize eviction strategy (composite of LRU and size-based)
-	lruEviction := NewLRUEviction()
-	sizeEviction := NewSizeEviction(cm.config.MaxSizeBytes)
-	cm.eviction = NewCompositeEviction(
-		[]EvictionStrategy{lruEviction, sizeEviction},
-		[]int{1, 2}, // Size-based eviction has higher priority
-	)
+	// Initialize eviction strategy (composite of LRU and size-based unless configured)
+	cm.eviction = cm.config.Eviction
+	if cm.eviction == nil {
+		lruEviction := NewLRUEviction()
+		sizeEviction := NewSizeEviction(cm.config.MaxSizeBytes)
+		cm.eviction = NewCompositeEviction(
+			[]EvictionStrategy{lruEviction, sizeEviction},
+			[]int{1, 2}, // Size-based eviction has higher priority
+		)
+	}
 
 	// Initialize manifest cache
 	cm.manifestCache = NewManifestCache(cm.storage, cm) // Pass self as manager
@@ -234,7 +244,7 @@ func (cm *Coordinator) performEviction(ctx context.Context) error {
 	entries := make(map[string]*Entry)
 
 	for _, key := range allKeys {
-		if indexEntry, exists := cm.index.Get(key); exists {
+		if indexEntry, exists := cm.index.Get(key); exists && !indexEntry.Pinned() {
 			entries[key] = &Entry{
 				Key:        key,
 				Data:       []byte{}, // Empty data for eviction decision
@@ -342,6 +352,7 @@ func (cm *Coordinator) PutManifest(
 		FilePath:    "manifests/" + digest,
 	}
 
+	cm.preservePin(digest, indexEntry)
 	if err := cm.index.Put(digest, indexEntry); err != nil {
 		duration := time.Since(start)
 		cm.metrics.RecordError()
@@ -369,6 +380,18 @@ func (cm *Coordinator) PutManifest(
 	return nil
 }
 
+// HasBlob reports whether an unexpired blob with the given digest is cached.
+func (cm *Coordinator) HasBlob(ctx context.Context, digest string) (bool, error) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	exists, err := cm.blobCache.HasBlob(ctx, digest)
+	if err != nil {
+		return false, fmt.Errorf("failed to check blob: %w", err)
+	}
+	return exists, nil
+}
+
 // GetBlob retrieves a blob from the cache.
 func (cm *Coordinator) GetBlob(
 	ctx context.Context,
@@ -436,6 +459,7 @@ func (cm *Coordinator) PutBlob(
 		FilePath:    "blobs/" + digest,
 	}
 
+	cm.preservePin(digest, indexEntry)
 	if err := cm.index.Put(digest, indexEntry); err != nil {
 		cm.metrics.RecordError()
 		cm.metrics.RecordLatency("put", time.Since(start))
@@ -713,6 +737,7 @@ func (cm *Coordinator) PutVerificationResult(
 		Metadata:    entry.Metadata,
 	}
 
+	cm.preservePin(key, indexEntry)
 	if err := cm.index.Put(key, indexEntry); err != nil {
 		duration := time.Since(start)
 		cm.metrics.RecordError()
@@ -840,6 +865,7 @@ func (cm *Coordinator) Put(_ context.Context, key string, entry *Entry) error {
 	}
 
 	// Add to index
+	cm.preservePin(key, indexEntry)
 	return cm.index.Put(key, indexEntry)
 }
 
@@ -886,6 +912,7 @@ func (cm *Coordinator) GetStats() Stats {
 		HitRate:            metricsSnapshot.HitRate,
 		Evictions:          metricsSnapshot.Evictions,
 		Errors:             metricsSnapshot.Errors,
+		PinnedEntries:      indexStats.PinnedEntries,
 		LastCompaction:     indexStats.LastCompaction,
 		AverageAccessCount: indexStats.AverageAccessCount,
 	}
@@ -927,6 +954,7 @@ func (cm *Coordinator) Close() error {
 type Stats struct {
 	TotalEntries       int       `json:"total_entries"`
 	ExpiredEntries     int       `json:"expired_entries"`
+	PinnedEntries      int       `json:"pinned_entries"`
 	TotalSize          int64     `json:"total_size_bytes"`
 	MaxSize            int64     `json:"max_size_bytes"`
 	HitRate            float64   `json:"hit_rate"`
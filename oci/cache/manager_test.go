@@ -109,9 +109,17 @@ func TestCoordinator_GetPutBlob(t *testing.T) {
 	digest := validTestDigest("blob")
 	data := []byte("test blob data")
 
+	has, err := coordinator.HasBlob(ctx, digest)
+	require.NoError(t, err)
+	assert.False(t, has)
+
 	// Put blob
-	err := coordinator.PutBlob(ctx, digest, bytes.NewReader(data))
+	err = coordinator.PutBlob(ctx, digest, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	has, err = coordinator.HasBlob(ctx, digest)
 	require.NoError(t, err)
+	assert.True(t, has)
 
 	// Get blob
 	reader, err := coordinator.GetBlob(ctx, digest)
@@ -325,6 +333,42 @@ func TestCoordinator_PerformEviction(t *testing.T) {
 	assert.True(t, size <= config.MaxSizeBytes)
 }
 
+// countingEviction records SelectForEviction calls and never evicts.
+type countingEviction struct {
+	selections int
+}
+
+func (e *countingEviction) SelectForEviction(map[string]*Entry) []string {
+	e.selections++
+	return nil
+}
+
+func (e *countingEviction) OnAccess(*Entry) {}
+func (e *countingEviction) OnAdd(*Entry)    {}
+func (e *countingEviction) OnRemove(*Entry) {}
+
+func TestCoordinator_CustomEviction(t *testing.T) {
+	eviction := &countingEviction{}
+	config := Config{
+		MaxSizeBytes: 100,
+		DefaultTTL:   time.Hour,
+		Eviction:     eviction,
+	}
+	coordinator := setupTestManager(t, config)
+
+	ctx := context.Background()
+	err := coordinator.PutBlob(ctx, validTestDigest("large"), bytes.NewReader(make([]byte, 200)))
+	require.NoError(t, err)
+
+	err = coordinator.performEviction(ctx)
+	require.NoError(t, err)
+
+	assert.Positive(t, eviction.selections)
+	size, err := coordinator.Size(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, size, config.MaxSizeBytes, "custom strategy should decide what is evicted")
+}
+
 func TestCoordinator_Metrics(t *testing.T) {
 	coordinator := setupTestManager(t, Config{
 		MaxSizeBytes: 1024 * 1024,
@@ -0,0 +1,170 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PruneOptions configures which entries Prune removes.
+type PruneOptions struct {
+	// OlderThan limits pruning to entries not accessed within this duration.
+	// Zero prunes all entries regardless of age.
+	OlderThan time.Duration
+
+	// Keep lists entry keys that must not be pruned.
+	Keep []string
+}
+
+// PruneResult reports what Prune removed.
+type PruneResult struct {
+	// Removed is the number of entries removed
+	Removed int
+
+	// FreedBytes is the total size of the removed entries
+	FreedBytes int64
+}
+
+// Pin marks the entry with the given key as pinned. Pinned entries are never
+// removed by expiration, eviction, or Prune until they are unpinned.
+func (cm *Coordinator) Pin(_ context.Context, key string) error {
+	return cm.setPinned(key, true)
+}
+
+// Unpin removes the pin from the entry with the given key.
+func (cm *Coordinator) Unpin(_ context.Context, key string) error {
+	return cm.setPinned(key, false)
+}
+
+// IsPinned reports whether the entry with the given key is pinned.
+func (cm *Coordinator) IsPinned(key string) bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	entry, exists := cm.index.Get(key)
+	return exists && entry.Pinned()
+}
+
+// setPinned updates the pin of an entry and persists the index.
+func (cm *Coordinator) setPinned(key string, pinned bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	entry, exists := cm.index.Get(key)
+	if !exists {
+		return fmt.Errorf("cache entry not found: %s", key)
+	}
+
+	// Copy the metadata so readers of the previous entry are unaffected
+	updated := *entry
+	updated.Metadata = make(map[string]string, len(entry.Metadata)+1)
+	for k, v := range entry.Metadata {
+		updated.Metadata[k] = v
+	}
+	if pinned {
+		updated.Metadata[pinnedMetadataKey] = "true"
+	} else {
+		delete(updated.Metadata, pinnedMetadataKey)
+	}
+
+	if err := cm.index.Put(key, &updated); err != nil {
+		return fmt.Errorf("failed to update cache entry: %w", err)
+	}
+	return cm.index.Persist()
+}
+
+// Prune removes unpinned entries and their data from the cache.
+// Entries that fail to be removed are skipped and reported in the returned
+// error after all other entries have been processed.
+func (cm *Coordinator) Prune(ctx context.Context, opts PruneOptions) (PruneResult, error) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	keep := make(map[string]bool, len(opts.Keep))
+	for _, key := range opts.Keep {
+		keep[key] = true
+	}
+
+	var cutoff time.Time
+	if opts.OlderThan > 0 {
+		cutoff = time.Now().Add(-opts.OlderThan)
+	}
+
+	candidates := cm.index.Keys(func(entry *IndexEntry) bool {
+		if entry.Pinned() || keep[entry.Key] {
+			return false
+		}
+		return cutoff.IsZero() || entry.AccessedAt.Before(cutoff)
+	})
+
+	var result PruneResult
+	var failed int
+	for _, key := range candidates {
+		if err := ctx.Err(); err != nil {
+			return result, fmt.Errorf("context cancelled: %w", err)
+		}
+
+		entry, exists := cm.index.Get(key)
+		if !exists {
+			continue
+		}
+
+		if err := cm.removeEntryData(ctx, entry); err != nil {
+			cm.logger.Warn(ctx, "failed to remove pruned entry data", "key", key, "error", err)
+			failed++
+			continue
+		}
+		if err := cm.deleteEntry(ctx, key); err != nil {
+			failed++
+			continue
+		}
+		cm.eviction.OnRemove(&Entry{Key: key})
+
+		result.Removed++
+		result.FreedBytes += entry.Size
+		LogEviction(ctx, cm.logger, key, entry.Size, "pruned")
+	}
+
+	if err := cm.index.Persist(); err != nil {
+		return result, fmt.Errorf("failed to persist index: %w", err)
+	}
+	if failed > 0 {
+		return result, fmt.Errorf("failed to prune %d cache entries", failed)
+	}
+	return result, nil
+}
+
+// preservePin carries the pin of an existing entry over to its replacement,
+// so storing an entry again does not unpin it.
+func (cm *Coordinator) preservePin(key string, entry *IndexEntry) {
+	existing, exists := cm.index.Get(key)
+	if !exists || !existing.Pinned() {
+		return
+	}
+
+	metadata := make(map[string]string, len(entry.Metadata)+1)
+	for k, v := range entry.Metadata {
+		metadata[k] = v
+	}
+	metadata[pinnedMetadataKey] = "true"
+	entry.Metadata = metadata
+}
+
+// removeEntryData removes the data file backing an index entry. Blobs are
+// removed along with their references, since the index tracks one entry per
+// blob regardless of how many times it was stored.
+func (cm *Coordinator) removeEntryData(ctx context.Context, entry *IndexEntry) error {
+	if entry.FilePath == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(entry.FilePath, "blobs/") {
+		if blobs, ok := cm.blobCache.(*blobCacheImpl); ok {
+			return blobs.purgeBlob(ctx, entry.Key)
+		}
+		return cm.blobCache.DeleteBlob(ctx, entry.Key)
+	}
+
+	return cm.storage.Remove(ctx, entry.FilePath)
+}
@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoordinator_Pin(t *testing.T) {
+	coordinator := setupTestManager(t, Config{
+		MaxSizeBytes: 1024 * 1024,
+		DefaultTTL:   time.Hour,
+	})
+
+	ctx := context.Background()
+	digest := validTestDigest("pin")
+	require.NoError(t, coordinator.PutBlob(ctx, digest, bytes.NewReader([]byte("data"))))
+
+	require.NoError(t, coordinator.Pin(ctx, digest))
+	assert.True(t, coordinator.IsPinned(digest))
+	assert.Equal(t, 1, coordinator.GetStats().PinnedEntries)
+
+	// Storing the blob again keeps the pin
+	require.NoError(t, coordinator.PutBlob(ctx, digest, bytes.NewReader([]byte("data"))))
+	assert.True(t, coordinator.IsPinned(digest))
+
+	// Pinned entries do not expire
+	entry, _ := coordinator.index.Get(digest)
+	entry.CreatedAt = time.Now().Add(-48 * time.Hour)
+	assert.False(t, entry.IsExpired())
+
+	require.NoError(t, coordinator.Unpin(ctx, digest))
+	assert.False(t, coordinator.IsPinned(digest))
+
+	err := coordinator.Pin(ctx, validTestDigest("missing"))
+	assert.Error(t, err)
+}
+
+func TestCoordinator_Prune(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes unpinned entries and blob data", func(t *testing.T) {
+		coordinator := setupTestManager(t, Config{
+			MaxSizeBytes: 1024 * 1024,
+			DefaultTTL:   time.Hour,
+		})
+
+		pinned := validTestDigest("pinned")
+		kept := validTestDigest("kept")
+		pruned := validTestDigest("pruned")
+		for _, digest := range []string{pinned, kept, pruned} {
+			require.NoError(t, coordinator.PutBlob(ctx, digest, bytes.NewReader([]byte(digest))))
+		}
+		// A second store adds a reference that must not keep the data alive
+		require.NoError(t, coordinator.PutBlob(ctx, pruned, bytes.NewReader([]byte(pruned))))
+		require.NoError(t, coordinator.Pin(ctx, pinned))
+
+		entry, _ := coordinator.index.Get(pruned)
+		size := entry.Size
+
+		result, err := coordinator.Prune(ctx, PruneOptions{Keep: []string{kept}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Removed)
+		assert.Equal(t, size, result.FreedBytes)
+
+		_, err = coordinator.GetBlob(ctx, pruned)
+		assert.Error(t, err)
+		has, err := coordinator.blobCache.HasBlob(ctx, pruned)
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		for _, digest := range []string{pinned, kept} {
+			reader, err := coordinator.GetBlob(ctx, digest)
+			require.NoError(t, err)
+			reader.Close()
+		}
+	})
+
+	t.Run("respects older than", func(t *testing.T) {
+		coordinator := setupTestManager(t, Config{
+			MaxSizeBytes: 1024 * 1024,
+			DefaultTTL:   time.Hour,
+		})
+
+		stale := validTestDigest("stale")
+		fresh := validTestDigest("fresh")
+		require.NoError(t, coordinator.PutBlob(ctx, stale, bytes.NewReader([]byte("stale"))))
+		require.NoError(t, coordinator.PutBlob(ctx, fresh, bytes.NewReader([]byte("fresh"))))
+
+		entry, _ := coordinator.index.Get(stale)
+		entry.AccessedAt = time.Now().Add(-2 * time.Hour)
+
+		result, err := coordinator.Prune(ctx, PruneOptions{OlderThan: time.Hour})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Removed)
+
+		_, exists := coordinator.index.Get(stale)
+		assert.False(t, exists)
+		_, exists = coordinator.index.Get(fresh)
+		assert.True(t, exists)
+	})
+
+	t.Run("eviction skips pinned entries", func(t *testing.T) {
+		coordinator := setupTestManager(t, Config{
+			MaxSizeBytes: 100,
+			DefaultTTL:   time.Hour,
+		})
+
+		digests := make([]string, 0, 5)
+		for i := 0; i < 5; i++ {
+			digest := validTestDigest("evict")
+			require.NoError(t, coordinator.PutBlob(ctx, digest, bytes.NewReader([]byte("data"))))
+			require.NoError(t, coordinator.Pin(ctx, digest))
+			digests = append(digests, digest)
+		}
+
+		require.NoError(t, coordinator.performEviction(ctx))
+
+		for _, digest := range digests {
+			assert.True(t, coordinator.IsPinned(digest))
+		}
+	})
+}
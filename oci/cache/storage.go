@@ -508,6 +508,18 @@ func (sw *StreamWriter) Close() error {
 	return nil
 }
 
+// Abort discards the written data without creating the final file.
+func (sw *StreamWriter) Abort() {
+	_ = sw.file.Close()
+
+	sw.storage.globalLock.Lock()
+	_ = sw.storage.fs.RemoveAll(sw.tempDirName) // Ignore errors in cleanup
+	sw.storage.globalLock.Unlock()
+
+	lock := sw.storage.getFileLock(sw.finalPath)
+	lock.Unlock()
+}
+
 // Size returns the current size of the written data.
 func (sw *StreamWriter) Size() int64 {
 	return sw.size
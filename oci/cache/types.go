@@ -11,6 +11,12 @@ type Config struct {
 	MaxSizeBytes int64
 	// DefaultTTL is the default time-to-live for cache entries.
 	DefaultTTL time.Duration
+	// Eviction selects entries to evict when the cache exceeds MaxSizeBytes.
+	// If nil, a composite of LRU and size-based eviction is used.
+	Eviction EvictionStrategy
+	// FsckOnStartup runs Coordinator.Fsck when the coordinator is created,
+	// repairing entries damaged by interrupted writes before they are served.
+	FsckOnStartup bool
 }
 
 // Validate checks that the cache configuration is valid.
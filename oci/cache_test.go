@@ -0,0 +1,94 @@
+package ocibundle
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/jmgilman/go/oci/cache"
+)
+
+func newTestCoordinator(t *testing.T, cachePath string) *cache.Coordinator {
+	t.Helper()
+	coordinator, err := cache.NewCoordinator(context.Background(), cache.Config{
+		MaxSizeBytes: 1024 * 1024,
+		DefaultTTL:   time.Hour,
+	}, billy.NewMemory(), cachePath, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = coordinator.Close() })
+	return coordinator
+}
+
+func TestClient_CacheManagement(t *testing.T) {
+	ctx := context.Background()
+	const (
+		stable  = "example.com/repo:stable"
+		current = "example.com/repo:v2"
+		old     = "example.com/repo:v1"
+	)
+	digests := map[string]string{
+		stable:  "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+		current: "sha256:2222222222222222222222222222222222222222222222222222222222222222",
+		old:     "sha256:3333333333333333333333333333333333333333333333333333333333333333",
+	}
+
+	cachePath := t.TempDir()
+	coordinator := newTestCoordinator(t, cachePath)
+	for reference, digest := range digests {
+		require.NoError(t, coordinator.PutBlob(ctx, digest, bytes.NewReader([]byte(reference))))
+		require.NoError(t, coordinator.PutTagMapping(ctx, reference, digest))
+	}
+
+	client, err := NewWithOptions(WithCache(coordinator, cachePath, 0, 0))
+	require.NoError(t, err)
+
+	stats, err := client.CacheStats()
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Entries)
+	assert.Positive(t, stats.SizeBytes)
+
+	require.NoError(t, client.CachePin(stable))
+	stats, err = client.CacheStats()
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.PinnedEntries)
+
+	result, err := client.CachePrune(ctx, PruneOptions{KeepRefs: []string{"example.com/repo@" + digests[current]}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Removed)
+	assert.Positive(t, result.FreedBytes)
+
+	_, err = coordinator.GetBlob(ctx, digests[old])
+	assert.Error(t, err)
+	for _, reference := range []string{stable, current} {
+		reader, err := coordinator.GetBlob(ctx, digests[reference])
+		require.NoError(t, err)
+		_ = reader.Close()
+	}
+
+	require.NoError(t, client.CacheUnpin(stable))
+	result, err = client.CachePrune(ctx, PruneOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Removed)
+
+	t.Run("pin requires a cached reference", func(t *testing.T) {
+		err := client.CachePin("example.com/repo:unknown")
+		assert.ErrorContains(t, err, "is not cached")
+		assert.Error(t, client.CachePin(""))
+	})
+
+	t.Run("requires a cache", func(t *testing.T) {
+		client, err := New()
+		require.NoError(t, err)
+
+		_, err = client.CacheStats()
+		assert.ErrorContains(t, err, "cache not configured")
+		_, err = client.CachePrune(ctx, PruneOptions{})
+		assert.Error(t, err)
+		assert.Error(t, client.CachePin(stable))
+	})
+}
@@ -14,9 +14,10 @@ import (
 
 	"github.com/jmgilman/go/fs/billy"
 	"github.com/jmgilman/go/fs/core"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"oras.land/oras-go/v2/registry/remote"
 
-	"github.com/jmgilman/go/oci/internal/cache"
+	"github.com/jmgilman/go/oci/cache"
 	orasint "github.com/jmgilman/go/oci/internal/oras"
 )
 
@@ -31,7 +32,9 @@ const (
 func applyPushOptions(opts []PushOption) *PushOptions {
 	pushOpts := DefaultPushOptions()
 	for _, opt := range opts {
+		maxRetries, retryDelay := pushOpts.MaxRetries, pushOpts.RetryDelay
 		opt(pushOpts)
+		syncRetryFields(&pushOpts.Retry, &pushOpts.MaxRetries, &pushOpts.RetryDelay, maxRetries, retryDelay)
 	}
 	return pushOpts
 }
@@ -40,11 +43,46 @@ func applyPushOptions(opts []PushOption) *PushOptions {
 func applyPullOptions(opts []PullOption) *PullOptions {
 	pullOpts := DefaultPullOptions()
 	for _, opt := range opts {
+		maxRetries, retryDelay := pullOpts.MaxRetries, pullOpts.RetryDelay
 		opt(pullOpts)
+		syncRetryFields(&pullOpts.Retry, &pullOpts.MaxRetries, &pullOpts.RetryDelay, maxRetries, retryDelay)
 	}
 	return pullOpts
 }
 
+// syncRetryFields copies changes an option made to the deprecated MaxRetries
+// and RetryDelay fields, which held prevMax and prevDelay before it ran, into
+// policy, and then sets the fields from policy.
+func syncRetryFields(policy *RetryPolicy, maxRetries *int, retryDelay *time.Duration, prevMax int, prevDelay time.Duration) {
+	if *maxRetries != prevMax {
+		policy.MaxRetries = *maxRetries
+	}
+	if *retryDelay != prevDelay {
+		policy.InitialDelay = *retryDelay
+	}
+	*maxRetries = policy.MaxRetries
+	*retryDelay = policy.InitialDelay
+}
+
+// pullExtractOptions returns the extraction options derived from pull options.
+func pullExtractOptions(pullOpts *PullOptions) ExtractOptions {
+	return ExtractOptions{
+		MaxFiles:          pullOpts.MaxFiles,
+		MaxSize:           pullOpts.MaxSize,
+		MaxFileSize:       pullOpts.MaxFileSize,
+		AllowHiddenFiles:  pullOpts.AllowHiddenFiles,
+		StripPrefix:       pullOpts.StripPrefix,
+		PreservePerms:     pullOpts.PreservePermissions,
+		FilesToExtract:    pullOpts.FilesToExtract,
+		Filter:            pullOpts.ExtractFilter,
+		OnExtract:         pullOpts.OnExtract,
+		SymlinkPolicy:     pullOpts.SymlinkPolicy,
+		PreserveMtimes:    pullOpts.PreserveMtimes,
+		PreserveOwnership: pullOpts.PreserveOwnership,
+		PreserveXattrs:    pullOpts.PreserveXattrs,
+	}
+}
+
 // validatePushInputs validates inputs for push operations.
 func validatePushInputs(fsys core.FS, sourceDir, reference string) error {
 	if sourceDir == "" {
@@ -170,6 +208,25 @@ func validateClientOptions(opts *ClientOptions) error {
 		return fmt.Errorf("client options cannot be nil")
 	}
 
+	if opts.Archiver != nil && opts.Archiver.MediaType() == "" {
+		return fmt.Errorf("archiver media type cannot be empty")
+	}
+	if _, ok := opts.Archivers[""]; ok {
+		return fmt.Errorf("archiver media type cannot be empty")
+	}
+	if opts.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+	if opts.ResumableTransfers && (opts.CacheConfig == nil || opts.CacheConfig.CachePath == "") {
+		return fmt.Errorf("resumable transfers require a cache path")
+	}
+	if opts.LinkedExtraction && (opts.CacheConfig == nil || opts.CacheConfig.CachePath == "") {
+		return fmt.Errorf("linked extraction requires a cache path")
+	}
+	if opts.ChunkSize < 0 {
+		return fmt.Errorf("chunk size cannot be negative")
+	}
+
 	// Validate authentication options if present
 	if opts.Auth == nil {
 		return nil
@@ -198,72 +255,6 @@ func (c *Client) createRepository(ctx context.Context, reference string) (*remot
 	return repo, nil
 }
 
-// retryOperation retries a function with exponential backoff for network-related errors
-func retryOperation(ctx context.Context, maxRetries int, delay time.Duration, operation func() error) error {
-	var lastErr error
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if err := isDone(ctx, "retry operation"); err != nil {
-			return err
-		}
-
-		if attempt > 0 {
-			backoffDelay := delay * time.Duration(1<<(attempt-1))
-			select {
-			case <-ctx.Done():
-				return fmt.Errorf("context cancelled during retry: %w", ctx.Err())
-			case <-time.After(backoffDelay):
-			}
-		}
-
-		err := operation()
-		if err == nil {
-			return nil
-		}
-
-		lastErr = err
-
-		if !isRetryableError(err) {
-			break
-		}
-	}
-
-	return lastErr
-}
-
-// isRetryableError determines if an error should trigger a retry
-func isRetryableError(err error) bool {
-	if errors.Is(err, context.Canceled) {
-		return false
-	}
-
-	if errors.Is(err, context.DeadlineExceeded) {
-		return true
-	}
-
-	// Signature verification errors are not retryable because they are deterministic
-	// If a signature is invalid once, it will always be invalid
-	if errors.Is(err, ErrSignatureNotFound) ||
-		errors.Is(err, ErrSignatureInvalid) ||
-		errors.Is(err, ErrUntrustedSigner) ||
-		errors.Is(err, ErrRekorVerificationFailed) ||
-		errors.Is(err, ErrCertificateExpired) ||
-		errors.Is(err, ErrInvalidAnnotations) {
-		return false
-	}
-
-	// Check for other retryable network errors by examining the error string
-	// (netErr.Temporary() is deprecated since Go 1.18)
-
-	errStr := err.Error()
-	return strings.Contains(errStr, "connection refused") ||
-		strings.Contains(errStr, "connection reset") ||
-		strings.Contains(errStr, "timeout") ||
-		strings.Contains(errStr, "temporary failure") ||
-		strings.Contains(errStr, "service unavailable") ||
-		strings.Contains(errStr, "internal server error")
-}
-
 // Push uploads a directory as an OCI artifact to the specified reference.
 func (c *Client) Push(ctx context.Context, sourceDir, reference string, opts ...PushOption) error {
 	// Thread safety: use read lock since we're only reading options
@@ -281,11 +272,45 @@ func (c *Client) Push(ctx context.Context, sourceDir, reference string, opts ...
 		return repoErr
 	}
 
+	if len(pushOpts.PlatformVariants) > 0 {
+		return c.pushIndex(ctx, sourceDir, reference, pushOpts)
+	}
+	if pushOpts.LayerSplit != nil {
+		return c.pushLayers(ctx, sourceDir, reference, pushOpts)
+	}
+
+	archiver, err := c.pushArchiverFor(pushOpts)
+	if err != nil {
+		return err
+	}
+	return c.pushStaged(ctx, reference, archiver.MediaType(), pushOpts, c.observeArchive(ctx, reference, func(w io.Writer) error {
+		var archiveErr error
+		if pushOpts.ProgressCallback != nil {
+			archiveErr = archiver.ArchiveWithProgress(ctx, sourceDir, w, pushOpts.ProgressCallback)
+		} else {
+			archiveErr = archiver.Archive(ctx, sourceDir, w)
+		}
+		if archiveErr != nil {
+			return fmt.Errorf("failed to archive directory: %w", archiveErr)
+		}
+		return nil
+	}))
+}
+
+// pushStaged writes an archive into a temporary file with write and pushes it
+// as a single-layer artifact with the given media type.
+func (c *Client) pushStaged(
+	ctx context.Context,
+	reference string,
+	mediaType string,
+	pushOpts *PushOptions,
+	write func(w io.Writer) error,
+) error {
 	tempDir, tmpErr := c.createTempDir("ocibundle-push-")
 	if tmpErr != nil {
 		return fmt.Errorf("failed to create temporary directory: %w", tmpErr)
 	}
-	tempFilePath := filepath.Join(tempDir, "bundle.tar.gz")
+	tempFilePath := filepath.Join(tempDir, "bundle")
 	tempFile, openErr := c.options.FS.OpenFile(tempFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o600)
 	if openErr != nil {
 		return fmt.Errorf("failed to create temporary file: %w", openErr)
@@ -298,16 +323,8 @@ func (c *Client) Push(ctx context.Context, sourceDir, reference string, opts ...
 		}
 	}()
 
-	archiver := NewTarGzArchiverWithFS(c.options.FS)
-
-	var archiveErr error
-	if pushOpts.ProgressCallback != nil {
-		archiveErr = archiver.ArchiveWithProgress(ctx, sourceDir, tempFile, pushOpts.ProgressCallback)
-	} else {
-		archiveErr = archiver.Archive(ctx, sourceDir, tempFile)
-	}
-	if archiveErr != nil {
-		return fmt.Errorf("failed to archive directory: %w", archiveErr)
+	if err := write(tempFile); err != nil {
+		return err
 	}
 
 	stat, statErr := tempFile.Stat()
@@ -315,29 +332,115 @@ func (c *Client) Push(ctx context.Context, sourceDir, reference string, opts ...
 		return fmt.Errorf("failed to get file size: %w", statErr)
 	}
 
-	pushErr := retryOperation(ctx, pushOpts.MaxRetries, pushOpts.RetryDelay, func() error {
-		if seeker, ok := tempFile.(io.Seeker); ok {
+	if err := c.pushBlob(ctx, reference, mediaType, tempFile, stat.Size(), pushOpts); err != nil {
+		return err
+	}
+
+	cleanupNeeded = false
+	return nil
+}
+
+// pushBlob pushes size bytes of data as a single-layer artifact with retries
+// and signs it if a SignatureSigner is configured. Data is rewound before each
+// attempt if it implements io.Seeker.
+func (c *Client) pushBlob(
+	ctx context.Context,
+	reference string,
+	mediaType string,
+	data io.Reader,
+	size int64,
+	pushOpts *PushOptions,
+) error {
+	var manifestDigest string
+	uploadCtx, span := c.startPhase(ctx, PhaseUpload, reference)
+	pushErr := retryOperation(uploadCtx, pushOpts.Retry, func() error {
+		if seeker, ok := data.(io.Seeker); ok {
 			if _, seekErr := seeker.Seek(0, io.SeekStart); seekErr != nil {
-				return fmt.Errorf("failed to seek temporary file: %w", seekErr)
+				return fmt.Errorf("failed to seek archive: %w", seekErr)
 			}
 		}
 		desc := &orasint.PushDescriptor{
-			MediaType:   archiver.MediaType(),
-			Data:        tempFile,
-			Size:        stat.Size(),
+			MediaType:   mediaType,
+			Data:        data,
+			Size:        size,
 			Annotations: pushOpts.Annotations,
 			Platform:    pushOpts.Platform,
+			MountFrom:   pushOpts.MountFrom,
+			Resume:      c.resumeOptions(),
+			OnManifest: func(digest string) {
+				manifestDigest = digest
+			},
 		}
-		return c.orasClient.Push(ctx, reference, desc, c.options.Auth)
+		return c.orasClient.Push(uploadCtx, reference, desc, c.options.Auth)
 	})
+	span.end(size, pushErr)
 	if pushErr != nil {
-		return fmt.Errorf("failed to push artifact after %d retries: %w", pushOpts.MaxRetries, pushErr)
+		return fmt.Errorf("failed to push artifact after %d retries: %w", pushOpts.Retry.MaxRetries, pushErr)
+	}
+
+	if c.shouldSignArtifact(pushOpts) {
+		if err := c.signArtifact(ctx, reference, manifestDigest, pushOpts); err != nil {
+			return err
+		}
 	}
 
-	cleanupNeeded = false
 	return nil
 }
 
+// shouldSignArtifact checks if signing is enabled for a push.
+// Returns true if PushSigned passed a signer or a SignatureSigner is
+// configured in ClientOptions.
+func (c *Client) shouldSignArtifact(pushOpts *PushOptions) bool {
+	return c.signerFor(pushOpts) != nil
+}
+
+// signerFor returns the signer for a push: the one passed to PushSigned, or
+// the client's SignatureSigner.
+func (c *Client) signerFor(pushOpts *PushOptions) SignatureSigner {
+	if pushOpts.signer != nil {
+		return pushOpts.signer
+	}
+	return c.options.SignatureSigner
+}
+
+// signArtifact signs a pushed artifact using the push's signer.
+// The signature covers the digest of the pushed manifest, which is resolved
+// from the registry if the ORAS client did not report it, and the push
+// annotations are propagated into the signature payload with any
+// signature-specific annotations merged on top.
+func (c *Client) signArtifact(ctx context.Context, reference, manifestDigest string, pushOpts *PushOptions) error {
+	if manifestDigest == "" {
+		digest, err := c.resolveDigest(ctx, reference)
+		if err != nil {
+			return NewBundleError("sign", reference, fmt.Errorf("failed to resolve pushed manifest: %w", err))
+		}
+		manifestDigest = digest
+	}
+
+	if err := c.signerFor(pushOpts).Sign(ctx, reference, manifestDigest, signatureAnnotations(pushOpts)); err != nil {
+		return &BundleError{
+			Op:        "sign",
+			Reference: reference,
+			Err:       fmt.Errorf("failed to sign artifact: %w", err),
+		}
+	}
+
+	return nil
+}
+
+// signatureAnnotations merges push annotations with signature-specific annotations.
+// Signature-specific annotations take precedence on key conflicts.
+func signatureAnnotations(pushOpts *PushOptions) map[string]string {
+	annotations := make(map[string]string, len(pushOpts.Annotations)+len(pushOpts.SignatureAnnotations))
+	for k, v := range pushOpts.Annotations {
+		annotations[k] = v
+	}
+	for k, v := range pushOpts.SignatureAnnotations {
+		annotations[k] = v
+	}
+	return annotations
+}
+
 // Pull downloads and extracts an OCI artifact to the specified directory.
 // Supports selective extraction using glob patterns and enforces security validation.
 // If a SignatureVerifier is configured, signatures are verified before extraction.
@@ -357,50 +460,167 @@ func (c *Client) Pull(ctx context.Context, reference, targetDir string, opts ...
 		return repoErr
 	}
 
+	descriptor, err := c.fetchVerified(ctx, reference, pullOpts)
+	if err != nil {
+		return err
+	}
+	defer descriptor.Data.Close()
+
+	multiLayer := len(descriptor.Layers) > 1 || len(pullOpts.Layers) > 0
+
+	if multiLayer {
+		layers, err := selectLayers(descriptor.Layers, pullOpts.Layers)
+		if err != nil {
+			return err
+		}
+		pullOpts.progress = newPullProgress(pullOpts, layers)
+		return c.extractLayers(ctx, repo, descriptor, layers, targetDir, pullOpts)
+	}
+
+	pullOpts.progress = newPullProgress(pullOpts, pulledLayers(descriptor))
+	extractOpts := pullOpts.progress.extractOptions(descriptor.Digest, pullExtractOptions(pullOpts))
+
+	archiver := c.pullArchiver(descriptor.MediaType)
+
+	// Selective extraction uses the eStargz TOC, which only tar.gz bundles carry.
+	// Other archivers receive the patterns through ExtractOptions instead.
+	if _, isTarGz := archiver.(*TarGzArchiver); isTarGz && len(pullOpts.FilesToExtract) > 0 {
+		extractCtx, span := c.startPhase(ctx, PhaseExtract, reference)
+		span.event.Digest = descriptor.Digest
+		err := c.extractSelective(extractCtx, repo, descriptor, targetDir, pullOpts, extractOpts)
+		span.end(0, err)
+		return err
+	}
+
+	blob, err := c.fetchBlob(ctx, repo, descriptor.Data, orasint.LayerInfo{
+		MediaType: descriptor.MediaType,
+		Digest:    descriptor.Digest,
+		Size:      descriptor.Size,
+	}, pullOpts)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = blob.Close() }()
+
+	err = c.observeExtract(ctx, reference, descriptor.Digest, blob, func(ctx context.Context, data io.Reader) error {
+		return c.extractAtomically(ctx, archiver, data, targetDir, extractOpts)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to extract archive: %w", err)
+	}
+	return nil
+}
+
+// fetchVerified pulls the artifact descriptor for reference with retries and,
+// if a verifier is configured, verifies its signature. The caller must close
+// the returned descriptor's data.
+func (c *Client) fetchVerified(ctx context.Context, reference string, pullOpts *PullOptions) (*orasint.PullDescriptor, error) {
 	var descriptor *orasint.PullDescriptor
-	pullErr := retryOperation(ctx, pullOpts.MaxRetries, pullOpts.RetryDelay, func() error {
+	pullErr := retryOperation(ctx, pullOpts.Retry, func() error {
 		var err error
 		descriptor, err = c.orasClient.Pull(ctx, reference, c.options.Auth)
 		if err != nil {
 			return fmt.Errorf("failed to pull OCI artifact %s: %w", reference, err)
 		}
+		if descriptor.MediaType == ocispec.MediaTypeImageIndex {
+			descriptor, err = c.pullPlatform(ctx, reference, descriptor, pullOpts.Platform)
+			if err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 	if pullErr != nil {
-		return fmt.Errorf("failed to pull artifact after %d retries: %w", pullOpts.MaxRetries, pullErr)
+		return nil, fmt.Errorf("failed to pull artifact after %d retries: %w", pullOpts.Retry.MaxRetries, pullErr)
+	}
+
+	if err := checkExpectedDigest(reference, descriptor.ManifestDigest, pullOpts.ExpectedDigest); err != nil {
+		_ = descriptor.Data.Close()
+		return nil, err
 	}
 
 	// Verify signature before extraction if verifier is configured
 	// This ensures only cryptographically verified artifacts are written to disk
+	if c.shouldVerifySignature() && len(descriptor.Layers) > 1 {
+		_ = descriptor.Data.Close()
+		return nil, fmt.Errorf("signature verification is not supported for multi-layer bundles")
+	}
 	if c.shouldVerifySignature() {
-		if err := c.verifySignature(ctx, reference, descriptor); err != nil {
+		verifyCtx, span := c.startPhase(ctx, PhaseVerify, reference)
+		span.event.Digest = descriptor.Digest
+		err := c.verifySignature(verifyCtx, reference, descriptor)
+		span.end(0, err)
+		if err != nil {
 			// Close descriptor data on verification failure to prevent resource leak
 			_ = descriptor.Data.Close()
-			return fmt.Errorf("signature verification failed: %w", err)
+			return nil, fmt.Errorf("signature verification failed: %w", err)
 		}
 	}
 
-	defer descriptor.Data.Close()
+	return descriptor, nil
+}
 
-	extractOpts := ExtractOptions{
-		MaxFiles:         pullOpts.MaxFiles,
-		MaxSize:          pullOpts.MaxSize,
-		MaxFileSize:      pullOpts.MaxFileSize,
-		AllowHiddenFiles: pullOpts.AllowHiddenFiles,
-		StripPrefix:      pullOpts.StripPrefix,
-		PreservePerms:    pullOpts.PreservePermissions,
-		FilesToExtract:   pullOpts.FilesToExtract,
+// pulledLayers returns the layer read from a single-layer descriptor.
+func pulledLayers(descriptor *orasint.PullDescriptor) []orasint.LayerInfo {
+	if len(descriptor.Layers) > 0 {
+		return descriptor.Layers[:1]
 	}
+	return []orasint.LayerInfo{{
+		MediaType: descriptor.MediaType,
+		Digest:    descriptor.Digest,
+		Size:      descriptor.Size,
+	}}
+}
 
-	if len(pullOpts.FilesToExtract) > 0 {
-		return c.extractSelective(ctx, repo, descriptor, targetDir, pullOpts, extractOpts)
+// checkExpectedDigest fails with ErrDigestMismatch if expected is set and
+// reference resolved to a different manifest digest.
+func checkExpectedDigest(reference, manifestDigest, expected string) error {
+	if expected == "" || manifestDigest == expected {
+		return nil
 	}
+	return NewBundleError("pull", reference,
+		fmt.Errorf("%w: resolved to %s, expected %s", ErrDigestMismatch, manifestDigest, expected))
+}
 
-	archiver := NewTarGzArchiverWithFS(c.options.FS)
-	if err := c.extractAtomically(ctx, archiver, descriptor.Data, targetDir, extractOpts); err != nil {
-		return fmt.Errorf("failed to extract archive: %w", err)
+// pushArchiver returns the archiver used to create bundles on Push.
+func (c *Client) pushArchiver() Archiver {
+	if c.options.Archiver != nil {
+		return c.options.Archiver
 	}
-	return nil
+	return NewTarGzArchiverWithFS(c.options.FS)
+}
+
+// pushArchiverFor returns the archiver used to create bundles for a push,
+// honoring the push options' compression.
+func (c *Client) pushArchiverFor(pushOpts *PushOptions) (Archiver, error) {
+	if pushOpts.Compression == "" && pushOpts.Archive.isZero() {
+		return c.pushArchiver(), nil
+	}
+	if c.options.Archiver != nil {
+		return nil, fmt.Errorf("compression and archive options cannot be combined with a custom archiver")
+	}
+
+	switch pushOpts.Compression {
+	case "", CompressionGzip:
+		return NewTarGzArchiverWithOptions(c.options.FS, pushOpts.Archive), nil
+	case CompressionZstd:
+		return NewTarZstdArchiverWithOptions(c.options.FS, pushOpts.Archive), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", pushOpts.Compression)
+	}
+}
+
+// pullArchiver returns the archiver registered for a pulled layer's media type,
+// falling back to the built-in tar+zstd or tar.gz archivers for unregistered
+// or missing media types.
+func (c *Client) pullArchiver(mediaType string) Archiver {
+	if archiver, ok := c.options.Archivers[mediaType]; ok {
+		return archiver
+	}
+	if zstdArchiver := NewTarZstdArchiverWithFS(c.options.FS); mediaType == zstdArchiver.MediaType() {
+		return zstdArchiver
+	}
+	return NewTarGzArchiverWithFS(c.options.FS)
 }
 
 // shouldVerifySignature checks if signature verification is enabled for this client.
@@ -455,27 +675,43 @@ func (c *Client) verifySignature(ctx context.Context, reference string, descript
 }
 
 // extractSelective handles selective file extraction from OCI artifacts.
+// When the registry supports HTTP Range requests, only the eStargz footer, TOC,
+// and the chunks of matching files are downloaded. Otherwise, or if the blob is
+// not eStargz, the full blob is streamed and filtered during extraction.
 func (c *Client) extractSelective(ctx context.Context, repo *remote.Repository, descriptor *orasint.PullDescriptor, targetDir string, pullOpts *PullOptions, extractOpts ExtractOptions) error {
-	readerAt, blobSize, err := getBlobReaderAt(ctx, repo, descriptor.Digest, descriptor.Data, descriptor.Size)
-	if err != nil {
-		return err
+	archiver := NewTarGzArchiverWithFS(c.options.FS)
+
+	rangeReader, ok := openBlobRange(ctx, repo, descriptor.Digest, descriptor.Size)
+	if !ok {
+		data := pullOpts.progress.reader(ctx, descriptor.Digest, descriptor.Data)
+		if err := c.extractAtomically(ctx, archiver, data, targetDir, extractOpts); err != nil {
+			return fmt.Errorf("failed to extract archive: %w", err)
+		}
+		return nil
 	}
 
+	// Content is fetched by range from here on, so release the full download stream
+	_ = descriptor.Data.Close()
+
 	tempDir, tmpErr := c.createTempDir("ocibundle-selective-")
 	if tmpErr != nil {
 		return fmt.Errorf("failed to create temporary directory: %w", tmpErr)
 	}
 	defer func() { _ = c.removeAllFS(tempDir) }()
 
-	if err := extractSelectiveFromStargz(
+	err := extractSelectiveFromStargz(
 		ctx,
-		readerAt,
-		blobSize,
+		pullOpts.progress.readerAt(ctx, descriptor.Digest, rangeReader),
+		descriptor.Size,
 		tempDir,
 		pullOpts.FilesToExtract,
 		extractOpts,
 		c.options.FS,
-	); err != nil {
+	)
+	if errors.Is(err, errNotStargz) {
+		return c.extractSelectiveFull(ctx, repo, descriptor, archiver, targetDir, pullOpts, extractOpts)
+	}
+	if err != nil {
 		return fmt.Errorf("failed to extract selectively: %w", err)
 	}
 
@@ -483,7 +719,7 @@ func (c *Client) extractSelective(ctx context.Context, repo *remote.Repository,
 		return fmt.Errorf("failed to create target directory: %w", err)
 	}
 
-	if err := c.moveFiles(tempDir, targetDir); err != nil {
+	if err := c.moveExtracted(tempDir, targetDir, extractOpts); err != nil {
 		_ = c.removeAllFS(targetDir)
 		return fmt.Errorf("failed to move extracted files: %w", err)
 	}
@@ -491,6 +727,31 @@ func (c *Client) extractSelective(ctx context.Context, repo *remote.Repository,
 	return nil
 }
 
+// extractSelectiveFull downloads the full blob by digest and extracts the files
+// matching extractOpts.FilesToExtract. It is the fallback for blobs that cannot
+// be read lazily.
+func (c *Client) extractSelectiveFull(
+	ctx context.Context,
+	repo *remote.Repository,
+	descriptor *orasint.PullDescriptor,
+	archiver Archiver,
+	targetDir string,
+	pullOpts *PullOptions,
+	extractOpts ExtractOptions,
+) error {
+	_, data, err := repo.Blobs().FetchReference(ctx, descriptor.Digest)
+	if err != nil {
+		return fmt.Errorf("failed to fetch blob %s: %w", descriptor.Digest, err)
+	}
+	defer func() { _ = data.Close() }()
+
+	blob := pullOpts.progress.reader(ctx, descriptor.Digest, data)
+	if err := c.extractAtomically(ctx, archiver, blob, targetDir, extractOpts); err != nil {
+		return fmt.Errorf("failed to extract archive: %w", err)
+	}
+	return nil
+}
+
 // PullWithCache downloads and extracts an OCI artifact with caching support.
 func (c *Client) PullWithCache(ctx context.Context, reference, targetDir string, opts ...PullOption) error {
 	// Thread safety: use read lock since we're only reading options
@@ -513,15 +774,38 @@ func (c *Client) PullWithCache(ctx context.Context, reference, targetDir string,
 		return c.Pull(ctx, reference, targetDir, opts...)
 	}
 
-	digest, err := c.resolveTagWithCache(ctx, reference)
-	if err != nil {
-		return c.Pull(ctx, reference, targetDir, opts...)
+	// Cached tag mappings may be stale, so pinned digests are checked against
+	// the registry before the cache is consulted
+	if pullOpts.ExpectedDigest != "" {
+		manifestDigest, err := c.resolveDigest(ctx, reference)
+		if err != nil {
+			return err
+		}
+		if err := checkExpectedDigest(reference, manifestDigest, pullOpts.ExpectedDigest); err != nil {
+			return err
+		}
 	}
 
-	cacheKey := c.generateCacheKey(digest)
+	// Cache hits skip the registry, so artifacts that must be verified are
+	// only served from the cache after their manifest has been verified
+	if !c.shouldVerifySignature() {
+		digest, err := c.resolveTagWithCache(ctx, reference)
+		if err != nil {
+			return c.Pull(ctx, reference, targetDir, opts...)
+		}
 
-	if err := c.getFromCache(ctx, cacheKey, targetDir); err == nil {
-		return nil
+		if err := c.getFromCache(ctx, reference, c.generateCacheKey(digest), targetDir, pullOpts); err == nil {
+			return nil
+		}
+	}
+
+	// Cache the layer by digest, shared with every reference that contains it
+	if coordinator, ok := c.cache.(*cache.Coordinator); ok && c.options.LinkedExtraction {
+		if layerDigest, err := c.cacheLayer(ctx, coordinator, reference, pullOpts); err == nil {
+			if err := c.getFromCache(ctx, reference, c.generateCacheKey(layerDigest), targetDir, pullOpts); err == nil {
+				return nil
+			}
+		}
 	}
 
 	if err := c.Pull(ctx, reference, targetDir, opts...); err != nil {
@@ -628,13 +912,19 @@ func (c *Client) resolveTagDirect(ctx context.Context, reference string) (string
 	}
 	defer func() { _ = descriptor.Data.Close() }()
 
+	// Only single-layer bundles are cached, and the descriptor digest names
+	// just the first layer of a multi-layer bundle
+	if len(descriptor.Layers) > 1 {
+		return "", fmt.Errorf("multi-layer bundles are not cached")
+	}
+
 	// The descriptor includes the digest
 	return descriptor.Digest, nil
 }
 
 // getFromCache attempts to retrieve and extract from cache.
 // Returns nil on success, error on cache miss or extraction failure.
-func (c *Client) getFromCache(ctx context.Context, cacheKey, targetDir string) error {
+func (c *Client) getFromCache(ctx context.Context, reference, cacheKey, targetDir string, pullOpts *PullOptions) error {
 	if c.cache == nil {
 		return fmt.Errorf("cache not configured")
 	}
@@ -647,21 +937,50 @@ func (c *Client) getFromCache(ctx context.Context, cacheKey, targetDir string) e
 	// Extract digest from cache key (format: "blob:sha256:abc...")
 	digest := strings.TrimPrefix(cacheKey, "blob:")
 
+	ctx, span := c.startPhase(ctx, PhaseCache, reference)
+	span.event.Digest = digest
+
+	extractOpts := pullExtractOptions(pullOpts)
+	var storeDir string
+	if c.options.LinkedExtraction && linkableExtraction(extractOpts) {
+		var err error
+		if storeDir, err = c.layerStorePath(digest, extractOpts); err != nil {
+			span.end(0, nil)
+			return fmt.Errorf("cache miss: %w", err)
+		}
+
+		// Layers already extracted by another pull are only linked
+		if exists, existsErr := c.options.FS.Exists(storeDir); existsErr == nil && exists {
+			span.event.CacheHit = true
+			err := c.linkLayer(storeDir, targetDir)
+			span.end(0, err)
+			return err
+		}
+	}
+
 	// Try to get cached blob
 	blobReader, err := coordinator.GetBlob(ctx, digest)
 	if err != nil {
+		span.end(0, nil)
 		return fmt.Errorf("cache miss: %w", err)
 	}
 	defer func() { _ = blobReader.Close() }()
+	span.event.CacheHit = true
+	counter := &countingReader{r: blobReader}
 
-	// Create archiver for extraction
-	archiver := NewTarGzArchiverWithFS(c.options.FS)
+	// Cached blobs carry no media type, so extract them in the client's push format
+	archiver := c.pushArchiver()
 
-	// Extract cached blob to target directory with default options
-	extractOpts := ExtractOptions{
-		PreservePerms: true,
+	if storeDir != "" {
+		err = c.storeLayer(ctx, archiver, counter, storeDir, extractOpts)
+		if err == nil {
+			err = c.linkLayer(storeDir, targetDir)
+		}
+	} else {
+		err = c.extractAtomically(ctx, archiver, counter, targetDir, extractOpts)
 	}
-	if err := c.extractAtomically(ctx, archiver, blobReader, targetDir, extractOpts); err != nil {
+	span.end(counter.n, err)
+	if err != nil {
 		return fmt.Errorf("failed to extract cached blob: %w", err)
 	}
 
@@ -671,7 +990,7 @@ func (c *Client) getFromCache(ctx context.Context, cacheKey, targetDir string) e
 // extractAtomically performs atomic extraction with rollback on failure
 func (c *Client) extractAtomically(
 	ctx context.Context,
-	archiver *TarGzArchiver,
+	archiver Archiver,
 	data io.Reader,
 	targetDir string,
 	opts ExtractOptions,
@@ -694,7 +1013,7 @@ func (c *Client) extractAtomically(
 	}
 
 	// Move extracted files from temp directory to target directory
-	if err := c.moveFiles(tempDir, targetDir); err != nil {
+	if err := c.moveExtracted(tempDir, targetDir, opts); err != nil {
 		// Clean up any partially moved files
 		_ = c.removeAllFS(targetDir)
 		return fmt.Errorf("failed to move extracted files: %w", err)
@@ -703,6 +1022,31 @@ func (c *Client) extractAtomically(
 	return nil
 }
 
+// moveExtracted moves the files extracted into srcDir to dstDir. Moving
+// recreates directories, so the directory metadata restored by extraction is
+// captured first and restored again afterwards.
+func (c *Client) moveExtracted(srcDir, dstDir string, opts ExtractOptions) error {
+	metadata := newMetadataRestorer(c.options.FS, opts)
+	if !metadata.enabled() {
+		return c.moveFiles(srcDir, dstDir)
+	}
+
+	dirs, err := dirHeaders(c.options.FS, srcDir)
+	if err != nil {
+		return err
+	}
+	if err := c.moveFiles(srcDir, dstDir); err != nil {
+		return err
+	}
+
+	for relPath, header := range dirs {
+		if err := metadata.restore(filepath.Join(dstDir, relPath), header); err != nil {
+			return err
+		}
+	}
+	return metadata.finish()
+}
+
 // moveFiles moves all files from srcDir to dstDir
 func (c *Client) moveFiles(srcDir, dstDir string) error {
 	if err := c.options.FS.Walk(srcDir, func(path string, d fs.DirEntry, walkErr error) error {
@@ -779,11 +1123,17 @@ func (c *Client) removeAllFS(root string) error {
 // createTempDir creates a unique temporary directory using TempFS interface if available,
 // otherwise uses os.MkdirTemp for automatic unique naming.
 func (c *Client) createTempDir(pattern string) (string, error) {
+	return c.createTempDirIn("", pattern)
+}
+
+// createTempDirIn creates a unique temporary directory in dir, or in the
+// default temporary directory if dir is empty.
+func (c *Client) createTempDirIn(dir, pattern string) (string, error) {
 	if tfs, ok := c.options.FS.(core.TempFS); ok {
-		return tfs.TempDir("", pattern)
+		return tfs.TempDir(dir, pattern)
 	}
 	// Fallback: use os.MkdirTemp which handles uniqueness automatically
-	dir, err := os.MkdirTemp("", pattern)
+	dir, err := os.MkdirTemp(dir, pattern)
 	if err != nil {
 		return "", fmt.Errorf("failed to create temporary directory: %w", err)
 	}
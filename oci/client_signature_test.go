@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/jmgilman/go/oci/internal/oras"
+	"github.com/jmgilman/go/oci/internal/oras/mocks"
 	"github.com/jmgilman/go/oci/internal/testutil"
 )
 
@@ -426,3 +430,117 @@ func TestClient_RekorVerificationFailure(t *testing.T) {
 		t.Error("Expected ErrRekorVerificationFailed")
 	}
 }
+
+// callbackSigner is a SignatureSigner that delegates to a function for testing.
+type callbackSigner struct {
+	signFunc func(ctx context.Context, reference, digest string, annotations map[string]string) error
+}
+
+func (s *callbackSigner) Sign(ctx context.Context, reference, digest string, annotations map[string]string) error {
+	return s.signFunc(ctx, reference, digest, annotations)
+}
+
+// TestClient_PushPropagatesAnnotationsToSigner tests that push annotations reach the signature payload.
+func TestClient_PushPropagatesAnnotationsToSigner(t *testing.T) {
+	ctx := context.Background()
+
+	manifestDigest := "sha256:" + strings.Repeat("a", 64)
+	mockORAS := &mocks.ClientMock{
+		PushFunc: func(_ context.Context, _ string, desc *oras.PushDescriptor, _ *oras.AuthOptions) error {
+			desc.OnManifest(manifestDigest)
+			return nil
+		},
+	}
+
+	var gotDigest string
+	var gotAnnotations map[string]string
+	signer := &callbackSigner{
+		signFunc: func(_ context.Context, _ string, digest string, annotations map[string]string) error {
+			gotDigest = digest
+			gotAnnotations = annotations
+			return nil
+		},
+	}
+
+	client, err := NewWithOptions(
+		WithORASClient(mockORAS),
+		WithSignatureSigner(signer),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "test.txt"), []byte("test content"), 0o644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	err = client.Push(ctx, sourceDir, "ghcr.io/test/repo:tag",
+		WithAnnotations(map[string]string{
+			"build-id":   "12345",
+			"git-commit": "abc123",
+		}),
+		WithSignatureAnnotations(map[string]string{
+			"build-id": "override",
+			"signer":   "ci",
+		}),
+		WithMaxRetries(0),
+	)
+	if err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+
+	if gotDigest != manifestDigest {
+		t.Errorf("Expected manifest digest %q, got %q", manifestDigest, gotDigest)
+	}
+
+	expected := map[string]string{
+		"build-id":   "override",
+		"git-commit": "abc123",
+		"signer":     "ci",
+	}
+	for k, v := range expected {
+		if gotAnnotations[k] != v {
+			t.Errorf("Annotation %s = %q, want %q", k, gotAnnotations[k], v)
+		}
+	}
+}
+
+// TestClient_PushSignerFailure tests that signing failures are surfaced as BundleErrors.
+func TestClient_PushSignerFailure(t *testing.T) {
+	ctx := context.Background()
+
+	mockORAS := &mocks.ClientMock{
+		PushFunc: func(_ context.Context, _ string, desc *oras.PushDescriptor, _ *oras.AuthOptions) error {
+			desc.OnManifest("sha256:" + strings.Repeat("a", 64))
+			return nil
+		},
+	}
+	signer := &callbackSigner{
+		signFunc: func(_ context.Context, _, _ string, _ map[string]string) error {
+			return errors.New("signing key unavailable")
+		},
+	}
+
+	client, err := NewWithOptions(
+		WithORASClient(mockORAS),
+		WithSignatureSigner(signer),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "test.txt"), []byte("test content"), 0o644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	err = client.Push(ctx, sourceDir, "ghcr.io/test/repo:tag", WithMaxRetries(0))
+	var bundleErr *BundleError
+	if !errors.As(err, &bundleErr) {
+		t.Fatalf("Expected BundleError, got %v", err)
+	}
+	if bundleErr.Op != "sign" {
+		t.Errorf("Expected op 'sign', got %q", bundleErr.Op)
+	}
+}
@@ -236,6 +236,28 @@ func TestClient_Push_WithOptions(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to push artifact")
 }
 
+// TestClient_Push_WithMountFrom tests that mount sources reach the ORAS push descriptor
+func TestClient_Push_WithMountFrom(t *testing.T) {
+	var mountFrom []string
+	mockORAS := &mocks.ClientMock{
+		PushFunc: func(_ context.Context, _ string, descriptor *oras.PushDescriptor, _ *oras.AuthOptions) error {
+			mountFrom = descriptor.MountFrom
+			return nil
+		},
+	}
+	client, err := NewWithOptions(WithORASClient(mockORAS))
+	require.NoError(t, err)
+
+	sourceDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "test.txt"), []byte("test content"), 0o644))
+
+	err = client.Push(context.Background(), sourceDir, "ghcr.io/org/b:tag",
+		WithMountFrom("org/a"),
+		WithMountFrom("ghcr.io/org/c"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"org/a", "ghcr.io/org/c"}, mountFrom)
+}
+
 // TestClient_Push_ErrorHandling tests error handling and cleanup
 func TestClient_Push_ErrorHandling(t *testing.T) {
 	mockORAS := &mocks.ClientMock{
@@ -529,8 +551,8 @@ func TestClient_Pull_OptionsValidation(t *testing.T) {
 		assert.False(t, opts.AllowHiddenFiles)
 		assert.False(t, opts.PreservePermissions)
 		assert.Equal(t, "", opts.StripPrefix)
-		assert.Equal(t, 3, opts.MaxRetries)
-		assert.Equal(t, 2*time.Second, opts.RetryDelay)
+		assert.Equal(t, 3, opts.Retry.MaxRetries)
+		assert.Equal(t, 2*time.Second, opts.Retry.InitialDelay)
 	})
 
 	t.Run("custom options", func(t *testing.T) {
@@ -1067,6 +1089,47 @@ func TestClient_Pull_DescriptorDataClosedOnVerificationFailure(t *testing.T) {
 	assert.True(t, closeCalled, "descriptor.Data should be closed on verification failure")
 }
 
+// TestClient_Pull_ExpectedDigest tests that pulls pinned to a digest fail
+// before extraction when the reference resolves elsewhere.
+func TestClient_Pull_ExpectedDigest(t *testing.T) {
+	mockTarGzData, err := createMockTarGzData()
+	require.NoError(t, err)
+
+	closeCalled := false
+	mockORAS := &mocks.ClientMock{
+		PullFunc: func(_ context.Context, _ string, _ *oras.AuthOptions) (*oras.PullDescriptor, error) {
+			return &oras.PullDescriptor{
+				MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+				Data: &testReadCloserWithTracking{
+					data:        mockTarGzData,
+					closeCalled: &closeCalled,
+				},
+				Size:           int64(len(mockTarGzData)),
+				Digest:         "sha256:layer",
+				ManifestDigest: "sha256:manifest",
+			}, nil
+		},
+	}
+
+	client, err := NewWithOptions(WithORASClient(mockORAS))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	targetDir := filepath.Join(t.TempDir(), "mismatch")
+	err = client.Pull(ctx, "example.com/test/repo:tag", targetDir, WithExpectedDigest("sha256:other"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+	assert.True(t, closeCalled, "descriptor.Data should be closed on digest mismatch")
+	assert.NoDirExists(t, targetDir)
+
+	targetDir = filepath.Join(t.TempDir(), "match")
+	err = client.Pull(ctx, "example.com/test/repo:tag", targetDir, WithExpectedDigest("sha256:manifest"))
+	require.NoError(t, err)
+	entries, err := os.ReadDir(targetDir)
+	require.NoError(t, err)
+	assert.NotEmpty(t, entries)
+}
+
 // TestClient_Pull_SignatureErrorTypes tests different signature error types.
 func TestClient_Pull_SignatureErrorTypes(t *testing.T) {
 	testCases := []struct {
@@ -1184,3 +1247,148 @@ func (t *testReadCloserWithTracking) Close() error {
 	*t.closeCalled = true
 	return nil
 }
+
+// stubArchiver is a minimal Archiver that writes a fixed payload and extracts it
+// into a single file, so tests can tell which archiver handled a bundle.
+type stubArchiver struct {
+	mediaType string
+	fs        *billy.MemoryFS
+	extracts  int
+}
+
+func (a *stubArchiver) Archive(ctx context.Context, sourceDir string, output io.Writer) error {
+	return a.ArchiveWithProgress(ctx, sourceDir, output, nil)
+}
+
+func (a *stubArchiver) ArchiveWithProgress(_ context.Context, sourceDir string, output io.Writer, _ func(current, total int64)) error {
+	_, err := fmt.Fprintf(output, "%s:%s", a.mediaType, sourceDir)
+	return err
+}
+
+func (a *stubArchiver) Extract(_ context.Context, input io.Reader, targetDir string, _ ExtractOptions) error {
+	a.extracts++
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+	if err := a.fs.MkdirAll(targetDir, 0o755); err != nil {
+		return err
+	}
+	return a.fs.WriteFile(filepath.Join(targetDir, "payload"), data, 0o644)
+}
+
+func (a *stubArchiver) MediaType() string {
+	return a.mediaType
+}
+
+// TestClient_WithArchiver_PushAndPull tests that a custom archiver is used end-to-end.
+func TestClient_WithArchiver_PushAndPull(t *testing.T) {
+	mem := billy.NewMemory()
+	require.NoError(t, mem.MkdirAll("/src", 0o755))
+	require.NoError(t, mem.WriteFile("/src/hello.txt", []byte("hi"), 0o644))
+
+	archiver := &stubArchiver{mediaType: "application/vnd.example.layer.v1.zip", fs: mem}
+
+	var pushedType string
+	var pushed []byte
+	mockORAS := &mocks.ClientMock{
+		PushFunc: func(_ context.Context, _ string, descriptor *oras.PushDescriptor, _ *oras.AuthOptions) error {
+			pushedType = descriptor.MediaType
+			data, err := io.ReadAll(descriptor.Data)
+			pushed = data
+			return err
+		},
+		PullFunc: func(_ context.Context, _ string, _ *oras.AuthOptions) (*oras.PullDescriptor, error) {
+			return &oras.PullDescriptor{
+				MediaType: pushedType,
+				Data:      &mockReadCloserForTest{data: pushed},
+				Size:      int64(len(pushed)),
+			}, nil
+		},
+	}
+
+	client, err := NewWithOptions(WithORASClient(mockORAS), WithFilesystem(mem), WithArchiver(archiver))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, client.Push(ctx, "/src", "example.com/repo:tag"))
+	assert.Equal(t, archiver.MediaType(), pushedType)
+	assert.Equal(t, "application/vnd.example.layer.v1.zip:/src", string(pushed))
+
+	require.NoError(t, client.Pull(ctx, "example.com/repo:tag", "/dst"))
+	assert.Equal(t, 1, archiver.extracts)
+
+	b, err := mem.ReadFile("/dst/payload")
+	require.NoError(t, err)
+	assert.Equal(t, pushed, b)
+}
+
+// TestClient_WithArchiver_SelectsByMediaType tests archiver selection on pull.
+func TestClient_WithArchiver_SelectsByMediaType(t *testing.T) {
+	mockTarGzData, err := createMockTarGzData()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		mediaType string
+		data      []byte
+		wantZip   int
+		wantOther int
+		wantFile  string
+	}{
+		{
+			name:      "first registered archiver",
+			mediaType: "application/vnd.example.layer.v1.zip",
+			data:      []byte("zip"),
+			wantZip:   1,
+			wantFile:  "payload",
+		},
+		{
+			name:      "last registered archiver",
+			mediaType: "application/vnd.example.layer.v1.other",
+			data:      []byte("other"),
+			wantOther: 1,
+			wantFile:  "payload",
+		},
+		{
+			name:      "tar.gz fallback",
+			mediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+			data:      mockTarGzData,
+			wantFile:  "test.txt",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mem := billy.NewMemory()
+			zipArchiver := &stubArchiver{mediaType: "application/vnd.example.layer.v1.zip", fs: mem}
+			otherArchiver := &stubArchiver{mediaType: "application/vnd.example.layer.v1.other", fs: mem}
+
+			mockORAS := &mocks.ClientMock{
+				PullFunc: func(_ context.Context, _ string, _ *oras.AuthOptions) (*oras.PullDescriptor, error) {
+					return &oras.PullDescriptor{
+						MediaType: tc.mediaType,
+						Data:      &mockReadCloserForTest{data: tc.data},
+						Size:      int64(len(tc.data)),
+					}, nil
+				},
+			}
+
+			client, err := NewWithOptions(
+				WithORASClient(mockORAS),
+				WithFilesystem(mem),
+				WithArchiver(zipArchiver),
+				WithArchiver(otherArchiver),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, client.Pull(context.Background(), "example.com/repo:tag", "/dst"))
+			assert.Equal(t, tc.wantZip, zipArchiver.extracts)
+			assert.Equal(t, tc.wantOther, otherArchiver.extracts)
+
+			exists, err := mem.Exists(filepath.Join("/dst", tc.wantFile))
+			require.NoError(t, err)
+			assert.True(t, exists)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains registry-to-registry artifact copying.
+package ocibundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote"
+
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// cosignTagSuffixes are the tag suffixes cosign uses to attach signatures,
+// attestations, and SBOMs to a manifest under "<algorithm>-<hex>".
+var cosignTagSuffixes = []string{".sig", ".att", ".sbom"}
+
+// Copy copies the artifact at srcRef to dstRef, streaming manifests and blobs
+// from the source registry to the destination without extracting them.
+// Blobs already present in the destination are skipped, and blobs in the same
+// registry are mounted instead of re-uploaded when the registry supports it.
+//
+// If dstRef has no tag or digest, the artifact is copied by digest only.
+// Use WithDigestPin to guarantee the promoted artifact is the one that was
+// tested, and WithIncludeSignatures to copy its signatures and attestations.
+func (c *Client) Copy(ctx context.Context, srcRef, dstRef string, opts ...CopyOption) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if srcRef == "" {
+		return fmt.Errorf("source reference cannot be empty")
+	}
+	if dstRef == "" {
+		return fmt.Errorf("destination reference cannot be empty")
+	}
+
+	copyOpts := DefaultCopyOptions()
+	for _, opt := range opts {
+		opt(copyOpts)
+	}
+
+	_, srcPart, _ := splitReference(srcRef)
+	if srcPart == "" {
+		return fmt.Errorf("source reference must include a tag or digest")
+	}
+	_, dstPart, dstIsDigest := splitReference(dstRef)
+
+	srcRepo, err := orasint.NewRepository(ctx, srcRef, c.options.Auth)
+	if err != nil {
+		return fmt.Errorf("failed to create source repository: %w", err)
+	}
+	dstRepo, err := orasint.NewRepository(ctx, dstRef, c.options.Auth)
+	if err != nil {
+		return fmt.Errorf("failed to create destination repository: %w", err)
+	}
+
+	desc, err := srcRepo.Resolve(ctx, srcPart)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", srcRef, err)
+	}
+	srcDigest := desc.Digest.String()
+
+	if copyOpts.DigestPin != "" && srcDigest != copyOpts.DigestPin {
+		return fmt.Errorf("%s resolved to %s, expected pinned digest %s", srcRef, srcDigest, copyOpts.DigestPin)
+	}
+	if dstPart == "" {
+		dstPart = srcDigest
+	} else if dstIsDigest && dstPart != srcDigest {
+		return fmt.Errorf("destination digest %s does not match source digest %s", dstPart, srcDigest)
+	}
+
+	// Copy by digest so a tag moved after resolution cannot change what is copied
+	graphOpts := copyGraphOptions(srcRepo, dstRepo)
+	if copyOpts.IncludeSignatures {
+		extendedOpts := oras.DefaultExtendedCopyOptions
+		extendedOpts.ExtendedCopyGraphOptions.CopyGraphOptions = graphOpts
+		if _, err := oras.ExtendedCopy(ctx, srcRepo, srcDigest, dstRepo, dstPart, extendedOpts); err != nil {
+			return fmt.Errorf("failed to copy %s with referrers: %w", srcRef, err)
+		}
+		return copyCosignArtifacts(ctx, srcRepo, dstRepo, desc, graphOpts)
+	}
+
+	copyOptions := oras.DefaultCopyOptions
+	copyOptions.CopyGraphOptions = graphOpts
+	if _, err := oras.Copy(ctx, srcRepo, srcDigest, dstRepo, dstPart, copyOptions); err != nil {
+		return fmt.Errorf("failed to copy %s: %w", srcRef, err)
+	}
+
+	return nil
+}
+
+// copyCosignArtifacts copies the cosign signature, attestation, and SBOM tags
+// of desc, skipping those that do not exist in the source repository.
+func copyCosignArtifacts(
+	ctx context.Context,
+	srcRepo, dstRepo *remote.Repository,
+	desc ocispec.Descriptor,
+	graphOpts oras.CopyGraphOptions,
+) error {
+	prefix := strings.Replace(desc.Digest.String(), ":", "-", 1)
+	copyOptions := oras.DefaultCopyOptions
+	copyOptions.CopyGraphOptions = graphOpts
+
+	for _, suffix := range cosignTagSuffixes {
+		tag := prefix + suffix
+		if _, err := srcRepo.Resolve(ctx, tag); err != nil {
+			if errors.Is(err, errdef.ErrNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed to resolve %s: %w", tag, err)
+		}
+		if _, err := oras.Copy(ctx, srcRepo, tag, dstRepo, tag, copyOptions); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// copyGraphOptions returns the graph copy options for copying from srcRepo to
+// dstRepo. Blobs are mounted from the source repository when both
+// repositories are in the same registry.
+func copyGraphOptions(srcRepo, dstRepo *remote.Repository) oras.CopyGraphOptions {
+	opts := oras.DefaultCopyGraphOptions
+	if srcRepo.Reference.Registry == dstRepo.Reference.Registry &&
+		srcRepo.Reference.Repository != dstRepo.Reference.Repository {
+		opts.MountFrom = func(context.Context, ocispec.Descriptor) ([]string, error) {
+			return []string{srcRepo.Reference.Repository}, nil
+		}
+	}
+	return opts
+}
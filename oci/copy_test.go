@@ -0,0 +1,300 @@
+package ocibundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCopyRegistry is a minimal in-memory registry supporting the endpoints
+// used when copying artifacts: manifests, blobs, uploads, and mounts. It does
+// not implement the referrers API, so clients fall back to the tag schema.
+type fakeCopyRegistry struct {
+	mu        sync.Mutex
+	host      string
+	blobs     map[string]map[digest.Digest][]byte // repository -> blobs
+	manifests map[string]map[string][]byte        // repository -> tag or digest -> manifest
+	uploads   int
+	mounts    int
+}
+
+func newFakeCopyRegistry(t *testing.T) *fakeCopyRegistry {
+	t.Helper()
+
+	reg := &fakeCopyRegistry{
+		blobs:     make(map[string]map[digest.Digest][]byte),
+		manifests: make(map[string]map[string][]byte),
+	}
+	server := httptest.NewServer(http.HandlerFunc(reg.serveHTTP))
+	t.Cleanup(server.Close)
+	reg.host = strings.TrimPrefix(server.URL, "http://")
+
+	return reg
+}
+
+// putArtifact stores a manifest with one layer in repository under tag and
+// returns the manifest digest.
+func (r *fakeCopyRegistry) putArtifact(t *testing.T, repository, tag, content string) digest.Digest {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.blobs[repository] == nil {
+		r.blobs[repository] = make(map[digest.Digest][]byte)
+		r.manifests[repository] = make(map[string][]byte)
+	}
+
+	layer := []byte(content)
+	r.blobs[repository][digest.FromBytes(layer)] = layer
+	r.blobs[repository][ocispec.DescriptorEmptyJSON.Digest] = ocispec.DescriptorEmptyJSON.Data
+
+	manifest, err := json.Marshal(ocispec.Manifest{
+		MediaType:    ocispec.MediaTypeImageManifest,
+		ArtifactType: "application/vnd.catalyst.bundle.v1",
+		Config:       ocispec.DescriptorEmptyJSON,
+		Layers: []ocispec.Descriptor{{
+			MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+			Digest:    digest.FromBytes(layer),
+			Size:      int64(len(layer)),
+		}},
+	})
+	require.NoError(t, err)
+
+	dgst := digest.FromBytes(manifest)
+	r.manifests[repository][dgst.String()] = manifest
+	r.manifests[repository][tag] = manifest
+	return dgst
+}
+
+func (r *fakeCopyRegistry) manifest(repository, reference string) ([]byte, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	manifest, ok := r.manifests[repository][reference]
+	return manifest, ok
+}
+
+func (r *fakeCopyRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	switch {
+	case strings.Contains(path, "/manifests/"):
+		repository, ref, _ := strings.Cut(path, "/manifests/")
+		r.serveManifest(w, req, repository, ref)
+	case strings.HasSuffix(path, "/blobs/uploads/"):
+		repository := strings.TrimSuffix(path, "/blobs/uploads/")
+		if from := req.URL.Query().Get("from"); from != "" {
+			dgst := digest.Digest(req.URL.Query().Get("mount"))
+			if data, ok := r.blobs[from][dgst]; ok {
+				r.storeBlob(repository, dgst, data)
+				r.mounts++
+				w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/%s", repository, dgst))
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+		}
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/session", repository))
+		w.WriteHeader(http.StatusAccepted)
+	case strings.HasSuffix(path, "/blobs/uploads/session"):
+		repository := strings.TrimSuffix(path, "/blobs/uploads/session")
+		data, _ := io.ReadAll(req.Body)
+		dgst := digest.Digest(req.URL.Query().Get("digest"))
+		r.storeBlob(repository, dgst, data)
+		r.uploads++
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/%s", repository, dgst))
+		w.WriteHeader(http.StatusCreated)
+	case strings.Contains(path, "/blobs/"):
+		repository, ref, _ := strings.Cut(path, "/blobs/")
+		data, ok := r.blobs[repository][digest.Digest(ref)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Docker-Content-Digest", ref)
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		// Includes the referrers API, which falls back to the tag schema
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (r *fakeCopyRegistry) serveManifest(w http.ResponseWriter, req *http.Request, repository, ref string) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		manifest, ok := r.manifests[repository][ref]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", manifestMediaType(manifest))
+		w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+		w.Header().Set("Docker-Content-Digest", digest.FromBytes(manifest).String())
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(manifest)
+		}
+	case http.MethodPut:
+		manifest, _ := io.ReadAll(req.Body)
+		if r.manifests[repository] == nil {
+			r.manifests[repository] = make(map[string][]byte)
+		}
+		dgst := digest.FromBytes(manifest)
+		r.manifests[repository][dgst.String()] = manifest
+		r.manifests[repository][ref] = manifest
+		w.Header().Set("Docker-Content-Digest", dgst.String())
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		if _, ok := r.manifests[repository][ref]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(r.manifests[repository], ref)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// manifestMediaType returns the media type declared by a stored manifest,
+// defaulting to an image manifest.
+func manifestMediaType(manifest []byte) string {
+	var versioned struct {
+		MediaType string `json:"mediaType"`
+	}
+	if err := json.Unmarshal(manifest, &versioned); err != nil || versioned.MediaType == "" {
+		return ocispec.MediaTypeImageManifest
+	}
+	return versioned.MediaType
+}
+
+func (r *fakeCopyRegistry) storeBlob(repository string, dgst digest.Digest, data []byte) {
+	if r.blobs[repository] == nil {
+		r.blobs[repository] = make(map[digest.Digest][]byte)
+	}
+	r.blobs[repository][dgst] = data
+}
+
+func TestClient_Copy(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := NewWithOptions(WithAllowHTTP())
+	require.NoError(t, err)
+
+	t.Run("copies between registries", func(t *testing.T) {
+		staging := newFakeCopyRegistry(t)
+		prod := newFakeCopyRegistry(t)
+		dgst := staging.putArtifact(t, "org/app", "v1", "bundle")
+
+		err := client.Copy(ctx, staging.host+"/org/app:v1", prod.host+"/org/app:v1")
+		require.NoError(t, err)
+
+		manifest, ok := prod.manifest("org/app", "v1")
+		require.True(t, ok)
+		assert.Equal(t, dgst, digest.FromBytes(manifest))
+		assert.Equal(t, 2, prod.uploads)
+	})
+
+	t.Run("mounts blobs within a registry", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		dgst := reg.putArtifact(t, "staging/app", "v1", "bundle")
+
+		err := client.Copy(ctx, reg.host+"/staging/app:v1", reg.host+"/prod/app:v1")
+		require.NoError(t, err)
+
+		_, ok := reg.manifest("prod/app", dgst.String())
+		assert.True(t, ok)
+		assert.Equal(t, 2, reg.mounts)
+		assert.Zero(t, reg.uploads)
+	})
+
+	t.Run("copies by digest without destination tag", func(t *testing.T) {
+		staging := newFakeCopyRegistry(t)
+		prod := newFakeCopyRegistry(t)
+		dgst := staging.putArtifact(t, "org/app", "v1", "bundle")
+
+		require.NoError(t, client.Copy(ctx, staging.host+"/org/app:v1", prod.host+"/org/app"))
+
+		_, ok := prod.manifest("org/app", dgst.String())
+		assert.True(t, ok)
+		_, ok = prod.manifest("org/app", "v1")
+		assert.False(t, ok)
+	})
+
+	t.Run("copies signatures", func(t *testing.T) {
+		staging := newFakeCopyRegistry(t)
+		prod := newFakeCopyRegistry(t)
+		dgst := staging.putArtifact(t, "org/app", "v1", "bundle")
+		sigTag := strings.Replace(dgst.String(), ":", "-", 1) + ".sig"
+		staging.putArtifact(t, "org/app", sigTag, "signature")
+
+		err := client.Copy(ctx, staging.host+"/org/app:v1", prod.host+"/org/app:v1", WithIncludeSignatures(true))
+		require.NoError(t, err)
+
+		_, ok := prod.manifest("org/app", "v1")
+		assert.True(t, ok)
+		_, ok = prod.manifest("org/app", sigTag)
+		assert.True(t, ok)
+	})
+
+	t.Run("skips signatures by default", func(t *testing.T) {
+		staging := newFakeCopyRegistry(t)
+		prod := newFakeCopyRegistry(t)
+		dgst := staging.putArtifact(t, "org/app", "v1", "bundle")
+		sigTag := strings.Replace(dgst.String(), ":", "-", 1) + ".sig"
+		staging.putArtifact(t, "org/app", sigTag, "signature")
+
+		require.NoError(t, client.Copy(ctx, staging.host+"/org/app:v1", prod.host+"/org/app:v1"))
+
+		_, ok := prod.manifest("org/app", sigTag)
+		assert.False(t, ok)
+	})
+
+	t.Run("enforces digest pin", func(t *testing.T) {
+		staging := newFakeCopyRegistry(t)
+		prod := newFakeCopyRegistry(t)
+		dgst := staging.putArtifact(t, "org/app", "v1", "bundle")
+
+		err := client.Copy(ctx, staging.host+"/org/app:v1", prod.host+"/org/app:v1",
+			WithDigestPin(digest.FromString("other").String()))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected pinned digest")
+		_, ok := prod.manifest("org/app", "v1")
+		assert.False(t, ok)
+
+		err = client.Copy(ctx, staging.host+"/org/app:v1", prod.host+"/org/app:v1", WithDigestPin(dgst.String()))
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects mismatched destination digest", func(t *testing.T) {
+		staging := newFakeCopyRegistry(t)
+		staging.putArtifact(t, "org/app", "v1", "bundle")
+
+		err := client.Copy(ctx, staging.host+"/org/app:v1", staging.host+"/prod/app@"+digest.FromString("other").String())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not match source digest")
+	})
+
+	t.Run("validates references", func(t *testing.T) {
+		require.Error(t, client.Copy(ctx, "", "localhost:5000/org/app:v1"))
+		require.Error(t, client.Copy(ctx, "localhost:5000/org/app:v1", ""))
+
+		err := client.Copy(ctx, "localhost:5000/org/app", "localhost:5000/prod/app:v1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "source reference must include a tag or digest")
+	})
+}
@@ -0,0 +1,138 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains credential helpers and the Docker keychain.
+package ocibundle
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+)
+
+// credentialExpirySkew is how long before expiry a helper credential is
+// refreshed. It covers the time the ORAS layer caches credentials for.
+const credentialExpirySkew = 5 * time.Minute
+
+// RegistryCredential is a credential issued by a CredentialHelper.
+type RegistryCredential struct {
+	// Username is the registry username.
+	Username string
+
+	// Password is the registry password or token.
+	Password string
+
+	// ExpiresAt is when the credential stops being valid. Zero means the
+	// credential does not expire.
+	ExpiresAt time.Time
+}
+
+// CredentialHelper issues short-lived credentials for the registries of a
+// cloud provider. Implementations must be safe for concurrent use.
+type CredentialHelper interface {
+	// Supports reports whether the helper issues credentials for registry,
+	// given as a host with an optional port.
+	Supports(registry string) bool
+
+	// Credential returns a credential for registry.
+	Credential(ctx context.Context, registry string) (RegistryCredential, error)
+}
+
+// WithCredentialHelpers authenticates to registries with the first helper
+// that supports them, caching issued credentials until shortly before they
+// expire. Registries no helper supports use the Docker keychain:
+//
+//	client, err := ocibundle.NewWithOptions(ocibundle.WithCredentialHelpers(
+//	    &ocibundle.ECRCredentialHelper{},
+//	    &ocibundle.GCRCredentialHelper{},
+//	    &ocibundle.ACRCredentialHelper{},
+//	))
+//
+// Like WithCredentialFunc, this overrides any other credential configuration.
+func WithCredentialHelpers(helpers ...CredentialHelper) ClientOption {
+	return WithCredentialFunc(newHelperCredentials(helpers, dockerKeychain()).credential)
+}
+
+// WithDockerKeychain authenticates with the credentials stored by docker
+// login, read from the Docker config file and the credential helpers it
+// configures, such as osxkeychain or pass.
+func WithDockerKeychain() ClientOption {
+	return WithCredentialFunc(dockerKeychain())
+}
+
+// dockerKeychain returns a credential function backed by the Docker
+// credential store. The store is loaded on first use.
+func dockerKeychain() auth.CredentialFunc {
+	loadStore := sync.OnceValues(func() (credentials.Store, error) {
+		return credentials.NewStoreFromDocker(credentials.StoreOptions{})
+	})
+	return func(ctx context.Context, registry string) (auth.Credential, error) {
+		store, err := loadStore()
+		if err != nil {
+			return auth.EmptyCredential, fmt.Errorf("failed to load docker credential store: %w", err)
+		}
+		return credentials.Credential(store)(ctx, registry)
+	}
+}
+
+// helperCredentials resolves registry credentials through credential
+// helpers and caches them by registry.
+type helperCredentials struct {
+	helpers  []CredentialHelper
+	fallback auth.CredentialFunc
+	now      func() time.Time
+
+	mu     sync.Mutex
+	cached map[string]RegistryCredential
+}
+
+// newHelperCredentials creates helperCredentials using fallback for
+// registries no helper supports.
+func newHelperCredentials(helpers []CredentialHelper, fallback auth.CredentialFunc) *helperCredentials {
+	return &helperCredentials{
+		helpers:  helpers,
+		fallback: fallback,
+		now:      time.Now,
+		cached:   make(map[string]RegistryCredential),
+	}
+}
+
+// credential implements auth.CredentialFunc.
+func (h *helperCredentials) credential(ctx context.Context, registry string) (auth.Credential, error) {
+	var helper CredentialHelper
+	for _, candidate := range h.helpers {
+		if candidate.Supports(registry) {
+			helper = candidate
+			break
+		}
+	}
+	if helper == nil {
+		if h.fallback == nil {
+			return auth.EmptyCredential, nil
+		}
+		return h.fallback(ctx, registry)
+	}
+
+	h.mu.Lock()
+	cred, ok := h.cached[registry]
+	h.mu.Unlock()
+	if !ok || h.expired(cred) {
+		var err error
+		cred, err = helper.Credential(ctx, registry)
+		if err != nil {
+			return auth.EmptyCredential, fmt.Errorf("failed to get credentials for %s: %w", registry, err)
+		}
+		h.mu.Lock()
+		h.cached[registry] = cred
+		h.mu.Unlock()
+	}
+
+	return auth.Credential{Username: cred.Username, Password: cred.Password}, nil
+}
+
+// expired reports whether cred must be refreshed.
+func (h *helperCredentials) expired(cred RegistryCredential) bool {
+	return !cred.ExpiresAt.IsZero() && !h.now().Add(credentialExpirySkew).Before(cred.ExpiresAt)
+}
@@ -0,0 +1,292 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains the credential helpers for ECR, GCR/Artifact Registry, and ACR.
+package ocibundle
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+)
+
+// ecrRegistryPattern matches ECR registry hosts and captures the FIPS suffix
+// and the region.
+var ecrRegistryPattern = regexp.MustCompile(`^\d{12}\.dkr\.ecr(-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?$`)
+
+// ECRCredentialHelper issues credentials for Amazon ECR registries by
+// exchanging AWS credentials for an ECR authorization token.
+type ECRCredentialHelper struct {
+	// Credentials provides the AWS credentials used to request tokens. If
+	// nil, the AWS SDK default credential chain is used: environment
+	// variables, shared config and credentials files, SSO, web identity, and
+	// ECS or EC2 instance roles.
+	Credentials aws.CredentialsProvider
+
+	// HTTPClient is used for token requests. Defaults to the AWS SDK client.
+	HTTPClient *http.Client
+}
+
+// Supports reports whether registry is an ECR registry.
+func (h *ECRCredentialHelper) Supports(registry string) bool {
+	return ecrRegistryPattern.MatchString(registryHost(registry))
+}
+
+// Credential requests an ECR authorization token for registry.
+func (h *ECRCredentialHelper) Credential(ctx context.Context, registry string) (RegistryCredential, error) {
+	match := ecrRegistryPattern.FindStringSubmatch(registryHost(registry))
+	if match == nil {
+		return RegistryCredential{}, fmt.Errorf("not an ECR registry: %s", registry)
+	}
+
+	loadOpts := []func(*config.LoadOptions) error{config.WithRegion(match[2])}
+	if match[1] != "" {
+		loadOpts = append(loadOpts, config.WithUseFIPSEndpoint(aws.FIPSEndpointStateEnabled))
+	}
+	if h.Credentials != nil {
+		loadOpts = append(loadOpts, config.WithCredentialsProvider(h.Credentials))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, loadOpts...)
+	if err != nil {
+		return RegistryCredential{}, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+
+	client := ecr.NewFromConfig(cfg, func(o *ecr.Options) {
+		if h.HTTPClient != nil {
+			o.HTTPClient = h.HTTPClient
+		}
+	})
+	resp, err := client.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err != nil {
+		return RegistryCredential{}, fmt.Errorf("failed to get ECR authorization token: %w", err)
+	}
+	if len(resp.AuthorizationData) == 0 {
+		return RegistryCredential{}, fmt.Errorf("ECR returned no authorization data")
+	}
+
+	data := resp.AuthorizationData[0]
+	decoded, err := base64.StdEncoding.DecodeString(aws.ToString(data.AuthorizationToken))
+	if err != nil {
+		return RegistryCredential{}, fmt.Errorf("failed to decode ECR authorization token: %w", err)
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return RegistryCredential{}, fmt.Errorf("malformed ECR authorization token")
+	}
+	return RegistryCredential{
+		Username:  username,
+		Password:  password,
+		ExpiresAt: aws.ToTime(data.ExpiresAt),
+	}, nil
+}
+
+// GCRCredentialHelper issues credentials for Google Container Registry and
+// Artifact Registry from a Google OAuth2 access token.
+type GCRCredentialHelper struct {
+	// TokenFunc returns an access token and its expiry. If nil, the token of
+	// the default service account is requested from the GCE metadata server.
+	TokenFunc func(ctx context.Context) (string, time.Time, error)
+
+	// HTTPClient is used for metadata server requests. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Supports reports whether registry is a GCR or Artifact Registry registry.
+func (h *GCRCredentialHelper) Supports(registry string) bool {
+	host := registryHost(registry)
+	return host == "gcr.io" || strings.HasSuffix(host, ".gcr.io") || strings.HasSuffix(host, "-docker.pkg.dev")
+}
+
+// Credential returns an access token credential for registry.
+func (h *GCRCredentialHelper) Credential(ctx context.Context, _ string) (RegistryCredential, error) {
+	tokenFunc := h.TokenFunc
+	if tokenFunc == nil {
+		tokenFunc = h.metadataToken
+	}
+	token, expiresAt, err := tokenFunc(ctx)
+	if err != nil {
+		return RegistryCredential{}, fmt.Errorf("failed to get Google access token: %w", err)
+	}
+	return RegistryCredential{Username: "oauth2accesstoken", Password: token, ExpiresAt: expiresAt}, nil
+}
+
+// metadataToken requests an access token from the GCE metadata server.
+func (h *GCRCredentialHelper) metadataToken(ctx context.Context) (string, time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token", nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := doJSON(h.HTTPClient, req, &resp); err != nil {
+		return "", time.Time{}, err
+	}
+	return resp.AccessToken, time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second), nil
+}
+
+// acrUsername is the username ACR expects with refresh tokens.
+const acrUsername = "00000000-0000-0000-0000-000000000000"
+
+// ACRCredentialHelper issues credentials for Azure Container Registry by
+// exchanging a Microsoft Entra ID access token for an ACR refresh token.
+type ACRCredentialHelper struct {
+	// TokenFunc returns an Entra ID access token for the Azure Resource
+	// Manager and its expiry. If nil, a managed identity token is requested
+	// from the Azure instance metadata service.
+	TokenFunc func(ctx context.Context) (string, time.Time, error)
+
+	// ClientID selects a user-assigned managed identity when TokenFunc is
+	// nil. Empty uses the system-assigned identity.
+	ClientID string
+
+	// HTTPClient is used for token requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Supports reports whether registry is an ACR registry.
+func (h *ACRCredentialHelper) Supports(registry string) bool {
+	host := registryHost(registry)
+	for _, suffix := range []string{".azurecr.io", ".azurecr.cn", ".azurecr.us"} {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Credential exchanges an Entra ID access token for an ACR refresh token.
+func (h *ACRCredentialHelper) Credential(ctx context.Context, registry string) (RegistryCredential, error) {
+	tokenFunc := h.TokenFunc
+	if tokenFunc == nil {
+		tokenFunc = h.managedIdentityToken
+	}
+	accessToken, expiresAt, err := tokenFunc(ctx)
+	if err != nil {
+		return RegistryCredential{}, fmt.Errorf("failed to get Entra ID access token: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type":   {"access_token"},
+		"service":      {registry},
+		"access_token": {accessToken},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		"https://"+registry+"/oauth2/exchange", strings.NewReader(form.Encode()))
+	if err != nil {
+		return RegistryCredential{}, fmt.Errorf("failed to create token exchange request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := doJSON(h.HTTPClient, req, &resp); err != nil {
+		return RegistryCredential{}, fmt.Errorf("failed to exchange ACR refresh token: %w", err)
+	}
+
+	// Refresh tokens are JWTs; their own expiry is preferred when readable
+	if exp, ok := jwtExpiry(resp.RefreshToken); ok {
+		expiresAt = exp
+	}
+	return RegistryCredential{Username: acrUsername, Password: resp.RefreshToken, ExpiresAt: expiresAt}, nil
+}
+
+// managedIdentityToken requests a managed identity access token from the
+// Azure instance metadata service.
+func (h *ACRCredentialHelper) managedIdentityToken(ctx context.Context) (string, time.Time, error) {
+	query := url.Values{
+		"api-version": {"2018-02-01"},
+		"resource":    {"https://management.azure.com/"},
+	}
+	if h.ClientID != "" {
+		query.Set("client_id", h.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"http://169.254.169.254/metadata/identity/oauth2/token?"+query.Encode(), nil)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Metadata", "true")
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresOn   string `json:"expires_on"`
+	}
+	if err := doJSON(h.HTTPClient, req, &resp); err != nil {
+		return "", time.Time{}, err
+	}
+	expiresOn, err := strconv.ParseInt(resp.ExpiresOn, 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid token expiry %q: %w", resp.ExpiresOn, err)
+	}
+	return resp.AccessToken, time.Unix(expiresOn, 0), nil
+}
+
+// jwtExpiry returns the exp claim of a JWT without verifying it.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0), true
+}
+
+// doJSON sends req with client, or http.DefaultClient if nil, and decodes
+// a successful JSON response into v.
+func doJSON(client *http.Client, req *http.Request, v any) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// registryHost returns registry without its port.
+func registryHost(registry string) string {
+	if host, _, err := net.SplitHostPort(registry); err == nil {
+		return host
+	}
+	return registry
+}
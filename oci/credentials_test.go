@@ -0,0 +1,214 @@
+package ocibundle
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// handlerClient returns an HTTP client that serves every request with handler.
+func handlerClient(handler http.HandlerFunc) *http.Client {
+	return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		recorder := httptest.NewRecorder()
+		handler(recorder, req)
+		return recorder.Result(), nil
+	})}
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// fakeCredentialHelper issues numbered credentials for a single registry.
+type fakeCredentialHelper struct {
+	registry string
+	ttl      time.Duration
+	calls    int
+}
+
+func (f *fakeCredentialHelper) Supports(registry string) bool {
+	return registry == f.registry
+}
+
+func (f *fakeCredentialHelper) Credential(context.Context, string) (RegistryCredential, error) {
+	f.calls++
+	cred := RegistryCredential{Username: "user", Password: strings.Repeat("x", f.calls)}
+	if f.ttl > 0 {
+		cred.ExpiresAt = time.Unix(0, 0).Add(f.ttl)
+	}
+	return cred, nil
+}
+
+func TestWithCredentialHelpers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caches credentials until they expire", func(t *testing.T) {
+		helper := &fakeCredentialHelper{registry: "registry.example.com", ttl: time.Hour}
+		creds := newHelperCredentials([]CredentialHelper{helper}, nil)
+		now := time.Unix(0, 0)
+		creds.now = func() time.Time { return now }
+
+		cred, err := creds.credential(ctx, "registry.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, auth.Credential{Username: "user", Password: "x"}, cred)
+
+		now = now.Add(50 * time.Minute)
+		_, err = creds.credential(ctx, "registry.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, 1, helper.calls)
+
+		// Credentials are refreshed before they expire
+		now = now.Add(6 * time.Minute)
+		cred, err = creds.credential(ctx, "registry.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "xx", cred.Password)
+		assert.Equal(t, 2, helper.calls)
+	})
+
+	t.Run("falls back for unsupported registries", func(t *testing.T) {
+		helper := &fakeCredentialHelper{registry: "registry.example.com"}
+		fallback := func(context.Context, string) (auth.Credential, error) {
+			return auth.Credential{Username: "docker"}, nil
+		}
+		creds := newHelperCredentials([]CredentialHelper{helper}, fallback)
+
+		cred, err := creds.credential(ctx, "other.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "docker", cred.Username)
+		assert.Zero(t, helper.calls)
+	})
+
+	t.Run("configures the client", func(t *testing.T) {
+		opts := DefaultClientOptions()
+		WithCredentialHelpers(&ECRCredentialHelper{})(opts)
+		require.NotNil(t, opts.Auth)
+		assert.NotNil(t, opts.Auth.CredentialFunc)
+	})
+}
+
+func TestECRCredentialHelper(t *testing.T) {
+	// Keep the default credential chain away from local AWS configuration
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	helper := &ECRCredentialHelper{
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "secret", "session"),
+	}
+
+	assert.True(t, helper.Supports("123456789012.dkr.ecr.us-west-2.amazonaws.com"))
+	assert.True(t, helper.Supports("123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn:443"))
+	assert.False(t, helper.Supports("ghcr.io"))
+
+	expiresAt := time.Now().Add(12 * time.Hour).Truncate(time.Second)
+	helper.HTTPClient = handlerClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "api.ecr.us-west-2.amazonaws.com", r.URL.Host)
+		assert.Equal(t, "AmazonEC2ContainerRegistry_V20150921.GetAuthorizationToken", r.Header.Get("X-Amz-Target"))
+		assert.Equal(t, "session", r.Header.Get("X-Amz-Security-Token"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-west-2/ecr/aws4_request")
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"authorizationData": []map[string]any{{
+				"authorizationToken": base64.StdEncoding.EncodeToString([]byte("AWS:password")),
+				"expiresAt":          expiresAt.Unix(),
+			}},
+		})
+	})
+
+	cred, err := helper.Credential(context.Background(), "123456789012.dkr.ecr.us-west-2.amazonaws.com")
+	require.NoError(t, err)
+	assert.Equal(t, "AWS", cred.Username)
+	assert.Equal(t, "password", cred.Password)
+	assert.True(t, expiresAt.Equal(cred.ExpiresAt))
+
+	t.Run("uses the default credential chain", func(t *testing.T) {
+		t.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+		t.Setenv("AWS_SESSION_TOKEN", "session")
+		_, err := (&ECRCredentialHelper{HTTPClient: helper.HTTPClient}).Credential(
+			context.Background(), "123456789012.dkr.ecr.us-west-2.amazonaws.com")
+		assert.NoError(t, err)
+	})
+
+	t.Run("requires credentials", func(t *testing.T) {
+		t.Setenv("AWS_ACCESS_KEY_ID", "")
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+		_, err := (&ECRCredentialHelper{HTTPClient: helper.HTTPClient}).Credential(
+			context.Background(), "123456789012.dkr.ecr.us-west-2.amazonaws.com")
+		assert.Error(t, err)
+	})
+}
+
+func TestGCRCredentialHelper(t *testing.T) {
+	helper := &GCRCredentialHelper{
+		HTTPClient: handlerClient(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "metadata.google.internal", r.URL.Host)
+			assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "expires_in": 3600})
+		}),
+	}
+
+	assert.True(t, helper.Supports("gcr.io"))
+	assert.True(t, helper.Supports("eu.gcr.io"))
+	assert.True(t, helper.Supports("us-central1-docker.pkg.dev"))
+	assert.False(t, helper.Supports("docker.io"))
+
+	cred, err := helper.Credential(context.Background(), "gcr.io")
+	require.NoError(t, err)
+	assert.Equal(t, "oauth2accesstoken", cred.Username)
+	assert.Equal(t, "token", cred.Password)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), cred.ExpiresAt, time.Minute)
+
+	t.Run("reports token errors", func(t *testing.T) {
+		helper := &GCRCredentialHelper{TokenFunc: func(context.Context) (string, time.Time, error) {
+			return "", time.Time{}, errors.New("no credentials")
+		}}
+		_, err := helper.Credential(context.Background(), "gcr.io")
+		assert.ErrorContains(t, err, "no credentials")
+	})
+}
+
+func TestACRCredentialHelper(t *testing.T) {
+	expiresAt := time.Now().Add(3 * time.Hour).Truncate(time.Second)
+	claims, err := json.Marshal(map[string]any{"exp": expiresAt.Unix()})
+	require.NoError(t, err)
+	refreshToken := "header." + base64.RawURLEncoding.EncodeToString(claims) + ".signature"
+
+	helper := &ACRCredentialHelper{
+		TokenFunc: func(context.Context) (string, time.Time, error) {
+			return "aad-token", time.Now().Add(time.Hour), nil
+		},
+		HTTPClient: handlerClient(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "https://myregistry.azurecr.io/oauth2/exchange", r.URL.String())
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "access_token", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "myregistry.azurecr.io", r.PostForm.Get("service"))
+			assert.Equal(t, "aad-token", r.PostForm.Get("access_token"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"refresh_token": refreshToken})
+		}),
+	}
+
+	assert.True(t, helper.Supports("myregistry.azurecr.io"))
+	assert.False(t, helper.Supports("azurecr.io.example.com"))
+
+	cred, err := helper.Credential(context.Background(), "myregistry.azurecr.io")
+	require.NoError(t, err)
+	assert.Equal(t, RegistryCredential{Username: acrUsername, Password: refreshToken, ExpiresAt: expiresAt}, cred)
+}
@@ -0,0 +1,115 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains functionality for comparing the files of two OCI artifacts
+// without downloading them, using their eStargz TOCs.
+package ocibundle
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DiffResult reports the differences between the files of two artifacts.
+// All lists are sorted by file name.
+type DiffResult struct {
+	// Added lists files present only in the second artifact
+	Added []FileMetadata
+
+	// Removed lists files present only in the first artifact
+	Removed []FileMetadata
+
+	// Changed lists files present in both artifacts that differ
+	Changed []FileChange
+}
+
+// FileChange describes a file whose content or metadata differs between two
+// artifacts.
+type FileChange struct {
+	// Name is the full path of the file within the archives
+	Name string
+
+	// Old is the file's metadata in the first artifact
+	Old FileMetadata
+
+	// New is the file's metadata in the second artifact
+	New FileMetadata
+}
+
+// HasChanges reports whether the artifacts differ.
+func (d *DiffResult) HasChanges() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0 || len(d.Changed) > 0
+}
+
+// Diff compares the files of the artifacts at refA and refB and reports the
+// files added, removed, and changed in refB relative to refA. Like ListFiles,
+// it reads only the eStargz TOC of each artifact, so neither is downloaded in
+// full when the registry supports HTTP Range requests.
+//
+// Files are compared by content digest, type, permissions, and link target.
+// Modification times are ignored because they change on every build.
+//
+// Example:
+//
+//	diff, err := client.Diff(ctx, "ghcr.io/org/repo:v1.0.0", "ghcr.io/org/repo:v1.1.0")
+//	for _, change := range diff.Changed {
+//	    fmt.Printf("~ %s (%s -> %s)\n", change.Name, change.Old.Digest, change.New.Digest)
+//	}
+func (c *Client) Diff(ctx context.Context, refA, refB string) (*DiffResult, error) {
+	if refA == "" || refB == "" {
+		return nil, fmt.Errorf("reference cannot be empty")
+	}
+
+	filesA, err := c.ListFiles(ctx, refA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files of %s: %w", refA, err)
+	}
+	filesB, err := c.ListFiles(ctx, refB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files of %s: %w", refB, err)
+	}
+
+	return diffFiles(filesA.Files, filesB.Files), nil
+}
+
+// diffFiles compares two file listings.
+func diffFiles(filesA, filesB []FileMetadata) *DiffResult {
+	result := &DiffResult{}
+
+	byName := make(map[string]FileMetadata, len(filesA))
+	for _, file := range filesA {
+		byName[file.Name] = file
+	}
+
+	for _, file := range filesB {
+		old, ok := byName[file.Name]
+		if !ok {
+			result.Added = append(result.Added, file)
+			continue
+		}
+		delete(byName, file.Name)
+
+		if fileChanged(old, file) {
+			result.Changed = append(result.Changed, FileChange{Name: file.Name, Old: old, New: file})
+		}
+	}
+	for _, file := range byName {
+		result.Removed = append(result.Removed, file)
+	}
+
+	compareNames := func(a, b FileMetadata) int { return strings.Compare(a.Name, b.Name) }
+	slices.SortFunc(result.Added, compareNames)
+	slices.SortFunc(result.Removed, compareNames)
+	slices.SortFunc(result.Changed, func(a, b FileChange) int { return strings.Compare(a.Name, b.Name) })
+
+	return result
+}
+
+// fileChanged reports whether two entries for the same path differ.
+func fileChanged(a, b FileMetadata) bool {
+	return a.Type != b.Type ||
+		a.Digest != b.Digest ||
+		a.Size != b.Size ||
+		a.Mode.Perm() != b.Mode.Perm() ||
+		a.LinkTarget != b.LinkTarget
+}
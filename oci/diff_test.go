@@ -0,0 +1,56 @@
+package ocibundle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffFiles(t *testing.T) {
+	filesA := []FileMetadata{
+		{Name: "config", Type: "dir", Mode: 0o755, IsDir: true},
+		{Name: "config/app.yaml", Type: "reg", Mode: 0o644, Size: 9, Digest: "sha256:aaa"},
+		{Name: "run.sh", Type: "reg", Mode: 0o644, Size: 4, Digest: "sha256:bbb"},
+		{Name: "old.txt", Type: "reg", Mode: 0o644, Size: 3, Digest: "sha256:ccc"},
+		{Name: "latest", Type: "symlink", LinkTarget: "v1"},
+	}
+	filesB := []FileMetadata{
+		{Name: "config", Type: "dir", Mode: 0o755, IsDir: true},
+		{Name: "config/app.yaml", Type: "reg", Mode: 0o644, Size: 10, Digest: "sha256:ddd"},
+		{Name: "run.sh", Type: "reg", Mode: 0o755, Size: 4, Digest: "sha256:bbb"},
+		{Name: "new.txt", Type: "reg", Mode: 0o644, Size: 3, Digest: "sha256:eee"},
+		{Name: "latest", Type: "symlink", LinkTarget: "v2"},
+	}
+
+	diff := diffFiles(filesA, filesB)
+	require.True(t, diff.HasChanges())
+
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "new.txt", diff.Added[0].Name)
+
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, "old.txt", diff.Removed[0].Name)
+
+	var changed []string
+	for _, change := range diff.Changed {
+		changed = append(changed, change.Name)
+	}
+	assert.Equal(t, []string{"config/app.yaml", "latest", "run.sh"}, changed)
+	assert.Equal(t, "sha256:aaa", diff.Changed[0].Old.Digest)
+	assert.Equal(t, "sha256:ddd", diff.Changed[0].New.Digest)
+
+	assert.False(t, diffFiles(filesA, filesA).HasChanges())
+}
+
+func TestClient_Diff(t *testing.T) {
+	client, err := New()
+	require.NoError(t, err)
+
+	_, err = client.Diff(context.Background(), "", "example.com/repo:v2")
+	require.Error(t, err)
+
+	_, err = client.Diff(context.Background(), "example.com/repo:v1", "")
+	require.Error(t, err)
+}
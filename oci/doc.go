@@ -3,11 +3,30 @@
 // This package enables pushing and pulling filesystem bundles to OCI-compliant
 // registries using the eStargz format. Key features:
 //   - eStargz archives (100% backward compatible with tar.gz)
-//   - Selective file extraction using glob patterns
+//   - Optional zstd compression with transparent decompression on pull
+//   - Selective file extraction using glob patterns or metadata predicates
+//   - Multi-layer bundles with per-component layers and parallel transfers
+//   - Multi-platform bundles using OCI image indexes, selected by platform on pull
+//   - Tag listing, resolution, promotion, and deletion
+//   - Digest pinning that rejects pulls of moved tags
+//   - File-level diffs between artifacts without downloading them
+//   - Integrity audits of extracted bundles against their artifacts
+//   - Registry-to-registry copies including signatures and attestations
+//   - Attaching and listing SBOMs and attestations via the referrers API
 //   - HTTP Range requests for bandwidth optimization
+//   - Resumable chunked uploads and ranged resume of interrupted downloads
+//   - Pull progress reporting through extraction, and download bandwidth limits
 //   - Comprehensive security validation (path traversal, size limits, permissions)
+//   - Exclude patterns and .bundleignore files for leaving entries out of pushes
+//   - Symlink and hardlink preservation with configurable symlink policies
+//   - Optional preservation of timestamps, ownership, and extended attributes
+//   - Deterministic archives and WithReproducible for reproducible layer digests
+//   - Built-in ECR, GCR/Artifact Registry, and ACR credential helpers with token caching
 //   - Optional signature verification for supply chain security
-//   - Optional caching for repeated operations
+//   - Optional caching for repeated operations, with pinning and pruning, shareable
+//     between clients through the oci/cache package
+//   - Cached layers shared across references, optionally hardlinked into pull targets
+//   - Observability hooks for metrics and tracing of each operation phase
 //   - Filesystem abstraction for testing and custom storage
 //
 // Basic usage:
@@ -20,6 +39,10 @@
 //	// Push a directory
 //	err = client.Push(ctx, "/path/to/bundle", "ghcr.io/myrepo:latest")
 //
+//	// Push a pre-built archive or an in-memory filesystem
+//	err = client.PushArchive(ctx, tarball, mediaType, "ghcr.io/myrepo:latest")
+//	err = client.PushFS(ctx, memfs, "ghcr.io/myrepo:latest")
+//
 //	// Pull to a directory
 //	err = client.Pull(ctx, "ghcr.io/myrepo:latest", "/path/to/target")
 //
@@ -28,6 +51,42 @@
 //	    ocibundle.WithFilesToExtract("**/*.json", "config/*.yaml"),
 //	)
 //
+//	// Extract into any writable filesystem, e.g. memory or S3
+//	err = client.PullToFS(ctx, reference, billy.NewMemory())
+//
+// Tag Management:
+//
+// Retention and promotion jobs can inspect and manage tags directly:
+//
+//	tags, err := client.ListTags(ctx, "ghcr.io/org/app")
+//	desc, err := client.Resolve(ctx, "ghcr.io/org/app:rc")
+//	err = client.CopyTag(ctx, "ghcr.io/org/app:rc", "stable")
+//	err = client.DeleteTag(ctx, "ghcr.io/org/app:rc")
+//
+// GetManifest returns a manifest's annotations, layers, creation time, and
+// platform, so build metadata can be checked before pulling:
+//
+//	info, err := client.GetManifest(ctx, "ghcr.io/org/app:v1")
+//	buildID := info.Annotations["org.example.build-id"]
+//
+// Copy promotes an artifact to another repository or registry without
+// extracting it, optionally pinned to a tested digest:
+//
+//	err = client.Copy(ctx, "staging.example.com/app:v1", "ghcr.io/org/app:v1",
+//	    ocibundle.WithDigestPin(desc.Digest),
+//	    ocibundle.WithIncludeSignatures(true),
+//	)
+//
+// Custom Archive Formats:
+//
+// Bundles are tar.gz by default. WithArchiver registers an alternate Archiver
+// for Push, and Pull selects the archiver registered for the pulled layer's
+// media type, falling back to tar.gz:
+//
+//	client, err := ocibundle.NewWithOptions(
+//	    ocibundle.WithArchiver(zipArchiver),
+//	)
+//
 // Signature Verification:
 //
 // Optional signature verification ensures artifacts are cryptographically verified
@@ -66,6 +66,23 @@ var (
 	// ErrInvalidAnnotations indicates that required annotations are missing or incorrect.
 	// This occurs when annotation-based policies are not satisfied by the signature.
 	ErrInvalidAnnotations = errors.New("required annotations missing or invalid")
+
+	// ErrAttestationNotFound indicates that an artifact has no attestation
+	// required by the verification policy, such as SLSA provenance.
+	ErrAttestationNotFound = errors.New("attestation not found")
+
+	// ErrAttestationInvalid indicates that an attestation failed signature
+	// verification or did not satisfy the provenance requirements.
+	ErrAttestationInvalid = errors.New("attestation verification failed")
+
+	// ErrDigestMismatch indicates that a reference resolved to a different
+	// digest than the one it was pinned to. This occurs when a tag was moved
+	// after a deployment spec was pinned to its digest.
+	ErrDigestMismatch = errors.New("digest mismatch")
+
+	// ErrPlatformNotFound indicates that a multi-platform artifact has no
+	// bundle for the requested platform.
+	ErrPlatformNotFound = errors.New("platform not found")
 )
 
 // BundleError provides detailed context about OCI bundle operation failures.
@@ -158,13 +175,17 @@ func (e *BundleError) IsAuthError() bool {
 //   - ErrRekorVerificationFailed
 //   - ErrCertificateExpired
 //   - ErrInvalidAnnotations
+//   - ErrAttestationNotFound
+//   - ErrAttestationInvalid
 func (e *BundleError) IsSignatureError() bool {
 	return errors.Is(e.Err, ErrSignatureNotFound) ||
 		errors.Is(e.Err, ErrSignatureInvalid) ||
 		errors.Is(e.Err, ErrUntrustedSigner) ||
 		errors.Is(e.Err, ErrRekorVerificationFailed) ||
 		errors.Is(e.Err, ErrCertificateExpired) ||
-		errors.Is(e.Err, ErrInvalidAnnotations)
+		errors.Is(e.Err, ErrInvalidAnnotations) ||
+		errors.Is(e.Err, ErrAttestationNotFound) ||
+		errors.Is(e.Err, ErrAttestationInvalid)
 }
 
 // SignatureErrorInfo provides detailed context about signature verification failures.
@@ -190,5 +211,6 @@ type SignatureErrorInfo struct {
 	//   - "crypto": Cryptographic signature verification failed
 	//   - "policy": Signature valid but policy check failed (identity, annotations)
 	//   - "rekor": Transparency log verification failed
+	//   - "attestation": An attestation could not be parsed
 	FailureStage string
 }
@@ -68,8 +68,16 @@ func main() {
 		ocibundle.WithAnnotations(annotations),
 		ocibundle.WithPlatform("linux/amd64"),
 		ocibundle.WithProgressCallback(pushProgress),
-		ocibundle.WithMaxRetries(5),
-		ocibundle.WithRetryDelay(3*time.Second),
+		ocibundle.WithRetryPolicy(ocibundle.RetryPolicy{
+			MaxRetries:   5,
+			InitialDelay: 3 * time.Second,
+			MaxDelay:     30 * time.Second,
+			Multiplier:   2,
+			Jitter:       0.2,
+			OnRetry: func(attempt ocibundle.RetryAttempt) {
+				fmt.Printf("\n🔁 Retry %d in %s: %v\n", attempt.Attempt, attempt.Delay, attempt.Err)
+			},
+		}),
 	)
 	if pushErr != nil {
 		log.Fatalf("Failed to push bundle: %v", pushErr)
@@ -89,8 +97,7 @@ func main() {
 		ocibundle.WithPullPreservePermissions(false),  // Sanitize permissions
 		ocibundle.WithPullStripPrefix("bundle-root/"), // Remove prefix if present
 		// Retry configuration
-		ocibundle.WithPullMaxRetries(3),
-		ocibundle.WithPullRetryDelay(2*time.Second),
+		ocibundle.WithPullRetryPolicy(ocibundle.DefaultRetryPolicy()),
 	)
 	if pullErr != nil {
 		log.Fatalf("Failed to pull bundle: %v", pullErr)
@@ -4,6 +4,7 @@ package main
 
 import (
 	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -162,16 +163,13 @@ func (a *ZipArchiver) Extract(
 	targetDir string,
 	opts ocibundle.ExtractOptions,
 ) error {
-	size, err := input.(io.Seeker).Seek(0, io.SeekEnd)
+	// ZIP requires random access, but blobs pulled from a registry are streams
+	readerAt, size, err := toReaderAt(input)
 	if err != nil {
-		return fmt.Errorf("failed to seek to end: %w", err)
+		return err
 	}
 
-	if _, err := input.(io.Seeker).Seek(0, io.SeekStart); err != nil {
-		return fmt.Errorf("failed to seek to start: %w", err)
-	}
-
-	zipReader, err := zip.NewReader(input.(io.ReaderAt), size)
+	zipReader, err := zip.NewReader(readerAt, size)
 	if err != nil {
 		return fmt.Errorf("failed to create ZIP reader: %w", err)
 	}
@@ -231,6 +229,30 @@ func (a *ZipArchiver) Extract(
 	return nil
 }
 
+// toReaderAt returns random access to input, buffering it in memory when the
+// input is a plain stream.
+func toReaderAt(input io.Reader) (io.ReaderAt, int64, error) {
+	if rs, ok := input.(interface {
+		io.ReaderAt
+		io.Seeker
+	}); ok {
+		size, err := rs.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to seek to end: %w", err)
+		}
+		if _, err := rs.Seek(0, io.SeekStart); err != nil {
+			return nil, 0, fmt.Errorf("failed to seek to start: %w", err)
+		}
+		return rs, size, nil
+	}
+
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read archive: %w", err)
+	}
+	return bytes.NewReader(data), int64(len(data)), nil
+}
+
 // MediaType returns the OCI media type for ZIP archives.
 func (a *ZipArchiver) MediaType() string {
 	return "application/vnd.oci.image.layer.v1.zip"
@@ -257,9 +279,13 @@ func main() {
 	// Create custom ZIP archiver
 	customArchiver := NewZipArchiver()
 
-	// For this example, we'll show how to create a client with a custom archiver
-	// Note: The current API doesn't directly support custom archivers yet,
-	// but this shows the pattern for implementation
+	// Register the archiver with a client. Push creates ZIP bundles with the
+	// archiver's media type, and Pull selects the archiver by layer media type.
+	client, err := ocibundle.NewWithOptions(ocibundle.WithArchiver(customArchiver))
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	_ = client // client.Push/client.Pull now use ZIP; see the basic example for registry usage
 
 	fmt.Printf("Custom ZIP Archiver Media Type: %s\n", customArchiver.MediaType())
 
@@ -314,6 +340,7 @@ func main() {
 	fmt.Println("   • Handle security validation in Extract method")
 	fmt.Println("   • Support progress reporting for better UX")
 	fmt.Println("   • Return appropriate OCI media type")
+	fmt.Println("   • Register it with ocibundle.WithArchiver")
 	fmt.Println("   • Include comprehensive error handling")
 }
 
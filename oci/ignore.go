@@ -0,0 +1,109 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains the ignore rules that exclude entries from pushed bundles.
+package ocibundle
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jmgilman/go/fs/core"
+)
+
+// BundleIgnoreFile is the name of the file at the root of a pushed bundle that
+// lists exclude patterns, one per line, in addition to those set with
+// WithExcludePatterns.
+const BundleIgnoreFile = ".bundleignore"
+
+// ignoreRule is a single parsed exclude pattern.
+type ignoreRule struct {
+	pattern string
+	// negate re-includes entries matched by earlier rules ("!pattern")
+	negate bool
+	// dirOnly matches directories only ("pattern/")
+	dirOnly bool
+	// anchored matches the full relative path instead of any path element
+	anchored bool
+}
+
+// ignoreRules decides which entries are left out of a pushed bundle. Later
+// rules take precedence over earlier ones.
+type ignoreRules []ignoreRule
+
+// loadIgnoreRules returns the rules in the BundleIgnoreFile at the root of
+// sourceDir, if any, followed by patterns.
+func loadIgnoreRules(fsys core.ReadFS, sourceDir string, patterns []string) (ignoreRules, error) {
+	var lines []string
+	data, err := fsys.ReadFile(path.Join(filepath.ToSlash(sourceDir), BundleIgnoreFile))
+	switch {
+	case err == nil:
+		lines = strings.Split(string(data), "\n")
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("failed to read %s: %w", BundleIgnoreFile, err)
+	}
+
+	var rules ignoreRules
+	for _, line := range append(lines, patterns...) {
+		rule, ok := parseIgnoreRule(line)
+		if !ok {
+			continue
+		}
+		if _, err := path.Match(rule.pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern %q: %w", line, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// parseIgnoreRule parses a line of gitignore-style syntax. Blank lines and
+// lines starting with "#" are skipped.
+func parseIgnoreRule(line string) (ignoreRule, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+
+	var rule ignoreRule
+	if rest, ok := strings.CutPrefix(line, "!"); ok {
+		rule.negate = true
+		line = rest
+	}
+	if rest, ok := strings.CutSuffix(line, "/"); ok {
+		rule.dirOnly = true
+		line = rest
+	}
+	line = strings.TrimPrefix(line, "./")
+	if rest, ok := strings.CutPrefix(line, "/"); ok {
+		rule.anchored = true
+		line = rest
+	}
+	if strings.Contains(line, "/") {
+		rule.anchored = true
+	}
+	rule.pattern = line
+	return rule, rule.pattern != ""
+}
+
+// excluded reports whether the entry at relPath, relative to the bundle root,
+// is excluded. Excluding a directory excludes everything beneath it.
+func (r ignoreRules) excluded(relPath string, isDir bool) bool {
+	relPath = filepath.ToSlash(relPath)
+	excluded := false
+	for _, rule := range r {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		name := relPath
+		if !rule.anchored {
+			name = path.Base(relPath)
+		}
+		if matchesPattern(name, rule.pattern) {
+			excluded = !rule.negate
+		}
+	}
+	return excluded
+}
@@ -0,0 +1,111 @@
+package ocibundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoreRules(t *testing.T) {
+	rules, err := loadIgnoreRules(billy.NewMemory(), ".", []string{
+		"# build output",
+		"",
+		"*.o",
+		".git/",
+		"/dist",
+		"docs/*.md",
+		"!docs/README.md",
+		"**/tmp",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		path     string
+		isDir    bool
+		excluded bool
+	}{
+		{"main.o", false, true},
+		{"src/lib/util.o", false, true},
+		{"main.go", false, false},
+		{".git", true, true},
+		{"sub/.git", true, true},
+		{".git", false, false},
+		{"dist", true, true},
+		{"sub/dist", true, false},
+		{"docs/guide.md", false, true},
+		{"docs/README.md", false, false},
+		{"docs/sub/guide.md", false, false},
+		{"a/b/tmp", true, true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.excluded, rules.excluded(tt.path, tt.isDir), tt.path)
+	}
+
+	t.Run("rejects invalid patterns", func(t *testing.T) {
+		_, err := loadIgnoreRules(billy.NewMemory(), ".", []string{"[a-"})
+		assert.ErrorContains(t, err, "invalid exclude pattern")
+	})
+}
+
+func TestWithExcludePatterns(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"main.go", "main.o", ".git/HEAD", "node_modules/pkg/index.js", "keep/node.txt"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, BundleIgnoreFile), []byte("node_modules/\n*.o\n"), 0o644))
+
+	opts := &PushOptions{}
+	WithExcludePatterns(".git/")(opts)
+	assert.Equal(t, []string{".git/"}, opts.Archive.Exclude)
+
+	client, err := New()
+	require.NoError(t, err)
+	archiver, err := client.pushArchiverFor(opts)
+	require.NoError(t, err)
+
+	var total int64
+	tarBytes, err := archiver.(*TarGzArchiver).buildTar(context.Background(), dir, func(_, t int64) { total = t })
+	require.NoError(t, err)
+
+	var names []string
+	tarReader := tar.NewReader(bytes.NewReader(tarBytes))
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+	}
+	assert.ElementsMatch(t, []string{BundleIgnoreFile, "keep", "keep/node.txt", "main.go"}, names)
+	assert.Equal(t, int64(len("node_modules/\n*.o\n")+len("keep/node.txt")+len("main.go")), total)
+
+	t.Run("applies to PushFS sources", func(t *testing.T) {
+		source := billy.NewMemory()
+		require.NoError(t, source.WriteFile("app.txt", []byte("app"), 0o644))
+		require.NoError(t, source.MkdirAll(".git", 0o755))
+		require.NoError(t, source.WriteFile(".git/HEAD", []byte("ref"), 0o644))
+
+		tarBytes, err := archiver.(*TarGzArchiver).buildFSTar(context.Background(), source, nil)
+		require.NoError(t, err)
+
+		var names []string
+		tarReader := tar.NewReader(bytes.NewReader(tarBytes))
+		for {
+			header, err := tarReader.Next()
+			if err != nil {
+				break
+			}
+			names = append(names, header.Name)
+		}
+		assert.Equal(t, []string{"app.txt"}, names)
+	})
+}
@@ -3,6 +3,7 @@
 package oras
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -10,14 +11,21 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/sync/errgroup"
 	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
 )
 
+// bundleArtifactType is the artifact type of pushed bundle manifests.
+const bundleArtifactType = "application/vnd.catalyst.bundle.v1"
+
 // DefaultORASClient implements Client using the real ORAS library.
 type DefaultORASClient struct{}
 
@@ -210,6 +218,94 @@ type PushDescriptor struct {
 	Size        int64
 	Annotations map[string]string
 	Platform    string
+
+	// MountFrom lists repositories in the target registry that may already hold
+	// the blob. When set, the blob is mounted from the first repository that has
+	// it instead of being uploaded.
+	MountFrom []string
+
+	// Layers, when non-empty, are pushed as the layers of a single manifest in
+	// order, and MediaType, Data, and Size are ignored.
+	Layers []LayerDescriptor
+
+	// Concurrency is the maximum number of layers pushed in parallel.
+	// Values below 1 push one layer at a time.
+	Concurrency int
+
+	// Progress, if non-nil, is called with the number of layer bytes pushed
+	// so far and the total size of all layers.
+	Progress func(current, total int64)
+
+	// Resume, if non-nil, uploads blobs in resumable chunks.
+	Resume *ResumeOptions
+
+	// Variants, when non-empty, are pushed as untagged platform-specific
+	// manifests listed in an image index, which is tagged instead. Each
+	// variant must set Platform and Layers; Annotations apply to the index,
+	// and Progress reports the layers of all variants.
+	Variants []PushDescriptor
+
+	// OnManifest, if non-nil, is called with the digest of the pushed
+	// manifest after it has been tagged. It is not called for image indexes.
+	OnManifest func(digest string)
+}
+
+// LayerDescriptor describes one layer of a multi-layer push.
+type LayerDescriptor struct {
+	MediaType   string
+	Data        io.ReadSeeker
+	Size        int64
+	Annotations map[string]string
+}
+
+// LayerInfo describes a layer listed in a pulled image manifest.
+type LayerInfo struct {
+	MediaType   string
+	Digest      string
+	Size        int64
+	Annotations map[string]string
+}
+
+// errMountUnavailable signals that a cross-repository mount did not complete.
+var errMountUnavailable = errors.New("blob mount unavailable")
+
+// mountBlob attempts to make the blob described by desc available in repo
+// without uploading it, either because repo already holds it or by mounting it
+// from one of the given repositories. Entries in mountFrom may be repository
+// paths (org/app) or full references in the same registry (ghcr.io/org/app);
+// repositories in other registries are skipped.
+// Returns true if the blob is available; failures are not errors because the
+// caller falls back to a regular upload.
+func mountBlob(ctx context.Context, repo *remote.Repository, desc ocispec.Descriptor, mountFrom []string) bool {
+	if len(mountFrom) == 0 {
+		return false
+	}
+
+	if exists, err := repo.Blobs().Exists(ctx, desc); err == nil && exists {
+		return true
+	}
+
+	// Registries that do not support mounting start an upload session instead;
+	// refuse to provide content so the caller's upload path is used.
+	noContent := func() (io.ReadCloser, error) {
+		return nil, errMountUnavailable
+	}
+
+	for _, from := range mountFrom {
+		if path, ok := strings.CutPrefix(from, repo.Reference.Registry+"/"); ok {
+			from = path
+		} else if host, _, found := strings.Cut(from, "/"); found && (strings.ContainsAny(host, ".:") || host == "localhost") {
+			continue // Reference to another registry
+		}
+		if from == "" || from == repo.Reference.Repository {
+			continue
+		}
+		if err := repo.Mount(ctx, desc, from, noContent); err == nil {
+			return true
+		}
+	}
+
+	return false
 }
 
 // pushStreamIfPossible attempts to stream-push the data when it is seekable.
@@ -250,22 +346,305 @@ func pushStreamIfPossible(
 		Size:      sz,
 	}
 
-	// Push blob streaming from file. On push error, fall back to buffered.
-	if pErr := repo.Blobs().Push(ctx, expected, io.LimitReader(rs, sz)); pErr != nil {
-		return false, nil
+	// Push blob streaming from file unless it can be mounted. On push error, fall back to buffered,
+	// except for resumable uploads, which continue from their recorded state when retried.
+	if !mountBlob(ctx, repo, expected, descriptor.MountFrom) {
+		if descriptor.Resume != nil {
+			if pErr := pushBlobResumable(ctx, repo, expected, rs, descriptor.Resume, nil); pErr != nil {
+				return true, mapORASError("push", reference, fmt.Errorf("push blob: %w", pErr))
+			}
+		} else if pErr := repo.Blobs().Push(ctx, expected, io.LimitReader(rs, sz)); pErr != nil {
+			return false, nil
+		}
 	}
 
 	// Pack manifest and tag
-	packOpts := oras.PackManifestOptions{Layers: []ocispec.Descriptor{expected}}
-	artifactType := "application/vnd.catalyst.bundle.v1"
-	manDesc, mErr := oras.PackManifest(ctx, repo, oras.PackManifestVersion1_1, artifactType, packOpts)
-	if mErr != nil {
-		return true, mapORASError("push", reference, fmt.Errorf("pack manifest v1.1: %w", mErr))
+	return true, packAndTag(ctx, repo, reference, refPart, []ocispec.Descriptor{expected}, descriptor)
+}
+
+// pushLayers pushes each layer blob, mounting it when possible, and then packs
+// and tags a manifest listing the layers in order.
+func pushLayers(
+	ctx context.Context,
+	repo *remote.Repository,
+	reference, refPart string,
+	descriptor *PushDescriptor,
+) error {
+	var total int64
+	for _, layer := range descriptor.Layers {
+		total += layer.Size
+	}
+	progress := &progressCounter{total: total, report: descriptor.Progress}
+
+	manDesc, err := pushLayerManifest(ctx, repo, reference, descriptor, progress)
+	if err != nil {
+		return err
+	}
+	if _, err := oras.Tag(ctx, repo, manDesc.Digest.String(), refPart); err != nil {
+		return mapORASError("push", reference, fmt.Errorf("tag manifest: %w", err))
+	}
+	if descriptor.OnManifest != nil {
+		descriptor.OnManifest(manDesc.Digest.String())
+	}
+	return nil
+}
+
+// pushIndex pushes the manifest of each variant, and then tags an image index
+// listing them with their platforms.
+func pushIndex(
+	ctx context.Context,
+	repo *remote.Repository,
+	reference, refPart string,
+	descriptor *PushDescriptor,
+) error {
+	var total int64
+	for _, variant := range descriptor.Variants {
+		for _, layer := range variant.Layers {
+			total += layer.Size
+		}
+	}
+	progress := &progressCounter{total: total, report: descriptor.Progress}
+
+	manifests := make([]ocispec.Descriptor, 0, len(descriptor.Variants))
+	for i := range descriptor.Variants {
+		variant := &descriptor.Variants[i]
+		platform, err := ParsePlatform(variant.Platform)
+		if err != nil {
+			return mapORASError("push", reference, err)
+		}
+		if len(variant.Layers) == 0 {
+			return mapORASError("push", reference, fmt.Errorf("no layers for platform %s", variant.Platform))
+		}
+
+		manDesc, err := pushLayerManifest(ctx, repo, reference, variant, progress)
+		if err != nil {
+			return err
+		}
+		manifests = append(manifests, ocispec.Descriptor{
+			MediaType:    manDesc.MediaType,
+			ArtifactType: manDesc.ArtifactType,
+			Digest:       manDesc.Digest,
+			Size:         manDesc.Size,
+			Platform:     &platform,
+		})
+	}
+
+	index := ocispec.Index{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    ocispec.MediaTypeImageIndex,
+		ArtifactType: bundleArtifactType,
+		Manifests:    manifests,
+		Annotations:  descriptor.Annotations,
+	}
+	indexBytes, err := json.Marshal(index)
+	if err != nil {
+		return mapORASError("push", reference, fmt.Errorf("encode index: %w", err))
 	}
-	if _, tErr := oras.Tag(ctx, repo, manDesc.Digest.String(), refPart); tErr != nil {
-		return true, mapORASError("push", reference, fmt.Errorf("tag manifest: %w", tErr))
+	indexDesc := content.NewDescriptorFromBytes(ocispec.MediaTypeImageIndex, indexBytes)
+	if err := repo.PushReference(ctx, indexDesc, bytes.NewReader(indexBytes), refPart); err != nil {
+		return mapORASError("push", reference, fmt.Errorf("push index: %w", err))
 	}
-	return true, nil
+	return nil
+}
+
+// pushLayerManifest pushes the layers of descriptor and an untagged manifest
+// listing them, and returns the manifest's descriptor.
+func pushLayerManifest(
+	ctx context.Context,
+	repo *remote.Repository,
+	reference string,
+	descriptor *PushDescriptor,
+	progress *progressCounter,
+) (ocispec.Descriptor, error) {
+	// Layers are independent blobs, so they are pushed in parallel up to the
+	// descriptor's concurrency and listed in the manifest in their original order
+	layers := make([]ocispec.Descriptor, len(descriptor.Layers))
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(max(descriptor.Concurrency, 1))
+	for i, layer := range descriptor.Layers {
+		eg.Go(func() error {
+			desc, err := pushLayer(egCtx, repo, layer, descriptor.MountFrom, descriptor.Resume, progress)
+			if err != nil {
+				return mapORASError("push", reference, fmt.Errorf("push layer %d: %w", i, err))
+			}
+			layers[i] = desc
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return ocispec.Descriptor{}, err
+	}
+
+	return packManifest(ctx, repo, reference, layers, descriptor)
+}
+
+// pushLayer pushes a single layer blob, mounting it when possible, and returns
+// its descriptor. Bytes read from the layer are reported to progress.
+func pushLayer(
+	ctx context.Context,
+	repo *remote.Repository,
+	layer LayerDescriptor,
+	mountFrom []string,
+	resume *ResumeOptions,
+	progress *progressCounter,
+) (ocispec.Descriptor, error) {
+	if _, err := layer.Data.Seek(0, io.SeekStart); err != nil {
+		return ocispec.Descriptor{}, fmt.Errorf("seek: %w", err)
+	}
+	d, err := digest.FromReader(layer.Data)
+	if err != nil {
+		return ocispec.Descriptor{}, fmt.Errorf("digest: %w", err)
+	}
+	if _, err := layer.Data.Seek(0, io.SeekStart); err != nil {
+		return ocispec.Descriptor{}, fmt.Errorf("seek: %w", err)
+	}
+
+	desc := ocispec.Descriptor{
+		MediaType:   layer.MediaType,
+		Digest:      d,
+		Size:        layer.Size,
+		Annotations: layer.Annotations,
+	}
+	if mountBlob(ctx, repo, desc, mountFrom) {
+		progress.add(layer.Size)
+		return desc, nil
+	}
+
+	if resume != nil {
+		if err := pushBlobResumable(ctx, repo, desc, layer.Data, resume, progress); err != nil {
+			return ocispec.Descriptor{}, err
+		}
+		return desc, nil
+	}
+
+	data := &progressReader{reader: io.LimitReader(layer.Data, layer.Size), progress: progress}
+	if err := repo.Blobs().Push(ctx, desc, data); err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	return desc, nil
+}
+
+// progressCounter aggregates bytes transferred across concurrent transfers
+// and reports the running total.
+type progressCounter struct {
+	mu      sync.Mutex
+	current int64
+	total   int64
+	report  func(current, total int64)
+}
+
+// add records n transferred bytes and reports the new total.
+// A nil counter discards the bytes.
+func (p *progressCounter) add(n int64) {
+	if p == nil || p.report == nil || n == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.current += n
+	p.report(p.current, p.total)
+}
+
+// progressReader reports bytes read from reader to a progressCounter.
+type progressReader struct {
+	reader   io.Reader
+	progress *progressCounter
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.progress.add(int64(n))
+	return n, err
+}
+
+// packAndTag packs an OCI 1.1 manifest listing layers, with the descriptor's
+// annotations and platform, and tags it with refPart.
+func packAndTag(
+	ctx context.Context,
+	repo *remote.Repository,
+	reference, refPart string,
+	layers []ocispec.Descriptor,
+	descriptor *PushDescriptor,
+) error {
+	manDesc, err := packManifest(ctx, repo, reference, layers, descriptor)
+	if err != nil {
+		return err
+	}
+	if _, err := oras.Tag(ctx, repo, manDesc.Digest.String(), refPart); err != nil {
+		return mapORASError("push", reference, fmt.Errorf("tag manifest: %w", err))
+	}
+	if descriptor.OnManifest != nil {
+		descriptor.OnManifest(manDesc.Digest.String())
+	}
+	return nil
+}
+
+// packManifest packs an untagged OCI 1.1 manifest listing layers, with the
+// descriptor's annotations and platform, and returns its descriptor.
+func packManifest(
+	ctx context.Context,
+	repo *remote.Repository,
+	reference string,
+	layers []ocispec.Descriptor,
+	descriptor *PushDescriptor,
+) (ocispec.Descriptor, error) {
+	packOpts := oras.PackManifestOptions{
+		Layers:              layers,
+		ManifestAnnotations: descriptor.Annotations,
+	}
+
+	// The platform is recorded in an image config, as the ORAS CLI does for
+	// platform-specific artifacts; otherwise the config is empty
+	if descriptor.Platform != "" {
+		config, err := platformConfig(descriptor.Platform)
+		if err != nil {
+			return ocispec.Descriptor{}, mapORASError("push", reference, err)
+		}
+		configDesc, err := oras.PushBytes(ctx, repo, ocispec.MediaTypeImageConfig, config)
+		if err != nil {
+			return ocispec.Descriptor{}, mapORASError("push", reference, fmt.Errorf("push config: %w", err))
+		}
+		packOpts.ConfigDescriptor = &configDesc
+	}
+
+	manDesc, err := oras.PackManifest(ctx, repo, oras.PackManifestVersion1_1, bundleArtifactType, packOpts)
+	if err != nil {
+		return ocispec.Descriptor{}, mapORASError("push", reference, fmt.Errorf("pack manifest v1.1: %w", err))
+	}
+	return manDesc, nil
+}
+
+// ParsePlatform parses a platform of the form os/architecture[/variant]
+// (e.g., "linux/arm64/v8").
+func ParsePlatform(platform string) (ocispec.Platform, error) {
+	parts := strings.Split(platform, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return ocispec.Platform{}, fmt.Errorf("invalid platform %q: expected os/architecture[/variant]", platform)
+	}
+
+	parsed := ocispec.Platform{
+		OS:           parts[0],
+		Architecture: parts[1],
+	}
+	if len(parts) == 3 {
+		parsed.Variant = parts[2]
+	}
+	return parsed, nil
+}
+
+// platformConfig returns an image config declaring platform, which has the
+// form os/architecture[/variant].
+func platformConfig(platform string) ([]byte, error) {
+	parsed, err := ParsePlatform(platform)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(ocispec.Image{Platform: parsed})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode platform config: %w", err)
+	}
+	return data, nil
 }
 
 // Push pushes an artifact to an OCI registry using ORAS.
@@ -287,6 +666,13 @@ func Push(ctx context.Context, reference string, descriptor *PushDescriptor, opt
 		return mapORASError("push", reference, fmt.Errorf("reference must include a tag or digest"))
 	}
 
+	if len(descriptor.Variants) > 0 {
+		return pushIndex(ctx, repo, reference, refPart, descriptor)
+	}
+	if len(descriptor.Layers) > 0 {
+		return pushLayers(ctx, repo, reference, refPart, descriptor)
+	}
+
 	// Try streaming path first
 	if handled, sErr := pushStreamIfPossible(ctx, repo, reference, refPart, descriptor); handled {
 		return sErr
@@ -301,26 +687,29 @@ func Push(ctx context.Context, reference string, descriptor *PushDescriptor, opt
 		return mapORASError("push", reference, fmt.Errorf("no data to push"))
 	}
 
-	// 1) Push the content blob
-	blobDesc, bErr := oras.PushBytes(ctx, repo, descriptor.MediaType, data)
-	if bErr != nil {
-		return mapORASError("push", reference, fmt.Errorf("push blob: %w", bErr))
-	}
-
-	// 2) Pack an OCI 1.1 manifest with artifactType and empty config
-	packOpts := oras.PackManifestOptions{Layers: []ocispec.Descriptor{blobDesc}}
-	artifactType := "application/vnd.catalyst.bundle.v1"
-	manDesc, pErr := oras.PackManifest(ctx, repo, oras.PackManifestVersion1_1, artifactType, packOpts)
-	if pErr != nil {
-		return mapORASError("push", reference, fmt.Errorf("pack manifest v1.1: %w", pErr))
-	}
-
-	// 3) Tag the manifest with the requested ref
-	if _, tErr := oras.Tag(ctx, repo, manDesc.Digest.String(), refPart); tErr != nil {
-		return mapORASError("push", reference, fmt.Errorf("tag manifest: %w", tErr))
+	// 1) Push the content blob unless it can be mounted, in chunks if resumable
+	blobDesc := ocispec.Descriptor{
+		MediaType: descriptor.MediaType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+	switch {
+	case mountBlob(ctx, repo, blobDesc, descriptor.MountFrom):
+	case descriptor.Resume != nil:
+		bErr := pushBlobResumable(ctx, repo, blobDesc, bytes.NewReader(data), descriptor.Resume, nil)
+		if bErr != nil {
+			return mapORASError("push", reference, fmt.Errorf("push blob: %w", bErr))
+		}
+	default:
+		var bErr error
+		blobDesc, bErr = oras.PushBytes(ctx, repo, descriptor.MediaType, data)
+		if bErr != nil {
+			return mapORASError("push", reference, fmt.Errorf("push blob: %w", bErr))
+		}
 	}
 
-	return nil
+	// 2) Pack an OCI 1.1 manifest with artifactType and tag it with the requested ref
+	return packAndTag(ctx, repo, reference, refPart, []ocispec.Descriptor{blobDesc}, descriptor)
 }
 
 // PullDescriptor describes the content pulled from an OCI registry.
@@ -330,6 +719,15 @@ type PullDescriptor struct {
 	Data      io.ReadCloser
 	Size      int64
 	Digest    string // OCI digest of the blob (e.g., "sha256:abc123...")
+
+	// ManifestDigest is the digest of the manifest the reference resolved
+	// to, or of the content itself when the reference is not a manifest.
+	ManifestDigest string
+
+	// Layers lists every layer of the pulled manifest in order. Data, Digest,
+	// and Size describe the first one. Empty when the reference is not an
+	// image manifest.
+	Layers []LayerInfo
 }
 
 // Pull pulls an artifact from an OCI registry using ORAS.
@@ -363,10 +761,11 @@ func Pull(ctx context.Context, reference string, opts *AuthOptions) (*PullDescri
 	// If not a manifest, the fetched target is the content itself
 	if desc.MediaType != ocispec.MediaTypeImageManifest {
 		return &PullDescriptor{
-			MediaType: desc.MediaType,
-			Data:      reader,
-			Size:      desc.Size,
-			Digest:    desc.Digest.String(),
+			MediaType:      desc.MediaType,
+			Data:           reader,
+			Size:           desc.Size,
+			Digest:         desc.Digest.String(),
+			ManifestDigest: desc.Digest.String(),
 		}, nil
 	}
 
@@ -392,11 +791,22 @@ func Pull(ctx context.Context, reference string, opts *AuthOptions) (*PullDescri
 	if err != nil {
 		return nil, mapORASError("pull", reference, fmt.Errorf("fetch layer: %w", err))
 	}
+	layers := make([]LayerInfo, 0, len(imgMan.Layers))
+	for _, layer := range imgMan.Layers {
+		layers = append(layers, LayerInfo{
+			MediaType:   layer.MediaType,
+			Digest:      layer.Digest.String(),
+			Size:        layer.Size,
+			Annotations: layer.Annotations,
+		})
+	}
 	return &PullDescriptor{
-		MediaType: layerDesc.MediaType,
-		Data:      layerReader,
-		Size:      layerDesc.Size,
-		Digest:    layerDesc.Digest.String(),
+		MediaType:      layerDesc.MediaType,
+		Data:           layerReader,
+		Size:           layerDesc.Size,
+		Digest:         layerDesc.Digest.String(),
+		ManifestDigest: desc.Digest.String(),
+		Layers:         layers,
 	}, nil
 }
 
@@ -2,11 +2,16 @@ package oras
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
 )
 
@@ -217,3 +222,73 @@ func TestErrorMapping(t *testing.T) {
 			strings.Contains(err.Error(), "authentication"))
 	})
 }
+
+// TestMountBlob tests cross-repository blob mounting against a fake registry
+func TestMountBlob(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("bundle data")
+	desc := ocispec.Descriptor{
+		MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+
+	// newRegistry starts a fake registry where org/a holds the blob and
+	// mounting is answered with mountStatus.
+	newRegistry := func(t *testing.T, mountStatus int) (*remote.Repository, *[]string) {
+		var mounts []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodHead && strings.HasPrefix(r.URL.Path, "/v2/org/b/blobs/"):
+				w.WriteHeader(http.StatusNotFound)
+			case r.Method == http.MethodPost && r.URL.Path == "/v2/org/b/blobs/uploads/":
+				mounts = append(mounts, r.URL.Query().Get("from"))
+				if mountStatus == http.StatusCreated && r.URL.Query().Get("from") != "org/a" {
+					w.Header().Set("Location", "/v2/org/b/blobs/uploads/session")
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				w.Header().Set("Location", "/v2/org/b/blobs/uploads/session")
+				w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+				w.WriteHeader(mountStatus)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		t.Cleanup(server.Close)
+
+		repo, err := remote.NewRepository(strings.TrimPrefix(server.URL, "http://") + "/org/b")
+		require.NoError(t, err)
+		repo.PlainHTTP = true
+		return repo, &mounts
+	}
+
+	t.Run("mounts from source repository", func(t *testing.T) {
+		repo, mounts := newRegistry(t, http.StatusCreated)
+		source := repo.Reference.Registry + "/org/a"
+
+		assert.True(t, mountBlob(ctx, repo, desc, []string{"org/missing", source}))
+		assert.Equal(t, []string{"org/missing", "org/a"}, *mounts)
+	})
+
+	t.Run("skips other registries and target repository", func(t *testing.T) {
+		repo, mounts := newRegistry(t, http.StatusCreated)
+
+		assert.False(t, mountBlob(ctx, repo, desc, []string{"ghcr.io/org/a", "org/b"}))
+		assert.Empty(t, *mounts)
+	})
+
+	t.Run("falls back when mounting is unsupported", func(t *testing.T) {
+		repo, mounts := newRegistry(t, http.StatusAccepted)
+
+		assert.False(t, mountBlob(ctx, repo, desc, []string{"org/a"}))
+		assert.Equal(t, []string{"org/a"}, *mounts)
+	})
+
+	t.Run("no sources", func(t *testing.T) {
+		repo, mounts := newRegistry(t, http.StatusCreated)
+
+		assert.False(t, mountBlob(ctx, repo, desc, nil))
+		assert.Empty(t, *mounts)
+	})
+}
@@ -0,0 +1,476 @@
+package oras
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	"github.com/jmgilman/go/fs/core"
+)
+
+// DefaultChunkSize is the chunk size used by resumable uploads when
+// ResumeOptions.ChunkSize is not set.
+const DefaultChunkSize = 16 * 1024 * 1024 // 16MB
+
+// ResumeOptions enables resumable blob transfers. Blobs are uploaded in chunks
+// and downloaded into partial files, and the progress of each transfer is
+// recorded under StateDir so an interrupted transfer continues where it
+// stopped instead of starting over.
+type ResumeOptions struct {
+	// FS is the filesystem holding the transfer state.
+	FS core.FS
+
+	// StateDir is the directory where transfer state is persisted.
+	StateDir string
+
+	// ChunkSize is the size of each uploaded chunk in bytes.
+	// Defaults to DefaultChunkSize if not positive.
+	ChunkSize int64
+
+	// WrapBody, if non-nil, wraps the blob content read by DownloadBlob,
+	// e.g. to report progress.
+	WrapBody func(io.Reader) io.Reader
+}
+
+// errUploadOutOfSync signals that the registry rejected a chunk because its
+// offset does not match the data received by the upload session.
+var errUploadOutOfSync = errors.New("upload session out of sync")
+
+// uploadState is the persisted state of an in-progress chunked upload.
+type uploadState struct {
+	// Location is the upload session URL returned by the registry
+	Location string `json:"location"`
+}
+
+// chunkSize returns the configured chunk size or the default.
+func (o *ResumeOptions) chunkSize() int64 {
+	if o.ChunkSize > 0 {
+		return o.ChunkSize
+	}
+	return DefaultChunkSize
+}
+
+// uploadStatePath returns the path of the upload state for blob d in repo.
+// Upload sessions belong to a repository, so the key includes it.
+func (o *ResumeOptions) uploadStatePath(repo *remote.Repository, d digest.Digest) string {
+	sum := sha256.Sum256([]byte(repo.Reference.Registry + "/" + repo.Reference.Repository + "@" + d.String()))
+	return filepath.Join(o.StateDir, "uploads", hex.EncodeToString(sum[:])+".json")
+}
+
+// downloadPath returns the path where blob d is downloaded.
+func (o *ResumeOptions) downloadPath(d digest.Digest) string {
+	return filepath.Join(o.StateDir, "downloads", d.Algorithm().String()+"-"+d.Encoded())
+}
+
+// pushBlobResumable uploads the blob described by desc from data in chunks,
+// recording the upload session so that a later call for the same blob and
+// repository continues from the last chunk the registry accepted. Accepted
+// bytes are reported to progress, which may be nil.
+func pushBlobResumable(
+	ctx context.Context,
+	repo *remote.Repository,
+	desc ocispec.Descriptor,
+	data io.ReadSeeker,
+	resume *ResumeOptions,
+	progress *progressCounter,
+) error {
+	if exists, err := repo.Blobs().Exists(ctx, desc); err == nil && exists {
+		progress.add(desc.Size)
+		return nil
+	}
+
+	statePath := resume.uploadStatePath(repo, desc.Digest)
+	location, offset := resumeUpload(ctx, repo, resume.FS, statePath)
+	if location == "" {
+		var err error
+		if location, err = startUpload(ctx, repo); err != nil {
+			return err
+		}
+		offset = 0
+	}
+	if err := saveUploadState(resume.FS, statePath, location); err != nil {
+		return err
+	}
+	progress.add(offset)
+
+	for offset < desc.Size {
+		n := min(resume.chunkSize(), desc.Size-offset)
+		next, end, err := uploadChunk(ctx, repo, location, data, offset, n)
+		if errors.Is(err, errUploadOutOfSync) {
+			_ = resume.FS.Remove(statePath) // Start over on the next attempt
+		}
+		if err != nil {
+			return fmt.Errorf("upload chunk at offset %d: %w", offset, err)
+		}
+		location = next
+		progress.add(end - offset)
+		offset = end
+
+		if err := saveUploadState(resume.FS, statePath, location); err != nil {
+			return err
+		}
+	}
+
+	if err := completeUpload(ctx, repo, location, desc.Digest); err != nil {
+		return err
+	}
+	_ = resume.FS.Remove(statePath)
+	return nil
+}
+
+// resumeUpload loads the upload session recorded at statePath and asks the
+// registry how much of it was received. Returns an empty location if there is
+// no session or the registry no longer knows it.
+func resumeUpload(ctx context.Context, repo *remote.Repository, fsys core.FS, statePath string) (string, int64) {
+	data, err := fsys.ReadFile(statePath)
+	if err != nil {
+		return "", 0
+	}
+	var state uploadState
+	if err := json.Unmarshal(data, &state); err != nil || state.Location == "" {
+		_ = fsys.Remove(statePath)
+		return "", 0
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, state.Location, nil)
+	if err != nil {
+		return "", 0
+	}
+	resp, err := repoClient(repo).Do(req)
+	if err != nil {
+		return "", 0
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusNoContent {
+		_ = fsys.Remove(statePath)
+		return "", 0
+	}
+
+	location, err := uploadLocation(resp)
+	if err != nil {
+		location = state.Location
+	}
+	return location, uploadOffset(resp)
+}
+
+// startUpload opens an upload session in repo and returns its URL.
+func startUpload(ctx context.Context, repo *remote.Repository) (string, error) {
+	protocol := "https"
+	if repo.PlainHTTP {
+		protocol = "http"
+	}
+	uploadURL := fmt.Sprintf("%s://%s/v2/%s/blobs/uploads/", protocol, repo.Reference.Host(), repo.Reference.Repository)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := repoClient(repo).Do(req)
+	if err != nil {
+		return "", fmt.Errorf("start upload: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return "", fmt.Errorf("start upload: registry returned status %d", resp.StatusCode)
+	}
+	return uploadLocation(resp)
+}
+
+// uploadChunk sends size bytes of data, starting at offset, to the upload
+// session at location. Returns the session URL for the next request and the
+// offset the registry has received up to.
+func uploadChunk(
+	ctx context.Context,
+	repo *remote.Repository,
+	location string,
+	data io.ReadSeeker,
+	offset, size int64,
+) (string, int64, error) {
+	// The body is rewound for requests replayed with credentials
+	getBody := func() (io.ReadCloser, error) {
+		if _, err := data.Seek(offset, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("seek: %w", err)
+		}
+		return io.NopCloser(io.LimitReader(data, size)), nil
+	}
+	body, err := getBody()
+	if err != nil {
+		return "", 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, location, body)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.GetBody = getBody
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Range", fmt.Sprintf("%d-%d", offset, offset+size-1))
+
+	resp, err := repoClient(repo).Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+	case http.StatusRequestedRangeNotSatisfiable:
+		return "", 0, errUploadOutOfSync
+	default:
+		return "", 0, fmt.Errorf("registry returned status %d", resp.StatusCode)
+	}
+
+	next, err := uploadLocation(resp)
+	if err != nil {
+		return "", 0, err
+	}
+	end := offset + size
+	if received := uploadOffset(resp); received > 0 {
+		end = received
+	}
+	if end <= offset {
+		return "", 0, fmt.Errorf("registry did not accept the chunk")
+	}
+	return next, end, nil
+}
+
+// completeUpload closes the upload session at location, committing the blob
+// under digest d.
+func completeUpload(ctx context.Context, repo *remote.Repository, location string, d digest.Digest) error {
+	completeURL, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("invalid upload location: %w", err)
+	}
+	query := completeURL.Query()
+	query.Set("digest", d.String())
+	completeURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, completeURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := repoClient(repo).Do(req)
+	if err != nil {
+		return fmt.Errorf("complete upload: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("complete upload: registry returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// uploadLocation returns the absolute upload session URL from the Location
+// header of resp.
+func uploadLocation(resp *http.Response) (string, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("registry did not return an upload location")
+	}
+	parsed, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("invalid upload location: %w", err)
+	}
+	return resp.Request.URL.ResolveReference(parsed).String(), nil
+}
+
+// uploadOffset returns the number of bytes received by an upload session, as
+// reported by the "0-<end>" Range header of resp. Returns 0 if it is missing.
+// Registries report empty sessions as "0-0", so that is also treated as 0.
+func uploadOffset(resp *http.Response) int64 {
+	_, end, found := strings.Cut(resp.Header.Get("Range"), "-")
+	if !found || end == "0" {
+		return 0
+	}
+	last, err := strconv.ParseInt(end, 10, 64)
+	if err != nil || last < 0 {
+		return 0
+	}
+	return last + 1
+}
+
+// repoClient returns the HTTP client of repo, which handles authentication.
+func repoClient(repo *remote.Repository) remote.Client {
+	if repo.Client != nil {
+		return repo.Client
+	}
+	return auth.DefaultClient
+}
+
+// saveUploadState records the upload session at location in statePath.
+func saveUploadState(fsys core.FS, statePath, location string) error {
+	data, err := json.Marshal(uploadState{Location: location})
+	if err != nil {
+		return fmt.Errorf("failed to encode upload state: %w", err)
+	}
+	if err := fsys.MkdirAll(filepath.Dir(statePath), 0o755); err != nil {
+		return fmt.Errorf("failed to create upload state directory: %w", err)
+	}
+	if err := fsys.WriteFile(statePath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to save upload state: %w", err)
+	}
+	return nil
+}
+
+// DownloadBlob downloads the blob described by desc into the resume state
+// directory and returns the path of the verified blob. Bytes are written to a
+// partial file as they arrive, so when a download is interrupted the next call
+// requests only the missing range. If initial is non-nil and no partial file
+// exists, it is read instead of fetching the blob.
+//
+// The caller is responsible for removing the returned file.
+func DownloadBlob(
+	ctx context.Context,
+	repo *remote.Repository,
+	desc ocispec.Descriptor,
+	initial io.Reader,
+	resume *ResumeOptions,
+) (string, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return "", fmt.Errorf("invalid digest: %w", err)
+	}
+
+	path := resume.downloadPath(desc.Digest)
+	partial := path + ".partial"
+	if err := resume.FS.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create download directory: %w", err)
+	}
+
+	var offset int64
+	if info, err := resume.FS.Stat(partial); err == nil {
+		offset = info.Size()
+	}
+	if offset > desc.Size {
+		offset = 0
+	}
+
+	if offset < desc.Size {
+		body := initial
+		if offset > 0 || body == nil {
+			var err error
+			var closer io.Closer
+			body, closer, offset, err = fetchRange(ctx, repo, desc, offset)
+			if err != nil {
+				return "", err
+			}
+			defer func() { _ = closer.Close() }()
+		}
+
+		if resume.WrapBody != nil {
+			body = resume.WrapBody(body)
+		}
+		if err := appendPartial(resume.FS, partial, body, offset, desc.Size-offset); err != nil {
+			return "", err
+		}
+	}
+
+	if err := verifyBlob(resume.FS, partial, desc.Digest); err != nil {
+		_ = resume.FS.Remove(partial)
+		return "", err
+	}
+	if err := resume.FS.Rename(partial, path); err != nil {
+		return "", fmt.Errorf("failed to finalize download: %w", err)
+	}
+	return path, nil
+}
+
+// fetchRange fetches the blob described by desc starting at offset. Registries
+// that ignore range requests return the whole blob, in which case the returned
+// offset is 0.
+func fetchRange(
+	ctx context.Context,
+	repo *remote.Repository,
+	desc ocispec.Descriptor,
+	offset int64,
+) (io.Reader, io.Closer, int64, error) {
+	protocol := "https"
+	if repo.PlainHTTP {
+		protocol = "http"
+	}
+	blobURL := fmt.Sprintf("%s://%s/v2/%s/blobs/%s",
+		protocol, repo.Reference.Host(), repo.Reference.Repository, desc.Digest)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, blobURL, nil)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := repoClient(repo).Do(req)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("fetch blob: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		return resp.Body, resp.Body, offset, nil
+	case resp.StatusCode == http.StatusOK:
+		return resp.Body, resp.Body, 0, nil
+	default:
+		_ = resp.Body.Close()
+		return nil, nil, 0, fmt.Errorf("fetch blob: registry returned status %d", resp.StatusCode)
+	}
+}
+
+// appendPartial writes up to size bytes of body to the partial file at path,
+// starting at offset. A zero offset truncates the file.
+func appendPartial(fsys core.FS, path string, body io.Reader, offset, size int64) error {
+	flag := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if offset == 0 {
+		flag = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	}
+	file, err := fsys.OpenFile(path, flag, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open partial download: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	// A short body leaves the partial file in place to be resumed
+	if _, err := io.CopyN(file, body, size); err != nil {
+		return fmt.Errorf("download interrupted: %w", err)
+	}
+	return nil
+}
+
+// verifyBlob checks that the file at path matches digest d.
+func verifyBlob(fsys core.FS, path string, d digest.Digest) error {
+	file, err := fsys.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("download missing: %w", err)
+		}
+		return fmt.Errorf("failed to open download: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	verifier := d.Verifier()
+	if _, err := io.Copy(verifier, file); err != nil {
+		return fmt.Errorf("failed to read download: %w", err)
+	}
+	if !verifier.Verified() {
+		return fmt.Errorf("downloaded blob does not match digest %s", d)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package oras
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/registry/remote"
+
+	"github.com/jmgilman/go/fs/billy"
+)
+
+// chunkRegistry is a fake registry supporting chunked uploads and ranged
+// blob downloads for a single repository.
+type chunkRegistry struct {
+	mu       sync.Mutex
+	sessions map[string][]byte
+	blobs    map[digest.Digest][]byte
+
+	// failPatch is the number of the PATCH request that fails, counting from 1
+	failPatch int
+	patches   int
+	posts     int
+	ranges    []string
+}
+
+func newChunkRegistry(t *testing.T) (*chunkRegistry, *remote.Repository) {
+	t.Helper()
+
+	reg := &chunkRegistry{
+		sessions: make(map[string][]byte),
+		blobs:    make(map[digest.Digest][]byte),
+	}
+	server := httptest.NewServer(http.HandlerFunc(reg.serveHTTP))
+	t.Cleanup(server.Close)
+
+	repo, err := remote.NewRepository(strings.TrimPrefix(server.URL, "http://") + "/org/app")
+	require.NoError(t, err)
+	repo.PlainHTTP = true
+	return reg, repo
+}
+
+func (r *chunkRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	const uploads = "/v2/org/app/blobs/uploads/"
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == uploads:
+		r.posts++
+		id := strconv.Itoa(r.posts)
+		r.sessions[id] = nil
+		w.Header().Set("Location", uploads+id)
+		w.WriteHeader(http.StatusAccepted)
+	case strings.HasPrefix(req.URL.Path, uploads):
+		id := strings.TrimPrefix(req.URL.Path, uploads)
+		data, ok := r.sessions[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.serveSession(w, req, id, data)
+	case strings.HasPrefix(req.URL.Path, "/v2/org/app/blobs/"):
+		data, ok := r.blobs[digest.Digest(strings.TrimPrefix(req.URL.Path, "/v2/org/app/blobs/"))]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if rangeHeader := req.Header.Get("Range"); rangeHeader != "" {
+			r.ranges = append(r.ranges, rangeHeader)
+			start, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rangeHeader, "bytes="), "-"))
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(data)-1, len(data)))
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write(data[start:])
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (r *chunkRegistry) serveSession(w http.ResponseWriter, req *http.Request, id string, data []byte) {
+	location := req.URL.Path
+	switch req.Method {
+	case http.MethodGet:
+		w.Header().Set("Location", location)
+		w.Header().Set("Range", fmt.Sprintf("0-%d", max(len(data)-1, 0)))
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPatch:
+		r.patches++
+		chunk, _ := io.ReadAll(req.Body)
+		if r.patches == r.failPatch {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if !strings.HasPrefix(req.Header.Get("Content-Range"), strconv.Itoa(len(data))+"-") {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		r.sessions[id] = append(data, chunk...)
+		w.Header().Set("Location", location)
+		w.Header().Set("Range", fmt.Sprintf("0-%d", len(r.sessions[id])-1))
+		w.WriteHeader(http.StatusAccepted)
+	case http.MethodPut:
+		dgst := digest.Digest(req.URL.Query().Get("digest"))
+		if dgst != digest.FromBytes(data) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.blobs[dgst] = data
+		delete(r.sessions, id)
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// TestPushBlobResumable tests that chunked uploads resume after a failure
+func TestPushBlobResumable(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("0123456789")
+	desc := ocispec.Descriptor{
+		MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+
+	t.Run("uploads in chunks", func(t *testing.T) {
+		reg, repo := newChunkRegistry(t)
+		resume := &ResumeOptions{FS: billy.NewMemory(), StateDir: "state", ChunkSize: 4}
+
+		require.NoError(t, pushBlobResumable(ctx, repo, desc, bytes.NewReader(data), resume, nil))
+		assert.Equal(t, data, reg.blobs[desc.Digest])
+		assert.Equal(t, 3, reg.patches)
+
+		exists, err := resume.FS.Exists(resume.uploadStatePath(repo, desc.Digest))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("resumes interrupted upload", func(t *testing.T) {
+		reg, repo := newChunkRegistry(t)
+		reg.failPatch = 2
+		resume := &ResumeOptions{FS: billy.NewMemory(), StateDir: "state", ChunkSize: 4}
+
+		err := pushBlobResumable(ctx, repo, desc, bytes.NewReader(data), resume, nil)
+		require.Error(t, err)
+
+		var reported int64
+		progress := &progressCounter{total: desc.Size, report: func(current, _ int64) { reported = current }}
+		require.NoError(t, pushBlobResumable(ctx, repo, desc, bytes.NewReader(data), resume, progress))
+
+		assert.Equal(t, data, reg.blobs[desc.Digest])
+		assert.Equal(t, 1, reg.posts, "upload session should be reused")
+		assert.Equal(t, 4, reg.patches, "only the failed and remaining chunks should be resent")
+		assert.Equal(t, desc.Size, reported)
+	})
+
+	t.Run("restarts expired session", func(t *testing.T) {
+		reg, repo := newChunkRegistry(t)
+		resume := &ResumeOptions{FS: billy.NewMemory(), StateDir: "state", ChunkSize: 4}
+		require.NoError(t, saveUploadState(resume.FS, resume.uploadStatePath(repo, desc.Digest),
+			"http://"+repo.Reference.Registry+"/v2/org/app/blobs/uploads/expired"))
+
+		require.NoError(t, pushBlobResumable(ctx, repo, desc, bytes.NewReader(data), resume, nil))
+		assert.Equal(t, data, reg.blobs[desc.Digest])
+		assert.Equal(t, 1, reg.posts)
+	})
+
+	t.Run("skips existing blob", func(t *testing.T) {
+		reg, repo := newChunkRegistry(t)
+		reg.blobs[desc.Digest] = data
+		resume := &ResumeOptions{FS: billy.NewMemory(), StateDir: "state"}
+
+		require.NoError(t, pushBlobResumable(ctx, repo, desc, bytes.NewReader(data), resume, nil))
+		assert.Zero(t, reg.posts)
+	})
+}
+
+// TestDownloadBlob tests that interrupted downloads resume with a range request
+func TestDownloadBlob(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("0123456789")
+	desc := ocispec.Descriptor{
+		MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+
+	t.Run("resumes interrupted download", func(t *testing.T) {
+		reg, repo := newChunkRegistry(t)
+		reg.blobs[desc.Digest] = data
+		resume := &ResumeOptions{FS: billy.NewMemory(), StateDir: "state"}
+
+		interrupted := io.MultiReader(bytes.NewReader(data[:4]), iotest.ErrReader(errors.New("connection reset")))
+		_, err := DownloadBlob(ctx, repo, desc, interrupted, resume)
+		require.Error(t, err)
+
+		path, err := DownloadBlob(ctx, repo, desc, nil, resume)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bytes=4-"}, reg.ranges)
+
+		downloaded, err := resume.FS.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, data, downloaded)
+	})
+
+	t.Run("reads initial stream", func(t *testing.T) {
+		reg, repo := newChunkRegistry(t)
+		resume := &ResumeOptions{FS: billy.NewMemory(), StateDir: "state"}
+
+		path, err := DownloadBlob(ctx, repo, desc, bytes.NewReader(data), resume)
+		require.NoError(t, err)
+		assert.Empty(t, reg.ranges)
+
+		downloaded, err := resume.FS.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, data, downloaded)
+	})
+
+	t.Run("rejects corrupted download", func(t *testing.T) {
+		_, repo := newChunkRegistry(t)
+		resume := &ResumeOptions{FS: billy.NewMemory(), StateDir: "state"}
+
+		_, err := DownloadBlob(ctx, repo, desc, bytes.NewReader([]byte("9876543210")), resume)
+		require.Error(t, err)
+
+		exists, err := resume.FS.Exists(resume.downloadPath(desc.Digest) + ".partial")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
@@ -0,0 +1,459 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains multi-layer bundle support.
+package ocibundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/sync/errgroup"
+	"oras.land/oras-go/v2/registry/remote"
+
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// DefaultLayerName is the layer that receives entries for which a layer split
+// function returns an empty name.
+const DefaultLayerName = "default"
+
+// SplitByTopLevelDir is a layer split function for WithLayerSplit that places
+// each top-level directory in its own layer named after the directory. Files at
+// the root of the bundle are placed in DefaultLayerName.
+func SplitByTopLevelDir(path string) string {
+	dir, _, found := strings.Cut(path, "/")
+	if !found {
+		return ""
+	}
+	return dir
+}
+
+// bundleLayer is a group of files staged for archiving as a single layer.
+type bundleLayer struct {
+	name string
+	dir  string
+}
+
+// stageLayers copies the entries of sourceDir into one staging directory per
+// layer under stagingDir, as assigned by split. Files and empty directories are
+// passed to split; other directories are recreated in the layers of the entries
+// they contain. Entries matching exclude or the bundle's ignore file are left
+// out. Layers are returned sorted by name so pushes are reproducible.
+func (c *Client) stageLayers(
+	sourceDir, stagingDir string,
+	split func(path string) string,
+	exclude []string,
+) ([]bundleLayer, error) {
+	rules, err := loadIgnoreRules(c.options.FS, sourceDir, exclude)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := collectFileInfos(c.options.FS, sourceDir, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	// Directories with children are created implicitly in each layer that needs them
+	nonEmpty := make(map[string]bool)
+	dirModes := make(map[string]fs.FileMode)
+	for _, entry := range entries {
+		nonEmpty[filepath.Dir(entry.relPath)] = true
+		if entry.info.IsDir() {
+			dirModes[entry.relPath] = entry.info.Mode().Perm()
+		}
+	}
+
+	layerDirs := make(map[string]string)
+	for _, entry := range entries {
+		if entry.info.IsDir() && nonEmpty[entry.relPath] {
+			continue
+		}
+		if !entry.info.IsDir() && !entry.info.Mode().IsRegular() {
+			continue // Only regular files and directories are archived
+		}
+
+		name := split(filepath.ToSlash(entry.relPath))
+		if name == "" {
+			name = DefaultLayerName
+		}
+		layerDir, ok := layerDirs[name]
+		if !ok {
+			layerDir = filepath.Join(stagingDir, fmt.Sprintf("layer-%d", len(layerDirs)))
+			layerDirs[name] = layerDir
+		}
+
+		target := filepath.Join(layerDir, entry.relPath)
+		parentMode := dirModes[filepath.Dir(entry.relPath)]
+		if parentMode == 0 {
+			parentMode = 0o755
+		}
+		if err := c.options.FS.MkdirAll(filepath.Dir(target), parentMode); err != nil {
+			return nil, fmt.Errorf("failed to create directory for %s: %w", entry.relPath, err)
+		}
+
+		if entry.info.IsDir() {
+			if err := c.options.FS.MkdirAll(target, entry.info.Mode().Perm()); err != nil {
+				return nil, fmt.Errorf("failed to create directory %s: %w", entry.relPath, err)
+			}
+			continue
+		}
+		if err := c.copyFile(entry.path, target, entry.info.Mode().Perm()); err != nil {
+			return nil, err
+		}
+	}
+
+	layers := make([]bundleLayer, 0, len(layerDirs))
+	for name, dir := range layerDirs {
+		layers = append(layers, bundleLayer{name: name, dir: dir})
+	}
+	slices.SortFunc(layers, func(a, b bundleLayer) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return layers, nil
+}
+
+// copyFile copies a regular file within the client filesystem.
+func (c *Client) copyFile(src, dst string, perm fs.FileMode) error {
+	in, err := c.options.FS.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s: %w", src, err)
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := c.options.FS.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return fmt.Errorf("failed to create file %s: %w", dst, err)
+	}
+	defer func() { _ = out.Close() }()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return fmt.Errorf("failed to copy file %s: %w", src, err)
+	}
+	return nil
+}
+
+// pushLayers archives sourceDir as one layer per group returned by the push
+// options' LayerSplit function and pushes the layers as a single artifact.
+// Each layer is annotated with its name under ocispec.AnnotationTitle.
+func (c *Client) pushLayers(ctx context.Context, sourceDir, reference string, pushOpts *PushOptions) error {
+	if c.shouldSignArtifact(pushOpts) {
+		return fmt.Errorf("signing is not supported for multi-layer bundles")
+	}
+
+	tempDir, tmpErr := c.createTempDir("ocibundle-layers-")
+	if tmpErr != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", tmpErr)
+	}
+	defer func() { _ = c.removeAllFS(tempDir) }()
+
+	stagingDir := filepath.Join(tempDir, "staging")
+	layers, err := c.stageLayers(sourceDir, stagingDir, pushOpts.LayerSplit, pushOpts.Archive.Exclude)
+	if err != nil {
+		return fmt.Errorf("failed to split bundle into layers: %w", err)
+	}
+	if len(layers) == 0 {
+		return fmt.Errorf("source directory is empty: %s", sourceDir)
+	}
+
+	archiver, err := c.pushArchiverFor(pushOpts)
+	if err != nil {
+		return err
+	}
+	descriptors := make([]orasint.LayerDescriptor, 0, len(layers))
+	for i, layer := range layers {
+		archivePath := filepath.Join(tempDir, fmt.Sprintf("layer-%d.archive", i))
+		archiveFile, openErr := c.options.FS.OpenFile(archivePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o600)
+		if openErr != nil {
+			return fmt.Errorf("failed to create temporary file: %w", openErr)
+		}
+		defer func() { _ = archiveFile.Close() }()
+
+		archive := c.observeArchive(ctx, reference, func(w io.Writer) error {
+			return archiver.Archive(ctx, layer.dir, w)
+		})
+		if err := archive(archiveFile); err != nil {
+			return fmt.Errorf("failed to archive layer %s: %w", layer.name, err)
+		}
+
+		stat, statErr := archiveFile.Stat()
+		if statErr != nil {
+			return fmt.Errorf("failed to get file size: %w", statErr)
+		}
+		data, ok := archiveFile.(io.ReadSeeker)
+		if !ok {
+			return fmt.Errorf("filesystem does not support seeking temporary files")
+		}
+
+		descriptors = append(descriptors, orasint.LayerDescriptor{
+			MediaType:   archiver.MediaType(),
+			Data:        data,
+			Size:        stat.Size(),
+			Annotations: map[string]string{ocispec.AnnotationTitle: layer.name},
+		})
+	}
+
+	var size int64
+	for _, desc := range descriptors {
+		size += desc.Size
+	}
+
+	uploadCtx, span := c.startPhase(ctx, PhaseUpload, reference)
+	pushErr := retryOperation(uploadCtx, pushOpts.Retry, func() error {
+		for _, layer := range descriptors {
+			if _, seekErr := layer.Data.Seek(0, io.SeekStart); seekErr != nil {
+				return fmt.Errorf("failed to seek layer archive: %w", seekErr)
+			}
+		}
+		desc := &orasint.PushDescriptor{
+			MediaType:   archiver.MediaType(),
+			Annotations: pushOpts.Annotations,
+			Platform:    pushOpts.Platform,
+			MountFrom:   pushOpts.MountFrom,
+			Layers:      descriptors,
+			Concurrency: c.options.Concurrency,
+			Progress:    pushOpts.ProgressCallback,
+			Resume:      c.resumeOptions(),
+		}
+		return c.orasClient.Push(uploadCtx, reference, desc, c.options.Auth)
+	})
+	span.end(size, pushErr)
+	if pushErr != nil {
+		return fmt.Errorf("failed to push artifact after %d retries: %w", pushOpts.Retry.MaxRetries, pushErr)
+	}
+
+	return nil
+}
+
+// selectLayers returns the layers whose names are listed in names, or all
+// layers if names is empty. Layer names are read from ocispec.AnnotationTitle.
+func selectLayers(layers []orasint.LayerInfo, names []string) ([]orasint.LayerInfo, error) {
+	if len(names) == 0 {
+		return layers, nil
+	}
+
+	var selected []orasint.LayerInfo
+	found := make(map[string]bool)
+	for _, layer := range layers {
+		name := layer.Annotations[ocispec.AnnotationTitle]
+		if slices.Contains(names, name) {
+			selected = append(selected, layer)
+			found[name] = true
+		}
+	}
+	for _, name := range names {
+		if !found[name] {
+			return nil, fmt.Errorf("layer not found in artifact: %s", name)
+		}
+	}
+	return selected, nil
+}
+
+// extractLayers extracts the given layers of a pulled artifact into targetDir.
+// The first layer is read from descriptor.Data; the others are fetched by
+// digest. Each layer is extracted with the archiver registered for its media
+// type, and the file count and size limits apply to all layers combined.
+func (c *Client) extractLayers(
+	ctx context.Context,
+	repo *remote.Repository,
+	descriptor *orasint.PullDescriptor,
+	layers []orasint.LayerInfo,
+	targetDir string,
+	pullOpts *PullOptions,
+) error {
+	opts := pullExtractOptions(pullOpts)
+
+	tempDir, tmpErr := c.createTempDir("ocibundle-layers-")
+	if tmpErr != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", tmpErr)
+	}
+	defer func() { _ = c.removeAllFS(tempDir) }()
+
+	blobPaths, err := c.prefetchLayers(ctx, repo, descriptor, layers, tempDir, pullOpts)
+	if err != nil {
+		return err
+	}
+
+	remaining := opts
+	streamUsed := false
+	layerDirs := make([]string, 0, len(layers))
+	for i, layer := range layers {
+		if i > 0 && ((opts.MaxFiles > 0 && remaining.MaxFiles <= 0) || (opts.MaxSize > 0 && remaining.MaxSize <= 0)) {
+			return fmt.Errorf("archive exceeds extraction limits across layers")
+		}
+
+		// The pulled stream holds the first manifest layer; other layers are fetched
+		var data io.Reader
+		if blobPaths != nil {
+			blob, err := c.options.FS.Open(blobPaths[i])
+			if err != nil {
+				return fmt.Errorf("failed to open layer %s: %w", layer.Digest, err)
+			}
+			defer func() { _ = blob.Close() }()
+			data = blob
+		} else {
+			var stream io.Reader
+			if !streamUsed && layer.Digest == descriptor.Digest {
+				stream = descriptor.Data
+				streamUsed = true
+			}
+			blob, err := c.fetchBlob(ctx, repo, stream, layer, pullOpts)
+			if err != nil {
+				return fmt.Errorf("failed to fetch layer %s: %w", layer.Digest, err)
+			}
+			defer func() { _ = blob.Close() }()
+			data = blob
+		}
+
+		layerDir := filepath.Join(tempDir, fmt.Sprintf("layer-%d", i))
+		layerOpts := pullOpts.progress.extractOptions(layer.Digest, remaining)
+		err := c.observeExtract(ctx, repo.Reference.String(), layer.Digest, data, func(ctx context.Context, data io.Reader) error {
+			return c.extractLayer(ctx, data, layer, layerDir, layerOpts)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to extract layer %s: %w", layer.Digest, err)
+		}
+		layerDirs = append(layerDirs, layerDir)
+
+		files, size, err := c.dirStats(layerDir)
+		if err != nil {
+			return err
+		}
+		remaining.MaxFiles -= files
+		remaining.MaxSize -= size
+	}
+
+	if err := c.options.FS.MkdirAll(targetDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create target directory: %w", err)
+	}
+	for _, layerDir := range layerDirs {
+		if err := c.moveExtracted(layerDir, targetDir, opts); err != nil {
+			_ = c.removeAllFS(targetDir)
+			return fmt.Errorf("failed to move extracted files: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// prefetchLayers downloads layers into files under tempDir in parallel, up to
+// the client's concurrency, and returns the file of each layer by index.
+// The first layer matching the pulled stream is copied from it instead of
+// being fetched again. Returns nil without downloading anything if the client
+// transfers one layer at a time, in which case layers are streamed as they are
+// extracted.
+func (c *Client) prefetchLayers(
+	ctx context.Context,
+	repo *remote.Repository,
+	descriptor *orasint.PullDescriptor,
+	layers []orasint.LayerInfo,
+	tempDir string,
+	pullOpts *PullOptions,
+) ([]string, error) {
+	if c.options.Concurrency <= 1 || len(layers) <= 1 {
+		return nil, nil
+	}
+
+	blobDir := filepath.Join(tempDir, "blobs")
+	if err := c.options.FS.MkdirAll(blobDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	paths := make([]string, len(layers))
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(c.options.Concurrency)
+	streamUsed := false
+	for i, layer := range layers {
+		paths[i] = filepath.Join(blobDir, fmt.Sprintf("layer-%d", i))
+
+		var stream io.Reader
+		if !streamUsed && layer.Digest == descriptor.Digest {
+			stream = descriptor.Data
+			streamUsed = true
+		}
+
+		eg.Go(func() error {
+			if err := c.downloadLayer(egCtx, repo, stream, layer, paths[i], pullOpts); err != nil {
+				return fmt.Errorf("failed to download layer %s: %w", layer.Digest, err)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// downloadLayer writes a layer blob to path, copying it from data or, if data
+// is nil, fetching it by digest.
+func (c *Client) downloadLayer(
+	ctx context.Context,
+	repo *remote.Repository,
+	data io.Reader,
+	layer orasint.LayerInfo,
+	path string,
+	pullOpts *PullOptions,
+) error {
+	blob, err := c.fetchBlob(ctx, repo, data, layer, pullOpts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch layer: %w", err)
+	}
+	defer func() { _ = blob.Close() }()
+
+	file, err := c.options.FS.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err := io.Copy(file, blob); err != nil {
+		return fmt.Errorf("failed to write layer: %w", err)
+	}
+	return nil
+}
+
+// extractLayer extracts a single layer read from data into layerDir.
+func (c *Client) extractLayer(
+	ctx context.Context,
+	data io.Reader,
+	layer orasint.LayerInfo,
+	layerDir string,
+	opts ExtractOptions,
+) error {
+	if err := c.pullArchiver(layer.MediaType).Extract(ctx, data, layerDir, opts); err != nil {
+		return fmt.Errorf("extraction failed: %w", err)
+	}
+	return nil
+}
+
+// dirStats returns the number of regular files under dir and their total size.
+func (c *Client) dirStats(dir string) (int, int64, error) {
+	var files int
+	var size int64
+	err := c.options.FS.Walk(dir, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return fmt.Errorf("failed to walk path %s: %w", path, walkErr)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("failed to get file info for %s: %w", path, err)
+		}
+		files++
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to inspect extracted layer: %w", err)
+	}
+	return files, size, nil
+}
@@ -0,0 +1,341 @@
+package ocibundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/oci/internal/oras"
+	"github.com/jmgilman/go/oci/internal/oras/mocks"
+	"github.com/jmgilman/go/oci/internal/testutil"
+)
+
+// TestSplitByTopLevelDir tests the top-level directory split function.
+func TestSplitByTopLevelDir(t *testing.T) {
+	assert.Equal(t, "", SplitByTopLevelDir("readme.txt"))
+	assert.Equal(t, "config", SplitByTopLevelDir("config/app.yaml"))
+	assert.Equal(t, "src", SplitByTopLevelDir("src/util/helper.go"))
+}
+
+// TestSelectLayers tests selecting layers by name.
+func TestSelectLayers(t *testing.T) {
+	layers := []oras.LayerInfo{
+		{Digest: "sha256:a", Annotations: map[string]string{ocispec.AnnotationTitle: "config"}},
+		{Digest: "sha256:b", Annotations: map[string]string{ocispec.AnnotationTitle: "src"}},
+	}
+
+	t.Run("all layers", func(t *testing.T) {
+		selected, err := selectLayers(layers, nil)
+		require.NoError(t, err)
+		assert.Equal(t, layers, selected)
+	})
+
+	t.Run("named layer", func(t *testing.T) {
+		selected, err := selectLayers(layers, []string{"src"})
+		require.NoError(t, err)
+		require.Len(t, selected, 1)
+		assert.Equal(t, "sha256:b", selected[0].Digest)
+	})
+
+	t.Run("missing layer", func(t *testing.T) {
+		_, err := selectLayers(layers, []string{"docs"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "layer not found in artifact: docs")
+	})
+}
+
+// TestClient_Push_WithLayerSplit tests pushing a bundle as multiple layers.
+func TestClient_Push_WithLayerSplit(t *testing.T) {
+	mem := billy.NewMemory()
+	require.NoError(t, mem.MkdirAll("/src/config", 0o755))
+	require.NoError(t, mem.MkdirAll("/src/bin/tools", 0o755))
+	require.NoError(t, mem.MkdirAll("/src/empty", 0o755))
+	require.NoError(t, mem.WriteFile("/src/readme.txt", []byte("readme"), 0o644))
+	require.NoError(t, mem.WriteFile("/src/config/app.yaml", []byte("app: test"), 0o644))
+	require.NoError(t, mem.WriteFile("/src/bin/tools/run.sh", []byte("#!/bin/sh"), 0o755))
+
+	var pushed []oras.LayerDescriptor
+	var blobs [][]byte
+	mockORAS := &mocks.ClientMock{
+		PushFunc: func(_ context.Context, _ string, descriptor *oras.PushDescriptor, _ *oras.AuthOptions) error {
+			pushed = descriptor.Layers
+			blobs = nil
+			for _, layer := range descriptor.Layers {
+				data, err := io.ReadAll(layer.Data)
+				if err != nil {
+					return err
+				}
+				blobs = append(blobs, data)
+			}
+			return nil
+		},
+	}
+
+	client, err := NewWithOptions(WithORASClient(mockORAS), WithFilesystem(mem))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, client.Push(ctx, "/src", "example.com/repo:tag", WithLayerSplit(SplitByTopLevelDir)))
+
+	require.Len(t, pushed, 3)
+	var names []string
+	for _, layer := range pushed {
+		names = append(names, layer.Annotations[ocispec.AnnotationTitle])
+		assert.Equal(t, "application/vnd.oci.image.layer.v1.tar+gzip", layer.MediaType)
+	}
+	assert.Equal(t, []string{"bin", "config", DefaultLayerName}, names)
+
+	// Each layer contains only its own files
+	wantFiles := map[string][]string{
+		"bin":            {"bin/tools/run.sh"},
+		"config":         {"config/app.yaml"},
+		DefaultLayerName: {"readme.txt"},
+	}
+	archiver := NewTarGzArchiverWithFS(mem)
+	for i, name := range names {
+		dir := "/extracted/" + name
+		require.NoError(t, archiver.Extract(ctx, bytes.NewReader(blobs[i]), dir, DefaultExtractOptions))
+		for _, file := range wantFiles[name] {
+			exists, err := mem.Exists(dir + "/" + file)
+			require.NoError(t, err)
+			assert.True(t, exists, "layer %s should contain %s", name, file)
+		}
+	}
+
+	exists, err := mem.Exists("/extracted/" + DefaultLayerName + "/empty")
+	require.NoError(t, err)
+	assert.True(t, exists, "empty directories should be kept")
+
+	exists, err = mem.Exists("/extracted/config/readme.txt")
+	require.NoError(t, err)
+	assert.False(t, exists, "root files should not be in the config layer")
+}
+
+// TestClient_Pull_WithLayers tests pulling a single named layer.
+func TestClient_Pull_WithLayers(t *testing.T) {
+	mem := billy.NewMemory()
+	require.NoError(t, mem.MkdirAll("/layer/config", 0o755))
+	require.NoError(t, mem.WriteFile("/layer/config/app.yaml", []byte("app: test"), 0o644))
+
+	var layer bytes.Buffer
+	require.NoError(t, NewTarGzArchiverWithFS(mem).Archive(context.Background(), "/layer", &layer))
+
+	layers := []oras.LayerInfo{
+		{
+			MediaType:   "application/vnd.oci.image.layer.v1.tar+gzip",
+			Digest:      "sha256:config",
+			Size:        int64(layer.Len()),
+			Annotations: map[string]string{ocispec.AnnotationTitle: "config"},
+		},
+		{
+			MediaType:   "application/vnd.oci.image.layer.v1.tar+gzip",
+			Digest:      "sha256:src",
+			Annotations: map[string]string{ocispec.AnnotationTitle: "src"},
+		},
+	}
+	mockORAS := &mocks.ClientMock{
+		PullFunc: func(_ context.Context, _ string, _ *oras.AuthOptions) (*oras.PullDescriptor, error) {
+			return &oras.PullDescriptor{
+				MediaType: layers[0].MediaType,
+				Data:      &mockReadCloserForTest{data: layer.Bytes()},
+				Size:      layers[0].Size,
+				Digest:    layers[0].Digest,
+				Layers:    layers,
+			}, nil
+		},
+	}
+
+	client, err := NewWithOptions(WithORASClient(mockORAS), WithFilesystem(mem))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("pulls named layer from stream", func(t *testing.T) {
+		require.NoError(t, client.Pull(ctx, "example.com/repo:tag", "/dst", WithLayers("config")))
+
+		content, err := mem.ReadFile("/dst/config/app.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "app: test", string(content))
+	})
+
+	t.Run("fails for missing layer", func(t *testing.T) {
+		err := client.Pull(ctx, "example.com/repo:tag", "/missing", WithLayers("docs"), WithPullMaxRetries(0))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "layer not found in artifact: docs")
+	})
+}
+
+// TestClient_LayerSplit_RejectsSignatures tests that multi-layer bundles are not signed or verified.
+func TestClient_LayerSplit_RejectsSignatures(t *testing.T) {
+	mem := billy.NewMemory()
+	require.NoError(t, mem.MkdirAll("/src", 0o755))
+	require.NoError(t, mem.WriteFile("/src/readme.txt", []byte("readme"), 0o644))
+
+	mockORAS := &mocks.ClientMock{
+		PullFunc: func(_ context.Context, _ string, _ *oras.AuthOptions) (*oras.PullDescriptor, error) {
+			return &oras.PullDescriptor{
+				Data:   &mockReadCloserForTest{},
+				Layers: []oras.LayerInfo{{Digest: "sha256:a"}, {Digest: "sha256:b"}},
+			}, nil
+		},
+	}
+
+	ctx := context.Background()
+
+	signer, err := NewWithOptions(WithORASClient(mockORAS), WithFilesystem(mem), WithSignatureSigner(&callbackSigner{
+		signFunc: func(context.Context, string, string, map[string]string) error { return nil },
+	}))
+	require.NoError(t, err)
+	err = signer.Push(ctx, "/src", "example.com/repo:tag", WithLayerSplit(SplitByTopLevelDir))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signing is not supported for multi-layer bundles")
+
+	verifier, err := NewWithOptions(WithORASClient(mockORAS), WithFilesystem(mem), WithSignatureVerifier(&testutil.MockVerifier{ShouldSucceed: true}))
+	require.NoError(t, err)
+	err = verifier.Pull(ctx, "example.com/repo:tag", "/dst", WithPullMaxRetries(0))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature verification is not supported for multi-layer bundles")
+}
+
+// putLayeredArtifact stores a manifest with one titled layer per archive in
+// repository under tag.
+func (r *fakeCopyRegistry) putLayeredArtifact(t *testing.T, repository, tag string, archives map[string][]byte) {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.blobs[repository] == nil {
+		r.blobs[repository] = make(map[digest.Digest][]byte)
+		r.manifests[repository] = make(map[string][]byte)
+	}
+	r.blobs[repository][ocispec.DescriptorEmptyJSON.Digest] = ocispec.DescriptorEmptyJSON.Data
+
+	names := make([]string, 0, len(archives))
+	for name := range archives {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	layers := make([]ocispec.Descriptor, 0, len(names))
+	for _, name := range names {
+		data := archives[name]
+		r.blobs[repository][digest.FromBytes(data)] = data
+		layers = append(layers, ocispec.Descriptor{
+			MediaType:   "application/vnd.oci.image.layer.v1.tar+gzip",
+			Digest:      digest.FromBytes(data),
+			Size:        int64(len(data)),
+			Annotations: map[string]string{ocispec.AnnotationTitle: name},
+		})
+	}
+
+	manifest, err := json.Marshal(ocispec.Manifest{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    ocispec.MediaTypeImageManifest,
+		ArtifactType: "application/vnd.catalyst.bundle.v1",
+		Config:       ocispec.DescriptorEmptyJSON,
+		Layers:       layers,
+	})
+	require.NoError(t, err)
+
+	r.manifests[repository][digest.FromBytes(manifest).String()] = manifest
+	r.manifests[repository][tag] = manifest
+}
+
+// TestClient_Concurrency tests transferring layers in parallel.
+func TestClient_Concurrency(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejects invalid concurrency", func(t *testing.T) {
+		_, err := NewWithOptions(WithConcurrency(0))
+		require.Error(t, err)
+	})
+
+	t.Run("pulls layers in parallel", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		archives := map[string][]byte{}
+		for _, name := range []string{"a", "b", "c", "d"} {
+			archives[name] = buildTestTarGz(t, map[string]string{name + "/file.txt": "layer " + name})
+		}
+		reg.putLayeredArtifact(t, "org/app", "v1", archives)
+
+		client, err := NewWithOptions(WithAllowHTTP(), WithConcurrency(3))
+		require.NoError(t, err)
+
+		targetDir := filepath.Join(t.TempDir(), "bundle")
+		require.NoError(t, client.Pull(ctx, reg.host+"/org/app:v1", targetDir))
+
+		for name := range archives {
+			content, err := os.ReadFile(filepath.Join(targetDir, name, "file.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "layer "+name, string(content))
+		}
+	})
+
+	t.Run("enforces limits across parallel layers", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		reg.putLayeredArtifact(t, "org/app", "v1", map[string][]byte{
+			"a": buildTestTarGz(t, map[string]string{"a.txt": "0123456789"}),
+			"b": buildTestTarGz(t, map[string]string{"b.txt": "0123456789"}),
+		})
+
+		client, err := NewWithOptions(WithAllowHTTP(), WithConcurrency(2))
+		require.NoError(t, err)
+
+		err = client.Pull(ctx, reg.host+"/org/app:v1", filepath.Join(t.TempDir(), "bundle"), WithPullMaxSize(15))
+		require.Error(t, err)
+	})
+
+	t.Run("pushes layers in parallel with aggregated progress", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		descriptors := make([]oras.LayerDescriptor, 0, 4)
+		var total int64
+		for _, name := range []string{"a", "b", "c", "d"} {
+			data := buildTestTarGz(t, map[string]string{name + ".txt": name})
+			total += int64(len(data))
+			descriptors = append(descriptors, oras.LayerDescriptor{
+				MediaType:   "application/vnd.oci.image.layer.v1.tar+gzip",
+				Data:        bytes.NewReader(data),
+				Size:        int64(len(data)),
+				Annotations: map[string]string{ocispec.AnnotationTitle: name},
+			})
+		}
+
+		var mu sync.Mutex
+		var last int64
+		err := oras.Push(ctx, reg.host+"/org/app:v1", &oras.PushDescriptor{
+			Layers:      descriptors,
+			Concurrency: 4,
+			Progress: func(current, reportedTotal int64) {
+				mu.Lock()
+				defer mu.Unlock()
+				assert.Equal(t, total, reportedTotal)
+				assert.GreaterOrEqual(t, current, last)
+				last = current
+			},
+		}, &oras.AuthOptions{HTTPConfig: &oras.HTTPConfig{AllowHTTP: true}})
+		require.NoError(t, err)
+		assert.Equal(t, total, last)
+
+		manifestBytes, ok := reg.manifest("org/app", "v1")
+		require.True(t, ok)
+		var manifest ocispec.Manifest
+		require.NoError(t, json.Unmarshal(manifestBytes, &manifest))
+		require.Len(t, manifest.Layers, 4)
+		for i, name := range []string{"a", "b", "c", "d"} {
+			assert.Equal(t, name, manifest.Layers[i].Annotations[ocispec.AnnotationTitle])
+		}
+	})
+}
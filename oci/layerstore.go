@@ -0,0 +1,296 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains the shared layer store that deduplicates cached layers
+// across references.
+package ocibundle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/jmgilman/go/fs/core"
+	"github.com/jmgilman/go/oci/cache"
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// layerStoreDir is the directory under the cache path that holds the layers
+// extracted for WithLinkedExtraction.
+const layerStoreDir = "layers"
+
+// cacheLayer fetches the manifest of reference and makes sure its layer is
+// cached. Layers are cached by digest, so the layer is only downloaded if no
+// reference with the same layer was cached before. It returns the layer
+// digest. Multi-layer bundles are not cached.
+//
+// Only used with WithLinkedExtraction, where the cached layer is extracted
+// into the shared layer store.
+func (c *Client) cacheLayer(
+	ctx context.Context,
+	coordinator *cache.Coordinator,
+	reference string,
+	pullOpts *PullOptions,
+) (string, error) {
+	repo, err := c.createRepository(ctx, reference)
+	if err != nil {
+		return "", err
+	}
+
+	descriptor, err := c.fetchVerified(ctx, reference, pullOpts)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = descriptor.Data.Close() }()
+
+	if len(descriptor.Layers) > 1 {
+		return "", fmt.Errorf("multi-layer bundles are not cached")
+	}
+	if cached, err := coordinator.HasBlob(ctx, descriptor.Digest); err == nil && cached {
+		return descriptor.Digest, nil
+	}
+
+	expected, err := digest.Parse(descriptor.Digest)
+	if err != nil {
+		return "", fmt.Errorf("invalid layer digest: %w", err)
+	}
+
+	pullOpts.progress = newPullProgress(pullOpts, pulledLayers(descriptor))
+	blob, err := c.fetchBlob(ctx, repo, descriptor.Data, orasint.LayerInfo{
+		MediaType: descriptor.MediaType,
+		Digest:    descriptor.Digest,
+		Size:      descriptor.Size,
+	}, pullOpts)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = blob.Close() }()
+
+	// The layer is verified while it is streamed into the cache, so a
+	// mismatch fails PutBlob before the blob is committed
+	verified := &verifyingReader{reader: blob, verifier: expected.Verifier(), digest: expected}
+	if err := coordinator.PutBlob(ctx, descriptor.Digest, verified); err != nil {
+		return "", fmt.Errorf("failed to cache layer: %w", err)
+	}
+	return descriptor.Digest, nil
+}
+
+// verifyingReader passes reads through to reader and returns an error
+// instead of io.EOF if the data read does not match digest.
+type verifyingReader struct {
+	reader   io.Reader
+	verifier digest.Verifier
+	digest   digest.Digest
+}
+
+// Read implements io.Reader.
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		_, _ = r.verifier.Write(p[:n])
+	}
+	if err == io.EOF && !r.verifier.Verified() {
+		return n, fmt.Errorf("layer does not match digest %s", r.digest)
+	}
+	return n, err
+}
+
+// linkableExtraction reports whether an extraction with opts can be served
+// from the layer store: every entry is extracted as stored, without
+// per-target metadata or callbacks.
+func linkableExtraction(opts ExtractOptions) bool {
+	return len(opts.FilesToExtract) == 0 &&
+		opts.Filter == nil &&
+		opts.OnExtract == nil &&
+		opts.Progress == nil &&
+		opts.StripPrefix == "" &&
+		!opts.PreserveMtimes &&
+		!opts.PreserveOwnership &&
+		!opts.PreserveXattrs
+}
+
+// layerStorePath returns the directory the layer with the given digest is
+// extracted to for linking. The options that decide whether and how entries
+// are extracted are part of the path, so pulls with different limits or
+// policies never share an extraction.
+func (c *Client) layerStorePath(layerDigest string, opts ExtractOptions) (string, error) {
+	parsed, err := digest.Parse(layerDigest)
+	if err != nil {
+		return "", fmt.Errorf("invalid layer digest: %w", err)
+	}
+
+	variant := sha256.Sum256(fmt.Appendf(nil, "%d/%d/%d/%t/%t/%s",
+		opts.MaxFiles, opts.MaxSize, opts.MaxFileSize, opts.AllowHiddenFiles, opts.PreservePerms, opts.SymlinkPolicy))
+	return filepath.Join(
+		c.options.CacheConfig.CachePath,
+		layerStoreDir,
+		parsed.Algorithm().String(),
+		parsed.Encoded(),
+		hex.EncodeToString(variant[:6]),
+	), nil
+}
+
+// storeLayer extracts a layer into storeDir. The layer is extracted into a
+// temporary directory next to storeDir and renamed into place, so concurrent
+// pulls of the same layer never see a partial extraction.
+func (c *Client) storeLayer(ctx context.Context, archiver Archiver, data io.Reader, storeDir string, opts ExtractOptions) error {
+	parent := filepath.Dir(storeDir)
+	if err := c.options.FS.MkdirAll(parent, 0o755); err != nil {
+		return fmt.Errorf("failed to create layer store: %w", err)
+	}
+
+	tempDir, err := c.createTempDirIn(parent, ".extract-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = c.removeAllFS(tempDir) }()
+
+	if err := archiver.Extract(ctx, data, tempDir, opts); err != nil {
+		return fmt.Errorf("failed to extract layer: %w", err)
+	}
+
+	if err := c.options.FS.Rename(tempDir, storeDir); err != nil {
+		// Another pull stored the layer first
+		if exists, existsErr := c.options.FS.Exists(storeDir); existsErr == nil && exists {
+			return nil
+		}
+		return fmt.Errorf("failed to store layer: %w", err)
+	}
+	return nil
+}
+
+// linkLayer recreates the tree extracted to storeDir in targetDir,
+// hardlinking files to the store.
+func (c *Client) linkLayer(storeDir, targetDir string) error {
+	if err := c.options.FS.MkdirAll(targetDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create target directory: %w", err)
+	}
+
+	err := c.options.FS.Walk(storeDir, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return fmt.Errorf("failed to walk path %s: %w", path, walkErr)
+		}
+		if path == storeDir {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(storeDir, path)
+		if err != nil {
+			return fmt.Errorf("failed to get relative path from %s to %s: %w", storeDir, path, err)
+		}
+		dstPath := filepath.Join(targetDir, relPath)
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("failed to get info of %s: %w", path, err)
+		}
+
+		switch {
+		case d.IsDir():
+			if err := c.options.FS.MkdirAll(dstPath, info.Mode().Perm()); err != nil {
+				return fmt.Errorf("failed to create directory %s: %w", dstPath, err)
+			}
+			return nil
+		case d.Type()&fs.ModeSymlink != 0:
+			target, ok, err := readlink(c.options.FS, path)
+			if err != nil || !ok {
+				return err
+			}
+			if err := removeExisting(c.options.FS, dstPath); err != nil {
+				return err
+			}
+			_, err = createSymlink(c.options.FS, target, dstPath)
+			return err
+		default:
+			return linkOrCopy(c.options.FS, path, dstPath, info.Mode().Perm())
+		}
+	})
+	if err != nil {
+		_ = c.removeAllFS(targetDir)
+		return fmt.Errorf("failed to link layer: %w", err)
+	}
+	return nil
+}
+
+// linkOrCopy creates newname as a hardlink to oldname, copying the file with
+// mode perm instead if fsys does not support hardlinks or the link fails, for
+// example because the files are on different devices.
+func linkOrCopy(fsys core.FS, oldname, newname string, perm fs.FileMode) error {
+	if err := removeExisting(fsys, newname); err != nil {
+		return err
+	}
+
+	var err error
+	switch lfs := fsys.(type) {
+	case hardlinkFS:
+		err = lfs.Link(oldname, newname)
+	case *billy.LocalFS:
+		err = os.Link(localPath(oldname), localPath(newname))
+	default:
+		return copyLinkedFile(fsys, oldname, newname, perm)
+	}
+	if err != nil {
+		return copyLinkedFile(fsys, oldname, newname, perm)
+	}
+	return nil
+}
+
+// removeExisting removes the file at name if there is one. Files in pull
+// targets may be linked to the layer store, so they are replaced rather than
+// written through.
+func removeExisting(fsys core.FS, name string) error {
+	exists, err := fsys.Exists(name)
+	if err != nil {
+		return fmt.Errorf("failed to check %s: %w", name, err)
+	}
+	if !exists {
+		return nil
+	}
+	if err := fsys.Remove(name); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", name, err)
+	}
+	return nil
+}
+
+// pruneLayerStore removes the extracted layers whose blobs are no longer
+// cached.
+func (c *Client) pruneLayerStore(ctx context.Context, coordinator *cache.Coordinator) error {
+	if c.options.CacheConfig.CachePath == "" {
+		return nil
+	}
+
+	root := filepath.Join(c.options.CacheConfig.CachePath, layerStoreDir)
+	algorithms, err := c.options.FS.ReadDir(root)
+	if err != nil {
+		if exists, existsErr := c.options.FS.Exists(root); existsErr == nil && !exists {
+			return nil
+		}
+		return fmt.Errorf("failed to read layer store: %w", err)
+	}
+
+	for _, algorithm := range algorithms {
+		if !algorithm.IsDir() {
+			continue
+		}
+		layers, err := c.options.FS.ReadDir(filepath.Join(root, algorithm.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read layer store: %w", err)
+		}
+		for _, layer := range layers {
+			cached, err := coordinator.HasBlob(ctx, algorithm.Name()+":"+layer.Name())
+			if err != nil || cached {
+				continue
+			}
+			if err := c.removeAllFS(filepath.Join(root, algorithm.Name(), layer.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package ocibundle
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/fs/billy"
+)
+
+func TestClient_PullWithCache_SharedLayers(t *testing.T) {
+	ctx := context.Background()
+	reg := newFakeCopyRegistry(t)
+
+	sourceDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, "bin"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "bin", "tool"), []byte("tool"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "config.yaml"), []byte("config"), 0o644))
+
+	pusher, err := NewWithOptions(WithAllowHTTP())
+	require.NoError(t, err)
+	references := []string{reg.host + "/team-a/tool:v1", reg.host + "/team-b/tool:v1"}
+	for _, reference := range references {
+		require.NoError(t, pusher.Push(ctx, sourceDir, reference, WithCompression(CompressionZstd), WithReproducible()))
+	}
+
+	cachePath := t.TempDir()
+	coordinator := newTestCoordinator(t, cachePath)
+	client, err := NewWithOptions(
+		WithAllowHTTP(),
+		WithCache(coordinator, cachePath, 0, 0),
+		WithLinkedExtraction(),
+	)
+	require.NoError(t, err)
+
+	targets := make([]string, len(references))
+	for i, reference := range references {
+		targets[i] = filepath.Join(t.TempDir(), "bundle")
+		require.NoError(t, client.PullWithCache(ctx, reference, targets[i]))
+
+		content, err := os.ReadFile(filepath.Join(targets[i], "bin", "tool"))
+		require.NoError(t, err)
+		assert.Equal(t, "tool", string(content))
+	}
+
+	// Both references share one cached blob and one extraction
+	stats, err := client.CacheStats()
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Entries)
+
+	layers, err := filepath.Glob(filepath.Join(cachePath, layerStoreDir, "sha256", "*"))
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+
+	first, err := os.Stat(filepath.Join(targets[0], "config.yaml"))
+	require.NoError(t, err)
+	second, err := os.Stat(filepath.Join(targets[1], "config.yaml"))
+	require.NoError(t, err)
+	assert.True(t, os.SameFile(first, second), "targets should be hardlinked to the layer store")
+
+	t.Run("extracts selective pulls without linking", func(t *testing.T) {
+		target := filepath.Join(t.TempDir(), "bundle")
+		require.NoError(t, client.PullWithCache(ctx, references[0], target, WithFilesToExtract("bin/**")))
+
+		info, err := os.Stat(filepath.Join(target, "bin", "tool"))
+		require.NoError(t, err)
+		assert.False(t, os.SameFile(first, info))
+		assert.NoFileExists(t, filepath.Join(target, "config.yaml"))
+	})
+
+	t.Run("prunes extractions of evicted layers", func(t *testing.T) {
+		_, err := client.CachePrune(ctx, PruneOptions{})
+		require.NoError(t, err)
+
+		layers, err := filepath.Glob(filepath.Join(cachePath, layerStoreDir, "sha256", "*"))
+		require.NoError(t, err)
+		assert.Empty(t, layers)
+	})
+
+	t.Run("requires a cache path", func(t *testing.T) {
+		_, err := NewWithOptions(WithLinkedExtraction())
+		assert.ErrorContains(t, err, "linked extraction requires a cache path")
+	})
+}
+
+func TestLinkOrCopy(t *testing.T) {
+	fsys := billy.NewMemory()
+	require.NoError(t, fsys.WriteFile("store/file", []byte("data"), 0o755))
+	require.NoError(t, fsys.WriteFile("target/file", []byte("stale"), 0o644))
+
+	// The in-memory filesystem has no hardlinks, so the file is copied
+	require.NoError(t, linkOrCopy(fsys, "store/file", "target/file", 0o755))
+
+	content, err := fsys.ReadFile("target/file")
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(content))
+
+	content, err = fsys.ReadFile("store/file")
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(content))
+}
+
+func TestVerifyingReader(t *testing.T) {
+	expected := digest.FromString("layer")
+
+	t.Run("passes matching data through", func(t *testing.T) {
+		r := &verifyingReader{reader: strings.NewReader("layer"), verifier: expected.Verifier(), digest: expected}
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "layer", string(data))
+	})
+
+	t.Run("fails on mismatched data", func(t *testing.T) {
+		r := &verifyingReader{reader: strings.NewReader("tampered"), verifier: expected.Verifier(), digest: expected}
+		_, err := io.ReadAll(r)
+		assert.ErrorContains(t, err, "layer does not match digest")
+	})
+}
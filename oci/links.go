@@ -0,0 +1,381 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains symlink and hardlink handling for archiving and extraction.
+package ocibundle
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/jmgilman/go/fs/core"
+)
+
+// SymlinkPolicy controls how symbolic links are extracted from archives.
+type SymlinkPolicy string
+
+const (
+	// SymlinkPreserveWithinRoot extracts symlinks whose targets resolve inside
+	// the extraction root and rejects archives containing symlinks that are
+	// absolute or escape it. This is the default.
+	SymlinkPreserveWithinRoot SymlinkPolicy = "preserve-within-root"
+
+	// SymlinkRewrite extracts all symlinks, rewriting targets that are
+	// absolute or escape the extraction root into relative targets inside it.
+	// Targets are resolved as if the extraction root were the filesystem
+	// root, so "/usr/bin/tool" refers to "usr/bin/tool" in the bundle.
+	SymlinkRewrite SymlinkPolicy = "rewrite"
+
+	// SymlinkReject rejects archives that contain symlinks.
+	SymlinkReject SymlinkPolicy = "reject"
+)
+
+// hardlinkFS is implemented by filesystems that support hardlinks.
+type hardlinkFS interface {
+	Link(oldname, newname string) error
+}
+
+// linkExtractor creates the symlinks and hardlinks of an archive during
+// extraction. It tracks the entries extracted so far so that links cannot be
+// used to read or write outside the extraction root. Names are slash-separated
+// paths relative to the root.
+type linkExtractor struct {
+	fsys   core.WriteFS
+	root   string
+	policy SymlinkPolicy
+
+	// symlinks maps the symlinks created so far to their resolved targets
+	symlinks map[string]string
+
+	// traversed holds the directories that symlink targets resolve through
+	traversed map[string]bool
+
+	// files holds the regular files extracted so far
+	files map[string]bool
+}
+
+// newLinkExtractor creates a linkExtractor for an extraction into root of
+// fsys. An empty root extracts relative to the root of fsys.
+func newLinkExtractor(fsys core.WriteFS, root string, policy SymlinkPolicy) *linkExtractor {
+	if policy == "" {
+		policy = SymlinkPreserveWithinRoot
+	}
+	return &linkExtractor{
+		fsys:      fsys,
+		root:      root,
+		policy:    policy,
+		symlinks:  make(map[string]string),
+		traversed: make(map[string]bool),
+		files:     make(map[string]bool),
+	}
+}
+
+// fullPath returns the path of name in the extraction filesystem.
+func (l *linkExtractor) fullPath(name string) string {
+	if l.root == "" {
+		return name
+	}
+	return filepath.Join(l.root, filepath.FromSlash(name))
+}
+
+// checkPath rejects an entry that is, or is nested under, a symlink created
+// earlier in the extraction, since writing it would follow the symlink.
+func (l *linkExtractor) checkPath(name string) error {
+	for p := name; p != "." && p != ""; p = path.Dir(p) {
+		if _, ok := l.symlinks[p]; ok {
+			return NewBundleError("extract", name, ErrSecurityViolation)
+		}
+	}
+	return nil
+}
+
+// file records that the regular file name was extracted.
+func (l *linkExtractor) file(name string) {
+	l.files[name] = true
+}
+
+// symlink creates name as a symlink to target according to the policy.
+// Symlinks are skipped if the filesystem does not support them.
+func (l *linkExtractor) symlink(name, target string) error {
+	switch l.policy {
+	case SymlinkPreserveWithinRoot, SymlinkRewrite:
+	case SymlinkReject:
+		return NewBundleError("extract", name, ErrSecurityViolation)
+	default:
+		return fmt.Errorf("unknown symlink policy %q", l.policy)
+	}
+
+	// A symlink here would change where earlier symlinks resolve to
+	if l.traversed[name] {
+		return NewBundleError("extract", name, ErrSecurityViolation)
+	}
+
+	dir := path.Dir(name)
+	resolved, traversed, escapes := resolveLink(l.symlinks, dir, target)
+	if escapes {
+		if l.policy != SymlinkRewrite {
+			return NewBundleError("extract", name, ErrSecurityViolation)
+		}
+		target = relativeLink(dir, resolved)
+	}
+
+	created, err := createSymlink(l.fsys, target, l.fullPath(name))
+	if err != nil || !created {
+		return err
+	}
+
+	l.symlinks[name] = resolved
+	for _, p := range traversed {
+		l.traversed[p] = true
+	}
+	return nil
+}
+
+// hardlink creates name as a hardlink to target, a regular file extracted
+// earlier. The file is copied if the filesystem does not support hardlinks.
+func (l *linkExtractor) hardlink(name, target string) error {
+	targetName, err := resolveFSPath(target)
+	if err != nil || targetName == "." {
+		return NewBundleError("extract", name, ErrSecurityViolation)
+	}
+	if !l.files[targetName] {
+		return fmt.Errorf("hardlink %s refers to %s, which was not extracted before it", name, target)
+	}
+
+	if err := createHardlink(l.fsys, l.fullPath(targetName), l.fullPath(name)); err != nil {
+		return err
+	}
+	l.file(name)
+	return nil
+}
+
+// resolveLink resolves the target of a symlink in dir to a path relative to
+// the root, following the given symlinks. Absolute targets and parent
+// references beyond the root are resolved as if the root were the filesystem
+// root and reported as escaping. It also returns the directories the target
+// resolves through.
+func resolveLink(symlinks map[string]string, dir, target string) (string, []string, bool) {
+	var parts []string
+	escapes := isAbsLink(target)
+	if !escapes {
+		parts = splitPath(dir)
+	}
+
+	var traversed []string
+	components := strings.Split(filepath.ToSlash(target), "/")
+	for i, component := range components {
+		switch component {
+		case "", ".":
+			continue
+		case "..":
+			if len(parts) == 0 {
+				escapes = true
+				continue
+			}
+			parts = parts[:len(parts)-1]
+			continue
+		}
+
+		parts = append(parts, component)
+		if i == len(components)-1 {
+			break
+		}
+
+		current := strings.Join(parts, "/")
+		if resolved, ok := symlinks[current]; ok {
+			parts = splitPath(resolved)
+		} else {
+			traversed = append(traversed, current)
+		}
+	}
+
+	return strings.Join(parts, "/"), traversed, escapes
+}
+
+// relativeLink returns a relative symlink target from dir to target, both
+// slash-separated paths relative to the root.
+func relativeLink(dir, target string) string {
+	from := splitPath(dir)
+	to := splitPath(target)
+
+	common := 0
+	for common < len(from) && common < len(to) && from[common] == to[common] {
+		common++
+	}
+
+	parts := make([]string, 0, len(from)-common+len(to)-common)
+	for range from[common:] {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, to[common:]...)
+
+	if len(parts) == 0 {
+		return "."
+	}
+	return strings.Join(parts, "/")
+}
+
+// splitPath splits a slash-separated relative path into its components.
+func splitPath(p string) []string {
+	if p == "" || p == "." {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// isAbsLink reports whether a symlink target is absolute on any platform.
+func isAbsLink(target string) bool {
+	return strings.HasPrefix(filepath.ToSlash(target), "/") ||
+		filepath.IsAbs(target) ||
+		filepath.VolumeName(target) != ""
+}
+
+// validateArchivedSymlink rejects a symlink being archived whose target is
+// absolute or escapes the archived directory, since it would not resolve to
+// the same file once extracted.
+func validateArchivedSymlink(name, target string) error {
+	if _, _, escapes := resolveLink(nil, path.Dir(filepath.ToSlash(name)), target); escapes {
+		return NewBundleError("archive", name, fmt.Errorf("%w: symlink target %s escapes the bundle", ErrSecurityViolation, target))
+	}
+	return nil
+}
+
+// hardlinkIndex detects regular files that are hardlinks to files archived
+// earlier. Files are compared with os.SameFile, so only hardlinks on
+// filesystems backed by the os package are detected.
+type hardlinkIndex map[int64][]archivedFile
+
+// archivedFile is a regular file recorded in a hardlinkIndex.
+type archivedFile struct {
+	name string
+	info fs.FileInfo
+}
+
+// add records a regular file and returns the name of the earlier file it is a
+// hardlink to, if any.
+func (h hardlinkIndex) add(name string, info fs.FileInfo) (string, bool) {
+	for _, file := range h[info.Size()] {
+		if os.SameFile(file.info, info) {
+			return file.name, true
+		}
+	}
+	h[info.Size()] = append(h[info.Size()], archivedFile{name: name, info: info})
+	return "", false
+}
+
+// hardlinkHeader returns the tar header for name as a hardlink to target.
+func hardlinkHeader(info fs.FileInfo, name, target string) (*tar.Header, error) {
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tar header for %s: %w", name, err)
+	}
+	header.Name = name
+	header.Typeflag = tar.TypeLink
+	header.Linkname = target
+	header.Size = 0
+	return header, nil
+}
+
+// supportsSymlinks reports whether readlink and createSymlink support fsys.
+func supportsSymlinks(fsys any) bool {
+	switch fsys.(type) {
+	case core.SymlinkFS, *billy.LocalFS:
+		return true
+	default:
+		return false
+	}
+}
+
+// readlink returns the target of the symlink name, reporting false if fsys
+// does not support symlinks. The local billy filesystem is rooted at "/" but
+// does not expose symlinks, so its symlinks are read with the os package.
+func readlink(fsys core.ReadFS, name string) (string, bool, error) {
+	var (
+		target string
+		err    error
+	)
+	switch sfs := fsys.(type) {
+	case core.SymlinkFS:
+		target, err = sfs.Readlink(name)
+	case *billy.LocalFS:
+		target, err = os.Readlink(localPath(name))
+	default:
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read symlink %s: %w", name, err)
+	}
+	return target, true, nil
+}
+
+// createSymlink creates newname as a symlink to oldname, reporting false if
+// fsys does not support symlinks. Symlinks on the local billy filesystem are
+// created with the os package, as in readlink.
+func createSymlink(fsys core.WriteFS, oldname, newname string) (bool, error) {
+	var err error
+	switch sfs := fsys.(type) {
+	case core.SymlinkFS:
+		err = sfs.Symlink(oldname, newname)
+	case *billy.LocalFS:
+		err = os.Symlink(oldname, localPath(newname))
+	default:
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create symlink %s -> %s: %w", newname, oldname, err)
+	}
+	return true, nil
+}
+
+// createHardlink creates newname as a hardlink to oldname, copying the file
+// if fsys does not support hardlinks.
+func createHardlink(fsys core.WriteFS, oldname, newname string) error {
+	var err error
+	switch lfs := fsys.(type) {
+	case hardlinkFS:
+		err = lfs.Link(oldname, newname)
+	case *billy.LocalFS:
+		err = os.Link(localPath(oldname), localPath(newname))
+	default:
+		return copyLinkedFile(fsys, oldname, newname, 0o644)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create hardlink %s -> %s: %w", newname, oldname, err)
+	}
+	return nil
+}
+
+// localPath returns the os path of name on the local billy filesystem, which
+// resolves relative paths against "/".
+func localPath(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(string(filepath.Separator), name)
+}
+
+// copyLinkedFile copies oldname to newname within fsys, creating newname with
+// mode perm.
+func copyLinkedFile(fsys core.WriteFS, oldname, newname string, perm fs.FileMode) error {
+	src, err := fsys.OpenFile(oldname, os.O_RDONLY, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open hardlink target %s: %w", oldname, err)
+	}
+	defer func() { _ = src.Close() }()
+
+	dst, err := fsys.OpenFile(newname, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return fmt.Errorf("failed to create file %s: %w", newname, err)
+	}
+	defer func() { _ = dst.Close() }()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return fmt.Errorf("failed to copy hardlink target %s to %s: %w", oldname, newname, err)
+	}
+	return nil
+}
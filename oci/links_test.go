@@ -0,0 +1,297 @@
+package ocibundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/fs/billy"
+)
+
+// linkTestEntry describes an entry of a test archive.
+type linkTestEntry struct {
+	name     string
+	typeflag byte
+	link     string
+	content  string
+}
+
+// createLinkArchive writes entries to a tar.gz archive.
+func createLinkArchive(t *testing.T, entries []linkTestEntry) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range entries {
+		header := &tar.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Linkname: entry.link,
+			Mode:     0o644,
+			Size:     int64(len(entry.content)),
+		}
+		if entry.typeflag == tar.TypeDir {
+			header.Mode = 0o755
+		}
+		require.NoError(t, tarWriter.WriteHeader(header))
+		if entry.content != "" {
+			_, err := tarWriter.Write([]byte(entry.content))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func TestTarGzArchiver_ExtractSymlinks(t *testing.T) {
+	tree := []linkTestEntry{
+		{name: "tool/", typeflag: tar.TypeDir},
+		{name: "tool/lib/", typeflag: tar.TypeDir},
+		{name: "tool/lib/libtool.so.1", typeflag: tar.TypeReg, content: "library"},
+		{name: "tool/lib/libtool.so", typeflag: tar.TypeSymlink, link: "libtool.so.1"},
+		{name: "tool/bin/", typeflag: tar.TypeDir},
+		{name: "tool/bin/lib", typeflag: tar.TypeSymlink, link: "../lib"},
+	}
+
+	extract := func(t *testing.T, entries []linkTestEntry, policy SymlinkPolicy) (string, error) {
+		t.Helper()
+		targetDir := filepath.Join(t.TempDir(), "out")
+		opts := DefaultExtractOptions
+		opts.SymlinkPolicy = policy
+		archiver := NewTarGzArchiverWithFS(billy.NewLocal())
+		err := archiver.Extract(context.Background(), bytes.NewReader(createLinkArchive(t, entries)), targetDir, opts)
+		return targetDir, err
+	}
+
+	t.Run("preserves symlinks within the root", func(t *testing.T) {
+		targetDir, err := extract(t, tree, SymlinkPreserveWithinRoot)
+		require.NoError(t, err)
+
+		target, err := os.Readlink(filepath.Join(targetDir, "tool/lib/libtool.so"))
+		require.NoError(t, err)
+		assert.Equal(t, "libtool.so.1", target)
+
+		content, err := os.ReadFile(filepath.Join(targetDir, "tool/bin/lib/libtool.so"))
+		require.NoError(t, err)
+		assert.Equal(t, "library", string(content))
+	})
+
+	t.Run("rejects escaping symlinks by default", func(t *testing.T) {
+		for _, link := range []string{"../../etc/passwd", "/etc/passwd"} {
+			entries := []linkTestEntry{{name: "tool/evil", typeflag: tar.TypeSymlink, link: link}}
+			_, err := extract(t, entries, "")
+			assert.True(t, errors.Is(err, ErrSecurityViolation), "link %s: %v", link, err)
+		}
+	})
+
+	t.Run("rejects escapes through other symlinks", func(t *testing.T) {
+		entries := []linkTestEntry{
+			{name: "up", typeflag: tar.TypeSymlink, link: "."},
+			{name: "tool/evil", typeflag: tar.TypeSymlink, link: "../up/../etc"},
+		}
+		_, err := extract(t, entries, SymlinkPreserveWithinRoot)
+		assert.True(t, errors.Is(err, ErrSecurityViolation))
+	})
+
+	t.Run("rejects entries written through symlinks", func(t *testing.T) {
+		entries := []linkTestEntry{
+			{name: "lib", typeflag: tar.TypeSymlink, link: "tool"},
+			{name: "lib/file", typeflag: tar.TypeReg, content: "data"},
+		}
+		_, err := extract(t, entries, SymlinkPreserveWithinRoot)
+		assert.True(t, errors.Is(err, ErrSecurityViolation))
+	})
+
+	t.Run("rejects symlinks replacing directories other links resolve through", func(t *testing.T) {
+		entries := []linkTestEntry{
+			{name: "current", typeflag: tar.TypeSymlink, link: "tool/bin"},
+			{name: "tool", typeflag: tar.TypeSymlink, link: "."},
+		}
+		_, err := extract(t, entries, SymlinkPreserveWithinRoot)
+		assert.True(t, errors.Is(err, ErrSecurityViolation))
+	})
+
+	t.Run("rewrites escaping symlinks", func(t *testing.T) {
+		entries := append([]linkTestEntry{}, tree...)
+		entries = append(entries,
+			linkTestEntry{name: "tool/bin/abs", typeflag: tar.TypeSymlink, link: "/tool/lib/libtool.so.1"},
+			linkTestEntry{name: "tool/bin/escape", typeflag: tar.TypeSymlink, link: "../../../etc/passwd"},
+		)
+		targetDir, err := extract(t, entries, SymlinkRewrite)
+		require.NoError(t, err)
+
+		target, err := os.Readlink(filepath.Join(targetDir, "tool/bin/abs"))
+		require.NoError(t, err)
+		assert.Equal(t, "../lib/libtool.so.1", target)
+
+		target, err = os.Readlink(filepath.Join(targetDir, "tool/bin/escape"))
+		require.NoError(t, err)
+		assert.Equal(t, "../../etc/passwd", target)
+
+		// Symlinks within the root are left untouched
+		target, err = os.Readlink(filepath.Join(targetDir, "tool/bin/lib"))
+		require.NoError(t, err)
+		assert.Equal(t, "../lib", target)
+	})
+
+	t.Run("reject policy rejects all symlinks", func(t *testing.T) {
+		_, err := extract(t, tree, SymlinkReject)
+		assert.True(t, errors.Is(err, ErrSecurityViolation))
+	})
+
+	t.Run("extracts hardlinks", func(t *testing.T) {
+		entries := []linkTestEntry{
+			{name: "bin/", typeflag: tar.TypeDir},
+			{name: "bin/tool", typeflag: tar.TypeReg, content: "binary"},
+			{name: "bin/tool-alias", typeflag: tar.TypeLink, link: "bin/tool"},
+		}
+		targetDir, err := extract(t, entries, SymlinkPreserveWithinRoot)
+		require.NoError(t, err)
+
+		original, err := os.Stat(filepath.Join(targetDir, "bin/tool"))
+		require.NoError(t, err)
+		alias, err := os.Stat(filepath.Join(targetDir, "bin/tool-alias"))
+		require.NoError(t, err)
+		assert.True(t, os.SameFile(original, alias))
+	})
+
+	t.Run("rejects invalid hardlinks", func(t *testing.T) {
+		escaping := []linkTestEntry{{name: "passwd", typeflag: tar.TypeLink, link: "../../etc/passwd"}}
+		_, err := extract(t, escaping, SymlinkPreserveWithinRoot)
+		assert.True(t, errors.Is(err, ErrSecurityViolation))
+
+		missing := []linkTestEntry{{name: "alias", typeflag: tar.TypeLink, link: "tool"}}
+		_, err = extract(t, missing, SymlinkPreserveWithinRoot)
+		assert.ErrorContains(t, err, "which was not extracted before it")
+	})
+}
+
+func TestTarGzArchiver_ExtractToFSHardlinks(t *testing.T) {
+	entries := []linkTestEntry{
+		{name: "bin/tool", typeflag: tar.TypeReg, content: "binary"},
+		{name: "bin/tool-alias", typeflag: tar.TypeLink, link: "bin/tool"},
+	}
+
+	// Filesystems without hardlink support receive a copy
+	target := billy.NewMemory()
+	archiver := NewTarGzArchiver()
+	err := archiver.ExtractToFS(context.Background(), bytes.NewReader(createLinkArchive(t, entries)), target, DefaultExtractOptions)
+	require.NoError(t, err)
+
+	content, err := target.ReadFile("bin/tool-alias")
+	require.NoError(t, err)
+	assert.Equal(t, "binary", string(content))
+}
+
+func TestTarGzArchiver_ArchiveLinks(t *testing.T) {
+	ctx := context.Background()
+	archiver := NewTarGzArchiverWithFS(billy.NewLocal())
+
+	t.Run("records symlinks and hardlinks", func(t *testing.T) {
+		sourceDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, "lib"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "lib/libtool.so.1"), []byte("library"), 0o644))
+		require.NoError(t, os.Symlink("libtool.so.1", filepath.Join(sourceDir, "lib/libtool.so")))
+		require.NoError(t, os.Link(filepath.Join(sourceDir, "lib/libtool.so.1"), filepath.Join(sourceDir, "libtool")))
+
+		tarBytes, err := archiver.buildTar(ctx, sourceDir, func(int64, int64) {})
+		require.NoError(t, err)
+
+		headers := make(map[string]*tar.Header)
+		var hardlinkAfterTarget bool
+		tarReader := tar.NewReader(bytes.NewReader(tarBytes))
+		for {
+			header, err := tarReader.Next()
+			if err != nil {
+				break
+			}
+			if header.Typeflag == tar.TypeLink {
+				_, hardlinkAfterTarget = headers[header.Linkname]
+			}
+			headers[header.Name] = header
+		}
+
+		require.Contains(t, headers, "lib/libtool.so")
+		assert.Equal(t, byte(tar.TypeSymlink), headers["lib/libtool.so"].Typeflag)
+		assert.Equal(t, "libtool.so.1", headers["lib/libtool.so"].Linkname)
+
+		// Whichever name is walked second becomes the hardlink
+		hardlinks := 0
+		for _, header := range headers {
+			if header.Typeflag == tar.TypeLink {
+				hardlinks++
+				assert.Zero(t, header.Size)
+			}
+		}
+		assert.Equal(t, 1, hardlinks)
+		assert.True(t, hardlinkAfterTarget)
+	})
+
+	t.Run("records symlink cycles without following them", func(t *testing.T) {
+		sourceDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, "a/nested"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "a/nested/file.txt"), []byte("data"), 0o644))
+		require.NoError(t, os.Symlink("..", filepath.Join(sourceDir, "a/nested/parent")))
+
+		tarBytes, err := archiver.buildTar(ctx, sourceDir, func(int64, int64) {})
+		require.NoError(t, err)
+
+		var names []string
+		tarReader := tar.NewReader(bytes.NewReader(tarBytes))
+		for {
+			header, err := tarReader.Next()
+			if err != nil {
+				break
+			}
+			names = append(names, header.Name)
+		}
+		assert.Equal(t, []string{"a", "a/nested", "a/nested/file.txt", "a/nested/parent"}, names)
+	})
+
+	t.Run("rejects escaping symlinks", func(t *testing.T) {
+		sourceDir := t.TempDir()
+		require.NoError(t, os.Symlink("../outside", filepath.Join(sourceDir, "evil")))
+
+		_, err := archiver.buildTar(ctx, sourceDir, nil)
+		assert.True(t, errors.Is(err, ErrSecurityViolation))
+	})
+}
+
+func TestResolveLink(t *testing.T) {
+	symlinks := map[string]string{"current": "releases/v2"}
+
+	tests := []struct {
+		name     string
+		dir      string
+		target   string
+		resolved string
+		escapes  bool
+	}{
+		{name: "sibling", dir: "bin", target: "tool", resolved: "bin/tool"},
+		{name: "parent", dir: "tool/bin", target: "../lib", resolved: "tool/lib"},
+		{name: "through symlink", dir: ".", target: "current/../v1", resolved: "releases/v1"},
+		{name: "escaping", dir: "bin", target: "../../etc", resolved: "etc", escapes: true},
+		{name: "absolute", dir: "bin", target: "/usr/bin/tool", resolved: "usr/bin/tool", escapes: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, _, escapes := resolveLink(symlinks, tt.dir, tt.target)
+			assert.Equal(t, tt.resolved, resolved)
+			assert.Equal(t, tt.escapes, escapes)
+		})
+	}
+
+	assert.Equal(t, "../lib/tool", relativeLink("bin", "lib/tool"))
+	assert.Equal(t, "tool", relativeLink("bin", "bin/tool"))
+}
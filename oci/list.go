@@ -17,7 +17,7 @@ import (
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"oras.land/oras-go/v2"
 
-	"github.com/jmgilman/go/oci/internal/cache"
+	"github.com/jmgilman/go/oci/cache"
 	orasint "github.com/jmgilman/go/oci/internal/oras"
 )
 
@@ -0,0 +1,140 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains manifest inspection functionality.
+package ocibundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ManifestInfo describes an artifact manifest and its layers, as returned by
+// GetManifest.
+type ManifestInfo struct {
+	ArtifactDescriptor
+
+	// Layers lists the layers of the manifest in order
+	Layers []LayerDescriptor
+
+	// Created is the creation time recorded in the manifest's
+	// "org.opencontainers.image.created" annotation, falling back to the
+	// image config. Zero if the artifact does not record one.
+	Created time.Time
+
+	// Platform is the platform the artifact was pushed for, in the form
+	// os/architecture[/variant] (e.g., "linux/amd64"). Empty if the artifact
+	// is not platform-specific.
+	Platform string
+}
+
+// LayerDescriptor describes a layer listed in an artifact manifest.
+type LayerDescriptor struct {
+	// Digest is the digest of the layer blob
+	Digest string
+
+	// MediaType is the media type of the layer blob
+	MediaType string
+
+	// Size is the size of the layer blob in bytes
+	Size int64
+
+	// Annotations are the annotations declared for the layer
+	Annotations map[string]string
+}
+
+// GetManifest returns the manifest that reference resolves to, including its
+// annotations, layers, creation time, and platform, without downloading any
+// layers. Use it to check build metadata before deciding to pull.
+//
+// Only image manifests are supported; references to indexes return an error.
+//
+// Example:
+//
+//	info, err := client.GetManifest(ctx, "ghcr.io/org/repo:v1.0.0")
+//	if info.Annotations["org.opencontainers.image.revision"] == deployedRevision {
+//	    return nil // Already up to date
+//	}
+func (c *Client) GetManifest(ctx context.Context, reference string) (*ManifestInfo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if reference == "" {
+		return nil, fmt.Errorf("reference cannot be empty")
+	}
+
+	repo, desc, manifestBytes, err := c.fetchManifest(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return nil, fmt.Errorf("unsupported manifest media type: %s", desc.MediaType)
+	}
+
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	info := &ManifestInfo{
+		ArtifactDescriptor: ArtifactDescriptor{
+			Digest:       desc.Digest.String(),
+			MediaType:    desc.MediaType,
+			ArtifactType: manifest.ArtifactType,
+			Size:         desc.Size,
+			Annotations:  manifest.Annotations,
+		},
+		Layers: make([]LayerDescriptor, 0, len(manifest.Layers)),
+	}
+	for _, layer := range manifest.Layers {
+		info.Layers = append(info.Layers, LayerDescriptor{
+			Digest:      layer.Digest.String(),
+			MediaType:   layer.MediaType,
+			Size:        layer.Size,
+			Annotations: layer.Annotations,
+		})
+	}
+
+	if created, ok := manifest.Annotations[ocispec.AnnotationCreated]; ok {
+		if t, err := time.Parse(time.RFC3339, created); err == nil {
+			info.Created = t
+		}
+	}
+
+	// Platform-specific artifacts record their platform in an image config
+	if manifest.Config.MediaType == ocispec.MediaTypeImageConfig {
+		reader, err := repo.Fetch(ctx, manifest.Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch config: %w", err)
+		}
+		defer func() { _ = reader.Close() }()
+
+		var config ocispec.Image
+		if err := json.NewDecoder(io.LimitReader(reader, maxManifestSize)).Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse config: %w", err)
+		}
+
+		info.Platform = formatPlatform(config.Platform)
+		if info.Created.IsZero() && config.Created != nil {
+			info.Created = *config.Created
+		}
+	}
+
+	return info, nil
+}
+
+// formatPlatform formats a platform as os/architecture[/variant].
+func formatPlatform(platform ocispec.Platform) string {
+	if platform.OS == "" || platform.Architecture == "" {
+		return ""
+	}
+	formatted := platform.OS + "/" + platform.Architecture
+	if platform.Variant != "" {
+		formatted += "/" + platform.Variant
+	}
+	return formatted
+}
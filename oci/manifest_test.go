@@ -0,0 +1,83 @@
+package ocibundle
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetManifest(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := NewWithOptions(WithAllowHTTP())
+	require.NoError(t, err)
+
+	archive := buildTestTarGz(t, map[string]string{"hello.txt": "hi"})
+	const mediaType = "application/vnd.oci.image.layer.v1.tar+gzip"
+
+	t.Run("reads back push metadata", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		ref := reg.host + "/org/app:v1"
+
+		err := client.PushArchive(ctx, bytes.NewReader(archive), mediaType, ref,
+			WithAnnotations(map[string]string{
+				"org.example.build-id":    "1234",
+				ocispec.AnnotationCreated: "2025-01-02T03:04:05Z",
+				ocispec.AnnotationVersion: "1.0.0",
+			}),
+			WithPlatform("linux/arm64/v8"),
+		)
+		require.NoError(t, err)
+
+		info, err := client.GetManifest(ctx, ref)
+		require.NoError(t, err)
+
+		assert.Equal(t, ocispec.MediaTypeImageManifest, info.MediaType)
+		assert.Equal(t, "application/vnd.catalyst.bundle.v1", info.ArtifactType)
+		assert.Equal(t, "1234", info.Annotations["org.example.build-id"])
+		assert.Equal(t, "1.0.0", info.Annotations[ocispec.AnnotationVersion])
+		assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), info.Created.UTC())
+		assert.Equal(t, "linux/arm64/v8", info.Platform)
+
+		require.Len(t, info.Layers, 1)
+		assert.Equal(t, mediaType, info.Layers[0].MediaType)
+		assert.Equal(t, int64(len(archive)), info.Layers[0].Size)
+
+		resolved, err := client.Resolve(ctx, ref)
+		require.NoError(t, err)
+		assert.Equal(t, resolved.Digest, info.Digest)
+	})
+
+	t.Run("records creation time without annotations", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		ref := reg.host + "/org/app:v1"
+
+		require.NoError(t, client.PushArchive(ctx, bytes.NewReader(archive), mediaType, ref))
+
+		info, err := client.GetManifest(ctx, ref)
+		require.NoError(t, err)
+		assert.False(t, info.Created.IsZero())
+		assert.Empty(t, info.Platform)
+	})
+
+	t.Run("rejects invalid platform", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+
+		err := client.PushArchive(ctx, bytes.NewReader(archive), mediaType, reg.host+"/org/app:v1",
+			WithPlatform("linux"))
+		require.Error(t, err)
+	})
+
+	t.Run("validates references", func(t *testing.T) {
+		_, err := client.GetManifest(ctx, "")
+		require.Error(t, err)
+
+		_, err = client.GetManifest(ctx, "localhost:5000/org/app")
+		require.Error(t, err)
+	})
+}
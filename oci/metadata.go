@@ -0,0 +1,302 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains the recording and restoring of file metadata.
+package ocibundle
+
+import (
+	"archive/tar"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/jmgilman/go/fs/core"
+)
+
+// xattrPAXPrefix is the PAX record prefix for extended attributes.
+const xattrPAXPrefix = "SCHILY.xattr."
+
+// xattrNamespace is the only extended attribute namespace that is archived and
+// restored. Other namespaces carry security labels and capabilities that must
+// not be copied between machines.
+const xattrNamespace = "user."
+
+// ArchiveOptions controls the file metadata recorded when creating archives.
+// The zero value records modification times and ownership as found on disk.
+type ArchiveOptions struct {
+	// PreserveXattrs records the extended attributes in the "user." namespace.
+	// Extended attributes are only read from the local filesystem on Linux.
+	PreserveXattrs bool
+
+	// Deterministic makes archives of the same content byte-identical, so
+	// pushing an unchanged tree produces the same layer digest. Modification
+	// times are set to Timestamp, access and change times are dropped, and
+	// ownership is recorded as root.
+	Deterministic bool
+
+	// Timestamp is the modification time recorded for every entry when
+	// Deterministic is set. Zero uses the Unix epoch.
+	Timestamp time.Time
+
+	// NormalizePermissions records directories and executable files as 0755
+	// and other files as 0644, dropping setuid, setgid and sticky bits, so
+	// the archive does not depend on the umask of the machine that built it.
+	NormalizePermissions bool
+
+	// Exclude lists gitignore-style patterns for entries left out of the
+	// archive, in addition to those in a BundleIgnoreFile at the root of the
+	// bundle. Patterns without a slash match any path element, a trailing
+	// slash matches directories only, and a leading "!" re-includes entries.
+	Exclude []string
+}
+
+// isZero reports whether o is the zero value.
+func (o ArchiveOptions) isZero() bool {
+	return !o.PreserveXattrs && !o.Deterministic && o.Timestamp.IsZero() &&
+		!o.NormalizePermissions && len(o.Exclude) == 0
+}
+
+// apply adjusts header according to the options, reading extended attributes
+// of the file at path in fsys.
+func (o ArchiveOptions) apply(header *tar.Header, fsys any, path string) error {
+	if o.PreserveXattrs && header.Typeflag != tar.TypeSymlink && header.Typeflag != tar.TypeLink {
+		xattrs, err := readXattrs(fsys, path)
+		if err != nil {
+			return fmt.Errorf("failed to read extended attributes of %s: %w", path, err)
+		}
+		for name, value := range xattrs {
+			if header.PAXRecords == nil {
+				header.PAXRecords = make(map[string]string)
+			}
+			header.PAXRecords[xattrPAXPrefix+name] = value
+		}
+	}
+
+	if o.Deterministic {
+		header.ModTime = o.Timestamp
+		if header.ModTime.IsZero() {
+			header.ModTime = time.Unix(0, 0)
+		}
+		header.ModTime = header.ModTime.UTC().Truncate(time.Second)
+		header.AccessTime = time.Time{}
+		header.ChangeTime = time.Time{}
+		header.Uid, header.Gid = 0, 0
+		header.Uname, header.Gname = "", ""
+	}
+
+	if o.NormalizePermissions {
+		header.Mode = normalizedMode(header)
+	}
+	return nil
+}
+
+// normalizedMode returns the permission bits recorded for header when
+// permissions are normalized.
+func normalizedMode(header *tar.Header) int64 {
+	switch {
+	case header.Typeflag == tar.TypeSymlink:
+		return 0o777
+	case header.Typeflag == tar.TypeDir, header.Mode&0o111 != 0:
+		return 0o755
+	default:
+		return 0o644
+	}
+}
+
+// metadataRestorer restores the metadata recorded in tar headers to
+// extracted entries. Directories are restored by finish, after their contents
+// have been extracted, since extracting into a directory updates its times.
+type metadataRestorer struct {
+	fsys   core.WriteFS
+	mtimes bool
+	owners bool
+	xattrs bool
+
+	dirs []restoredEntry
+}
+
+// restoredEntry is an extracted entry whose metadata is restored later.
+type restoredEntry struct {
+	path   string
+	header *tar.Header
+}
+
+// newMetadataRestorer creates a metadataRestorer for the options. Ownership
+// is only restored when running as root.
+func newMetadataRestorer(fsys core.WriteFS, opts ExtractOptions) *metadataRestorer {
+	// Metadata is restored directly on filesystems wrapped for accounting
+	if wrapper, ok := fsys.(interface{ Unwrap() core.WriteFS }); ok {
+		fsys = wrapper.Unwrap()
+	}
+	return &metadataRestorer{
+		fsys:   fsys,
+		mtimes: opts.PreserveMtimes,
+		owners: opts.PreserveOwnership && os.Geteuid() == 0,
+		xattrs: opts.PreserveXattrs,
+	}
+}
+
+// enabled reports whether any metadata is restored.
+func (m *metadataRestorer) enabled() bool {
+	return m.mtimes || m.owners || m.xattrs
+}
+
+// restore applies the metadata in hdr to the entry extracted at path.
+func (m *metadataRestorer) restore(path string, hdr *tar.Header) error {
+	if !m.enabled() {
+		return nil
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		m.dirs = append(m.dirs, restoredEntry{path: path, header: hdr})
+		return nil
+	case tar.TypeReg, tar.TypeSymlink:
+		return m.apply(path, hdr)
+	default:
+		// Hardlinks share the metadata of the file they link to
+		return nil
+	}
+}
+
+// finish restores the metadata of directories, deepest first.
+func (m *metadataRestorer) finish() error {
+	for i := len(m.dirs) - 1; i >= 0; i-- {
+		if err := m.apply(m.dirs[i].path, m.dirs[i].header); err != nil {
+			return err
+		}
+	}
+	m.dirs = nil
+	return nil
+}
+
+// apply restores the metadata in hdr to the entry at path. Symlinks only
+// have their ownership restored, since times and extended attributes would
+// be applied to the file they point to.
+func (m *metadataRestorer) apply(path string, hdr *tar.Header) error {
+	symlink := hdr.Typeflag == tar.TypeSymlink
+
+	if m.xattrs && !symlink {
+		for key, value := range hdr.PAXRecords {
+			name, ok := strings.CutPrefix(key, xattrPAXPrefix)
+			if !ok || !strings.HasPrefix(name, xattrNamespace) {
+				continue
+			}
+			if err := writeXattr(m.fsys, path, name, value); err != nil {
+				return fmt.Errorf("failed to set extended attribute %s on %s: %w", name, path, err)
+			}
+		}
+	}
+
+	if m.owners {
+		if err := lchown(m.fsys, path, hdr.Uid, hdr.Gid); err != nil {
+			return fmt.Errorf("failed to set ownership of %s: %w", path, err)
+		}
+	}
+
+	if m.mtimes && !symlink {
+		atime := hdr.AccessTime
+		if atime.IsZero() {
+			atime = hdr.ModTime
+		}
+		if err := chtimes(m.fsys, path, atime, hdr.ModTime); err != nil {
+			return fmt.Errorf("failed to set times of %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// dirHeaders returns headers describing the metadata of the directories
+// under root, for restoring them after the directories are recreated
+// elsewhere. Paths are relative to root.
+func dirHeaders(fsys core.FS, root string) (map[string]*tar.Header, error) {
+	headers := make(map[string]*tar.Header)
+	err := fsys.Walk(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk failed at %s: %w", path, err)
+		}
+		if !d.IsDir() || path == root {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("failed to get dir info: %w", err)
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return fmt.Errorf("failed to read metadata of %s: %w", path, err)
+		}
+		xattrs, err := readXattrs(fsys, path)
+		if err != nil {
+			return fmt.Errorf("failed to read extended attributes of %s: %w", path, err)
+		}
+		for name, value := range xattrs {
+			if header.PAXRecords == nil {
+				header.PAXRecords = make(map[string]string)
+			}
+			header.PAXRecords[xattrPAXPrefix+name] = value
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return fmt.Errorf("failed to get relative path for %s: %w", path, err)
+		}
+		headers[relPath] = header
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory metadata: %w", err)
+	}
+	return headers, nil
+}
+
+// chtimes sets the times of name if fsys supports it.
+func chtimes(fsys core.WriteFS, name string, atime, mtime time.Time) error {
+	switch mfs := fsys.(type) {
+	case core.MetadataFS:
+		return mfs.Chtimes(name, atime, mtime)
+	case *billy.LocalFS:
+		return os.Chtimes(localPath(name), atime, mtime)
+	default:
+		return nil
+	}
+}
+
+// lchown sets the ownership of name, without following symlinks, if fsys is
+// the local filesystem.
+func lchown(fsys core.WriteFS, name string, uid, gid int) error {
+	if _, ok := fsys.(*billy.LocalFS); !ok {
+		return nil
+	}
+	return os.Lchown(localPath(name), uid, gid)
+}
+
+// readXattrs returns the extended attributes of name in the "user."
+// namespace if fsys is the local filesystem.
+func readXattrs(fsys any, name string) (map[string]string, error) {
+	if _, ok := fsys.(*billy.LocalFS); !ok {
+		return nil, nil
+	}
+	xattrs, err := listXattrs(localPath(name))
+	if err != nil {
+		return nil, err
+	}
+	for attr := range xattrs {
+		if !strings.HasPrefix(attr, xattrNamespace) {
+			delete(xattrs, attr)
+		}
+	}
+	return xattrs, nil
+}
+
+// writeXattr sets an extended attribute of name if fsys is the local
+// filesystem.
+func writeXattr(fsys core.WriteFS, name, attr, value string) error {
+	if _, ok := fsys.(*billy.LocalFS); !ok {
+		return nil
+	}
+	return setXattr(localPath(name), attr, value)
+}
@@ -0,0 +1,251 @@
+package ocibundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/fs/billy"
+)
+
+func TestTarGzArchiver_DeterministicArchive(t *testing.T) {
+	ctx := context.Background()
+	timestamp := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	archiver := NewTarGzArchiverWithOptions(billy.NewLocal(), ArchiveOptions{
+		Deterministic: true,
+		Timestamp:     timestamp,
+	})
+
+	// newTree writes the same content with different modification times
+	newTree := func(t *testing.T, mtime time.Time) string {
+		dir := t.TempDir()
+		for i := 0; i < 20; i++ {
+			name := filepath.Join(dir, "dir", string(rune('a'+i))+".txt")
+			require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+			require.NoError(t, os.WriteFile(name, bytes.Repeat([]byte{byte(i)}, 1024*i), 0o644))
+			require.NoError(t, os.Chtimes(name, mtime, mtime))
+		}
+		return dir
+	}
+
+	first, err := archiver.buildTar(ctx, newTree(t, time.Now().Add(-time.Hour)), nil)
+	require.NoError(t, err)
+	second, err := archiver.buildTar(ctx, newTree(t, time.Now()), nil)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	tarReader := tar.NewReader(bytes.NewReader(first))
+	var names []string
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+		assert.True(t, timestamp.Equal(header.ModTime), header.Name)
+		assert.Zero(t, header.Uid)
+		assert.Empty(t, header.Uname)
+	}
+	assert.IsIncreasing(t, names)
+}
+
+func TestTarGzArchiver_ExtractMetadata(t *testing.T) {
+	mtime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	headers := []*tar.Header{
+		{Name: "bin/", Typeflag: tar.TypeDir, Mode: 0o755, ModTime: mtime, Uid: 1234, Gid: 5678},
+		{
+			Name: "bin/tool", Typeflag: tar.TypeReg, Mode: 0o755, Size: 4, ModTime: mtime, Uid: 1234, Gid: 5678,
+			PAXRecords: map[string]string{
+				xattrPAXPrefix + "user.origin":      "build",
+				xattrPAXPrefix + "security.selinux": "label",
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, header := range headers {
+		require.NoError(t, tarWriter.WriteHeader(header))
+		if header.Size > 0 {
+			_, err := tarWriter.Write([]byte("exit"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	extract := func(t *testing.T, opts ExtractOptions) string {
+		t.Helper()
+		targetDir := filepath.Join(t.TempDir(), "out")
+		archiver := NewTarGzArchiverWithFS(billy.NewLocal())
+		require.NoError(t, archiver.Extract(context.Background(), bytes.NewReader(buf.Bytes()), targetDir, opts))
+		return targetDir
+	}
+
+	t.Run("not preserved by default", func(t *testing.T) {
+		targetDir := extract(t, DefaultExtractOptions)
+		info, err := os.Stat(filepath.Join(targetDir, "bin/tool"))
+		require.NoError(t, err)
+		assert.False(t, mtime.Equal(info.ModTime()))
+	})
+
+	t.Run("preserves modification times", func(t *testing.T) {
+		opts := DefaultExtractOptions
+		opts.PreserveMtimes = true
+		targetDir := extract(t, opts)
+
+		for _, name := range []string{"bin", "bin/tool"} {
+			info, err := os.Stat(filepath.Join(targetDir, name))
+			require.NoError(t, err)
+			assert.True(t, mtime.Equal(info.ModTime()), "%s has mtime %s", name, info.ModTime())
+		}
+	})
+
+	t.Run("preserves ownership when privileged", func(t *testing.T) {
+		if os.Geteuid() != 0 {
+			t.Skip("changing ownership requires root")
+		}
+		opts := DefaultExtractOptions
+		opts.PreserveOwnership = true
+		targetDir := extract(t, opts)
+
+		uid, gid := fileOwner(t, filepath.Join(targetDir, "bin/tool"))
+		assert.Equal(t, 1234, uid)
+		assert.Equal(t, 5678, gid)
+	})
+
+	t.Run("preserves user extended attributes", func(t *testing.T) {
+		probe := filepath.Join(t.TempDir(), "probe")
+		require.NoError(t, os.WriteFile(probe, nil, 0o644))
+		if err := setXattr(probe, "user.probe", "1"); err != nil {
+			t.Skipf("extended attributes are not supported: %v", err)
+		}
+		if xattrs, _ := listXattrs(probe); len(xattrs) == 0 {
+			t.Skip("extended attributes are not supported on this platform")
+		}
+
+		opts := DefaultExtractOptions
+		opts.PreserveXattrs = true
+		targetDir := extract(t, opts)
+
+		xattrs, err := listXattrs(filepath.Join(targetDir, "bin/tool"))
+		require.NoError(t, err)
+		assert.Equal(t, "build", xattrs["user.origin"])
+		assert.NotContains(t, xattrs, "security.selinux")
+	})
+}
+
+func TestTarGzArchiver_ArchiveXattrs(t *testing.T) {
+	sourceDir := t.TempDir()
+	name := filepath.Join(sourceDir, "tool")
+	require.NoError(t, os.WriteFile(name, []byte("exit"), 0o755))
+	if err := setXattr(name, "user.origin", "build"); err != nil {
+		t.Skipf("extended attributes are not supported: %v", err)
+	}
+
+	archiver := NewTarGzArchiverWithOptions(billy.NewLocal(), ArchiveOptions{PreserveXattrs: true})
+	tarBytes, err := archiver.buildTar(context.Background(), sourceDir, nil)
+	require.NoError(t, err)
+
+	header, err := tar.NewReader(bytes.NewReader(tarBytes)).Next()
+	require.NoError(t, err)
+	if xattrs, _ := listXattrs(name); len(xattrs) == 0 {
+		t.Skip("extended attributes are not supported on this platform")
+	}
+	assert.Equal(t, "build", header.PAXRecords[xattrPAXPrefix+"user.origin"])
+}
+
+func TestClient_PushArchiverFor_ArchiveOptions(t *testing.T) {
+	client, err := New()
+	require.NoError(t, err)
+
+	archiver, err := client.pushArchiverFor(&PushOptions{Archive: ArchiveOptions{Deterministic: true}})
+	require.NoError(t, err)
+	require.IsType(t, &TarGzArchiver{}, archiver)
+	assert.True(t, archiver.(*TarGzArchiver).options.Deterministic)
+
+	custom, err := NewWithOptions(WithArchiver(NewTarGzArchiver()))
+	require.NoError(t, err)
+	_, err = custom.pushArchiverFor(&PushOptions{Archive: ArchiveOptions{Deterministic: true}})
+	assert.Error(t, err)
+}
+
+func TestWithReproducible(t *testing.T) {
+	opts := &PushOptions{}
+	WithArchiveOptions(ArchiveOptions{PreserveXattrs: true})(opts)
+	WithReproducible()(opts)
+	assert.True(t, opts.Archive.Deterministic)
+	assert.True(t, opts.Archive.NormalizePermissions)
+	assert.True(t, opts.Archive.PreserveXattrs)
+
+	client, err := New()
+	require.NoError(t, err)
+	zstdOpts := &PushOptions{Compression: CompressionZstd}
+	WithReproducible()(zstdOpts)
+	archiver, err := client.pushArchiverFor(zstdOpts)
+	require.NoError(t, err)
+
+	// newTree writes the same content with different times and permissions
+	newTree := func(t *testing.T, mtime time.Time, fileMode, dirMode os.FileMode) string {
+		dir := t.TempDir()
+		for _, name := range []string{"b/tool", "a.txt", "a/nested.txt", "c.txt"} {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+			require.NoError(t, os.WriteFile(path, []byte(name), fileMode))
+			require.NoError(t, os.Chmod(path, fileMode))
+			require.NoError(t, os.Chtimes(path, mtime, mtime))
+		}
+		require.NoError(t, os.Chmod(filepath.Join(dir, "b/tool"), 0o775))
+		require.NoError(t, os.Chmod(filepath.Join(dir, "a"), dirMode))
+		return dir
+	}
+
+	archive := func(t *testing.T, dir string) []byte {
+		var buf bytes.Buffer
+		require.NoError(t, archiver.Archive(context.Background(), dir, &buf))
+		return buf.Bytes()
+	}
+
+	first := archive(t, newTree(t, time.Now().Add(-time.Hour), 0o600, 0o700))
+	second := archive(t, newTree(t, time.Now(), 0o664, 0o775))
+	assert.Equal(t, first, second)
+
+	tarBytes, err := archiver.(*TarZstdArchiver).tar.buildTar(context.Background(), newTree(t, time.Now(), 0o640|os.ModeSetuid, 0o700), nil)
+	require.NoError(t, err)
+	modes := make(map[string]int64)
+	tarReader := tar.NewReader(bytes.NewReader(tarBytes))
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+		modes[header.Name] = header.Mode
+	}
+	assert.Equal(t, map[string]int64{
+		"a":            0o755,
+		"a/nested.txt": 0o644,
+		"a.txt":        0o644,
+		"b":            0o755,
+		"b/tool":       0o755,
+		"c.txt":        0o644,
+	}, modes)
+}
+
+// fileOwner returns the uid and gid of the file at path.
+func fileOwner(t *testing.T, path string) (int, int) {
+	t.Helper()
+	info, err := os.Lstat(path)
+	require.NoError(t, err)
+	header, err := tar.FileInfoHeader(info, "")
+	require.NoError(t, err)
+	return header.Uid, header.Gid
+}
@@ -0,0 +1,228 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains observability hooks for the phases of bundle operations.
+package ocibundle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// Phase identifies a stage of a bundle operation reported to an Observer.
+type Phase string
+
+const (
+	// PhaseArchive is the creation of a bundle archive from a directory or
+	// filesystem during a push. Bytes is the archive size.
+	PhaseArchive Phase = "archive"
+
+	// PhaseUpload is the upload of an artifact to the registry, including
+	// retries. Bytes is the total size of the uploaded blobs.
+	PhaseUpload Phase = "upload"
+
+	// PhaseDownload is the download of a blob from the registry. Bytes is the
+	// number of bytes read. Streamed downloads overlap with PhaseExtract.
+	PhaseDownload Phase = "download"
+
+	// PhaseExtract is the extraction of an archive during a pull. Bytes is the
+	// number of archive bytes read.
+	PhaseExtract Phase = "extract"
+
+	// PhaseVerify is the verification of an artifact's signature during a
+	// pull, or of an extracted bundle by Verify.
+	PhaseVerify Phase = "verify"
+
+	// PhaseCache is a cache lookup during PullWithCache, including extraction
+	// of the cached blob on a hit. Bytes is the size of the cached blob read.
+	PhaseCache Phase = "cache"
+)
+
+// PhaseEvent describes a completed phase of a bundle operation.
+type PhaseEvent struct {
+	// Phase is the phase that completed
+	Phase Phase
+
+	// Reference is the OCI reference being processed
+	Reference string
+
+	// Digest is the digest of the blob the phase processed, if known
+	Digest string
+
+	// Bytes is the number of bytes the phase processed (see each Phase)
+	Bytes int64
+
+	// Duration is how long the phase took
+	Duration time.Duration
+
+	// CacheHit reports whether a PhaseCache lookup found the blob
+	CacheHit bool
+
+	// Err is the error the phase failed with, or nil on success
+	Err error
+}
+
+// Observer receives the phases of client operations, for metrics and tracing.
+// Implementations must be safe for concurrent use, since layers may be
+// transferred in parallel.
+//
+// StartPhase returns the context used for the phase, which is then passed to
+// EndPhase. This allows tracing implementations to start a span in StartPhase,
+// carry it through the phase's registry requests, and end it in EndPhase:
+//
+//	func (o *tracingObserver) StartPhase(ctx context.Context, phase ocibundle.Phase, ref string) context.Context {
+//	    ctx, _ = o.tracer.Start(ctx, "ocibundle."+string(phase))
+//	    return ctx
+//	}
+//
+//	func (o *tracingObserver) EndPhase(ctx context.Context, event ocibundle.PhaseEvent) {
+//	    span := trace.SpanFromContext(ctx)
+//	    span.SetAttributes(attribute.Int64("bytes", event.Bytes))
+//	    if event.Err != nil {
+//	        span.RecordError(event.Err)
+//	    }
+//	    span.End()
+//	}
+type Observer interface {
+	// StartPhase is called when a phase begins.
+	StartPhase(ctx context.Context, phase Phase, reference string) context.Context
+
+	// EndPhase is called when a phase completes, successfully or not.
+	EndPhase(ctx context.Context, event PhaseEvent)
+}
+
+// phaseSpan tracks a phase in progress. It does nothing if the client has no
+// observer.
+type phaseSpan struct {
+	observer Observer
+	ctx      context.Context
+	event    PhaseEvent
+	start    time.Time
+	once     sync.Once
+}
+
+// startPhase notifies the client's observer that phase began and returns the
+// context to use for it.
+func (c *Client) startPhase(ctx context.Context, phase Phase, reference string) (context.Context, *phaseSpan) {
+	span := &phaseSpan{
+		observer: c.options.Observer,
+		ctx:      ctx,
+		event:    PhaseEvent{Phase: phase, Reference: reference},
+		start:    time.Now(),
+	}
+	if span.observer == nil {
+		return ctx, span
+	}
+
+	if phaseCtx := span.observer.StartPhase(ctx, phase, reference); phaseCtx != nil {
+		span.ctx = phaseCtx
+	}
+	return span.ctx, span
+}
+
+// end notifies the observer that the phase completed. Only the first call
+// has an effect.
+func (s *phaseSpan) end(bytes int64, err error) {
+	if s.observer == nil {
+		return
+	}
+	s.once.Do(func() {
+		s.event.Bytes = bytes
+		s.event.Err = err
+		s.event.Duration = time.Since(s.start)
+		s.observer.EndPhase(s.ctx, s.event)
+	})
+}
+
+// observeArchive wraps write so that it is reported as PhaseArchive.
+func (c *Client) observeArchive(ctx context.Context, reference string, write func(w io.Writer) error) func(w io.Writer) error {
+	if c.options.Observer == nil {
+		return write
+	}
+	return func(w io.Writer) error {
+		_, span := c.startPhase(ctx, PhaseArchive, reference)
+		counter := &countingWriter{w: w}
+		err := write(counter)
+		span.end(counter.n, err)
+		return err
+	}
+}
+
+// observeExtract runs extract on data, reporting it as PhaseExtract.
+func (c *Client) observeExtract(
+	ctx context.Context,
+	reference string,
+	digest string,
+	data io.Reader,
+	extract func(ctx context.Context, data io.Reader) error,
+) error {
+	if c.options.Observer == nil {
+		return extract(ctx, data)
+	}
+	ctx, span := c.startPhase(ctx, PhaseExtract, reference)
+	span.event.Digest = digest
+	counter := &countingReader{r: data}
+	err := extract(ctx, counter)
+	span.end(counter.n, err)
+	return err
+}
+
+// observeDownload reports the blob read from rc as PhaseDownload, ending the
+// phase when rc is closed.
+func (c *Client) observeDownload(ctx context.Context, reference, digest string, rc io.ReadCloser) io.ReadCloser {
+	if c.options.Observer == nil {
+		return rc
+	}
+	_, span := c.startPhase(ctx, PhaseDownload, reference)
+	span.event.Digest = digest
+	return &observedReader{ReadCloser: rc, span: span}
+}
+
+// observedReader counts the bytes read from a blob and ends its download
+// phase when closed.
+type observedReader struct {
+	io.ReadCloser
+	span *phaseSpan
+	n    int64
+	err  error
+}
+
+func (r *observedReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.err = err
+	}
+	return n, err
+}
+
+func (r *observedReader) Close() error {
+	err := r.ReadCloser.Close()
+	r.span.end(r.n, r.err)
+	return err
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,135 @@
+package ocibundle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/oci/internal/oras"
+	"github.com/jmgilman/go/oci/internal/oras/mocks"
+	"github.com/jmgilman/go/oci/internal/testutil"
+)
+
+type observerKey struct{}
+
+// recordingObserver records the phases reported to it.
+type recordingObserver struct {
+	mu      sync.Mutex
+	started []Phase
+	events  []PhaseEvent
+	ctxOK   bool
+}
+
+func (o *recordingObserver) StartPhase(ctx context.Context, phase Phase, _ string) context.Context {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.started = append(o.started, phase)
+	return context.WithValue(ctx, observerKey{}, phase)
+}
+
+func (o *recordingObserver) EndPhase(ctx context.Context, event PhaseEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ctxOK = ctx.Value(observerKey{}) == event.Phase
+	o.events = append(o.events, event)
+}
+
+func (o *recordingObserver) event(t *testing.T, phase Phase) PhaseEvent {
+	t.Helper()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, event := range o.events {
+		if event.Phase == phase {
+			return event
+		}
+	}
+	t.Fatalf("phase %s not observed", phase)
+	return PhaseEvent{}
+}
+
+func TestClient_Observer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pull reports verify, download, and extract", func(t *testing.T) {
+		data, err := createMockTarGzData()
+		require.NoError(t, err)
+
+		mockORAS := &mocks.ClientMock{
+			PullFunc: func(_ context.Context, _ string, _ *oras.AuthOptions) (*oras.PullDescriptor, error) {
+				return &oras.PullDescriptor{
+					MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+					Data:      &mockReadCloserForTest{data: data},
+					Size:      int64(len(data)),
+					Digest:    "sha256:abc123",
+				}, nil
+			},
+		}
+		observer := &recordingObserver{}
+		client, err := NewWithOptions(
+			WithORASClient(mockORAS),
+			WithSignatureVerifier(&testutil.MockVerifier{ShouldSucceed: true}),
+			WithObserver(observer),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, client.Pull(ctx, "example.com/repo:v1", t.TempDir()))
+
+		assert.Equal(t, []Phase{PhaseVerify, PhaseDownload, PhaseExtract}, observer.started)
+		assert.True(t, observer.ctxOK)
+
+		download := observer.event(t, PhaseDownload)
+		assert.Equal(t, "sha256:abc123", download.Digest)
+		assert.Equal(t, int64(len(data)), download.Bytes)
+
+		extract := observer.event(t, PhaseExtract)
+		assert.Equal(t, "example.com/repo:v1", extract.Reference)
+		assert.NoError(t, extract.Err)
+		assert.Positive(t, extract.Bytes)
+		assert.Positive(t, extract.Duration)
+	})
+
+	t.Run("push reports upload", func(t *testing.T) {
+		pushErr := errors.New("registry unavailable")
+		mockORAS := &mocks.ClientMock{
+			PushFunc: func(_ context.Context, _ string, descriptor *oras.PushDescriptor, _ *oras.AuthOptions) error {
+				_, _ = io.Copy(io.Discard, descriptor.Data)
+				return pushErr
+			},
+		}
+		observer := &recordingObserver{}
+		client, err := NewWithOptions(WithORASClient(mockORAS), WithObserver(observer))
+		require.NoError(t, err)
+
+		archive := bytes.NewReader([]byte("archive"))
+		err = client.PushArchive(ctx, archive, "application/vnd.oci.image.layer.v1.tar+gzip", "example.com/repo:v1",
+			WithRetryPolicy(RetryPolicy{}))
+		require.Error(t, err)
+
+		assert.Equal(t, []Phase{PhaseUpload}, observer.started)
+		upload := observer.event(t, PhaseUpload)
+		assert.Equal(t, int64(7), upload.Bytes)
+		assert.ErrorIs(t, upload.Err, pushErr)
+	})
+
+	t.Run("archive counts bytes written", func(t *testing.T) {
+		observer := &recordingObserver{}
+		client, err := NewWithOptions(WithObserver(observer))
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		write := client.observeArchive(ctx, "example.com/repo:v1", func(w io.Writer) error {
+			_, err := w.Write([]byte("bundle"))
+			return err
+		})
+		require.NoError(t, write(&buf))
+
+		assert.Equal(t, "bundle", buf.String())
+		assert.Equal(t, int64(6), observer.event(t, PhaseArchive).Bytes)
+	})
+}
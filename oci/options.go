@@ -9,7 +9,7 @@ import (
 	"github.com/jmgilman/go/fs/core"
 	"oras.land/oras-go/v2/registry/remote/auth"
 
-	"github.com/jmgilman/go/oci/internal/cache"
+	"github.com/jmgilman/go/oci/cache"
 	"github.com/jmgilman/go/oci/internal/oras"
 )
 
@@ -51,6 +51,42 @@ type ClientOptions struct {
 	//       ocibundle.WithSignatureVerifier(verifier),
 	//   )
 	SignatureVerifier SignatureVerifier
+
+	// SignatureSigner signs OCI artifacts after they are pushed.
+	// If nil, pushed artifacts are not signed.
+	// Push annotations are propagated into the signature payload annotations.
+	SignatureSigner SignatureSigner
+
+	// Archiver creates the bundle archive on Push.
+	// If nil, a tar.gz archiver bound to FS is used.
+	Archiver Archiver
+
+	// Archivers maps layer media types to the archivers that extract them on Pull.
+	// Layers with media types that have no registered archiver are extracted
+	// as tar.gz.
+	Archivers map[string]Archiver
+
+	// Concurrency is the maximum number of layer blobs transferred in parallel
+	// by multi-layer pushes and pulls. Defaults to 1.
+	Concurrency int
+
+	// ResumableTransfers enables chunked, resumable blob uploads and ranged
+	// resume of interrupted blob downloads. Transfer state is persisted under
+	// CacheConfig.CachePath, which must be set.
+	ResumableTransfers bool
+
+	// ChunkSize is the size in bytes of each chunk uploaded when
+	// ResumableTransfers is enabled. Defaults to 16MB if zero.
+	ChunkSize int64
+
+	// Observer receives the phases of client operations for metrics and
+	// tracing. Nil disables observation.
+	Observer Observer
+
+	// LinkedExtraction makes PullWithCache extract each cached layer once
+	// under CacheConfig.CachePath, which must be set, and hardlink its files
+	// into pull targets instead of extracting them again.
+	LinkedExtraction bool
 }
 
 // HTTPConfig contains configuration for HTTP transport settings.
@@ -192,17 +228,67 @@ type PushOptions struct {
 	// ProgressCallback is called during push operations to report progress
 	ProgressCallback func(current, total int64)
 
-	// MaxRetries is the maximum number of retry attempts for network operations
+	// Retry is the retry policy for network operations
+	Retry RetryPolicy
+
+	// MaxRetries is the maximum number of retry attempts for network operations.
+	//
+	// Deprecated: Use Retry.MaxRetries. Changes made by a PushOption are
+	// copied into Retry.
 	MaxRetries int
 
-	// RetryDelay is the delay between retry attempts
+	// RetryDelay is the delay before the first retry attempt.
+	//
+	// Deprecated: Use Retry.InitialDelay. Changes made by a PushOption are
+	// copied into Retry.
 	RetryDelay time.Duration
 
 	// CacheBypass disables caching for this specific push operation.
 	// When true, the operation will bypass any configured cache.
 	CacheBypass bool
+
+	// SignatureAnnotations are additional annotations embedded in the signature
+	// payload when a SignatureSigner is configured. They are merged on top of
+	// Annotations, which are always propagated to the signature.
+	SignatureAnnotations map[string]string
+
+	// MountFrom lists repositories in the target registry to mount the bundle
+	// blob from instead of uploading it again.
+	MountFrom []string
+
+	// LayerSplit assigns each bundle entry to a named layer. When nil, the
+	// bundle is pushed as a single layer.
+	LayerSplit func(path string) string
+
+	// Compression selects the compression of the bundle archive created by
+	// the built-in archiver. Empty uses the client's archiver.
+	Compression Compression
+
+	// Archive controls the file metadata recorded by the built-in archiver.
+	Archive ArchiveOptions
+
+	// PlatformVariants, when non-empty, pushes one bundle per platform and an
+	// image index listing them instead of a single bundle.
+	PlatformVariants []PlatformVariant
+
+	// signer signs the pushed artifact instead of the client's SignatureSigner
+	signer SignatureSigner
 }
 
+// Compression identifies the compression of bundles created by the built-in
+// archiver.
+type Compression string
+
+const (
+	// CompressionGzip creates eStargz (tar+gzip) bundles, which support
+	// selective extraction with HTTP Range requests. This is the default.
+	CompressionGzip Compression = "gzip"
+
+	// CompressionZstd creates tar+zstd bundles, which are typically smaller
+	// and faster to compress and decompress than gzip.
+	CompressionZstd Compression = "zstd"
+)
+
 // PushOption is a functional option for configuring Push operations.
 type PushOption func(*PushOptions)
 
@@ -225,6 +311,24 @@ func WithPlatform(platform string) PushOption {
 	}
 }
 
+// WithPlatformVariant adds a platform-specific bundle, read from dir relative
+// to the source directory, to a multi-platform push. Each variant is pushed as
+// its own manifest, and the reference is tagged with an image index listing
+// them, from which Pull selects the variant for its platform:
+//
+//	err := client.Push(ctx, "./dist", ref,
+//	    ocibundle.WithPlatformVariant("linux/amd64", "linux-amd64"),
+//	    ocibundle.WithPlatformVariant("darwin/arm64", "darwin-arm64"),
+//	)
+//
+// Platforms have the form os/architecture[/variant]. Multi-platform bundles
+// cannot be combined with WithLayerSplit or signing.
+func WithPlatformVariant(platform, dir string) PushOption {
+	return func(opts *PushOptions) {
+		opts.PlatformVariants = append(opts.PlatformVariants, PlatformVariant{Platform: platform, Dir: dir})
+	}
+}
+
 // WithProgressCallback sets a callback function for progress reporting.
 func WithProgressCallback(callback func(current, total int64)) PushOption {
 	return func(opts *PushOptions) {
@@ -232,17 +336,28 @@ func WithProgressCallback(callback func(current, total int64)) PushOption {
 	}
 }
 
+// WithRetryPolicy sets the retry policy for network operations.
+func WithRetryPolicy(policy RetryPolicy) PushOption {
+	return func(opts *PushOptions) {
+		opts.Retry = policy
+	}
+}
+
 // WithMaxRetries sets the maximum number of retry attempts for network operations.
+//
+// Deprecated: Use WithRetryPolicy.
 func WithMaxRetries(maxRetries int) PushOption {
 	return func(opts *PushOptions) {
-		opts.MaxRetries = maxRetries
+		opts.Retry.MaxRetries = maxRetries
 	}
 }
 
-// WithRetryDelay sets the delay between retry attempts.
+// WithRetryDelay sets the delay before the first retry attempt.
+//
+// Deprecated: Use WithRetryPolicy.
 func WithRetryDelay(delay time.Duration) PushOption {
 	return func(opts *PushOptions) {
-		opts.RetryDelay = delay
+		opts.Retry.InitialDelay = delay
 	}
 }
 
@@ -253,6 +368,116 @@ func WithPushCacheBypass(bypass bool) PushOption {
 	}
 }
 
+// WithSignatureAnnotations sets additional annotations to embed in the signature
+// payload. Push annotations are propagated automatically; these take precedence.
+func WithSignatureAnnotations(annotations map[string]string) PushOption {
+	return func(opts *PushOptions) {
+		if opts.SignatureAnnotations == nil {
+			opts.SignatureAnnotations = make(map[string]string)
+		}
+		for k, v := range annotations {
+			opts.SignatureAnnotations[k] = v
+		}
+	}
+}
+
+// WithMountFrom sets repositories in the target registry to mount the bundle
+// blob from instead of uploading it again. Entries may be repository paths
+// (e.g. "org/app") or references in the target registry (e.g. "ghcr.io/org/app").
+// This speeds up pushing the same bundle to several repositories in one
+// registry: push to the first repository normally, then push to the others
+// with WithMountFrom naming the first.
+//
+// Sources in other registries are ignored. If no source holds the blob or the
+// registry does not support mounting, the blob is uploaded as usual.
+func WithMountFrom(repositories ...string) PushOption {
+	return func(opts *PushOptions) {
+		opts.MountFrom = append(opts.MountFrom, repositories...)
+	}
+}
+
+// WithLayerSplit pushes the bundle as multiple layers, one per distinct name
+// returned by split. split receives the slash-separated path of each file (and
+// empty directory) relative to the source directory; an empty name selects
+// DefaultLayerName. Layers are ordered by name and annotated with it under
+// org.opencontainers.image.title.
+//
+// Unchanged layers keep their digests across pushes, so registries and caches
+// deduplicate them, and pulls can fetch a single component with WithLayers:
+//
+//	err := client.Push(ctx, "./app", ref, ocibundle.WithLayerSplit(ocibundle.SplitByTopLevelDir))
+//	err = client.Pull(ctx, ref, "./config", ocibundle.WithLayers("config"))
+//
+// Progress callbacks are not invoked for multi-layer pushes, and multi-layer
+// bundles cannot be signed or verified.
+func WithLayerSplit(split func(path string) string) PushOption {
+	return func(opts *PushOptions) {
+		opts.LayerSplit = split
+	}
+}
+
+// WithCompression selects the compression of the bundle archive created by
+// Push and PushFS. CompressionZstd pushes layers with the
+// application/vnd.oci.image.layer.v1.tar+zstd media type; Pull decompresses
+// both formats transparently.
+//
+//	err := client.Push(ctx, "./app", ref, ocibundle.WithCompression(ocibundle.CompressionZstd))
+//
+// Compression applies to the built-in archiver only and cannot be combined
+// with a custom archiver configured with WithArchiver.
+func WithCompression(compression Compression) PushOption {
+	return func(opts *PushOptions) {
+		opts.Compression = compression
+	}
+}
+
+// WithArchiveOptions controls the file metadata recorded in the bundle archive
+// created by Push and PushFS. Use Deterministic for reproducible layer digests:
+//
+//	err := client.Push(ctx, "./app", ref, ocibundle.WithArchiveOptions(ocibundle.ArchiveOptions{
+//	    Deterministic: true,
+//	}))
+//
+// Archive options apply to the built-in archiver only and cannot be combined
+// with a custom archiver configured with WithArchiver.
+func WithArchiveOptions(archiveOpts ArchiveOptions) PushOption {
+	return func(opts *PushOptions) {
+		opts.Archive = archiveOpts
+	}
+}
+
+// WithReproducible makes pushing identical content always produce identical
+// layer digests, for build caching and provenance. Entries are sorted, times
+// and ownership are fixed, permissions are normalized, and compression does
+// not depend on the number of CPUs:
+//
+//	err := client.Push(ctx, "./app", ref, ocibundle.WithReproducible())
+//
+// WithReproducible adjusts the archive options, so it must follow any
+// WithArchiveOptions and cannot be combined with a custom archiver.
+func WithReproducible() PushOption {
+	return func(opts *PushOptions) {
+		opts.Archive.Deterministic = true
+		opts.Archive.NormalizePermissions = true
+	}
+}
+
+// WithExcludePatterns leaves entries matching the gitignore-style patterns out
+// of the bundle created by Push and PushFS, such as version control metadata
+// and build artifacts:
+//
+//	err := client.Push(ctx, "./app", ref, ocibundle.WithExcludePatterns(".git/", "node_modules/", "*.o"))
+//
+// Patterns are added to those in a BundleIgnoreFile at the root of the bundle,
+// which the built-in archiver reads even without this option. Like
+// WithReproducible, it must follow any WithArchiveOptions and cannot be
+// combined with a custom archiver.
+func WithExcludePatterns(patterns ...string) PushOption {
+	return func(opts *PushOptions) {
+		opts.Archive.Exclude = append(opts.Archive.Exclude, patterns...)
+	}
+}
+
 // PullOptions contains options for the Pull operation.
 type PullOptions struct {
 	// MaxFiles is the maximum number of files allowed in the archive.
@@ -278,10 +503,32 @@ type PullOptions struct {
 	// Useful for removing leading directory names from archived paths.
 	StripPrefix string
 
+	// SymlinkPolicy controls how symlinks in the bundle are extracted.
+	SymlinkPolicy SymlinkPolicy
+
+	// PreserveMtimes restores the recorded modification times of files.
+	PreserveMtimes bool
+
+	// PreserveOwnership restores the recorded uid and gid of files when
+	// running as root.
+	PreserveOwnership bool
+
+	// PreserveXattrs restores recorded extended attributes.
+	PreserveXattrs bool
+
+	// Retry is the retry policy for network operations.
+	Retry RetryPolicy
+
 	// MaxRetries is the maximum number of retry attempts for network operations.
+	//
+	// Deprecated: Use Retry.MaxRetries. Changes made by a PullOption are
+	// copied into Retry.
 	MaxRetries int
 
-	// RetryDelay is the delay between retry attempts.
+	// RetryDelay is the delay before the first retry attempt.
+	//
+	// Deprecated: Use Retry.InitialDelay. Changes made by a PullOption are
+	// copied into Retry.
 	RetryDelay time.Duration
 
 	// CacheBypass disables caching for this specific pull operation.
@@ -296,6 +543,37 @@ type PullOptions struct {
 	//   - **/*.txt: matches all .txt files recursively
 	// When empty, all files are extracted (default behavior).
 	FilesToExtract []string
+
+	// ExtractFilter selects entries to extract by their metadata, after
+	// FilesToExtract. When nil, all entries are extracted.
+	ExtractFilter func(info FileInfo) bool
+
+	// OnExtract is called after each entry is written with its path relative
+	// to the target and its archive metadata.
+	OnExtract func(path string, info FileInfo)
+
+	// Layers restricts extraction of multi-layer bundles to the named layers.
+	// When empty, all layers are extracted.
+	Layers []string
+
+	// ExpectedDigest is the manifest digest the reference must resolve to.
+	// When empty, whatever the reference resolves to is pulled.
+	ExpectedDigest string
+
+	// Platform selects the bundle to pull from a multi-platform artifact, in
+	// the form os/architecture[/variant]. When empty, the platform of the
+	// running process is used.
+	Platform string
+
+	// Progress is called as layers are downloaded and extracted.
+	Progress func(PullProgress)
+
+	// BandwidthLimit caps the download rate in bytes per second across all
+	// layers of the pull. Zero means unlimited.
+	BandwidthLimit int64
+
+	// progress tracks the pull in progress
+	progress *pullProgress
 }
 
 // PullOption is a functional option for configuring Pull operations.
@@ -336,6 +614,40 @@ func WithPullPreservePermissions(preserve bool) PullOption {
 	}
 }
 
+// WithPullPreserveMtimes restores the modification times recorded in the
+// bundle instead of leaving extracted files with their extraction time.
+func WithPullPreserveMtimes(preserve bool) PullOption {
+	return func(opts *PullOptions) {
+		opts.PreserveMtimes = preserve
+	}
+}
+
+// WithPullPreserveOwnership restores the uid and gid recorded in the bundle.
+// Ownership can only be changed by root, so it is ignored for other users.
+func WithPullPreserveOwnership(preserve bool) PullOption {
+	return func(opts *PullOptions) {
+		opts.PreserveOwnership = preserve
+	}
+}
+
+// WithPullPreserveXattrs restores the extended attributes in the "user."
+// namespace recorded in the bundle. Extended attributes are only restored on
+// Linux.
+func WithPullPreserveXattrs(preserve bool) PullOption {
+	return func(opts *PullOptions) {
+		opts.PreserveXattrs = preserve
+	}
+}
+
+// WithPullSymlinkPolicy sets how symlinks in the bundle are extracted.
+// The default, SymlinkPreserveWithinRoot, rejects bundles containing
+// symlinks that point outside the extraction directory.
+func WithPullSymlinkPolicy(policy SymlinkPolicy) PullOption {
+	return func(opts *PullOptions) {
+		opts.SymlinkPolicy = policy
+	}
+}
+
 // WithPullStripPrefix sets the prefix to remove from all file paths during extraction.
 func WithPullStripPrefix(prefix string) PullOption {
 	return func(opts *PullOptions) {
@@ -343,17 +655,28 @@ func WithPullStripPrefix(prefix string) PullOption {
 	}
 }
 
+// WithPullRetryPolicy sets the retry policy for network operations.
+func WithPullRetryPolicy(policy RetryPolicy) PullOption {
+	return func(opts *PullOptions) {
+		opts.Retry = policy
+	}
+}
+
 // WithPullMaxRetries sets the maximum number of retry attempts for network operations.
+//
+// Deprecated: Use WithPullRetryPolicy.
 func WithPullMaxRetries(maxRetries int) PullOption {
 	return func(opts *PullOptions) {
-		opts.MaxRetries = maxRetries
+		opts.Retry.MaxRetries = maxRetries
 	}
 }
 
-// WithPullRetryDelay sets the delay between retry attempts.
+// WithPullRetryDelay sets the delay before the first retry attempt.
+//
+// Deprecated: Use WithPullRetryPolicy.
 func WithPullRetryDelay(delay time.Duration) PullOption {
 	return func(opts *PullOptions) {
-		opts.RetryDelay = delay
+		opts.Retry.InitialDelay = delay
 	}
 }
 
@@ -373,6 +696,81 @@ func WithFilesToExtract(patterns ...string) PullOption {
 	}
 }
 
+// WithExtractFilter extracts only the entries for which filter returns true,
+// for selection that glob patterns cannot express, such as by size, mode, or
+// owner:
+//
+//	err := client.Pull(ctx, ref, "./app", ocibundle.WithExtractFilter(func(info ocibundle.FileInfo) bool {
+//	    return info.Size < 10*1024*1024
+//	}))
+//
+// The filter is called for directories too and applies after
+// WithFilesToExtract. Entries inside a skipped directory are still extracted
+// if the filter accepts them.
+func WithExtractFilter(filter func(info FileInfo) bool) PullOption {
+	return func(opts *PullOptions) {
+		opts.ExtractFilter = filter
+	}
+}
+
+// WithOnExtract calls callback after each entry is written, with its
+// slash-separated path relative to the target and its archive metadata, so
+// callers can audit exactly what a pull wrote.
+func WithOnExtract(callback func(path string, info FileInfo)) PullOption {
+	return func(opts *PullOptions) {
+		opts.OnExtract = callback
+	}
+}
+
+// WithLayers restricts extraction to the named layers of a bundle pushed with
+// WithLayerSplit. Layers not listed are not downloaded. Pull fails if a named
+// layer does not exist.
+func WithLayers(names ...string) PullOption {
+	return func(opts *PullOptions) {
+		opts.Layers = names
+	}
+}
+
+// WithExpectedDigest fails the pull before anything is downloaded unless the
+// reference resolves to the manifest digest (e.g. "sha256:abc123..."). Use it
+// to enforce deployment specs pinned to digests when pulling by tag; failures
+// wrap ErrDigestMismatch.
+func WithExpectedDigest(digest string) PullOption {
+	return func(opts *PullOptions) {
+		opts.ExpectedDigest = digest
+	}
+}
+
+// WithPullPlatform selects the bundle for platform (e.g., "linux/arm64") when
+// pulling a multi-platform artifact pushed with WithPlatformVariant, instead of
+// the platform of the running process. Pull fails with ErrPlatformNotFound if
+// the artifact has no bundle for it. Single-platform artifacts are pulled
+// regardless of the platform.
+func WithPullPlatform(platform string) PullOption {
+	return func(opts *PullOptions) {
+		opts.Platform = platform
+	}
+}
+
+// WithPullProgress sets a callback receiving the progress of a pull: bytes
+// downloaded, entries and bytes extracted, and a per-layer breakdown. The
+// callback is called from the goroutines transferring layers, so it must be
+// safe for concurrent use when layers are pulled in parallel.
+func WithPullProgress(callback func(PullProgress)) PullOption {
+	return func(opts *PullOptions) {
+		opts.Progress = callback
+	}
+}
+
+// WithBandwidthLimit caps the download rate of a pull at bytesPerSecond,
+// shared by all layers transferred in parallel. Use it on constrained edge
+// nodes to leave bandwidth for other traffic.
+func WithBandwidthLimit(bytesPerSecond int64) PullOption {
+	return func(opts *PullOptions) {
+		opts.BandwidthLimit = bytesPerSecond
+	}
+}
+
 // WithMaxFiles is an alias for WithPullMaxFiles for convenience.
 func WithMaxFiles(maxFiles int) PullOption {
 	return WithPullMaxFiles(maxFiles)
@@ -388,6 +786,37 @@ func WithCacheBypass(bypass bool) PullOption {
 	return WithPullCacheBypass(bypass)
 }
 
+// CopyOptions contains options for the Copy operation.
+type CopyOptions struct {
+	// DigestPin is the digest the source reference must resolve to.
+	// When empty, whatever the source reference resolves to is copied.
+	DigestPin string
+
+	// IncludeSignatures copies the artifact's referrers (OCI 1.1 signatures,
+	// attestations, and SBOMs) and its cosign ".sig", ".att", and ".sbom" tags.
+	IncludeSignatures bool
+}
+
+// CopyOption is a functional option for configuring Copy operations.
+type CopyOption func(*CopyOptions)
+
+// WithDigestPin fails the copy unless the source reference resolves to digest
+// (e.g. "sha256:abc123..."). Use it in promotion flows to guarantee the
+// artifact being promoted is the one that was tested.
+func WithDigestPin(digest string) CopyOption {
+	return func(opts *CopyOptions) {
+		opts.DigestPin = digest
+	}
+}
+
+// WithIncludeSignatures copies signatures and attestations along with the
+// artifact, so verification keeps working against the destination.
+func WithIncludeSignatures(include bool) CopyOption {
+	return func(opts *CopyOptions) {
+		opts.IncludeSignatures = include
+	}
+}
+
 // DefaultPullOptions returns the default pull options.
 func DefaultPullOptions() *PullOptions {
 	return &PullOptions{
@@ -397,8 +826,10 @@ func DefaultPullOptions() *PullOptions {
 		AllowHiddenFiles:    false,
 		PreservePermissions: false,
 		StripPrefix:         "",
-		MaxRetries:          3,
-		RetryDelay:          2 * time.Second,
+		SymlinkPolicy:       SymlinkPreserveWithinRoot,
+		Retry:               DefaultRetryPolicy(),
+		MaxRetries:          DefaultRetryPolicy().MaxRetries,
+		RetryDelay:          DefaultRetryPolicy().InitialDelay,
 		CacheBypass:         false, // Use cache by default
 		FilesToExtract:      nil,   // Extract all files by default
 	}
@@ -410,12 +841,21 @@ func DefaultPushOptions() *PushOptions {
 		Annotations:      make(map[string]string),
 		Platform:         "",
 		ProgressCallback: nil,
-		MaxRetries:       3,
-		RetryDelay:       2 * time.Second,
+		Retry:            DefaultRetryPolicy(),
+		MaxRetries:       DefaultRetryPolicy().MaxRetries,
+		RetryDelay:       DefaultRetryPolicy().InitialDelay,
 		CacheBypass:      false, // Use cache by default
 	}
 }
 
+// DefaultCopyOptions returns the default copy options.
+func DefaultCopyOptions() *CopyOptions {
+	return &CopyOptions{
+		DigestPin:         "",    // Copy whatever the source resolves to
+		IncludeSignatures: false, // Copy only the artifact itself
+	}
+}
+
 // DefaultClientOptions returns the default client options.
 func DefaultClientOptions() *ClientOptions {
 	return &ClientOptions{
@@ -423,6 +863,7 @@ func DefaultClientOptions() *ClientOptions {
 		HTTPConfig:  nil, // Use default HTTPS with certificate validation
 		FS:          nil, // Filled by constructor if unset
 		CacheConfig: nil, // Caching disabled by default
+		Concurrency: 1,   // Transfer one layer at a time
 	}
 }
 
@@ -524,3 +965,110 @@ func WithSignatureVerifier(verifier SignatureVerifier) ClientOption {
 		opts.SignatureVerifier = verifier
 	}
 }
+
+// WithArchiver registers an archiver for its media type and uses it to create
+// bundles on Push. The layer media type of the pushed artifact is taken from
+// Archiver.MediaType, and Pull selects the archiver registered for the pulled
+// layer's media type, so bundles round-trip in alternate formats.
+//
+// WithArchiver may be given multiple times to support pulling several formats;
+// the last archiver given is used for Push. The built-in tar.gz archiver is
+// always available on Pull as a fallback. Passing nil is a no-op.
+//
+//	client, err := ocibundle.NewWithOptions(
+//	    ocibundle.WithArchiver(NewZipArchiver()),
+//	)
+func WithArchiver(archiver Archiver) ClientOption {
+	return func(opts *ClientOptions) {
+		if archiver == nil {
+			return
+		}
+		if opts.Archivers == nil {
+			opts.Archivers = make(map[string]Archiver)
+		}
+		opts.Archivers[archiver.MediaType()] = archiver
+		opts.Archiver = archiver
+	}
+}
+
+// WithConcurrency sets the maximum number of layer blobs transferred in
+// parallel by multi-layer pushes and pulls, which improves throughput for
+// bundles with many layers over high-latency links. Pulled layers are still
+// extracted one at a time, in order, so extraction limits apply to all layers
+// combined. Single-layer bundles are unaffected.
+//
+//	client, err := ocibundle.NewWithOptions(
+//	    ocibundle.WithConcurrency(4),
+//	)
+func WithConcurrency(n int) ClientOption {
+	return func(opts *ClientOptions) {
+		opts.Concurrency = n
+	}
+}
+
+// WithResumableTransfers enables resumable blob transfers. Pushes upload blobs
+// in chunks of chunkSize bytes (16MB if zero), and pulls download blobs into
+// partial files before extracting them. The progress of each transfer is
+// persisted in the cache directory, so retrying an interrupted Push or Pull,
+// even from a new process, continues from the last completed chunk or byte
+// instead of starting over.
+//
+// Requires a cache path configured with WithCache.
+//
+//	client, err := ocibundle.NewWithOptions(
+//	    ocibundle.WithCache(coordinator, "/var/cache/oci", 0, 0),
+//	    ocibundle.WithResumableTransfers(0),
+//	)
+func WithResumableTransfers(chunkSize int64) ClientOption {
+	return func(opts *ClientOptions) {
+		opts.ResumableTransfers = true
+		opts.ChunkSize = chunkSize
+	}
+}
+
+// WithLinkedExtraction makes PullWithCache extract each cached layer once
+// into a shared store in the cache directory and hardlink its files into pull
+// targets, so bundles that share layers use their disk space once. Files are
+// copied instead where the filesystem does not support hardlinks, for example
+// when the cache and the target are on different devices.
+//
+// Linked files share their data with the store and with other targets, so they
+// must not be modified in place. Pulls that select, filter, strip, or restore
+// metadata of entries are extracted normally.
+//
+// Requires a cache path configured with WithCache.
+//
+//	client, err := ocibundle.NewWithOptions(
+//	    ocibundle.WithCache(coordinator, "/var/cache/oci", 0, 0),
+//	    ocibundle.WithLinkedExtraction(),
+//	)
+func WithLinkedExtraction() ClientOption {
+	return func(opts *ClientOptions) {
+		opts.LinkedExtraction = true
+	}
+}
+
+// WithObserver reports the archive, upload, download, extract, verify, and
+// cache phases of client operations to observer, with their durations and
+// byte counts, for monitoring bundle distribution.
+//
+//	client, err := ocibundle.NewWithOptions(
+//	    ocibundle.WithObserver(metricsObserver),
+//	)
+func WithObserver(observer Observer) ClientOption {
+	return func(opts *ClientOptions) {
+		opts.Observer = observer
+	}
+}
+
+// WithSignatureSigner configures signing for OCI artifacts.
+// When set, all Push operations sign the pushed artifact after upload, embedding
+// the push annotations in the signature payload so that annotation-based
+// verification policies are satisfied automatically.
+//
+// Pass nil to disable signing.
+func WithSignatureSigner(signer SignatureSigner) ClientOption {
+	return func(opts *ClientOptions) {
+		opts.SignatureSigner = signer
+	}
+}
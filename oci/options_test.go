@@ -138,3 +138,26 @@ func TestNewWithOptions_WithOptions(t *testing.T) {
 	assert.NotNil(t, client)
 	assert.Nil(t, client.options.Auth) // WithAuthNone should set to nil
 }
+
+// TestWithArchiver_Options tests registering archivers by media type
+func TestWithArchiver_Options(t *testing.T) {
+	zipArchiver := &stubArchiver{mediaType: "application/vnd.example.layer.v1.zip"}
+	otherArchiver := &stubArchiver{mediaType: "application/vnd.example.layer.v1.other"}
+
+	opts := DefaultClientOptions()
+	WithArchiver(zipArchiver)(opts)
+	WithArchiver(otherArchiver)(opts)
+	WithArchiver(nil)(opts)
+
+	assert.Same(t, otherArchiver, opts.Archiver)
+	assert.Len(t, opts.Archivers, 2)
+	assert.Same(t, zipArchiver, opts.Archivers["application/vnd.example.layer.v1.zip"])
+	assert.Same(t, otherArchiver, opts.Archivers["application/vnd.example.layer.v1.other"])
+}
+
+// TestWithArchiver_EmptyMediaType tests that archivers without a media type are rejected
+func TestWithArchiver_EmptyMediaType(t *testing.T) {
+	_, err := NewWithOptions(WithArchiver(&stubArchiver{}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "archiver media type cannot be empty")
+}
@@ -0,0 +1,171 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains multi-platform bundle support using OCI image indexes.
+package ocibundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// PlatformVariant is a platform-specific bundle pushed as part of an image
+// index with WithPlatformVariant.
+type PlatformVariant struct {
+	// Platform is the platform of the bundle in the form
+	// os/architecture[/variant] (e.g., "linux/arm64")
+	Platform string
+
+	// Dir is the directory holding the bundle, relative to the source
+	// directory passed to Push
+	Dir string
+}
+
+// pushIndex archives each platform variant under sourceDir as its own
+// single-layer manifest and pushes an image index listing them.
+func (c *Client) pushIndex(ctx context.Context, sourceDir, reference string, pushOpts *PushOptions) error {
+	if c.shouldSignArtifact(pushOpts) {
+		return fmt.Errorf("signing is not supported for multi-platform bundles")
+	}
+	if pushOpts.LayerSplit != nil {
+		return fmt.Errorf("layer split cannot be combined with platform variants")
+	}
+
+	archiver, err := c.pushArchiverFor(pushOpts)
+	if err != nil {
+		return err
+	}
+
+	tempDir, tmpErr := c.createTempDir("ocibundle-platforms-")
+	if tmpErr != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", tmpErr)
+	}
+	defer func() { _ = c.removeAllFS(tempDir) }()
+
+	variants := make([]orasint.PushDescriptor, 0, len(pushOpts.PlatformVariants))
+	var size int64
+	for i, variant := range pushOpts.PlatformVariants {
+		if _, err := orasint.ParsePlatform(variant.Platform); err != nil {
+			return err
+		}
+		variantDir := filepath.Join(sourceDir, variant.Dir)
+		if exists, err := c.options.FS.Exists(variantDir); err != nil {
+			return fmt.Errorf("failed to check platform directory: %w", err)
+		} else if !exists {
+			return fmt.Errorf("platform directory does not exist: %s", variantDir)
+		}
+
+		archivePath := filepath.Join(tempDir, fmt.Sprintf("platform-%d.archive", i))
+		archiveFile, openErr := c.options.FS.OpenFile(archivePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o600)
+		if openErr != nil {
+			return fmt.Errorf("failed to create temporary file: %w", openErr)
+		}
+		defer func() { _ = archiveFile.Close() }()
+
+		archive := c.observeArchive(ctx, reference, func(w io.Writer) error {
+			return archiver.Archive(ctx, variantDir, w)
+		})
+		if err := archive(archiveFile); err != nil {
+			return fmt.Errorf("failed to archive platform %s: %w", variant.Platform, err)
+		}
+
+		stat, statErr := archiveFile.Stat()
+		if statErr != nil {
+			return fmt.Errorf("failed to get file size: %w", statErr)
+		}
+		data, ok := archiveFile.(io.ReadSeeker)
+		if !ok {
+			return fmt.Errorf("filesystem does not support seeking temporary files")
+		}
+		size += stat.Size()
+
+		variants = append(variants, orasint.PushDescriptor{
+			Platform:  variant.Platform,
+			MountFrom: pushOpts.MountFrom,
+			Resume:    c.resumeOptions(),
+			Layers: []orasint.LayerDescriptor{{
+				MediaType: archiver.MediaType(),
+				Data:      data,
+				Size:      stat.Size(),
+			}},
+		})
+	}
+
+	uploadCtx, span := c.startPhase(ctx, PhaseUpload, reference)
+	pushErr := retryOperation(uploadCtx, pushOpts.Retry, func() error {
+		desc := &orasint.PushDescriptor{
+			Annotations: pushOpts.Annotations,
+			Variants:    variants,
+			Progress:    pushOpts.ProgressCallback,
+		}
+		return c.orasClient.Push(uploadCtx, reference, desc, c.options.Auth)
+	})
+	span.end(size, pushErr)
+	if pushErr != nil {
+		return fmt.Errorf("failed to push artifact after %d retries: %w", pushOpts.Retry.MaxRetries, pushErr)
+	}
+
+	return nil
+}
+
+// pullPlatform selects the manifest for platform from a pulled image index
+// and pulls it in place of the index. An empty platform selects the platform
+// of the running process. The returned descriptor keeps the index digest as
+// its ManifestDigest, since that is what reference resolved to.
+func (c *Client) pullPlatform(
+	ctx context.Context,
+	reference string,
+	index *orasint.PullDescriptor,
+	platform string,
+) (*orasint.PullDescriptor, error) {
+	defer func() { _ = index.Data.Close() }()
+
+	if platform == "" {
+		platform = runtime.GOOS + "/" + runtime.GOARCH
+	}
+	want, err := orasint.ParsePlatform(platform)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed ocispec.Index
+	if err := json.NewDecoder(io.LimitReader(index.Data, maxManifestSize)).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse image index: %w", err)
+	}
+
+	manifest, ok := selectPlatform(parsed.Manifests, want)
+	if !ok {
+		return nil, NewBundleError("pull", reference, fmt.Errorf("%w: %s", ErrPlatformNotFound, platform))
+	}
+
+	repository, _, _ := splitReference(reference)
+	descriptor, err := c.orasClient.Pull(ctx, repository+"@"+manifest.Digest.String(), c.options.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull platform %s: %w", platform, err)
+	}
+	descriptor.ManifestDigest = index.ManifestDigest
+	return descriptor, nil
+}
+
+// selectPlatform returns the first manifest built for want. The variant is
+// only compared when want specifies one.
+func selectPlatform(manifests []ocispec.Descriptor, want ocispec.Platform) (ocispec.Descriptor, bool) {
+	for _, manifest := range manifests {
+		got := manifest.Platform
+		if got == nil || got.OS != want.OS || got.Architecture != want.Architecture {
+			continue
+		}
+		if want.Variant != "" && got.Variant != want.Variant {
+			continue
+		}
+		return manifest, true
+	}
+	return ocispec.Descriptor{}, false
+}
@@ -0,0 +1,117 @@
+package ocibundle
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectPlatform(t *testing.T) {
+	manifests := []ocispec.Descriptor{
+		{Digest: "sha256:amd64", Platform: &ocispec.Platform{OS: "linux", Architecture: "amd64"}},
+		{Digest: "sha256:armv7", Platform: &ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}},
+		{Digest: "sha256:none"},
+	}
+
+	tests := []struct {
+		want   ocispec.Platform
+		digest digest.Digest
+		found  bool
+	}{
+		{ocispec.Platform{OS: "linux", Architecture: "amd64"}, "sha256:amd64", true},
+		{ocispec.Platform{OS: "linux", Architecture: "arm"}, "sha256:armv7", true},
+		{ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, "sha256:armv7", true},
+		{ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v6"}, "", false},
+		{ocispec.Platform{OS: "darwin", Architecture: "arm64"}, "", false},
+	}
+	for _, tt := range tests {
+		manifest, found := selectPlatform(manifests, tt.want)
+		assert.Equal(t, tt.found, found, tt.want)
+		assert.Equal(t, tt.digest, manifest.Digest, tt.want)
+	}
+}
+
+func TestClient_PlatformVariants(t *testing.T) {
+	ctx := context.Background()
+	reg := newFakeCopyRegistry(t)
+	reference := reg.host + "/org/tool:v1"
+
+	sourceDir := t.TempDir()
+	platforms := []string{"plan9/386", "aix/ppc64", runtime.GOOS + "/" + runtime.GOARCH}
+	for _, platform := range platforms[:2] {
+		dir := filepath.Join(sourceDir, filepath.FromSlash(platform))
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "tool"), []byte(platform), 0o644))
+	}
+	native := filepath.Join(sourceDir, "native")
+	require.NoError(t, os.MkdirAll(native, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(native, "tool"), []byte("native"), 0o644))
+
+	client, err := NewWithOptions(WithAllowHTTP())
+	require.NoError(t, err)
+	err = client.Push(ctx, sourceDir, reference,
+		WithCompression(CompressionZstd),
+		WithAnnotations(map[string]string{ocispec.AnnotationVersion: "1.0.0"}),
+		WithPlatformVariant("plan9/386", "plan9/386"),
+		WithPlatformVariant("aix/ppc64", "aix/ppc64"),
+		WithPlatformVariant(platforms[2], "native"),
+	)
+	require.NoError(t, err)
+
+	indexBytes, ok := reg.manifest("org/tool", "v1")
+	require.True(t, ok)
+	var index ocispec.Index
+	require.NoError(t, json.Unmarshal(indexBytes, &index))
+	assert.Equal(t, ocispec.MediaTypeImageIndex, index.MediaType)
+	assert.Equal(t, "1.0.0", index.Annotations[ocispec.AnnotationVersion])
+	require.Len(t, index.Manifests, 3)
+	for i, manifest := range index.Manifests {
+		require.NotNil(t, manifest.Platform)
+		assert.Equal(t, platforms[i], formatPlatform(*manifest.Platform))
+	}
+
+	pull := func(t *testing.T, opts ...PullOption) (string, error) {
+		targetDir := filepath.Join(t.TempDir(), "bundle")
+		if err := client.Pull(ctx, reference, targetDir, append(opts, WithPullMaxRetries(0))...); err != nil {
+			return "", err
+		}
+		content, err := os.ReadFile(filepath.Join(targetDir, "tool"))
+		require.NoError(t, err)
+		return string(content), nil
+	}
+
+	t.Run("pulls the running platform by default", func(t *testing.T) {
+		content, err := pull(t)
+		require.NoError(t, err)
+		assert.Equal(t, "native", content)
+	})
+
+	t.Run("pulls an explicit platform", func(t *testing.T) {
+		content, err := pull(t, WithPullPlatform("aix/ppc64"))
+		require.NoError(t, err)
+		assert.Equal(t, "aix/ppc64", content)
+	})
+
+	t.Run("pins the index digest", func(t *testing.T) {
+		_, err := pull(t, WithExpectedDigest(digest.FromBytes(indexBytes).String()))
+		require.NoError(t, err)
+	})
+
+	t.Run("fails for missing platforms", func(t *testing.T) {
+		_, err := pull(t, WithPullPlatform("windows/amd64"))
+		assert.ErrorIs(t, err, ErrPlatformNotFound)
+	})
+
+	t.Run("rejects missing platform directories", func(t *testing.T) {
+		err := client.Push(ctx, sourceDir, reference, WithPlatformVariant("linux/amd64", "missing"))
+		assert.ErrorContains(t, err, "platform directory does not exist")
+	})
+}
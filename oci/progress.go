@@ -0,0 +1,249 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains pull progress reporting and bandwidth limiting.
+package ocibundle
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// PullProgress is a snapshot of the progress of a pull, reported to the
+// callback set with WithPullProgress.
+type PullProgress struct {
+	// DownloadedBytes is the number of blob bytes downloaded across all layers.
+	DownloadedBytes int64
+
+	// TotalBytes is the combined size of the layer blobs being pulled.
+	TotalBytes int64
+
+	// ExtractedFiles is the number of entries extracted across all layers.
+	ExtractedFiles int64
+
+	// ExtractedBytes is the uncompressed size of the file contents extracted
+	// across all layers.
+	ExtractedBytes int64
+
+	// Layers is the progress of each layer being pulled, in manifest order.
+	Layers []LayerProgress
+}
+
+// LayerProgress is the progress of a single layer of a pull.
+type LayerProgress struct {
+	// Digest is the digest of the layer blob
+	Digest string
+
+	// Name is the layer name for bundles pushed with WithLayerSplit
+	Name string
+
+	// Size is the size of the layer blob in bytes
+	Size int64
+
+	// DownloadedBytes is the number of blob bytes downloaded
+	DownloadedBytes int64
+
+	// ExtractedFiles is the number of entries extracted from the layer
+	ExtractedFiles int64
+
+	// ExtractedBytes is the uncompressed size of the file contents extracted
+	ExtractedBytes int64
+}
+
+// pullProgress tracks the progress of a pull and limits its download rate.
+// A nil pullProgress does nothing, so it can be used unconditionally.
+type pullProgress struct {
+	callback func(PullProgress)
+	limiter  *bandwidthLimiter
+
+	mu     sync.Mutex
+	state  PullProgress
+	layers map[string]int
+}
+
+// newPullProgress creates a pullProgress for layers, or returns nil if the
+// pull options neither report progress nor limit bandwidth.
+func newPullProgress(pullOpts *PullOptions, layers []orasint.LayerInfo) *pullProgress {
+	if pullOpts.Progress == nil && pullOpts.BandwidthLimit <= 0 {
+		return nil
+	}
+
+	p := &pullProgress{
+		callback: pullOpts.Progress,
+		layers:   make(map[string]int, len(layers)),
+	}
+	if pullOpts.BandwidthLimit > 0 {
+		p.limiter = &bandwidthLimiter{bytesPerSecond: pullOpts.BandwidthLimit}
+	}
+	for i, layer := range layers {
+		p.layers[layer.Digest] = i
+		p.state.TotalBytes += layer.Size
+		p.state.Layers = append(p.state.Layers, LayerProgress{
+			Digest: layer.Digest,
+			Name:   layer.Annotations[ocispec.AnnotationTitle],
+			Size:   layer.Size,
+		})
+	}
+	return p
+}
+
+// reader returns r wrapped to report the download of the layer with digest
+// and to honor the bandwidth limit.
+func (p *pullProgress) reader(ctx context.Context, digest string, r io.Reader) io.Reader {
+	if p == nil {
+		return r
+	}
+	return &progressReader{ctx: ctx, r: r, progress: p, digest: digest}
+}
+
+// readCloser is reader for blobs that must be closed.
+func (p *pullProgress) readCloser(ctx context.Context, digest string, rc io.ReadCloser) io.ReadCloser {
+	if p == nil {
+		return rc
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{p.reader(ctx, digest, rc), rc}
+}
+
+// readerAt returns r wrapped like reader, for ranged reads of a blob.
+func (p *pullProgress) readerAt(ctx context.Context, digest string, r io.ReaderAt) io.ReaderAt {
+	if p == nil {
+		return r
+	}
+	return &progressReaderAt{ctx: ctx, r: r, progress: p, digest: digest}
+}
+
+// extractOptions returns opts reporting the entries extracted from the layer
+// with digest.
+func (p *pullProgress) extractOptions(digest string, opts ExtractOptions) ExtractOptions {
+	if p == nil || p.callback == nil {
+		return opts
+	}
+	opts.Progress = func(_ string, size int64) {
+		p.update(digest, func(layer *LayerProgress) {
+			layer.ExtractedFiles++
+			layer.ExtractedBytes += size
+			p.state.ExtractedFiles++
+			p.state.ExtractedBytes += size
+		})
+	}
+	return opts
+}
+
+// downloaded records n bytes downloaded for the layer with digest.
+func (p *pullProgress) downloaded(digest string, n int) {
+	if p.callback == nil || n == 0 {
+		return
+	}
+	p.update(digest, func(layer *LayerProgress) {
+		layer.DownloadedBytes += int64(n)
+		p.state.DownloadedBytes += int64(n)
+	})
+}
+
+// update applies fn to the progress of the layer with digest and reports the
+// result to the callback.
+func (p *pullProgress) update(digest string, fn func(layer *LayerProgress)) {
+	p.mu.Lock()
+	// Blobs outside the manifest layers are tracked as an extra layer
+	i, ok := p.layers[digest]
+	if !ok {
+		i = len(p.state.Layers)
+		p.layers[digest] = i
+		p.state.Layers = append(p.state.Layers, LayerProgress{Digest: digest})
+	}
+	fn(&p.state.Layers[i])
+
+	snapshot := p.state
+	snapshot.Layers = append([]LayerProgress(nil), p.state.Layers...)
+	p.mu.Unlock()
+
+	p.callback(snapshot)
+}
+
+// progressReader reports the bytes read from a layer blob and limits the
+// rate they are read at.
+type progressReader struct {
+	ctx      context.Context
+	r        io.Reader
+	progress *pullProgress
+	digest   string
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	p = r.progress.limiter.limit(p)
+	n, err := r.r.Read(p)
+	r.progress.downloaded(r.digest, n)
+	if waitErr := r.progress.limiter.wait(r.ctx, n); waitErr != nil && err == nil {
+		err = waitErr
+	}
+	return n, err
+}
+
+// progressReaderAt is progressReader for ranged reads.
+type progressReaderAt struct {
+	ctx      context.Context
+	r        io.ReaderAt
+	progress *pullProgress
+	digest   string
+}
+
+func (r *progressReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.r.ReadAt(p, off)
+	r.progress.downloaded(r.digest, n)
+	if waitErr := r.progress.limiter.wait(r.ctx, n); waitErr != nil && err == nil {
+		err = waitErr
+	}
+	return n, err
+}
+
+// bandwidthLimiter limits the rate bytes are read at across all the readers
+// of a pull. Each read reserves the time its bytes take at the limit, and
+// waits for the reservations before it to elapse.
+type bandwidthLimiter struct {
+	bytesPerSecond int64
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// limit shortens p to at most a second's worth of bytes, so that reads are
+// spread evenly instead of arriving in large bursts. A nil limiter returns p.
+func (l *bandwidthLimiter) limit(p []byte) []byte {
+	if l == nil || int64(len(p)) <= l.bytesPerSecond {
+		return p
+	}
+	return p[:l.bytesPerSecond]
+}
+
+// wait blocks until n bytes fit within the limit or ctx is done.
+// A nil limiter returns immediately.
+func (l *bandwidthLimiter) wait(ctx context.Context, n int) error {
+	if l == nil || n <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	l.next = l.next.Add(time.Duration(float64(n) / float64(l.bytesPerSecond) * float64(time.Second)))
+	delay := l.next.Sub(now)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,111 @@
+package ocibundle
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/oci/internal/oras"
+	"github.com/jmgilman/go/oci/internal/oras/mocks"
+)
+
+func TestClient_Pull_Progress(t *testing.T) {
+	mockTarGzData, err := createMockTarGzData()
+	require.NoError(t, err)
+
+	mockORAS := &mocks.ClientMock{
+		PullFunc: func(_ context.Context, _ string, _ *oras.AuthOptions) (*oras.PullDescriptor, error) {
+			return &oras.PullDescriptor{
+				MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+				Data:      &mockReadCloserForTest{data: mockTarGzData},
+				Size:      int64(len(mockTarGzData)),
+				Digest:    "sha256:layer",
+			}, nil
+		},
+	}
+	client, err := NewWithOptions(WithORASClient(mockORAS))
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var last PullProgress
+	calls := 0
+	err = client.Pull(context.Background(), "example.com/test/repo:tag", t.TempDir(),
+		WithPullProgress(func(progress PullProgress) {
+			mu.Lock()
+			defer mu.Unlock()
+			last = progress
+			calls++
+		}),
+	)
+	require.NoError(t, err)
+
+	assert.Greater(t, calls, 1)
+	assert.Equal(t, int64(len(mockTarGzData)), last.TotalBytes)
+	assert.Equal(t, int64(len(mockTarGzData)), last.DownloadedBytes)
+	assert.Equal(t, int64(1), last.ExtractedFiles)
+	assert.Equal(t, int64(len("Hello, World!")), last.ExtractedBytes)
+	require.Len(t, last.Layers, 1)
+	assert.Equal(t, LayerProgress{
+		Digest:          "sha256:layer",
+		Size:            int64(len(mockTarGzData)),
+		DownloadedBytes: int64(len(mockTarGzData)),
+		ExtractedFiles:  1,
+		ExtractedBytes:  int64(len("Hello, World!")),
+	}, last.Layers[0])
+}
+
+func TestPullProgress_Layers(t *testing.T) {
+	var last PullProgress
+	pullOpts := &PullOptions{Progress: func(progress PullProgress) { last = progress }}
+	progress := newPullProgress(pullOpts, []oras.LayerInfo{
+		{Digest: "sha256:a", Size: 10, Annotations: map[string]string{ocispec.AnnotationTitle: "config"}},
+		{Digest: "sha256:b", Size: 20, Annotations: map[string]string{ocispec.AnnotationTitle: "data"}},
+	})
+
+	_, err := io.ReadAll(progress.reader(context.Background(), "sha256:b", bytes.NewReader(make([]byte, 20))))
+	require.NoError(t, err)
+	progress.extractOptions("sha256:b", ExtractOptions{}).Progress("file", 7)
+
+	assert.Equal(t, int64(30), last.TotalBytes)
+	assert.Equal(t, int64(20), last.DownloadedBytes)
+	assert.Equal(t, []LayerProgress{
+		{Digest: "sha256:a", Name: "config", Size: 10},
+		{Digest: "sha256:b", Name: "data", Size: 20, DownloadedBytes: 20, ExtractedFiles: 1, ExtractedBytes: 7},
+	}, last.Layers)
+
+	t.Run("disabled without callback or limit", func(t *testing.T) {
+		assert.Nil(t, newPullProgress(&PullOptions{}, nil))
+
+		var progress *pullProgress
+		r := bytes.NewReader(nil)
+		assert.Same(t, r, progress.reader(context.Background(), "sha256:a", r))
+		assert.Nil(t, progress.extractOptions("sha256:a", ExtractOptions{}).Progress)
+	})
+}
+
+func TestWithBandwidthLimit(t *testing.T) {
+	progress := newPullProgress(&PullOptions{BandwidthLimit: 10000}, nil)
+	require.NotNil(t, progress)
+
+	start := time.Now()
+	data, err := io.ReadAll(progress.reader(context.Background(), "sha256:a", bytes.NewReader(make([]byte, 3000))))
+	require.NoError(t, err)
+	assert.Len(t, data, 3000)
+	assert.GreaterOrEqual(t, time.Since(start), 250*time.Millisecond)
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		progress := newPullProgress(&PullOptions{BandwidthLimit: 1}, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := io.ReadAll(progress.reader(ctx, "sha256:a", bytes.NewReader(make([]byte, 100))))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,349 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains extraction of bundles into arbitrary filesystems.
+package ocibundle
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/jmgilman/go/fs/core"
+	validatepkg "github.com/jmgilman/go/oci/internal/validate"
+)
+
+// FSExtractor is implemented by archivers that can extract an archive directly
+// into a filesystem. Paths are written relative to the root of the target, so
+// providers without a local directory (in-memory, S3) can be used as targets.
+type FSExtractor interface {
+	// ExtractToFS expands an archive into the root of target.
+	// The opts parameter controls extraction behavior and security constraints.
+	// Returns an error if the extraction process fails.
+	ExtractToFS(ctx context.Context, input io.Reader, target core.WriteFS, opts ExtractOptions) error
+}
+
+// PullToFS downloads an OCI artifact and extracts it into the root of target.
+// Unlike Pull, files are streamed directly into target without being staged in
+// a temporary directory, so extraction is not atomic: if it fails, target may
+// contain a partially extracted bundle.
+//
+// Pull options are applied as in Pull. Symlinks are only created if target
+// implements core.SymlinkFS, and hardlinks are copied unless target supports
+// them. If a SignatureVerifier is configured, signatures are verified before
+// anything is written to target.
+//
+// Example:
+//
+//	memfs := billy.NewMemory()
+//	err := client.PullToFS(ctx, "ghcr.io/org/repo:v1.0.0", memfs)
+func (c *Client) PullToFS(ctx context.Context, reference string, target core.WriteFS, opts ...PullOption) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	pullOpts := applyPullOptions(opts)
+
+	if reference == "" {
+		return fmt.Errorf("reference cannot be empty")
+	}
+	if target == nil {
+		return fmt.Errorf("target filesystem cannot be nil")
+	}
+
+	descriptor, err := c.fetchVerified(ctx, reference, pullOpts)
+	if err != nil {
+		return err
+	}
+	defer descriptor.Data.Close()
+
+	extractOpts := pullExtractOptions(pullOpts)
+
+	if len(descriptor.Layers) <= 1 && len(pullOpts.Layers) == 0 {
+		progress := newPullProgress(pullOpts, pulledLayers(descriptor))
+		data := progress.reader(ctx, descriptor.Digest, descriptor.Data)
+		layerOpts := progress.extractOptions(descriptor.Digest, extractOpts)
+		err := c.observeExtract(ctx, reference, descriptor.Digest, data, func(ctx context.Context, data io.Reader) error {
+			return c.extractToFS(ctx, descriptor.MediaType, data, target, layerOpts)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to extract archive: %w", err)
+		}
+		return nil
+	}
+
+	layers, err := selectLayers(descriptor.Layers, pullOpts.Layers)
+	if err != nil {
+		return err
+	}
+	progress := newPullProgress(pullOpts, layers)
+
+	repo, err := c.createRepository(ctx, reference)
+	if err != nil {
+		return err
+	}
+
+	// Limits apply to all layers combined, so track what each layer wrote
+	counter := &countingWriteFS{WriteFS: target}
+	streamUsed := false
+	for i, layer := range layers {
+		remaining := extractOpts
+		remaining.MaxFiles -= counter.files
+		remaining.MaxSize -= counter.size
+		if i > 0 && ((extractOpts.MaxFiles > 0 && remaining.MaxFiles <= 0) || (extractOpts.MaxSize > 0 && remaining.MaxSize <= 0)) {
+			return fmt.Errorf("archive exceeds extraction limits across layers")
+		}
+
+		// The pulled stream holds the first manifest layer; other layers are fetched
+		var data io.Reader = descriptor.Data
+		if streamUsed || layer.Digest != descriptor.Digest {
+			_, blob, err := repo.Blobs().FetchReference(ctx, layer.Digest)
+			if err != nil {
+				return fmt.Errorf("failed to fetch layer %s: %w", layer.Digest, err)
+			}
+			data = blob
+		} else {
+			streamUsed = true
+		}
+
+		layerOpts := progress.extractOptions(layer.Digest, remaining)
+		blob := progress.reader(ctx, layer.Digest, data)
+		err := c.observeExtract(ctx, reference, layer.Digest, blob, func(ctx context.Context, data io.Reader) error {
+			return c.extractToFS(ctx, layer.MediaType, data, counter, layerOpts)
+		})
+		if closer, ok := data.(io.Closer); ok && data != descriptor.Data {
+			_ = closer.Close()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to extract layer %s: %w", layer.Digest, err)
+		}
+	}
+
+	return nil
+}
+
+// extractToFS extracts data into target using the archiver for mediaType.
+func (c *Client) extractToFS(
+	ctx context.Context,
+	mediaType string,
+	data io.Reader,
+	target core.WriteFS,
+	opts ExtractOptions,
+) error {
+	archiver := c.pullArchiver(mediaType)
+	extractor, ok := archiver.(FSExtractor)
+	if !ok {
+		return fmt.Errorf("archiver for media type %s does not support extraction to a filesystem", archiver.MediaType())
+	}
+	return extractor.ExtractToFS(ctx, data, target, opts)
+}
+
+// ExtractToFS expands a tar.gz archive into the root of target with the same
+// security validation as Extract.
+func (a *TarGzArchiver) ExtractToFS(ctx context.Context, input io.Reader, target core.WriteFS, opts ExtractOptions) error {
+	if input == nil {
+		return fmt.Errorf("input reader cannot be nil")
+	}
+	if target == nil {
+		return fmt.Errorf("target filesystem cannot be nil")
+	}
+
+	decompressed, err := newDecompressor(input)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = decompressed.Close() }()
+
+	tarReader := tar.NewReader(decompressed)
+
+	validators := newDefaultValidatorChain(opts)
+
+	// Paths are validated relative to the root of target
+	pv := validatepkg.NewPathTraversalValidator()
+	pv.AllowHiddenFiles = opts.AllowHiddenFiles
+	pv.RootPath = "."
+
+	totalSize := int64(0)
+	fileCount := 0
+	links := newLinkExtractor(target, "", opts.SymlinkPolicy)
+	metadata := newMetadataRestorer(target, opts)
+
+	for {
+		header, nextErr := tarReader.Next()
+		if errors.Is(nextErr, io.EOF) {
+			break
+		}
+		if nextErr != nil {
+			return fmt.Errorf("failed to read tar header: %w", nextErr)
+		}
+
+		if isEstargzMetadata(header.Name) {
+			continue
+		}
+
+		if err := handleFSHeader(ctx, tarReader, header, opts, validators, pv, links, metadata, &totalSize, &fileCount, target); err != nil {
+			return err
+		}
+	}
+
+	return metadata.finish()
+}
+
+// handleFSHeader validates and extracts a single tar entry into target.
+func handleFSHeader(
+	ctx context.Context,
+	tr *tar.Reader,
+	hdr *tar.Header,
+	opts ExtractOptions,
+	validators Validator,
+	pv *validatepkg.PathTraversalValidator,
+	links *linkExtractor,
+	metadata *metadataRestorer,
+	totalSize *int64,
+	fileCount *int,
+	target core.WriteFS,
+) error {
+	if err := isDone(ctx, "extraction"); err != nil {
+		return err
+	}
+
+	if err := pv.ValidatePath(hdr.Name); err != nil {
+		return NewBundleError("extract", hdr.Name, ErrSecurityViolation)
+	}
+
+	name := stripPrefix(hdr.Name, opts.StripPrefix)
+	fsPath, err := resolveFSPath(name)
+	if err != nil {
+		return NewBundleError("extract", hdr.Name, ErrSecurityViolation)
+	}
+
+	if len(opts.FilesToExtract) > 0 && hdr.Typeflag != tar.TypeDir {
+		if !matchesAnyPattern(name, opts.FilesToExtract) {
+			return nil
+		}
+	}
+	if opts.Filter != nil && !opts.Filter(headerFileInfo(hdr)) {
+		return nil
+	}
+
+	*fileCount++
+
+	if err := validateFileAndArchive(validators, hdr, opts, totalSize, fileCount); err != nil {
+		return err
+	}
+
+	if fsPath == "." {
+		return nil
+	}
+	if err := links.checkPath(fsPath); err != nil {
+		return err
+	}
+
+	if dir := path.Dir(fsPath); dir != "." {
+		if err := target.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", fsPath, err)
+		}
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		err = extractDir(target, fsPath)
+	case tar.TypeReg:
+		if err = extractRegularFile(target, tr, fsPath); err == nil {
+			links.file(fsPath)
+		}
+	case tar.TypeSymlink:
+		err = links.symlink(fsPath, hdr.Linkname)
+	case tar.TypeLink:
+		err = links.hardlink(fsPath, stripPrefix(hdr.Linkname, opts.StripPrefix))
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := metadata.restore(fsPath, hdr); err != nil {
+		return err
+	}
+	reportExtracted(opts, hdr, fsPath)
+	return nil
+}
+
+// resolveFSPath converts an archive member name into a slash-separated path
+// relative to the root of a filesystem, rejecting paths that escape it.
+func resolveFSPath(name string) (string, error) {
+	cleaned := path.Clean(strings.TrimPrefix(name, "/"))
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("path escapes target filesystem: %s", name)
+	}
+	return cleaned, nil
+}
+
+// countingWriteFS wraps a core.WriteFS and records the number of files and
+// bytes written through it.
+type countingWriteFS struct {
+	core.WriteFS
+	files int
+	size  int64
+}
+
+// Create creates a file and counts the bytes written to it.
+func (c *countingWriteFS) Create(name string) (core.File, error) {
+	return c.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+}
+
+// OpenFile opens a file and counts the bytes written to it.
+func (c *countingWriteFS) OpenFile(name string, flag int, perm fs.FileMode) (core.File, error) {
+	file, err := c.WriteFS.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	c.files++
+	return &countingFile{File: file, size: &c.size}, nil
+}
+
+// WriteFile writes a file and counts its bytes.
+func (c *countingWriteFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	if err := c.WriteFS.WriteFile(name, data, perm); err != nil {
+		return err
+	}
+	c.files++
+	c.size += int64(len(data))
+	return nil
+}
+
+// Symlink forwards to the wrapped filesystem if it supports symlinks.
+func (c *countingWriteFS) Symlink(oldname, newname string) error {
+	_, err := createSymlink(c.WriteFS, oldname, newname)
+	return err
+}
+
+// Unwrap returns the wrapped filesystem.
+func (c *countingWriteFS) Unwrap() core.WriteFS {
+	return c.WriteFS
+}
+
+// Readlink forwards to the wrapped filesystem if it supports symlinks.
+func (c *countingWriteFS) Readlink(name string) (string, error) {
+	sfs, ok := c.WriteFS.(core.SymlinkFS)
+	if !ok {
+		return "", fmt.Errorf("filesystem does not support symlinks")
+	}
+	return sfs.Readlink(name)
+}
+
+// countingFile adds the bytes written to a file to a shared counter.
+type countingFile struct {
+	core.File
+	size *int64
+}
+
+// Write writes to the file and counts the bytes written.
+func (f *countingFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	*f.size += int64(n)
+	return n, err
+}
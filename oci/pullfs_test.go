@@ -0,0 +1,129 @@
+package ocibundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/oci/internal/oras"
+	"github.com/jmgilman/go/oci/internal/oras/mocks"
+)
+
+// buildTestTarGz builds a tar.gz archive from the given entries. Entries
+// with a trailing slash are written as directories.
+func buildTestTarGz(t *testing.T, entries map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range entries {
+		if name[len(name)-1] == '/' {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Typeflag: tar.TypeDir}))
+			continue
+		}
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// newPullToFSClient returns a client whose ORAS client serves archive.
+func newPullToFSClient(t *testing.T, archive []byte) *Client {
+	t.Helper()
+
+	mockORAS := &mocks.ClientMock{
+		PullFunc: func(_ context.Context, _ string, _ *oras.AuthOptions) (*oras.PullDescriptor, error) {
+			return &oras.PullDescriptor{
+				MediaType: "application/vnd.oci.image.layer.v1.tar+gzip",
+				Data:      io.NopCloser(bytes.NewReader(archive)),
+				Size:      int64(len(archive)),
+			}, nil
+		},
+	}
+
+	client, err := NewWithOptions(WithORASClient(mockORAS))
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_PullToFS(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("extracts into memory filesystem", func(t *testing.T) {
+		client := newPullToFSClient(t, buildTestTarGz(t, map[string]string{
+			"config/":          "",
+			"config/app.yaml":  "name: app",
+			"nested/a/b/c.txt": "deep",
+		}))
+
+		mem := billy.NewMemory()
+		require.NoError(t, client.PullToFS(ctx, "example.com/repo:tag", mem))
+
+		data, err := mem.ReadFile("config/app.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "name: app", string(data))
+
+		data, err = mem.ReadFile("nested/a/b/c.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "deep", string(data))
+	})
+
+	t.Run("applies pull options", func(t *testing.T) {
+		client := newPullToFSClient(t, buildTestTarGz(t, map[string]string{
+			"bundle/keep.txt": "keep",
+			"bundle/skip.md":  "skip",
+		}))
+
+		mem := billy.NewMemory()
+		err := client.PullToFS(ctx, "example.com/repo:tag", mem,
+			WithPullStripPrefix("bundle"),
+			WithFilesToExtract("*.txt"),
+		)
+		require.NoError(t, err)
+
+		data, err := mem.ReadFile("keep.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "keep", string(data))
+
+		exists, err := mem.Exists("skip.md")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("enforces size limits", func(t *testing.T) {
+		client := newPullToFSClient(t, buildTestTarGz(t, map[string]string{
+			"big.txt": "0123456789",
+		}))
+
+		err := client.PullToFS(ctx, "example.com/repo:tag", billy.NewMemory(), WithMaxSize(5))
+		require.Error(t, err)
+	})
+
+	t.Run("rejects path traversal", func(t *testing.T) {
+		var archive bytes.Buffer
+		createMaliciousArchive(t, &archive)
+		client := newPullToFSClient(t, archive.Bytes())
+
+		err := client.PullToFS(ctx, "example.com/repo:tag", billy.NewMemory())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "security")
+	})
+
+	t.Run("validates inputs", func(t *testing.T) {
+		client := newPullToFSClient(t, nil)
+
+		require.Error(t, client.PullToFS(ctx, "", billy.NewMemory()))
+		require.Error(t, client.PullToFS(ctx, "example.com/repo:tag", nil))
+	})
+}
@@ -0,0 +1,283 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains pushing of pre-built archives and filesystem bundles.
+package ocibundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/jmgilman/go/fs/core"
+)
+
+// FSArchiver is implemented by archivers that can archive the contents of a
+// filesystem directly, so bundles held in memory or remote storage don't need
+// to be staged in a local directory first.
+type FSArchiver interface {
+	// ArchiveFS creates an archive from the root of source.
+	// The output parameter is where the archive data is written.
+	// The progress callback, if non-nil, is called periodically during archiving.
+	// Returns an error if the archiving process fails.
+	ArchiveFS(ctx context.Context, source core.ReadFS, output io.Writer, progress func(current, total int64)) error
+}
+
+// PushArchive uploads a pre-built archive as an OCI artifact with the given
+// media type. This lets build systems that already produce a bundle tarball
+// push it without unpacking it first.
+//
+// If r implements io.Seeker, it is pushed directly and rewound between
+// retries and for signing. Otherwise, it is first copied to a temporary file
+// so its size is known.
+//
+// Example:
+//
+//	f, err := os.Open("dist/bundle.tar.gz")
+//	err = client.PushArchive(ctx, f, "application/vnd.oci.image.layer.v1.tar+gzip", "ghcr.io/org/repo:v1.0.0")
+func (c *Client) PushArchive(ctx context.Context, r io.Reader, mediaType, reference string, opts ...PushOption) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	pushOpts := applyPushOptions(opts)
+
+	if r == nil {
+		return fmt.Errorf("archive reader cannot be nil")
+	}
+	if mediaType == "" {
+		return fmt.Errorf("media type cannot be empty")
+	}
+	if reference == "" {
+		return fmt.Errorf("reference cannot be empty")
+	}
+	if pushOpts.LayerSplit != nil {
+		return fmt.Errorf("layer splitting is not supported when pushing a pre-built archive")
+	}
+
+	if _, err := c.createRepository(ctx, reference); err != nil {
+		return err
+	}
+
+	if seeker, ok := r.(io.ReadSeeker); ok {
+		size, err := seeker.Seek(0, io.SeekEnd)
+		if err != nil {
+			return fmt.Errorf("failed to determine archive size: %w", err)
+		}
+		return c.pushBlob(ctx, reference, mediaType, seeker, size, pushOpts)
+	}
+
+	return c.pushStaged(ctx, reference, mediaType, pushOpts, func(w io.Writer) error {
+		if _, err := io.Copy(w, r); err != nil {
+			return fmt.Errorf("failed to copy archive: %w", err)
+		}
+		return nil
+	})
+}
+
+// PushFS uploads the contents of a filesystem as an OCI artifact. The root of
+// source becomes the root of the bundle, so files held in memory (or any other
+// core.ReadFS) can be pushed without writing them to disk first.
+//
+// The configured archiver must implement FSArchiver; the default tar.gz
+// archiver does. Symlinks are only archived if source implements
+// core.SymlinkFS, and symlinks pointing outside source are rejected.
+// Layer splitting is not supported.
+//
+// Example:
+//
+//	mem := billy.NewMemory()
+//	_ = mem.WriteFile("config.yaml", data, 0o644)
+//	err := client.PushFS(ctx, mem, "ghcr.io/org/repo:v1.0.0")
+func (c *Client) PushFS(ctx context.Context, source core.ReadFS, reference string, opts ...PushOption) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	pushOpts := applyPushOptions(opts)
+
+	if source == nil {
+		return fmt.Errorf("source filesystem cannot be nil")
+	}
+	if reference == "" {
+		return fmt.Errorf("reference cannot be empty")
+	}
+	if pushOpts.LayerSplit != nil {
+		return fmt.Errorf("layer splitting is not supported when pushing a filesystem")
+	}
+
+	archiver, err := c.pushArchiverFor(pushOpts)
+	if err != nil {
+		return err
+	}
+	fsArchiver, ok := archiver.(FSArchiver)
+	if !ok {
+		return fmt.Errorf("archiver for media type %s does not support archiving a filesystem", archiver.MediaType())
+	}
+
+	if _, err := c.createRepository(ctx, reference); err != nil {
+		return err
+	}
+
+	return c.pushStaged(ctx, reference, archiver.MediaType(), pushOpts, c.observeArchive(ctx, reference, func(w io.Writer) error {
+		if err := fsArchiver.ArchiveFS(ctx, source, w, pushOpts.ProgressCallback); err != nil {
+			return fmt.Errorf("failed to archive filesystem: %w", err)
+		}
+		return nil
+	}))
+}
+
+// ArchiveFS creates an eStargz archive from the root of source.
+func (a *TarGzArchiver) ArchiveFS(
+	ctx context.Context,
+	source core.ReadFS,
+	output io.Writer,
+	progress func(current, total int64),
+) error {
+	if source == nil {
+		return fmt.Errorf("source filesystem cannot be nil")
+	}
+	if output == nil {
+		return fmt.Errorf("output writer cannot be nil")
+	}
+
+	tarBytes, err := a.buildFSTar(ctx, source, progress)
+	if err != nil {
+		return err
+	}
+	return writeEstargz(tarBytes, output, a.options)
+}
+
+// buildFSTar creates an uncompressed tar archive from the root of source, to
+// be compressed by the caller.
+func (a *TarGzArchiver) buildFSTar(
+	ctx context.Context,
+	source core.ReadFS,
+	progress func(current, total int64),
+) ([]byte, error) {
+	rules, err := loadIgnoreRules(source, ".", a.options.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []fsArchiveEntry
+	walkErr := fs.WalkDir(source, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk failed at %s: %w", name, err)
+		}
+		if name == "." {
+			return nil
+		}
+		if rules.excluded(name, d.IsDir()) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if err := isDone(ctx, "archiving"); err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return fmt.Errorf("failed to get file info for %s: %w", name, err)
+		}
+
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			target, ok, err := readlink(source, name)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+			if err := validateArchivedSymlink(name, target); err != nil {
+				return err
+			}
+			link = target
+		}
+
+		entries = append(entries, fsArchiveEntry{name: name, info: info, link: link})
+		return nil
+	})
+	if walkErr != nil {
+		return nil, fmt.Errorf("failed to collect files: %w", walkErr)
+	}
+
+	if a.options.Deterministic {
+		slices.SortStableFunc(entries, func(x, y fsArchiveEntry) int {
+			return strings.Compare(x.name, y.name)
+		})
+	}
+
+	// Entries are written in order, so hardlinks follow their targets
+	var totalSize int64
+	seen := make(hardlinkIndex)
+	for i, entry := range entries {
+		if !entry.info.Mode().IsRegular() {
+			continue
+		}
+		if entries[i].link, entries[i].hardlink = seen.add(entry.name, entry.info); !entries[i].hardlink {
+			totalSize += entry.info.Size()
+		}
+	}
+
+	var tarBuf bytes.Buffer
+	tarWriter := tar.NewWriter(&tarBuf)
+
+	var currentSize int64
+	for _, entry := range entries {
+		if err := isDone(ctx, "archiving"); err != nil {
+			return nil, err
+		}
+		if err := a.writeFSEntry(source, tarWriter, entry, &currentSize, totalSize, progress); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close tar writer: %w", err)
+	}
+
+	return tarBuf.Bytes(), nil
+}
+
+// fsArchiveEntry holds a filesystem entry to be archived by ArchiveFS.
+type fsArchiveEntry struct {
+	name     string
+	info     fs.FileInfo
+	link     string
+	hardlink bool
+}
+
+// writeFSEntry writes a single filesystem entry and its content to tarWriter.
+func (a *TarGzArchiver) writeFSEntry(
+	source core.ReadFS,
+	tarWriter *tar.Writer,
+	entry fsArchiveEntry,
+	currentSize *int64,
+	totalSize int64,
+	progress func(current, total int64),
+) error {
+	header, err := a.header(source, entry.info, entry.name, entry.name, entry.link, entry.hardlink)
+	if err != nil {
+		return err
+	}
+
+	var content io.ReadCloser
+	if header.Typeflag == tar.TypeReg {
+		file, err := source.Open(entry.name)
+		if err != nil {
+			return fmt.Errorf("failed to open file %s: %w", entry.name, err)
+		}
+		content = file
+	}
+
+	return writeArchiveEntry(tarWriter, archiveResult{
+		relPath: entry.name,
+		header:  header,
+		content: content,
+	}, a.copyWithProgress, currentSize, totalSize, progress)
+}
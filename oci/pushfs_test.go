@@ -0,0 +1,123 @@
+package ocibundle
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/oci/internal/oras"
+	"github.com/jmgilman/go/oci/internal/oras/mocks"
+)
+
+// pushRecorder captures the descriptors pushed through a mock ORAS client.
+type pushRecorder struct {
+	mediaType string
+	data      []byte
+	size      int64
+	pushes    int
+}
+
+// newPushRecordingClient returns a client that records pushes into rec.
+func newPushRecordingClient(t *testing.T, rec *pushRecorder) *Client {
+	t.Helper()
+
+	mockORAS := &mocks.ClientMock{
+		PushFunc: func(_ context.Context, _ string, descriptor *oras.PushDescriptor, _ *oras.AuthOptions) error {
+			data, err := io.ReadAll(descriptor.Data)
+			if err != nil {
+				return err
+			}
+			rec.mediaType = descriptor.MediaType
+			rec.data = data
+			rec.size = descriptor.Size
+			rec.pushes++
+			return nil
+		},
+	}
+
+	client, err := NewWithOptions(WithORASClient(mockORAS))
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_PushArchive(t *testing.T) {
+	ctx := context.Background()
+	archive := buildTestTarGz(t, map[string]string{"hello.txt": "hi"})
+	const mediaType = "application/vnd.oci.image.layer.v1.tar+gzip"
+
+	t.Run("pushes seekable archive", func(t *testing.T) {
+		rec := &pushRecorder{}
+		client := newPushRecordingClient(t, rec)
+
+		require.NoError(t, client.PushArchive(ctx, bytes.NewReader(archive), mediaType, "example.com/repo:tag"))
+
+		assert.Equal(t, 1, rec.pushes)
+		assert.Equal(t, mediaType, rec.mediaType)
+		assert.Equal(t, archive, rec.data)
+		assert.Equal(t, int64(len(archive)), rec.size)
+	})
+
+	t.Run("pushes non-seekable archive", func(t *testing.T) {
+		rec := &pushRecorder{}
+		client := newPushRecordingClient(t, rec)
+
+		reader := io.MultiReader(bytes.NewReader(archive))
+		require.NoError(t, client.PushArchive(ctx, reader, mediaType, "example.com/repo:tag"))
+
+		assert.Equal(t, archive, rec.data)
+		assert.Equal(t, int64(len(archive)), rec.size)
+	})
+
+	t.Run("validates inputs", func(t *testing.T) {
+		client := newPushRecordingClient(t, &pushRecorder{})
+
+		require.Error(t, client.PushArchive(ctx, nil, mediaType, "example.com/repo:tag"))
+		require.Error(t, client.PushArchive(ctx, bytes.NewReader(archive), "", "example.com/repo:tag"))
+		require.Error(t, client.PushArchive(ctx, bytes.NewReader(archive), mediaType, ""))
+		require.Error(t, client.PushArchive(ctx, bytes.NewReader(archive), mediaType, "example.com/repo:tag",
+			WithLayerSplit(SplitByTopLevelDir)))
+	})
+}
+
+func TestClient_PushFS(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pushes memory filesystem", func(t *testing.T) {
+		mem := billy.NewMemory()
+		require.NoError(t, mem.MkdirAll("config", 0o755))
+		require.NoError(t, mem.WriteFile("config/app.yaml", []byte("name: app"), 0o644))
+		require.NoError(t, mem.WriteFile("README.md", []byte("readme"), 0o644))
+
+		rec := &pushRecorder{}
+		client := newPushRecordingClient(t, rec)
+
+		require.NoError(t, client.PushFS(ctx, mem, "example.com/repo:tag"))
+		assert.Equal(t, "application/vnd.oci.image.layer.v1.tar+gzip", rec.mediaType)
+
+		// The pushed archive extracts back to the source tree
+		target := billy.NewMemory()
+		require.NoError(t, NewTarGzArchiver().ExtractToFS(ctx, bytes.NewReader(rec.data), target, DefaultExtractOptions))
+
+		data, err := target.ReadFile("config/app.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "name: app", string(data))
+
+		data, err = target.ReadFile("README.md")
+		require.NoError(t, err)
+		assert.Equal(t, "readme", string(data))
+	})
+
+	t.Run("validates inputs", func(t *testing.T) {
+		client := newPushRecordingClient(t, &pushRecorder{})
+
+		require.Error(t, client.PushFS(ctx, nil, "example.com/repo:tag"))
+		require.Error(t, client.PushFS(ctx, billy.NewMemory(), ""))
+		require.Error(t, client.PushFS(ctx, billy.NewMemory(), "example.com/repo:tag",
+			WithLayerSplit(SplitByTopLevelDir)))
+	})
+}
@@ -0,0 +1,133 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains support for attaching artifacts such as SBOMs and
+// attestations to manifests using the OCI referrers API.
+package ocibundle
+
+import (
+	"context"
+	"fmt"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/registry/remote"
+
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// Attach pushes blob as an artifact of artifactType (e.g.,
+// "application/spdx+json") that refers to the manifest subjectRef resolves to,
+// and returns the descriptor of the pushed manifest. The blob is stored as the
+// artifact's only layer, with artifactType as its media type.
+//
+// The artifact is pushed by digest only and is discoverable with
+// ListReferrers. Registries without the referrers API are supported through
+// the referrers tag schema, where the artifact is recorded in an index tagged
+// "<algorithm>-<hex>" after the subject's digest.
+//
+// Example:
+//
+//	sbom, _ := os.ReadFile("sbom.spdx.json")
+//	desc, err := client.Attach(ctx, "ghcr.io/org/repo:v1.0.0", "application/spdx+json", sbom)
+func (c *Client) Attach(ctx context.Context, subjectRef, artifactType string, blob []byte) (*ArtifactDescriptor, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if subjectRef == "" {
+		return nil, fmt.Errorf("reference cannot be empty")
+	}
+	if artifactType == "" {
+		return nil, fmt.Errorf("artifact type cannot be empty")
+	}
+	if len(blob) == 0 {
+		return nil, fmt.Errorf("blob cannot be empty")
+	}
+
+	repo, subject, err := c.resolveSubject(ctx, subjectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	layer, err := oras.PushBytes(ctx, repo, artifactType, blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to push blob: %w", err)
+	}
+
+	desc, err := oras.PackManifest(ctx, repo, oras.PackManifestVersion1_1, artifactType, oras.PackManifestOptions{
+		Subject: &subject,
+		Layers:  []ocispec.Descriptor{layer},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to push manifest: %w", err)
+	}
+
+	return referrerDescriptor(desc), nil
+}
+
+// ListReferrers returns the artifacts that refer to the manifest reference
+// resolves to, such as SBOMs, attestations, and signatures. If artifactType is
+// not empty, only artifacts of that type are returned.
+//
+// The referrers API is used when the registry supports it, falling back to the
+// referrers tag schema otherwise.
+//
+// Example:
+//
+//	sboms, err := client.ListReferrers(ctx, "ghcr.io/org/repo:v1.0.0", "application/spdx+json")
+func (c *Client) ListReferrers(ctx context.Context, reference, artifactType string) ([]ArtifactDescriptor, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if reference == "" {
+		return nil, fmt.Errorf("reference cannot be empty")
+	}
+
+	repo, subject, err := c.resolveSubject(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	referrers := []ArtifactDescriptor{}
+	err = repo.Referrers(ctx, subject, artifactType, func(page []ocispec.Descriptor) error {
+		for _, desc := range page {
+			referrers = append(referrers, *referrerDescriptor(desc))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list referrers: %w", err)
+	}
+
+	return referrers, nil
+}
+
+// resolveSubject resolves reference to the descriptor of its manifest,
+// returning the repository it belongs to.
+func (c *Client) resolveSubject(ctx context.Context, reference string) (*remote.Repository, ocispec.Descriptor, error) {
+	_, refPart, _ := splitReference(reference)
+	if refPart == "" {
+		return nil, ocispec.Descriptor{}, fmt.Errorf("reference must include a tag or digest")
+	}
+
+	repo, err := orasint.NewRepository(ctx, reference, c.options.Auth)
+	if err != nil {
+		return nil, ocispec.Descriptor{}, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	desc, err := repo.Resolve(ctx, refPart)
+	if err != nil {
+		return nil, ocispec.Descriptor{}, fmt.Errorf("failed to resolve %s: %w", reference, err)
+	}
+
+	return repo, desc, nil
+}
+
+// referrerDescriptor converts a referrer's descriptor to an ArtifactDescriptor.
+func referrerDescriptor(desc ocispec.Descriptor) *ArtifactDescriptor {
+	return &ArtifactDescriptor{
+		Digest:       desc.Digest.String(),
+		MediaType:    desc.MediaType,
+		ArtifactType: desc.ArtifactType,
+		Size:         desc.Size,
+		Annotations:  desc.Annotations,
+	}
+}
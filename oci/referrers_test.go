@@ -0,0 +1,68 @@
+package ocibundle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Referrers(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := NewWithOptions(WithAllowHTTP())
+	require.NoError(t, err)
+
+	t.Run("attaches and lists referrers", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		reg.putArtifact(t, "app", "v1", "bundle")
+		ref := reg.host + "/app:v1"
+
+		sbom, err := client.Attach(ctx, ref, "application/spdx+json", []byte(`{"spdxVersion":"SPDX-2.3"}`))
+		require.NoError(t, err)
+		assert.Equal(t, "application/spdx+json", sbom.ArtifactType)
+
+		provenance, err := client.Attach(ctx, ref, "application/vnd.in-toto+json", []byte(`{"_type":"statement"}`))
+		require.NoError(t, err)
+
+		referrers, err := client.ListReferrers(ctx, ref, "")
+		require.NoError(t, err)
+		var digests []string
+		for _, referrer := range referrers {
+			digests = append(digests, referrer.Digest)
+		}
+		assert.ElementsMatch(t, []string{sbom.Digest, provenance.Digest}, digests)
+
+		filtered, err := client.ListReferrers(ctx, ref, "application/spdx+json")
+		require.NoError(t, err)
+		require.Len(t, filtered, 1)
+		assert.Equal(t, sbom.Digest, filtered[0].Digest)
+	})
+
+	t.Run("lists no referrers", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		reg.putArtifact(t, "app", "v1", "bundle")
+
+		referrers, err := client.ListReferrers(ctx, reg.host+"/app:v1", "")
+		require.NoError(t, err)
+		assert.Empty(t, referrers)
+	})
+
+	t.Run("validates arguments", func(t *testing.T) {
+		_, err := client.Attach(ctx, "", "application/spdx+json", []byte("{}"))
+		require.Error(t, err)
+
+		_, err = client.Attach(ctx, "example.com/app:v1", "", []byte("{}"))
+		require.Error(t, err)
+
+		_, err = client.Attach(ctx, "example.com/app:v1", "application/spdx+json", nil)
+		require.Error(t, err)
+
+		_, err = client.ListReferrers(ctx, "", "")
+		require.Error(t, err)
+
+		_, err = client.ListReferrers(ctx, "example.com/app", "")
+		require.Error(t, err)
+	})
+}
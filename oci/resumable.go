@@ -0,0 +1,108 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains resumable blob transfer support.
+package ocibundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/registry/remote"
+
+	"github.com/jmgilman/go/fs/core"
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// resumeOptions returns the configuration for resumable transfers, or nil if
+// they are disabled. Transfer state is kept in the "transfers" directory of
+// the cache path.
+func (c *Client) resumeOptions() *orasint.ResumeOptions {
+	if !c.options.ResumableTransfers {
+		return nil
+	}
+	return &orasint.ResumeOptions{
+		FS:        c.options.FS,
+		StateDir:  filepath.Join(c.options.CacheConfig.CachePath, "transfers"),
+		ChunkSize: c.options.ChunkSize,
+	}
+}
+
+// fetchBlob returns a reader for the blob of layer, reading stream if it is
+// non-nil and fetching the blob by digest otherwise.
+//
+// With resumable transfers enabled, the blob is first downloaded into the
+// cache directory with retries, each of which requests only the bytes still
+// missing. The downloaded file is removed when the returned reader is closed.
+func (c *Client) fetchBlob(
+	ctx context.Context,
+	repo *remote.Repository,
+	stream io.Reader,
+	layer orasint.LayerInfo,
+	pullOpts *PullOptions,
+) (io.ReadCloser, error) {
+	reference := repo.Reference.String()
+	resume := c.resumeOptions()
+	if resume == nil {
+		if stream != nil {
+			stream = pullOpts.progress.reader(ctx, layer.Digest, stream)
+			return c.observeDownload(ctx, reference, layer.Digest, io.NopCloser(stream)), nil
+		}
+		_, blob, err := repo.Blobs().FetchReference(ctx, layer.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch layer: %w", err)
+		}
+		blob = pullOpts.progress.readCloser(ctx, layer.Digest, blob)
+		return c.observeDownload(ctx, reference, layer.Digest, blob), nil
+	}
+
+	desc := ocispec.Descriptor{
+		MediaType: layer.MediaType,
+		Digest:    digest.Digest(layer.Digest),
+		Size:      layer.Size,
+	}
+
+	downloadCtx, span := c.startPhase(ctx, PhaseDownload, reference)
+	span.event.Digest = layer.Digest
+	if pullOpts.progress != nil {
+		resume.WrapBody = func(body io.Reader) io.Reader {
+			return pullOpts.progress.reader(downloadCtx, layer.Digest, body)
+		}
+	}
+
+	var path string
+	err := retryOperation(downloadCtx, pullOpts.Retry, func() error {
+		var err error
+		path, err = orasint.DownloadBlob(downloadCtx, repo, desc, stream, resume)
+		// The stream cannot be rewound, so retries fetch the missing range
+		stream = nil
+		return err
+	})
+	span.end(layer.Size, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download layer after %d retries: %w", pullOpts.Retry.MaxRetries, err)
+	}
+
+	file, err := c.options.FS.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open downloaded layer: %w", err)
+	}
+	return &downloadedBlob{File: file, fs: c.options.FS, path: path}, nil
+}
+
+// downloadedBlob is a blob downloaded by a resumable transfer. Closing it
+// removes the downloaded file.
+type downloadedBlob struct {
+	fs.File
+	fs   core.FS
+	path string
+}
+
+func (b *downloadedBlob) Close() error {
+	err := b.File.Close()
+	_ = b.fs.Remove(b.path)
+	return err
+}
@@ -0,0 +1,57 @@
+package ocibundle
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ResumableTransfers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requires cache path", func(t *testing.T) {
+		_, err := NewWithOptions(WithResumableTransfers(0))
+		require.Error(t, err)
+
+		_, err = NewWithOptions(WithCache(nil, t.TempDir(), 0, 0), WithResumableTransfers(-1))
+		require.Error(t, err)
+	})
+
+	t.Run("pulls through partial download", func(t *testing.T) {
+		reg := newFakeCopyRegistry(t)
+		archive := buildTestTarGz(t, map[string]string{"hello.txt": "hi"})
+		reg.putArtifact(t, "org/app", "v1", string(archive))
+
+		cacheDir := t.TempDir()
+		client, err := NewWithOptions(
+			WithAllowHTTP(),
+			WithCache(nil, cacheDir, 0, 0),
+			WithResumableTransfers(0),
+		)
+		require.NoError(t, err)
+
+		// Leave a partial download behind, as an interrupted pull would
+		dgst := digest.FromBytes(archive)
+		downloads := filepath.Join(cacheDir, "transfers", "downloads")
+		require.NoError(t, os.MkdirAll(downloads, 0o755))
+		partial := filepath.Join(downloads, "sha256-"+dgst.Encoded()+".partial")
+		require.NoError(t, os.WriteFile(partial, archive[:len(archive)/2], 0o600))
+
+		targetDir := filepath.Join(t.TempDir(), "bundle")
+		require.NoError(t, client.Pull(ctx, reg.host+"/org/app:v1", targetDir))
+
+		content, err := os.ReadFile(filepath.Join(targetDir, "hello.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "hi", string(content))
+
+		// Completed downloads are removed once extracted
+		entries, err := os.ReadDir(downloads)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
@@ -0,0 +1,202 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains the retry policy applied to network operations.
+package ocibundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	platformerrors "github.com/jmgilman/go/errors"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+// RetryPolicy configures how failed network operations are retried.
+// Delays grow exponentially from InitialDelay by Multiplier, are capped at
+// MaxDelay, and are randomized by Jitter to avoid synchronized retries from
+// many clients.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	// Zero disables retries.
+	MaxRetries int
+
+	// InitialDelay is the delay before the first retry.
+	InitialDelay time.Duration
+
+	// MaxDelay caps the delay between retries. Zero means no cap.
+	MaxDelay time.Duration
+
+	// Multiplier is the factor the delay grows by after each retry.
+	// Values below 1 are treated as 1.
+	Multiplier float64
+
+	// Jitter randomizes each delay by up to this fraction in either direction
+	// (e.g., 0.2 for ±20%). Zero disables jitter.
+	Jitter float64
+
+	// MaxElapsedTime stops retrying once the next retry would start this long
+	// after the first attempt. Zero means no limit.
+	MaxElapsedTime time.Duration
+
+	// OnRetry is called before each retry, for logging or metrics.
+	OnRetry func(RetryAttempt)
+}
+
+// RetryAttempt describes a retry about to be made.
+type RetryAttempt struct {
+	// Attempt is the number of the retry, starting at 1
+	Attempt int
+
+	// Err is the error of the failed attempt
+	Err error
+
+	// Delay is how long the operation waits before retrying
+	Delay time.Duration
+}
+
+// DefaultRetryPolicy returns the default retry policy: up to 3 retries,
+// starting at 2 seconds and doubling up to 30 seconds, with ±20% jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:   3,
+		InitialDelay: 2 * time.Second,
+		MaxDelay:     30 * time.Second,
+		Multiplier:   2,
+		Jitter:       0.2,
+	}
+}
+
+// delay returns the delay before the given retry, starting at 1.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	multiplier := math.Max(p.Multiplier, 1)
+
+	delay := float64(p.InitialDelay)
+	for i := 1; i < retry; i++ {
+		delay *= multiplier
+		if p.MaxDelay > 0 && delay >= float64(p.MaxDelay) {
+			break
+		}
+	}
+	if p.MaxDelay > 0 {
+		delay = math.Min(delay, float64(p.MaxDelay))
+	}
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(math.Max(delay, 0))
+}
+
+// retryOperation runs operation, retrying retryable errors according to policy.
+// Retry-after hints attached with platformerrors.WithRetryAfter replace the
+// policy's backoff delay. It returns the error of the last attempt.
+func retryOperation(ctx context.Context, policy RetryPolicy, operation func() error) error {
+	start := time.Now()
+
+	for attempt := 0; ; attempt++ {
+		if err := isDone(ctx, "retry operation"); err != nil {
+			return err
+		}
+
+		err := operation()
+		if err == nil {
+			return nil
+		}
+		if attempt >= policy.MaxRetries || !isRetryableError(err) {
+			return err
+		}
+
+		delay := policy.delay(attempt + 1)
+		if retryAfter, ok := platformerrors.GetRetryAfter(err); ok {
+			// Servers know better than our backoff how long they need
+			delay = retryAfter
+		}
+		if policy.MaxElapsedTime > 0 && time.Since(start)+delay > policy.MaxElapsedTime {
+			return err
+		}
+		if policy.OnRetry != nil {
+			policy.OnRetry(RetryAttempt{Attempt: attempt + 1, Err: err, Delay: delay})
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled during retry: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isRetryableError determines if an error should trigger a retry.
+// Errors are classified, in order, by context state, this package's sentinel
+// errors, platform error classification, registry HTTP status, and network
+// error type, falling back to matching common transient error messages.
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	// Signature verification errors are not retryable because they are deterministic
+	// If a signature is invalid once, it will always be invalid
+	if errors.Is(err, ErrSignatureNotFound) ||
+		errors.Is(err, ErrSignatureInvalid) ||
+		errors.Is(err, ErrUntrustedSigner) ||
+		errors.Is(err, ErrRekorVerificationFailed) ||
+		errors.Is(err, ErrCertificateExpired) ||
+		errors.Is(err, ErrInvalidAnnotations) {
+		return false
+	}
+
+	var platformErr platformerrors.PlatformError
+	if errors.As(err, &platformErr) {
+		return platformerrors.IsRetryable(platformErr)
+	}
+
+	var respErr *errcode.ErrorResponse
+	if errors.As(err, &respErr) {
+		return isRetryableStatus(respErr.StatusCode)
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	// Check for other retryable network errors by examining the error string
+	// (netErr.Temporary() is deprecated since Go 1.18)
+
+	errStr := err.Error()
+	return strings.Contains(errStr, "connection refused") ||
+		strings.Contains(errStr, "connection reset") ||
+		strings.Contains(errStr, "timeout") ||
+		strings.Contains(errStr, "temporary failure") ||
+		strings.Contains(errStr, "service unavailable") ||
+		strings.Contains(errStr, "internal server error")
+}
+
+// isRetryableStatus reports whether a registry response with the given HTTP
+// status code is worth retrying: server errors other than 501, rate limiting,
+// and request timeouts.
+func isRetryableStatus(status int) bool {
+	switch {
+	case status == http.StatusTooManyRequests, status == http.StatusRequestTimeout:
+		return true
+	case status == http.StatusNotImplemented:
+		return false
+	default:
+		return status >= http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,177 @@
+package ocibundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	platformerrors "github.com/jmgilman/go/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{
+		InitialDelay: 100 * time.Millisecond,
+		MaxDelay:     time.Second,
+		Multiplier:   3,
+	}
+	assert.Equal(t, 100*time.Millisecond, policy.delay(1))
+	assert.Equal(t, 300*time.Millisecond, policy.delay(2))
+	assert.Equal(t, 900*time.Millisecond, policy.delay(3))
+	assert.Equal(t, time.Second, policy.delay(4))
+	assert.Equal(t, time.Second, policy.delay(100))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := policy.delay(1)
+		assert.GreaterOrEqual(t, delay, 50*time.Millisecond)
+		assert.LessOrEqual(t, delay, 150*time.Millisecond)
+	}
+}
+
+func TestRetryOperation(t *testing.T) {
+	ctx := context.Background()
+	transient := errors.New("connection reset by peer")
+
+	t.Run("retries until success", func(t *testing.T) {
+		var attempts []RetryAttempt
+		policy := RetryPolicy{
+			MaxRetries: 5,
+			OnRetry:    func(a RetryAttempt) { attempts = append(attempts, a) },
+		}
+
+		calls := 0
+		err := retryOperation(ctx, policy, func() error {
+			calls++
+			if calls < 3 {
+				return transient
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		require.Len(t, attempts, 2)
+		assert.Equal(t, 1, attempts[0].Attempt)
+		assert.Equal(t, 2, attempts[1].Attempt)
+		assert.ErrorIs(t, attempts[0].Err, transient)
+	})
+
+	t.Run("stops after max retries", func(t *testing.T) {
+		calls := 0
+		err := retryOperation(ctx, RetryPolicy{MaxRetries: 2}, func() error {
+			calls++
+			return transient
+		})
+		require.ErrorIs(t, err, transient)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		calls := 0
+		err := retryOperation(ctx, RetryPolicy{MaxRetries: 2}, func() error {
+			calls++
+			return ErrSignatureInvalid
+		})
+		require.ErrorIs(t, err, ErrSignatureInvalid)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops at max elapsed time", func(t *testing.T) {
+		policy := RetryPolicy{
+			MaxRetries:     10,
+			InitialDelay:   time.Hour,
+			MaxElapsedTime: time.Minute,
+		}
+
+		calls := 0
+		err := retryOperation(ctx, policy, func() error {
+			calls++
+			return transient
+		})
+		require.ErrorIs(t, err, transient)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("honors retry-after hints", func(t *testing.T) {
+		var attempts []RetryAttempt
+		policy := RetryPolicy{
+			MaxRetries:   1,
+			InitialDelay: time.Hour,
+			OnRetry:      func(a RetryAttempt) { attempts = append(attempts, a) },
+		}
+		rateLimited := platformerrors.WithRetryAfter(
+			platformerrors.New(platformerrors.CodeRateLimit, "too many requests"), time.Millisecond)
+
+		calls := 0
+		err := retryOperation(ctx, policy, func() error {
+			calls++
+			if calls == 1 {
+				return rateLimited
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, attempts, 1)
+		assert.Equal(t, time.Millisecond, attempts[0].Delay)
+	})
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{"canceled", context.Canceled, false},
+		{"deadline exceeded", context.DeadlineExceeded, true},
+		{"signature invalid", fmt.Errorf("verify: %w", ErrSignatureInvalid), false},
+		{"retryable platform error", platformerrors.New(platformerrors.CodeNetwork, "dial failed"), true},
+		{"permanent platform error", platformerrors.New(platformerrors.CodeNotFound, "timeout waiting for blob"), false},
+		{"rate limited", &errcode.ErrorResponse{StatusCode: http.StatusTooManyRequests}, true},
+		{"server error", fmt.Errorf("push: %w", &errcode.ErrorResponse{StatusCode: http.StatusBadGateway}), true},
+		{"not implemented", &errcode.ErrorResponse{StatusCode: http.StatusNotImplemented}, false},
+		{"not found", &errcode.ErrorResponse{StatusCode: http.StatusNotFound}, false},
+		{"unauthorized", &errcode.ErrorResponse{StatusCode: http.StatusUnauthorized}, false},
+		{"connection refused", errors.New("dial tcp: connection refused"), true},
+		{"other", errors.New("invalid reference"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.retryable, isRetryableError(tt.err))
+		})
+	}
+}
+
+func TestDeprecatedRetryFields(t *testing.T) {
+	t.Run("options that set the fields update the policy", func(t *testing.T) {
+		pushOpts := applyPushOptions([]PushOption{func(opts *PushOptions) {
+			opts.MaxRetries = 0
+			opts.RetryDelay = time.Second
+		}})
+		assert.Equal(t, 0, pushOpts.Retry.MaxRetries)
+		assert.Equal(t, time.Second, pushOpts.Retry.InitialDelay)
+
+		pullOpts := applyPullOptions([]PullOption{func(opts *PullOptions) {
+			opts.MaxRetries = 5
+		}})
+		assert.Equal(t, 5, pullOpts.Retry.MaxRetries)
+		assert.Equal(t, DefaultRetryPolicy().InitialDelay, pullOpts.Retry.InitialDelay)
+	})
+
+	t.Run("fields reflect the policy", func(t *testing.T) {
+		policy := RetryPolicy{MaxRetries: 7, InitialDelay: time.Millisecond}
+		pushOpts := applyPushOptions([]PushOption{WithRetryPolicy(policy)})
+		assert.Equal(t, 7, pushOpts.MaxRetries)
+		assert.Equal(t, time.Millisecond, pushOpts.RetryDelay)
+
+		pullOpts := applyPullOptions([]PullOption{WithPullRetryPolicy(policy)})
+		assert.Equal(t, 7, pullOpts.MaxRetries)
+		assert.Equal(t, time.Millisecond, pullOpts.RetryDelay)
+	})
+}
@@ -2,7 +2,10 @@
 // This file contains security validators and constraints for safe archive handling.
 package ocibundle
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Validator checks for security issues during archive extraction.
 // Implementations of this interface validate different aspects of files and archives
@@ -35,6 +38,20 @@ type FileInfo struct {
 
 	// Mode contains the file permissions and type information
 	Mode uint32
+
+	// Type is the tar type flag of the entry, such as tar.TypeReg or
+	// tar.TypeDir
+	Type byte
+
+	// Linkname is the target of a symlink or hardlink
+	Linkname string
+
+	// ModTime is the recorded modification time
+	ModTime time.Time
+
+	// UID and GID are the recorded owner and group IDs
+	UID int
+	GID int
 }
 
 // ArchiveStats represents archive statistics used for security validation.
@@ -0,0 +1,71 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains signing of pushed artifacts.
+package ocibundle
+
+import (
+	"context"
+	"fmt"
+)
+
+// PushSigned pushes sourceDir like Push and signs the artifact with signer,
+// so publish pipelines can sign bundles in the same process that pushes them.
+// The signer is used instead of any SignatureSigner configured on the client.
+//
+// Example:
+//
+//	signer := signature.NewKeylessSigner()
+//	err := client.PushSigned(ctx, "./app", "ghcr.io/org/app:v1.0", signer)
+func (c *Client) PushSigned(ctx context.Context, sourceDir, reference string, signer SignatureSigner, opts ...PushOption) error {
+	if signer == nil {
+		return fmt.Errorf("signer cannot be nil")
+	}
+
+	return c.Push(ctx, sourceDir, reference, append(opts, func(pushOpts *PushOptions) {
+		pushOpts.signer = signer
+	})...)
+}
+
+// Sign signs an artifact that has already been pushed with the client's
+// SignatureSigner. The signature payload carries the manifest annotations of
+// the artifact, with annotations set with WithSignatureAnnotations merged on
+// top; other push options are ignored.
+//
+// Only single-layer bundles can be signed, matching Push.
+//
+// Example:
+//
+//	client, err := ocibundle.NewWithOptions(ocibundle.WithSignatureSigner(signer))
+//	if err != nil {
+//	    return err
+//	}
+//	err = client.Sign(ctx, "ghcr.io/org/app:v1.0")
+func (c *Client) Sign(ctx context.Context, reference string, opts ...PushOption) error {
+	c.mu.RLock()
+	signer := c.options.SignatureSigner
+	c.mu.RUnlock()
+
+	if signer == nil {
+		return fmt.Errorf("no signature signer configured")
+	}
+
+	manifest, err := c.GetManifest(ctx, reference)
+	if err != nil {
+		return NewBundleError("sign", reference, err)
+	}
+	if len(manifest.Layers) != 1 {
+		return NewBundleError("sign", reference,
+			fmt.Errorf("signing is only supported for single-layer bundles, got %d layers", len(manifest.Layers)))
+	}
+
+	pushOpts := applyPushOptions(opts)
+	pushOpts.Annotations = manifest.Annotations
+	if err := signer.Sign(ctx, reference, manifest.Digest, signatureAnnotations(pushOpts)); err != nil {
+		return &BundleError{
+			Op:        "sign",
+			Reference: reference,
+			Err:       fmt.Errorf("failed to sign artifact: %w", err),
+		}
+	}
+
+	return nil
+}
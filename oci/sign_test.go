@@ -0,0 +1,89 @@
+package ocibundle
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Sign(t *testing.T) {
+	ctx := context.Background()
+	reg := newFakeCopyRegistry(t)
+	reference := reg.host + "/org/app:v1"
+
+	sourceDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("app"), 0o644))
+
+	type signCall struct {
+		reference   string
+		digest      string
+		annotations map[string]string
+	}
+	var calls []signCall
+	signer := &callbackSigner{
+		signFunc: func(_ context.Context, reference, digest string, annotations map[string]string) error {
+			calls = append(calls, signCall{reference, digest, annotations})
+			return nil
+		},
+	}
+
+	t.Run("pushes and signs with the given signer", func(t *testing.T) {
+		calls = nil
+		client, err := NewWithOptions(WithAllowHTTP())
+		require.NoError(t, err)
+
+		err = client.PushSigned(ctx, sourceDir, reference, signer,
+			WithCompression(CompressionZstd),
+			WithAnnotations(map[string]string{"build-id": "42"}),
+		)
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		assert.Equal(t, reference, calls[0].reference)
+		assert.Equal(t, "42", calls[0].annotations["build-id"])
+
+		manifestDigest, err := client.ResolveDigest(ctx, reference)
+		require.NoError(t, err)
+		assert.Equal(t, manifestDigest, calls[0].digest)
+
+		assert.ErrorContains(t, client.PushSigned(ctx, sourceDir, reference, nil), "signer cannot be nil")
+	})
+
+	t.Run("signs a pushed artifact", func(t *testing.T) {
+		pushedDigest := calls[0].digest
+		calls = nil
+		client, err := NewWithOptions(WithAllowHTTP(), WithSignatureSigner(signer))
+		require.NoError(t, err)
+
+		err = client.Sign(ctx, reference, WithSignatureAnnotations(map[string]string{"signer": "release"}))
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		assert.Equal(t, pushedDigest, calls[0].digest)
+		assert.Equal(t, "42", calls[0].annotations["build-id"])
+		assert.Equal(t, "release", calls[0].annotations["signer"])
+	})
+
+	t.Run("requires a signer", func(t *testing.T) {
+		client, err := NewWithOptions(WithAllowHTTP())
+		require.NoError(t, err)
+		assert.ErrorContains(t, client.Sign(ctx, reference), "no signature signer configured")
+	})
+
+	t.Run("rejects multi-layer bundles", func(t *testing.T) {
+		multiLayer := reg.host + "/org/app:layers"
+		require.NoError(t, os.MkdirAll(filepath.Join(sourceDir, "lib"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "lib", "lib.txt"), []byte("lib"), 0o644))
+
+		pusher, err := NewWithOptions(WithAllowHTTP())
+		require.NoError(t, err)
+		require.NoError(t, pusher.Push(ctx, sourceDir, multiLayer,
+			WithCompression(CompressionZstd), WithLayerSplit(SplitByTopLevelDir)))
+
+		client, err := NewWithOptions(WithAllowHTTP(), WithSignatureSigner(signer))
+		require.NoError(t, err)
+		assert.ErrorContains(t, client.Sign(ctx, multiLayer), "single-layer bundles")
+	})
+}
@@ -0,0 +1,381 @@
+// Package signature provides OCI artifact signature verification using Sigstore/Cosign.
+package signature
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+
+	ocibundle "github.com/jmgilman/go/oci"
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+const (
+	// PredicateSLSAProvenanceV02 is the in-toto predicate type of SLSA v0.2 provenance.
+	PredicateSLSAProvenanceV02 = "https://slsa.dev/provenance/v0.2"
+
+	// PredicateSLSAProvenanceV1 is the in-toto predicate type of SLSA v1 provenance.
+	PredicateSLSAProvenanceV1 = "https://slsa.dev/provenance/v1"
+
+	// inTotoPayloadType is the DSSE payload type of in-toto statements.
+	inTotoPayloadType = "application/vnd.in-toto+json"
+)
+
+// ProvenancePolicy contains the requirements for SLSA provenance attached to an
+// artifact as an in-toto attestation.
+//
+// Provenance is only trusted if its attestation is signed by the same keys or
+// identities that are accepted for the artifact signature.
+type ProvenancePolicy struct {
+	// BuilderID is a glob pattern the builder ID of the provenance must match.
+	// Example: "https://github.com/slsa-framework/slsa-github-generator/*"
+	// If empty, any builder is accepted.
+	BuilderID string
+
+	// SourceRepo is a glob pattern the source repository of the provenance must match.
+	// The repository is compared without its "git+" scheme prefix and "@ref" suffix.
+	// Example: "https://github.com/example/*"
+	// If empty, any source is accepted.
+	SourceRepo string
+
+	// MinSLSALevel is the minimum SLSA build level the provenance must meet.
+	// Signed provenance meets level 2; provenance from a builder matching
+	// HardenedBuilders meets level 3.
+	MinSLSALevel int
+
+	// HardenedBuilders contains glob patterns of builder IDs that are trusted
+	// to meet SLSA build level 3.
+	HardenedBuilders []string
+}
+
+// Validate checks if the provenance requirements are valid.
+func (p *ProvenancePolicy) Validate() error {
+	if p.MinSLSALevel < 0 || p.MinSLSALevel > 3 {
+		return fmt.Errorf("minimum SLSA level must be between 0 and 3, got %d", p.MinSLSALevel)
+	}
+
+	patterns := append([]string{p.BuilderID, p.SourceRepo}, p.HardenedBuilders...)
+	for _, pattern := range patterns {
+		if pattern != "" && !isValidGlobPattern(pattern) {
+			return fmt.Errorf("invalid provenance pattern %q", pattern)
+		}
+	}
+
+	if p.MinSLSALevel == 3 && len(p.HardenedBuilders) == 0 {
+		return fmt.Errorf("SLSA level 3 requires at least one hardened builder")
+	}
+
+	return nil
+}
+
+// Check reports an error if provenance does not satisfy the policy.
+func (p *ProvenancePolicy) Check(provenance Provenance) error {
+	if p.BuilderID != "" && !matchesGlobPattern(p.BuilderID, provenance.BuilderID) {
+		return fmt.Errorf("builder %q does not match %q", provenance.BuilderID, p.BuilderID)
+	}
+
+	if p.SourceRepo != "" {
+		source := normalizeSourceRepo(provenance.SourceRepo)
+		if !matchesGlobPattern(p.SourceRepo, source) {
+			return fmt.Errorf("source repository %q does not match %q", source, p.SourceRepo)
+		}
+	}
+
+	if level := p.SLSALevel(provenance); level < p.MinSLSALevel {
+		return fmt.Errorf("builder %q meets SLSA level %d, required %d", provenance.BuilderID, level, p.MinSLSALevel)
+	}
+
+	return nil
+}
+
+// SLSALevel returns the SLSA build level provenance meets. Provenance is only
+// evaluated after its attestation signature was verified, so it meets at least
+// level 2.
+func (p *ProvenancePolicy) SLSALevel(provenance Provenance) int {
+	for _, pattern := range p.HardenedBuilders {
+		if matchesGlobPattern(pattern, provenance.BuilderID) {
+			return 3
+		}
+	}
+	return 2
+}
+
+// Provenance is the subset of SLSA provenance that policies are evaluated against.
+type Provenance struct {
+	// PredicateType is the in-toto predicate type of the attestation
+	PredicateType string
+
+	// BuilderID identifies the platform that produced the artifact
+	BuilderID string
+
+	// SourceRepo is the repository the artifact was built from
+	SourceRepo string
+}
+
+// inTotoStatement is an in-toto v0.1 or v1 statement.
+type inTotoStatement struct {
+	Type          string          `json:"_type"`
+	PredicateType string          `json:"predicateType"`
+	Subject       []inTotoSubject `json:"subject"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+// inTotoSubject is an artifact an in-toto statement is about.
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// dsseEnvelope is the signed envelope cosign stores attestations in.
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+}
+
+// slsaProvenanceV02 holds the fields of SLSA v0.2 provenance used by policies.
+type slsaProvenanceV02 struct {
+	Builder struct {
+		ID string `json:"id"`
+	} `json:"builder"`
+	Invocation struct {
+		ConfigSource struct {
+			URI string `json:"uri"`
+		} `json:"configSource"`
+	} `json:"invocation"`
+	Materials []struct {
+		URI string `json:"uri"`
+	} `json:"materials"`
+}
+
+// slsaProvenanceV1 holds the fields of SLSA v1 provenance used by policies.
+type slsaProvenanceV1 struct {
+	BuildDefinition struct {
+		ExternalParameters struct {
+			Workflow struct {
+				Repository string `json:"repository"`
+			} `json:"workflow"`
+			Source struct {
+				URI string `json:"uri"`
+			} `json:"source"`
+		} `json:"externalParameters"`
+		ResolvedDependencies []struct {
+			URI string `json:"uri"`
+		} `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+	RunDetails struct {
+		Builder struct {
+			ID string `json:"id"`
+		} `json:"builder"`
+	} `json:"runDetails"`
+}
+
+// fetchAttestations fetches the attestations attached to an artifact and
+// verifies their signatures with the same keys or identities as signatures.
+//
+// Missing attestations always fail, regardless of the verification mode, so
+// extraction is blocked for artifacts without required attestations.
+func fetchAttestations(
+	ctx context.Context,
+	ref name.Reference,
+	checkOpts *cosign.CheckOpts,
+	reference string,
+	descriptor *orasint.PullDescriptor,
+) ([]oci.Signature, error) {
+	// Attestation payloads are in-toto statements, not simple signing claims
+	attestationOpts := *checkOpts
+	attestationOpts.ClaimVerifier = cosign.IntotoSubjectClaimVerifier
+
+	attestations, _, err := cosign.VerifyImageAttestations(ctx, ref, &attestationOpts)
+	if err != nil {
+		var noAttestations *cosign.ErrNoMatchingAttestations
+		if isNotFoundError(err) || errors.As(err, &noAttestations) {
+			return nil, provenanceError(reference, descriptor, ocibundle.ErrAttestationNotFound,
+				fmt.Sprintf("No attestations found for artifact: %s", err.Error()), "fetch")
+		}
+		return nil, provenanceError(reference, descriptor, fmt.Errorf("%w: %w", ocibundle.ErrAttestationInvalid, err),
+			fmt.Sprintf("Attestation verification failed: %s", err.Error()), determineFailureStage(err))
+	}
+	return attestations, nil
+}
+
+// verifyProvenance checks that at least one SLSA provenance statement about
+// the artifact among its verified attestations satisfies the provenance policy.
+func (v *CosignVerifier) verifyProvenance(
+	attestations []oci.Signature,
+	reference string,
+	descriptor *orasint.PullDescriptor,
+) error {
+	provenances, err := provenanceFromAttestations(attestations, attestationSubject(descriptor))
+	if err != nil {
+		return provenanceError(reference, descriptor, fmt.Errorf("%w: %w", ocibundle.ErrAttestationInvalid, err),
+			fmt.Sprintf("Malformed attestation: %s", err.Error()), "attestation")
+	}
+	if len(provenances) == 0 {
+		return provenanceError(reference, descriptor, ocibundle.ErrAttestationNotFound,
+			"No SLSA provenance found for artifact", "fetch")
+	}
+
+	var reasons []string
+	for _, provenance := range provenances {
+		if err := v.policy.Provenance.Check(provenance); err != nil {
+			reasons = append(reasons, err.Error())
+			continue
+		}
+		return nil
+	}
+
+	return provenanceError(reference, descriptor, ocibundle.ErrAttestationInvalid,
+		fmt.Sprintf("Provenance policy not satisfied: %s", strings.Join(reasons, "; ")), "policy")
+}
+
+// attestationSubject returns the digest attestations about the pulled
+// artifact name as their subject: the manifest digest the reference resolved
+// to, or the content digest when the reference is not a manifest.
+func attestationSubject(descriptor *orasint.PullDescriptor) string {
+	if descriptor.ManifestDigest != "" {
+		return descriptor.ManifestDigest
+	}
+	return descriptor.Digest
+}
+
+// provenanceFromAttestations extracts the SLSA provenance about the artifact
+// with the given digest from verified attestations. Statements about other
+// subjects and other predicate types are ignored.
+func provenanceFromAttestations(attestations []oci.Signature, digest string) ([]Provenance, error) {
+	provenances := make([]Provenance, 0, len(attestations))
+	for _, attestation := range attestations {
+		payload, err := attestation.Payload()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read attestation payload: %w", err)
+		}
+
+		statement, err := parseInTotoStatement(payload)
+		if err != nil {
+			return nil, err
+		}
+		if !statementHasSubject(statement, digest) {
+			continue
+		}
+
+		provenance, ok, err := parseProvenance(statement)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			provenances = append(provenances, provenance)
+		}
+	}
+	return provenances, nil
+}
+
+// parseInTotoStatement decodes the in-toto statement in a DSSE envelope.
+func parseInTotoStatement(envelopeJSON []byte) (*inTotoStatement, error) {
+	var envelope dsseEnvelope
+	if err := json.Unmarshal(envelopeJSON, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse attestation envelope: %w", err)
+	}
+	if envelope.PayloadType != inTotoPayloadType {
+		return nil, fmt.Errorf("unexpected attestation payload type %q", envelope.PayloadType)
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode attestation payload: %w", err)
+	}
+
+	var statement inTotoStatement
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return nil, fmt.Errorf("failed to parse in-toto statement: %w", err)
+	}
+	return &statement, nil
+}
+
+// statementHasSubject reports whether an in-toto statement is about the
+// artifact with the given digest.
+func statementHasSubject(statement *inTotoStatement, digest string) bool {
+	algorithm, encoded, ok := strings.Cut(digest, ":")
+	if !ok {
+		return false
+	}
+	for _, subject := range statement.Subject {
+		if strings.EqualFold(subject.Digest[algorithm], encoded) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseProvenance extracts the provenance fields from a statement. It reports
+// false for statements that are not SLSA provenance.
+func parseProvenance(statement *inTotoStatement) (Provenance, bool, error) {
+	provenance := Provenance{PredicateType: statement.PredicateType}
+
+	switch statement.PredicateType {
+	case PredicateSLSAProvenanceV02:
+		var predicate slsaProvenanceV02
+		if err := json.Unmarshal(statement.Predicate, &predicate); err != nil {
+			return Provenance{}, false, fmt.Errorf("failed to parse SLSA v0.2 provenance: %w", err)
+		}
+		provenance.BuilderID = predicate.Builder.ID
+		provenance.SourceRepo = predicate.Invocation.ConfigSource.URI
+		if provenance.SourceRepo == "" && len(predicate.Materials) > 0 {
+			provenance.SourceRepo = predicate.Materials[0].URI
+		}
+	case PredicateSLSAProvenanceV1:
+		var predicate slsaProvenanceV1
+		if err := json.Unmarshal(statement.Predicate, &predicate); err != nil {
+			return Provenance{}, false, fmt.Errorf("failed to parse SLSA v1 provenance: %w", err)
+		}
+		external := predicate.BuildDefinition.ExternalParameters
+		provenance.BuilderID = predicate.RunDetails.Builder.ID
+		switch {
+		case external.Workflow.Repository != "":
+			provenance.SourceRepo = external.Workflow.Repository
+		case external.Source.URI != "":
+			provenance.SourceRepo = external.Source.URI
+		case len(predicate.BuildDefinition.ResolvedDependencies) > 0:
+			provenance.SourceRepo = predicate.BuildDefinition.ResolvedDependencies[0].URI
+		}
+	default:
+		return Provenance{}, false, nil
+	}
+
+	return provenance, true, nil
+}
+
+// normalizeSourceRepo strips the "git+" scheme prefix and "@ref" suffix from
+// a source URI, so "git+https://github.com/org/repo@refs/heads/main" becomes
+// "https://github.com/org/repo".
+func normalizeSourceRepo(uri string) string {
+	uri = strings.TrimPrefix(uri, "git+")
+	if scheme := strings.Index(uri, "://"); scheme >= 0 {
+		host := scheme + len("://")
+		if slash := strings.Index(uri[host:], "/"); slash >= 0 {
+			if at := strings.Index(uri[host+slash:], "@"); at >= 0 {
+				uri = uri[:host+slash+at]
+			}
+		}
+	}
+	return strings.TrimSuffix(uri, ".git")
+}
+
+// provenanceError creates the error returned for a provenance failure.
+func provenanceError(reference string, descriptor *orasint.PullDescriptor, err error, reason, stage string) error {
+	return &ocibundle.BundleError{
+		Op:        "verify",
+		Reference: reference,
+		Err:       err,
+		SignatureInfo: &ocibundle.SignatureErrorInfo{
+			Digest:       descriptor.Digest,
+			Reason:       reason,
+			FailureStage: stage,
+		},
+	}
+}
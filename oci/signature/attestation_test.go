@@ -0,0 +1,252 @@
+package signature
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testDigest = "sha256:4f2d8e1c0a9b7e6d5c4b3a29180f7e6d5c4b3a29180f7e6d5c4b3a29180f7e6d"
+
+// newTestEnvelope wraps an in-toto statement in a DSSE envelope.
+func newTestEnvelope(t *testing.T, predicateType string, predicate any, digest string) []byte {
+	t.Helper()
+
+	algorithm, encoded := "sha256", digest[len("sha256:"):]
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v1",
+		"predicateType": predicateType,
+		"subject": []map[string]any{
+			{"name": "bundle", "digest": map[string]string{algorithm: encoded}},
+		},
+		"predicate": predicate,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal statement: %v", err)
+	}
+
+	envelope, err := json.Marshal(map[string]any{
+		"payloadType": inTotoPayloadType,
+		"payload":     base64.StdEncoding.EncodeToString(statement),
+		"signatures":  []map[string]string{{"sig": "c2ln"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal envelope: %v", err)
+	}
+	return envelope
+}
+
+// TestParseProvenance tests extracting provenance from SLSA predicates.
+func TestParseProvenance(t *testing.T) {
+	tests := []struct {
+		name          string
+		predicateType string
+		predicate     any
+		want          Provenance
+		ok            bool
+	}{
+		{
+			name:          "SLSA v0.2",
+			predicateType: PredicateSLSAProvenanceV02,
+			predicate: map[string]any{
+				"builder": map[string]string{"id": "https://github.com/actions/runner"},
+				"invocation": map[string]any{
+					"configSource": map[string]string{"uri": "git+https://github.com/example/app@refs/heads/main"},
+				},
+			},
+			want: Provenance{
+				PredicateType: PredicateSLSAProvenanceV02,
+				BuilderID:     "https://github.com/actions/runner",
+				SourceRepo:    "git+https://github.com/example/app@refs/heads/main",
+			},
+			ok: true,
+		},
+		{
+			name:          "SLSA v1",
+			predicateType: PredicateSLSAProvenanceV1,
+			predicate: map[string]any{
+				"buildDefinition": map[string]any{
+					"externalParameters": map[string]any{
+						"workflow": map[string]string{"repository": "https://github.com/example/app"},
+					},
+				},
+				"runDetails": map[string]any{
+					"builder": map[string]string{"id": "https://github.com/slsa-framework/slsa-github-generator/generic@v2"},
+				},
+			},
+			want: Provenance{
+				PredicateType: PredicateSLSAProvenanceV1,
+				BuilderID:     "https://github.com/slsa-framework/slsa-github-generator/generic@v2",
+				SourceRepo:    "https://github.com/example/app",
+			},
+			ok: true,
+		},
+		{
+			name:          "other predicate",
+			predicateType: "https://spdx.dev/Document",
+			predicate:     map[string]string{"spdxVersion": "SPDX-2.3"},
+			ok:            false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement, err := parseInTotoStatement(newTestEnvelope(t, tt.predicateType, tt.predicate, testDigest))
+			if err != nil {
+				t.Fatalf("failed to parse statement: %v", err)
+			}
+			if !statementHasSubject(statement, testDigest) {
+				t.Error("expected statement to be about the artifact")
+			}
+
+			provenance, ok, err := parseProvenance(statement)
+			if err != nil {
+				t.Fatalf("failed to parse provenance: %v", err)
+			}
+			if ok != tt.ok {
+				t.Fatalf("expected ok=%v, got %v", tt.ok, ok)
+			}
+			if provenance != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, provenance)
+			}
+		})
+	}
+
+	t.Run("ignores other subjects", func(t *testing.T) {
+		other := "sha256:" + strings.Repeat("0", 64)
+		statement, err := parseInTotoStatement(newTestEnvelope(t, PredicateSLSAProvenanceV1, map[string]any{}, other))
+		if err != nil {
+			t.Fatalf("failed to parse statement: %v", err)
+		}
+		if statementHasSubject(statement, testDigest) {
+			t.Error("expected statement about another artifact to be ignored")
+		}
+	})
+
+	t.Run("rejects other payload types", func(t *testing.T) {
+		envelope := []byte(`{"payloadType":"text/plain","payload":"aGVsbG8="}`)
+		if _, err := parseInTotoStatement(envelope); err == nil {
+			t.Error("expected error for non in-toto payload")
+		}
+	})
+}
+
+// TestProvenancePolicyCheck tests evaluating provenance requirements.
+func TestProvenancePolicyCheck(t *testing.T) {
+	provenance := Provenance{
+		PredicateType: PredicateSLSAProvenanceV1,
+		BuilderID:     "https://github.com/slsa-framework/slsa-github-generator/generic@v2",
+		SourceRepo:    "git+https://github.com/example/app@refs/tags/v1.0.0",
+	}
+
+	tests := []struct {
+		name    string
+		policy  ProvenancePolicy
+		wantErr bool
+	}{
+		{
+			name:    "no requirements",
+			policy:  ProvenancePolicy{},
+			wantErr: false,
+		},
+		{
+			name: "matching builder and source",
+			policy: ProvenancePolicy{
+				BuilderID:  "https://github.com/slsa-framework/slsa-github-generator/*",
+				SourceRepo: "https://github.com/example/*",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "untrusted builder",
+			policy:  ProvenancePolicy{BuilderID: "https://github.com/actions/runner"},
+			wantErr: true,
+		},
+		{
+			name:    "untrusted source",
+			policy:  ProvenancePolicy{SourceRepo: "https://github.com/other/*"},
+			wantErr: true,
+		},
+		{
+			name: "hardened builder meets level 3",
+			policy: ProvenancePolicy{
+				MinSLSALevel:     3,
+				HardenedBuilders: []string{"https://github.com/slsa-framework/slsa-github-generator/*"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "other builders meet level 2",
+			policy: ProvenancePolicy{
+				MinSLSALevel:     3,
+				HardenedBuilders: []string{"https://builder.example.com/*"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); err != nil {
+				t.Fatalf("unexpected validation error: %v", err)
+			}
+			err := tt.policy.Check(provenance)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestProvenancePolicyValidation tests validating provenance requirements.
+func TestProvenancePolicyValidation(t *testing.T) {
+	if err := (&ProvenancePolicy{MinSLSALevel: 4}).Validate(); err == nil {
+		t.Error("expected error for SLSA level above 3")
+	}
+	if err := (&ProvenancePolicy{MinSLSALevel: 3}).Validate(); err == nil {
+		t.Error("expected error for level 3 without hardened builders")
+	}
+
+	policy := NewKeylessVerifier(
+		WithAllowedIdentities("*@example.com"),
+		WithProvenance(ProvenancePolicy{MinSLSALevel: 3}),
+	).Policy()
+	if err := policy.Validate(); err == nil {
+		t.Error("expected policy validation to check provenance requirements")
+	}
+}
+
+// TestNormalizeSourceRepo tests normalizing source repository URIs.
+func TestNormalizeSourceRepo(t *testing.T) {
+	tests := map[string]string{
+		"git+https://github.com/example/app@refs/heads/main": "https://github.com/example/app",
+		"https://github.com/example/app.git":                 "https://github.com/example/app",
+		"https://github.com/example/app":                     "https://github.com/example/app",
+		"https://user@git.example.com/example/app@v1":        "https://user@git.example.com/example/app",
+	}
+	for uri, want := range tests {
+		if got := normalizeSourceRepo(uri); got != want {
+			t.Errorf("normalizeSourceRepo(%q) = %q, want %q", uri, got, want)
+		}
+	}
+}
+
+// TestWithProvenance tests that provenance requirements change the policy hash.
+func TestWithProvenance(t *testing.T) {
+	base := NewKeylessVerifier(WithAllowedIdentities("*@example.com"))
+	withProvenance := NewKeylessVerifier(
+		WithAllowedIdentities("*@example.com"),
+		WithProvenance(ProvenancePolicy{SourceRepo: "https://github.com/example/*"}),
+	)
+
+	policy := withProvenance.Policy()
+	if policy.Provenance == nil || policy.Provenance.SourceRepo != "https://github.com/example/*" {
+		t.Fatalf("expected provenance requirements to be set, got %+v", policy.Provenance)
+	}
+
+	basePolicy := base.Policy()
+	if !PolicyChanged(&basePolicy, &policy) {
+		t.Error("expected provenance requirements to change the policy hash")
+	}
+}
@@ -0,0 +1,128 @@
+// Package signature provides OCI artifact signature verification using Sigstore/Cosign.
+package signature
+
+import (
+	"time"
+
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// AuditOutcome is the result of a verification attempt.
+type AuditOutcome string
+
+const (
+	// AuditOutcomeVerified means the artifact had signatures satisfying the policy.
+	AuditOutcomeVerified AuditOutcome = "verified"
+
+	// AuditOutcomeUnsigned means the artifact had no signatures and the
+	// verification mode allowed it.
+	AuditOutcomeUnsigned AuditOutcome = "unsigned"
+
+	// AuditOutcomeRejected means verification failed and the artifact must
+	// not be used.
+	AuditOutcomeRejected AuditOutcome = "rejected"
+)
+
+// AuditEvent describes one verification attempt, for recording supply-chain
+// decisions in audit logs.
+type AuditEvent struct {
+	// Time is when verification started.
+	Time time.Time
+
+	// Reference is the artifact reference that was verified.
+	Reference string
+
+	// Digest is the digest of the verified content, from the pull descriptor.
+	Digest string
+
+	// Signer is the identity of the signer for keyless signatures.
+	// Empty for public key signatures and unsigned artifacts.
+	Signer string
+
+	// PolicyHash is the hash of the policy the artifact was verified against,
+	// as computed by ComputePolicyHash.
+	PolicyHash string
+
+	// Outcome is the result of the verification.
+	Outcome AuditOutcome
+
+	// Err is the verification error for rejected artifacts, usually a
+	// *ocibundle.BundleError with the reason and failure stage.
+	Err error
+
+	// Latency is how long verification took.
+	Latency time.Duration
+
+	// CacheHit is true if the result was served from the verification cache.
+	CacheHit bool
+}
+
+// AuditLogger receives an AuditEvent for every verification attempt.
+// It is called synchronously after each verification and must be safe for
+// concurrent use; slow loggers should hand events off to a queue.
+type AuditLogger func(AuditEvent)
+
+// WithAuditLogger sets a logger that receives a structured event for every
+// verification attempt, including those answered from the cache and those
+// verified against a policy selected per reference.
+//
+// Example:
+//
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("*@example.com"),
+//	).WithAuditLogger(func(event AuditEvent) {
+//	    slog.Info("signature verification",
+//	        "reference", event.Reference,
+//	        "digest", event.Digest,
+//	        "outcome", event.Outcome,
+//	        "signer", event.Signer,
+//	    )
+//	})
+//
+// Note: This method returns the verifier to support method chaining.
+func (v *CosignVerifier) WithAuditLogger(logger AuditLogger) *CosignVerifier {
+	v.auditLogger = logger
+	return v
+}
+
+// audit emits the event for a verification by verifier that started at start.
+func (v *CosignVerifier) audit(
+	verifier *CosignVerifier,
+	start time.Time,
+	reference string,
+	descriptor *orasint.PullDescriptor,
+	result verifyResult,
+	err error,
+) {
+	if v.auditLogger == nil {
+		return
+	}
+
+	event := AuditEvent{
+		Time:       start,
+		Reference:  reference,
+		Signer:     result.signer,
+		PolicyHash: ComputePolicyHash(verifier.policy),
+		Outcome:    outcomeOf(result, err),
+		Err:        err,
+		Latency:    time.Since(start),
+		CacheHit:   result.cacheHit,
+	}
+	if descriptor != nil {
+		event.Digest = descriptor.Digest
+	}
+
+	v.auditLogger(event)
+}
+
+// outcomeOf returns the outcome of a verification that produced result and err.
+func outcomeOf(result verifyResult, err error) AuditOutcome {
+	switch {
+	case err != nil:
+		return AuditOutcomeRejected
+	case result.signed:
+		return AuditOutcomeVerified
+	default:
+		return AuditOutcomeUnsigned
+	}
+}
@@ -0,0 +1,166 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+
+	ocibundle "github.com/jmgilman/go/oci"
+)
+
+// memoryVerificationCache is a VerificationCache that never expires entries.
+type memoryVerificationCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+// memoryCacheEntry is a verification result stored in a memoryVerificationCache.
+type memoryCacheEntry struct {
+	verified bool
+	signer   string
+	ttl      time.Duration
+}
+
+func newMemoryVerificationCache() *memoryVerificationCache {
+	return &memoryVerificationCache{entries: map[string]memoryCacheEntry{}}
+}
+
+func (c *memoryVerificationCache) GetCachedVerification(_ context.Context, digest, policyHash string) (bool, string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[digest+":"+policyHash]
+	if !ok {
+		return false, "", errors.New("cache miss")
+	}
+	return entry.verified, entry.signer, nil
+}
+
+func (c *memoryVerificationCache) PutCachedVerification(_ context.Context, digest, policyHash string, verified bool, signer string, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[digest+":"+policyHash] = memoryCacheEntry{verified: verified, signer: signer, ttl: ttl}
+	return nil
+}
+
+// TestWithAuditLogger tests that every verification emits an audit event.
+func TestWithAuditLogger(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	key := generateTestKey(t)
+	signer, err := NewPublicKeySigner(key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("signed"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	signed := host + "/org/app:signed"
+	if err := pusher.PushSigned(ctx, sourceDir, signed, signer); err != nil {
+		t.Fatalf("PushSigned failed: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("unsigned"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	unsigned := host + "/org/app:unsigned"
+	if err := pusher.Push(ctx, sourceDir, unsigned); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+
+	var (
+		mu     sync.Mutex
+		events []AuditEvent
+	)
+	verifier := NewPublicKeyVerifierWithOptions(
+		[]crypto.PublicKey{&key.PublicKey},
+		WithOptionalMode(true),
+	).WithCacheForVerifier(newMemoryVerificationCache()).
+		WithAuditLogger(func(event AuditEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		})
+	policy := verifier.Policy()
+	policyHash := ComputePolicyHash(&policy)
+
+	puller, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP(), ocibundle.WithSignatureVerifier(verifier))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	for _, reference := range []string{signed, signed, unsigned} {
+		if err := puller.Pull(ctx, reference, t.TempDir()); err != nil {
+			t.Fatalf("Pull %s failed: %v", reference, err)
+		}
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3", len(events))
+	}
+	for i, want := range []struct {
+		reference string
+		outcome   AuditOutcome
+		cacheHit  bool
+	}{
+		{signed, AuditOutcomeVerified, false},
+		{signed, AuditOutcomeVerified, true},
+		{unsigned, AuditOutcomeUnsigned, false},
+	} {
+		event := events[i]
+		if event.Reference != want.reference || event.Outcome != want.outcome || event.CacheHit != want.cacheHit {
+			t.Errorf("event %d = %s %s cacheHit=%t, want %s %s cacheHit=%t",
+				i, event.Reference, event.Outcome, event.CacheHit, want.reference, want.outcome, want.cacheHit)
+		}
+		if event.PolicyHash != policyHash {
+			t.Errorf("event %d policy hash = %s, want %s", i, event.PolicyHash, policyHash)
+		}
+		if !strings.HasPrefix(event.Digest, "sha256:") {
+			t.Errorf("event %d digest = %q", i, event.Digest)
+		}
+		if event.Time.IsZero() || event.Latency <= 0 {
+			t.Errorf("event %d has no timing", i)
+		}
+	}
+
+	t.Run("rejected", func(t *testing.T) {
+		var rejected []AuditEvent
+		verifier := NewPublicKeyVerifierWithOptions(
+			[]crypto.PublicKey{&generateTestKey(t).PublicKey},
+			WithEnforceMode(true),
+		).WithAuditLogger(func(event AuditEvent) {
+			rejected = append(rejected, event)
+		})
+		puller, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP(), ocibundle.WithSignatureVerifier(verifier))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		if err := puller.Pull(ctx, signed, t.TempDir()); err == nil {
+			t.Fatal("expected Pull to fail with a different key")
+		}
+
+		if len(rejected) != 1 {
+			t.Fatalf("got %d events, want 1", len(rejected))
+		}
+		if rejected[0].Outcome != AuditOutcomeRejected || rejected[0].Err == nil {
+			t.Errorf("event = %s %v, want rejected with an error", rejected[0].Outcome, rejected[0].Err)
+		}
+	})
+}
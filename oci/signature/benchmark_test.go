@@ -10,7 +10,7 @@ import (
 	"time"
 
 	"github.com/jmgilman/go/fs/billy"
-	"github.com/jmgilman/go/oci/internal/cache"
+	"github.com/jmgilman/go/oci/cache"
 	"github.com/jmgilman/go/oci/internal/oras"
 )
 
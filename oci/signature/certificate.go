@@ -0,0 +1,143 @@
+// Package signature provides OCI artifact signature verification using Sigstore/Cosign.
+package signature
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+)
+
+// GitHubActionsIssuer is the OIDC issuer of GitHub Actions workflow identities.
+const GitHubActionsIssuer = "https://token.actions.githubusercontent.com"
+
+// CertificatePolicy contains requirements for the Fulcio signing certificates
+// of keyless signatures, beyond the identity and issuer. Fields are matched
+// against the certificate's URI subject alternative names and the GitHub
+// Actions workflow extensions Fulcio records in it.
+//
+// A signature only counts towards the signature policy if its certificate
+// satisfies every field that is set.
+type CertificatePolicy struct {
+	// SubjectURIs contains glob patterns, one of which a URI subject
+	// alternative name of the certificate must match.
+	// Example: "https://github.com/example/app/.github/workflows/*@refs/tags/*"
+	// If empty, URI SANs are not checked.
+	SubjectURIs []string
+
+	// WorkflowRepository is a glob pattern the repository of the signing
+	// workflow must match.
+	// Example: "example/app"
+	// If empty, any repository is accepted.
+	WorkflowRepository string
+
+	// WorkflowRef is a glob pattern the git ref of the signing workflow run
+	// must match.
+	// Example: "refs/tags/*"
+	// If empty, any ref is accepted.
+	WorkflowRef string
+
+	// WorkflowTrigger is a glob pattern the event that triggered the signing
+	// workflow must match.
+	// Example: "push"
+	// If empty, any trigger is accepted.
+	WorkflowTrigger string
+
+	// WorkflowName is a glob pattern the name of the signing workflow must match.
+	// If empty, any workflow is accepted.
+	WorkflowName string
+}
+
+// Validate checks if the certificate requirements are valid.
+func (p *CertificatePolicy) Validate() error {
+	patterns := append([]string{p.WorkflowRepository, p.WorkflowRef, p.WorkflowTrigger, p.WorkflowName}, p.SubjectURIs...)
+	for _, pattern := range patterns {
+		if pattern != "" && !isValidGlobPattern(pattern) {
+			return fmt.Errorf("invalid certificate pattern %q", pattern)
+		}
+	}
+
+	for _, pattern := range p.SubjectURIs {
+		if pattern == "" {
+			return fmt.Errorf("subject URI pattern cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// Check reports an error if cert does not satisfy the policy.
+func (p *CertificatePolicy) Check(cert *x509.Certificate) error {
+	if len(p.SubjectURIs) > 0 && !p.matchesSubjectURI(cert) {
+		return fmt.Errorf("no URI subject alternative name matches %v", p.SubjectURIs)
+	}
+
+	extensions := cosign.CertExtensions{Cert: cert}
+	checks := []struct {
+		name    string
+		pattern string
+		value   string
+	}{
+		{"workflow repository", p.WorkflowRepository, extensions.GetCertExtensionGithubWorkflowRepository()},
+		{"workflow ref", p.WorkflowRef, extensions.GetCertExtensionGithubWorkflowRef()},
+		{"workflow trigger", p.WorkflowTrigger, extensions.GetCertExtensionGithubWorkflowTrigger()},
+		{"workflow name", p.WorkflowName, extensions.GetCertExtensionGithubWorkflowName()},
+	}
+	for _, check := range checks {
+		if check.pattern != "" && !matchesGlobPattern(check.pattern, check.value) {
+			return fmt.Errorf("%s %q does not match %q", check.name, check.value, check.pattern)
+		}
+	}
+
+	return nil
+}
+
+// matchesSubjectURI reports whether a URI SAN of cert matches SubjectURIs.
+func (p *CertificatePolicy) matchesSubjectURI(cert *x509.Certificate) bool {
+	for _, uri := range cert.URIs {
+		for _, pattern := range p.SubjectURIs {
+			if matchesGlobPattern(pattern, uri.String()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filterByCertificate returns the signatures whose certificates satisfy the
+// policy's certificate requirements, and the reason the last one that did not
+// was rejected.
+func (v *CosignVerifier) filterByCertificate(signatures []oci.Signature) ([]oci.Signature, error) {
+	if v.policy.Certificate == nil {
+		return signatures, nil
+	}
+
+	var (
+		accepted []oci.Signature
+		lastErr  error
+	)
+	for _, sig := range signatures {
+		if err := v.checkCertificate(sig); err != nil {
+			lastErr = err
+			continue
+		}
+		accepted = append(accepted, sig)
+	}
+
+	return accepted, lastErr
+}
+
+// checkCertificate reports an error if the certificate of sig does not
+// satisfy the policy's certificate requirements.
+func (v *CosignVerifier) checkCertificate(sig oci.Signature) error {
+	if v.policy.Certificate == nil {
+		return nil
+	}
+
+	cert, err := sig.Cert()
+	if err != nil || cert == nil {
+		return fmt.Errorf("signature has no certificate")
+	}
+	return v.policy.Certificate.Check(cert)
+}
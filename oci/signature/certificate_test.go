@@ -0,0 +1,195 @@
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+)
+
+// testWorkflowCert creates a certificate with the URI SAN and GitHub workflow
+// extensions Fulcio issues to GitHub Actions.
+func testWorkflowCert(t *testing.T, uri, repository, ref, trigger string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	san, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("failed to parse URI: %v", err)
+	}
+
+	extension := func(oid asn1.ObjectIdentifier, value string) pkix.Extension {
+		return pkix.Extension{Id: oid, Value: []byte(value)}
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(10 * time.Minute),
+		URIs:         []*url.URL{san},
+		ExtraExtensions: []pkix.Extension{
+			extension(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}, GitHubActionsIssuer),
+			extension(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 2}, trigger),
+			extension(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 4}, "release"),
+			extension(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 5}, repository),
+			extension(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 6}, ref),
+		},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return cert
+}
+
+// TestCertificatePolicy_Check tests matching certificates against certificate requirements.
+func TestCertificatePolicy_Check(t *testing.T) {
+	cert := testWorkflowCert(t,
+		"https://github.com/example/app/.github/workflows/release.yml@refs/tags/v1.0.0",
+		"example/app", "refs/tags/v1.0.0", "push")
+
+	tests := []struct {
+		name    string
+		policy  CertificatePolicy
+		wantErr string
+	}{
+		{
+			name: "empty policy",
+		},
+		{
+			name: "matching workflow",
+			policy: CertificatePolicy{
+				SubjectURIs:        []string{"https://github.com/example/app/.github/workflows/*@refs/tags/*"},
+				WorkflowRepository: "example/app",
+				WorkflowRef:        "refs/tags/*",
+				WorkflowTrigger:    "push",
+				WorkflowName:       "release",
+			},
+		},
+		{
+			name:    "other subject URI",
+			policy:  CertificatePolicy{SubjectURIs: []string{"https://github.com/other/*"}},
+			wantErr: "no URI subject alternative name",
+		},
+		{
+			name:    "other repository",
+			policy:  CertificatePolicy{WorkflowRepository: "example/other"},
+			wantErr: "workflow repository",
+		},
+		{
+			name:    "branch instead of tag",
+			policy:  CertificatePolicy{WorkflowRef: "refs/heads/*"},
+			wantErr: "workflow ref",
+		},
+		{
+			name:    "other trigger",
+			policy:  CertificatePolicy{WorkflowTrigger: "workflow_dispatch"},
+			wantErr: "workflow trigger",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Check(cert)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Check failed: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestFilterByCertificate tests dropping signatures whose certificates do not
+// satisfy the certificate policy.
+func TestFilterByCertificate(t *testing.T) {
+	signature := func(cert *x509.Certificate) oci.Signature {
+		certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+		sig, err := static.NewSignature([]byte("{}"), "c2ln", static.WithCertChain(certPEM, nil))
+		if err != nil {
+			t.Fatalf("failed to create signature: %v", err)
+		}
+		return sig
+	}
+
+	tag := signature(testWorkflowCert(t, "https://github.com/example/app/.github/workflows/release.yml@refs/tags/v1", "example/app", "refs/tags/v1", "push"))
+	branch := signature(testWorkflowCert(t, "https://github.com/example/app/.github/workflows/release.yml@refs/heads/main", "example/app", "refs/heads/main", "push"))
+
+	verifier := NewKeylessVerifier(WithGitHubWorkflow("example/app", "refs/tags/*"))
+
+	accepted, err := verifier.filterByCertificate([]oci.Signature{branch, tag})
+	if len(accepted) != 1 || accepted[0] != tag {
+		t.Fatalf("expected only the tag signature to be accepted, got %d signatures", len(accepted))
+	}
+	if err == nil || !strings.Contains(err.Error(), "refs/heads/main") {
+		t.Errorf("expected the rejection reason of the branch signature, got %v", err)
+	}
+
+	accepted, _ = NewKeylessVerifier(WithRequiredIssuer(GitHubActionsIssuer)).filterByCertificate([]oci.Signature{branch})
+	if len(accepted) != 1 {
+		t.Error("expected signatures to be kept without a certificate policy")
+	}
+}
+
+// TestCertificatePolicyOptions tests configuring certificate requirements.
+func TestCertificatePolicyOptions(t *testing.T) {
+	t.Run("WithGitHubWorkflow sets the issuer", func(t *testing.T) {
+		policy := NewKeylessVerifier(
+			WithCertificatePolicy(CertificatePolicy{WorkflowTrigger: "push"}),
+			WithGitHubWorkflow("example/*", "refs/tags/*"),
+		).Policy()
+
+		if policy.RequiredIssuer != GitHubActionsIssuer {
+			t.Errorf("RequiredIssuer = %q, want %q", policy.RequiredIssuer, GitHubActionsIssuer)
+		}
+		if policy.Certificate.WorkflowRepository != "example/*" || policy.Certificate.WorkflowRef != "refs/tags/*" {
+			t.Errorf("Certificate = %+v", policy.Certificate)
+		}
+		if policy.Certificate.WorkflowTrigger != "push" {
+			t.Error("expected WithGitHubWorkflow to keep other certificate requirements")
+		}
+	})
+
+	t.Run("rejects public keys", func(t *testing.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		policy := NewPolicy()
+		policy.PublicKeys = append(policy.PublicKeys, &key.PublicKey)
+		policy.Certificate = &CertificatePolicy{WorkflowRef: "refs/tags/*"}
+		if err := policy.Validate(); err == nil {
+			t.Error("expected certificate policy with public keys to be invalid")
+		}
+	})
+
+	t.Run("changes the policy hash", func(t *testing.T) {
+		base := NewKeylessVerifier(WithRequiredIssuer(GitHubActionsIssuer)).Policy()
+		withRef := NewKeylessVerifier(WithGitHubWorkflow("example/app", "refs/tags/*")).Policy()
+		withBranch := NewKeylessVerifier(WithGitHubWorkflow("example/app", "refs/heads/*")).Policy()
+		if !PolicyChanged(&base, &withRef) || !PolicyChanged(&withRef, &withBranch) {
+			t.Error("expected certificate requirements to change the policy hash")
+		}
+	})
+}
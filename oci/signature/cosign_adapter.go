@@ -2,15 +2,23 @@
 package signature
 
 import (
+	"bytes"
 	"context"
 	"crypto"
+	"crypto/x509"
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/sigstore/cosign/v2/pkg/cosign"
 	"github.com/sigstore/rekor/pkg/generated/client"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	sigstoretuf "github.com/sigstore/sigstore-go/pkg/tuf"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/fulcioroots"
 	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/tuf"
 )
 
 // policyToCheckOpts converts our Policy to Cosign's CheckOpts.
@@ -21,9 +29,15 @@ import (
 // - Keyless mode: CheckOpts.Identities, CheckOpts.CertOidcIssuer
 // - Required annotations: CheckOpts.Annotations
 // - Rekor URL: CheckOpts.RekorClient and CheckOpts.RekorPubKeys
+// - Offline Rekor: CheckOpts.Offline, without a Rekor client
+// - TSA certificates: CheckOpts.UseSignedTimestamps and the CheckOpts.TSA* certificates
+// - Trust roots: CheckOpts.RootCerts and CheckOpts.CTLogPubKeys, from the policy, its snapshot, or TUF
+//
+// Trust roots loaded from the policy's snapshot or TUF mirror are stored in
+// trust, which may be nil to load them on every call.
 //
 // Returns CheckOpts configured for verification, or an error if policy is invalid.
-func policyToCheckOpts(ctx context.Context, policy *Policy) (*cosign.CheckOpts, error) {
+func policyToCheckOpts(ctx context.Context, policy *Policy, trust *trustRootCache) (*cosign.CheckOpts, error) {
 	if policy == nil {
 		return nil, fmt.Errorf("policy cannot be nil")
 	}
@@ -38,12 +52,13 @@ func policyToCheckOpts(ctx context.Context, policy *Policy) (*cosign.CheckOpts,
 		ClaimVerifier: cosign.SimpleClaimVerifier,
 		IgnoreSCT:     false, // Verify SCT (Certificate Transparency)
 		IgnoreTlog:    !policy.RekorEnabled,
+		MaxWorkers:    policy.MaxWorkers,
 	}
 
 	// Configure based on verification mode (public key vs keyless)
 	if policy.IsKeylessMode() {
 		// Keyless mode: configure identity and issuer matching
-		if err := configureKeylessMode(ctx, checkOpts, policy); err != nil {
+		if err := configureKeylessMode(ctx, checkOpts, policy, trust); err != nil {
 			return nil, fmt.Errorf("failed to configure keyless mode: %w", err)
 		}
 	} else {
@@ -55,11 +70,18 @@ func policyToCheckOpts(ctx context.Context, policy *Policy) (*cosign.CheckOpts,
 
 	// Configure Rekor if enabled
 	if policy.RekorEnabled {
-		if err := configureRekor(checkOpts, policy); err != nil {
+		if err := configureRekor(ctx, checkOpts, policy, trust); err != nil {
 			return nil, fmt.Errorf("failed to configure Rekor: %w", err)
 		}
 	}
 
+	// Configure timestamp verification if a TSA is trusted
+	if len(policy.TSACertificates) > 0 {
+		if err := configureTimestampAuthority(checkOpts, policy); err != nil {
+			return nil, fmt.Errorf("failed to configure timestamp authority: %w", err)
+		}
+	}
+
 	// Configure annotations if required
 	// Note: Cosign's CheckOpts.Annotations field expects map[string]interface{}
 	// We need to convert our map[string]string to the expected type
@@ -76,7 +98,7 @@ func policyToCheckOpts(ctx context.Context, policy *Policy) (*cosign.CheckOpts,
 
 // configureKeylessMode sets up CheckOpts for keyless (OIDC) verification.
 // This configures identity/issuer matching using Cosign's native matchers.
-func configureKeylessMode(_ context.Context, checkOpts *cosign.CheckOpts, policy *Policy) error {
+func configureKeylessMode(ctx context.Context, checkOpts *cosign.CheckOpts, policy *Policy, trust *trustRootCache) error {
 	// Configure identity matchers
 	// Cosign's Identities field expects a list of identity matchers
 	// Each identity can be an exact match or a regex pattern
@@ -123,9 +145,9 @@ func configureKeylessMode(_ context.Context, checkOpts *cosign.CheckOpts, policy
 		}
 	}
 
-	// Use TUF for Fulcio roots (default Sigstore behavior)
-	// This replaces our manual TUF root fetching in keyless.go:verifyCertificateChain
-	checkOpts.RootCerts = nil // nil means use TUF to fetch roots automatically
+	if err := configureTrustRoots(ctx, checkOpts, policy, trust); err != nil {
+		return err
+	}
 
 	// Ignore SCT only if explicitly requested (default: verify SCT)
 	checkOpts.IgnoreSCT = false
@@ -133,6 +155,194 @@ func configureKeylessMode(_ context.Context, checkOpts *cosign.CheckOpts, policy
 	return nil
 }
 
+// mirrorTrustRoot holds the Sigstore trust roots distributed by a TUF mirror.
+type mirrorTrustRoot struct {
+	roots         *x509.CertPool
+	intermediates *x509.CertPool
+	ctLogKeys     *cosign.TrustedTransparencyLogPubKeys
+	rekorKeys     *cosign.TrustedTransparencyLogPubKeys
+}
+
+// trustRootCache holds the trust root a verifier loaded from its pinned
+// snapshot or fetched from its TUF mirror. Each verifier has its own cache, so
+// verifiers using different mirrors never share trust roots and the
+// process-wide TUF client is left untouched.
+type trustRootCache struct {
+	mu   sync.Mutex
+	root *mirrorTrustRoot
+
+	// snapshot replaces the policy's snapshot after RefreshTrustRoot
+	snapshot *TrustRootSnapshot
+}
+
+// get returns the trust root for the policy's snapshot or TUF mirror, loading
+// it on first use. Pinned snapshots are checked for staleness on every call.
+// A nil cache loads the trust root on every call.
+func (c *trustRootCache) get(policy *Policy) (*mirrorTrustRoot, error) {
+	if c == nil {
+		return loadTrustRoot(policy, policy.TrustRootSnapshot)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := policy.TrustRootSnapshot
+	if c.snapshot != nil {
+		snapshot = c.snapshot
+	}
+	if snapshot != nil {
+		if err := snapshot.checkAge(policy.TrustRootMaxAge); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.root == nil {
+		root, err := loadTrustRoot(policy, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		c.root = root
+	}
+
+	return c.root, nil
+}
+
+// loadTrustRoot returns the trust root in snapshot, or fetches it from the
+// policy's TUF mirror if snapshot is nil.
+func loadTrustRoot(policy *Policy, snapshot *TrustRootSnapshot) (*mirrorTrustRoot, error) {
+	if snapshot == nil {
+		return fetchMirrorTrustRoot(policy)
+	}
+	if err := snapshot.checkAge(policy.TrustRootMaxAge); err != nil {
+		return nil, err
+	}
+	return parseTrustedRoot(snapshot.TrustedRoot)
+}
+
+// fetchMirrorTrustRoot fetches trusted_root.json from the policy's TUF mirror.
+func fetchMirrorTrustRoot(policy *Policy) (*mirrorTrustRoot, error) {
+	rootJSON, err := fetchTrustedRootJSON(policy.TUFMirror, policy.TUFRoot)
+	if err != nil {
+		return nil, err
+	}
+	return parseTrustedRoot(rootJSON)
+}
+
+// fetchTrustedRootJSON fetches trusted_root.json from a TUF mirror, using a
+// TUF client dedicated to that mirror and initial root.
+func fetchTrustedRootJSON(mirror string, initialRoot []byte) ([]byte, error) {
+	opts := sigstoretuf.DefaultOptions().WithRepositoryBaseURL(mirror)
+	if len(initialRoot) > 0 {
+		opts = opts.WithRoot(initialRoot)
+	}
+
+	tufClient, err := sigstoretuf.New(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize TUF mirror %s: %w", mirror, err)
+	}
+	rootJSON, err := tufClient.GetTarget("trusted_root.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trusted root from TUF mirror %s: %w", mirror, err)
+	}
+	return rootJSON, nil
+}
+
+// parseTrustedRoot converts a trusted_root.json into the trust roots used by Cosign.
+func parseTrustedRoot(rootJSON []byte) (*mirrorTrustRoot, error) {
+	trustedRoot, err := root.NewTrustedRootFromJSON(rootJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse trusted root: %w", err)
+	}
+
+	result := &mirrorTrustRoot{
+		roots:         x509.NewCertPool(),
+		intermediates: x509.NewCertPool(),
+	}
+	for _, ca := range trustedRoot.FulcioCertificateAuthorities() {
+		fulcioCA, ok := ca.(*root.FulcioCertificateAuthority)
+		if !ok {
+			continue
+		}
+		result.roots.AddCert(fulcioCA.Root)
+		for _, cert := range fulcioCA.Intermediates {
+			result.intermediates.AddCert(cert)
+		}
+	}
+
+	if result.ctLogKeys, err = transparencyLogKeys(trustedRoot.CTLogs()); err != nil {
+		return nil, fmt.Errorf("failed to load CT log public keys: %w", err)
+	}
+	if result.rekorKeys, err = transparencyLogKeys(trustedRoot.RekorLogs()); err != nil {
+		return nil, fmt.Errorf("failed to load Rekor public keys: %w", err)
+	}
+
+	return result, nil
+}
+
+// transparencyLogKeys converts trusted root log entries into Cosign's key set.
+func transparencyLogKeys(logs map[string]*root.TransparencyLog) (*cosign.TrustedTransparencyLogPubKeys, error) {
+	keys := cosign.NewTrustedTransparencyLogPubKeys()
+	for _, log := range logs {
+		pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(log.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if err := keys.AddTransparencyLogPubKey(pemBytes, tuf.Active); err != nil {
+			return nil, err
+		}
+	}
+	return &keys, nil
+}
+
+// configureTrustRoots sets the Fulcio roots and CT log keys used to verify
+// keyless signing certificates. Roots from the policy take precedence; anything
+// not set comes from the policy's trust root snapshot or TUF mirror if one is
+// configured, otherwise from the public Sigstore TUF repository.
+func configureTrustRoots(ctx context.Context, checkOpts *cosign.CheckOpts, policy *Policy, trust *trustRootCache) error {
+	var mirrorRoot *mirrorTrustRoot
+	if policy.pinsTrustRoot() {
+		var err error
+		if mirrorRoot, err = trust.get(policy); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case len(policy.FulcioRoots) > 0:
+		checkOpts.RootCerts = x509.NewCertPool()
+		for _, root := range policy.FulcioRoots {
+			checkOpts.RootCerts.AddCert(root)
+		}
+	case mirrorRoot != nil:
+		checkOpts.RootCerts = mirrorRoot.roots
+		checkOpts.IntermediateCerts = mirrorRoot.intermediates
+	default:
+		roots, err := fulcioroots.Get()
+		if err != nil {
+			return fmt.Errorf("failed to get Fulcio roots: %w", err)
+		}
+		intermediates, err := fulcioroots.GetIntermediates()
+		if err != nil {
+			return fmt.Errorf("failed to get Fulcio intermediates: %w", err)
+		}
+		checkOpts.RootCerts = roots
+		checkOpts.IntermediateCerts = intermediates
+	}
+
+	if mirrorRoot != nil {
+		checkOpts.CTLogPubKeys = mirrorRoot.ctLogKeys
+		return nil
+	}
+
+	ctLogKeys, err := cosign.GetCTLogPubs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get CT log public keys: %w", err)
+	}
+	checkOpts.CTLogPubKeys = ctLogKeys
+
+	return nil
+}
+
 // configurePublicKeyMode sets up CheckOpts for public key verification.
 // This creates a multi-key verifier that can verify with any of the provided public keys.
 func configurePublicKeyMode(checkOpts *cosign.CheckOpts, policy *Policy) error {
@@ -175,11 +385,10 @@ func configurePublicKeyMode(checkOpts *cosign.CheckOpts, policy *Policy) error {
 	return nil
 }
 
-// configureRekor sets up Rekor transparency log verification.
-// This replaces our manual Rekor client creation in rekor.go.
-func configureRekor(checkOpts *cosign.CheckOpts, policy *Policy) error {
-	// Get Rekor URL (use default if not specified)
-	rekorURL := policy.RekorURL
+// newRekorClient creates a client for the Rekor instance at rekorURL.
+// An empty URL selects the REKOR_URL environment variable or, if unset, the
+// public Sigstore instance.
+func newRekorClient(rekorURL string) (*client.Rekor, error) {
 	if rekorURL == "" {
 		// Use default Sigstore Rekor instance
 		// Check environment variable first
@@ -192,25 +401,116 @@ func configureRekor(checkOpts *cosign.CheckOpts, policy *Policy) error {
 
 	// Validate Rekor URL uses HTTPS (security requirement preserved from rekor.go)
 	if !strings.HasPrefix(rekorURL, "https://") {
-		return fmt.Errorf("rekor URL must use HTTPS: %s", rekorURL)
+		return nil, fmt.Errorf("rekor URL must use HTTPS: %s", rekorURL)
 	}
 
 	// Parse the host from the URL (remove https:// prefix)
 	rekorHost := strings.TrimPrefix(rekorURL, "https://")
 
-	// Create Rekor client
-	rekorClient := client.NewHTTPClientWithConfig(nil, &client.TransportConfig{
+	return client.NewHTTPClientWithConfig(nil, &client.TransportConfig{
 		Host:     rekorHost,
 		BasePath: client.DefaultBasePath,
 		Schemes:  []string{"https"},
-	})
+	}), nil
+}
+
+// configureRekor sets up Rekor transparency log verification.
+// This replaces our manual Rekor client creation in rekor.go.
+//
+// In offline mode no Rekor client is created, so Cosign verifies only the
+// bundles embedded in signatures and fails signatures without one.
+func configureRekor(ctx context.Context, checkOpts *cosign.CheckOpts, policy *Policy, trust *trustRootCache) error {
+	if policy.RekorOffline {
+		checkOpts.Offline = true
+	} else {
+		rekorClient, err := newRekorClient(policy.RekorURL)
+		if err != nil {
+			return err
+		}
+		checkOpts.RekorClient = rekorClient
+	}
 
-	checkOpts.RekorClient = rekorClient
 	checkOpts.IgnoreTlog = false // Enable transparency log verification
 
-	// Note: RekorPubKeys can be set here if custom Rekor instance is used
-	// For the public Sigstore instance, keys are fetched via TUF
-	// checkOpts.RekorPubKeys = ...
+	// Use the configured Rekor key for private instances, otherwise fetch
+	// the keys from the policy's snapshot or mirror if one is configured, or TUF
+	if policy.RekorPublicKey != nil {
+		pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(policy.RekorPublicKey)
+		if err != nil {
+			return fmt.Errorf("failed to encode Rekor public key: %w", err)
+		}
+		rekorKeys := cosign.NewTrustedTransparencyLogPubKeys()
+		if err := rekorKeys.AddTransparencyLogPubKey(pemBytes, tuf.Active); err != nil {
+			return fmt.Errorf("failed to add Rekor public key: %w", err)
+		}
+		checkOpts.RekorPubKeys = &rekorKeys
+		return nil
+	}
+
+	if policy.pinsTrustRoot() {
+		mirrorRoot, err := trust.get(policy)
+		if err != nil {
+			return err
+		}
+		checkOpts.RekorPubKeys = mirrorRoot.rekorKeys
+		return nil
+	}
+
+	rekorKeys, err := cosign.GetRekorPubs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Rekor public keys: %w", err)
+	}
+	checkOpts.RekorPubKeys = rekorKeys
+
+	return nil
+}
+
+// tsaCertificates is a timestamp authority certificate chain sorted by role.
+type tsaCertificates struct {
+	leaf          *x509.Certificate
+	intermediates []*x509.Certificate
+	roots         []*x509.Certificate
+}
+
+// splitTSACertificates sorts a timestamp authority certificate chain into its
+// signing certificate, intermediates, and self-signed roots.
+func splitTSACertificates(certs []*x509.Certificate) (*tsaCertificates, error) {
+	result := &tsaCertificates{}
+	for _, cert := range certs {
+		switch {
+		case cert == nil:
+			return nil, fmt.Errorf("certificate cannot be nil")
+		case !cert.IsCA:
+			if result.leaf != nil {
+				return nil, fmt.Errorf("more than one signing certificate")
+			}
+			result.leaf = cert
+		case bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil:
+			result.roots = append(result.roots, cert)
+		default:
+			result.intermediates = append(result.intermediates, cert)
+		}
+	}
+
+	if len(result.roots) == 0 {
+		return nil, fmt.Errorf("no self-signed root certificate")
+	}
+
+	return result, nil
+}
+
+// configureTimestampAuthority enables verification of RFC 3161 timestamps
+// embedded in signatures against the policy's TSA certificates.
+func configureTimestampAuthority(checkOpts *cosign.CheckOpts, policy *Policy) error {
+	certs, err := splitTSACertificates(policy.TSACertificates)
+	if err != nil {
+		return err
+	}
+
+	checkOpts.UseSignedTimestamps = true
+	checkOpts.TSACertificate = certs.leaf
+	checkOpts.TSAIntermediateCertificates = certs.intermediates
+	checkOpts.TSARootCertificates = certs.roots
 
 	return nil
 }
@@ -14,6 +14,7 @@
 // Key features:
 //   - Multiple verification modes (public key and keyless/OIDC)
 //   - Policy-based control (identity, annotation, issuer restrictions)
+//   - SLSA provenance verification from signed in-toto attestations
 //   - Performance caching to avoid redundant cryptographic operations
 //   - Comprehensive error context for debugging
 //   - Standards compliance (Sigstore/Cosign format)
@@ -100,6 +101,11 @@
 //	    // Transparency requirements
 //	    signature.WithRekor(true),
 //
+//	    // Provenance requirements
+//	    signature.WithProvenance(signature.ProvenancePolicy{
+//	        SourceRepo: "https://github.com/trusted-org/*",
+//	    }),
+//
 //	    // Enforcement mode
 //	    signature.WithEnforceMode(true), // Require all artifacts to be signed
 //	)
@@ -108,7 +114,7 @@
 //
 // Cache verification results to improve performance:
 //
-//	import "github.com/jmgilman/go/oci/internal/cache"
+//	import "github.com/jmgilman/go/oci/cache"
 //
 //	// Create cache coordinator
 //	cacheConfig := cache.Config{
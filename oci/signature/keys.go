@@ -2,6 +2,7 @@
 package signature
 
 import (
+	"context"
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
@@ -10,6 +11,12 @@ import (
 	"encoding/pem"
 	"fmt"
 	"os"
+	"path"
+	"strings"
+
+	"github.com/sigstore/sigstore/pkg/signature/kms"
+
+	"github.com/jmgilman/go/fs/core"
 )
 
 // LoadPublicKey loads a public key from a file.
@@ -93,6 +100,101 @@ func LoadPublicKeyFromBytes(data []byte) (crypto.PublicKey, error) {
 	return key, nil
 }
 
+// LoadPublicKeyFromKMS loads the public key of a key held in a key management
+// service, identified by a Cosign-style key reference:
+//   - awskms://[ENDPOINT]/[ID/ALIAS/ARN]
+//   - gcpkms://projects/[PROJECT]/locations/[LOCATION]/keyRings/[RING]/cryptoKeys/[KEY]
+//   - hashivault://[KEY]
+//   - azurekms://[VAULT_NAME][VAULT_URI]/[KEY]
+//
+// Only the public key is read; the private key never leaves the KMS.
+//
+// Providers are registered by importing their Sigstore packages, so
+// applications only link the cloud SDKs they use. Credentials are taken from
+// the provider's usual environment (e.g. AWS_REGION and the AWS credential
+// chain).
+//
+// Example:
+//
+//	import _ "github.com/sigstore/sigstore/pkg/signature/kms/aws"
+//
+//	pubKey, err := LoadPublicKeyFromKMS(ctx, "awskms:///alias/release-signing")
+//	if err != nil {
+//	    return fmt.Errorf("failed to load public key: %w", err)
+//	}
+//	verifier := NewPublicKeyVerifier(pubKey)
+func LoadPublicKeyFromKMS(ctx context.Context, keyRef string) (crypto.PublicKey, error) {
+	if !strings.Contains(keyRef, "://") {
+		return nil, fmt.Errorf("invalid KMS key reference %q: missing scheme", keyRef)
+	}
+
+	signerVerifier, err := kms.Get(ctx, keyRef, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load KMS key %s: %w", keyRef, err)
+	}
+
+	key, err := signerVerifier.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of KMS key %s: %w", keyRef, err)
+	}
+
+	key, err = validatePublicKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key for KMS key %s: %w", keyRef, err)
+	}
+
+	return key, nil
+}
+
+// LoadPublicKeys loads every public key in a directory of the given
+// filesystem. Files with a .pub or .pem extension are loaded in name order;
+// other files, hidden files, and subdirectories are ignored.
+//
+// Keeping trusted keys in a directory lets keys be rotated by adding and
+// removing files, without changing configuration.
+//
+// Example:
+//
+//	keys, err := LoadPublicKeys(billy.NewLocal(), "/etc/app/trusted-keys")
+//	if err != nil {
+//	    return fmt.Errorf("failed to load public keys: %w", err)
+//	}
+//	verifier := NewPublicKeyVerifier(keys...)
+func LoadPublicKeys(fsys core.ReadFS, dir string) ([]crypto.PublicKey, error) {
+	entries, err := fsys.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key directory %s: %w", dir, err)
+	}
+
+	var keys []crypto.PublicKey
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") {
+			continue
+		}
+		if ext := path.Ext(name); ext != ".pub" && ext != ".pem" {
+			continue
+		}
+
+		file := path.Join(dir, name)
+		data, err := fsys.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read public key file %s: %w", file, err)
+		}
+		key, err := LoadPublicKeyFromBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key from %s: %w", file, err)
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no public keys (*.pub, *.pem) found in %s", dir)
+	}
+
+	return keys, nil
+}
+
 // parsePublicKeyDER parses a DER-encoded public key.
 // The pemType parameter is optional and provides hints about the key format when
 // the data comes from a PEM block.
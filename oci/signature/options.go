@@ -4,6 +4,7 @@ package signature
 import (
 	"context"
 	"crypto"
+	"crypto/x509"
 	"time"
 )
 
@@ -188,6 +189,146 @@ func WithRequiredAnnotations(annotations map[string]string) VerifierOption {
 	}
 }
 
+// WithTrustRootSnapshot verifies against a pinned Sigstore trust root
+// snapshot instead of fetching trust roots via TUF, so verification behaves
+// the same in every environment and needs no TUF access. Once the snapshot is
+// older than maxAge, verification fails with ErrTrustRootStale until it is
+// replaced or refreshed with CosignVerifier.RefreshTrustRoot. A zero maxAge
+// never expires the snapshot.
+//
+// Example:
+//
+//	//go:embed trust-root.json
+//	var trustRoot []byte
+//
+//	snapshot, err := ParseTrustRootSnapshot(trustRoot)
+//	if err != nil {
+//	    return err
+//	}
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("*@example.com"),
+//	    WithTrustRootSnapshot(snapshot, 90*24*time.Hour),
+//	)
+func WithTrustRootSnapshot(snapshot *TrustRootSnapshot, maxAge time.Duration) VerifierOption {
+	return func(p *Policy) {
+		p.TrustRootSnapshot = snapshot
+		p.TrustRootMaxAge = maxAge
+	}
+}
+
+// WithProvenance requires artifacts to carry a signed SLSA provenance
+// attestation that satisfies the given requirements. Attestations are verified
+// with the same keys or identities as signatures. Artifacts without matching
+// provenance fail verification in every verification mode, which blocks
+// their extraction.
+//
+// Example:
+//
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("https://github.com/example/*"),
+//	    WithProvenance(ProvenancePolicy{
+//	        BuilderID:        "https://github.com/slsa-framework/slsa-github-generator/*",
+//	        SourceRepo:       "https://github.com/example/*",
+//	        MinSLSALevel:     3,
+//	        HardenedBuilders: []string{"https://github.com/slsa-framework/slsa-github-generator/*"},
+//	    }),
+//	)
+func WithProvenance(requirements ProvenancePolicy) VerifierOption {
+	return func(p *Policy) {
+		requirements.HardenedBuilders = append([]string(nil), requirements.HardenedBuilders...)
+		p.Provenance = &requirements
+	}
+}
+
+// WithRequireSBOM requires artifacts to carry a signed SPDX or CycloneDX SBOM
+// attestation. Attestations are verified with the same keys or identities as
+// signatures. Artifacts without an SBOM fail verification in every
+// verification mode, which blocks their extraction.
+//
+// Use WithSBOMPolicy to restrict the accepted formats or validate the
+// document schema.
+//
+// Example:
+//
+//	verifier := NewPublicKeyVerifierWithOptions(
+//	    []crypto.PublicKey{publicKey},
+//	    WithRequireSBOM(true),
+//	)
+func WithRequireSBOM(required bool) VerifierOption {
+	return func(p *Policy) {
+		if !required {
+			p.SBOM = nil
+			return
+		}
+		if p.SBOM == nil {
+			p.SBOM = &SBOMPolicy{}
+		}
+	}
+}
+
+// WithSBOMPolicy requires artifacts to carry a signed SBOM attestation that
+// satisfies the given requirements. It implies WithRequireSBOM(true).
+//
+// Example:
+//
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("https://github.com/example/*"),
+//	    WithSBOMPolicy(SBOMPolicy{
+//	        Formats:        []SBOMFormat{SBOMFormatCycloneDX},
+//	        ValidateSchema: true,
+//	    }),
+//	)
+func WithSBOMPolicy(requirements SBOMPolicy) VerifierOption {
+	return func(p *Policy) {
+		requirements.Formats = append([]SBOMFormat(nil), requirements.Formats...)
+		p.SBOM = &requirements
+	}
+}
+
+// WithCertificatePolicy requires the signing certificates of keyless
+// signatures to satisfy requirements on their URI subject alternative names
+// and GitHub Actions workflow extensions. Signatures whose certificates do not
+// are ignored, and if none remain verification fails with ErrUntrustedSigner.
+//
+// Example:
+//
+//	verifier := NewKeylessVerifier(
+//	    WithRequiredIssuer(GitHubActionsIssuer),
+//	    WithCertificatePolicy(CertificatePolicy{
+//	        SubjectURIs:     []string{"https://github.com/example/app/.github/workflows/*"},
+//	        WorkflowTrigger: "push",
+//	    }),
+//	)
+func WithCertificatePolicy(requirements CertificatePolicy) VerifierOption {
+	return func(p *Policy) {
+		certificate := requirements
+		p.Certificate = &certificate
+	}
+}
+
+// WithGitHubWorkflow requires keyless signatures to come from a GitHub Actions
+// workflow in a repository matching repository and running on a ref matching
+// ref, such as "example/app" and "refs/tags/*". It sets the required issuer to
+// GitHubActionsIssuer and keeps other certificate requirements.
+//
+// Example:
+//
+//	verifier := NewKeylessVerifier(
+//	    WithGitHubWorkflow("example/app", "refs/tags/*"),
+//	)
+func WithGitHubWorkflow(repository, ref string) VerifierOption {
+	return func(p *Policy) {
+		p.RequiredIssuer = GitHubActionsIssuer
+		var certificate CertificatePolicy
+		if p.Certificate != nil {
+			certificate = *p.Certificate
+		}
+		certificate.WorkflowRepository = repository
+		certificate.WorkflowRef = ref
+		p.Certificate = &certificate
+	}
+}
+
 // WithRekor enables or disables Rekor transparency log verification.
 // When enabled, signatures must be present in the Rekor transparency log.
 // This provides an audit trail and non-repudiation for signatures.
@@ -226,6 +367,119 @@ func WithRekorURL(url string) VerifierOption {
 	}
 }
 
+// WithRekorOffline verifies Rekor inclusion from the bundle Cosign embeds in
+// each signature instead of querying Rekor, so transparency log checks work
+// in air-gapped environments. Signatures without a bundle fail verification.
+// Enabling it also enables Rekor.
+//
+// The Rekor public keys are still fetched via TUF unless WithRekorPublicKey
+// is set, so fully offline deployments should set it too. Keyless
+// verification also needs WithFulcioRoots or a reachable WithTUFMirror.
+//
+// Example:
+//
+//	rekorKey, _ := LoadPublicKey("rekor.pub")
+//	verifier := NewPublicKeyVerifierWithOptions(
+//	    []crypto.PublicKey{pubKey},
+//	    WithRekorOffline(true),
+//	    WithRekorPublicKey(rekorKey),
+//	)
+func WithRekorOffline(offline bool) VerifierOption {
+	return func(p *Policy) {
+		p.RekorOffline = offline
+		if offline {
+			p.RekorEnabled = true
+		}
+	}
+}
+
+// WithRekorPublicKey sets the public key used to verify Rekor log entries.
+// Use this with WithRekorURL for private Rekor deployments whose keys are not
+// distributed via TUF.
+//
+// Example:
+//
+//	rekorKey, _ := LoadPublicKey("rekor.pub")
+//	verifier := NewKeylessVerifier(
+//	    WithRekorURL("https://rekor.private.example.com"),
+//	    WithRekorPublicKey(rekorKey),
+//	)
+func WithRekorPublicKey(key crypto.PublicKey) VerifierOption {
+	return func(p *Policy) {
+		p.RekorPublicKey = key
+	}
+}
+
+// WithFulcioRoots sets the root certificates of the Fulcio CA that issues
+// signing certificates for keyless verification. This is useful for private
+// Fulcio deployments. By default, the roots of the public Sigstore instance
+// are fetched via TUF.
+//
+// Signed certificate timestamps are still verified against the CT log keys
+// from TUF, so private deployments with their own CT log should also use
+// WithTUFMirror.
+//
+// Example:
+//
+//	roots, _ := cryptoutils.UnmarshalCertificatesFromPEM(fulcioRootPEM)
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("*@example.com"),
+//	    WithFulcioRoots(roots...),
+//	)
+func WithFulcioRoots(roots ...*x509.Certificate) VerifierOption {
+	return func(p *Policy) {
+		p.FulcioRoots = roots
+	}
+}
+
+// WithTUFMirror sets the TUF repository that Sigstore trust roots (Fulcio
+// roots, CT log keys, and Rekor keys) are fetched from. The root parameter is
+// the initial trusted root.json of the repository; pass nil for mirrors of the
+// public Sigstore repository.
+//
+// The mirror must publish a trusted_root.json target. It is fetched on first
+// use and kept by the verifier, so verifiers using different mirrors do not
+// affect each other.
+//
+// Example:
+//
+//	root, _ := os.ReadFile("root.json")
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("*@example.com"),
+//	    WithTUFMirror("https://tuf.sigstore.example.com", root),
+//	)
+func WithTUFMirror(mirror string, root []byte) VerifierOption {
+	return func(p *Policy) {
+		p.TUFMirror = mirror
+		p.TUFRoot = root
+	}
+}
+
+// WithTSACertificates trusts the RFC 3161 timestamp authority with the given
+// certificate chain: its root certificates and, optionally, its intermediates
+// and signing certificate, in any order.
+//
+// Timestamps embedded in signatures (e.g. by cosign sign --timestamp-server-url)
+// are then verified, and signing certificates are checked against the
+// timestamped time instead of the current time. Keyless signatures stay
+// verifiable after their short-lived Fulcio certificates expire, without
+// relying only on Rekor. A signature with an invalid timestamp fails
+// verification; one without a timestamp falls back to Rekor or the current
+// time.
+//
+// Example:
+//
+//	chain, _ := cryptoutils.UnmarshalCertificatesFromPEM(tsaChainPEM)
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("*@example.com"),
+//	    WithTSACertificates(chain...),
+//	)
+func WithTSACertificates(certs ...*x509.Certificate) VerifierOption {
+	return func(p *Policy) {
+		p.TSACertificates = certs
+	}
+}
+
 // WithPublicKeys sets the public keys for traditional signature verification.
 // This enables public key cryptography mode (as opposed to keyless OIDC mode).
 // Multiple keys can be provided - any valid signature from any key passes verification
@@ -239,6 +493,23 @@ func WithPublicKeys(keys ...crypto.PublicKey) VerifierOption {
 	}
 }
 
+// WithMaxWorkers sets the maximum number of signatures verified concurrently.
+// Artifacts with many signatures, each possibly requiring a Rekor lookup,
+// verify faster with more workers. Defaults to 10.
+//
+// Example:
+//
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("*@example.com"),
+//	    WithRekor(true),
+//	    WithMaxWorkers(4),
+//	)
+func WithMaxWorkers(n int) VerifierOption {
+	return func(p *Policy) {
+		p.MaxWorkers = n
+	}
+}
+
 // WithCacheTTL sets the time-to-live for cached verification results.
 // This controls how long a verification result is cached before re-verification
 // is required.
@@ -258,6 +529,32 @@ func WithCacheTTL(ttl time.Duration) VerifierOption {
 	}
 }
 
+// WithNegativeCacheTTL caches verification failures for ttl, so repeated pulls
+// of an artifact known to fail verification do not query the registry and
+// Rekor each time. Keep it short, such as a few minutes, since a failure may
+// be fixed by publishing a new signature; use CosignVerifier.Refresh to
+// re-verify an artifact before its cached failure expires.
+//
+// Only definitive failures are cached: invalid signatures and unsatisfied
+// signature, certificate, or provenance policies. Failures that may be
+// transient, such as network errors, are always re-verified.
+//
+// Requires a cache set with WithCacheForVerifier. Zero (the default) disables
+// negative caching.
+//
+// Example:
+//
+//	verifier := NewKeylessVerifier(
+//	    WithAllowedIdentities("*@example.com"),
+//	    WithCacheTTL(time.Hour),
+//	    WithNegativeCacheTTL(2*time.Minute),
+//	).WithCacheForVerifier(coordinator)
+func WithNegativeCacheTTL(ttl time.Duration) VerifierOption {
+	return func(p *Policy) {
+		p.NegativeCacheTTL = ttl
+	}
+}
+
 // WithCache enables caching of verification results using the provided cache implementation.
 // When caching is enabled, verification results are stored and reused to avoid
 // redundant cryptographic operations.
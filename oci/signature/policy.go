@@ -3,8 +3,10 @@ package signature
 
 import (
 	"crypto"
+	"crypto/x509"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gobwas/glob"
@@ -101,6 +103,10 @@ type Policy struct {
 	// Only used when MultiSignatureMode is MultiSignatureModeMinimum.
 	MinimumSignatures int
 
+	// MaxWorkers is the maximum number of signatures verified concurrently.
+	// If zero, up to 10 signatures are verified at once.
+	MaxWorkers int
+
 	// PublicKeys contains the public keys for signature verification.
 	// Used for traditional public key cryptography mode.
 	// If empty, keyless (OIDC) verification is assumed.
@@ -125,13 +131,84 @@ type Policy struct {
 	// When true, signatures must be present in the Rekor log.
 	RekorEnabled bool
 
+	// RekorOffline verifies Rekor inclusion only from the bundle embedded in
+	// each signature, without contacting Rekor. Signatures without a bundle
+	// fail verification. Requires RekorEnabled.
+	RekorOffline bool
+
 	// RekorURL is the URL of the Rekor transparency log server.
 	// Defaults to the public Sigstore Rekor instance if empty.
 	RekorURL string
 
+	// RekorPublicKey is the public key used to verify Rekor log entries.
+	// Required for private Rekor deployments that are not distributed via TUF.
+	// If nil, the keys are fetched via TUF.
+	RekorPublicKey crypto.PublicKey
+
+	// FulcioRoots contains the root certificates of the Fulcio CA that issued
+	// signing certificates. Used for keyless verification against a private
+	// Fulcio deployment.
+	// If empty, the roots are fetched via TUF.
+	FulcioRoots []*x509.Certificate
+
+	// TUFMirror is the URL of the TUF repository that distributes Sigstore
+	// trust roots. Defaults to the public Sigstore TUF repository if empty.
+	TUFMirror string
+
+	// TUFRoot is the initial trusted root.json of the TUF mirror.
+	// If empty, the root embedded for the public Sigstore instance is used.
+	TUFRoot []byte
+
+	// TrustRootSnapshot is a pinned Sigstore trusted root. When set, Fulcio
+	// roots, CT log keys, and Rekor keys not set explicitly come from the
+	// snapshot instead of TUF, and TUFMirror is only used to refresh it.
+	// If nil, trust roots are fetched via TUF.
+	TrustRootSnapshot *TrustRootSnapshot
+
+	// TrustRootMaxAge is the maximum age of TrustRootSnapshot. Verification
+	// fails with ErrTrustRootStale once the snapshot is older.
+	// If zero, the snapshot never becomes stale.
+	TrustRootMaxAge time.Duration
+
+	// TSACertificates contains the certificate chain of the RFC 3161
+	// timestamp authority trusted to timestamp signatures: the root
+	// certificates and, optionally, the intermediates and the signing
+	// certificate. When set, timestamps embedded in signatures are verified
+	// and used to check signing certificate validity.
+	// If empty, timestamps are ignored.
+	TSACertificates []*x509.Certificate
+
 	// CacheTTL is the time-to-live for cached verification results.
 	// Defaults to 1 hour for keyless, 24 hours for public key mode.
 	CacheTTL time.Duration
+
+	// NegativeCacheTTL is the time-to-live for cached verification failures.
+	// While a failure is cached, verifying the same digest fails without
+	// contacting the registry or Rekor. Failures that may be transient, such
+	// as network errors, are never cached.
+	// If zero, failures are not cached and are re-verified on every pull.
+	NegativeCacheTTL time.Duration
+
+	// Provenance contains the requirements for SLSA provenance attestations.
+	// When set, artifacts without provenance satisfying these requirements fail
+	// verification, regardless of VerificationMode.
+	// If nil, attestations are not checked.
+	Provenance *ProvenancePolicy
+
+	// SBOM contains the requirements for software bill of materials
+	// attestations. When set, artifacts without an SBOM satisfying these
+	// requirements fail verification, regardless of VerificationMode.
+	// If nil, SBOMs are not required.
+	SBOM *SBOMPolicy
+
+	// Certificate contains requirements for the signing certificates of
+	// keyless signatures, such as the GitHub Actions workflow that signed.
+	// If nil, only the identity and issuer are checked.
+	Certificate *CertificatePolicy
+
+	// Repositories replace this policy for references whose repository
+	// matches their pattern. The first match wins; see ForReference.
+	Repositories []RepositoryPolicy
 }
 
 // NewPolicy creates a new Policy with default settings.
@@ -143,11 +220,11 @@ type Policy struct {
 //   - CacheTTL: 1 hour
 func NewPolicy() *Policy {
 	return &Policy{
-		VerificationMode:   VerificationModeRequired,
-		MultiSignatureMode: MultiSignatureModeAny,
-		MinimumSignatures:  1,
-		RekorEnabled:       false,
-		CacheTTL:           time.Hour,
+		VerificationMode:    VerificationModeRequired,
+		MultiSignatureMode:  MultiSignatureModeAny,
+		MinimumSignatures:   1,
+		RekorEnabled:        false,
+		CacheTTL:            time.Hour,
 		RequiredAnnotations: make(map[string]string),
 	}
 }
@@ -183,17 +260,91 @@ func (p *Policy) Validate() error {
 
 	// Validate that either public keys or keyless config is present
 	hasPublicKeys := len(p.PublicKeys) > 0
-	hasKeylessConfig := len(p.AllowedIdentities) > 0 || p.RequiredIssuer != "" || p.RekorEnabled
+	hasKeylessConfig := len(p.AllowedIdentities) > 0 || p.RequiredIssuer != ""
 
-	if !hasPublicKeys && !hasKeylessConfig {
+	if !hasPublicKeys && !hasKeylessConfig && !p.RekorEnabled {
 		return fmt.Errorf("policy must specify either public keys or keyless configuration (identities, issuer, or rekor)")
 	}
 
 	// Public keys and keyless are mutually exclusive
+	// Rekor applies to both
 	if hasPublicKeys && hasKeylessConfig {
 		return fmt.Errorf("policy cannot specify both public keys and keyless configuration")
 	}
 
+	if p.MaxWorkers < 0 {
+		return fmt.Errorf("max workers cannot be negative, got %d", p.MaxWorkers)
+	}
+
+	if p.NegativeCacheTTL < 0 {
+		return fmt.Errorf("negative cache TTL cannot be negative, got %s", p.NegativeCacheTTL)
+	}
+
+	if p.RekorOffline && !p.RekorEnabled {
+		return fmt.Errorf("offline Rekor verification requires Rekor to be enabled")
+	}
+
+	// Fulcio roots only apply to certificates issued for keyless signing
+	if hasPublicKeys && len(p.FulcioRoots) > 0 {
+		return fmt.Errorf("fulcio roots require keyless verification")
+	}
+
+	if len(p.TSACertificates) > 0 {
+		if _, err := splitTSACertificates(p.TSACertificates); err != nil {
+			return fmt.Errorf("invalid TSA certificates: %w", err)
+		}
+	}
+
+	// TUF mirrors must use HTTPS, like Rekor
+	if p.TUFMirror != "" && !strings.HasPrefix(p.TUFMirror, "https://") {
+		return fmt.Errorf("TUF mirror must use HTTPS: %s", p.TUFMirror)
+	}
+
+	if p.TrustRootSnapshot != nil {
+		if err := p.TrustRootSnapshot.Validate(); err != nil {
+			return fmt.Errorf("invalid trust root snapshot: %w", err)
+		}
+	}
+	if p.TrustRootMaxAge < 0 {
+		return fmt.Errorf("trust root max age cannot be negative, got %s", p.TrustRootMaxAge)
+	}
+
+	if p.Provenance != nil {
+		if err := p.Provenance.Validate(); err != nil {
+			return fmt.Errorf("invalid provenance policy: %w", err)
+		}
+	}
+
+	if p.SBOM != nil {
+		if err := p.SBOM.Validate(); err != nil {
+			return fmt.Errorf("invalid SBOM policy: %w", err)
+		}
+	}
+
+	if p.Certificate != nil {
+		if hasPublicKeys {
+			return fmt.Errorf("certificate policy requires keyless verification")
+		}
+		if err := p.Certificate.Validate(); err != nil {
+			return fmt.Errorf("invalid certificate policy: %w", err)
+		}
+	}
+
+	for _, repo := range p.Repositories {
+		if repo.Match == "" || !isValidGlobPattern(repo.Match) {
+			return fmt.Errorf("invalid repository pattern %q", repo.Match)
+		}
+		if repo.Policy == nil {
+			return fmt.Errorf("repository %s has no policy", repo.Match)
+		}
+		if len(repo.Policy.Repositories) > 0 {
+			return fmt.Errorf("repository %s policy cannot have repository overrides", repo.Match)
+		}
+		if err := repo.Policy.Validate(); err != nil {
+			return fmt.Errorf("invalid policy for repository %s: %w", repo.Match, err)
+		}
+	}
+
 	return nil
 }
 
@@ -202,6 +353,12 @@ func (p *Policy) IsKeylessMode() bool {
 	return len(p.PublicKeys) == 0
 }
 
+// pinsTrustRoot reports whether trust roots come from the policy's snapshot
+// or TUF mirror instead of the public Sigstore TUF repository.
+func (p *Policy) pinsTrustRoot() bool {
+	return p.TrustRootSnapshot != nil || p.TUFMirror != ""
+}
+
 // MatchesIdentity checks if a given identity matches any allowed pattern.
 // Uses proper glob pattern matching with security validations.
 func (p *Policy) MatchesIdentity(identity string) bool {
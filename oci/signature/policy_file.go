@@ -0,0 +1,355 @@
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"cuelang.org/go/encoding/yaml"
+
+	"github.com/jmgilman/go/fs/core"
+)
+
+// policyDocument is the file format read by LoadPolicy.
+type policyDocument struct {
+	policyRules
+
+	// Repositories override the top-level rules for matching repositories.
+	Repositories []repositoryRules `json:"repositories,omitempty"`
+}
+
+// repositoryRules are the rules of a per-repository override.
+type repositoryRules struct {
+	// Match is a glob matched against the repository of a reference,
+	// for example "registry.example.com/prod/*".
+	Match string `json:"match"`
+
+	policyRules
+}
+
+// policyRules are the verification rules of a policy document. Unset fields
+// of a repository override inherit the top-level value.
+type policyRules struct {
+	Mode        *string           `json:"mode,omitempty"`
+	Signatures  *signatureRules   `json:"signatures,omitempty"`
+	PublicKeys  []string          `json:"publicKeys,omitempty"`
+	Identities  []string          `json:"identities,omitempty"`
+	Issuer      *string           `json:"issuer,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Certificate *certificateRules `json:"certificate,omitempty"`
+	Rekor       *rekorRules       `json:"rekor,omitempty"`
+	CacheTTL    *string           `json:"cacheTTL,omitempty"`
+
+	NegativeCacheTTL *string `json:"negativeCacheTTL,omitempty"`
+}
+
+// signatureRules are the multi-signature requirements of a policy document.
+type signatureRules struct {
+	Mode    string `json:"mode,omitempty"`
+	Minimum int    `json:"minimum,omitempty"`
+}
+
+// certificateRules are the signing certificate requirements of a policy
+// document.
+type certificateRules struct {
+	SubjectURIs        []string `json:"subjectURIs,omitempty"`
+	WorkflowRepository string   `json:"workflowRepository,omitempty"`
+	WorkflowRef        string   `json:"workflowRef,omitempty"`
+	WorkflowTrigger    string   `json:"workflowTrigger,omitempty"`
+	WorkflowName       string   `json:"workflowName,omitempty"`
+}
+
+// rekorRules are the transparency log settings of a policy document.
+type rekorRules struct {
+	Enabled   bool   `json:"enabled"`
+	Offline   bool   `json:"offline,omitempty"`
+	URL       string `json:"url,omitempty"`
+	PublicKey string `json:"publicKey,omitempty"`
+}
+
+// RepositoryPolicy is a policy that replaces the base policy for references
+// whose repository matches a glob pattern.
+type RepositoryPolicy struct {
+	// Match is a glob pattern matched against the repository of a reference,
+	// without its tag or digest, for example "registry.example.com/prod/*".
+	Match string
+
+	// Policy is the policy used for matching references.
+	Policy *Policy
+}
+
+// LoadPolicy reads a declarative verification policy from path on fsys.
+// Files ending in .cue are evaluated as CUE; .yaml, .yml, and .json files are
+// parsed as YAML. CUE documents must be concrete. Unknown fields are rejected.
+//
+// Public key paths are read from fsys relative to the directory of the policy
+// file. Repository overrides inherit every field they do not set from the
+// top level of the document.
+//
+// Example policy.yaml:
+//
+//	mode: enforce
+//	identities: ["*@example.com"]
+//	issuer: https://token.actions.githubusercontent.com
+//	annotations:
+//	  team: platform
+//	signatures:
+//	  mode: minimum
+//	  minimum: 2
+//	rekor:
+//	  enabled: true
+//	repositories:
+//	  - match: registry.example.com/dev/*
+//	    mode: optional
+//
+// Example:
+//
+//	policy, err := signature.LoadPolicy(billy.NewLocal(), "policy.yaml")
+//	if err != nil {
+//	    return err
+//	}
+//	verifier, err := signature.NewVerifier(policy)
+func LoadPolicy(fsys core.ReadFS, file string) (*Policy, error) {
+	data, err := fsys.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file %s: %w", file, err)
+	}
+
+	doc, err := parsePolicyDocument(file, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %w", file, err)
+	}
+
+	dir := path.Dir(file)
+	policy, err := doc.apply(NewPolicy(), fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", file, err)
+	}
+
+	// Overrides start from the top-level rules
+	base := policy
+	for _, repo := range doc.Repositories {
+		override, err := repo.apply(base, fsys, dir)
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy file %s: repository %s: %w", file, repo.Match, err)
+		}
+		policy.Repositories = append(policy.Repositories, RepositoryPolicy{Match: repo.Match, Policy: override})
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", file, err)
+	}
+
+	return policy, nil
+}
+
+// parsePolicyDocument decodes a policy document in the format given by the
+// extension of file.
+func parsePolicyDocument(file string, data []byte) (*policyDocument, error) {
+	ctx := cuecontext.New()
+
+	var value cue.Value
+	switch strings.ToLower(path.Ext(file)) {
+	case ".cue":
+		value = ctx.CompileBytes(data, cue.Filename(file))
+	case ".yaml", ".yml", ".json":
+		f, err := yaml.Extract(file, data)
+		if err != nil {
+			return nil, err
+		}
+		value = ctx.BuildFile(f)
+	default:
+		return nil, fmt.Errorf("unsupported policy format %q (expected .yaml, .yml, .json, or .cue)", path.Ext(file))
+	}
+
+	if err := value.Validate(cue.Concrete(true)); err != nil {
+		return nil, err
+	}
+	data, err := value.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var doc policyDocument
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// apply returns a copy of base with the rules set in r.
+func (r policyRules) apply(base *Policy, fsys core.ReadFS, dir string) (*Policy, error) {
+	policy := *base
+	policy.Repositories = nil
+
+	if r.Mode != nil {
+		mode, err := parseVerificationMode(*r.Mode)
+		if err != nil {
+			return nil, err
+		}
+		policy.VerificationMode = mode
+	}
+
+	if r.Signatures != nil {
+		if r.Signatures.Mode != "" {
+			mode, err := parseMultiSignatureMode(r.Signatures.Mode)
+			if err != nil {
+				return nil, err
+			}
+			policy.MultiSignatureMode = mode
+		}
+		if r.Signatures.Minimum != 0 {
+			policy.MinimumSignatures = r.Signatures.Minimum
+		}
+	}
+
+	if r.PublicKeys != nil {
+		policy.PublicKeys = nil
+		for _, keyPath := range r.PublicKeys {
+			key, err := loadPolicyKey(fsys, dir, keyPath)
+			if err != nil {
+				return nil, err
+			}
+			policy.PublicKeys = append(policy.PublicKeys, key)
+		}
+		// Public keys and keyless settings are mutually exclusive, so keys
+		// in an override replace inherited keyless settings
+		policy.AllowedIdentities = nil
+		policy.RequiredIssuer = ""
+		policy.Certificate = nil
+	}
+
+	// Likewise, keyless settings replace inherited public keys
+	if r.PublicKeys == nil && (r.Identities != nil || r.Issuer != nil || r.Certificate != nil) {
+		policy.PublicKeys = nil
+	}
+	if r.Identities != nil {
+		policy.AllowedIdentities = append([]string(nil), r.Identities...)
+	}
+	if r.Issuer != nil {
+		policy.RequiredIssuer = *r.Issuer
+	}
+
+	if r.Certificate != nil {
+		policy.Certificate = &CertificatePolicy{
+			SubjectURIs:        append([]string(nil), r.Certificate.SubjectURIs...),
+			WorkflowRepository: r.Certificate.WorkflowRepository,
+			WorkflowRef:        r.Certificate.WorkflowRef,
+			WorkflowTrigger:    r.Certificate.WorkflowTrigger,
+			WorkflowName:       r.Certificate.WorkflowName,
+		}
+	}
+
+	if r.Annotations != nil {
+		policy.RequiredAnnotations = make(map[string]string, len(r.Annotations))
+		for k, v := range r.Annotations {
+			policy.RequiredAnnotations[k] = v
+		}
+	}
+
+	if r.Rekor != nil {
+		policy.RekorEnabled = r.Rekor.Enabled || r.Rekor.Offline
+		policy.RekorOffline = r.Rekor.Offline
+		policy.RekorURL = r.Rekor.URL
+		policy.RekorPublicKey = nil
+		if r.Rekor.PublicKey != "" {
+			key, err := loadPolicyKey(fsys, dir, r.Rekor.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+			policy.RekorPublicKey = key
+		}
+	}
+
+	if r.CacheTTL != nil {
+		ttl, err := time.ParseDuration(*r.CacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cacheTTL %q: %w", *r.CacheTTL, err)
+		}
+		policy.CacheTTL = ttl
+	}
+
+	if r.NegativeCacheTTL != nil {
+		ttl, err := time.ParseDuration(*r.NegativeCacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid negativeCacheTTL %q: %w", *r.NegativeCacheTTL, err)
+		}
+		policy.NegativeCacheTTL = ttl
+	}
+
+	return &policy, nil
+}
+
+// loadPolicyKey reads a public key referenced by a policy file. Relative
+// paths are resolved against dir.
+func loadPolicyKey(fsys core.ReadFS, dir, keyPath string) (crypto.PublicKey, error) {
+	if !path.IsAbs(keyPath) {
+		keyPath = path.Join(dir, keyPath)
+	}
+
+	data, err := fsys.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key file %s: %w", keyPath, err)
+	}
+
+	key, err := LoadPublicKeyFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key from %s: %w", keyPath, err)
+	}
+	return key, nil
+}
+
+// parseVerificationMode parses the name of a verification mode.
+func parseVerificationMode(s string) (VerificationMode, error) {
+	for _, mode := range []VerificationMode{VerificationModeOptional, VerificationModeRequired, VerificationModeEnforce} {
+		if s == mode.String() {
+			return mode, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown verification mode %q (expected optional, required, or enforce)", s)
+}
+
+// parseMultiSignatureMode parses the name of a multi-signature mode.
+func parseMultiSignatureMode(s string) (MultiSignatureMode, error) {
+	for _, mode := range []MultiSignatureMode{MultiSignatureModeAny, MultiSignatureModeAll, MultiSignatureModeMinimum} {
+		if s == mode.String() {
+			return mode, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown signature mode %q (expected any, all, or minimum)", s)
+}
+
+// ForReference returns the policy that applies to reference: the policy of
+// the first repository override whose pattern matches the repository of
+// reference, or p itself if none match.
+func (p *Policy) ForReference(reference string) *Policy {
+	if p == nil {
+		return nil
+	}
+	repository := repositoryOf(reference)
+	for _, repo := range p.Repositories {
+		if matchesGlobPattern(repo.Match, repository) {
+			return repo.Policy
+		}
+	}
+	return p
+}
+
+// repositoryOf returns reference without its tag or digest.
+func repositoryOf(reference string) string {
+	if i := strings.Index(reference, "@"); i >= 0 {
+		reference = reference[:i]
+	}
+	if i := strings.LastIndex(reference, ":"); i > strings.LastIndex(reference, "/") {
+		reference = reference[:i]
+	}
+	return reference
+}
@@ -0,0 +1,279 @@
+package signature
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+
+	"github.com/jmgilman/go/fs/billy"
+	ocibundle "github.com/jmgilman/go/oci"
+)
+
+// TestLoadPolicy tests reading policy documents.
+func TestLoadPolicy(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	fsys := billy.NewMemory()
+	if err := fsys.WriteFile("policies/keys/release.pub", encodePublicKeyPEM(&key.PublicKey), 0o644); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	write := func(t *testing.T, name, content string) {
+		t.Helper()
+		if err := fsys.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write policy: %v", err)
+		}
+	}
+
+	t.Run("yaml", func(t *testing.T) {
+		write(t, "policies/keyless.yaml", `
+mode: enforce
+identities: ["*@example.com"]
+issuer: https://token.actions.githubusercontent.com
+annotations:
+  team: platform
+signatures:
+  mode: minimum
+  minimum: 2
+rekor:
+  enabled: true
+certificate:
+  workflowRepository: example/app
+  workflowRef: refs/tags/*
+cacheTTL: 30m
+negativeCacheTTL: 2m
+`)
+		policy, err := LoadPolicy(fsys, "policies/keyless.yaml")
+		if err != nil {
+			t.Fatalf("LoadPolicy failed: %v", err)
+		}
+
+		if policy.VerificationMode != VerificationModeEnforce {
+			t.Errorf("VerificationMode = %v, want enforce", policy.VerificationMode)
+		}
+		if len(policy.AllowedIdentities) != 1 || policy.AllowedIdentities[0] != "*@example.com" {
+			t.Errorf("AllowedIdentities = %v", policy.AllowedIdentities)
+		}
+		if policy.RequiredIssuer != "https://token.actions.githubusercontent.com" {
+			t.Errorf("RequiredIssuer = %q", policy.RequiredIssuer)
+		}
+		if policy.RequiredAnnotations["team"] != "platform" {
+			t.Errorf("RequiredAnnotations = %v", policy.RequiredAnnotations)
+		}
+		if policy.MultiSignatureMode != MultiSignatureModeMinimum || policy.MinimumSignatures != 2 {
+			t.Errorf("signatures = %v/%d, want minimum/2", policy.MultiSignatureMode, policy.MinimumSignatures)
+		}
+		if !policy.RekorEnabled {
+			t.Error("expected Rekor to be enabled")
+		}
+		if policy.Certificate == nil || policy.Certificate.WorkflowRepository != "example/app" || policy.Certificate.WorkflowRef != "refs/tags/*" {
+			t.Errorf("Certificate = %+v", policy.Certificate)
+		}
+		if policy.CacheTTL != 30*time.Minute {
+			t.Errorf("CacheTTL = %v, want 30m", policy.CacheTTL)
+		}
+		if policy.NegativeCacheTTL != 2*time.Minute {
+			t.Errorf("NegativeCacheTTL = %v, want 2m", policy.NegativeCacheTTL)
+		}
+	})
+
+	t.Run("cue with relative key path", func(t *testing.T) {
+		write(t, "policies/keys.cue", `
+mode: "required"
+publicKeys: ["keys/release.pub"]
+`)
+		policy, err := LoadPolicy(fsys, "policies/keys.cue")
+		if err != nil {
+			t.Fatalf("LoadPolicy failed: %v", err)
+		}
+
+		if policy.VerificationMode != VerificationModeRequired {
+			t.Errorf("VerificationMode = %v, want required", policy.VerificationMode)
+		}
+		if len(policy.PublicKeys) != 1 {
+			t.Fatalf("len(PublicKeys) = %d, want 1", len(policy.PublicKeys))
+		}
+		if !key.PublicKey.Equal(policy.PublicKeys[0]) {
+			t.Error("loaded public key does not match")
+		}
+	})
+
+	t.Run("repository overrides", func(t *testing.T) {
+		write(t, "policies/overrides.yaml", `
+mode: enforce
+identities: ["*@example.com"]
+repositories:
+  - match: registry.example.com/dev/*
+    mode: optional
+  - match: registry.example.com/release/*
+    publicKeys: [keys/release.pub]
+`)
+		policy, err := LoadPolicy(fsys, "policies/overrides.yaml")
+		if err != nil {
+			t.Fatalf("LoadPolicy failed: %v", err)
+		}
+
+		if got := policy.ForReference("registry.example.com/prod/app:v1"); got != policy {
+			t.Error("expected unmatched reference to use the base policy")
+		}
+
+		dev := policy.ForReference("registry.example.com/dev/app:v1")
+		if dev.VerificationMode != VerificationModeOptional {
+			t.Errorf("dev VerificationMode = %v, want optional", dev.VerificationMode)
+		}
+		if len(dev.AllowedIdentities) != 1 {
+			t.Errorf("expected dev override to inherit identities, got %v", dev.AllowedIdentities)
+		}
+
+		release := policy.ForReference("registry.example.com/release/app@sha256:abc")
+		if len(release.PublicKeys) != 1 || len(release.AllowedIdentities) != 0 {
+			t.Errorf("expected release override to replace identities with keys")
+		}
+		if release.VerificationMode != VerificationModeEnforce {
+			t.Errorf("release VerificationMode = %v, want enforce", release.VerificationMode)
+		}
+
+		verifier, err := NewVerifier(policy)
+		if err != nil {
+			t.Fatalf("NewVerifier failed: %v", err)
+		}
+		if got := verifier.Policy(); len(got.Repositories) != 2 {
+			t.Errorf("len(Repositories) = %d, want 2", len(got.Repositories))
+		}
+	})
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "unknown field",
+			file:    "bad/unknown.yaml",
+			content: "mode: enforce\nidentity: foo\n",
+			wantErr: "unknown field",
+		},
+		{
+			name:    "unknown mode",
+			file:    "bad/mode.yaml",
+			content: "mode: strict\n",
+			wantErr: "unknown verification mode",
+		},
+		{
+			name:    "missing key file",
+			file:    "bad/key.yaml",
+			content: "publicKeys: [missing.pub]\n",
+			wantErr: "failed to read public key file bad/missing.pub",
+		},
+		{
+			name:    "invalid policy",
+			file:    "bad/invalid.yaml",
+			content: "publicKeys: [../policies/keys/release.pub]\nidentities: [\"*@example.com\"]\n",
+			wantErr: "cannot specify both public keys and keyless",
+		},
+		{
+			name:    "unsupported format",
+			file:    "bad/policy.toml",
+			content: "mode = \"enforce\"\n",
+			wantErr: "unsupported policy format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			write(t, tt.file, tt.content)
+			_, err := LoadPolicy(fsys, tt.file)
+			if err == nil {
+				t.Fatal("expected LoadPolicy to fail")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestNewVerifier_RepositoryOverrides tests that Verify applies the policy of
+// the matching repository override.
+func TestNewVerifier_RepositoryOverrides(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("app"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	devKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	prodKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	// Both artifacts are signed with the dev key
+	signer, err := NewPublicKeySigner(devKey)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	for _, reference := range []string{host + "/dev/app:v1", host + "/prod/app:v1"} {
+		if err := pusher.PushSigned(ctx, sourceDir, reference, signer); err != nil {
+			t.Fatalf("PushSigned failed: %v", err)
+		}
+	}
+
+	fsys := billy.NewMemory()
+	if err := fsys.WriteFile("keys/prod.pub", encodePublicKeyPEM(&prodKey.PublicKey), 0o644); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	if err := fsys.WriteFile("keys/dev.pub", encodePublicKeyPEM(&devKey.PublicKey), 0o644); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	policyYAML := "mode: enforce\npublicKeys: [keys/prod.pub]\nrepositories:\n  - match: " + host + "/dev/*\n    publicKeys: [keys/dev.pub]\n"
+	if err := fsys.WriteFile("policy.yaml", []byte(policyYAML), 0o644); err != nil {
+		t.Fatalf("failed to write policy: %v", err)
+	}
+	policy, err := LoadPolicy(fsys, "policy.yaml")
+	if err != nil {
+		t.Fatalf("LoadPolicy failed: %v", err)
+	}
+	verifier, err := NewVerifier(policy)
+	if err != nil {
+		t.Fatalf("NewVerifier failed: %v", err)
+	}
+
+	puller, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP(), ocibundle.WithSignatureVerifier(verifier))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if err := puller.Pull(ctx, host+"/dev/app:v1", t.TempDir()); err != nil {
+		t.Errorf("expected pull verified by the dev override to succeed: %v", err)
+	}
+	if err := puller.Pull(ctx, host+"/prod/app:v1", t.TempDir()); err == nil {
+		t.Error("expected pull verified by the base policy to fail")
+	}
+}
@@ -25,6 +25,12 @@ import (
 //   - RequiredAnnotations (sorted key-value pairs)
 //   - RekorEnabled (whether Rekor verification is required)
 //   - RekorURL (URL of Rekor server)
+//   - RekorOffline (whether only embedded Rekor bundles are verified)
+//   - RekorPublicKey, FulcioRoots, TUFMirror, TUFRoot, TrustRootSnapshot, and TSACertificates (trust roots)
+//   - Provenance (SLSA provenance requirements)
+//   - SBOM (SBOM requirements)
+//   - Certificate (signing certificate requirements)
+//   - Repositories (patterns and policy hashes, in order)
 //
 // The hash is computed by concatenating all relevant fields in a deterministic
 // order and computing SHA256. This ensures that:
@@ -95,10 +101,94 @@ func ComputePolicyHash(policy *Policy) string {
 
 	// Add Rekor settings
 	_, _ = fmt.Fprintf(h, "rekor_enabled:%t\n", policy.RekorEnabled)
+	if policy.RekorOffline {
+		_, _ = fmt.Fprintf(h, "rekor_offline:%t\n", policy.RekorOffline)
+	}
 	if policy.RekorURL != "" {
 		_, _ = fmt.Fprintf(h, "rekor_url:%s\n", policy.RekorURL)
 	}
 
+	// Add trust roots
+	if policy.RekorPublicKey != nil {
+		_, _ = fmt.Fprintf(h, "rekor_public_key:%s\n", computeKeyFingerprint(policy.RekorPublicKey))
+	}
+	if len(policy.FulcioRoots) > 0 {
+		fingerprints := make([]string, len(policy.FulcioRoots))
+		for i, cert := range policy.FulcioRoots {
+			sum := sha256.Sum256(cert.Raw)
+			fingerprints[i] = hex.EncodeToString(sum[:])
+		}
+		sort.Strings(fingerprints)
+		for _, fp := range fingerprints {
+			_, _ = fmt.Fprintf(h, "fulcio_root:%s\n", fp)
+		}
+	}
+	if policy.TUFMirror != "" {
+		rootHash := sha256.Sum256(policy.TUFRoot)
+		_, _ = fmt.Fprintf(h, "tuf_mirror:%s\n", policy.TUFMirror)
+		_, _ = fmt.Fprintf(h, "tuf_root:%s\n", hex.EncodeToString(rootHash[:]))
+	}
+	if policy.TrustRootSnapshot != nil {
+		snapshotHash := sha256.Sum256(policy.TrustRootSnapshot.TrustedRoot)
+		_, _ = fmt.Fprintf(h, "trust_root_snapshot:%s\n", hex.EncodeToString(snapshotHash[:]))
+		_, _ = fmt.Fprintf(h, "trust_root_max_age:%s\n", policy.TrustRootMaxAge)
+	}
+
+	if len(policy.TSACertificates) > 0 {
+		fingerprints := make([]string, len(policy.TSACertificates))
+		for i, cert := range policy.TSACertificates {
+			sum := sha256.Sum256(cert.Raw)
+			fingerprints[i] = hex.EncodeToString(sum[:])
+		}
+		sort.Strings(fingerprints)
+		for _, fp := range fingerprints {
+			_, _ = fmt.Fprintf(h, "tsa_certificate:%s\n", fp)
+		}
+	}
+
+	// Add provenance requirements
+	if policy.Provenance != nil {
+		builders := make([]string, len(policy.Provenance.HardenedBuilders))
+		copy(builders, policy.Provenance.HardenedBuilders)
+		sort.Strings(builders)
+		_, _ = fmt.Fprintf(h, "provenance_builder:%s\n", policy.Provenance.BuilderID)
+		_, _ = fmt.Fprintf(h, "provenance_source:%s\n", policy.Provenance.SourceRepo)
+		_, _ = fmt.Fprintf(h, "provenance_min_level:%d\n", policy.Provenance.MinSLSALevel)
+		for _, builder := range builders {
+			_, _ = fmt.Fprintf(h, "provenance_hardened_builder:%s\n", builder)
+		}
+	}
+
+	// Add SBOM requirements
+	if policy.SBOM != nil {
+		formats := make([]string, len(policy.SBOM.Formats))
+		for i, format := range policy.SBOM.Formats {
+			formats[i] = string(format)
+		}
+		sort.Strings(formats)
+		_, _ = fmt.Fprintf(h, "sbom_formats:%s\n", strings.Join(formats, ","))
+		_, _ = fmt.Fprintf(h, "sbom_validate_schema:%t\n", policy.SBOM.ValidateSchema)
+	}
+
+	// Add certificate requirements
+	if policy.Certificate != nil {
+		uris := make([]string, len(policy.Certificate.SubjectURIs))
+		copy(uris, policy.Certificate.SubjectURIs)
+		sort.Strings(uris)
+		for _, uri := range uris {
+			_, _ = fmt.Fprintf(h, "certificate_subject_uri:%s\n", uri)
+		}
+		_, _ = fmt.Fprintf(h, "certificate_workflow_repository:%s\n", policy.Certificate.WorkflowRepository)
+		_, _ = fmt.Fprintf(h, "certificate_workflow_ref:%s\n", policy.Certificate.WorkflowRef)
+		_, _ = fmt.Fprintf(h, "certificate_workflow_trigger:%s\n", policy.Certificate.WorkflowTrigger)
+		_, _ = fmt.Fprintf(h, "certificate_workflow_name:%s\n", policy.Certificate.WorkflowName)
+	}
+
+	// Add repository overrides in order, since the first match wins
+	for _, repo := range policy.Repositories {
+		_, _ = fmt.Fprintf(h, "repository:%s=%s\n", repo.Match, ComputePolicyHash(repo.Policy))
+	}
+
 	// Compute final hash
 	hashBytes := h.Sum(nil)
 	return hex.EncodeToString(hashBytes)
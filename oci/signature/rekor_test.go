@@ -0,0 +1,232 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+
+	ocibundle "github.com/jmgilman/go/oci"
+)
+
+// TestWithRekorOffline tests verifying Rekor bundles embedded in signatures
+// without contacting Rekor.
+func TestWithRekorOffline(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("app"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	key := generateTestKey(t)
+	rekorKey := generateTestKey(t)
+
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	bundled := host + "/org/app:bundled"
+	if err := pusher.Push(ctx, sourceDir, bundled); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+	signWithRekorBundle(t, bundled, key, rekorKey)
+
+	// Signed without a bundle, with different content so the artifacts do
+	// not share signatures
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("other"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	signer, err := NewPublicKeySigner(key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	unbundled := host + "/org/app:unbundled"
+	if err := pusher.PushSigned(ctx, sourceDir, unbundled, signer); err != nil {
+		t.Fatalf("PushSigned failed: %v", err)
+	}
+
+	pull := func(t *testing.T, reference string, rekorPublicKey crypto.PublicKey) error {
+		t.Helper()
+		verifier := NewPublicKeyVerifierWithOptions(
+			[]crypto.PublicKey{&key.PublicKey},
+			WithEnforceMode(true),
+			WithRekorOffline(true),
+			WithRekorPublicKey(rekorPublicKey),
+			// Unreachable, so any online lookup fails
+			WithRekorURL("https://rekor.invalid"),
+		)
+		puller, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP(), ocibundle.WithSignatureVerifier(verifier))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		return puller.Pull(ctx, reference, t.TempDir())
+	}
+
+	t.Run("verifies embedded bundle", func(t *testing.T) {
+		if err := pull(t, bundled, &rekorKey.PublicKey); err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+	})
+
+	t.Run("rejects signatures without a bundle", func(t *testing.T) {
+		if err := pull(t, unbundled, &rekorKey.PublicKey); err == nil {
+			t.Fatal("expected Pull to fail without a Rekor bundle")
+		}
+	})
+
+	t.Run("rejects bundles from another log", func(t *testing.T) {
+		if err := pull(t, bundled, &generateTestKey(t).PublicKey); err == nil {
+			t.Fatal("expected Pull to fail with a different Rekor key")
+		}
+	})
+
+	t.Run("requires Rekor", func(t *testing.T) {
+		policy := NewPolicy()
+		policy.PublicKeys = []crypto.PublicKey{&key.PublicKey}
+		policy.RekorOffline = true
+		if err := policy.Validate(); err == nil {
+			t.Fatal("expected offline Rekor without Rekor to be invalid")
+		}
+	})
+}
+
+// generateTestKey generates a P-256 key.
+func generateTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+// signWithRekorBundle signs reference with key and attaches a Rekor bundle
+// whose signed entry timestamp is signed by rekorKey, as if the signature had
+// been recorded in that log.
+func signWithRekorBundle(t *testing.T, reference string, key, rekorKey *ecdsa.PrivateKey) {
+	t.Helper()
+
+	publicKeyPEM, err := cryptoutils.MarshalPublicKeyToPEM(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to encode public key: %v", err)
+	}
+
+	attachTestSignature(t, reference, key, func(payloadBytes, sig []byte) static.Option {
+		payloadHash := sha256.Sum256(payloadBytes)
+		entry, err := json.Marshal(map[string]any{
+			"apiVersion": "0.0.1",
+			"kind":       "hashedrekord",
+			"spec": map[string]any{
+				"data": map[string]any{
+					"hash": map[string]any{"algorithm": "sha256", "value": hex.EncodeToString(payloadHash[:])},
+				},
+				"signature": map[string]any{
+					"content":   base64.StdEncoding.EncodeToString(sig),
+					"publicKey": map[string]any{"content": base64.StdEncoding.EncodeToString(publicKeyPEM)},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to encode log entry: %v", err)
+		}
+		logID, err := cosign.GetTransparencyLogID(&rekorKey.PublicKey)
+		if err != nil {
+			t.Fatalf("failed to compute log ID: %v", err)
+		}
+		rekorPayload := cbundle.RekorPayload{
+			Body:           base64.StdEncoding.EncodeToString(entry),
+			IntegratedTime: time.Now().Unix(),
+			LogIndex:       1,
+			LogID:          logID,
+		}
+
+		// The signed entry timestamp covers the canonical JSON of the payload,
+		// which for these fields is a map marshaled with sorted keys
+		canonical, err := json.Marshal(map[string]any{
+			"body":           rekorPayload.Body,
+			"integratedTime": rekorPayload.IntegratedTime,
+			"logIndex":       rekorPayload.LogIndex,
+			"logID":          rekorPayload.LogID,
+		})
+		if err != nil {
+			t.Fatalf("failed to encode bundle payload: %v", err)
+		}
+		canonicalHash := sha256.Sum256(canonical)
+		set, err := ecdsa.SignASN1(rand.Reader, rekorKey, canonicalHash[:])
+		if err != nil {
+			t.Fatalf("failed to sign entry timestamp: %v", err)
+		}
+
+		return static.WithBundle(&cbundle.RekorBundle{SignedEntryTimestamp: set, Payload: rekorPayload})
+	})
+}
+
+// attachTestSignature signs reference with key and publishes the signature,
+// with the option built by extra from the signed payload and raw signature.
+func attachTestSignature(t *testing.T, reference string, key *ecdsa.PrivateKey, extra func(payload, sig []byte) static.Option) {
+	t.Helper()
+
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		t.Fatalf("failed to parse reference: %v", err)
+	}
+	desc, err := remote.Get(ref)
+	if err != nil {
+		t.Fatalf("failed to resolve reference: %v", err)
+	}
+	digestRef := ref.Context().Digest(desc.Digest.String())
+
+	payloadBytes, err := payload.Cosign{Image: digestRef}.MarshalJSON()
+	if err != nil {
+		t.Fatalf("failed to create payload: %v", err)
+	}
+	payloadHash := sha256.Sum256(payloadBytes)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, payloadHash[:])
+	if err != nil {
+		t.Fatalf("failed to sign payload: %v", err)
+	}
+
+	ociSig, err := static.NewSignature(payloadBytes, base64.StdEncoding.EncodeToString(sig), extra(payloadBytes, sig))
+	if err != nil {
+		t.Fatalf("failed to create signature: %v", err)
+	}
+	entity, err := ociremote.SignedEntity(digestRef)
+	if err != nil {
+		t.Fatalf("failed to fetch artifact: %v", err)
+	}
+	signed, err := mutate.AttachSignatureToEntity(entity, ociSig)
+	if err != nil {
+		t.Fatalf("failed to attach signature: %v", err)
+	}
+	if err := ociremote.WriteSignatures(digestRef.Repository, signed); err != nil {
+		t.Fatalf("failed to publish signature: %v", err)
+	}
+}
@@ -0,0 +1,179 @@
+// Package signature provides OCI artifact signature verification using Sigstore/Cosign.
+package signature
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// VerificationReport explains the result of a verification, for showing users
+// why an artifact is or is not trusted.
+type VerificationReport struct {
+	// Reference is the artifact reference that was verified.
+	Reference string
+
+	// Digest is the digest of the verified content, from the pull descriptor.
+	Digest string
+
+	// PolicyHash is the hash of the policy the artifact was verified against,
+	// as computed by ComputePolicyHash.
+	PolicyHash string
+
+	// Outcome is the result of the verification.
+	Outcome AuditOutcome
+
+	// Signer is the identity of the signer the policy matched, for keyless
+	// signatures. Empty for public key signatures and unsigned artifacts.
+	Signer string
+
+	// CacheHit is true if the result was served from the verification cache.
+	// Cached results do not include Signatures.
+	CacheHit bool
+
+	// Signatures contains a result for each signature that verified
+	// cryptographically against the policy's keys or trust roots. In
+	// MultiSignatureModeAny, verification stops at the first accepted
+	// signature, so other valid signatures may be missing.
+	Signatures []SignatureReport
+}
+
+// SignatureReport describes one verified signature of an artifact.
+type SignatureReport struct {
+	// Identity is the signer identity from the signing certificate.
+	// Empty for public key signatures.
+	Identity string
+
+	// Issuer is the OIDC issuer recorded in the signing certificate.
+	// Empty for public key signatures.
+	Issuer string
+
+	// Accepted is true if the signature counted towards the signature policy.
+	Accepted bool
+
+	// Reason explains why a signature was not accepted.
+	Reason string
+
+	// CertificateChain summarizes the signing certificate followed by its
+	// chain, leaf first. Empty for public key signatures.
+	CertificateChain []CertificateSummary
+
+	// RekorLogIndex is the index of the signature's entry in the Rekor
+	// transparency log. Nil if the signature has no Rekor bundle.
+	RekorLogIndex *int64
+}
+
+// CertificateSummary describes a certificate of a signing certificate chain.
+type CertificateSummary struct {
+	// Subject is the certificate subject distinguished name.
+	Subject string
+
+	// Issuer is the distinguished name of the certificate authority that
+	// issued the certificate.
+	Issuer string
+
+	// SerialNumber is the certificate serial number in decimal.
+	SerialNumber string
+
+	// NotBefore is the start of the certificate validity period.
+	NotBefore time.Time
+
+	// NotAfter is the end of the certificate validity period.
+	NotAfter time.Time
+}
+
+// VerifyDetailed verifies the signature for the given OCI artifact like Verify,
+// and also returns a report of the signatures it found and how the policy
+// judged them.
+//
+// The report is returned even when verification fails, with Outcome set to
+// AuditOutcomeRejected; the error is the one Verify would return.
+//
+// Example:
+//
+//	report, err := verifier.VerifyDetailed(ctx, reference, descriptor)
+//	for _, sig := range report.Signatures {
+//	    fmt.Printf("%s (%s): accepted=%t %s\n", sig.Identity, sig.Issuer, sig.Accepted, sig.Reason)
+//	}
+func (v *CosignVerifier) VerifyDetailed(
+	ctx context.Context,
+	reference string,
+	descriptor *orasint.PullDescriptor,
+) (*VerificationReport, error) {
+	verifier, result, err := v.verifyAndAudit(ctx, reference, descriptor, false)
+
+	report := &VerificationReport{
+		Reference:  reference,
+		PolicyHash: ComputePolicyHash(verifier.policy),
+		Outcome:    outcomeOf(result, err),
+		Signer:     result.signer,
+		CacheHit:   result.cacheHit,
+	}
+	if descriptor != nil {
+		report.Digest = descriptor.Digest
+	}
+	for _, sig := range result.signatures {
+		report.Signatures = append(report.Signatures, verifier.signatureReport(sig))
+	}
+
+	return report, err
+}
+
+// signatureReport describes a verified signature and whether it satisfies
+// the certificate policy.
+func (v *CosignVerifier) signatureReport(sig oci.Signature) SignatureReport {
+	report := SignatureReport{Accepted: true}
+
+	if rekorBundle, err := sig.Bundle(); err == nil && rekorBundle != nil {
+		logIndex := rekorBundle.Payload.LogIndex
+		report.RekorLogIndex = &logIndex
+	}
+
+	cert, err := sig.Cert()
+	if err != nil || cert == nil {
+		if v.policy.Certificate != nil {
+			report.Accepted = false
+			report.Reason = "signature has no certificate"
+		}
+		return report
+	}
+
+	report.Identity, _ = extractIdentityFromCert(cert)
+	extensions := cosign.CertExtensions{Cert: cert}
+	report.Issuer = extensions.GetIssuer()
+
+	report.CertificateChain = []CertificateSummary{summarizeCertificate(cert)}
+	if chain, err := sig.Chain(); err == nil {
+		for _, c := range chain {
+			if c.Equal(cert) {
+				continue
+			}
+			report.CertificateChain = append(report.CertificateChain, summarizeCertificate(c))
+		}
+	}
+
+	if v.policy.Certificate != nil {
+		if err := v.policy.Certificate.Check(cert); err != nil {
+			report.Accepted = false
+			report.Reason = err.Error()
+		}
+	}
+
+	return report
+}
+
+// summarizeCertificate returns the summary of cert.
+func summarizeCertificate(cert *x509.Certificate) CertificateSummary {
+	return CertificateSummary{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: cert.SerialNumber.String(),
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+	}
+}
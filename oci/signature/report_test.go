@@ -0,0 +1,147 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+
+	ocibundle "github.com/jmgilman/go/oci"
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// TestVerifyDetailed tests reporting the signatures found during verification.
+func TestVerifyDetailed(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("signed"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	signed := host + "/org/app:signed"
+	if err := pusher.Push(ctx, sourceDir, signed); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("unsigned"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	unsigned := host + "/org/app:unsigned"
+	if err := pusher.Push(ctx, sourceDir, unsigned); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+
+	key := generateTestKey(t)
+	rekorKey := generateTestKey(t)
+	signWithRekorBundle(t, signed, key, rekorKey)
+
+	descriptor := &orasint.PullDescriptor{
+		Digest:    "sha256:abc123def456",
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Size:      1024,
+	}
+
+	t.Run("verified", func(t *testing.T) {
+		verifier := NewPublicKeyVerifierWithOptions(
+			[]crypto.PublicKey{&key.PublicKey},
+			WithRekorOffline(true),
+			WithRekorPublicKey(&rekorKey.PublicKey),
+		)
+		report, err := verifier.VerifyDetailed(ctx, signed, descriptor)
+		if err != nil {
+			t.Fatalf("VerifyDetailed failed: %v", err)
+		}
+
+		policy := verifier.Policy()
+		if report.Outcome != AuditOutcomeVerified || report.Digest != descriptor.Digest || report.PolicyHash != ComputePolicyHash(&policy) {
+			t.Errorf("report = %+v", report)
+		}
+		if len(report.Signatures) != 1 {
+			t.Fatalf("got %d signatures, want 1", len(report.Signatures))
+		}
+		sig := report.Signatures[0]
+		if !sig.Accepted || sig.Identity != "" || len(sig.CertificateChain) != 0 {
+			t.Errorf("signature = %+v", sig)
+		}
+		if sig.RekorLogIndex == nil || *sig.RekorLogIndex != 1 {
+			t.Errorf("RekorLogIndex = %v, want 1", sig.RekorLogIndex)
+		}
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		verifier := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithOptionalMode(true))
+		report, err := verifier.VerifyDetailed(ctx, unsigned, descriptor)
+		if err != nil {
+			t.Fatalf("VerifyDetailed failed: %v", err)
+		}
+		if report.Outcome != AuditOutcomeUnsigned || len(report.Signatures) != 0 {
+			t.Errorf("report = %+v", report)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		verifier := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithEnforceMode(true))
+		report, err := verifier.VerifyDetailed(ctx, unsigned, descriptor)
+		if !errors.Is(err, ocibundle.ErrSignatureNotFound) {
+			t.Fatalf("expected ErrSignatureNotFound, got %v", err)
+		}
+		if report == nil || report.Outcome != AuditOutcomeRejected {
+			t.Errorf("report = %+v", report)
+		}
+	})
+}
+
+// TestSignatureReport tests describing keyless signing certificates.
+func TestSignatureReport(t *testing.T) {
+	leaf := testWorkflowCert(t,
+		"https://github.com/example/app/.github/workflows/release.yml@refs/heads/main",
+		"example/app", "refs/heads/main", "push")
+	root := testWorkflowCert(t, "https://example.com/root", "", "", "")
+
+	encode := func(certs ...*x509.Certificate) []byte {
+		var out []byte
+		for _, cert := range certs {
+			out = append(out, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+		}
+		return out
+	}
+	sig, err := static.NewSignature([]byte("{}"), "c2ln", static.WithCertChain(encode(leaf), encode(root)))
+	if err != nil {
+		t.Fatalf("failed to create signature: %v", err)
+	}
+
+	report := NewKeylessVerifier(WithGitHubWorkflow("example/app", "refs/tags/*")).signatureReport(sig)
+
+	if report.Identity != leaf.URIs[0].String() {
+		t.Errorf("Identity = %q, want %q", report.Identity, leaf.URIs[0].String())
+	}
+	if report.Issuer != GitHubActionsIssuer {
+		t.Errorf("Issuer = %q, want %q", report.Issuer, GitHubActionsIssuer)
+	}
+	if len(report.CertificateChain) != 2 || report.CertificateChain[0].SerialNumber != leaf.SerialNumber.String() {
+		t.Errorf("CertificateChain = %+v", report.CertificateChain)
+	}
+	if report.Accepted || !strings.Contains(report.Reason, "workflow ref") {
+		t.Errorf("expected the branch signature to be rejected, got accepted=%t reason=%q", report.Accepted, report.Reason)
+	}
+	if report.RekorLogIndex != nil {
+		t.Errorf("RekorLogIndex = %d, want nil", *report.RekorLogIndex)
+	}
+}
@@ -0,0 +1,232 @@
+// Package signature provides OCI artifact signature verification using Sigstore/Cosign.
+package signature
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sigstore/cosign/v2/pkg/oci"
+
+	ocibundle "github.com/jmgilman/go/oci"
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+const (
+	// PredicateSPDX is the in-toto predicate type of SPDX SBOMs.
+	PredicateSPDX = "https://spdx.dev/Document"
+
+	// PredicateCycloneDX is the in-toto predicate type of CycloneDX SBOMs.
+	PredicateCycloneDX = "https://cyclonedx.org/bom"
+)
+
+// SBOMFormat is a software bill of materials format.
+type SBOMFormat string
+
+const (
+	// SBOMFormatSPDX is an SPDX document, in JSON or tag-value form.
+	SBOMFormatSPDX SBOMFormat = "spdx"
+
+	// SBOMFormatCycloneDX is a CycloneDX JSON document.
+	SBOMFormatCycloneDX SBOMFormat = "cyclonedx"
+)
+
+// SBOMPolicy contains the requirements for a software bill of materials
+// attached to an artifact as an in-toto attestation.
+//
+// An SBOM is only trusted if its attestation is signed by the same keys or
+// identities that are accepted for the artifact signature.
+type SBOMPolicy struct {
+	// Formats contains the accepted SBOM formats.
+	// If empty, SPDX and CycloneDX are both accepted.
+	Formats []SBOMFormat
+
+	// ValidateSchema requires the SBOM document to contain the fields its
+	// format makes mandatory, such as the SPDX version and document namespace
+	// or the CycloneDX spec version.
+	ValidateSchema bool
+}
+
+// Validate checks if the SBOM requirements are valid.
+func (p *SBOMPolicy) Validate() error {
+	for _, format := range p.Formats {
+		if format != SBOMFormatSPDX && format != SBOMFormatCycloneDX {
+			return fmt.Errorf("unsupported SBOM format %q", format)
+		}
+	}
+	return nil
+}
+
+// accepts reports whether the policy accepts SBOMs in the given format.
+func (p *SBOMPolicy) accepts(format SBOMFormat) bool {
+	if len(p.Formats) == 0 {
+		return true
+	}
+	for _, accepted := range p.Formats {
+		if accepted == format {
+			return true
+		}
+	}
+	return false
+}
+
+// SBOM is a software bill of materials found in an attestation.
+type SBOM struct {
+	// Format is the format of the document
+	Format SBOMFormat
+
+	// PredicateType is the in-toto predicate type of the attestation
+	PredicateType string
+
+	// Document is the raw predicate of the attestation. SPDX tag-value
+	// documents are encoded as a JSON string.
+	Document json.RawMessage
+}
+
+// verifySBOM checks that at least one SBOM about the artifact among its
+// verified attestations satisfies the SBOM policy.
+func (v *CosignVerifier) verifySBOM(
+	attestations []oci.Signature,
+	reference string,
+	descriptor *orasint.PullDescriptor,
+) error {
+	sboms, err := sbomsFromAttestations(attestations, attestationSubject(descriptor))
+	if err != nil {
+		return provenanceError(reference, descriptor, fmt.Errorf("%w: %w", ocibundle.ErrAttestationInvalid, err),
+			fmt.Sprintf("Malformed attestation: %s", err.Error()), "attestation")
+	}
+	if len(sboms) == 0 {
+		return provenanceError(reference, descriptor, ocibundle.ErrAttestationNotFound,
+			"No SBOM found for artifact", "fetch")
+	}
+
+	var reasons []string
+	for _, sbom := range sboms {
+		if !v.policy.SBOM.accepts(sbom.Format) {
+			reasons = append(reasons, fmt.Sprintf("format %q is not accepted", sbom.Format))
+			continue
+		}
+		if v.policy.SBOM.ValidateSchema {
+			if err := validateSBOMSchema(sbom); err != nil {
+				reasons = append(reasons, err.Error())
+				continue
+			}
+		}
+		return nil
+	}
+
+	return provenanceError(reference, descriptor, ocibundle.ErrAttestationInvalid,
+		fmt.Sprintf("SBOM policy not satisfied: %s", strings.Join(reasons, "; ")), "policy")
+}
+
+// sbomsFromAttestations extracts the SBOMs about the artifact with the given
+// digest from verified attestations. Statements about other subjects and
+// other predicate types are ignored.
+func sbomsFromAttestations(attestations []oci.Signature, digest string) ([]SBOM, error) {
+	var sboms []SBOM
+	for _, attestation := range attestations {
+		payload, err := attestation.Payload()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read attestation payload: %w", err)
+		}
+
+		statement, err := parseInTotoStatement(payload)
+		if err != nil {
+			return nil, err
+		}
+		if !statementHasSubject(statement, digest) {
+			continue
+		}
+
+		if format, ok := sbomFormat(statement.PredicateType); ok {
+			sboms = append(sboms, SBOM{
+				Format:        format,
+				PredicateType: statement.PredicateType,
+				Document:      statement.Predicate,
+			})
+		}
+	}
+	return sboms, nil
+}
+
+// sbomFormat returns the SBOM format of an in-toto predicate type. Versioned
+// predicate types, such as "https://spdx.dev/Document/v2.3", are accepted.
+func sbomFormat(predicateType string) (SBOMFormat, bool) {
+	switch {
+	case predicateType == PredicateSPDX || strings.HasPrefix(predicateType, PredicateSPDX+"/"):
+		return SBOMFormatSPDX, true
+	case predicateType == PredicateCycloneDX || strings.HasPrefix(predicateType, PredicateCycloneDX+"/"):
+		return SBOMFormatCycloneDX, true
+	default:
+		return "", false
+	}
+}
+
+// validateSBOMSchema checks that an SBOM document contains the fields its
+// format makes mandatory.
+func validateSBOMSchema(sbom SBOM) error {
+	switch sbom.Format {
+	case SBOMFormatSPDX:
+		return validateSPDX(sbom.Document)
+	case SBOMFormatCycloneDX:
+		return validateCycloneDX(sbom.Document)
+	default:
+		return fmt.Errorf("unsupported SBOM format %q", sbom.Format)
+	}
+}
+
+// validateSPDX checks the document creation information of an SPDX document.
+func validateSPDX(document json.RawMessage) error {
+	// Tag-value documents are attested as a JSON string
+	var tagValue string
+	if err := json.Unmarshal(document, &tagValue); err == nil {
+		if !strings.Contains(tagValue, "SPDXVersion:") {
+			return fmt.Errorf("SPDX document has no SPDXVersion")
+		}
+		return nil
+	}
+
+	var doc struct {
+		SPDXVersion       string `json:"spdxVersion"`
+		SPDXID            string `json:"SPDXID"`
+		Name              string `json:"name"`
+		DataLicense       string `json:"dataLicense"`
+		DocumentNamespace string `json:"documentNamespace"`
+	}
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return fmt.Errorf("failed to parse SPDX document: %w", err)
+	}
+
+	switch {
+	case !strings.HasPrefix(doc.SPDXVersion, "SPDX-"):
+		return fmt.Errorf("SPDX document has invalid spdxVersion %q", doc.SPDXVersion)
+	case doc.SPDXID != "SPDXRef-DOCUMENT":
+		return fmt.Errorf("SPDX document has invalid SPDXID %q", doc.SPDXID)
+	case doc.Name == "":
+		return fmt.Errorf("SPDX document has no name")
+	case doc.DataLicense == "":
+		return fmt.Errorf("SPDX document has no dataLicense")
+	case doc.DocumentNamespace == "":
+		return fmt.Errorf("SPDX document has no documentNamespace")
+	}
+	return nil
+}
+
+// validateCycloneDX checks the format and spec version of a CycloneDX document.
+func validateCycloneDX(document json.RawMessage) error {
+	var doc struct {
+		BOMFormat   string `json:"bomFormat"`
+		SpecVersion string `json:"specVersion"`
+	}
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return fmt.Errorf("failed to parse CycloneDX document: %w", err)
+	}
+
+	switch {
+	case doc.BOMFormat != "CycloneDX":
+		return fmt.Errorf("CycloneDX document has invalid bomFormat %q", doc.BOMFormat)
+	case doc.SpecVersion == "":
+		return fmt.Errorf("CycloneDX document has no specVersion")
+	}
+	return nil
+}
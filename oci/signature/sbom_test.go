@@ -0,0 +1,299 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+
+	ocibundle "github.com/jmgilman/go/oci"
+)
+
+// attachTestAttestation signs an in-toto statement about reference with key
+// and publishes it as an attestation.
+func attachTestAttestation(t *testing.T, reference string, key *ecdsa.PrivateKey, predicateType string, predicate any) {
+	t.Helper()
+
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		t.Fatalf("failed to parse reference: %v", err)
+	}
+	desc, err := remote.Get(ref)
+	if err != nil {
+		t.Fatalf("failed to resolve reference: %v", err)
+	}
+	digestRef := ref.Context().Digest(desc.Digest.String())
+
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v0.1",
+		"predicateType": predicateType,
+		"subject": []map[string]any{
+			{"name": ref.Context().String(), "digest": map[string]string{"sha256": desc.Digest.Hex}},
+		},
+		"predicate": predicate,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal statement: %v", err)
+	}
+
+	// DSSE signs the pre-authentication encoding of the payload
+	pae := fmt.Sprintf("DSSEv1 %d %s %d %s", len(inTotoPayloadType), inTotoPayloadType, len(statement), statement)
+	hash := sha256.Sum256([]byte(pae))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatalf("failed to sign statement: %v", err)
+	}
+	envelope, err := json.Marshal(map[string]any{
+		"payloadType": inTotoPayloadType,
+		"payload":     base64.StdEncoding.EncodeToString(statement),
+		"signatures":  []map[string]string{{"keyid": "", "sig": base64.StdEncoding.EncodeToString(sig)}},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal envelope: %v", err)
+	}
+
+	attestation, err := static.NewAttestation(envelope)
+	if err != nil {
+		t.Fatalf("failed to create attestation: %v", err)
+	}
+	entity, err := ociremote.SignedEntity(digestRef)
+	if err != nil {
+		t.Fatalf("failed to fetch artifact: %v", err)
+	}
+	attested, err := mutate.AttachAttestationToEntity(entity, attestation)
+	if err != nil {
+		t.Fatalf("failed to attach attestation: %v", err)
+	}
+	if err := ociremote.WriteAttestations(digestRef.Repository, attested); err != nil {
+		t.Fatalf("failed to publish attestation: %v", err)
+	}
+}
+
+// TestWithRequireSBOM tests requiring signed SBOM attestations on pull.
+func TestWithRequireSBOM(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	key := generateTestKey(t)
+	signer, err := NewPublicKeySigner(key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	push := func(t *testing.T, tag string) string {
+		t.Helper()
+		sourceDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte(tag), 0o644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+		reference := host + "/org/app:" + tag
+		if err := pusher.PushSigned(ctx, sourceDir, reference, signer); err != nil {
+			t.Fatalf("PushSigned failed: %v", err)
+		}
+		return reference
+	}
+
+	withSBOM := push(t, "with-sbom")
+	attachTestAttestation(t, withSBOM, key, PredicateCycloneDX, map[string]any{
+		"bomFormat":   "CycloneDX",
+		"specVersion": "1.5",
+	})
+	attachTestAttestation(t, withSBOM, key, PredicateSLSAProvenanceV1, map[string]any{
+		"runDetails": map[string]any{"builder": map[string]any{"id": "https://github.com/example/builder"}},
+	})
+	withoutSBOM := push(t, "without-sbom")
+	untrusted := push(t, "untrusted-sbom")
+	attachTestAttestation(t, untrusted, generateTestKey(t), PredicateCycloneDX, map[string]any{
+		"bomFormat":   "CycloneDX",
+		"specVersion": "1.5",
+	})
+
+	pull := func(t *testing.T, reference string, opts ...VerifierOption) error {
+		t.Helper()
+		verifier := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, opts...)
+		puller, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP(), ocibundle.WithSignatureVerifier(verifier))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		return puller.Pull(ctx, reference, t.TempDir())
+	}
+
+	t.Run("accepts artifact with SBOM", func(t *testing.T) {
+		if err := pull(t, withSBOM, WithRequireSBOM(true)); err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+	})
+
+	t.Run("validates schema", func(t *testing.T) {
+		if err := pull(t, withSBOM, WithSBOMPolicy(SBOMPolicy{ValidateSchema: true})); err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+	})
+
+	t.Run("verifies provenance alongside SBOM", func(t *testing.T) {
+		provenance := WithProvenance(ProvenancePolicy{BuilderID: "https://github.com/example/*"})
+		if err := pull(t, withSBOM, WithRequireSBOM(true), provenance); err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+	})
+
+	t.Run("rejects artifact without SBOM", func(t *testing.T) {
+		err := pull(t, withoutSBOM, WithRequireSBOM(true), WithOptionalMode(true))
+		if !errors.Is(err, ocibundle.ErrAttestationNotFound) {
+			t.Fatalf("expected ErrAttestationNotFound, got %v", err)
+		}
+	})
+
+	t.Run("rejects SBOM signed by another key", func(t *testing.T) {
+		if err := pull(t, untrusted, WithRequireSBOM(true)); err == nil {
+			t.Fatal("expected Pull to fail with an untrusted SBOM")
+		}
+	})
+
+	t.Run("rejects unaccepted format", func(t *testing.T) {
+		err := pull(t, withSBOM, WithSBOMPolicy(SBOMPolicy{Formats: []SBOMFormat{SBOMFormatSPDX}}))
+		if !errors.Is(err, ocibundle.ErrAttestationInvalid) {
+			t.Fatalf("expected ErrAttestationInvalid, got %v", err)
+		}
+	})
+
+	t.Run("does not require SBOM by default", func(t *testing.T) {
+		if err := pull(t, withoutSBOM); err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+	})
+}
+
+// TestValidateSBOMSchema tests checking the mandatory fields of SBOM documents.
+func TestValidateSBOMSchema(t *testing.T) {
+	spdx := map[string]any{
+		"spdxVersion":       "SPDX-2.3",
+		"SPDXID":            "SPDXRef-DOCUMENT",
+		"name":              "app",
+		"dataLicense":       "CC0-1.0",
+		"documentNamespace": "https://example.com/app",
+	}
+	without := func(doc map[string]any, field string) map[string]any {
+		out := map[string]any{}
+		for k, v := range doc {
+			if k != field {
+				out[k] = v
+			}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name          string
+		predicateType string
+		predicate     any
+		wantErr       string
+	}{
+		{name: "SPDX JSON", predicateType: PredicateSPDX, predicate: spdx},
+		{name: "SPDX tag-value", predicateType: PredicateSPDX, predicate: "SPDXVersion: SPDX-2.3\nDataLicense: CC0-1.0\n"},
+		{name: "versioned SPDX", predicateType: PredicateSPDX + "/v2.3", predicate: spdx},
+		{name: "SPDX without namespace", predicateType: PredicateSPDX, predicate: without(spdx, "documentNamespace"), wantErr: "documentNamespace"},
+		{name: "SPDX with invalid version", predicateType: PredicateSPDX, predicate: map[string]any{"spdxVersion": "2.3"}, wantErr: "spdxVersion"},
+		{name: "SPDX tag-value without version", predicateType: PredicateSPDX, predicate: "DataLicense: CC0-1.0\n", wantErr: "SPDXVersion"},
+		{name: "CycloneDX", predicateType: PredicateCycloneDX, predicate: map[string]any{"bomFormat": "CycloneDX", "specVersion": "1.5"}},
+		{name: "CycloneDX without spec version", predicateType: PredicateCycloneDX, predicate: map[string]any{"bomFormat": "CycloneDX"}, wantErr: "specVersion"},
+		{name: "CycloneDX with wrong format", predicateType: PredicateCycloneDX, predicate: map[string]any{"bomFormat": "SPDX", "specVersion": "1.5"}, wantErr: "bomFormat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attestation, err := static.NewAttestation(newTestEnvelope(t, tt.predicateType, tt.predicate, testDigest))
+			if err != nil {
+				t.Fatalf("failed to create attestation: %v", err)
+			}
+			sboms, err := sbomsFromAttestations([]oci.Signature{attestation}, testDigest)
+			if err != nil {
+				t.Fatalf("sbomsFromAttestations failed: %v", err)
+			}
+			if len(sboms) != 1 {
+				t.Fatalf("got %d SBOMs, want 1", len(sboms))
+			}
+
+			err = validateSBOMSchema(sboms[0])
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateSBOMSchema failed: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("ignores other predicates and subjects", func(t *testing.T) {
+		provenance, err := static.NewAttestation(newTestEnvelope(t, PredicateSLSAProvenanceV1, map[string]any{}, testDigest))
+		if err != nil {
+			t.Fatalf("failed to create attestation: %v", err)
+		}
+		other, err := static.NewAttestation(newTestEnvelope(t, PredicateSPDX, spdx,
+			"sha256:0000000000000000000000000000000000000000000000000000000000000000"))
+		if err != nil {
+			t.Fatalf("failed to create attestation: %v", err)
+		}
+		sboms, err := sbomsFromAttestations([]oci.Signature{provenance, other}, testDigest)
+		if err != nil {
+			t.Fatalf("sbomsFromAttestations failed: %v", err)
+		}
+		if len(sboms) != 0 {
+			t.Errorf("got %d SBOMs, want 0", len(sboms))
+		}
+	})
+}
+
+// TestSBOMPolicy tests validating and hashing SBOM requirements.
+func TestSBOMPolicy(t *testing.T) {
+	key := generateTestKey(t)
+
+	invalid := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey},
+		WithSBOMPolicy(SBOMPolicy{Formats: []SBOMFormat{"swid"}})).Policy()
+	if err := invalid.Validate(); err == nil || !strings.Contains(err.Error(), "unsupported SBOM format") {
+		t.Errorf("error = %v, want unsupported SBOM format", err)
+	}
+
+	without := NewPublicKeyVerifier(&key.PublicKey).Policy()
+	required := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithRequireSBOM(true)).Policy()
+	validated := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey},
+		WithSBOMPolicy(SBOMPolicy{ValidateSchema: true})).Policy()
+	if !PolicyChanged(&without, &required) || !PolicyChanged(&required, &validated) {
+		t.Error("expected SBOM requirements to change the policy hash")
+	}
+
+	disabled := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey},
+		WithRequireSBOM(true), WithRequireSBOM(false)).Policy()
+	if disabled.SBOM != nil {
+		t.Errorf("SBOM = %+v, want nil", disabled.SBOM)
+	}
+}
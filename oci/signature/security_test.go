@@ -13,7 +13,7 @@ import (
 	"time"
 
 	ocibundle "github.com/jmgilman/go/oci"
-	"github.com/jmgilman/go/oci/internal/cache"
+	"github.com/jmgilman/go/oci/cache"
 	"github.com/jmgilman/go/oci/internal/oras"
 )
 
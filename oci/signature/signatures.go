@@ -0,0 +1,146 @@
+// Package signature provides OCI artifact signature verification using Sigstore/Cosign.
+package signature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultMaxWorkers is the number of signatures verified concurrently when
+// the policy does not set MaxWorkers, matching Cosign.
+const defaultMaxWorkers = 10
+
+// errNoSignatures is returned by verifySignatures for artifacts without signatures.
+var errNoSignatures = errors.New("no signatures found")
+
+// unverifiedSignaturesError is returned by verifySignatures for artifacts that
+// have signatures, none of which verified. It is a verification failure, never
+// a missing signature.
+type unverifiedSignaturesError struct {
+	// errs holds the verification error of each signature
+	errs []error
+}
+
+// Error describes the verification error of each signature.
+func (e *unverifiedSignaturesError) Error() string {
+	return fmt.Sprintf("none of %d signatures verified: %v", len(e.errs), errors.Join(e.errs...))
+}
+
+// Unwrap returns the verification errors of the signatures.
+func (e *unverifiedSignaturesError) Unwrap() []error {
+	return e.errs
+}
+
+// verifySignatures fetches the signatures of an artifact and verifies them
+// concurrently, with at most MaxWorkers verifications in flight. It returns
+// the signatures that verified and the number of signatures found, or an
+// *unverifiedSignaturesError if none of the signatures verified.
+//
+// In MultiSignatureModeAny, verification stops as soon as one signature
+// verifies and satisfies the certificate policy, so the returned signatures
+// may not include every valid signature of the artifact.
+func (v *CosignVerifier) verifySignatures(
+	ctx context.Context,
+	ref name.Reference,
+	checkOpts *cosign.CheckOpts,
+) ([]oci.Signature, int, error) {
+	digest, err := ociremote.ResolveDigest(ref, checkOpts.RegistryClientOpts...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to resolve digest: %w", err)
+	}
+	hash, err := v1.NewHash(digest.Identifier())
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid digest: %w", err)
+	}
+
+	sigTag, err := ociremote.SignatureTag(digest, checkOpts.RegistryClientOpts...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to resolve signature tag: %w", err)
+	}
+	sigs, err := ociremote.Signatures(sigTag, checkOpts.RegistryClientOpts...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch signatures: %w", err)
+	}
+	signatures, err := sigs.Get()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read signatures: %w", err)
+	}
+	if len(signatures) == 0 {
+		return nil, 0, errNoSignatures
+	}
+
+	workers := v.policy.MaxWorkers
+	if workers == 0 {
+		workers = defaultMaxWorkers
+	}
+
+	// Cancelled once ANY mode is satisfied, to skip the remaining signatures
+	verifyCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	verified := make([]oci.Signature, len(signatures))
+	errs := make([]error, len(signatures))
+	var eg errgroup.Group
+	eg.SetLimit(workers)
+	for i, sig := range signatures {
+		if verifyCtx.Err() != nil {
+			break
+		}
+
+		eg.Go(func() error {
+			if verifyCtx.Err() != nil {
+				return nil
+			}
+
+			// Copy the signature so concurrent verifications do not share layers
+			sig, err := static.Copy(sig)
+			if err != nil {
+				errs[i] = err
+				return nil
+			}
+			if _, err := cosign.VerifyImageSignature(verifyCtx, sig, hash, checkOpts); err != nil {
+				errs[i] = err
+				return nil
+			}
+			verified[i] = sig
+
+			if v.policy.MultiSignatureMode == MultiSignatureModeAny && v.checkCertificate(sig) == nil {
+				cancel()
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	// Verifications interrupted by the caller are not signature failures
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	var checked []oci.Signature
+	for _, sig := range verified {
+		if sig != nil {
+			checked = append(checked, sig)
+		}
+	}
+	if len(checked) == 0 {
+		var failures []error
+		for _, err := range errs {
+			if err != nil {
+				failures = append(failures, err)
+			}
+		}
+		return nil, 0, &unverifiedSignaturesError{errs: failures}
+	}
+
+	return checked, len(signatures), nil
+}
@@ -0,0 +1,133 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+
+	ocibundle "github.com/jmgilman/go/oci"
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// TestVerifySignaturesConcurrently tests verifying artifacts with many signatures.
+func TestVerifySignaturesConcurrently(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	push := func(t *testing.T, tag string) string {
+		t.Helper()
+		sourceDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte(tag), 0o644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+		reference := host + "/org/app:" + tag
+		if err := pusher.Push(ctx, sourceDir, reference); err != nil {
+			t.Fatalf("Push failed: %v", err)
+		}
+		return reference
+	}
+
+	key := generateTestKey(t)
+	signed := push(t, "signed")
+	for i := range 5 {
+		attachTestSignature(t, signed, key, func(_, _ []byte) static.Option {
+			return static.WithAnnotations(map[string]string{"index": fmt.Sprint(i)})
+		})
+	}
+	mixed := push(t, "mixed")
+	attachTestSignature(t, mixed, key, func(_, _ []byte) static.Option {
+		return static.WithAnnotations(map[string]string{"signer": "trusted"})
+	})
+	attachTestSignature(t, mixed, generateTestKey(t), func(_, _ []byte) static.Option {
+		return static.WithAnnotations(map[string]string{"signer": "untrusted"})
+	})
+	untrusted := push(t, "untrusted")
+	attachTestSignature(t, untrusted, generateTestKey(t), func(_, _ []byte) static.Option {
+		return static.WithAnnotations(map[string]string{"signer": "untrusted"})
+	})
+
+	descriptor := &orasint.PullDescriptor{
+		Digest:    "sha256:abc123def456",
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Size:      1024,
+	}
+
+	tests := []struct {
+		name      string
+		reference string
+		opts      []VerifierOption
+		wantCount int
+		wantErr   error
+	}{
+		{name: "any mode stops at first valid signature", reference: signed, opts: []VerifierOption{WithMaxWorkers(1)}, wantCount: 1},
+		{name: "all mode verifies every signature", reference: signed, opts: []VerifierOption{WithRequireAll(true), WithMaxWorkers(2)}, wantCount: 5},
+		{name: "minimum mode counts concurrent results", reference: signed, opts: []VerifierOption{WithMinimumSignatures(3)}, wantCount: 5},
+		{name: "any mode accepts one valid signature", reference: mixed, wantCount: 1},
+		{name: "all mode rejects an invalid signature", reference: mixed, opts: []VerifierOption{WithRequireAll(true)}, wantErr: ocibundle.ErrSignatureInvalid},
+		{name: "untrusted signatures are invalid, not missing", reference: untrusted, wantErr: ocibundle.ErrSignatureInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, tt.opts...)
+			report, err := verifier.VerifyDetailed(ctx, tt.reference, descriptor)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyDetailed failed: %v", err)
+			}
+			if len(report.Signatures) != tt.wantCount {
+				t.Errorf("got %d signatures, want %d", len(report.Signatures), tt.wantCount)
+			}
+		})
+	}
+
+	t.Run("preserves signature errors", func(t *testing.T) {
+		verifier := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey})
+		err := verifier.Verify(ctx, untrusted, descriptor)
+		var unverified *unverifiedSignaturesError
+		if !errors.As(err, &unverified) || len(unverified.errs) != 1 {
+			t.Fatalf("expected the signature error to be preserved, got %v", err)
+		}
+		if !strings.Contains(err.Error(), unverified.errs[0].Error()) {
+			t.Errorf("expected error to describe the signature error, got %v", err)
+		}
+	})
+
+	t.Run("cancelled context fails verification", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		verifier := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithOptionalMode(true))
+		if err := verifier.Verify(cancelled, signed, descriptor); err == nil {
+			t.Fatal("expected Verify to fail with a cancelled context")
+		}
+	})
+
+	t.Run("rejects negative max workers", func(t *testing.T) {
+		policy := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithMaxWorkers(-1)).Policy()
+		if err := policy.Validate(); err == nil || !strings.Contains(err.Error(), "max workers") {
+			t.Errorf("error = %v, want max workers error", err)
+		}
+	})
+}
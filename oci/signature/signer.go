@@ -0,0 +1,383 @@
+// Package signature provides OCI artifact signature verification using Sigstore/Cosign.
+package signature
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/cmd/cosign/cli/fulcio"
+	"github.com/sigstore/cosign/v2/cmd/cosign/cli/options"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+)
+
+// defaultFulcioURL is the Fulcio instance of the public Sigstore deployment.
+const defaultFulcioURL = "https://fulcio.sigstore.dev"
+
+// SignerOption is a functional option for configuring a CosignSigner.
+type SignerOption func(*CosignSigner)
+
+// WithSignerRekor controls whether signatures are uploaded to the Rekor
+// transparency log. Enabled by default for keyless signing, which verifiers
+// need to check short-lived certificates, and disabled for public key signing.
+//
+// Example:
+//
+//	signer, err := NewPublicKeySigner(key,
+//	    WithSignerRekor(true),
+//	)
+func WithSignerRekor(enabled bool) SignerOption {
+	return func(s *CosignSigner) {
+		s.rekorEnabled = enabled
+	}
+}
+
+// WithSignerRekorURL sets the Rekor instance signatures are uploaded to and
+// enables the upload. Defaults to the public Sigstore Rekor instance.
+//
+// Example:
+//
+//	signer := NewKeylessSigner(
+//	    WithSignerRekorURL("https://rekor.private.example.com"),
+//	)
+func WithSignerRekorURL(url string) SignerOption {
+	return func(s *CosignSigner) {
+		s.rekorURL = url
+		if url != "" {
+			s.rekorEnabled = true
+		}
+	}
+}
+
+// WithFulcioURL sets the Fulcio instance that issues signing certificates in
+// keyless mode. Defaults to the public Sigstore Fulcio instance.
+//
+// Example:
+//
+//	signer := NewKeylessSigner(
+//	    WithFulcioURL("https://fulcio.private.example.com"),
+//	)
+func WithFulcioURL(url string) SignerOption {
+	return func(s *CosignSigner) {
+		s.fulcioURL = url
+	}
+}
+
+// WithIdentityToken sets the OIDC identity token exchanged for a signing
+// certificate in keyless mode. If unset, the token is obtained from the
+// ambient CI environment, such as GitHub Actions.
+//
+// Example:
+//
+//	signer := NewKeylessSigner(
+//	    WithIdentityToken(os.Getenv("SIGSTORE_ID_TOKEN")),
+//	)
+func WithIdentityToken(token string) SignerOption {
+	return func(s *CosignSigner) {
+		s.identityToken = token
+	}
+}
+
+// WithKeychain sets the keychain used to authenticate to the registry when
+// publishing signatures. Defaults to authn.DefaultKeychain, which reads the
+// Docker credential configuration.
+func WithKeychain(keychain authn.Keychain) SignerOption {
+	return func(s *CosignSigner) {
+		s.keychain = keychain
+	}
+}
+
+// CosignSigner implements signing of OCI artifacts using Sigstore/Cosign.
+// It supports both traditional private key signing and keyless (OIDC) signing.
+// Signatures are published to the registry in Cosign's format, so they can be
+// verified by CosignVerifier and the Cosign CLI.
+//
+// The signer is thread-safe and can be reused across multiple signing operations.
+type CosignSigner struct {
+	// signer signs payloads in public key mode
+	// Nil in keyless mode, where an ephemeral key is generated per signature
+	signer signature.SignerVerifier
+
+	// rekorEnabled controls whether signatures are uploaded to Rekor
+	rekorEnabled bool
+
+	// rekorURL is the Rekor instance signatures are uploaded to
+	rekorURL string
+
+	// fulcioURL is the Fulcio instance that issues keyless certificates
+	fulcioURL string
+
+	// identityToken is the OIDC token exchanged for keyless certificates
+	identityToken string
+
+	// keychain authenticates to the registry signatures are published to
+	keychain authn.Keychain
+}
+
+// NewPublicKeySigner creates a new CosignSigner that signs with a private key.
+// Artifacts signed this way are verified with the corresponding public key,
+// for example with NewPublicKeyVerifier.
+//
+// Example:
+//
+//	signer, err := NewPublicKeySigner(privateKey)
+//	if err != nil {
+//	    return err
+//	}
+//
+//	client, err := ocibundle.NewWithOptions(
+//	    ocibundle.WithSignatureSigner(signer),
+//	)
+func NewPublicKeySigner(key crypto.Signer, opts ...SignerOption) (*CosignSigner, error) {
+	if key == nil {
+		return nil, fmt.Errorf("private key cannot be nil")
+	}
+	if err := validateKeyStrength(key.Public()); err != nil {
+		return nil, fmt.Errorf("private key failed validation: %w", err)
+	}
+
+	sv, err := signature.LoadDefaultSignerVerifier(key, *cosign.GetDefaultLoadOptions(nil)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer: %w", err)
+	}
+
+	return newCosignSigner(sv, false, opts), nil
+}
+
+// NewPublicKeySignerFromFile creates a new CosignSigner from an encrypted
+// Cosign private key file, as created by "cosign generate-key-pair".
+//
+// Example:
+//
+//	signer, err := NewPublicKeySignerFromFile("cosign.key", []byte(os.Getenv("COSIGN_PASSWORD")))
+func NewPublicKeySignerFromFile(path string, password []byte, opts ...SignerOption) (*CosignSigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key file %s: %w", path, err)
+	}
+
+	sv, err := cosign.LoadPrivateKey(data, password, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key from %s: %w", path, err)
+	}
+
+	return newCosignSigner(sv, false, opts), nil
+}
+
+// NewKeylessSigner creates a new CosignSigner for keyless (OIDC) signing.
+// Each signature is made with an ephemeral key whose certificate is issued by
+// Fulcio for the OIDC identity of the caller, and is recorded in Rekor.
+// Artifacts signed this way are verified with NewKeylessVerifier.
+//
+// Example:
+//
+//	signer := NewKeylessSigner(
+//	    WithIdentityToken(token),
+//	)
+func NewKeylessSigner(opts ...SignerOption) *CosignSigner {
+	return newCosignSigner(nil, true, opts)
+}
+
+// newCosignSigner creates a CosignSigner with the mode defaults and applies opts.
+func newCosignSigner(sv signature.SignerVerifier, keyless bool, opts []SignerOption) *CosignSigner {
+	s := &CosignSigner{
+		signer:       sv,
+		rekorEnabled: keyless,
+		fulcioURL:    defaultFulcioURL,
+		keychain:     authn.DefaultKeychain,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Sign signs the manifest that reference points to and publishes the
+// signature to the registry. This method implements the
+// ocibundle.SignatureSigner interface.
+//
+// The signing process:
+//  1. Resolve the reference to its manifest digest
+//  2. Check that the reference still points at the pushed manifest
+//  3. Obtain a signing certificate from Fulcio (keyless mode only)
+//  4. Sign the Cosign payload, embedding the annotations
+//  5. Optionally upload the signature to Rekor
+//  6. Publish the signature under Cosign's signature tag
+func (s *CosignSigner) Sign(ctx context.Context, reference, digest string, annotations map[string]string) error {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return fmt.Errorf("invalid reference format: %w", err)
+	}
+
+	remoteOpts := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(s.keychain),
+	}
+
+	digestRef, err := s.resolveSubject(ref, digest, remoteOpts)
+	if err != nil {
+		return err
+	}
+
+	payloadAnnotations := make(map[string]interface{}, len(annotations))
+	for k, v := range annotations {
+		payloadAnnotations[k] = v
+	}
+	payloadBytes, err := payload.Cosign{
+		Image:           digestRef,
+		ClaimedIdentity: reference,
+		Annotations:     payloadAnnotations,
+	}.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to create signature payload: %w", err)
+	}
+
+	sv, cert, chain, err := s.signingKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	sig, err := sv.SignMessage(bytes.NewReader(payloadBytes))
+	if err != nil {
+		return fmt.Errorf("failed to sign payload: %w", err)
+	}
+
+	var sigOpts []static.Option
+	if cert != nil {
+		sigOpts = append(sigOpts, static.WithCertChain(cert, chain))
+	}
+	if s.rekorEnabled {
+		rekorBundle, err := s.uploadToRekor(ctx, sv, sig, payloadBytes, cert)
+		if err != nil {
+			return err
+		}
+		sigOpts = append(sigOpts, static.WithBundle(rekorBundle))
+	}
+
+	ociSig, err := static.NewSignature(payloadBytes, base64.StdEncoding.EncodeToString(sig), sigOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to create signature: %w", err)
+	}
+
+	entity, err := ociremote.SignedEntity(digestRef, ociremote.WithRemoteOptions(remoteOpts...))
+	if err != nil {
+		return fmt.Errorf("failed to fetch artifact: %w", err)
+	}
+	signed, err := mutate.AttachSignatureToEntity(entity, ociSig)
+	if err != nil {
+		return fmt.Errorf("failed to attach signature: %w", err)
+	}
+	if err := ociremote.WriteSignatures(digestRef.Repository, signed, ociremote.WithRemoteOptions(remoteOpts...)); err != nil {
+		return fmt.Errorf("failed to publish signature: %w", err)
+	}
+
+	return nil
+}
+
+// resolveSubject resolves ref to the digest of its manifest. If digest is
+// set, the manifest must be the one with that digest, or contain a layer with
+// it for callers that only know the pushed blob, so a tag moved after the
+// push is never signed.
+func (s *CosignSigner) resolveSubject(ref name.Reference, digest string, remoteOpts []remote.Option) (name.Digest, error) {
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return name.Digest{}, fmt.Errorf("failed to resolve reference: %w", err)
+	}
+	digestRef := ref.Context().Digest(desc.Digest.String())
+
+	if digest == "" || digest == desc.Digest.String() {
+		return digestRef, nil
+	}
+
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return name.Digest{}, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.Digest.String() == digest {
+			return digestRef, nil
+		}
+	}
+
+	return name.Digest{}, fmt.Errorf("reference %s no longer points at artifact %s", ref, digest)
+}
+
+// signingKey returns the key to sign with. In keyless mode, an ephemeral key
+// is generated and its certificate and chain are obtained from Fulcio.
+func (s *CosignSigner) signingKey(ctx context.Context) (signature.SignerVerifier, []byte, []byte, error) {
+	if s.signer != nil {
+		return s.signer, nil, nil, nil
+	}
+
+	privateKey, err := cosign.GeneratePrivateKey()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate ephemeral key: %w", err)
+	}
+	sv, err := signature.LoadECDSASignerVerifier(privateKey, crypto.SHA256)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create ephemeral signer: %w", err)
+	}
+
+	keylessSigner, err := fulcio.NewSigner(ctx, options.KeyOpts{
+		FulcioURL:        s.fulcioURL,
+		IDToken:          s.identityToken,
+		SkipConfirmation: true,
+	}, sv)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to obtain signing certificate: %w", err)
+	}
+
+	return sv, keylessSigner.Cert, keylessSigner.Chain, nil
+}
+
+// uploadToRekor records a signature in the Rekor transparency log and returns
+// the bundle that lets verifiers check the inclusion offline.
+func (s *CosignSigner) uploadToRekor(
+	ctx context.Context,
+	sv signature.SignerVerifier,
+	sig []byte,
+	payloadBytes []byte,
+	cert []byte,
+) (*cbundle.RekorBundle, error) {
+	rekorClient, err := newRekorClient(s.rekorURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rekor records the certificate in keyless mode and the public key otherwise
+	pemBytes := cert
+	if pemBytes == nil {
+		publicKey, err := sv.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get public key: %w", err)
+		}
+		pemBytes, err = cryptoutils.MarshalPublicKeyToPEM(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode public key: %w", err)
+		}
+	}
+
+	checksum := sha256.New()
+	checksum.Write(payloadBytes)
+	entry, err := cosign.TLogUpload(ctx, rekorClient, sig, checksum, pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload signature to Rekor: %w", err)
+	}
+
+	return cbundle.EntryToBundle(entry), nil
+}
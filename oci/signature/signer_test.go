@@ -0,0 +1,80 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+
+	ocibundle "github.com/jmgilman/go/oci"
+)
+
+// TestCosignSigner_RoundTrip tests that artifacts signed on push verify on pull.
+func TestCosignSigner_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	reference := strings.TrimPrefix(server.URL, "http://") + "/org/app:v1"
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("app"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	signer, err := NewPublicKeySigner(key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if err := pusher.PushSigned(ctx, sourceDir, reference, signer); err != nil {
+		t.Fatalf("PushSigned failed: %v", err)
+	}
+
+	t.Run("verifies with the signing key", func(t *testing.T) {
+		puller, err := ocibundle.NewWithOptions(
+			ocibundle.WithAllowHTTP(),
+			ocibundle.WithSignatureVerifier(NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithEnforceMode(true))),
+		)
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		if err := puller.Pull(ctx, reference, t.TempDir()); err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+	})
+
+	t.Run("rejects other keys", func(t *testing.T) {
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		puller, err := ocibundle.NewWithOptions(
+			ocibundle.WithAllowHTTP(),
+			ocibundle.WithSignatureVerifier(NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&otherKey.PublicKey}, WithEnforceMode(true))),
+		)
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		if err := puller.Pull(ctx, reference, t.TempDir()); err == nil {
+			t.Fatal("expected Pull to fail with a different key")
+		}
+	})
+}
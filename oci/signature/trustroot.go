@@ -0,0 +1,173 @@
+// Package signature provides OCI artifact signature verification using Sigstore/Cosign.
+package signature
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sigstore/sigstore-go/pkg/root"
+	sigstoretuf "github.com/sigstore/sigstore-go/pkg/tuf"
+)
+
+// ErrTrustRootStale is returned when a pinned trust root snapshot is older
+// than the maximum age allowed by the policy. Take a new snapshot, or call
+// CosignVerifier.RefreshTrustRoot, to verify again.
+var ErrTrustRootStale = errors.New("trust root snapshot is stale")
+
+// TrustRootSnapshot is a copy of the Sigstore trusted root distributed by a
+// TUF repository: the Fulcio certificate authorities, CT log keys, and Rekor
+// keys that keyless signatures and transparency log entries are verified with.
+//
+// Pinning a snapshot makes verification reproducible across environments and
+// removes the TUF network dependency. Snapshots are typically taken at build
+// time with FetchTrustRootSnapshot, stored with Marshal, and embedded:
+//
+//	//go:embed trust-root.json
+//	var trustRoot []byte
+//
+//	snapshot, err := signature.ParseTrustRootSnapshot(trustRoot)
+type TrustRootSnapshot struct {
+	// FetchedAt is when the snapshot was taken.
+	FetchedAt time.Time `json:"fetchedAt"`
+
+	// Source is the URL of the TUF repository the snapshot was taken from.
+	Source string `json:"source"`
+
+	// TrustedRoot is the trusted_root.json target of the TUF repository.
+	TrustedRoot json.RawMessage `json:"trustedRoot"`
+}
+
+// FetchTrustRootSnapshot takes a snapshot of the trusted root distributed by
+// a TUF repository. The mirror defaults to the public Sigstore TUF repository
+// if empty; root is the initial root.json of the mirror and may be nil for
+// the public repository.
+//
+// Example:
+//
+//	snapshot, err := signature.FetchTrustRootSnapshot(ctx, "", nil)
+//	if err != nil {
+//	    return err
+//	}
+//	data, err := snapshot.Marshal()
+//	if err != nil {
+//	    return err
+//	}
+//	return os.WriteFile("trust-root.json", data, 0o644)
+func FetchTrustRootSnapshot(ctx context.Context, mirror string, root []byte) (*TrustRootSnapshot, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if mirror == "" {
+		mirror = sigstoretuf.DefaultMirror
+	}
+
+	rootJSON, err := fetchTrustedRootJSON(mirror, root)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &TrustRootSnapshot{
+		FetchedAt:   time.Now().UTC(),
+		Source:      mirror,
+		TrustedRoot: rootJSON,
+	}
+	if err := snapshot.Validate(); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// ParseTrustRootSnapshot parses a snapshot stored with TrustRootSnapshot.Marshal.
+func ParseTrustRootSnapshot(data []byte) (*TrustRootSnapshot, error) {
+	var snapshot TrustRootSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse trust root snapshot: %w", err)
+	}
+	if err := snapshot.Validate(); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Marshal encodes the snapshot as JSON for ParseTrustRootSnapshot.
+func (s *TrustRootSnapshot) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode trust root snapshot: %w", err)
+	}
+	return data, nil
+}
+
+// Validate checks that the snapshot has a fetch time and a trusted root
+// that can be parsed.
+func (s *TrustRootSnapshot) Validate() error {
+	if s.FetchedAt.IsZero() {
+		return fmt.Errorf("trust root snapshot has no fetch time")
+	}
+	if len(s.TrustedRoot) == 0 {
+		return fmt.Errorf("trust root snapshot has no trusted root")
+	}
+	if _, err := root.NewTrustedRootFromJSON(s.TrustedRoot); err != nil {
+		return fmt.Errorf("invalid trusted root in snapshot: %w", err)
+	}
+	return nil
+}
+
+// checkAge reports ErrTrustRootStale if the snapshot is older than maxAge.
+// A zero maxAge never expires the snapshot.
+func (s *TrustRootSnapshot) checkAge(maxAge time.Duration) error {
+	if maxAge <= 0 {
+		return nil
+	}
+	if age := time.Since(s.FetchedAt); age > maxAge {
+		return fmt.Errorf("%w: snapshot of %s taken at %s is %s old, maximum age is %s",
+			ErrTrustRootStale, s.Source, s.FetchedAt.Format(time.RFC3339), age.Round(time.Second), maxAge)
+	}
+	return nil
+}
+
+// RefreshTrustRoot takes a new trust root snapshot from the policy's TUF
+// mirror, or the public Sigstore TUF repository, and verifies against it from
+// now on. It returns the snapshot so callers can store it for future runs.
+//
+// Verifiers without a pinned snapshot refetch the trust root of their TUF
+// mirror. Verifiers for policies selected per reference keep their own
+// trust roots.
+//
+// Example:
+//
+//	err := verifier.Verify(ctx, reference, descriptor)
+//	if errors.Is(err, signature.ErrTrustRootStale) {
+//	    if _, err := verifier.RefreshTrustRoot(ctx); err != nil {
+//	        return err
+//	    }
+//	    err = verifier.Verify(ctx, reference, descriptor)
+//	}
+func (v *CosignVerifier) RefreshTrustRoot(ctx context.Context) (*TrustRootSnapshot, error) {
+	if v.policy.TrustRootSnapshot == nil && v.policy.TUFMirror == "" {
+		return nil, fmt.Errorf("trust root refresh requires a trust root snapshot or TUF mirror")
+	}
+
+	mirror := v.policy.TUFMirror
+	if mirror == "" {
+		mirror = v.policy.TrustRootSnapshot.Source
+	}
+	snapshot, err := FetchTrustRootSnapshot(ctx, mirror, v.policy.TUFRoot)
+	if err != nil {
+		return nil, err
+	}
+	trustRoot, err := parseTrustedRoot(snapshot.TrustedRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	v.trustRoot.mu.Lock()
+	defer v.trustRoot.mu.Unlock()
+	v.trustRoot.snapshot = snapshot
+	v.trustRoot.root = trustRoot
+
+	return snapshot, nil
+}
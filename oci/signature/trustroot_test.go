@@ -0,0 +1,170 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+
+	ocibundle "github.com/jmgilman/go/oci"
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// newTestTrustRootSnapshot creates a snapshot of a trusted root whose only
+// transparency log is signed by rekorKey.
+func newTestTrustRootSnapshot(t *testing.T, rekorKey *ecdsa.PrivateKey, fetchedAt time.Time) *TrustRootSnapshot {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(&rekorKey.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to encode public key: %v", err)
+	}
+	logID := sha256.Sum256(der)
+	trustedRoot, err := json.Marshal(map[string]any{
+		"mediaType": "application/vnd.dev.sigstore.trustedroot+json;version=0.1",
+		"tlogs": []map[string]any{{
+			"baseUrl":       "https://rekor.example.com",
+			"hashAlgorithm": "SHA2_256",
+			"publicKey": map[string]any{
+				"rawBytes":   base64.StdEncoding.EncodeToString(der),
+				"keyDetails": "PKIX_ECDSA_P256_SHA_256",
+				"validFor":   map[string]string{"start": "2020-01-01T00:00:00Z"},
+			},
+			"logId": map[string]string{"keyId": base64.StdEncoding.EncodeToString(logID[:])},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode trusted root: %v", err)
+	}
+
+	return &TrustRootSnapshot{
+		FetchedAt:   fetchedAt,
+		Source:      "https://tuf.example.com",
+		TrustedRoot: trustedRoot,
+	}
+}
+
+// TestWithTrustRootSnapshot tests verifying against a pinned trust root.
+func TestWithTrustRootSnapshot(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("pinned"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	reference := host + "/org/app:pinned"
+	if err := pusher.Push(ctx, sourceDir, reference); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+
+	key := generateTestKey(t)
+	rekorKey := generateTestKey(t)
+	signWithRekorBundle(t, reference, key, rekorKey)
+
+	descriptor := &orasint.PullDescriptor{
+		Digest:    "sha256:abc123def456",
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Size:      1024,
+	}
+	verify := func(snapshot *TrustRootSnapshot, maxAge time.Duration) error {
+		verifier := NewPublicKeyVerifierWithOptions(
+			[]crypto.PublicKey{&key.PublicKey},
+			WithEnforceMode(true),
+			WithRekorOffline(true),
+			WithTrustRootSnapshot(snapshot, maxAge),
+		)
+		return verifier.Verify(ctx, reference, descriptor)
+	}
+
+	t.Run("verifies with snapshot keys", func(t *testing.T) {
+		if err := verify(newTestTrustRootSnapshot(t, rekorKey, time.Now()), 24*time.Hour); err != nil {
+			t.Fatalf("Verify failed: %v", err)
+		}
+	})
+
+	t.Run("never expires without max age", func(t *testing.T) {
+		if err := verify(newTestTrustRootSnapshot(t, rekorKey, time.Now().Add(-365*24*time.Hour)), 0); err != nil {
+			t.Fatalf("Verify failed: %v", err)
+		}
+	})
+
+	t.Run("rejects stale snapshot", func(t *testing.T) {
+		err := verify(newTestTrustRootSnapshot(t, rekorKey, time.Now().Add(-48*time.Hour)), 24*time.Hour)
+		if !errors.Is(err, ErrTrustRootStale) {
+			t.Fatalf("expected ErrTrustRootStale, got %v", err)
+		}
+	})
+
+	t.Run("rejects bundles from another log", func(t *testing.T) {
+		if err := verify(newTestTrustRootSnapshot(t, generateTestKey(t), time.Now()), 0); err == nil {
+			t.Fatal("expected Verify to fail with a different Rekor key")
+		}
+	})
+}
+
+// TestTrustRootSnapshot tests storing, validating, and hashing snapshots.
+func TestTrustRootSnapshot(t *testing.T) {
+	rekorKey := generateTestKey(t)
+	snapshot := newTestTrustRootSnapshot(t, rekorKey, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	data, err := snapshot.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	parsed, err := ParseTrustRootSnapshot(data)
+	if err != nil {
+		t.Fatalf("ParseTrustRootSnapshot failed: %v", err)
+	}
+	if !parsed.FetchedAt.Equal(snapshot.FetchedAt) || parsed.Source != snapshot.Source {
+		t.Errorf("parsed = %+v, want %+v", parsed, snapshot)
+	}
+
+	for name, data := range map[string]string{
+		"invalid JSON":    "{",
+		"no fetch time":   `{"trustedRoot": {}}`,
+		"no trust root":   `{"fetchedAt": "2026-01-02T03:04:05Z"}`,
+		"invalid content": `{"fetchedAt": "2026-01-02T03:04:05Z", "trustedRoot": {"tlogs": "invalid"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseTrustRootSnapshot([]byte(data)); err == nil {
+				t.Error("expected ParseTrustRootSnapshot to fail")
+			}
+		})
+	}
+
+	key := generateTestKey(t)
+	without := NewPublicKeyVerifier(&key.PublicKey).Policy()
+	with := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithTrustRootSnapshot(snapshot, 0)).Policy()
+	other := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey},
+		WithTrustRootSnapshot(newTestTrustRootSnapshot(t, generateTestKey(t), snapshot.FetchedAt), 0)).Policy()
+	if !PolicyChanged(&without, &with) || !PolicyChanged(&with, &other) {
+		t.Error("expected trust root snapshots to change the policy hash")
+	}
+
+	t.Run("refresh requires a trust root source", func(t *testing.T) {
+		if _, err := NewPublicKeyVerifier(&key.PublicKey).RefreshTrustRoot(context.Background()); err == nil {
+			t.Error("expected RefreshTrustRoot to fail without a snapshot or mirror")
+		}
+	})
+}
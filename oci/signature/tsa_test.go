@@ -0,0 +1,202 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"io"
+	"log"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitorus/timestamp"
+	"github.com/google/go-containerregistry/pkg/registry"
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+
+	ocibundle "github.com/jmgilman/go/oci"
+)
+
+// testTSA is a timestamp authority with a root and a signing certificate.
+type testTSA struct {
+	root *x509.Certificate
+	leaf *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestTSA creates a timestamp authority.
+func newTestTSA(t *testing.T) *testTSA {
+	t.Helper()
+
+	createCert := func(template, parent *x509.Certificate, pub *ecdsa.PublicKey, signer *ecdsa.PrivateKey) *x509.Certificate {
+		der, err := x509.CreateCertificate(nil, template, parent, pub, signer)
+		if err != nil {
+			t.Fatalf("failed to create certificate: %v", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatalf("failed to parse certificate: %v", err)
+		}
+		return cert
+	}
+
+	rootKey := generateTestKey(t)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test TSA Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	root := createCert(rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+
+	// Timestamping certificates must have a critical extended key usage
+	// extension containing only id-kp-timeStamping
+	eku, err := asn1.Marshal([]asn1.ObjectIdentifier{{1, 3, 6, 1, 5, 5, 7, 3, 8}})
+	if err != nil {
+		t.Fatalf("failed to encode extended key usage: %v", err)
+	}
+	key := generateTestKey(t)
+	leaf := createCert(&x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "Test TSA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		ExtraExtensions: []pkix.Extension{
+			{Id: asn1.ObjectIdentifier{2, 5, 29, 37}, Critical: true, Value: eku},
+		},
+	}, root, &key.PublicKey, rootKey)
+
+	return &testTSA{root: root, leaf: leaf, key: key}
+}
+
+// timestamp returns an RFC 3161 timestamp response for sig.
+func (a *testTSA) timestamp(t *testing.T, sig []byte) []byte {
+	t.Helper()
+
+	digest := sha256.Sum256(sig)
+	ts := timestamp.Timestamp{
+		HashAlgorithm:     crypto.SHA256,
+		HashedMessage:     digest[:],
+		Time:              time.Now(),
+		Policy:            asn1.ObjectIdentifier{1, 2, 3, 4, 1},
+		AddTSACertificate: true,
+	}
+	resp, err := ts.CreateResponseWithOpts(a.leaf, a.key, crypto.SHA256)
+	if err != nil {
+		t.Fatalf("failed to create timestamp: %v", err)
+	}
+	return resp
+}
+
+// TestWithTSACertificates tests verifying RFC 3161 timestamps embedded in signatures.
+func TestWithTSACertificates(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("app"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	reference := host + "/org/app:timestamped"
+	if err := pusher.Push(ctx, sourceDir, reference); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+
+	key := generateTestKey(t)
+	tsa := newTestTSA(t)
+	attachTestSignature(t, reference, key, func(_, sig []byte) static.Option {
+		return static.WithRFC3161Timestamp(&cbundle.RFC3161Timestamp{SignedRFC3161Timestamp: tsa.timestamp(t, sig)})
+	})
+
+	pull := func(t *testing.T, certs ...*x509.Certificate) error {
+		t.Helper()
+		verifier := NewPublicKeyVerifierWithOptions(
+			[]crypto.PublicKey{&key.PublicKey},
+			WithEnforceMode(true),
+			WithTSACertificates(certs...),
+		)
+		puller, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP(), ocibundle.WithSignatureVerifier(verifier))
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		return puller.Pull(ctx, reference, t.TempDir())
+	}
+
+	t.Run("verifies timestamp", func(t *testing.T) {
+		if err := pull(t, tsa.leaf, tsa.root); err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+	})
+
+	t.Run("verifies timestamp with root only", func(t *testing.T) {
+		if err := pull(t, tsa.root); err != nil {
+			t.Fatalf("Pull failed: %v", err)
+		}
+	})
+
+	t.Run("rejects timestamp from another TSA", func(t *testing.T) {
+		other := newTestTSA(t)
+		if err := pull(t, other.leaf, other.root); err == nil {
+			t.Fatal("expected Pull to fail with a different TSA")
+		}
+	})
+}
+
+// TestTSACertificatesPolicy tests validating and hashing TSA certificates.
+func TestTSACertificatesPolicy(t *testing.T) {
+	tsa := newTestTSA(t)
+	key := generateTestKey(t)
+
+	tests := []struct {
+		name    string
+		certs   []*x509.Certificate
+		wantErr string
+	}{
+		{name: "chain", certs: []*x509.Certificate{tsa.root, tsa.leaf}},
+		{name: "no root", certs: []*x509.Certificate{tsa.leaf}, wantErr: "no self-signed root"},
+		{name: "two signing certificates", certs: []*x509.Certificate{tsa.root, tsa.leaf, newTestTSA(t).leaf}, wantErr: "more than one"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithTSACertificates(tt.certs...)).Policy()
+			err := policy.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate failed: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	without := NewPublicKeyVerifier(&key.PublicKey).Policy()
+	with := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithTSACertificates(tsa.root)).Policy()
+	other := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, WithTSACertificates(newTestTSA(t).root)).Policy()
+	if !PolicyChanged(&without, &with) || !PolicyChanged(&with, &other) {
+		t.Error("expected TSA certificates to change the policy hash")
+	}
+}
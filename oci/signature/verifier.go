@@ -10,6 +10,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/sigstore/cosign/v2/pkg/cosign"
@@ -32,8 +34,30 @@ type CosignVerifier struct {
 	// cache stores verification results to avoid redundant operations
 	// Optional - if nil, no caching is performed
 	cache VerificationCache
+
+	// trustRoot holds the trust root fetched from the policy's TUF mirror
+	trustRoot trustRootCache
+
+	// resolver selects the policy for each reference
+	// Optional - if nil, the policy's repository overrides are used
+	resolver PolicyResolver
+
+	// auditLogger receives an event for every verification
+	// Optional - if nil, no events are emitted
+	auditLogger AuditLogger
+
+	// policyVerifiers holds the verifiers for policies selected per
+	// reference, keyed by policy hash and created on first use
+	policyVerifiers sync.Map
 }
 
+// PolicyResolver returns the policy to verify reference with. Returning nil
+// falls back to the verifier's own policy and its repository overrides.
+//
+// Resolvers are called on every verification and must be safe for concurrent
+// use.
+type PolicyResolver func(reference string) *Policy
+
 // NewPublicKeyVerifier creates a new CosignVerifier for public key verification.
 // This mode uses traditional public key cryptography where artifacts are signed
 // with a private key and verified with the corresponding public key.
@@ -133,6 +157,33 @@ func (v *CosignVerifier) WithCacheForVerifier(cache VerificationCache) *CosignVe
 	return v
 }
 
+// WithPolicyResolver sets a resolver that selects the policy for each
+// reference, so one client can apply different requirements to different
+// registries and repositories. Resolved policies share the verifier's cache;
+// the policy hash keeps their cached results apart.
+//
+// Example:
+//
+//	prod := signature.NewPolicy()
+//	prod.VerificationMode = signature.VerificationModeEnforce
+//	prod.PublicKeys = []crypto.PublicKey{releaseKey}
+//
+//	verifier := NewPublicKeyVerifierWithOptions(
+//	    []crypto.PublicKey{devKey},
+//	    WithOptionalMode(true),
+//	).WithPolicyResolver(func(reference string) *Policy {
+//	    if strings.HasPrefix(reference, "registry.example.com/prod/") {
+//	        return prod
+//	    }
+//	    return nil // Use the verifier's own policy
+//	})
+//
+// Note: This method returns the verifier to support method chaining.
+func (v *CosignVerifier) WithPolicyResolver(resolver PolicyResolver) *CosignVerifier {
+	v.resolver = resolver
+	return v
+}
+
 // NewKeylessVerifier creates a new CosignVerifier for keyless (OIDC) verification.
 // This mode uses Sigstore's keyless signing infrastructure where identities are
 // verified via OIDC providers (GitHub, Google, etc.) and short-lived certificates.
@@ -168,6 +219,35 @@ func NewKeylessVerifier(opts ...VerifierOption) *CosignVerifier {
 	return verifier
 }
 
+// NewVerifier creates a CosignVerifier from a complete policy, such as one
+// read by LoadPolicy. The policy is validated and copied, so later changes to
+// it do not affect the verifier.
+//
+// References matching one of the policy's Repositories are verified with that
+// repository's policy instead.
+//
+// Example:
+//
+//	policy, err := LoadPolicy(billy.NewLocal(), "policy.yaml")
+//	if err != nil {
+//	    return err
+//	}
+//	verifier, err := NewVerifier(policy)
+//	if err != nil {
+//	    return err
+//	}
+func NewVerifier(policy *Policy) (*CosignVerifier, error) {
+	if policy == nil {
+		return nil, fmt.Errorf("policy cannot be nil")
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+
+	copied := *policy
+	return &CosignVerifier{policy: &copied}, nil
+}
+
 // Verify validates the signature for the given OCI artifact.
 // This method implements the ocibundle.SignatureVerifier interface.
 //
@@ -179,10 +259,78 @@ func NewKeylessVerifier(opts ...VerifierOption) *CosignVerifier {
 //  5. Verify the cryptographic signature matches the artifact digest
 //  6. Validate policy requirements (identity, annotations, etc.)
 //  7. Optionally verify transparency log inclusion (Rekor)
-//  8. Store verification result in cache (if caching enabled)
+//  8. Optionally verify attached SLSA provenance and SBOM attestations
+//  9. Store verification result in cache (if caching enabled)
+//
+// The policy is selected per reference: the policy returned by the resolver
+// set with WithPolicyResolver, else the first repository override matching
+// reference, else the verifier's own policy.
 //
 // Returns nil if verification succeeds, or a BundleError with details if it fails.
 func (v *CosignVerifier) Verify(ctx context.Context, reference string, descriptor *orasint.PullDescriptor) error {
+	_, _, err := v.verifyAndAudit(ctx, reference, descriptor, false)
+	return err
+}
+
+// Refresh verifies the signature for the given OCI artifact like Verify, but
+// ignores any cached result and replaces it with the new one. Use it to
+// re-verify an artifact whose failure is cached (see WithNegativeCacheTTL)
+// after publishing a new signature, or to re-check a cached success.
+//
+// Example:
+//
+//	if err := verifier.Refresh(ctx, reference, descriptor); err != nil {
+//	    return err
+//	}
+func (v *CosignVerifier) Refresh(ctx context.Context, reference string, descriptor *orasint.PullDescriptor) error {
+	_, _, err := v.verifyAndAudit(ctx, reference, descriptor, true)
+	return err
+}
+
+// verifyAndAudit verifies descriptor with the verifier for reference and
+// emits the audit event. It returns that verifier and the result. If refresh
+// is true, cached results are ignored.
+func (v *CosignVerifier) verifyAndAudit(
+	ctx context.Context,
+	reference string,
+	descriptor *orasint.PullDescriptor,
+	refresh bool,
+) (*CosignVerifier, verifyResult, error) {
+	start := time.Now()
+	verifier := v.verifierFor(reference)
+
+	var result verifyResult
+	err := verifier.verify(ctx, reference, descriptor, refresh, &result)
+
+	v.audit(verifier, start, reference, descriptor, result, err)
+	return verifier, result, err
+}
+
+// verifyResult records how a verification that did not fail was decided.
+type verifyResult struct {
+	// signed is true if the artifact had signatures satisfying the policy
+	signed bool
+
+	// signer is the identity of the signer, if known
+	signer string
+
+	// cacheHit is true if the result was served from the cache
+	cacheHit bool
+
+	// signatures holds the signatures that verified cryptographically,
+	// before the certificate policy was applied
+	signatures []oci.Signature
+}
+
+// verify verifies descriptor against v's policy, recording the outcome in
+// result. If refresh is true, cached results are ignored.
+func (v *CosignVerifier) verify(
+	ctx context.Context,
+	reference string,
+	descriptor *orasint.PullDescriptor,
+	refresh bool,
+	result *verifyResult,
+) error {
 	// Validate input parameters
 	if err := v.validateVerifyInputs(reference, descriptor); err != nil {
 		return err
@@ -191,7 +339,7 @@ func (v *CosignVerifier) Verify(ctx context.Context, reference string, descripto
 	// Check cache if enabled
 	// Note: Cache implementation MUST validate TTL expiration before returning results
 	// (see VerificationCache interface documentation for security requirements)
-	if v.cache != nil {
+	if v.cache != nil && !refresh {
 		policyHash := ComputePolicyHash(v.policy)
 		verified, signer, err := v.cache.GetCachedVerification(ctx, descriptor.Digest, policyHash)
 
@@ -202,13 +350,26 @@ func (v *CosignVerifier) Verify(ctx context.Context, reference string, descripto
 				// Note: We trust the cached result because:
 				//   1. The policy hash matches (policy hasn't changed)
 				//   2. Cache validated TTL (result is not stale)
+				result.signed = true
+				result.signer = signer
+				result.cacheHit = true
 				return nil
 			}
-			// Previous verification failed - return the cached failure
-			// For cached failures, we re-verify to get fresh error details
-			// (Alternatively, we could cache the error details too)
-			// Fall through to perform fresh verification
-			_ = signer // Signer info available from cache if needed
+			// Previous verification failed - return the cached failure if
+			// negative caching is enabled, otherwise re-verify
+			if v.policy.NegativeCacheTTL > 0 {
+				result.cacheHit = true
+				return &ocibundle.BundleError{
+					Op:        "verify",
+					Reference: reference,
+					Err:       ocibundle.ErrSignatureInvalid,
+					SignatureInfo: &ocibundle.SignatureErrorInfo{
+						Digest:       descriptor.Digest,
+						Reason:       "Verification failed recently and the failure is cached; use Refresh to re-verify",
+						FailureStage: "cache",
+					},
+				}
+			}
 		}
 		// Cache miss, expired, or error - proceed with verification
 		// Cache errors are logged but don't fail verification (cache is optional)
@@ -231,7 +392,7 @@ func (v *CosignVerifier) Verify(ctx context.Context, reference string, descripto
 	}
 
 	// Convert policy to Cosign CheckOpts
-	checkOpts, err := policyToCheckOpts(ctx, v.policy)
+	checkOpts, err := policyToCheckOpts(ctx, v.policy, &v.trustRoot)
 	if err != nil {
 		return &ocibundle.BundleError{
 			Op:        "verify",
@@ -245,15 +406,41 @@ func (v *CosignVerifier) Verify(ctx context.Context, reference string, descripto
 		}
 	}
 
-	// Fetch and verify signatures using Cosign's high-level API
-	// This replaces our manual signature discovery and verification
-	verifiedSignatures, _, err := cosign.VerifyImageSignatures(ctx, ref, checkOpts)
+	// Fetch and verify signatures concurrently
+	verifiedSignatures, totalSignatures, err := v.verifySignatures(ctx, ref, checkOpts)
 	if err != nil {
-		return v.handleVerificationError(err, reference, descriptor)
+		if verifyErr := v.handleVerificationError(err, reference, descriptor); verifyErr != nil {
+			// Invalid signatures are definitive; other errors may be transient
+			var unverified *unverifiedSignaturesError
+			if errors.As(err, &unverified) {
+				v.storeCachedVerification(ctx, descriptor.Digest, false, "")
+			}
+			return verifyErr
+		}
+		// Missing signature allowed by policy - attestations are still required
+		return v.checkAttestations(ctx, ref, checkOpts, reference, descriptor)
 	}
 
+	result.signatures = verifiedSignatures
+
+	// Drop signatures whose certificates do not meet the certificate policy
+	verifiedSignatures, certErr := v.filterByCertificate(verifiedSignatures)
+
 	// Count verified signatures
 	validCount := len(verifiedSignatures)
+	if validCount == 0 && certErr != nil {
+		v.storeCachedVerification(ctx, descriptor.Digest, false, "")
+		return &ocibundle.BundleError{
+			Op:        "verify",
+			Reference: reference,
+			Err:       ocibundle.ErrUntrustedSigner,
+			SignatureInfo: &ocibundle.SignatureErrorInfo{
+				Digest:       descriptor.Digest,
+				Reason:       fmt.Sprintf("No signing certificate satisfies the certificate policy: %s", certErr.Error()),
+				FailureStage: "policy",
+			},
+		}
+	}
 	if validCount == 0 {
 		// No signatures found
 		if v.policy.VerificationMode == VerificationModeEnforce {
@@ -269,26 +456,91 @@ func (v *CosignVerifier) Verify(ctx context.Context, reference string, descripto
 			}
 		}
 		// Optional or Required mode: missing signature is allowed
-		return nil
+		return v.checkAttestations(ctx, ref, checkOpts, reference, descriptor)
 	}
 
 	// Apply our multi-signature policy logic
-	// Cosign verifies each signature individually, but we need to check
+	// Each signature is verified individually, but we need to check
 	// if the number of valid signatures meets our policy requirements
-	// Note: For public key mode with multiple keys, Cosign returns all
-	// signatures that verified with ANY of the keys. We need to apply
-	// our multi-signature logic on top of this.
-	totalSignatures := validCount // Cosign only returns verified signatures
+	// Note: For public key mode with multiple keys, a signature is valid
+	// if it verified with ANY of the keys. We need to apply our
+	// multi-signature logic on top of this.
 	if err := v.checkSignaturePolicy(validCount, totalSignatures, reference, descriptor.Digest); err != nil {
 		// Store failed verification in cache (if enabled)
 		v.storeCachedVerification(ctx, descriptor.Digest, false, "")
 		return err
 	}
 
+	// Verify attached provenance and SBOM if the policy requires them
+	if err := v.checkAttestations(ctx, ref, checkOpts, reference, descriptor); err != nil {
+		v.storeCachedVerification(ctx, descriptor.Digest, false, "")
+		return err
+	}
+
 	// Verification succeeded - store result in cache (if enabled)
 	// Extract signer identity if available
 	signer := v.extractSignerFromVerifiedSignatures(verifiedSignatures)
 	v.storeCachedVerification(ctx, descriptor.Digest, true, signer)
+	result.signed = true
+	result.signer = signer
+
+	return nil
+}
+
+// verifierFor returns the verifier for the policy that applies to reference.
+func (v *CosignVerifier) verifierFor(reference string) *CosignVerifier {
+	policy := v.policy
+	if v.resolver != nil {
+		if resolved := v.resolver(reference); resolved != nil {
+			policy = resolved
+		}
+	}
+
+	policy = policy.ForReference(reference)
+	if policy == v.policy {
+		return v
+	}
+	return v.policyVerifier(policy)
+}
+
+// policyVerifier returns the verifier for a policy selected per reference.
+// Verifiers are keyed by policy hash, so resolvers may build a new *Policy on
+// every call without growing the set of verifiers.
+func (v *CosignVerifier) policyVerifier(policy *Policy) *CosignVerifier {
+	key := ComputePolicyHash(policy)
+	if verifier, ok := v.policyVerifiers.Load(key); ok {
+		return verifier.(*CosignVerifier)
+	}
+	verifier, _ := v.policyVerifiers.LoadOrStore(key, &CosignVerifier{policy: policy, cache: v.cache})
+	return verifier.(*CosignVerifier)
+}
+
+// checkAttestations verifies the attestations of an artifact if the policy
+// requires provenance or an SBOM. Attestations are fetched once for both.
+func (v *CosignVerifier) checkAttestations(
+	ctx context.Context,
+	ref name.Reference,
+	checkOpts *cosign.CheckOpts,
+	reference string,
+	descriptor *orasint.PullDescriptor,
+) error {
+	if v.policy.Provenance == nil && v.policy.SBOM == nil {
+		return nil
+	}
+
+	attestations, err := fetchAttestations(ctx, ref, checkOpts, reference, descriptor)
+	if err != nil {
+		return err
+	}
+
+	if v.policy.Provenance != nil {
+		if err := v.verifyProvenance(attestations, reference, descriptor); err != nil {
+			return err
+		}
+	}
+	if v.policy.SBOM != nil {
+		return v.verifySBOM(attestations, reference, descriptor)
+	}
 
 	return nil
 }
@@ -301,8 +553,16 @@ func (v *CosignVerifier) storeCachedVerification(ctx context.Context, digest str
 		return // Caching not enabled
 	}
 
-	policyHash := ComputePolicyHash(v.policy)
+	// Failures are only cached with negative caching enabled, and expire sooner
 	ttl := v.policy.CacheTTL
+	if !verified {
+		if v.policy.NegativeCacheTTL <= 0 {
+			return
+		}
+		ttl = v.policy.NegativeCacheTTL
+	}
+
+	policyHash := ComputePolicyHash(v.policy)
 
 	// Attempt to store in cache - errors are logged but don't fail verification
 	if err := v.cache.PutCachedVerification(ctx, digest, policyHash, verified, signer, ttl); err != nil {
@@ -398,7 +658,8 @@ func (v *CosignVerifier) Policy() Policy {
 //
 // The function checks:
 // 1. OCI/ORAS standard error definitions (errdef.ErrNotFound)
-// 2. String matching as a fallback for non-standard registries
+// 2. Cosign's error for artifacts without any signatures
+// 3. String matching as a fallback for non-standard registries
 //
 // This prevents security issues from misclassifying network errors or
 // other transient failures as "not found" errors.
@@ -413,6 +674,12 @@ func isNotFoundError(err error) bool {
 		return true
 	}
 
+	// Cosign reports a missing signature image this way
+	var noSignatures *cosign.ErrNoSignaturesFound
+	if errors.As(err, &noSignatures) || errors.Is(err, errNoSignatures) {
+		return true
+	}
+
 	// Fallback: String matching for registries that don't use standard error types
 	// This is kept as a last resort for compatibility but is less reliable
 	errStr := strings.ToLower(err.Error())
@@ -456,8 +723,23 @@ func validateKeyStrength(pubKey crypto.PublicKey) error {
 
 // handleVerificationError processes verification errors and applies policy rules.
 func (v *CosignVerifier) handleVerificationError(err error, reference string, descriptor *orasint.PullDescriptor) error {
+	// Signatures that exist but fail verification are invalid, never missing
+	var unverified *unverifiedSignaturesError
+	if errors.As(err, &unverified) {
+		return &ocibundle.BundleError{
+			Op:        "verify",
+			Reference: reference,
+			Err:       fmt.Errorf("%w: %w", ocibundle.ErrSignatureInvalid, err),
+			SignatureInfo: &ocibundle.SignatureErrorInfo{
+				Digest:       descriptor.Digest,
+				Reason:       err.Error(),
+				FailureStage: determineFailureStage(err),
+			},
+		}
+	}
+
 	// Check if error indicates no signatures found
-	if isNotFoundError(err) || strings.Contains(err.Error(), "no matching signatures") {
+	if isNotFoundError(err) {
 		// Signature not found - apply verification mode policy
 		switch v.policy.VerificationMode {
 		case VerificationModeEnforce:
@@ -8,14 +8,26 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/sigstore/pkg/signature/kms/fake"
+
+	"github.com/jmgilman/go/fs/billy"
 	ocibundle "github.com/jmgilman/go/oci"
 	orasint "github.com/jmgilman/go/oci/internal/oras"
 )
@@ -299,6 +311,76 @@ func TestLoadPublicKeyFromFile(t *testing.T) {
 	}
 }
 
+// TestLoadPublicKeys tests loading a directory of public keys.
+func TestLoadPublicKeys(t *testing.T) {
+	fsys := billy.NewMemory()
+	write := func(t *testing.T, name string, data []byte) {
+		t.Helper()
+		if err := fsys.WriteFile(name, data, 0o644); err != nil {
+			t.Fatalf("failed to write key file: %v", err)
+		}
+	}
+
+	first, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	second, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	write(t, "keys/b-release.pem", encodePublicKeyPEM(&second.PublicKey))
+	write(t, "keys/a-release.pub", encodePublicKeyPEM(&first.PublicKey))
+	write(t, "keys/README.md", []byte("not a key"))
+	write(t, "keys/.old.pub", []byte("not a key"))
+	write(t, "keys/retired/old.pub", []byte("not a key"))
+
+	keys, err := LoadPublicKeys(fsys, "keys")
+	if err != nil {
+		t.Fatalf("LoadPublicKeys failed: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("got %d keys, want 2", len(keys))
+	}
+	if !first.PublicKey.Equal(keys[0]) || !second.PublicKey.Equal(keys[1]) {
+		t.Error("expected keys in file name order")
+	}
+
+	write(t, "broken/bad.pub", []byte("not a key"))
+	if _, err := LoadPublicKeys(fsys, "broken"); err == nil || !strings.Contains(err.Error(), "broken/bad.pub") {
+		t.Errorf("expected error naming the invalid key file, got %v", err)
+	}
+
+	write(t, "empty/README.md", []byte("no keys"))
+	if _, err := LoadPublicKeys(fsys, "empty"); err == nil {
+		t.Error("expected error for a directory without keys")
+	}
+
+	if _, err := LoadPublicKeys(fsys, "missing"); err == nil {
+		t.Error("expected error for a missing directory")
+	}
+}
+
+// TestLoadPublicKeyFromKMS tests loading public keys through KMS providers.
+func TestLoadPublicKeyFromKMS(t *testing.T) {
+	ctx := context.Background()
+
+	key, err := LoadPublicKeyFromKMS(ctx, fake.ReferenceScheme+"release")
+	if err != nil {
+		t.Fatalf("LoadPublicKeyFromKMS failed: %v", err)
+	}
+	if _, ok := key.(*ecdsa.PublicKey); !ok {
+		t.Errorf("expected an ECDSA key, got %T", key)
+	}
+
+	if _, err := LoadPublicKeyFromKMS(ctx, "cosign.pub"); err == nil {
+		t.Error("expected error for a key reference without a scheme")
+	}
+	if _, err := LoadPublicKeyFromKMS(ctx, "unknownkms://release"); err == nil {
+		t.Error("expected error for a key reference without a registered provider")
+	}
+}
+
 // Note: TestBuildSignatureReference has been removed because signature reference
 // construction is now handled internally by Cosign's VerifyImageSignatures API.
 // This was an implementation detail that is no longer exposed.
@@ -460,6 +542,225 @@ func TestVerifierOptions(t *testing.T) {
 	})
 }
 
+// TestTrustRootOptions tests configuring private Sigstore trust roots.
+func TestTrustRootOptions(t *testing.T) {
+	rekorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate Rekor key: %v", err)
+	}
+	root := testFulcioRoot(t)
+	mirror := "https://tuf.sigstore.example.com"
+
+	verifier := NewKeylessVerifier(
+		WithAllowedIdentities("*@example.com"),
+		WithFulcioRoots(root),
+		WithRekorURL("https://rekor.sigstore.example.com"),
+		WithRekorPublicKey(&rekorKey.PublicKey),
+		WithTUFMirror(mirror, []byte("{}")),
+	)
+	policy := verifier.Policy()
+	if len(policy.FulcioRoots) != 1 || policy.FulcioRoots[0] != root {
+		t.Error("expected Fulcio roots to be set")
+	}
+	if policy.RekorPublicKey != &rekorKey.PublicKey {
+		t.Error("expected Rekor public key to be set")
+	}
+	if policy.TUFMirror != mirror || string(policy.TUFRoot) != "{}" {
+		t.Errorf("expected TUF mirror %s, got %s", mirror, policy.TUFMirror)
+	}
+	if err := policy.Validate(); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+
+	t.Run("uses the configured Rekor key", func(t *testing.T) {
+		mirrorKeys := cosign.NewTrustedTransparencyLogPubKeys()
+		trust := &trustRootCache{root: &mirrorTrustRoot{
+			roots:         x509.NewCertPool(),
+			intermediates: x509.NewCertPool(),
+			ctLogKeys:     &mirrorKeys,
+			rekorKeys:     &mirrorKeys,
+		}}
+
+		checkOpts, err := policyToCheckOpts(context.Background(), &policy, trust)
+		if err != nil {
+			t.Fatalf("failed to create check options: %v", err)
+		}
+		if checkOpts.RekorPubKeys == nil || len(checkOpts.RekorPubKeys.Keys) != 1 {
+			t.Error("expected the configured Rekor key to be trusted")
+		}
+		roots := x509.NewCertPool()
+		roots.AddCert(root)
+		if !checkOpts.RootCerts.Equal(roots) {
+			t.Error("expected the configured Fulcio roots to be trusted")
+		}
+		if checkOpts.CTLogPubKeys != &mirrorKeys {
+			t.Error("expected CT log keys from the TUF mirror")
+		}
+	})
+
+	t.Run("uses trust roots from the TUF mirror", func(t *testing.T) {
+		policy := NewKeylessVerifier(
+			WithAllowedIdentities("*@example.com"),
+			WithTUFMirror(mirror, nil),
+		).Policy()
+		mirrorRoot := &mirrorTrustRoot{
+			roots:         x509.NewCertPool(),
+			intermediates: x509.NewCertPool(),
+		}
+		ctLogKeys := cosign.NewTrustedTransparencyLogPubKeys()
+		mirrorRoot.ctLogKeys = &ctLogKeys
+
+		checkOpts, err := policyToCheckOpts(context.Background(), &policy, &trustRootCache{root: mirrorRoot})
+		if err != nil {
+			t.Fatalf("failed to create check options: %v", err)
+		}
+		if checkOpts.RootCerts != mirrorRoot.roots || checkOpts.IntermediateCerts != mirrorRoot.intermediates {
+			t.Error("expected Fulcio roots from the TUF mirror")
+		}
+	})
+
+	t.Run("rejects insecure TUF mirrors", func(t *testing.T) {
+		policy := NewKeylessVerifier(
+			WithAllowedIdentities("*@example.com"),
+			WithTUFMirror("http://tuf.sigstore.example.com", nil),
+		).Policy()
+		if err := policy.Validate(); err == nil {
+			t.Error("expected error for HTTP TUF mirror")
+		}
+	})
+
+	t.Run("rejects Fulcio roots with public keys", func(t *testing.T) {
+		policy := NewPublicKeyVerifierWithOptions(
+			[]crypto.PublicKey{&rekorKey.PublicKey},
+			WithFulcioRoots(root),
+		).Policy()
+		if err := policy.Validate(); err == nil {
+			t.Error("expected error for Fulcio roots in public key mode")
+		}
+	})
+
+	t.Run("changes the policy hash", func(t *testing.T) {
+		base := NewKeylessVerifier(WithAllowedIdentities("*@example.com")).Policy()
+		if !PolicyChanged(&base, &policy) {
+			t.Error("expected trust roots to change the policy hash")
+		}
+	})
+
+	t.Run("distinguishes Fulcio roots with the same subject", func(t *testing.T) {
+		other := NewKeylessVerifier(
+			WithAllowedIdentities("*@example.com"),
+			WithFulcioRoots(testFulcioRoot(t)),
+		).Policy()
+		mine := NewKeylessVerifier(
+			WithAllowedIdentities("*@example.com"),
+			WithFulcioRoots(root),
+		).Policy()
+		if !PolicyChanged(&mine, &other) {
+			t.Error("expected Fulcio roots with different keys to change the policy hash")
+		}
+	})
+}
+
+// testFulcioRoot creates a self-signed Fulcio root certificate with a new key.
+// Every root has the same subject.
+func testFulcioRoot(t *testing.T) *x509.Certificate {
+	t.Helper()
+
+	key := generateTestKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Fulcio Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return cert
+}
+
+// TestWithPolicyResolver tests selecting the verification policy per reference.
+func TestWithPolicyResolver(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("app"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	for _, reference := range []string{host + "/dev/app:v1", host + "/prod/app:v1"} {
+		if err := pusher.Push(ctx, sourceDir, reference); err != nil {
+			t.Fatalf("Push failed: %v", err)
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	calls := 0
+	verifier := NewPublicKeyVerifierWithOptions(
+		[]crypto.PublicKey{&key.PublicKey},
+		WithEnforceMode(true),
+	).WithPolicyResolver(func(reference string) *Policy {
+		if !strings.HasPrefix(reference, host+"/dev/") {
+			return nil
+		}
+		calls++
+		// A new policy on every call
+		policy := NewPolicy()
+		policy.PublicKeys = []crypto.PublicKey{&key.PublicKey}
+		policy.VerificationMode = VerificationModeOptional
+		return policy
+	})
+
+	puller, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP(), ocibundle.WithSignatureVerifier(verifier))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	t.Run("resolved policy allows unsigned artifacts", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			if err := puller.Pull(ctx, host+"/dev/app:v1", t.TempDir()); err != nil {
+				t.Fatalf("Pull failed: %v", err)
+			}
+		}
+		if calls != 2 {
+			t.Errorf("resolver called %d times, want 2", calls)
+		}
+
+		count := 0
+		verifier.policyVerifiers.Range(func(_, _ any) bool {
+			count++
+			return true
+		})
+		if count != 1 {
+			t.Errorf("expected equal resolved policies to share a verifier, got %d verifiers", count)
+		}
+	})
+
+	t.Run("unresolved references use the verifier policy", func(t *testing.T) {
+		err := puller.Pull(ctx, host+"/prod/app:v1", t.TempDir())
+		if !errors.Is(err, ocibundle.ErrSignatureNotFound) {
+			t.Errorf("expected ErrSignatureNotFound, got %v", err)
+		}
+	})
+}
+
 // Helper function to encode a public key as PEM.
 func encodePublicKeyPEM(pubKey crypto.PublicKey) []byte {
 	derBytes, err := x509.MarshalPKIXPublicKey(pubKey)
@@ -472,3 +773,137 @@ func encodePublicKeyPEM(pubKey crypto.PublicKey) []byte {
 	}
 	return pem.EncodeToMemory(block)
 }
+
+// TestWithNegativeCacheTTL tests caching verification failures.
+func TestWithNegativeCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int64
+	handler := registry.New(registry.Logger(log.New(io.Discard, "", 0)))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	signer, err := NewPublicKeySigner(key)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	sourceDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sourceDir, "app.txt"), []byte("app"), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	pusher, err := ocibundle.NewWithOptions(ocibundle.WithAllowHTTP())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	reference := host + "/org/app:v1"
+	if err := pusher.PushSigned(ctx, sourceDir, reference, signer); err != nil {
+		t.Fatalf("PushSigned failed: %v", err)
+	}
+
+	descriptor := &orasint.PullDescriptor{
+		Digest:    "sha256:abc123def456",
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Size:      1024,
+	}
+	failureStage := func(err error) string {
+		var bundleErr *ocibundle.BundleError
+		if !errors.As(err, &bundleErr) || bundleErr.SignatureInfo == nil {
+			t.Fatalf("expected a BundleError with signature info, got %v", err)
+		}
+		return bundleErr.SignatureInfo.FailureStage
+	}
+
+	// One signature never satisfies a two-signature policy
+	newVerifier := func(cache VerificationCache, opts ...VerifierOption) *CosignVerifier {
+		opts = append([]VerifierOption{WithMinimumSignatures(2)}, opts...)
+		return NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&key.PublicKey}, opts...).WithCacheForVerifier(cache)
+	}
+
+	t.Run("caches failures", func(t *testing.T) {
+		cache := newMemoryVerificationCache()
+		verifier := newVerifier(cache, WithNegativeCacheTTL(2*time.Minute))
+
+		if err := verifier.Verify(ctx, reference, descriptor); failureStage(err) != "policy" {
+			t.Fatalf("expected a policy failure, got %v", err)
+		}
+		if len(cache.entries) != 1 {
+			t.Fatalf("got %d cache entries, want 1", len(cache.entries))
+		}
+		for _, entry := range cache.entries {
+			if entry.verified || entry.ttl != 2*time.Minute {
+				t.Errorf("cached entry = %+v, want a failure with the negative cache TTL", entry)
+			}
+		}
+
+		before := requests.Load()
+		err := verifier.Verify(ctx, reference, descriptor)
+		if !errors.Is(err, ocibundle.ErrSignatureInvalid) || failureStage(err) != "cache" {
+			t.Fatalf("expected the cached failure, got %v", err)
+		}
+		if requests.Load() != before {
+			t.Error("expected a cached failure not to contact the registry")
+		}
+
+		if err := verifier.Refresh(ctx, reference, descriptor); failureStage(err) != "policy" {
+			t.Fatalf("expected Refresh to re-verify, got %v", err)
+		}
+		if requests.Load() == before {
+			t.Error("expected Refresh to contact the registry")
+		}
+	})
+
+	t.Run("caches invalid signatures", func(t *testing.T) {
+		wrongKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+
+		for _, opts := range [][]VerifierOption{{}, {WithEnforceMode(true)}} {
+			cache := newMemoryVerificationCache()
+			opts = append(opts, WithNegativeCacheTTL(2*time.Minute))
+			verifier := NewPublicKeyVerifierWithOptions([]crypto.PublicKey{&wrongKey.PublicKey}, opts...).
+				WithCacheForVerifier(cache)
+
+			err := verifier.Verify(ctx, reference, descriptor)
+			if !errors.Is(err, ocibundle.ErrSignatureInvalid) {
+				t.Fatalf("%s mode: expected an invalid signature, got %v", verifier.Policy().VerificationMode, err)
+			}
+			if len(cache.entries) != 1 {
+				t.Fatalf("%s mode: got %d cache entries, want 1", verifier.Policy().VerificationMode, len(cache.entries))
+			}
+
+			err = verifier.Verify(ctx, reference, descriptor)
+			if !errors.Is(err, ocibundle.ErrSignatureInvalid) || failureStage(err) != "cache" {
+				t.Fatalf("%s mode: expected the cached failure, got %v", verifier.Policy().VerificationMode, err)
+			}
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		cache := newMemoryVerificationCache()
+		verifier := newVerifier(cache)
+
+		for range 2 {
+			if err := verifier.Verify(ctx, reference, descriptor); failureStage(err) != "policy" {
+				t.Fatalf("expected a policy failure, got %v", err)
+			}
+		}
+		if len(cache.entries) != 0 {
+			t.Errorf("expected failures not to be cached, got %d entries", len(cache.entries))
+		}
+	})
+
+	t.Run("rejects negative TTL", func(t *testing.T) {
+		policy := newVerifier(nil, WithNegativeCacheTTL(-time.Minute)).Policy()
+		if err := policy.Validate(); err == nil {
+			t.Error("expected negative TTL to be invalid")
+		}
+	})
+}
@@ -108,3 +108,41 @@ type SignatureVerifier interface {
 	//   - Cache results internally (caching is handled by the client)
 	Verify(ctx context.Context, reference string, descriptor *orasint.PullDescriptor) error
 }
+
+// SignatureSigner signs OCI artifacts after they have been pushed.
+// Like SignatureVerifier, this interface keeps the main oci package free of
+// signing library dependencies; implementations live in the oci/signature
+// submodule.
+//
+// When a signer is configured, Push calls Sign once the artifact has been
+// uploaded. The push annotations (see WithAnnotations) are propagated into
+// the signature payload annotations automatically, so verification policies
+// built with signature.WithRequiredAnnotations can be satisfied without a
+// separate signing step. Annotations set with WithSignatureAnnotations are
+// merged on top and take precedence.
+//
+// Example usage:
+//
+//	client, err := oci.NewWithOptions(
+//	    oci.WithSignatureSigner(signer),
+//	)
+//
+//	// The signature payload will carry build-id and git-commit annotations
+//	err = client.Push(ctx, "./app", "ghcr.io/org/app:v1.0",
+//	    oci.WithAnnotations(map[string]string{
+//	        "build-id":   "12345",
+//	        "git-commit": "abc123",
+//	    }),
+//	)
+type SignatureSigner interface {
+	// Sign creates and publishes a signature for the given OCI artifact.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - reference: Full OCI reference the artifact was pushed to
+	//   - digest: Digest of the pushed manifest
+	//   - annotations: Annotations to embed in the signature payload
+	//
+	// Returns nil if the signature was created and published successfully.
+	Sign(ctx context.Context, reference, digest string, annotations map[string]string) error
+}
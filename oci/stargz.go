@@ -4,6 +4,7 @@
 package ocibundle
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"errors"
@@ -48,31 +49,16 @@ func testBlobRangeSupport(ctx context.Context, httpClient *http.Client, blobURL
 	return resp.StatusCode == http.StatusPartialContent
 }
 
-// getBlobReaderAt attempts to create an io.ReaderAt for a blob, preferring HTTP Range requests
-// but falling back to reading the full blob into memory if necessary.
-// Returns the ReaderAt and size, or an error if both approaches fail.
-func getBlobReaderAt(
-	ctx context.Context,
-	repo *remote.Repository,
-	digest string,
-	descriptorData io.ReadCloser,
-	descriptorSize int64,
-) (io.ReaderAt, int64, error) {
-	blobURL, httpClient, urlErr := getBlobURLFromRepository(repo, digest)
-	if urlErr == nil && testBlobRangeSupport(ctx, httpClient, blobURL) {
-		// Registry supports Range requests - use HTTP Range seeker
-		_ = descriptorData.Close() // Close the full download stream
-		rangeSeeker := newHTTPRangeSeeker(httpClient, blobURL)
-		return newReaderAtFromSeeker(rangeSeeker, descriptorSize), descriptorSize, nil
-	}
-
-	// Fallback: Read full blob into memory
-	blobData, err := io.ReadAll(descriptorData)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to read blob data: %w", err)
+// openBlobRange returns an io.ReaderAt that fetches a blob lazily using HTTP
+// Range requests. The second return value is false if the blob URL cannot be
+// derived from the repository or the registry does not honor Range requests,
+// in which case the caller should fall back to downloading the full blob.
+func openBlobRange(ctx context.Context, repo *remote.Repository, digest string, size int64) (*httpRangeReaderAt, bool) {
+	blobURL, httpClient, err := getBlobURLFromRepository(repo, digest)
+	if err != nil || !testBlobRangeSupport(ctx, httpClient, blobURL) {
+		return nil, false
 	}
-
-	return bytes.NewReader(blobData), int64(len(blobData)), nil
+	return newHTTPRangeReaderAt(ctx, httpClient, blobURL, size), true
 }
 
 // newHTTPRangeSeeker creates an HTTP Range request seeker for a blob URL.
@@ -373,6 +359,104 @@ func (r *readerAtFromSeeker) Size() int64 {
 	return r.size
 }
 
+// rangeBlockSize is the minimum number of bytes fetched per HTTP Range request.
+// Decompressors read in small increments, so reads are rounded up to a block
+// and served from it until they leave its bounds.
+const rangeBlockSize = 256 * 1024
+
+// httpRangeReaderAt implements io.ReaderAt over a registry blob using bounded
+// HTTP Range requests, so only the regions that are read are downloaded.
+// The context is retained because io.ReaderAt has no way to pass one per call.
+type httpRangeReaderAt struct {
+	ctx        context.Context
+	httpClient *http.Client
+	blobURL    string
+	size       int64
+
+	mu         sync.Mutex
+	block      []byte
+	blockStart int64
+	fetched    int64
+}
+
+// newHTTPRangeReaderAt creates a ReaderAt for the blob at blobURL with the given size.
+func newHTTPRangeReaderAt(ctx context.Context, httpClient *http.Client, blobURL string, size int64) *httpRangeReaderAt {
+	return &httpRangeReaderAt{
+		ctx:        ctx,
+		httpClient: httpClient,
+		blobURL:    blobURL,
+		size:       size,
+	}
+}
+
+// ReadAt implements io.ReaderAt, fetching at least rangeBlockSize bytes per request.
+// This method is thread-safe via mutex serialization.
+func (r *httpRangeReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("invalid offset %d", off)
+	}
+	if off >= r.size {
+		return 0, io.EOF
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	end := min(off+int64(len(p)), r.size)
+	if off < r.blockStart || end > r.blockStart+int64(len(r.block)) {
+		block, err := r.fetch(off, min(max(end, off+rangeBlockSize), r.size))
+		if err != nil {
+			return 0, err
+		}
+		r.block = block
+		r.blockStart = off
+	}
+
+	n := copy(p, r.block[off-r.blockStart:end-r.blockStart])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// fetch downloads the blob bytes in [start, end) with a single Range request.
+func (r *httpRangeReaderAt) fetch(start, end int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(r.ctx, http.MethodGet, r.blobURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create range request: %w", err)
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end-1))
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("range request for bytes %d-%d failed: %w", start, end-1, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusPartialContent {
+		return nil, fmt.Errorf("range request for bytes %d-%d returned status %d", start, end-1, resp.StatusCode)
+	}
+
+	block := make([]byte, end-start)
+	if _, err := io.ReadFull(resp.Body, block); err != nil {
+		return nil, fmt.Errorf("failed to read bytes %d-%d: %w", start, end-1, err)
+	}
+	r.fetched += end - start
+
+	return block, nil
+}
+
+// Fetched returns the total number of bytes downloaded so far.
+func (r *httpRangeReaderAt) Fetched() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.fetched
+}
+
+// errNotStargz indicates that a blob could not be opened as an eStargz archive,
+// e.g. because it is a plain tar.gz pushed by another tool.
+var errNotStargz = errors.New("blob is not an eStargz archive")
+
 // extractSelectiveFromStargz extracts files matching patterns from an eStargz archive
 // using an io.ReaderAt (typically from HTTP Range requests for bandwidth savings).
 //
@@ -454,7 +538,7 @@ func collectStargzEntries(ctx context.Context, stargzReader *estargz.Reader, pat
 }
 
 // extractStargzEntry extracts a single entry from the stargz archive.
-func extractStargzEntry(ctx context.Context, stargzReader *estargz.Reader, entryName string, targetDir string, validators *ValidatorChain, fsys core.FS) error {
+func extractStargzEntry(ctx context.Context, stargzReader *estargz.Reader, entryName string, targetDir string, validators *ValidatorChain, links *linkExtractor, metadata *metadataRestorer, fsys core.FS) error {
 	if err := isDone(ctx, "extraction"); err != nil {
 		return err
 	}
@@ -476,8 +560,17 @@ func extractStargzEntry(ctx context.Context, stargzReader *estargz.Reader, entry
 		return fmt.Errorf("validation failed for %s: %w", entryName, err)
 	}
 
+	// Entries must stay inside targetDir and not be written through symlinks
+	name, err := resolveFSPath(entryName)
+	if err != nil {
+		return NewBundleError("extract", entryName, ErrSecurityViolation)
+	}
+	if err := links.checkPath(name); err != nil {
+		return err
+	}
+
 	// Create target path
-	targetPath := filepath.Join(targetDir, entryName)
+	targetPath := filepath.Join(targetDir, filepath.FromSlash(name))
 
 	// Handle based on entry type
 	switch entry.Type {
@@ -510,18 +603,49 @@ func extractStargzEntry(ctx context.Context, stargzReader *estargz.Reader, entry
 		if _, err := io.Copy(targetFile, sr); err != nil {
 			return fmt.Errorf("failed to write file %s: %w", targetPath, err)
 		}
+		links.file(name)
 
 	case "symlink":
-		// Handle symlinks if filesystem supports them
-		if sfs, ok := fsys.(core.SymlinkFS); ok {
-			if err := sfs.Symlink(entry.LinkName, targetPath); err != nil {
-				return fmt.Errorf("failed to create symlink %s: %w", targetPath, err)
-			}
+		if err := fsys.MkdirAll(filepath.Dir(targetPath), 0o755); err != nil {
+			return fmt.Errorf("failed to create parent directory for %s: %w", targetPath, err)
+		}
+		if err := links.symlink(name, entry.LinkName); err != nil {
+			return err
 		}
-		// If symlinks not supported, skip silently
+
+	default:
+		return nil
 	}
 
-	return nil
+	return metadata.restore(targetPath, stargzHeader(entry))
+}
+
+// stargzHeader returns a tar header carrying the metadata of a TOC entry.
+func stargzHeader(entry *estargz.TOCEntry) *tar.Header {
+	header := &tar.Header{
+		Name:     entry.Name,
+		Size:     entry.Size,
+		Mode:     entry.Mode,
+		Linkname: entry.LinkName,
+		ModTime:  entry.ModTime(),
+		Uid:      entry.UID,
+		Gid:      entry.GID,
+	}
+	switch entry.Type {
+	case "dir":
+		header.Typeflag = tar.TypeDir
+	case "symlink":
+		header.Typeflag = tar.TypeSymlink
+	default:
+		header.Typeflag = tar.TypeReg
+	}
+	for name, value := range entry.Xattrs {
+		if header.PAXRecords == nil {
+			header.PAXRecords = make(map[string]string)
+		}
+		header.PAXRecords[xattrPAXPrefix+name] = string(value)
+	}
+	return header
 }
 
 // Returns error if extraction fails.
@@ -540,7 +664,7 @@ func extractSelectiveFromStargz(
 	// Open the estargz archive
 	stargzReader, err := estargz.Open(sectionReader)
 	if err != nil {
-		return fmt.Errorf("failed to open estargz archive: %w", err)
+		return fmt.Errorf("%w: %w", errNotStargz, err)
 	}
 
 	// Create validators
@@ -555,6 +679,8 @@ func extractSelectiveFromStargz(
 	// Track statistics for validation
 	var totalSize int64
 	var fileCount int
+	links := newLinkExtractor(fsys, targetDir, opts.SymlinkPolicy)
+	metadata := newMetadataRestorer(fsys, opts)
 
 	// Now extract the collected files
 	for _, entryName := range filesToExtract {
@@ -563,6 +689,10 @@ func extractSelectiveFromStargz(
 		if !ok {
 			continue // Entry not found, skip
 		}
+		header := stargzHeader(entry)
+		if opts.Filter != nil && !opts.Filter(headerFileInfo(header)) {
+			continue
+		}
 
 		fileCount++
 		totalSize += entry.Size
@@ -577,10 +707,11 @@ func extractSelectiveFromStargz(
 		}
 
 		// Extract the individual entry
-		if err := extractStargzEntry(ctx, stargzReader, entryName, targetDir, validators, fsys); err != nil {
+		if err := extractStargzEntry(ctx, stargzReader, entryName, targetDir, validators, links, metadata, fsys); err != nil {
 			return fmt.Errorf("failed to extract entry %s: %w", entryName, err)
 		}
+		reportExtracted(opts, header, entryName)
 	}
 
-	return nil
+	return metadata.finish()
 }
@@ -5,11 +5,15 @@ import (
 	"context"
 	"errors"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/jmgilman/go/fs/billy"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -280,3 +284,134 @@ func TestGetBlobURLFromRepository(t *testing.T) {
 		assert.NotNil(t, getBlobURLFromRepository, "getBlobURLFromRepository should exist")
 	})
 }
+
+// TestHTTPRangeReaderAt tests the bounded HTTP Range ReaderAt.
+func TestHTTPRangeReaderAt(t *testing.T) {
+	data := make([]byte, 2*rangeBlockSize+100)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+
+	t.Run("reads ranges with bounded requests", func(t *testing.T) {
+		var ranges []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ranges = append(ranges, r.Header.Get("Range"))
+			http.ServeContent(w, r, "blob", time.Time{}, bytes.NewReader(data))
+		}))
+		defer server.Close()
+
+		reader := newHTTPRangeReaderAt(context.Background(), server.Client(), server.URL, int64(len(data)))
+
+		buf := make([]byte, 10)
+		n, err := reader.ReadAt(buf, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 10, n)
+		assert.Equal(t, data[100:110], buf)
+
+		// Reads within the fetched block are served without another request
+		n, err = reader.ReadAt(buf, 200)
+		require.NoError(t, err)
+		assert.Equal(t, 10, n)
+		assert.Equal(t, data[200:210], buf)
+
+		assert.Equal(t, []string{"bytes=100-262243"}, ranges)
+		assert.Equal(t, int64(rangeBlockSize), reader.Fetched())
+	})
+
+	t.Run("returns EOF at end of blob", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.ServeContent(w, r, "blob", time.Time{}, bytes.NewReader(data))
+		}))
+		defer server.Close()
+
+		reader := newHTTPRangeReaderAt(context.Background(), server.Client(), server.URL, int64(len(data)))
+
+		buf := make([]byte, 20)
+		n, err := reader.ReadAt(buf, int64(len(data)-5))
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 5, n)
+		assert.Equal(t, data[len(data)-5:], buf[:n])
+
+		_, err = reader.ReadAt(buf, int64(len(data)))
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("fails when range is ignored", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(data) //nolint:errcheck
+		}))
+		defer server.Close()
+
+		reader := newHTTPRangeReaderAt(context.Background(), server.Client(), server.URL, int64(len(data)))
+
+		_, err := reader.ReadAt(make([]byte, 10), 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "returned status 200")
+	})
+}
+
+// TestExtractSelectiveFromStargz_HTTPRange tests that selective extraction over
+// HTTP Range requests downloads only a fraction of the blob.
+func TestExtractSelectiveFromStargz_HTTPRange(t *testing.T) {
+	sourceDir := filepath.Join(t.TempDir(), "source")
+	require.NoError(t, os.MkdirAll(sourceDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "config.json"), []byte(`{"app":"test"}`), 0o644))
+
+	// Incompressible content keeps the blob large
+	large := make([]byte, 4*1024*1024)
+	_, err := rand.New(rand.NewSource(1)).Read(large)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "large.bin"), large, 0o644))
+
+	var blob bytes.Buffer
+	require.NoError(t, NewTarGzArchiver().Archive(context.Background(), sourceDir, &blob))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "blob", time.Time{}, bytes.NewReader(blob.Bytes()))
+	}))
+	defer server.Close()
+
+	size := int64(blob.Len())
+	reader := newHTTPRangeReaderAt(context.Background(), server.Client(), server.URL, size)
+	mem := billy.NewMemory()
+
+	err = extractSelectiveFromStargz(
+		context.Background(),
+		reader,
+		size,
+		"/out",
+		[]string{"*.json"},
+		DefaultExtractOptions,
+		mem,
+	)
+	require.NoError(t, err)
+
+	content, err := mem.ReadFile("/out/config.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"app":"test"}`, string(content))
+
+	exists, err := mem.Exists("/out/large.bin")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	assert.Less(t, reader.Fetched(), size/2, "should fetch only footer, TOC, and matching chunks")
+}
+
+// TestExtractSelectiveFromStargz_NotStargz tests that non-eStargz blobs are reported
+// with errNotStargz so callers can fall back to a full download.
+func TestExtractSelectiveFromStargz_NotStargz(t *testing.T) {
+	data, err := createMockTarGzData()
+	require.NoError(t, err)
+
+	err = extractSelectiveFromStargz(
+		context.Background(),
+		bytes.NewReader(data),
+		int64(len(data)),
+		"/out",
+		[]string{"*.txt"},
+		DefaultExtractOptions,
+		billy.NewMemory(),
+	)
+	assert.ErrorIs(t, err, errNotStargz)
+}
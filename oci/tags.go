@@ -0,0 +1,251 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains tag listing and management functionality.
+package ocibundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/registry/remote"
+
+	orasint "github.com/jmgilman/go/oci/internal/oras"
+)
+
+// maxManifestSize caps the size of manifests read from registries.
+const maxManifestSize = 4 * 1024 * 1024
+
+// ArtifactDescriptor describes the manifest a reference resolves to.
+type ArtifactDescriptor struct {
+	// Digest is the digest of the manifest (e.g., "sha256:abc123...")
+	Digest string
+
+	// MediaType is the media type of the manifest
+	MediaType string
+
+	// ArtifactType is the artifact type declared by the manifest, if any
+	ArtifactType string
+
+	// Size is the size of the manifest in bytes
+	Size int64
+
+	// Annotations are the annotations declared by the manifest
+	Annotations map[string]string
+}
+
+// ListTags returns the tags in a repository (e.g., "ghcr.io/org/app"),
+// in the order returned by the registry.
+func (c *Client) ListTags(ctx context.Context, repository string) ([]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if repository == "" {
+		return nil, fmt.Errorf("repository cannot be empty")
+	}
+	if _, refPart, _ := splitReference(repository); refPart != "" {
+		return nil, fmt.Errorf("repository must not include a tag or digest: %s", repository)
+	}
+
+	repo, err := orasint.NewRepository(ctx, repository, c.options.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	tags := []string{}
+	err = repo.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// Resolve resolves a tag or digest reference to the manifest it points to,
+// without downloading any layers.
+func (c *Client) Resolve(ctx context.Context, reference string) (*ArtifactDescriptor, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if reference == "" {
+		return nil, fmt.Errorf("reference cannot be empty")
+	}
+
+	_, desc, manifestBytes, err := c.fetchManifest(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	// Image manifests and indexes share these fields
+	var manifest struct {
+		ArtifactType string            `json:"artifactType"`
+		Annotations  map[string]string `json:"annotations"`
+	}
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	return &ArtifactDescriptor{
+		Digest:       desc.Digest.String(),
+		MediaType:    desc.MediaType,
+		ArtifactType: manifest.ArtifactType,
+		Size:         desc.Size,
+		Annotations:  manifest.Annotations,
+	}, nil
+}
+
+// ResolveDigest resolves a tag or digest reference to the digest of the
+// manifest it points to, with a single request that downloads nothing. Pin
+// deployment specs to the returned digest and enforce it with
+// WithExpectedDigest.
+func (c *Client) ResolveDigest(ctx context.Context, reference string) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.resolveDigest(ctx, reference)
+}
+
+// resolveDigest resolves reference to its manifest digest.
+func (c *Client) resolveDigest(ctx context.Context, reference string) (string, error) {
+	if reference == "" {
+		return "", fmt.Errorf("reference cannot be empty")
+	}
+
+	repo, err := orasint.NewRepository(ctx, reference, c.options.Auth)
+	if err != nil {
+		return "", fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	_, refPart, _ := splitReference(reference)
+	if refPart == "" {
+		return "", fmt.Errorf("reference must include a tag or digest")
+	}
+
+	desc, err := repo.Resolve(ctx, refPart)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", reference, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+// fetchManifest fetches the manifest reference resolves to, returning the
+// repository it was fetched from, its descriptor, and its content.
+func (c *Client) fetchManifest(ctx context.Context, reference string) (*remote.Repository, ocispec.Descriptor, []byte, error) {
+	repo, err := orasint.NewRepository(ctx, reference, c.options.Auth)
+	if err != nil {
+		return nil, ocispec.Descriptor{}, nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	_, refPart, _ := splitReference(reference)
+	if refPart == "" {
+		return nil, ocispec.Descriptor{}, nil, fmt.Errorf("reference must include a tag or digest")
+	}
+
+	desc, reader, err := repo.FetchReference(ctx, refPart)
+	if err != nil {
+		return nil, ocispec.Descriptor{}, nil, fmt.Errorf("failed to fetch manifest: %w", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	manifestBytes, err := io.ReadAll(io.LimitReader(reader, maxManifestSize))
+	if err != nil {
+		return nil, ocispec.Descriptor{}, nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	return repo, desc, manifestBytes, nil
+}
+
+// CopyTag tags the manifest that reference resolves to with tag, in the same
+// repository. Existing tags with the same name are moved to the manifest.
+// Use it to promote an artifact, e.g. from "app:rc" to "app:stable".
+func (c *Client) CopyTag(ctx context.Context, reference, tag string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if reference == "" {
+		return fmt.Errorf("reference cannot be empty")
+	}
+	if tag == "" {
+		return fmt.Errorf("tag cannot be empty")
+	}
+	if strings.ContainsAny(tag, "/:@") {
+		return fmt.Errorf("tag must not be a reference: %s", tag)
+	}
+
+	repo, err := orasint.NewRepository(ctx, reference, c.options.Auth)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	_, refPart, _ := splitReference(reference)
+	if refPart == "" {
+		return fmt.Errorf("reference must include a tag or digest")
+	}
+
+	if _, err := oras.Tag(ctx, repo, refPart, tag); err != nil {
+		return fmt.Errorf("failed to tag %s as %s: %w", reference, tag, err)
+	}
+
+	return nil
+}
+
+// DeleteTag removes a tag from its repository. The manifest it points to and
+// any other tags referencing it are left in place.
+//
+// Tag deletion is optional in the OCI distribution spec; registries that only
+// support deleting manifests by digest return an error.
+func (c *Client) DeleteTag(ctx context.Context, reference string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if reference == "" {
+		return fmt.Errorf("reference cannot be empty")
+	}
+
+	repo, err := orasint.NewRepository(ctx, reference, c.options.Auth)
+	if err != nil {
+		return fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	_, refPart, isDigest := splitReference(reference)
+	if refPart == "" || isDigest {
+		return fmt.Errorf("reference must include a tag")
+	}
+
+	protocol := "https"
+	if repo.PlainHTTP {
+		protocol = "http"
+	}
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s",
+		protocol, repo.Reference.Registry, repo.Reference.Repository, url.PathEscape(refPart))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, manifestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := repo.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusAccepted, http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("tag not found: %s", reference)
+	case http.StatusBadRequest, http.StatusMethodNotAllowed:
+		return fmt.Errorf("registry does not support tag deletion: returned status %d", resp.StatusCode)
+	default:
+		return fmt.Errorf("failed to delete tag: registry returned status %d", resp.StatusCode)
+	}
+}
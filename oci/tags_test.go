@@ -0,0 +1,196 @@
+package ocibundle
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTagRegistry is a minimal registry serving the manifests of org/app.
+type fakeTagRegistry struct {
+	mu          sync.Mutex
+	tags        map[string]digest.Digest
+	manifests   map[digest.Digest][]byte
+	allowDelete bool
+}
+
+func newFakeTagRegistry(t *testing.T, allowDelete bool) (*fakeTagRegistry, string) {
+	t.Helper()
+
+	manifest, err := json.Marshal(ocispec.Manifest{
+		MediaType:    ocispec.MediaTypeImageManifest,
+		ArtifactType: "application/vnd.catalyst.bundle.v1",
+		Config:       ocispec.DescriptorEmptyJSON,
+		Layers:       []ocispec.Descriptor{},
+		Annotations:  map[string]string{"org.example.version": "1.0.0"},
+	})
+	require.NoError(t, err)
+	dgst := digest.FromBytes(manifest)
+
+	reg := &fakeTagRegistry{
+		tags:        map[string]digest.Digest{"v1": dgst, "rc": dgst},
+		manifests:   map[digest.Digest][]byte{dgst: manifest},
+		allowDelete: allowDelete,
+	}
+	server := httptest.NewServer(http.HandlerFunc(reg.serveHTTP))
+	t.Cleanup(server.Close)
+
+	return reg, strings.TrimPrefix(server.URL, "http://") + "/org/app"
+}
+
+func (r *fakeTagRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if req.URL.Path == "/v2/org/app/tags/list" {
+		tags := make([]string, 0, len(r.tags))
+		for tag := range r.tags {
+			tags = append(tags, tag)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": "org/app", "tags": tags})
+		return
+	}
+
+	ref, ok := strings.CutPrefix(req.URL.Path, "/v2/org/app/manifests/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		dgst, ok := r.tags[ref]
+		if !ok {
+			dgst = digest.Digest(ref)
+		}
+		manifest, ok := r.manifests[dgst]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+		w.Header().Set("Docker-Content-Digest", dgst.String())
+		w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(manifest)
+		}
+	case http.MethodPut:
+		manifest, _ := io.ReadAll(req.Body)
+		dgst := digest.FromBytes(manifest)
+		r.manifests[dgst] = manifest
+		r.tags[ref] = dgst
+		w.Header().Set("Docker-Content-Digest", dgst.String())
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		if !r.allowDelete {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if _, ok := r.tags[ref]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(r.tags, ref)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestClient_TagManagement(t *testing.T) {
+	ctx := context.Background()
+
+	newClient := func(t *testing.T) *Client {
+		client, err := NewWithOptions(WithAllowHTTP())
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("lists tags", func(t *testing.T) {
+		_, repository := newFakeTagRegistry(t, true)
+
+		tags, err := newClient(t).ListTags(ctx, repository)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"v1", "rc"}, tags)
+	})
+
+	t.Run("rejects tagged repository", func(t *testing.T) {
+		_, err := newClient(t).ListTags(ctx, "localhost:5000/org/app:v1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must not include a tag or digest")
+	})
+
+	t.Run("resolves reference", func(t *testing.T) {
+		reg, repository := newFakeTagRegistry(t, true)
+
+		desc, err := newClient(t).Resolve(ctx, repository+":v1")
+		require.NoError(t, err)
+		assert.Equal(t, reg.tags["v1"].String(), desc.Digest)
+		assert.Equal(t, ocispec.MediaTypeImageManifest, desc.MediaType)
+		assert.Equal(t, "application/vnd.catalyst.bundle.v1", desc.ArtifactType)
+		assert.Equal(t, int64(len(reg.manifests[reg.tags["v1"]])), desc.Size)
+		assert.Equal(t, map[string]string{"org.example.version": "1.0.0"}, desc.Annotations)
+	})
+
+	t.Run("resolves digest", func(t *testing.T) {
+		reg, repository := newFakeTagRegistry(t, true)
+
+		dgst, err := newClient(t).ResolveDigest(ctx, repository+":v1")
+		require.NoError(t, err)
+		assert.Equal(t, reg.tags["v1"].String(), dgst)
+
+		_, err = newClient(t).ResolveDigest(ctx, repository+":missing")
+		assert.Error(t, err)
+	})
+
+	t.Run("copies tag", func(t *testing.T) {
+		reg, repository := newFakeTagRegistry(t, true)
+
+		require.NoError(t, newClient(t).CopyTag(ctx, repository+":rc", "stable"))
+		assert.Equal(t, reg.tags["rc"], reg.tags["stable"])
+	})
+
+	t.Run("rejects reference as tag", func(t *testing.T) {
+		err := newClient(t).CopyTag(ctx, "localhost:5000/org/app:rc", "localhost:5000/org/app:stable")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "tag must not be a reference")
+	})
+
+	t.Run("deletes tag", func(t *testing.T) {
+		reg, repository := newFakeTagRegistry(t, true)
+
+		require.NoError(t, newClient(t).DeleteTag(ctx, repository+":rc"))
+		assert.NotContains(t, reg.tags, "rc")
+		assert.Contains(t, reg.tags, "v1")
+
+		err := newClient(t).DeleteTag(ctx, repository+":rc")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "tag not found")
+	})
+
+	t.Run("reports unsupported tag deletion", func(t *testing.T) {
+		_, repository := newFakeTagRegistry(t, false)
+
+		err := newClient(t).DeleteTag(ctx, repository+":rc")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not support tag deletion")
+	})
+
+	t.Run("rejects digest reference for deletion", func(t *testing.T) {
+		err := newClient(t).DeleteTag(ctx, "localhost:5000/org/app@sha256:"+strings.Repeat("a", 64))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must include a tag")
+	})
+}
@@ -15,7 +15,7 @@ import (
 
 	"github.com/jmgilman/go/fs/billy"
 	ocibundle "github.com/jmgilman/go/oci"
-	"github.com/jmgilman/go/oci/internal/cache"
+	"github.com/jmgilman/go/oci/cache"
 	"github.com/jmgilman/go/oci/internal/testutil"
 )
 
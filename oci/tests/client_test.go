@@ -697,3 +697,63 @@ func TestClient_SelectiveExtraction(t *testing.T) {
 
 	t.Log("✓ Selective extraction tests passed")
 }
+
+// TestClient_LayerSplit tests pushing a bundle as multiple layers and pulling
+// all layers or a single component.
+func TestClient_LayerSplit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+
+	registry, err := testutil.NewTestRegistry(ctx)
+	require.NoError(t, err)
+	defer registry.Close(ctx)
+
+	err = registry.WaitForReady(ctx, 30*time.Second)
+	require.NoError(t, err)
+
+	sourceDir := t.TempDir()
+	testFiles := map[string]string{
+		"readme.txt":         "README content",
+		"config/app.yaml":    "app: test",
+		"src/main.go":        "package main",
+		"src/util/helper.go": "package util",
+	}
+	for path, content := range testFiles {
+		fullPath := filepath.Join(sourceDir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0o755))
+		require.NoError(t, os.WriteFile(fullPath, []byte(content), 0o644))
+	}
+
+	client, err := ocibundle.NewWithOptions(
+		ocibundle.WithHTTP(true, true, []string{registry.Reference()}),
+	)
+	require.NoError(t, err)
+
+	reference := fmt.Sprintf("%s/layer-split-test:latest", registry.Reference())
+	err = client.Push(ctx, sourceDir, reference, ocibundle.WithLayerSplit(ocibundle.SplitByTopLevelDir))
+	require.NoError(t, err)
+
+	t.Run("pull all layers", func(t *testing.T) {
+		targetDir := t.TempDir()
+		require.NoError(t, client.Pull(ctx, reference, targetDir))
+
+		for path, content := range testFiles {
+			data, err := os.ReadFile(filepath.Join(targetDir, path))
+			require.NoError(t, err)
+			assert.Equal(t, content, string(data))
+		}
+	})
+
+	t.Run("pull single layer", func(t *testing.T) {
+		targetDir := t.TempDir()
+		require.NoError(t, client.Pull(ctx, reference, targetDir, ocibundle.WithLayers("src")))
+
+		assert.FileExists(t, filepath.Join(targetDir, "src/main.go"))
+		assert.FileExists(t, filepath.Join(targetDir, "src/util/helper.go"))
+		assert.NoFileExists(t, filepath.Join(targetDir, "readme.txt"))
+		assert.NoFileExists(t, filepath.Join(targetDir, "config/app.yaml"))
+	})
+}
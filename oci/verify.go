@@ -0,0 +1,214 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains integrity verification of extracted bundles against the
+// eStargz TOC of their artifact.
+package ocibundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/jmgilman/go/fs/core"
+)
+
+// VerifyResult reports the outcome of verifying an extracted bundle against
+// its artifact.
+type VerifyResult struct {
+	// Verified is the number of entries that match the artifact
+	Verified int
+
+	// Missing lists entries of the artifact that are absent from the directory
+	Missing []string
+
+	// Tampered lists entries whose content or type no longer match the artifact
+	Tampered []TamperedFile
+}
+
+// TamperedFile describes an extracted entry that does not match its artifact.
+type TamperedFile struct {
+	// Name is the full path of the entry within the archive
+	Name string
+
+	// Reason describes the mismatch (e.g., "content digest mismatch")
+	Reason string
+}
+
+// OK reports whether every entry of the artifact was found intact.
+func (r *VerifyResult) OK() bool {
+	return len(r.Missing) == 0 && len(r.Tampered) == 0
+}
+
+// Verify checks the files extracted into dir against the artifact at
+// reference. Regular files are re-hashed and compared with the content digests
+// recorded in the artifact's eStargz TOC, which is read without downloading
+// the bundle. Directories and symlinks are checked for their type and link
+// target. As with extraction, symlinks are only checked if the client
+// filesystem supports them, and other entry types are skipped.
+//
+// Files in dir that are not part of the artifact are ignored, so dir should be
+// a full extraction without stripped prefixes or file filters. Mismatches are
+// reported in the result rather than as an error; an error means verification
+// could not be performed.
+//
+// Example:
+//
+//	result, err := client.Verify(ctx, "ghcr.io/org/repo:v1.0.0", "/opt/app")
+//	if err == nil && !result.OK() {
+//	    log.Printf("missing: %v, tampered: %v", result.Missing, result.Tampered)
+//	}
+func (c *Client) Verify(ctx context.Context, reference, dir string) (*VerifyResult, error) {
+	if reference == "" {
+		return nil, fmt.Errorf("reference cannot be empty")
+	}
+	if dir == "" {
+		return nil, fmt.Errorf("directory cannot be empty")
+	}
+
+	info, err := c.options.FS.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to access directory %s: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("not a directory: %s", dir)
+	}
+
+	ctx, span := c.startPhase(ctx, PhaseVerify, reference)
+	result, err := c.verifyReference(ctx, reference, dir)
+	span.end(0, err)
+	return result, err
+}
+
+// verifyReference verifies dir against the TOC of the artifact at reference.
+func (c *Client) verifyReference(ctx context.Context, reference, dir string) (*VerifyResult, error) {
+	listing, err := c.ListFiles(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files of %s: %w", reference, err)
+	}
+
+	return c.verifyFiles(ctx, dir, listing.Files)
+}
+
+// verifyFiles checks each entry of files against its extracted copy in dir.
+func (c *Client) verifyFiles(ctx context.Context, dir string, files []FileMetadata) (*VerifyResult, error) {
+	result := &VerifyResult{}
+
+	symlinks := supportsSymlinks(c.options.FS)
+
+	for _, file := range files {
+		if err := isDone(ctx, "verification"); err != nil {
+			return nil, err
+		}
+
+		// Only verify entries that extraction creates on this filesystem.
+		switch file.Type {
+		case "dir", "reg":
+		case "symlink":
+			if !symlinks {
+				continue
+			}
+		default:
+			continue
+		}
+
+		path := filepath.Join(dir, filepath.FromSlash(file.Name))
+		info, err := c.lstat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			result.Missing = append(result.Missing, file.Name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat %s: %w", path, err)
+		}
+
+		reason, err := c.verifyEntry(path, info, file)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			result.Tampered = append(result.Tampered, TamperedFile{Name: file.Name, Reason: reason})
+			continue
+		}
+		result.Verified++
+	}
+
+	return result, nil
+}
+
+// verifyEntry compares an extracted entry at path with its TOC metadata and
+// returns a non-empty reason on mismatch.
+func (c *Client) verifyEntry(path string, info fs.FileInfo, file FileMetadata) (string, error) {
+	switch file.Type {
+	case "dir":
+		if !info.IsDir() {
+			return "expected a directory", nil
+		}
+
+	case "reg":
+		if !info.Mode().IsRegular() {
+			return "expected a regular file", nil
+		}
+		if info.Size() != file.Size {
+			return fmt.Sprintf("size mismatch: expected %d bytes, found %d", file.Size, info.Size()), nil
+		}
+		if file.Digest == "" {
+			return "", nil
+		}
+		matches, err := c.contentMatches(path, file.Digest)
+		if err != nil {
+			return "", err
+		}
+		if !matches {
+			return "content digest mismatch", nil
+		}
+
+	case "symlink":
+		// Without Lstat, info describes the link target and can't be checked
+		if _, ok := c.options.FS.(core.MetadataFS); ok && info.Mode()&fs.ModeSymlink == 0 {
+			return "expected a symlink", nil
+		}
+		target, _, err := readlink(c.options.FS, path)
+		if err != nil {
+			return "", err
+		}
+		if target != file.LinkTarget {
+			return fmt.Sprintf("symlink target mismatch: expected %s, found %s", file.LinkTarget, target), nil
+		}
+	}
+
+	return "", nil
+}
+
+// contentMatches reports whether the content of the file at path matches
+// expected, a digest such as "sha256:abc123...".
+func (c *Client) contentMatches(path, expected string) (bool, error) {
+	d, err := digest.Parse(expected)
+	if err != nil {
+		return false, fmt.Errorf("invalid digest %q in TOC: %w", expected, err)
+	}
+
+	f, err := c.options.FS.Open(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	verifier := d.Verifier()
+	if _, err := io.Copy(verifier, f); err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return verifier.Verified(), nil
+}
+
+// lstat returns file info for path without following symlinks when the
+// client filesystem supports it.
+func (c *Client) lstat(path string) (fs.FileInfo, error) {
+	if mfs, ok := c.options.FS.(core.MetadataFS); ok {
+		return mfs.Lstat(path)
+	}
+	return c.options.FS.Stat(path)
+}
@@ -0,0 +1,122 @@
+package ocibundle
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jmgilman/go/fs/billy"
+	"github.com/jmgilman/go/fs/core"
+)
+
+// symlinkLocalFS adds symlink and metadata support to the local billy filesystem.
+type symlinkLocalFS struct {
+	core.FS
+}
+
+func (s symlinkLocalFS) Symlink(oldname, newname string) error     { return os.Symlink(oldname, newname) }
+func (s symlinkLocalFS) Readlink(name string) (string, error)      { return os.Readlink(name) }
+func (s symlinkLocalFS) Lstat(name string) (fs.FileInfo, error)    { return os.Lstat(name) }
+func (s symlinkLocalFS) Chmod(name string, mode fs.FileMode) error { return os.Chmod(name, mode) }
+func (s symlinkLocalFS) Chtimes(name string, atime, mtime time.Time) error {
+	return os.Chtimes(name, atime, mtime)
+}
+
+func TestClient_VerifyFiles(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := NewWithOptions(WithFilesystem(symlinkLocalFS{FS: billy.NewLocal()}))
+	require.NoError(t, err)
+
+	// newBundle extracts a small bundle into a temporary directory and returns
+	// it with the TOC listing that describes it.
+	newBundle := func(t *testing.T) (string, []FileMetadata) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "config"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "config", "app.yaml"), []byte("name: app"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "run.sh"), []byte("exit"), 0o755))
+		require.NoError(t, os.Symlink("config/app.yaml", filepath.Join(dir, "current")))
+
+		files := []FileMetadata{
+			{Name: "config", Type: "dir", IsDir: true},
+			{Name: "config/app.yaml", Type: "reg", Size: 9, Digest: digest.FromString("name: app").String()},
+			{Name: "run.sh", Type: "reg", Size: 4, Digest: digest.FromString("exit").String()},
+			{Name: "current", Type: "symlink", LinkTarget: "config/app.yaml"},
+		}
+		return dir, files
+	}
+
+	t.Run("intact bundle", func(t *testing.T) {
+		dir, files := newBundle(t)
+
+		result, err := client.verifyFiles(ctx, dir, files)
+		require.NoError(t, err)
+		assert.True(t, result.OK())
+		assert.Equal(t, 4, result.Verified)
+	})
+
+	t.Run("reports tampered and missing files", func(t *testing.T) {
+		dir, files := newBundle(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "config", "app.yaml"), []byte("name: bad"), 0o644))
+		require.NoError(t, os.Remove(filepath.Join(dir, "run.sh")))
+		require.NoError(t, os.Remove(filepath.Join(dir, "current")))
+		require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(dir, "current")))
+
+		result, err := client.verifyFiles(ctx, dir, files)
+		require.NoError(t, err)
+		assert.False(t, result.OK())
+		assert.Equal(t, []string{"run.sh"}, result.Missing)
+
+		require.Len(t, result.Tampered, 2)
+		assert.Equal(t, "config/app.yaml", result.Tampered[0].Name)
+		assert.Equal(t, "content digest mismatch", result.Tampered[0].Reason)
+		assert.Equal(t, "current", result.Tampered[1].Name)
+		assert.Contains(t, result.Tampered[1].Reason, "symlink target mismatch")
+	})
+
+	t.Run("reports type changes", func(t *testing.T) {
+		dir, files := newBundle(t)
+		require.NoError(t, os.Remove(filepath.Join(dir, "run.sh")))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "run.sh"), 0o755))
+
+		result, err := client.verifyFiles(ctx, dir, files)
+		require.NoError(t, err)
+		require.Len(t, result.Tampered, 1)
+		assert.Equal(t, "expected a regular file", result.Tampered[0].Reason)
+	})
+
+	t.Run("skips symlinks without symlink support", func(t *testing.T) {
+		dir, files := newBundle(t)
+		require.NoError(t, os.Remove(filepath.Join(dir, "current")))
+
+		// Wrapping the local filesystem hides its symlink support
+		plain, err := NewWithOptions(WithFilesystem(struct{ core.FS }{billy.NewLocal()}))
+		require.NoError(t, err)
+
+		result, err := plain.verifyFiles(ctx, dir, files)
+		require.NoError(t, err)
+		assert.True(t, result.OK())
+		assert.Equal(t, 3, result.Verified)
+	})
+}
+
+func TestClient_Verify(t *testing.T) {
+	client, err := New()
+	require.NoError(t, err)
+
+	_, err = client.Verify(context.Background(), "", t.TempDir())
+	require.Error(t, err)
+
+	_, err = client.Verify(context.Background(), "example.com/repo:v1", "")
+	require.Error(t, err)
+
+	_, err = client.Verify(context.Background(), "example.com/repo:v1", filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
@@ -0,0 +1,60 @@
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains extended attribute support on Linux.
+package ocibundle
+
+import (
+	"bytes"
+	"errors"
+	"syscall"
+)
+
+// listXattrs returns the extended attributes of path. Filesystems without
+// extended attribute support report none.
+func listXattrs(path string) (map[string]string, error) {
+	size, err := syscall.Listxattr(path, nil)
+	if errors.Is(err, syscall.ENOTSUP) {
+		return nil, nil
+	}
+	if err != nil || size == 0 {
+		return nil, err
+	}
+
+	names := make([]byte, size)
+	size, err = syscall.Listxattr(path, names)
+	if err != nil {
+		return nil, err
+	}
+
+	xattrs := make(map[string]string)
+	for _, name := range bytes.Split(names[:size], []byte{0}) {
+		if len(name) == 0 {
+			continue
+		}
+		value, err := getXattr(path, string(name))
+		if err != nil {
+			return nil, err
+		}
+		xattrs[string(name)] = value
+	}
+	return xattrs, nil
+}
+
+// getXattr returns the value of an extended attribute of path.
+func getXattr(path, name string) (string, error) {
+	size, err := syscall.Getxattr(path, name, nil)
+	if err != nil || size == 0 {
+		return "", err
+	}
+
+	value := make([]byte, size)
+	size, err = syscall.Getxattr(path, name, value)
+	if err != nil {
+		return "", err
+	}
+	return string(value[:size]), nil
+}
+
+// setXattr sets an extended attribute of path.
+func setXattr(path, name, value string) error {
+	return syscall.Setxattr(path, name, []byte(value), 0)
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+// Package ocibundle provides OCI bundle distribution functionality.
+// This file contains the fallback for platforms without extended attribute support.
+package ocibundle
+
+// listXattrs reports no extended attributes on this platform.
+func listXattrs(string) (map[string]string, error) {
+	return nil, nil
+}
+
+// setXattr ignores extended attributes on this platform.
+func setXattr(string, string, string) error {
+	return nil
+}